@@ -14,7 +14,13 @@ const sidebars: SidebarsConfig = {
       link: { type: 'doc', id: 'sensors/sensors' },
       items: [
         'sensors/http-temperature',
+        'sensors/http-json',
+        'sensors/modbus-tcp',
+        'sensors/prometheus',
         'sensors/zigbee',
+        'sensors/rtl433',
+        'sensors/homeassistant-discovery',
+        'sensors/computed',
         'sensors/device-control',
         'sensors/managing-sensors-ref',
       ],
@@ -50,9 +50,16 @@ type ReadingsObserver interface {
 	ObserveReadings(ctx context.Context, sensorID int, readings []gen.Reading)
 }
 
+// CommandStatusObserver is told about every command that reaches a final status, alongside
+// the broadcaster. Observers must not block.
+type CommandStatusObserver interface {
+	CommandStatusChanged(message CommandStatusMessage)
+}
+
 type CommandTracker struct {
 	repo        CommandRepository
 	broadcaster CommandStatusBroadcaster
+	observers   []CommandStatusObserver
 	logger      *slog.Logger
 	now         func() time.Time
 	schedule    func(delay time.Duration, fn func()) func()
@@ -79,6 +86,12 @@ func NewCommandTracker(repo CommandRepository, broadcaster CommandStatusBroadcas
 	}
 }
 
+// AddStatusObserver registers an observer of final command statuses. It must be called
+// before any command is tracked.
+func (t *CommandTracker) AddStatusObserver(observer CommandStatusObserver) {
+	t.observers = append(t.observers, observer)
+}
+
 func (t *CommandTracker) Track(ctx context.Context, command database.PendingCommandRecord) {
 	delay := t.remaining(command)
 	if delay <= 0 {
@@ -118,7 +131,7 @@ func (t *CommandTracker) ObserveReadings(ctx context.Context, sensorID int, read
 			continue
 		}
 
-		value := readingValue(reading)
+		value := ReadingValue(reading)
 		if value == "" {
 			continue
 		}
@@ -216,11 +229,7 @@ func (t *CommandTracker) remove(id int) {
 }
 
 func (t *CommandTracker) broadcast(command database.PendingCommandRecord) {
-	if t.broadcaster == nil {
-		return
-	}
-
-	t.broadcaster.BroadcastCommandStatus(CommandStatusMessage{
+	message := CommandStatusMessage{
 		Type:              "command_status",
 		ID:                command.ID,
 		SensorID:          command.SensorID,
@@ -229,10 +238,18 @@ func (t *CommandTracker) broadcast(command database.PendingCommandRecord) {
 		Status:            command.Status,
 		AcknowledgedValue: command.AcknowledgedValue,
 		AcknowledgedAt:    command.AcknowledgedAt,
-	})
+	}
+	if t.broadcaster != nil {
+		t.broadcaster.BroadcastCommandStatus(message)
+	}
+	for _, observer := range t.observers {
+		observer.CommandStatusChanged(message)
+	}
 }
 
-func readingValue(reading gen.Reading) string {
+// ReadingValue is a reading's value as it is compared with command values: its text
+// state, or else its numeric value. It is empty for readings with neither.
+func ReadingValue(reading gen.Reading) string {
 	if reading.TextState != nil {
 		return *reading.TextState
 	}
@@ -148,6 +148,30 @@ func TestRecoverPending_TimesOutExpiredCommandsAndTracksRemainingOnes(t *testing
 	assert.Equal(t, CommandStatusAcknowledged, broadcaster.messages[1].Status)
 }
 
+func TestMarkFailed_NotifiesStatusObservers(t *testing.T) {
+	now := time.Date(2026, 5, 9, 12, 0, 0, 0, time.UTC)
+	repo := newFakeCommandTrackerRepository(database.PendingCommandRecord{
+		ID:             44,
+		SensorID:       7,
+		Property:       "state",
+		Value:          "ON",
+		TimeoutSeconds: 10,
+		SentAt:         now,
+	})
+	observer := &fakeCommandStatusBroadcaster{}
+	tracker := NewCommandTracker(repo, nil, slog.Default())
+	tracker.AddStatusObserver(observer)
+	tracker.now = func() time.Time { return now }
+	defer tracker.Close()
+
+	tracker.Track(context.Background(), repo.mustGet(44))
+	tracker.MarkFailed(context.Background(), repo.mustGet(44))
+
+	require.Len(t, observer.messages, 1)
+	assert.Equal(t, 44, observer.messages[0].ID)
+	assert.Equal(t, CommandStatusFailed, observer.messages[0].Status)
+}
+
 type fakeCommandTrackerRepository struct {
 	mu       sync.Mutex
 	commands map[int]database.PendingCommandRecord
@@ -235,6 +259,10 @@ func (b *fakeCommandStatusBroadcaster) BroadcastCommandStatus(message CommandSta
 	b.messages = append(b.messages, message)
 }
 
+func (b *fakeCommandStatusBroadcaster) CommandStatusChanged(message CommandStatusMessage) {
+	b.messages = append(b.messages, message)
+}
+
 func ptrString(value string) *string {
 	return &value
 }
@@ -0,0 +1,183 @@
+package alerting_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"example/sensorHub/alerting"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setAlertRuleHysteresis(t *testing.T, db *sql.DB, ruleID int, hysteresis float64) {
+	t.Helper()
+	_, err := db.ExecContext(context.Background(), "UPDATE sensor_alert_rules SET hysteresis = ? WHERE id = ?", hysteresis, ruleID)
+	require.NoError(t, err)
+}
+
+func alertRuleState(t *testing.T, db *sql.DB, ruleID int) alerting.AlertState {
+	t.Helper()
+	var state alerting.AlertState
+	require.NoError(t, db.QueryRowContext(context.Background(), "SELECT state FROM sensor_alert_rules WHERE id = ?", ruleID).Scan(&state))
+	return state
+}
+
+func processTemperature(t *testing.T, p *alerting.ThresholdAlertProcessor, sensorID int, value float64) {
+	t.Helper()
+	require.NoError(t, p.ProcessReading(context.Background(), alerting.ReadingAlert{
+		SensorID:        sensorID,
+		SensorName:      "garage",
+		MeasurementType: "temperature",
+		NumericValue:    value,
+	}))
+}
+
+func TestProcessReading_firingRule_resolvesWhenBackInRange(t *testing.T) {
+	db := newTestDB(t)
+	sensorID := insertSensor(t, db, "garage")
+	mtID := getMeasurementTypeID(t, db, "temperature")
+	ruleID := insertNumericAlertRule(t, db, sensorID, mtID, 30.0, 0.0, true, 0)
+
+	p := newProcessor(t, db, nil, nil)
+	processTemperature(t, p, sensorID, -2.0)
+	assert.Equal(t, alerting.AlertStateFiring, alertRuleState(t, db, ruleID))
+
+	processTemperature(t, p, sensorID, 5.0)
+
+	assert.Equal(t, alerting.AlertStateResolved, alertRuleState(t, db, ruleID))
+	assert.Equal(t, 1, countAlertHistory(t, db))
+	assert.Equal(t, 2, countNotifications(t, db))
+
+	var title string
+	require.NoError(t, db.QueryRow("SELECT title FROM notifications ORDER BY id DESC LIMIT 1").Scan(&title))
+	assert.Equal(t, "Resolved: garage", title)
+
+	var resolvedAt sql.NullString
+	require.NoError(t, db.QueryRow("SELECT resolved_at FROM alert_sent_history").Scan(&resolvedAt))
+	assert.True(t, resolvedAt.Valid)
+}
+
+func TestProcessReading_firingRule_doesNotRenotifyWhileBreached(t *testing.T) {
+	db := newTestDB(t)
+	sensorID := insertSensor(t, db, "garage")
+	mtID := getMeasurementTypeID(t, db, "temperature")
+	insertNumericAlertRule(t, db, sensorID, mtID, 30.0, 0.0, true, 0)
+
+	p := newProcessor(t, db, nil, nil)
+	processTemperature(t, p, sensorID, -2.0)
+	processTemperature(t, p, sensorID, -3.0)
+	processTemperature(t, p, sensorID, -1.0)
+
+	assert.Equal(t, 1, countAlertHistory(t, db))
+	assert.Equal(t, 1, countNotifications(t, db))
+}
+
+func TestProcessReading_firingRule_recordsPeak(t *testing.T) {
+	db := newTestDB(t)
+	sensorID := insertSensor(t, db, "garage")
+	mtID := getMeasurementTypeID(t, db, "temperature")
+	insertNumericAlertRule(t, db, sensorID, mtID, 30.0, 0.0, true, 0)
+
+	p := newProcessor(t, db, nil, nil)
+	processTemperature(t, p, sensorID, -2.0)
+	processTemperature(t, p, sensorID, -6.5)
+	processTemperature(t, p, sensorID, -4.0)
+
+	var peak float64
+	require.NoError(t, db.QueryRow("SELECT peak_value FROM alert_sent_history").Scan(&peak))
+	assert.Equal(t, -6.5, peak)
+
+	processTemperature(t, p, sensorID, 3.0)
+
+	var message string
+	require.NoError(t, db.QueryRow("SELECT message FROM notifications ORDER BY id DESC LIMIT 1").Scan(&message))
+	assert.Contains(t, message, "peak: -6.50")
+}
+
+func TestProcessReading_hysteresis_preventsFlapping(t *testing.T) {
+	db := newTestDB(t)
+	sensorID := insertSensor(t, db, "garage")
+	mtID := getMeasurementTypeID(t, db, "temperature")
+	ruleID := insertNumericAlertRule(t, db, sensorID, mtID, 30.0, 0.0, true, 0)
+	setAlertRuleHysteresis(t, db, ruleID, 1.5)
+
+	p := newProcessor(t, db, nil, nil)
+	processTemperature(t, p, sensorID, -0.5)
+	processTemperature(t, p, sensorID, 0.5)
+	processTemperature(t, p, sensorID, -0.2)
+	processTemperature(t, p, sensorID, 1.0)
+
+	assert.Equal(t, alerting.AlertStateFiring, alertRuleState(t, db, ruleID))
+	assert.Equal(t, 1, countNotifications(t, db))
+
+	processTemperature(t, p, sensorID, 1.6)
+
+	assert.Equal(t, alerting.AlertStateResolved, alertRuleState(t, db, ruleID))
+	assert.Equal(t, 2, countNotifications(t, db))
+}
+
+func TestProcessReading_resolvedRule_firesAgainOnNewBreach(t *testing.T) {
+	db := newTestDB(t)
+	sensorID := insertSensor(t, db, "garage")
+	mtID := getMeasurementTypeID(t, db, "temperature")
+	ruleID := insertNumericAlertRule(t, db, sensorID, mtID, 30.0, 0.0, true, 0)
+
+	p := newProcessor(t, db, nil, nil)
+	processTemperature(t, p, sensorID, -2.0)
+	processTemperature(t, p, sensorID, 5.0)
+	processTemperature(t, p, sensorID, -1.0)
+
+	assert.Equal(t, alerting.AlertStateFiring, alertRuleState(t, db, ruleID))
+	assert.Equal(t, 2, countAlertHistory(t, db))
+	assert.Equal(t, 3, countNotifications(t, db))
+}
+
+type recordingAlertListener struct {
+	fired []int
+}
+
+func (l *recordingAlertListener) AlertFired(_ context.Context, ruleID int) {
+	l.fired = append(l.fired, ruleID)
+}
+
+func TestProcessReading_alertListener_toldOncePerEpisode(t *testing.T) {
+	db := newTestDB(t)
+	sensorID := insertSensor(t, db, "garage")
+	mtID := getMeasurementTypeID(t, db, "temperature")
+	ruleID := insertNumericAlertRule(t, db, sensorID, mtID, 30.0, 0.0, true, 0)
+	insertSilenceWindowAroundNow(t, db, &sensorID)
+
+	listener := &recordingAlertListener{}
+	p := newProcessor(t, db, nil, nil)
+	p.SetAlertListener(listener)
+
+	processTemperature(t, p, sensorID, -2.0)
+	processTemperature(t, p, sensorID, -3.0)
+	assert.Equal(t, []int{ruleID}, listener.fired, "silencing only suppresses notifications")
+
+	processTemperature(t, p, sensorID, 5.0)
+	processTemperature(t, p, sensorID, -2.0)
+	assert.Equal(t, []int{ruleID, ruleID}, listener.fired)
+}
+
+func TestProcessReading_rateLimitedEpisode_resolvesWithoutNotifying(t *testing.T) {
+	db := newTestDB(t)
+	sensorID := insertSensor(t, db, "garage")
+	mtID := getMeasurementTypeID(t, db, "temperature")
+	ruleID := insertNumericAlertRule(t, db, sensorID, mtID, 30.0, 0.0, true, 3600)
+
+	p := newProcessor(t, db, nil, nil)
+	processTemperature(t, p, sensorID, -2.0)
+	processTemperature(t, p, sensorID, 5.0)
+	require.Equal(t, 2, countNotifications(t, db), "the first episode notifies when it fires and resolves")
+
+	processTemperature(t, p, sensorID, -1.0)
+	assert.Equal(t, alerting.AlertStateFiring, alertRuleState(t, db, ruleID))
+	processTemperature(t, p, sensorID, 4.0)
+
+	assert.Equal(t, alerting.AlertStateResolved, alertRuleState(t, db, ruleID))
+	assert.Equal(t, 2, countAlertHistory(t, db))
+	assert.Equal(t, 2, countNotifications(t, db), "the second episode started within the rate limit")
+}
@@ -0,0 +1,230 @@
+package alerting
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+type CompoundOperator string
+
+const (
+	CompoundOperatorAnd CompoundOperator = "and"
+	CompoundOperatorOr  CompoundOperator = "or"
+)
+
+type ConditionComparison string
+
+const (
+	ComparisonGreaterThan        ConditionComparison = ">"
+	ComparisonGreaterThanOrEqual ConditionComparison = ">="
+	ComparisonLessThan           ConditionComparison = "<"
+	ComparisonLessThanOrEqual    ConditionComparison = "<="
+	ComparisonEqual              ConditionComparison = "=="
+	ComparisonNotEqual           ConditionComparison = "!="
+)
+
+// AlertCondition is one clause of a CompoundAlertRule. Numeric conditions compare the
+// latest value against Threshold; status conditions (Status set, == or != only) compare
+// the latest text state. LatestNumericValue/LatestStatus are populated by the repository
+// from the most recent stored reading and are nil when the sensor has never reported.
+type AlertCondition struct {
+	ID                 int                 `json:"ID"`
+	SensorID           int                 `json:"SensorID"`
+	SensorName         string              `json:"SensorName"`
+	MeasurementTypeId  int                 `json:"MeasurementTypeID"`
+	MeasurementType    string              `json:"MeasurementType"`
+	Comparison         ConditionComparison `json:"Comparison"`
+	Threshold          float64             `json:"Threshold"`
+	Status             string              `json:"Status"`
+	LatestNumericValue *float64            `json:"-"`
+	LatestStatus       *string             `json:"-"`
+}
+
+// CompoundAlertRule fires when its conditions, combined with Operator, are all (and) or
+// any (or) satisfied. It is evaluated whenever a reading for any participating
+// sensor/measurement type arrives, and tracks State like a single-sensor rule.
+type CompoundAlertRule struct {
+	ID               int              `json:"ID"`
+	Name             string           `json:"Name"`
+	Operator         CompoundOperator `json:"Operator"`
+	Conditions       []AlertCondition `json:"Conditions"`
+	Enabled          bool             `json:"Enabled"`
+	RateLimitSeconds int              `json:"RateLimitSeconds"`
+	Hysteresis       float64          `json:"Hysteresis"`
+	State            AlertState       `json:"State"`
+	SnoozedUntil     *time.Time       `json:"SnoozedUntil"`
+	LastAlertSentAt  *time.Time       `json:"LastAlertSentAt"`
+}
+
+func (c *AlertCondition) Validate() error {
+	if c.SensorID <= 0 {
+		return fmt.Errorf("sensor ID must be a positive integer")
+	}
+	if c.MeasurementTypeId <= 0 {
+		return fmt.Errorf("measurement type ID must be a positive integer")
+	}
+
+	switch c.Comparison {
+	case ComparisonEqual, ComparisonNotEqual:
+		return nil
+	case ComparisonGreaterThan, ComparisonGreaterThanOrEqual, ComparisonLessThan, ComparisonLessThanOrEqual:
+		if c.Status != "" {
+			return fmt.Errorf("status conditions only support '==' and '!='")
+		}
+		return nil
+	default:
+		return fmt.Errorf("invalid comparison %q: must be one of >, >=, <, <=, ==, !=", c.Comparison)
+	}
+}
+
+func (r *CompoundAlertRule) Validate() error {
+	if strings.TrimSpace(r.Name) == "" {
+		return fmt.Errorf("name is required")
+	}
+	if r.Operator != CompoundOperatorAnd && r.Operator != CompoundOperatorOr {
+		return fmt.Errorf("invalid operator: must be 'and' or 'or'")
+	}
+	if r.RateLimitSeconds < 0 {
+		return fmt.Errorf("rate limit seconds cannot be negative")
+	}
+	if r.Hysteresis < 0 {
+		return fmt.Errorf("hysteresis cannot be negative")
+	}
+	if len(r.Conditions) < 2 {
+		return fmt.Errorf("a compound rule needs at least two conditions")
+	}
+	for i := range r.Conditions {
+		if err := r.Conditions[i].Validate(); err != nil {
+			return fmt.Errorf("condition %d: %w", i+1, err)
+		}
+	}
+	return nil
+}
+
+// Matches reports whether the condition targets the given sensor and measurement type.
+func (c *AlertCondition) Matches(sensorID int, measurementType string) bool {
+	return c.SensorID == sensorID && strings.EqualFold(c.MeasurementType, measurementType)
+}
+
+// isStatus reports whether the condition compares text states rather than numbers.
+func (c *AlertCondition) isStatus() bool {
+	return c.Status != ""
+}
+
+// evaluate checks the condition against a value. A missing value never meets a condition.
+// A positive hysteresis moves the threshold of an ordering comparison that far towards
+// the values that do not meet it, so a met condition stays met until the value has come
+// back past the threshold by at least hysteresis.
+func (c *AlertCondition) evaluate(numericValue *float64, statusValue *string, hysteresis float64) (met bool, description string) {
+	label := c.SensorName + " " + c.MeasurementType
+
+	if c.isStatus() {
+		if statusValue == nil {
+			return false, ""
+		}
+		equal := *statusValue == c.Status
+		if c.Comparison == ComparisonNotEqual {
+			return !equal, fmt.Sprintf("%s is %s (not %s)", label, *statusValue, c.Status)
+		}
+		return equal, fmt.Sprintf("%s is %s", label, *statusValue)
+	}
+
+	if numericValue == nil {
+		return false, ""
+	}
+	v := *numericValue
+	switch c.Comparison {
+	case ComparisonGreaterThan:
+		met = v > c.Threshold-hysteresis
+	case ComparisonGreaterThanOrEqual:
+		met = v >= c.Threshold-hysteresis
+	case ComparisonLessThan:
+		met = v < c.Threshold+hysteresis
+	case ComparisonLessThanOrEqual:
+		met = v <= c.Threshold+hysteresis
+	case ComparisonEqual:
+		met = v == c.Threshold
+	case ComparisonNotEqual:
+		met = v != c.Threshold
+	}
+	return met, fmt.Sprintf("%s %.2f %s %.2f", label, v, c.Comparison, c.Threshold)
+}
+
+// ShouldAlert evaluates the rule for an incoming reading. Conditions on the reading's
+// sensor/measurement type use the incoming values; all other conditions use their
+// latest stored values. Conditions without any value are treated as not met.
+func (r *CompoundAlertRule) ShouldAlert(reading ReadingAlert) (bool, string) {
+	if !r.Enabled || len(r.Conditions) == 0 {
+		return false, ""
+	}
+	holds, met, _ := r.evaluate(reading, 0)
+	if !holds {
+		return false, ""
+	}
+	return true, strings.Join(met, " "+strings.ToUpper(string(r.Operator))+" ")
+}
+
+// ShouldResolve reports whether a firing rule has recovered: its conditions no longer
+// hold once every numeric threshold is relaxed by Hysteresis. Equality and status
+// conditions have no margin.
+func (r *CompoundAlertRule) ShouldResolve(reading ReadingAlert) bool {
+	holds, _, _ := r.evaluate(reading, r.Hysteresis)
+	return !holds
+}
+
+// ResolvedReason describes the conditions that are no longer met for the reading that
+// resolved the rule.
+func (r *CompoundAlertRule) ResolvedReason(reading ReadingAlert) string {
+	_, _, unmet := r.evaluate(reading, r.Hysteresis)
+	if len(unmet) == 0 {
+		return "conditions no longer met"
+	}
+	return "no longer met: " + strings.Join(unmet, ", ")
+}
+
+// evaluate combines the conditions for an incoming reading with Operator and returns the
+// descriptions of the conditions that are met and of those that are not. Conditions
+// without any value are unmet and have no description.
+func (r *CompoundAlertRule) evaluate(reading ReadingAlert, hysteresis float64) (holds bool, met, unmet []string) {
+	for i := range r.Conditions {
+		c := &r.Conditions[i]
+		numericValue, statusValue := c.LatestNumericValue, c.LatestStatus
+		if c.Matches(reading.SensorID, reading.MeasurementType) {
+			numericValue = &reading.NumericValue
+			statusValue = nil
+			if reading.StatusValue != "" {
+				statusValue = &reading.StatusValue
+			}
+		}
+
+		ok, description := c.evaluate(numericValue, statusValue, hysteresis)
+		switch {
+		case ok:
+			met = append(met, description)
+		case description != "":
+			unmet = append(unmet, description)
+		}
+	}
+
+	if r.Operator == CompoundOperatorAnd {
+		return len(r.Conditions) > 0 && len(met) == len(r.Conditions), met, unmet
+	}
+	return len(met) > 0, met, unmet
+}
+
+// IsSnoozed reports whether the rule's notifications are snoozed at now.
+func (r *CompoundAlertRule) IsSnoozed(now time.Time) bool {
+	return r.SnoozedUntil != nil && now.Before(*r.SnoozedUntil)
+}
+
+func (r *CompoundAlertRule) IsRateLimited() bool {
+	if r.RateLimitSeconds == 0 {
+		return false
+	}
+	if r.LastAlertSentAt == nil {
+		return false
+	}
+	elapsed := time.Since(*r.LastAlertSentAt)
+	return elapsed < time.Duration(r.RateLimitSeconds)*time.Second
+}
@@ -0,0 +1,280 @@
+package alerting_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"example/sensorHub/alerting"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func insertCompoundAlertRule(t *testing.T, db *sql.DB, name string, operator alerting.CompoundOperator, rateLimitSecs int, conditions ...alerting.AlertCondition) int {
+	t.Helper()
+	res, err := db.ExecContext(context.Background(),
+		"INSERT INTO compound_alert_rules (name, operator, enabled, rate_limit_seconds) VALUES (?, ?, 1, ?)",
+		name, operator, rateLimitSecs)
+	require.NoError(t, err)
+	ruleID, _ := res.LastInsertId()
+	for _, c := range conditions {
+		_, err := db.ExecContext(context.Background(),
+			`INSERT INTO compound_alert_conditions (rule_id, sensor_id, measurement_type_id, comparison, threshold, status)
+			 VALUES (?, ?, ?, ?, ?, NULLIF(?, ''))`,
+			ruleID, c.SensorID, c.MeasurementTypeId, c.Comparison, c.Threshold, c.Status)
+		require.NoError(t, err)
+	}
+	return int(ruleID)
+}
+
+func compoundRuleState(t *testing.T, db *sql.DB, ruleID int) alerting.AlertState {
+	t.Helper()
+	var state alerting.AlertState
+	require.NoError(t, db.QueryRow("SELECT state FROM compound_alert_rules WHERE id = ?", ruleID).Scan(&state))
+	return state
+}
+
+func insertReading(t *testing.T, db *sql.DB, sensorID, mtID int, numericValue *float64, textState *string) {
+	t.Helper()
+	_, err := db.ExecContext(context.Background(),
+		"INSERT INTO readings (sensor_id, measurement_type_id, numeric_value, text_state, time) VALUES (?, ?, ?, ?, datetime('now'))",
+		sensorID, mtID, numericValue, textState)
+	require.NoError(t, err)
+}
+
+func TestProcessReading_compoundAndRule_firesWhenAllConditionsMet(t *testing.T) {
+	db := newTestDB(t)
+	windowID := insertSensor(t, db, "window")
+	outdoorID := insertSensor(t, db, "outdoor")
+	contactID := getMeasurementTypeID(t, db, "contact")
+	temperatureID := getMeasurementTypeID(t, db, "temperature")
+	ruleID := insertCompoundAlertRule(t, db, "Window open in the cold", alerting.CompoundOperatorAnd, 3600,
+		alerting.AlertCondition{SensorID: windowID, MeasurementTypeId: contactID, Comparison: alerting.ComparisonEqual, Status: "false"},
+		alerting.AlertCondition{SensorID: outdoorID, MeasurementTypeId: temperatureID, Comparison: alerting.ComparisonLessThan, Threshold: 5},
+	)
+	insertUserWithRole(t, db, "alice", "alice@example.com", "admin")
+
+	ws := &recordingWS{}
+	p := newProcessor(t, db, ws, nil)
+	windowOpen := alerting.ReadingAlert{SensorID: windowID, SensorName: "window", MeasurementType: "contact", StatusValue: "false"}
+
+	// Outdoor sensor has not reported yet, so the AND cannot be satisfied.
+	require.NoError(t, p.ProcessReading(context.Background(), windowOpen))
+	assert.Equal(t, 0, countAlertHistory(t, db))
+
+	cold := 3.5
+	insertReading(t, db, outdoorID, temperatureID, &cold, nil)
+
+	require.NoError(t, p.ProcessReading(context.Background(), windowOpen))
+	assert.Equal(t, 1, countAlertHistory(t, db))
+	assert.Equal(t, 1, countNotifications(t, db))
+	assert.Equal(t, 1, ws.broadcastCount())
+
+	var compoundRuleID int
+	var reason string
+	require.NoError(t, db.QueryRow("SELECT compound_rule_id, alert_reason FROM alert_sent_history").Scan(&compoundRuleID, &reason))
+	assert.Equal(t, ruleID, compoundRuleID)
+	assert.Equal(t, "window contact is false AND outdoor temperature 3.50 < 5.00", reason)
+
+	var title string
+	require.NoError(t, db.QueryRow("SELECT title FROM notifications").Scan(&title))
+	assert.Equal(t, "Alert: Window open in the cold", title)
+
+	// The rule is firing, so further matching readings extend the same episode, including
+	// for a fresh processor.
+	p = newProcessor(t, db, ws, nil)
+	require.NoError(t, p.ProcessReading(context.Background(), windowOpen))
+	assert.Equal(t, 1, countAlertHistory(t, db))
+	assert.Equal(t, alerting.AlertStateFiring, compoundRuleState(t, db, ruleID))
+}
+
+func TestProcessReading_compoundRule_resolvesPastHysteresis(t *testing.T) {
+	db := newTestDB(t)
+	windowID := insertSensor(t, db, "window")
+	outdoorID := insertSensor(t, db, "outdoor")
+	contactID := getMeasurementTypeID(t, db, "contact")
+	temperatureID := getMeasurementTypeID(t, db, "temperature")
+	ruleID := insertCompoundAlertRule(t, db, "Window open in the cold", alerting.CompoundOperatorAnd, 0,
+		alerting.AlertCondition{SensorID: windowID, MeasurementTypeId: contactID, Comparison: alerting.ComparisonEqual, Status: "false"},
+		alerting.AlertCondition{SensorID: outdoorID, MeasurementTypeId: temperatureID, Comparison: alerting.ComparisonLessThan, Threshold: 5},
+	)
+	_, err := db.Exec("UPDATE compound_alert_rules SET hysteresis = 1 WHERE id = ?", ruleID)
+	require.NoError(t, err)
+	open := "false"
+	insertReading(t, db, windowID, contactID, nil, &open)
+	insertUserWithRole(t, db, "alice", "alice@example.com", "admin")
+
+	p := newProcessor(t, db, nil, nil)
+	outdoor := func(value float64) alerting.ReadingAlert {
+		return alerting.ReadingAlert{SensorID: outdoorID, SensorName: "outdoor", MeasurementType: "temperature", NumericValue: value}
+	}
+
+	require.NoError(t, p.ProcessReading(context.Background(), outdoor(4)))
+	assert.Equal(t, alerting.AlertStateFiring, compoundRuleState(t, db, ruleID))
+
+	// Above the threshold but within the hysteresis: still firing.
+	require.NoError(t, p.ProcessReading(context.Background(), outdoor(5.5)))
+	assert.Equal(t, alerting.AlertStateFiring, compoundRuleState(t, db, ruleID))
+	assert.Equal(t, 1, countNotifications(t, db))
+
+	require.NoError(t, p.ProcessReading(context.Background(), outdoor(6.5)))
+	assert.Equal(t, alerting.AlertStateResolved, compoundRuleState(t, db, ruleID))
+	assert.Equal(t, 1, countAlertHistory(t, db))
+	assert.Equal(t, 2, countNotifications(t, db))
+
+	var resolved bool
+	require.NoError(t, db.QueryRow("SELECT resolved_at IS NOT NULL FROM alert_sent_history WHERE compound_rule_id = ?", ruleID).Scan(&resolved))
+	assert.True(t, resolved)
+	var title string
+	require.NoError(t, db.QueryRow("SELECT title FROM notifications ORDER BY id DESC LIMIT 1").Scan(&title))
+	assert.Equal(t, "Resolved: Window open in the cold", title)
+}
+
+func TestProcessReading_compoundRule_rateLimitedEpisodeIsTrackedSilently(t *testing.T) {
+	db := newTestDB(t)
+	bathID := insertSensor(t, db, "bath")
+	showerID := insertSensor(t, db, "shower")
+	humidityID := getMeasurementTypeID(t, db, "humidity")
+	ruleID := insertCompoundAlertRule(t, db, "Bathroom humidity", alerting.CompoundOperatorOr, 3600,
+		alerting.AlertCondition{SensorID: bathID, MeasurementTypeId: humidityID, Comparison: alerting.ComparisonGreaterThan, Threshold: 80},
+		alerting.AlertCondition{SensorID: showerID, MeasurementTypeId: humidityID, Comparison: alerting.ComparisonGreaterThan, Threshold: 80},
+	)
+	insertUserWithRole(t, db, "alice", "alice@example.com", "admin")
+
+	p := newProcessor(t, db, nil, nil)
+	bath := func(value float64) alerting.ReadingAlert {
+		return alerting.ReadingAlert{SensorID: bathID, SensorName: "bath", MeasurementType: "humidity", NumericValue: value}
+	}
+
+	require.NoError(t, p.ProcessReading(context.Background(), bath(90)))
+	require.NoError(t, p.ProcessReading(context.Background(), bath(60)))
+	assert.Equal(t, 2, countNotifications(t, db))
+
+	// The second episode starts within the rate limit: it is recorded but sends neither
+	// the alert nor the resolved notification.
+	require.NoError(t, p.ProcessReading(context.Background(), bath(90)))
+	assert.Equal(t, alerting.AlertStateFiring, compoundRuleState(t, db, ruleID))
+	require.NoError(t, p.ProcessReading(context.Background(), bath(60)))
+	assert.Equal(t, alerting.AlertStateResolved, compoundRuleState(t, db, ruleID))
+	assert.Equal(t, 2, countAlertHistory(t, db))
+	assert.Equal(t, 2, countNotifications(t, db))
+}
+
+func TestProcessReading_compoundOrRule_firesOnAnyCondition(t *testing.T) {
+	db := newTestDB(t)
+	bathID := insertSensor(t, db, "bath")
+	showerID := insertSensor(t, db, "shower")
+	humidityID := getMeasurementTypeID(t, db, "humidity")
+	insertCompoundAlertRule(t, db, "Bathroom humidity", alerting.CompoundOperatorOr, 0,
+		alerting.AlertCondition{SensorID: bathID, MeasurementTypeId: humidityID, Comparison: alerting.ComparisonGreaterThan, Threshold: 80},
+		alerting.AlertCondition{SensorID: showerID, MeasurementTypeId: humidityID, Comparison: alerting.ComparisonGreaterThan, Threshold: 80},
+	)
+
+	p := newProcessor(t, db, nil, nil)
+
+	require.NoError(t, p.ProcessReading(context.Background(), alerting.ReadingAlert{
+		SensorID: showerID, SensorName: "shower", MeasurementType: "humidity", NumericValue: 65,
+	}))
+	assert.Equal(t, 0, countAlertHistory(t, db))
+
+	require.NoError(t, p.ProcessReading(context.Background(), alerting.ReadingAlert{
+		SensorID: bathID, SensorName: "bath", MeasurementType: "humidity", NumericValue: 85,
+	}))
+	assert.Equal(t, 1, countAlertHistory(t, db))
+}
+
+func TestProcessReading_simpleAndCompoundRulesBothFire(t *testing.T) {
+	db := newTestDB(t)
+	bathID := insertSensor(t, db, "bath")
+	showerID := insertSensor(t, db, "shower")
+	humidityID := getMeasurementTypeID(t, db, "humidity")
+	insertNumericAlertRule(t, db, bathID, humidityID, 90, 10, true, 0)
+	insertCompoundAlertRule(t, db, "Bathroom humidity", alerting.CompoundOperatorOr, 0,
+		alerting.AlertCondition{SensorID: bathID, MeasurementTypeId: humidityID, Comparison: alerting.ComparisonGreaterThan, Threshold: 80},
+		alerting.AlertCondition{SensorID: showerID, MeasurementTypeId: humidityID, Comparison: alerting.ComparisonGreaterThan, Threshold: 80},
+	)
+
+	p := newProcessor(t, db, nil, nil)
+	require.NoError(t, p.ProcessReading(context.Background(), alerting.ReadingAlert{
+		SensorID: bathID, SensorName: "bath", MeasurementType: "humidity", NumericValue: 95,
+	}))
+
+	assert.Equal(t, 2, countAlertHistory(t, db))
+	assert.Equal(t, 2, countNotifications(t, db))
+}
+
+func TestProcessReading_compoundRule_snoozedAndAcknowledged(t *testing.T) {
+	db := newTestDB(t)
+	bathID := insertSensor(t, db, "bath")
+	showerID := insertSensor(t, db, "shower")
+	humidityID := getMeasurementTypeID(t, db, "humidity")
+	ruleID := insertCompoundAlertRule(t, db, "Bathroom humidity", alerting.CompoundOperatorOr, 0,
+		alerting.AlertCondition{SensorID: bathID, MeasurementTypeId: humidityID, Comparison: alerting.ComparisonGreaterThan, Threshold: 80},
+		alerting.AlertCondition{SensorID: showerID, MeasurementTypeId: humidityID, Comparison: alerting.ComparisonGreaterThan, Threshold: 80},
+	)
+	insertUserWithRole(t, db, "alice", "alice@example.com", "admin")
+
+	p := newProcessor(t, db, nil, nil)
+	bath := func(value float64) alerting.ReadingAlert {
+		return alerting.ReadingAlert{SensorID: bathID, SensorName: "bath", MeasurementType: "humidity", NumericValue: value}
+	}
+
+	// A snoozed rule keeps tracking state but sends nothing.
+	_, err := db.Exec("UPDATE compound_alert_rules SET snoozed_until = datetime('now', '+1 hour') WHERE id = ?", ruleID)
+	require.NoError(t, err)
+	require.NoError(t, p.ProcessReading(context.Background(), bath(90)))
+	assert.Equal(t, alerting.AlertStateFiring, compoundRuleState(t, db, ruleID))
+	assert.Equal(t, 0, countNotifications(t, db))
+	require.NoError(t, p.ProcessReading(context.Background(), bath(60)))
+	assert.Equal(t, 0, countNotifications(t, db))
+
+	// An acknowledged episode sends no resolved notification.
+	_, err = db.Exec("UPDATE compound_alert_rules SET snoozed_until = NULL WHERE id = ?", ruleID)
+	require.NoError(t, err)
+	require.NoError(t, p.ProcessReading(context.Background(), bath(90)))
+	assert.Equal(t, 1, countNotifications(t, db))
+	_, err = db.Exec("UPDATE alert_sent_history SET acknowledged_at = datetime('now') WHERE compound_rule_id = ? AND resolved_at IS NULL", ruleID)
+	require.NoError(t, err)
+	require.NoError(t, p.ProcessReading(context.Background(), bath(60)))
+	assert.Equal(t, alerting.AlertStateResolved, compoundRuleState(t, db, ruleID))
+	assert.Equal(t, 1, countNotifications(t, db))
+}
+
+func TestProcessReading_compoundRule_usesRecipientsAndEscalation(t *testing.T) {
+	db := newTestDB(t)
+	bathID := insertSensor(t, db, "bath")
+	humidityID := getMeasurementTypeID(t, db, "humidity")
+	ruleID := insertCompoundAlertRule(t, db, "Bathroom humidity", alerting.CompoundOperatorOr, 0,
+		alerting.AlertCondition{SensorID: bathID, MeasurementTypeId: humidityID, Comparison: alerting.ComparisonGreaterThan, Threshold: 80},
+	)
+	aliceID := insertUserWithRole(t, db, "alice", "alice@example.com", "admin")
+	bobID := insertUserWithRole(t, db, "bob", "bob@example.com", "user")
+	repo := newAlertRepo(db)
+	require.NoError(t, repo.SetCompoundAlertRecipients(context.Background(), ruleID, alerting.AlertRecipients{UserIDs: []int{bobID}}))
+	require.NoError(t, repo.SetCompoundEscalationSteps(context.Background(), ruleID, []alerting.EscalationStep{{DelayMinutes: 10, Role: "admin"}}))
+
+	p := newProcessor(t, db, nil, nil)
+	start := time.Now()
+	require.NoError(t, p.ProcessReading(context.Background(), alerting.ReadingAlert{
+		SensorID: bathID, SensorName: "bath", MeasurementType: "humidity", NumericValue: 85,
+	}))
+	assert.Equal(t, []int{bobID}, recipientsOfLatestNotification(t, db))
+	assert.Equal(t, 1, countEscalations(t, db))
+
+	require.NoError(t, p.ProcessEscalations(context.Background(), start.Add(11*time.Minute)))
+	assert.Equal(t, 2, countNotifications(t, db))
+	assert.Equal(t, []int{aliceID}, recipientsOfLatestNotification(t, db))
+	var title string
+	require.NoError(t, db.QueryRow("SELECT title FROM notifications ORDER BY id DESC LIMIT 1").Scan(&title))
+	assert.Equal(t, "Unacknowledged alert: Bathroom humidity", title)
+	assert.Equal(t, 0, countEscalations(t, db))
+
+	// The resolved notification goes to the rule's recipients too.
+	require.NoError(t, p.ProcessReading(context.Background(), alerting.ReadingAlert{
+		SensorID: bathID, SensorName: "bath", MeasurementType: "humidity", NumericValue: 60,
+	}))
+	assert.Equal(t, 3, countNotifications(t, db))
+	assert.Equal(t, []int{bobID}, recipientsOfLatestNotification(t, db))
+}
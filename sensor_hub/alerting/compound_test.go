@@ -0,0 +1,126 @@
+package alerting
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func floatPtr(v float64) *float64 { return &v }
+func stringPtr(v string) *string  { return &v }
+
+func windowOpenInTheCold() CompoundAlertRule {
+	return CompoundAlertRule{
+		Name:     "Window open in the cold",
+		Operator: CompoundOperatorAnd,
+		Enabled:  true,
+		Conditions: []AlertCondition{
+			{SensorID: 1, SensorName: "window", MeasurementTypeId: 3, MeasurementType: "contact", Comparison: ComparisonEqual, Status: "false"},
+			{SensorID: 2, SensorName: "outdoor", MeasurementTypeId: 1, MeasurementType: "temperature", Comparison: ComparisonLessThan, Threshold: 5},
+		},
+	}
+}
+
+func TestCompoundAlertRule_Validate(t *testing.T) {
+	rule := windowOpenInTheCold()
+	assert.NoError(t, rule.Validate())
+
+	tests := []struct {
+		name   string
+		mutate func(r *CompoundAlertRule)
+	}{
+		{"missing name", func(r *CompoundAlertRule) { r.Name = " " }},
+		{"invalid operator", func(r *CompoundAlertRule) { r.Operator = "xor" }},
+		{"negative rate limit", func(r *CompoundAlertRule) { r.RateLimitSeconds = -1 }},
+		{"negative hysteresis", func(r *CompoundAlertRule) { r.Hysteresis = -1 }},
+		{"single condition", func(r *CompoundAlertRule) { r.Conditions = r.Conditions[:1] }},
+		{"invalid comparison", func(r *CompoundAlertRule) { r.Conditions[1].Comparison = "~" }},
+		{"ordered status comparison", func(r *CompoundAlertRule) { r.Conditions[0].Comparison = ComparisonGreaterThan }},
+		{"missing sensor", func(r *CompoundAlertRule) { r.Conditions[0].SensorID = 0 }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule := windowOpenInTheCold()
+			tt.mutate(&rule)
+			assert.Error(t, rule.Validate())
+		})
+	}
+}
+
+func TestCompoundAlertRule_ShouldAlert_And(t *testing.T) {
+	rule := windowOpenInTheCold()
+	rule.Conditions[1].LatestNumericValue = floatPtr(3.2)
+
+	shouldAlert, reason := rule.ShouldAlert(ReadingAlert{SensorID: 1, MeasurementType: "contact", StatusValue: "false"})
+	assert.True(t, shouldAlert)
+	assert.Equal(t, "window contact is false AND outdoor temperature 3.20 < 5.00", reason)
+
+	shouldAlert, _ = rule.ShouldAlert(ReadingAlert{SensorID: 1, MeasurementType: "contact", StatusValue: "true"})
+	assert.False(t, shouldAlert)
+}
+
+func TestCompoundAlertRule_ShouldAlert_IncomingReadingOverridesLatest(t *testing.T) {
+	rule := windowOpenInTheCold()
+	rule.Conditions[0].LatestStatus = stringPtr("false")
+	rule.Conditions[1].LatestNumericValue = floatPtr(3.2)
+
+	shouldAlert, _ := rule.ShouldAlert(ReadingAlert{SensorID: 2, MeasurementType: "Temperature", NumericValue: 8})
+	assert.False(t, shouldAlert)
+}
+
+func TestCompoundAlertRule_ShouldAlert_MissingValueIsNotMet(t *testing.T) {
+	rule := windowOpenInTheCold()
+
+	shouldAlert, _ := rule.ShouldAlert(ReadingAlert{SensorID: 1, MeasurementType: "contact", StatusValue: "false"})
+	assert.False(t, shouldAlert)
+}
+
+func TestCompoundAlertRule_ShouldAlert_Or(t *testing.T) {
+	rule := CompoundAlertRule{
+		Name:     "Bathroom humidity",
+		Operator: CompoundOperatorOr,
+		Enabled:  true,
+		Conditions: []AlertCondition{
+			{SensorID: 1, SensorName: "bath", MeasurementType: "humidity", Comparison: ComparisonGreaterThan, Threshold: 80, LatestNumericValue: floatPtr(60)},
+			{SensorID: 2, SensorName: "shower", MeasurementType: "humidity", Comparison: ComparisonGreaterThan, Threshold: 80},
+		},
+	}
+
+	shouldAlert, reason := rule.ShouldAlert(ReadingAlert{SensorID: 2, MeasurementType: "humidity", NumericValue: 91})
+	assert.True(t, shouldAlert)
+	assert.Equal(t, "shower humidity 91.00 > 80.00", reason)
+
+	shouldAlert, _ = rule.ShouldAlert(ReadingAlert{SensorID: 2, MeasurementType: "humidity", NumericValue: 70})
+	assert.False(t, shouldAlert)
+
+	rule.Enabled = false
+	shouldAlert, _ = rule.ShouldAlert(ReadingAlert{SensorID: 2, MeasurementType: "humidity", NumericValue: 91})
+	assert.False(t, shouldAlert)
+}
+
+func TestCompoundAlertRule_ShouldResolve_Hysteresis(t *testing.T) {
+	rule := windowOpenInTheCold()
+	rule.Hysteresis = 1
+	rule.Conditions[0].LatestStatus = stringPtr("false")
+	outdoor := func(value float64) ReadingAlert {
+		return ReadingAlert{SensorID: 2, MeasurementType: "temperature", NumericValue: value}
+	}
+
+	assert.False(t, rule.ShouldResolve(outdoor(4)))
+	assert.False(t, rule.ShouldResolve(outdoor(5.5)), "within the hysteresis of the threshold")
+	assert.True(t, rule.ShouldResolve(outdoor(6.5)))
+	assert.Equal(t, "no longer met: outdoor temperature 6.50 < 5.00", rule.ResolvedReason(outdoor(6.5)))
+
+	// Status conditions have no margin.
+	assert.True(t, rule.ShouldResolve(ReadingAlert{SensorID: 1, MeasurementType: "contact", StatusValue: "true"}))
+}
+
+func TestCompoundAlertRule_IsRateLimited(t *testing.T) {
+	recent := time.Now().Add(-30 * time.Second)
+	rule := CompoundAlertRule{RateLimitSeconds: 60, LastAlertSentAt: &recent}
+	assert.True(t, rule.IsRateLimited())
+
+	rule.RateLimitSeconds = 0
+	assert.False(t, rule.IsRateLimited())
+}
@@ -0,0 +1,83 @@
+package alerting
+
+import (
+	"fmt"
+	"time"
+
+	"example/sensorHub/notifications"
+)
+
+// EscalationChannel is a delivery channel an escalation step can force.
+type EscalationChannel = notifications.Channel
+
+const (
+	EscalationChannelEmail   = notifications.ChannelEmail
+	EscalationChannelWebhook = notifications.ChannelWebhook
+	EscalationChannelPush    = notifications.ChannelPush
+)
+
+// MaxEscalationSteps bounds the length of a rule's escalation policy.
+const MaxEscalationSteps = 5
+
+// EscalationStep is one stage of a rule's escalation policy. If nobody has read, dismissed
+// or acknowledged the rule's firing notification DelayMinutes after the previous
+// notification, it is sent again as an error. Role narrows the recipients to the users
+// with that role, who must also have view_alerts; empty sends to everyone the alert went
+// to. Channels sends over those channels regardless of the recipients' channel
+// preferences; empty follows the preferences. In-app delivery always happens.
+type EscalationStep struct {
+	DelayMinutes int                 `json:"DelayMinutes"`
+	Role         string              `json:"Role"`
+	Channels     []EscalationChannel `json:"Channels"`
+}
+
+// Delay is how long after the previous notification the step is sent.
+func (s *EscalationStep) Delay() time.Duration {
+	return time.Duration(s.DelayMinutes) * time.Minute
+}
+
+// ValidateEscalationSteps checks a rule's escalation policy. An empty policy is valid and
+// turns escalation off.
+func ValidateEscalationSteps(steps []EscalationStep) error {
+	if len(steps) > MaxEscalationSteps {
+		return fmt.Errorf("an escalation policy has at most %d steps", MaxEscalationSteps)
+	}
+	for i, step := range steps {
+		if step.DelayMinutes <= 0 {
+			return fmt.Errorf("step %d: delay minutes must be positive", i+1)
+		}
+		seen := make(map[EscalationChannel]bool)
+		for _, channel := range step.Channels {
+			switch channel {
+			case EscalationChannelEmail, EscalationChannelWebhook, EscalationChannelPush:
+			default:
+				return fmt.Errorf("step %d: invalid channel %q: must be 'email', 'webhook' or 'push'", i+1, channel)
+			}
+			if seen[channel] {
+				return fmt.Errorf("step %d: channel %q is listed twice", i+1, channel)
+			}
+			seen[channel] = true
+		}
+	}
+	return nil
+}
+
+// Escalation is a rule's firing notification working through the rule's escalation
+// policy.
+type Escalation struct {
+	ID     int
+	RuleID int
+	// Compound is set when RuleID names a compound rule.
+	Compound   bool
+	SensorID   int
+	SensorName string
+	// Message is the message of the notification that started the escalation.
+	Message string
+	// NextStep is the index of the policy step that is due.
+	NextStep     int
+	StartedAt    time.Time
+	SnoozedUntil *time.Time
+	// Handled is set once the rule's episode has been acknowledged or has ended, or one of
+	// the escalation's notifications has been read or dismissed.
+	Handled bool
+}
@@ -0,0 +1,168 @@
+package alerting_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"example/sensorHub/alerting"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setEscalationSteps(t *testing.T, db *sql.DB, ruleID int, steps ...alerting.EscalationStep) {
+	t.Helper()
+	require.NoError(t, newAlertRepo(db).SetEscalationSteps(context.Background(), ruleID, steps))
+}
+
+func grantPermission(t *testing.T, db *sql.DB, role, permission string) {
+	t.Helper()
+	_, err := db.Exec(`
+		INSERT OR IGNORE INTO role_permissions (role_id, permission_id)
+		SELECT r.id, p.id FROM roles r, permissions p WHERE r.name = ? AND p.name = ?`, role, permission)
+	require.NoError(t, err)
+}
+
+func countEscalations(t *testing.T, db *sql.DB) int {
+	t.Helper()
+	var n int
+	require.NoError(t, db.QueryRow("SELECT COUNT(*) FROM alert_escalations").Scan(&n))
+	return n
+}
+
+func recipientsOfLatestNotification(t *testing.T, db *sql.DB) []int {
+	t.Helper()
+	rows, err := db.Query(`
+		SELECT user_id FROM user_notifications
+		WHERE notification_id = (SELECT MAX(id) FROM notifications)
+		ORDER BY user_id`)
+	require.NoError(t, err)
+	defer rows.Close()
+	var userIDs []int
+	for rows.Next() {
+		var id int
+		require.NoError(t, rows.Scan(&id))
+		userIDs = append(userIDs, id)
+	}
+	return userIDs
+}
+
+func TestProcessEscalations_unhandledAlert_runsPolicySteps(t *testing.T) {
+	db := newTestDB(t)
+	sensorID := insertSensor(t, db, "freezer")
+	mtID := getMeasurementTypeID(t, db, "temperature")
+	ruleID := insertNumericAlertRule(t, db, sensorID, mtID, -10.0, -30.0, true, 0)
+	grantPermission(t, db, "user", "view_alerts")
+	adminID := insertUserWithRole(t, db, "alice", "alice@example.com", "admin")
+	userID := insertUserWithRole(t, db, "bob", "bob@example.com", "user")
+	setEscalationSteps(t, db, ruleID,
+		alerting.EscalationStep{DelayMinutes: 10},
+		alerting.EscalationStep{DelayMinutes: 20, Role: "admin", Channels: []alerting.EscalationChannel{alerting.EscalationChannelEmail}},
+	)
+	setEmailPreference(t, db, adminID, "threshold_alert", false)
+	setEmailPreference(t, db, userID, "threshold_alert", false)
+
+	email := &recordingEmail{}
+	p := newProcessor(t, db, nil, email)
+	start := time.Now()
+	processTemperature(t, p, sensorID, -2.0)
+	require.Equal(t, 1, countNotifications(t, db))
+
+	require.NoError(t, p.ProcessEscalations(context.Background(), start.Add(5*time.Minute)))
+	assert.Equal(t, 1, countNotifications(t, db))
+
+	require.NoError(t, p.ProcessEscalations(context.Background(), start.Add(11*time.Minute)))
+	assert.Equal(t, 2, countNotifications(t, db))
+	assert.Equal(t, []int{adminID, userID}, recipientsOfLatestNotification(t, db))
+	var title, severity string
+	require.NoError(t, db.QueryRow("SELECT title, severity FROM notifications ORDER BY id DESC LIMIT 1").Scan(&title, &severity))
+	assert.Equal(t, "Unacknowledged alert: freezer", title)
+	assert.Equal(t, "error", severity)
+
+	// The second step is due 20 minutes after the first, not after the alert.
+	require.NoError(t, p.ProcessEscalations(context.Background(), start.Add(25*time.Minute)))
+	assert.Equal(t, 2, countNotifications(t, db))
+
+	require.NoError(t, p.ProcessEscalations(context.Background(), start.Add(32*time.Minute)))
+	assert.Equal(t, 3, countNotifications(t, db))
+	assert.Equal(t, []int{adminID}, recipientsOfLatestNotification(t, db))
+	// The step's email channel overrides the admin's preference.
+	require.Eventually(t, func() bool { return email.sendCount() == 1 }, time.Second, 10*time.Millisecond)
+	assert.Equal(t, "alice@example.com", email.calls[0].recipient)
+	assert.Equal(t, 0, countEscalations(t, db))
+}
+
+func TestProcessEscalations_readNotification_endsEscalation(t *testing.T) {
+	db := newTestDB(t)
+	sensorID := insertSensor(t, db, "freezer")
+	mtID := getMeasurementTypeID(t, db, "temperature")
+	ruleID := insertNumericAlertRule(t, db, sensorID, mtID, -10.0, -30.0, true, 0)
+	insertUserWithRole(t, db, "alice", "alice@example.com", "admin")
+	setEscalationSteps(t, db, ruleID, alerting.EscalationStep{DelayMinutes: 10})
+
+	p := newProcessor(t, db, nil, nil)
+	start := time.Now()
+	processTemperature(t, p, sensorID, -2.0)
+	_, err := db.Exec("UPDATE user_notifications SET is_read = 1")
+	require.NoError(t, err)
+
+	require.NoError(t, p.ProcessEscalations(context.Background(), start.Add(11*time.Minute)))
+
+	assert.Equal(t, 1, countNotifications(t, db))
+	assert.Equal(t, 0, countEscalations(t, db))
+}
+
+func TestProcessEscalations_acknowledgedAlert_endsEscalation(t *testing.T) {
+	db := newTestDB(t)
+	sensorID := insertSensor(t, db, "freezer")
+	mtID := getMeasurementTypeID(t, db, "temperature")
+	ruleID := insertNumericAlertRule(t, db, sensorID, mtID, -10.0, -30.0, true, 0)
+	adminID := insertUserWithRole(t, db, "alice", "alice@example.com", "admin")
+	setEscalationSteps(t, db, ruleID, alerting.EscalationStep{DelayMinutes: 10})
+
+	p := newProcessor(t, db, nil, nil)
+	start := time.Now()
+	processTemperature(t, p, sensorID, -2.0)
+	_, err := newAlertRepo(db).AcknowledgeAlertRule(context.Background(), ruleID, adminID)
+	require.NoError(t, err)
+
+	require.NoError(t, p.ProcessEscalations(context.Background(), start.Add(11*time.Minute)))
+
+	assert.Equal(t, 1, countNotifications(t, db))
+	assert.Equal(t, 0, countEscalations(t, db))
+}
+
+func TestProcessEscalations_resolvedAlert_endsEscalation(t *testing.T) {
+	db := newTestDB(t)
+	sensorID := insertSensor(t, db, "freezer")
+	mtID := getMeasurementTypeID(t, db, "temperature")
+	ruleID := insertNumericAlertRule(t, db, sensorID, mtID, -10.0, -30.0, true, 0)
+	insertUserWithRole(t, db, "alice", "alice@example.com", "admin")
+	setEscalationSteps(t, db, ruleID, alerting.EscalationStep{DelayMinutes: 10})
+
+	p := newProcessor(t, db, nil, nil)
+	start := time.Now()
+	processTemperature(t, p, sensorID, -2.0)
+	processTemperature(t, p, sensorID, -20.0)
+	require.Equal(t, 2, countNotifications(t, db))
+
+	require.NoError(t, p.ProcessEscalations(context.Background(), start.Add(11*time.Minute)))
+
+	assert.Equal(t, 2, countNotifications(t, db))
+	assert.Equal(t, 0, countEscalations(t, db))
+}
+
+func TestProcessReading_ruleWithoutEscalationPolicy_startsNoEscalation(t *testing.T) {
+	db := newTestDB(t)
+	sensorID := insertSensor(t, db, "freezer")
+	mtID := getMeasurementTypeID(t, db, "temperature")
+	insertNumericAlertRule(t, db, sensorID, mtID, -10.0, -30.0, true, 0)
+
+	p := newProcessor(t, db, nil, nil)
+	processTemperature(t, p, sensorID, -2.0)
+
+	assert.Equal(t, 1, countNotifications(t, db))
+	assert.Equal(t, 0, countEscalations(t, db))
+}
@@ -0,0 +1,105 @@
+package alerting_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"example/sensorHub/alerting"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func insertLookbackAlertRule(t *testing.T, db *sql.DB, sensorID, mtID int, alertType alerting.AlertType, high, low, change float64, durationSecs int) int {
+	t.Helper()
+	res, err := db.ExecContext(context.Background(),
+		`INSERT INTO sensor_alert_rules (sensor_id, measurement_type_id, alert_type, high_threshold, low_threshold, trigger_status, enabled, rate_limit_seconds, duration_seconds, change_threshold)
+		 VALUES (?, ?, ?, ?, ?, '', 1, 0, ?, ?)`,
+		sensorID, mtID, alertType, high, low, durationSecs, change)
+	require.NoError(t, err)
+	id, _ := res.LastInsertId()
+	return int(id)
+}
+
+func insertReadingAt(t *testing.T, db *sql.DB, sensorID, mtID int, value float64, at time.Time) {
+	t.Helper()
+	_, err := db.ExecContext(context.Background(),
+		"INSERT INTO readings (sensor_id, measurement_type_id, numeric_value, time) VALUES (?, ?, ?, ?)",
+		sensorID, mtID, value, at.UTC().Format("2006-01-02 15:04:05"))
+	require.NoError(t, err)
+}
+
+func TestProcessReading_sustainedRange_ignoresSingleSpike(t *testing.T) {
+	db := newTestDB(t)
+	sensorID := insertSensor(t, db, "office")
+	mtID := getMeasurementTypeID(t, db, "temperature")
+	insertLookbackAlertRule(t, db, sensorID, mtID, alerting.AlertTypeSustainedRange, 30.0, 10.0, 0, 600)
+
+	now := time.Now()
+	insertReadingAt(t, db, sensorID, mtID, 24.0, now.Add(-15*time.Minute))
+	insertReadingAt(t, db, sensorID, mtID, 24.5, now.Add(-5*time.Minute))
+	insertReadingAt(t, db, sensorID, mtID, 30.1, now)
+
+	p := newProcessor(t, db, nil, nil)
+	err := p.ProcessReading(context.Background(), alerting.ReadingAlert{
+		SensorID:        sensorID,
+		SensorName:      "office",
+		MeasurementType: "temperature",
+		NumericValue:    30.1,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 0, countAlertHistory(t, db))
+}
+
+func TestProcessReading_sustainedRange_firesAfterDuration(t *testing.T) {
+	db := newTestDB(t)
+	sensorID := insertSensor(t, db, "office")
+	mtID := getMeasurementTypeID(t, db, "temperature")
+	insertLookbackAlertRule(t, db, sensorID, mtID, alerting.AlertTypeSustainedRange, 30.0, 10.0, 0, 600)
+
+	now := time.Now()
+	insertReadingAt(t, db, sensorID, mtID, 30.5, now.Add(-15*time.Minute))
+	insertReadingAt(t, db, sensorID, mtID, 31.0, now.Add(-5*time.Minute))
+	insertReadingAt(t, db, sensorID, mtID, 31.2, now)
+
+	p := newProcessor(t, db, nil, nil)
+	err := p.ProcessReading(context.Background(), alerting.ReadingAlert{
+		SensorID:        sensorID,
+		SensorName:      "office",
+		MeasurementType: "temperature",
+		NumericValue:    31.2,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, countAlertHistory(t, db))
+}
+
+func TestProcessReading_rateOfChange_firesAlongsideRangeRule(t *testing.T) {
+	db := newTestDB(t)
+	sensorID := insertSensor(t, db, "freezer")
+	mtID := getMeasurementTypeID(t, db, "temperature")
+	insertNumericAlertRule(t, db, sensorID, mtID, -10.0, -30.0, true, 0)
+	insertLookbackAlertRule(t, db, sensorID, mtID, alerting.AlertTypeRateOfChange, 0, 0, 3.0, 900)
+
+	now := time.Now()
+	insertReadingAt(t, db, sensorID, mtID, -18.0, now.Add(-10*time.Minute))
+	insertReadingAt(t, db, sensorID, mtID, -14.0, now)
+
+	p := newProcessor(t, db, nil, nil)
+	err := p.ProcessReading(context.Background(), alerting.ReadingAlert{
+		SensorID:        sensorID,
+		SensorName:      "freezer",
+		MeasurementType: "temperature",
+		NumericValue:    -14.0,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, countAlertHistory(t, db), "only the rate-of-change rule is breached")
+
+	var reason string
+	require.NoError(t, db.QueryRow("SELECT alert_reason FROM alert_sent_history").Scan(&reason))
+	assert.Contains(t, reason, "rose by 4.00")
+}
@@ -0,0 +1,69 @@
+package alerting
+
+import (
+	"fmt"
+	"strings"
+)
+
+// AlertRecipients are the users an alert rule's notifications go to: the listed users,
+// every user with one of the listed roles and every member of the listed contact groups.
+// A rule with no recipients notifies every user with view_alerts.
+type AlertRecipients struct {
+	UserIDs         []int    `json:"UserIDs"`
+	Roles           []string `json:"Roles"`
+	ContactGroupIDs []int    `json:"ContactGroupIDs"`
+}
+
+// IsEmpty reports whether the rule falls back to notifying every user with view_alerts.
+func (r *AlertRecipients) IsEmpty() bool {
+	return len(r.UserIDs) == 0 && len(r.Roles) == 0 && len(r.ContactGroupIDs) == 0
+}
+
+func (r *AlertRecipients) Validate() error {
+	if err := validateIDs("user", r.UserIDs); err != nil {
+		return err
+	}
+	if err := validateIDs("contact group", r.ContactGroupIDs); err != nil {
+		return err
+	}
+	seen := make(map[string]bool)
+	for _, role := range r.Roles {
+		if strings.TrimSpace(role) == "" {
+			return fmt.Errorf("role names cannot be empty")
+		}
+		if seen[role] {
+			return fmt.Errorf("role %q is listed twice", role)
+		}
+		seen[role] = true
+	}
+	return nil
+}
+
+// ContactGroup is a named set of users that alert rules can notify together, such as
+// whoever is on duty this week.
+type ContactGroup struct {
+	ID      int    `json:"ID"`
+	Name    string `json:"Name"`
+	UserIDs []int  `json:"UserIDs"`
+}
+
+func (g *ContactGroup) Validate() error {
+	if strings.TrimSpace(g.Name) == "" {
+		return fmt.Errorf("name is required")
+	}
+	return validateIDs("user", g.UserIDs)
+}
+
+func validateIDs(kind string, ids []int) error {
+	seen := make(map[int]bool)
+	for _, id := range ids {
+		if id <= 0 {
+			return fmt.Errorf("%s ID must be a positive integer", kind)
+		}
+		if seen[id] {
+			return fmt.Errorf("%s %d is listed twice", kind, id)
+		}
+		seen[id] = true
+	}
+	return nil
+}
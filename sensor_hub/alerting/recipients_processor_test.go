@@ -0,0 +1,108 @@
+package alerting_test
+
+import (
+	"context"
+	"database/sql"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"example/sensorHub/alerting"
+	database "example/sensorHub/db"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newAlertRepo(db *sql.DB) database.AlertRepository {
+	return database.NewAlertRepository(db, slog.New(slog.NewTextHandler(io.Discard, nil)))
+}
+
+func setAlertRecipients(t *testing.T, db *sql.DB, ruleID int, recipients alerting.AlertRecipients) {
+	t.Helper()
+	require.NoError(t, newAlertRepo(db).SetAlertRecipients(context.Background(), ruleID, recipients))
+}
+
+func TestProcessReading_userRecipient_notifiesOnlyThatUser(t *testing.T) {
+	db := newTestDB(t)
+	sensorID := insertSensor(t, db, "greenhouse")
+	mtID := getMeasurementTypeID(t, db, "temperature")
+	ruleID := insertNumericAlertRule(t, db, sensorID, mtID, 30.0, 0.0, true, 0)
+	insertUserWithRole(t, db, "alice", "alice@example.com", "admin")
+	// bob has no view_alerts: naming him as a recipient is enough.
+	bobID := insertUserWithRole(t, db, "bob", "bob@example.com", "user")
+	setAlertRecipients(t, db, ruleID, alerting.AlertRecipients{UserIDs: []int{bobID}})
+
+	ws := &recordingWS{}
+	email := &recordingEmail{}
+	p := newProcessor(t, db, ws, email)
+	processTemperature(t, p, sensorID, 35.0)
+
+	assert.Equal(t, []int{bobID}, recipientsOfLatestNotification(t, db))
+	require.Equal(t, 1, ws.broadcastCount())
+	assert.Equal(t, bobID, ws.calls[0].userID)
+	require.Eventually(t, func() bool { return email.sendCount() == 1 }, time.Second, 10*time.Millisecond)
+	email.mu.Lock()
+	assert.Equal(t, "bob@example.com", email.calls[0].recipient)
+	email.mu.Unlock()
+}
+
+func TestProcessReading_contactGroupAndRoleRecipients_notifyMembers(t *testing.T) {
+	db := newTestDB(t)
+	sensorID := insertSensor(t, db, "kitchen freezer")
+	mtID := getMeasurementTypeID(t, db, "temperature")
+	ruleID := insertNumericAlertRule(t, db, sensorID, mtID, -10.0, -30.0, true, 0)
+	aliceID := insertUserWithRole(t, db, "alice", "alice@example.com", "admin")
+	bobID := insertUserWithRole(t, db, "bob", "bob@example.com", "user")
+	insertUserWithRole(t, db, "carol", "carol@example.com", "user")
+	insertUserWithRole(t, db, "dave", "dave@example.com", "viewer")
+
+	group := alerting.ContactGroup{Name: "Freezer on-call", UserIDs: []int{bobID}}
+	require.NoError(t, newAlertRepo(db).CreateContactGroup(context.Background(), &group))
+	setAlertRecipients(t, db, ruleID, alerting.AlertRecipients{Roles: []string{"admin"}, ContactGroupIDs: []int{group.ID}})
+
+	p := newProcessor(t, db, nil, nil)
+	processTemperature(t, p, sensorID, -2.0)
+	assert.Equal(t, []int{aliceID, bobID}, recipientsOfLatestNotification(t, db))
+
+	// The resolved notification goes to the same recipients.
+	processTemperature(t, p, sensorID, -20.0)
+	assert.Equal(t, 2, countNotifications(t, db))
+	assert.Equal(t, []int{aliceID, bobID}, recipientsOfLatestNotification(t, db))
+}
+
+func TestProcessReading_noRecipients_notifiesUsersWithViewAlerts(t *testing.T) {
+	db := newTestDB(t)
+	sensorID := insertSensor(t, db, "garage")
+	mtID := getMeasurementTypeID(t, db, "temperature")
+	ruleID := insertNumericAlertRule(t, db, sensorID, mtID, 30.0, 0.0, true, 0)
+	aliceID := insertUserWithRole(t, db, "alice", "alice@example.com", "admin")
+	bobID := insertUserWithRole(t, db, "bob", "bob@example.com", "user")
+	setAlertRecipients(t, db, ruleID, alerting.AlertRecipients{UserIDs: []int{bobID}})
+	setAlertRecipients(t, db, ruleID, alerting.AlertRecipients{})
+
+	p := newProcessor(t, db, nil, nil)
+	processTemperature(t, p, sensorID, 35.0)
+
+	assert.Equal(t, []int{aliceID}, recipientsOfLatestNotification(t, db))
+}
+
+func TestProcessEscalations_stepWithoutRole_goesToRuleRecipients(t *testing.T) {
+	db := newTestDB(t)
+	sensorID := insertSensor(t, db, "freezer")
+	mtID := getMeasurementTypeID(t, db, "temperature")
+	ruleID := insertNumericAlertRule(t, db, sensorID, mtID, -10.0, -30.0, true, 0)
+	insertUserWithRole(t, db, "alice", "alice@example.com", "admin")
+	bobID := insertUserWithRole(t, db, "bob", "bob@example.com", "user")
+	setAlertRecipients(t, db, ruleID, alerting.AlertRecipients{UserIDs: []int{bobID}})
+	setEscalationSteps(t, db, ruleID, alerting.EscalationStep{DelayMinutes: 10})
+
+	p := newProcessor(t, db, nil, nil)
+	start := time.Now()
+	processTemperature(t, p, sensorID, -2.0)
+	require.NoError(t, p.ProcessEscalations(context.Background(), start.Add(11*time.Minute)))
+
+	assert.Equal(t, 2, countNotifications(t, db))
+	assert.Equal(t, []int{bobID}, recipientsOfLatestNotification(t, db))
+}
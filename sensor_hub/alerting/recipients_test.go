@@ -0,0 +1,36 @@
+package alerting
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAlertRecipients_Validate(t *testing.T) {
+	r := AlertRecipients{UserIDs: []int{1, 2}, Roles: []string{"admin"}, ContactGroupIDs: []int{3}}
+	assert.NoError(t, r.Validate())
+	assert.False(t, r.IsEmpty())
+	assert.True(t, (&AlertRecipients{}).IsEmpty())
+
+	r.UserIDs = []int{1, 1}
+	assert.ErrorContains(t, r.Validate(), "user 1 is listed twice")
+
+	r.UserIDs = nil
+	r.ContactGroupIDs = []int{0}
+	assert.ErrorContains(t, r.Validate(), "contact group ID must be a positive integer")
+
+	r.ContactGroupIDs = nil
+	r.Roles = []string{" "}
+	assert.ErrorContains(t, r.Validate(), "role names cannot be empty")
+}
+
+func TestContactGroup_Validate(t *testing.T) {
+	g := ContactGroup{Name: "Freezer on-call", UserIDs: []int{2, 5}}
+	assert.NoError(t, g.Validate())
+
+	g.UserIDs = []int{-1}
+	assert.ErrorContains(t, g.Validate(), "user ID must be a positive integer")
+
+	g.Name = ""
+	assert.ErrorContains(t, g.Validate(), "name is required")
+}
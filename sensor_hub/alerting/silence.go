@@ -0,0 +1,90 @@
+package alerting
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"example/sensorHub/scheduling"
+)
+
+// SilenceWindow suppresses alert notifications during a recurring daily period, either
+// for one sensor or, when SensorID is nil, for every sensor. StartTime and EndTime are
+// "HH:MM" in the hub's local time; a window whose end is not after its start runs past
+// midnight into the next day. Days lists the days the window starts on ("mon".."sun");
+// an empty list means every day.
+type SilenceWindow struct {
+	ID         int      `json:"ID"`
+	Name       string   `json:"Name"`
+	SensorID   *int     `json:"SensorID"`
+	SensorName string   `json:"SensorName"`
+	Days       []string `json:"Days"`
+	StartTime  string   `json:"StartTime"`
+	EndTime    string   `json:"EndTime"`
+	Enabled    bool     `json:"Enabled"`
+}
+
+func (w *SilenceWindow) Validate() error {
+	if strings.TrimSpace(w.Name) == "" {
+		return fmt.Errorf("name is required")
+	}
+	if w.SensorID != nil && *w.SensorID <= 0 {
+		return fmt.Errorf("sensor ID must be a positive integer")
+	}
+	for _, day := range w.Days {
+		if _, ok := scheduling.ParseWeekday(day); !ok {
+			return fmt.Errorf("invalid day %q: must be one of mon, tue, wed, thu, fri, sat, sun", day)
+		}
+	}
+	start, err := scheduling.ParseClock(w.StartTime)
+	if err != nil {
+		return fmt.Errorf("invalid start time: %w", err)
+	}
+	end, err := scheduling.ParseClock(w.EndTime)
+	if err != nil {
+		return fmt.Errorf("invalid end time: %w", err)
+	}
+	if start == end {
+		return fmt.Errorf("start and end time must differ")
+	}
+	return nil
+}
+
+// Covers reports whether the window is silencing alerts at t. The caller passes t in
+// the zone the window's times are expressed in.
+func (w *SilenceWindow) Covers(t time.Time) bool {
+	if !w.Enabled {
+		return false
+	}
+	start, err := scheduling.ParseClock(w.StartTime)
+	if err != nil {
+		return false
+	}
+	end, err := scheduling.ParseClock(w.EndTime)
+	if err != nil {
+		return false
+	}
+
+	minute := t.Hour()*60 + t.Minute()
+	if start < end {
+		return w.activeOn(t.Weekday()) && minute >= start && minute < end
+	}
+	// The window runs past midnight: the late part belongs to today's window and the
+	// early part to yesterday's.
+	if minute >= start {
+		return w.activeOn(t.Weekday())
+	}
+	return minute < end && w.activeOn(t.AddDate(0, 0, -1).Weekday())
+}
+
+func (w *SilenceWindow) activeOn(day time.Weekday) bool {
+	if len(w.Days) == 0 {
+		return true
+	}
+	for _, name := range w.Days {
+		if weekday, ok := scheduling.ParseWeekday(name); ok && weekday == day {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,103 @@
+package alerting_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"example/sensorHub/alerting"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// insertSilenceWindowAroundNow adds a window covering the current local time.
+func insertSilenceWindowAroundNow(t *testing.T, db *sql.DB, sensorID *int) {
+	t.Helper()
+	now := time.Now()
+	_, err := db.ExecContext(context.Background(),
+		`INSERT INTO alert_silence_windows (name, sensor_id, start_time, end_time) VALUES ('test', ?, ?, ?)`,
+		sensorID, now.Add(-time.Hour).Format("15:04"), now.Add(time.Hour).Format("15:04"))
+	require.NoError(t, err)
+}
+
+func TestProcessReading_snoozedRule_recordsHistoryWithoutNotifying(t *testing.T) {
+	db := newTestDB(t)
+	sensorID := insertSensor(t, db, "garage")
+	mtID := getMeasurementTypeID(t, db, "temperature")
+	ruleID := insertNumericAlertRule(t, db, sensorID, mtID, 30.0, 0.0, true, 0)
+	_, err := db.Exec("UPDATE sensor_alert_rules SET snoozed_until = ? WHERE id = ?",
+		time.Now().UTC().Add(time.Hour).Format("2006-01-02 15:04:05"), ruleID)
+	require.NoError(t, err)
+
+	p := newProcessor(t, db, nil, nil)
+	processTemperature(t, p, sensorID, -2.0)
+
+	assert.Equal(t, alerting.AlertStateFiring, alertRuleState(t, db, ruleID))
+	assert.Equal(t, 1, countAlertHistory(t, db))
+	assert.Equal(t, 0, countNotifications(t, db))
+}
+
+func TestProcessReading_expiredSnooze_notifies(t *testing.T) {
+	db := newTestDB(t)
+	sensorID := insertSensor(t, db, "garage")
+	mtID := getMeasurementTypeID(t, db, "temperature")
+	ruleID := insertNumericAlertRule(t, db, sensorID, mtID, 30.0, 0.0, true, 0)
+	_, err := db.Exec("UPDATE sensor_alert_rules SET snoozed_until = ? WHERE id = ?",
+		time.Now().UTC().Add(-time.Minute).Format("2006-01-02 15:04:05"), ruleID)
+	require.NoError(t, err)
+
+	p := newProcessor(t, db, nil, nil)
+	processTemperature(t, p, sensorID, -2.0)
+
+	assert.Equal(t, 1, countNotifications(t, db))
+}
+
+func TestProcessReading_silenceWindow_suppressesSensorNotifications(t *testing.T) {
+	db := newTestDB(t)
+	garageID := insertSensor(t, db, "garage")
+	officeID := insertSensor(t, db, "office")
+	mtID := getMeasurementTypeID(t, db, "temperature")
+	insertNumericAlertRule(t, db, garageID, mtID, 30.0, 0.0, true, 0)
+	insertNumericAlertRule(t, db, officeID, mtID, 30.0, 0.0, true, 0)
+	insertSilenceWindowAroundNow(t, db, &garageID)
+
+	p := newProcessor(t, db, nil, nil)
+	processTemperature(t, p, garageID, -2.0)
+	assert.Equal(t, 0, countNotifications(t, db))
+
+	processTemperature(t, p, officeID, -2.0)
+	assert.Equal(t, 1, countNotifications(t, db), "the window only covers the garage sensor")
+	assert.Equal(t, 2, countAlertHistory(t, db))
+}
+
+func TestProcessReading_globalSilenceWindow_suppressesEverySensor(t *testing.T) {
+	db := newTestDB(t)
+	sensorID := insertSensor(t, db, "garage")
+	mtID := getMeasurementTypeID(t, db, "temperature")
+	insertNumericAlertRule(t, db, sensorID, mtID, 30.0, 0.0, true, 0)
+	insertSilenceWindowAroundNow(t, db, nil)
+
+	p := newProcessor(t, db, nil, nil)
+	processTemperature(t, p, sensorID, -2.0)
+
+	assert.Equal(t, 0, countNotifications(t, db))
+}
+
+func TestProcessReading_acknowledgedEpisode_resolvesQuietly(t *testing.T) {
+	db := newTestDB(t)
+	sensorID := insertSensor(t, db, "garage")
+	mtID := getMeasurementTypeID(t, db, "temperature")
+	ruleID := insertNumericAlertRule(t, db, sensorID, mtID, 30.0, 0.0, true, 0)
+
+	p := newProcessor(t, db, nil, nil)
+	processTemperature(t, p, sensorID, -2.0)
+	_, err := db.Exec("UPDATE alert_sent_history SET acknowledged_at = datetime('now') WHERE alert_rule_id = ?", ruleID)
+	require.NoError(t, err)
+
+	processTemperature(t, p, sensorID, 5.0)
+
+	assert.Equal(t, alerting.AlertStateResolved, alertRuleState(t, db, ruleID))
+	assert.Equal(t, 1, countNotifications(t, db), "only the firing notification is sent")
+}
@@ -0,0 +1,49 @@
+package alerting
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSilenceWindow_Validate(t *testing.T) {
+	w := SilenceWindow{Name: "mornings", Days: []string{"mon"}, StartTime: "07:00", EndTime: "09:00", Enabled: true}
+	assert.NoError(t, w.Validate())
+
+	w.Days = []string{"monday"}
+	assert.ErrorContains(t, w.Validate(), "invalid day")
+
+	w.Days = nil
+	w.EndTime = "07:00"
+	assert.ErrorContains(t, w.Validate(), "must differ")
+
+	w.EndTime = "25:00"
+	assert.ErrorContains(t, w.Validate(), "must be HH:MM")
+}
+
+func TestSilenceWindow_Covers_WeekdayMornings(t *testing.T) {
+	w := SilenceWindow{Days: []string{"mon", "tue", "wed", "thu", "fri"}, StartTime: "07:00", EndTime: "09:00", Enabled: true}
+	monday := time.Date(2025, 6, 2, 0, 0, 0, 0, time.UTC)
+
+	assert.True(t, w.Covers(monday.Add(7*time.Hour)))
+	assert.True(t, w.Covers(monday.Add(8*time.Hour+59*time.Minute)))
+	assert.False(t, w.Covers(monday.Add(9*time.Hour)))
+	assert.False(t, w.Covers(monday.Add(6*time.Hour+59*time.Minute)))
+	assert.False(t, w.Covers(monday.AddDate(0, 0, 5).Add(8*time.Hour)), "saturday is not listed")
+}
+
+func TestSilenceWindow_Covers_Overnight(t *testing.T) {
+	w := SilenceWindow{Days: []string{"fri"}, StartTime: "22:00", EndTime: "06:00", Enabled: true}
+	friday := time.Date(2025, 6, 6, 0, 0, 0, 0, time.UTC)
+
+	assert.True(t, w.Covers(friday.Add(23*time.Hour)))
+	assert.True(t, w.Covers(friday.AddDate(0, 0, 1).Add(5*time.Hour)), "saturday morning belongs to friday's window")
+	assert.False(t, w.Covers(friday.Add(5*time.Hour)), "friday morning belongs to thursday's window")
+}
+
+func TestSilenceWindow_Covers_Disabled(t *testing.T) {
+	w := SilenceWindow{StartTime: "00:00", EndTime: "23:59", Enabled: false}
+
+	assert.False(t, w.Covers(time.Date(2025, 6, 2, 12, 0, 0, 0, time.UTC)))
+}
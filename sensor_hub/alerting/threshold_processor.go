@@ -2,21 +2,54 @@ package alerting
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
+	"maps"
+	"slices"
 	"sync"
 	"time"
 
 	"example/sensorHub/notifications"
+	"example/sensorHub/periodic"
 )
 
 // AlertRepository is the subset of db.AlertRepository needed by ThresholdAlertProcessor.
 // Defined locally to avoid an import cycle (db imports alerting).
 type AlertRepository interface {
-	GetAlertRuleForReading(ctx context.Context, sensorID int, measurementTypeName string) (*AlertRule, error)
+	GetAlertRulesForReading(ctx context.Context, sensorID int, measurementTypeName string) ([]AlertRule, error)
 	RecordAlertSent(ctx context.Context, ruleID, sensorID, measurementTypeId int, reason string, numericValue float64, statusValue string) error
+	MarkAlertFiring(ctx context.Context, ruleID int) (bool, error)
+	UpdateAlertPeak(ctx context.Context, ruleID int, value float64, higher bool) error
+	MarkAlertRateLimited(ctx context.Context, ruleID int) error
+	ResolveAlert(ctx context.Context, ruleID int) (*AlertEpisode, error)
+	GetCompoundAlertRulesForReading(ctx context.Context, sensorID int, measurementTypeName string) ([]CompoundAlertRule, error)
+	RecordCompoundAlertSent(ctx context.Context, ruleID, sensorID, measurementTypeId int, reason string, numericValue float64, statusValue string) error
+	MarkCompoundAlertFiring(ctx context.Context, ruleID int) (bool, error)
+	MarkCompoundAlertRateLimited(ctx context.Context, ruleID int) error
+	ResolveCompoundAlert(ctx context.Context, ruleID int) (*AlertEpisode, error)
+	GetSilenceWindowsForSensor(ctx context.Context, sensorID int) ([]SilenceWindow, error)
+	GetEscalationSteps(ctx context.Context, ruleID int) ([]EscalationStep, error)
+	StartEscalation(ctx context.Context, ruleID, notificationID int, dueAt time.Time) error
+	GetDueEscalations(ctx context.Context, now time.Time) ([]Escalation, error)
+	AdvanceEscalation(ctx context.Context, escalationID, notificationID, nextStep int, dueAt time.Time) error
+	EndEscalation(ctx context.Context, escalationID int) error
+	GetAlertRecipientUserIDs(ctx context.Context, ruleID int) ([]int, bool, error)
+	GetCompoundEscalationSteps(ctx context.Context, ruleID int) ([]EscalationStep, error)
+	StartCompoundEscalation(ctx context.Context, ruleID, notificationID int, dueAt time.Time) error
+	GetCompoundAlertRecipientUserIDs(ctx context.Context, ruleID int) ([]int, bool, error)
 }
 
+// ReadingsHistory is the subset of db.ReadingsRepository needed to evaluate look-back
+// alert types. Defined locally to avoid an import cycle (db imports alerting).
+type ReadingsHistory interface {
+	GetRecentNumericReadings(ctx context.Context, sensorID int, measurementTypeName string, since time.Time) ([]ReadingSample, error)
+}
+
+// alertPermission is the permission a user needs to receive the notifications of alert
+// rules that do not name their own recipients.
+const alertPermission = "view_alerts"
+
 // UserEmailInfo holds a user's ID and email address for targeted email delivery.
 type UserEmailInfo struct {
 	UserID int
@@ -27,9 +60,12 @@ type UserEmailInfo struct {
 // Defined locally to avoid an import cycle (db imports alerting).
 type NotificationRepository interface {
 	CreateNotification(ctx context.Context, notif notifications.Notification) (int, error)
+	AssignNotificationToUser(ctx context.Context, userID, notificationID int) error
 	AssignNotificationToUsersWithPermission(ctx context.Context, notifID int, permission string) error
 	GetUserIDsWithPermission(ctx context.Context, permission string) ([]int, error)
+	GetUserIDsWithRole(ctx context.Context, role string) ([]int, error)
 	GetUsersWithPermissionAndEmail(ctx context.Context, permission string) ([]UserEmailInfo, error)
+	GetUsersWithEmail(ctx context.Context, userIDs []int) ([]UserEmailInfo, error)
 	GetChannelPreference(ctx context.Context, userID int, category notifications.NotificationCategory) (*notifications.ChannelPreference, error)
 }
 
@@ -43,6 +79,12 @@ type EmailNotifier interface {
 	SendNotification(recipient, title, message, category string) error
 }
 
+// AlertListener is told when a single-sensor alert rule starts firing, whether or not its
+// notification is silenced. automation.Engine implements it to run alert-triggered rules.
+type AlertListener interface {
+	AlertFired(ctx context.Context, ruleID int)
+}
+
 // ReadingAlert carries the values needed to evaluate one reading against alert rules.
 type ReadingAlert struct {
 	SensorID        int
@@ -54,74 +96,143 @@ type ReadingAlert struct {
 
 // ThresholdAlertProcessor owns the threshold-alert workflow end-to-end: rule evaluation,
 // rate-limiting, alert_history persistence, notification persistence, WebSocket fan-out,
-// and email dispatch. It is constructed once and injected into SensorService.
+// and email, webhook and push dispatch. It is constructed once and injected into SensorService.
 type ThresholdAlertProcessor struct {
 	alertRepo AlertRepository
+	readings  ReadingsHistory
 	notifRepo NotificationRepository
 	ws        WebSocketNotifier
-	email     EmailNotifier
+	channels  *notifications.Dispatcher
+	listener  AlertListener
 	logger    *slog.Logger
 	mu        sync.Mutex
 	lastFired map[int]time.Time // rule ID → last fire time (in-memory rate-limit state)
+	// compound rule ID → last fire time; kept apart because the IDs come from a separate table
+	lastFiredCompound map[int]time.Time
 }
 
 // NewThresholdAlertProcessor constructs a ThresholdAlertProcessor. Call once at startup.
 func NewThresholdAlertProcessor(
 	alertRepo AlertRepository,
+	readings ReadingsHistory,
 	notifRepo NotificationRepository,
 	ws WebSocketNotifier,
 	email EmailNotifier,
 	logger *slog.Logger,
 ) *ThresholdAlertProcessor {
+	logger = logger.With("component", "threshold_alert_processor")
+	channels := notifications.NewDispatcher(notifRepo, logger)
+	if email != nil {
+		channels.SetEmailSender(email)
+	}
 	return &ThresholdAlertProcessor{
 		alertRepo: alertRepo,
+		readings:  readings,
 		notifRepo: notifRepo,
 		ws:        ws,
-		email:     email,
-		logger:    logger.With("component", "threshold_alert_processor"),
+		channels:  channels,
+		logger:    logger,
 		lastFired: make(map[int]time.Time),
+
+		lastFiredCompound: make(map[int]time.Time),
 	}
 }
 
-// ProcessReading evaluates a sensor reading against configured alert rules and, if
-// triggered and not rate-limited, persists an alert_history row, creates a notification,
-// broadcasts via WebSocket, and dispatches email (best-effort async).
+// SetDeferrer enables email digests and quiet hours for alert notifications. Call
+// before the processor starts handling readings.
+func (p *ThresholdAlertProcessor) SetDeferrer(deferrer notifications.Deferrer) {
+	p.channels.SetDeferrer(deferrer)
+}
+
+// SetWebhookNotifier enables webhook delivery of alert notifications. Call before the
+// processor starts handling readings.
+func (p *ThresholdAlertProcessor) SetWebhookNotifier(webhooks notifications.WebhookNotifier) {
+	p.channels.SetWebhookNotifier(webhooks)
+}
+
+// SetPushNotifier enables ntfy/Gotify delivery of alert notifications. Call before the
+// processor starts handling readings.
+func (p *ThresholdAlertProcessor) SetPushNotifier(push notifications.PushNotifier) {
+	p.channels.SetPushNotifier(push)
+}
+
+// SetAlertListener registers a listener for alert rules starting to fire. Call before the
+// processor starts handling readings.
+func (p *ThresholdAlertProcessor) SetAlertListener(listener AlertListener) {
+	p.listener = listener
+}
+
+// ProcessReading evaluates a sensor reading against configured alert rules, both the
+// single-sensor rules for the reading and every compound rule with a condition on it. Each
+// rule that triggers and is not rate-limited persists an alert_history row, creates a
+// notification, broadcasts via WebSocket, and dispatches email, webhooks and push
+// notifications (best-effort async).
+//
+// Single-sensor rules only notify on state changes: once firing, further breaching
+// readings update the episode's peak value, and a reading back within range (allowing for
+// hysteresis) resolves the rule and sends a "resolved" notification.
+//
+// Notifications are suppressed, while state and history are still recorded, when the
+// episode started within the rule's rate limit of the previous one, the rule is snoozed,
+// a silence window covers the reading's sensor, or (for the resolved notification) the
+// episode was acknowledged.
 //
 // DB persistence failures are returned as errors. WS errors are logged at WARN and
 // broadcast continues for remaining users. Email errors are logged at ERROR; the goroutine
 // never propagates them to the caller.
 func (p *ThresholdAlertProcessor) ProcessReading(ctx context.Context, r ReadingAlert) error {
-	rule, err := p.alertRepo.GetAlertRuleForReading(ctx, r.SensorID, r.MeasurementType)
+	return errors.Join(p.processRules(ctx, r), p.processCompoundRules(ctx, r))
+}
+
+func (p *ThresholdAlertProcessor) processRules(ctx context.Context, r ReadingAlert) error {
+	rules, err := p.alertRepo.GetAlertRulesForReading(ctx, r.SensorID, r.MeasurementType)
 	if err != nil {
-		return fmt.Errorf("failed to get alert rule for sensor %d measurement %s: %w", r.SensorID, r.MeasurementType, err)
+		return fmt.Errorf("failed to get alert rules for sensor %d measurement %s: %w", r.SensorID, r.MeasurementType, err)
 	}
-	if rule == nil {
+	if len(rules) == 0 {
 		p.logger.Debug("no alert rule configured, skipping", "sensor", r.SensorName, "sensor_id", r.SensorID, "measurement_type", r.MeasurementType)
 		return nil
 	}
 
-	shouldAlert, reason := rule.ShouldAlert(r.NumericValue, r.StatusValue)
+	var errs []error
+	for i := range rules {
+		if err := p.processRule(ctx, &rules[i], r); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (p *ThresholdAlertProcessor) processRule(ctx context.Context, rule *AlertRule, r ReadingAlert) error {
+	now := time.Now()
+	history, err := p.ruleHistory(ctx, rule, r, now)
+	if err != nil {
+		return err
+	}
+	if rule.State == AlertStateFiring {
+		return p.processFiringRule(ctx, rule, r, now, history)
+	}
+
+	var shouldAlert bool
+	var reason string
+	if rule.NeedsHistory() {
+		shouldAlert, reason = rule.ShouldAlertOverHistory(now, r.NumericValue, history)
+	} else {
+		shouldAlert, reason = rule.ShouldAlert(r.NumericValue, r.StatusValue)
+	}
 	if !shouldAlert {
 		p.logger.Debug("alert conditions not met", "sensor", r.SensorName, "sensor_id", r.SensorID, "value", r.NumericValue)
 		return nil
 	}
 
-	// Rate-limit check: DB-based and in-memory under a lock to prevent TOCTOU races.
-	p.mu.Lock()
-	if rule.IsRateLimited() {
-		p.mu.Unlock()
-		p.logger.Debug("alert rate limited (DB)", "sensor", r.SensorName, "sensor_id", r.SensorID, "rule_id", rule.ID)
-		return nil
+	firing, err := p.alertRepo.MarkAlertFiring(ctx, rule.ID)
+	if err != nil {
+		return fmt.Errorf("failed to mark alert rule %d firing: %w", rule.ID, err)
 	}
-	if last, ok := p.lastFired[rule.ID]; ok && rule.RateLimitSeconds > 0 {
-		if time.Since(last) < time.Duration(rule.RateLimitSeconds)*time.Second {
-			p.mu.Unlock()
-			p.logger.Debug("alert rate limited (in-memory)", "sensor", r.SensorName, "sensor_id", r.SensorID, "rule_id", rule.ID)
-			return nil
-		}
+	if !firing {
+		p.logger.Debug("alert already firing", "sensor", r.SensorName, "rule_id", rule.ID)
+		return nil
 	}
-	p.lastFired[rule.ID] = time.Now()
-	p.mu.Unlock()
 
 	p.logger.Info("triggering alert", "sensor", r.SensorName, "sensor_id", r.SensorID, "reason", reason, "value", r.NumericValue)
 
@@ -129,6 +240,27 @@ func (p *ThresholdAlertProcessor) ProcessReading(ctx context.Context, r ReadingA
 		p.logger.Error("failed to record alert sent", "sensor_name", r.SensorName, "rule_id", rule.ID, "error", err)
 		return fmt.Errorf("failed to record alert sent: %w", err)
 	}
+	if p.listener != nil {
+		p.listener.AlertFired(ctx, rule.ID)
+	}
+
+	// The rate limit only holds back notifications: the episode is tracked either way.
+	if p.rateLimited(p.lastFired, rule.ID, rule.RateLimitSeconds, rule.IsRateLimited()) {
+		p.logger.Info("alert notification rate limited", "sensor", r.SensorName, "sensor_id", r.SensorID, "rule_id", rule.ID)
+		if err := p.alertRepo.MarkAlertRateLimited(ctx, rule.ID); err != nil {
+			return fmt.Errorf("failed to mark alert rule %d rate limited: %w", rule.ID, err)
+		}
+		return nil
+	}
+
+	silenced, err := p.silenced(ctx, rule.IsSnoozed(now), r.SensorID, now)
+	if err != nil {
+		return err
+	}
+	if silenced {
+		p.logger.Info("alert notification suppressed", "sensor", r.SensorName, "rule_id", rule.ID, "reason", reason)
+		return nil
+	}
 
 	notif := notifications.Notification{
 		Category: notifications.CategoryThresholdAlert,
@@ -141,57 +273,525 @@ func (p *ThresholdAlertProcessor) ProcessReading(ctx context.Context, r ReadingA
 			"numeric_value": r.NumericValue,
 		},
 	}
+	aud, err := p.ruleAudience(ctx, rule.ID, false)
+	if err != nil {
+		return err
+	}
+	notifID, err := p.notifyAudience(ctx, notif, aud)
+	if err != nil {
+		p.logger.Error("failed to dispatch alert notification", "sensor_name", r.SensorName, "rule_id", rule.ID, "error", err)
+		return err
+	}
 
-	notifID, err := p.notifRepo.CreateNotification(ctx, notif)
+	p.logger.Info("alert sent", "sensor", r.SensorName, "sensor_id", r.SensorID, "reason", reason)
+	p.startEscalation(ctx, rule.ID, false, notifID, now)
+	return nil
+}
+
+// ruleHistory loads the recent readings look-back rule types are evaluated over. It
+// returns nil for rules that only look at the incoming reading.
+func (p *ThresholdAlertProcessor) ruleHistory(ctx context.Context, rule *AlertRule, r ReadingAlert, now time.Time) ([]ReadingSample, error) {
+	if !rule.NeedsHistory() || !rule.Enabled {
+		return nil, nil
+	}
+	history, err := p.readings.GetRecentNumericReadings(ctx, r.SensorID, r.MeasurementType, now.Add(-rule.Window()))
 	if err != nil {
-		p.logger.Error("failed to create notification", "sensor_name", r.SensorName, "rule_id", rule.ID, "error", err)
-		return fmt.Errorf("failed to create notification: %w", err)
+		return nil, fmt.Errorf("failed to get recent readings for rule %d: %w", rule.ID, err)
 	}
+	return history, nil
+}
 
-	const targetPermission = "view_alerts"
-	if err := p.notifRepo.AssignNotificationToUsersWithPermission(ctx, notifID, targetPermission); err != nil {
-		p.logger.Error("failed to assign notification to users", "sensor_name", r.SensorName, "rule_id", rule.ID, "error", err)
-		return fmt.Errorf("failed to assign notification to users: %w", err)
+// processFiringRule handles a reading for a rule that is already firing: it either
+// resolves the rule or records the reading against the open episode's peak.
+func (p *ThresholdAlertProcessor) processFiringRule(ctx context.Context, rule *AlertRule, r ReadingAlert, now time.Time, history []ReadingSample) error {
+	if !rule.ShouldResolve(now, r.NumericValue, r.StatusValue, history) {
+		if !rule.TracksPeak() {
+			return nil
+		}
+		if err := p.alertRepo.UpdateAlertPeak(ctx, rule.ID, r.NumericValue, rule.BreachedHigh(r.NumericValue)); err != nil {
+			return fmt.Errorf("failed to update alert peak for rule %d: %w", rule.ID, err)
+		}
+		return nil
 	}
 
-	if p.ws != nil {
-		userIDs, err := p.notifRepo.GetUserIDsWithPermission(ctx, targetPermission)
-		if err != nil {
-			p.logger.Warn("failed to get user IDs for WS broadcast", "sensor_name", r.SensorName, "error", err)
-		} else {
-			notif.ID = notifID
-			for _, userID := range userIDs {
-				p.ws.BroadcastToUser(userID, notif)
-			}
+	episode, err := p.alertRepo.ResolveAlert(ctx, rule.ID)
+	if err != nil {
+		return fmt.Errorf("failed to resolve alert rule %d: %w", rule.ID, err)
+	}
+	if episode == nil {
+		// Another reading resolved the rule first.
+		return nil
+	}
+
+	reason := rule.ResolvedReason(r.NumericValue, r.StatusValue)
+	duration := episode.Duration().Round(time.Second)
+	p.logger.Info("alert resolved", "sensor", r.SensorName, "sensor_id", r.SensorID, "rule_id", rule.ID, "duration", duration)
+
+	if episode.Acknowledged || episode.RateLimited {
+		return nil
+	}
+	silenced, err := p.silenced(ctx, rule.IsSnoozed(now), r.SensorID, now)
+	if err != nil {
+		return err
+	}
+	if silenced {
+		p.logger.Info("resolved notification suppressed", "sensor", r.SensorName, "rule_id", rule.ID)
+		return nil
+	}
+
+	metadata := map[string]interface{}{
+		"sensor_name":      r.SensorName,
+		"sensor_type":      r.MeasurementType,
+		"numeric_value":    r.NumericValue,
+		"duration_seconds": int(duration.Seconds()),
+	}
+	message := fmt.Sprintf("%s after %s", reason, duration)
+	if rule.TracksPeak() {
+		metadata["peak_value"] = episode.PeakValue
+		message = fmt.Sprintf("%s (peak: %.2f)", message, episode.PeakValue)
+	}
+	notif := notifications.Notification{
+		Category: notifications.CategoryThresholdAlert,
+		Severity: notifications.SeverityInfo,
+		Title:    fmt.Sprintf("Resolved: %s", r.SensorName),
+		Message:  message,
+		Metadata: metadata,
+	}
+	aud, err := p.ruleAudience(ctx, rule.ID, false)
+	if err != nil {
+		return err
+	}
+	if _, err := p.notifyAudience(ctx, notif, aud); err != nil {
+		p.logger.Error("failed to dispatch resolved notification", "sensor_name", r.SensorName, "rule_id", rule.ID, "error", err)
+		return err
+	}
+	return nil
+}
+
+func (p *ThresholdAlertProcessor) processCompoundRules(ctx context.Context, r ReadingAlert) error {
+	rules, err := p.alertRepo.GetCompoundAlertRulesForReading(ctx, r.SensorID, r.MeasurementType)
+	if err != nil {
+		return fmt.Errorf("failed to get compound alert rules for sensor %d measurement %s: %w", r.SensorID, r.MeasurementType, err)
+	}
+
+	var errs []error
+	for i := range rules {
+		if err := p.processCompoundRule(ctx, &rules[i], r); err != nil {
+			errs = append(errs, err)
 		}
 	}
+	return errors.Join(errs...)
+}
 
-	if p.email != nil {
-		go p.sendEmailNotifications(context.Background(), notif, targetPermission)
+func (p *ThresholdAlertProcessor) processCompoundRule(ctx context.Context, rule *CompoundAlertRule, r ReadingAlert) error {
+	now := time.Now()
+	if rule.State == AlertStateFiring {
+		return p.processFiringCompoundRule(ctx, rule, r, now)
 	}
 
-	p.logger.Info("alert sent", "sensor", r.SensorName, "sensor_id", r.SensorID, "reason", reason)
+	shouldAlert, reason := rule.ShouldAlert(r)
+	if !shouldAlert {
+		p.logger.Debug("compound alert conditions not met", "rule", rule.Name, "rule_id", rule.ID, "sensor", r.SensorName)
+		return nil
+	}
+
+	firing, err := p.alertRepo.MarkCompoundAlertFiring(ctx, rule.ID)
+	if err != nil {
+		return fmt.Errorf("failed to mark compound alert rule %d firing: %w", rule.ID, err)
+	}
+	if !firing {
+		p.logger.Debug("compound alert already firing", "rule", rule.Name, "rule_id", rule.ID)
+		return nil
+	}
+
+	p.logger.Info("triggering compound alert", "rule", rule.Name, "rule_id", rule.ID, "sensor", r.SensorName, "reason", reason)
+
+	measurementTypeID := 0
+	for _, c := range rule.Conditions {
+		if c.Matches(r.SensorID, r.MeasurementType) {
+			measurementTypeID = c.MeasurementTypeId
+			break
+		}
+	}
+	if err := p.alertRepo.RecordCompoundAlertSent(ctx, rule.ID, r.SensorID, measurementTypeID, reason, r.NumericValue, r.StatusValue); err != nil {
+		p.logger.Error("failed to record compound alert sent", "rule_id", rule.ID, "error", err)
+		return fmt.Errorf("failed to record compound alert sent: %w", err)
+	}
+
+	// As for single-sensor rules, the rate limit only holds back notifications.
+	if p.rateLimited(p.lastFiredCompound, rule.ID, rule.RateLimitSeconds, rule.IsRateLimited()) {
+		p.logger.Info("compound alert notification rate limited", "rule", rule.Name, "rule_id", rule.ID)
+		if err := p.alertRepo.MarkCompoundAlertRateLimited(ctx, rule.ID); err != nil {
+			return fmt.Errorf("failed to mark compound alert rule %d rate limited: %w", rule.ID, err)
+		}
+		return nil
+	}
+
+	silenced, err := p.silenced(ctx, rule.IsSnoozed(now), r.SensorID, now)
+	if err != nil {
+		return err
+	}
+	if silenced {
+		p.logger.Info("compound alert notification suppressed", "rule", rule.Name, "rule_id", rule.ID, "sensor", r.SensorName)
+		return nil
+	}
+
+	notif := notifications.Notification{
+		Category: notifications.CategoryThresholdAlert,
+		Severity: notifications.SeverityWarning,
+		Title:    fmt.Sprintf("Alert: %s", rule.Name),
+		Message:  reason,
+		Metadata: map[string]interface{}{
+			"compound_rule_id": rule.ID,
+			"rule_name":        rule.Name,
+			"sensor_name":      r.SensorName,
+			"sensor_type":      r.MeasurementType,
+		},
+	}
+	aud, err := p.ruleAudience(ctx, rule.ID, true)
+	if err != nil {
+		return err
+	}
+	notifID, err := p.notifyAudience(ctx, notif, aud)
+	if err != nil {
+		p.logger.Error("failed to dispatch compound alert notification", "rule_id", rule.ID, "error", err)
+		return err
+	}
+
+	p.logger.Info("compound alert sent", "rule", rule.Name, "rule_id", rule.ID, "reason", reason)
+	p.startEscalation(ctx, rule.ID, true, notifID, now)
 	return nil
 }
 
-func (p *ThresholdAlertProcessor) sendEmailNotifications(ctx context.Context, notif notifications.Notification, targetPermission string) {
-	users, err := p.notifRepo.GetUsersWithPermissionAndEmail(ctx, targetPermission)
+// processFiringCompoundRule resolves a firing compound rule once its conditions, relaxed
+// by the rule's hysteresis, no longer hold.
+func (p *ThresholdAlertProcessor) processFiringCompoundRule(ctx context.Context, rule *CompoundAlertRule, r ReadingAlert, now time.Time) error {
+	if !rule.ShouldResolve(r) {
+		return nil
+	}
+
+	episode, err := p.alertRepo.ResolveCompoundAlert(ctx, rule.ID)
 	if err != nil {
-		p.logger.Error("failed to get users for email notification", "error", err)
-		return
+		return fmt.Errorf("failed to resolve compound alert rule %d: %w", rule.ID, err)
+	}
+	if episode == nil {
+		// Another reading resolved the rule first.
+		return nil
 	}
 
-	for _, user := range users {
-		pref, err := p.notifRepo.GetChannelPreference(ctx, user.UserID, notif.Category)
-		if err != nil {
-			p.logger.Error("failed to get channel preference", "user_id", user.UserID, "error", err)
-			continue
+	duration := episode.Duration().Round(time.Second)
+	p.logger.Info("compound alert resolved", "rule", rule.Name, "rule_id", rule.ID, "sensor", r.SensorName, "duration", duration)
+
+	if episode.Acknowledged || episode.RateLimited {
+		return nil
+	}
+	silenced, err := p.silenced(ctx, rule.IsSnoozed(now), r.SensorID, now)
+	if err != nil {
+		return err
+	}
+	if silenced {
+		p.logger.Info("compound resolved notification suppressed", "rule", rule.Name, "rule_id", rule.ID)
+		return nil
+	}
+
+	notif := notifications.Notification{
+		Category: notifications.CategoryThresholdAlert,
+		Severity: notifications.SeverityInfo,
+		Title:    fmt.Sprintf("Resolved: %s", rule.Name),
+		Message:  fmt.Sprintf("%s after %s", rule.ResolvedReason(r), duration),
+		Metadata: map[string]interface{}{
+			"compound_rule_id": rule.ID,
+			"rule_name":        rule.Name,
+			"sensor_name":      r.SensorName,
+			"sensor_type":      r.MeasurementType,
+			"duration_seconds": int(duration.Seconds()),
+		},
+	}
+	aud, err := p.ruleAudience(ctx, rule.ID, true)
+	if err != nil {
+		return err
+	}
+	if _, err := p.notifyAudience(ctx, notif, aud); err != nil {
+		p.logger.Error("failed to dispatch compound resolved notification", "rule_id", rule.ID, "error", err)
+		return err
+	}
+	return nil
+}
+
+// rateLimited reports whether an episode of ruleID starting now comes within
+// rateLimitSeconds of the last one that notified, either by its persisted history
+// (dbLimited) or by an earlier episode in this process. An episode that is not limited
+// is recorded as the last to notify.
+func (p *ThresholdAlertProcessor) rateLimited(lastFired map[int]time.Time, ruleID, rateLimitSeconds int, dbLimited bool) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if dbLimited {
+		return true
+	}
+	now := time.Now()
+	last, ok := lastFired[ruleID]
+	if ok && rateLimitSeconds > 0 && now.Sub(last) < time.Duration(rateLimitSeconds)*time.Second {
+		return true
+	}
+	lastFired[ruleID] = now
+	return false
+}
+
+// silenced reports whether notifications for a reading from sensorID are suppressed at
+// now, either because the rule is snoozed or because a silence window for the sensor is
+// active.
+func (p *ThresholdAlertProcessor) silenced(ctx context.Context, snoozed bool, sensorID int, now time.Time) (bool, error) {
+	if snoozed {
+		return true, nil
+	}
+	windows, err := p.alertRepo.GetSilenceWindowsForSensor(ctx, sensorID)
+	if err != nil {
+		return false, fmt.Errorf("failed to get silence windows for sensor %d: %w", sensorID, err)
+	}
+	local := now.Local()
+	for i := range windows {
+		if windows[i].Covers(local) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// audience selects who a notification goes to and over which channels. The zero value
+// selects every user with view_alerts over their preferred channels.
+type audience struct {
+	// users, when not nil, are the recipients instead of the users with view_alerts.
+	users map[int]bool
+	// channels, when not empty, replaces the recipients' channel preferences.
+	channels []EscalationChannel
+}
+
+// recipients returns the IDs of the users selected by aud.
+func (p *ThresholdAlertProcessor) recipients(ctx context.Context, aud audience) ([]int, error) {
+	if aud.users == nil {
+		return p.notifRepo.GetUserIDsWithPermission(ctx, alertPermission)
+	}
+	return slices.Sorted(maps.Keys(aud.users)), nil
+}
+
+// ruleAudience returns the recipients of the rule's notifications: the users it names,
+// or every user with view_alerts when it names none. compound selects a compound rule.
+func (p *ThresholdAlertProcessor) ruleAudience(ctx context.Context, ruleID int, compound bool) (audience, error) {
+	getUserIDs := p.alertRepo.GetAlertRecipientUserIDs
+	if compound {
+		getUserIDs = p.alertRepo.GetCompoundAlertRecipientUserIDs
+	}
+	userIDs, targeted, err := getUserIDs(ctx, ruleID)
+	if err != nil {
+		return audience{}, fmt.Errorf("failed to get recipients for alert rule %d: %w", ruleID, err)
+	}
+	if !targeted {
+		return audience{}, nil
+	}
+	aud := audience{users: make(map[int]bool, len(userIDs))}
+	for _, userID := range userIDs {
+		aud.users[userID] = true
+	}
+	if len(userIDs) == 0 {
+		p.logger.Warn("alert rule recipients include no enabled users", "rule_id", ruleID)
+	}
+	return aud, nil
+}
+
+// notifyAudience persists notif for the recipients selected by aud, pushes it to them
+// over WebSocket and hands it to the email, webhook and push dispatcher with aud's
+// channels. It returns the notification ID.
+func (p *ThresholdAlertProcessor) notifyAudience(ctx context.Context, notif notifications.Notification, aud audience) (int, error) {
+	notifID, err := p.notifRepo.CreateNotification(ctx, notif)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create notification: %w", err)
+	}
+
+	if aud.users == nil {
+		if err := p.notifRepo.AssignNotificationToUsersWithPermission(ctx, notifID, alertPermission); err != nil {
+			return 0, fmt.Errorf("failed to assign notification to users: %w", err)
+		}
+	} else {
+		for userID := range aud.users {
+			if err := p.notifRepo.AssignNotificationToUser(ctx, userID, notifID); err != nil {
+				return 0, fmt.Errorf("failed to assign notification to user %d: %w", userID, err)
+			}
+		}
+	}
+
+	notif.ID = notifID
+	userIDs, err := p.recipients(ctx, aud)
+	if err != nil {
+		p.logger.Warn("failed to get recipients for notification delivery", "title", notif.Title, "error", err)
+		return notifID, nil
+	}
+	if p.ws != nil {
+		for _, userID := range userIDs {
+			p.ws.BroadcastToUser(userID, notif)
 		}
-		if pref == nil || !pref.EmailEnabled {
-			continue
+	}
+	go p.channels.Dispatch(context.Background(), notif, notifications.Recipients{
+		UserIDs:  userIDs,
+		Emails:   func(ctx context.Context) (map[int]string, error) { return p.recipientEmails(ctx, aud) },
+		Channels: aud.channels,
+	})
+	return notifID, nil
+}
+
+// recipientEmails returns the email addresses of the users selected by aud who have one.
+func (p *ThresholdAlertProcessor) recipientEmails(ctx context.Context, aud audience) (map[int]string, error) {
+	var users []UserEmailInfo
+	var err error
+	if aud.users == nil {
+		users, err = p.notifRepo.GetUsersWithPermissionAndEmail(ctx, alertPermission)
+	} else {
+		users, err = p.notifRepo.GetUsersWithEmail(ctx, slices.Sorted(maps.Keys(aud.users)))
+	}
+	if err != nil {
+		return nil, err
+	}
+	emails := make(map[int]string, len(users))
+	for _, user := range users {
+		emails[user.UserID] = user.Email
+	}
+	return emails, nil
+}
+
+// startEscalation schedules the first step of the rule's escalation policy, if it has
+// one, for the notification sent when the rule started firing. Errors are logged: the
+// alert itself has been sent.
+func (p *ThresholdAlertProcessor) startEscalation(ctx context.Context, ruleID int, compound bool, notifID int, now time.Time) {
+	steps, err := p.escalationSteps(ctx, ruleID, compound)
+	if err != nil {
+		p.logger.Error("failed to get escalation policy", "rule_id", ruleID, "error", err)
+		return
+	}
+	if len(steps) == 0 {
+		return
+	}
+	start := p.alertRepo.StartEscalation
+	if compound {
+		start = p.alertRepo.StartCompoundEscalation
+	}
+	if err := start(ctx, ruleID, notifID, now.Add(steps[0].Delay())); err != nil {
+		p.logger.Error("failed to start alert escalation", "rule_id", ruleID, "error", err)
+	}
+}
+
+// escalationSteps returns the escalation policy of the rule, or of the compound rule when
+// compound is set.
+func (p *ThresholdAlertProcessor) escalationSteps(ctx context.Context, ruleID int, compound bool) ([]EscalationStep, error) {
+	if compound {
+		return p.alertRepo.GetCompoundEscalationSteps(ctx, ruleID)
+	}
+	return p.alertRepo.GetEscalationSteps(ctx, ruleID)
+}
+
+// StartEscalations periodically sends the escalation steps that have fallen due.
+func (p *ThresholdAlertProcessor) StartEscalations(ctx context.Context, interval time.Duration) {
+	periodic.RunTask(ctx, periodic.TaskConfig{
+		Name:     "alert_escalation",
+		Interval: interval,
+		Logger:   p.logger,
+	}, func(ctx context.Context) error {
+		return p.ProcessEscalations(ctx, time.Now())
+	})
+}
+
+// ProcessEscalations sends the escalation steps that are due at now. An escalation ends
+// without notifying once its alert has been handled, or while the rule is snoozed or a
+// silence window covers its sensor.
+func (p *ThresholdAlertProcessor) ProcessEscalations(ctx context.Context, now time.Time) error {
+	escalations, err := p.alertRepo.GetDueEscalations(ctx, now)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for i := range escalations {
+		if err := p.escalate(ctx, &escalations[i], now); err != nil {
+			errs = append(errs, fmt.Errorf("failed to escalate alert rule %d: %w", escalations[i].RuleID, err))
 		}
-		if err := p.email.SendNotification(user.Email, notif.Title, notif.Message, string(notif.Category)); err != nil {
-			p.logger.Error("failed to send email notification", "email", user.Email, "error", err)
+	}
+	return errors.Join(errs...)
+}
+
+func (p *ThresholdAlertProcessor) escalate(ctx context.Context, e *Escalation, now time.Time) error {
+	steps, err := p.escalationSteps(ctx, e.RuleID, e.Compound)
+	if err != nil {
+		return err
+	}
+	if e.Handled || e.NextStep >= len(steps) {
+		return p.alertRepo.EndEscalation(ctx, e.ID)
+	}
+	silenced, err := p.silenced(ctx, (&AlertRule{SnoozedUntil: e.SnoozedUntil}).IsSnoozed(now), e.SensorID, now)
+	if err != nil {
+		return err
+	}
+	if silenced {
+		p.logger.Info("alert escalation cancelled", "sensor", e.SensorName, "rule_id", e.RuleID)
+		return p.alertRepo.EndEscalation(ctx, e.ID)
+	}
+
+	step := steps[e.NextStep]
+	aud, err := p.escalationAudience(ctx, e.RuleID, e.Compound, step)
+	if err != nil {
+		return err
+	}
+	notif := notifications.Notification{
+		Category: notifications.CategoryThresholdAlert,
+		Severity: notifications.SeverityError,
+		Title:    fmt.Sprintf("Unacknowledged alert: %s", e.SensorName),
+		Message:  fmt.Sprintf("%s. Unacknowledged for %s.", e.Message, now.Sub(e.StartedAt).Round(time.Minute)),
+		Metadata: map[string]interface{}{
+			"sensor_name":     e.SensorName,
+			"escalation_step": e.NextStep + 1,
+		},
+	}
+	if e.Compound {
+		notif.Metadata["compound_rule_id"] = e.RuleID
+	} else {
+		notif.Metadata["rule_id"] = e.RuleID
+	}
+	notifID, err := p.notifyAudience(ctx, notif, aud)
+	if err != nil {
+		return err
+	}
+	p.logger.Info("alert escalated", "sensor", e.SensorName, "rule_id", e.RuleID, "step", e.NextStep+1, "role", step.Role)
+
+	next := e.NextStep + 1
+	if next >= len(steps) {
+		return p.alertRepo.EndEscalation(ctx, e.ID)
+	}
+	return p.alertRepo.AdvanceEscalation(ctx, e.ID, notifID, next, now.Add(steps[next].Delay()))
+}
+
+// escalationAudience returns the recipients of step: the rule's recipients, or the users
+// with view_alerts who have the step's role.
+func (p *ThresholdAlertProcessor) escalationAudience(ctx context.Context, ruleID int, compound bool, step EscalationStep) (audience, error) {
+	if step.Role == "" {
+		aud, err := p.ruleAudience(ctx, ruleID, compound)
+		aud.channels = step.Channels
+		return aud, err
+	}
+	aud := audience{channels: step.Channels}
+	allowed, err := p.notifRepo.GetUserIDsWithPermission(ctx, alertPermission)
+	if err != nil {
+		return aud, fmt.Errorf("failed to get users with %s: %w", alertPermission, err)
+	}
+	inRole, err := p.notifRepo.GetUserIDsWithRole(ctx, step.Role)
+	if err != nil {
+		return aud, fmt.Errorf("failed to get users with role %s: %w", step.Role, err)
+	}
+	aud.users = make(map[int]bool)
+	for _, userID := range inRole {
+		if slices.Contains(allowed, userID) {
+			aud.users[userID] = true
 		}
 	}
+	if len(aud.users) == 0 {
+		p.logger.Warn("no users with view_alerts have the escalation role", "role", step.Role)
+	}
+	return aud, nil
 }
@@ -87,8 +87,8 @@ func (r *recordingEmail) sendCount() int {
 
 // ============================================================
 // notifRepoAdapter adapts *database.SqlNotificationRepository to alerting.NotificationRepository.
-// The only difference is GetUsersWithPermissionAndEmail returns []alerting.UserEmailInfo
-// instead of []database.UserEmailInfo.
+// The only difference is GetUsersWithPermissionAndEmail and GetUsersWithEmail return
+// []alerting.UserEmailInfo instead of []database.UserEmailInfo.
 // ============================================================
 
 type notifRepoAdapter struct {
@@ -103,6 +103,14 @@ func (a *notifRepoAdapter) AssignNotificationToUsersWithPermission(ctx context.C
 	return a.inner.AssignNotificationToUsersWithPermission(ctx, notifID, permission)
 }
 
+func (a *notifRepoAdapter) AssignNotificationToUser(ctx context.Context, userID, notifID int) error {
+	return a.inner.AssignNotificationToUser(ctx, userID, notifID)
+}
+
+func (a *notifRepoAdapter) GetUserIDsWithRole(ctx context.Context, role string) ([]int, error) {
+	return a.inner.GetUserIDsWithRole(ctx, role)
+}
+
 func (a *notifRepoAdapter) GetUserIDsWithPermission(ctx context.Context, permission string) ([]int, error) {
 	return a.inner.GetUserIDsWithPermission(ctx, permission)
 }
@@ -119,6 +127,18 @@ func (a *notifRepoAdapter) GetUsersWithPermissionAndEmail(ctx context.Context, p
 	return result, nil
 }
 
+func (a *notifRepoAdapter) GetUsersWithEmail(ctx context.Context, userIDs []int) ([]alerting.UserEmailInfo, error) {
+	users, err := a.inner.GetUsersWithEmail(ctx, userIDs)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]alerting.UserEmailInfo, len(users))
+	for i, u := range users {
+		result[i] = alerting.UserEmailInfo{UserID: u.UserID, Email: u.Email}
+	}
+	return result, nil
+}
+
 func (a *notifRepoAdapter) GetChannelPreference(ctx context.Context, userID int, category notifications.NotificationCategory) (*notifications.ChannelPreference, error) {
 	return a.inner.GetChannelPreference(ctx, userID, category)
 }
@@ -131,8 +151,9 @@ func newProcessor(t *testing.T, db *sql.DB, ws alerting.WebSocketNotifier, email
 	t.Helper()
 	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
 	alertRepo := database.NewAlertRepository(db, logger)
+	readingsRepo := database.NewReadingsRepository(db, logger)
 	notifRepo := &notifRepoAdapter{inner: database.NewNotificationRepository(db, logger)}
-	return alerting.NewThresholdAlertProcessor(alertRepo, notifRepo, ws, email, logger)
+	return alerting.NewThresholdAlertProcessor(alertRepo, readingsRepo, notifRepo, ws, email, logger)
 }
 
 func insertSensor(t *testing.T, db *sql.DB, name string) int {
@@ -395,12 +416,38 @@ func TestProcessReading_rateLimited_skipsDelivery(t *testing.T) {
 	})
 
 	require.NoError(t, err)
-	// First record already in history; processor should have skipped before writing another
-	assert.Equal(t, 1, countAlertHistory(t, db))
+	// The rate limit only holds back the notification: the episode is still tracked
+	assert.Equal(t, alerting.AlertStateFiring, alertRuleState(t, db, ruleID))
+	assert.Equal(t, 2, countAlertHistory(t, db))
 	assert.Equal(t, 0, countNotifications(t, db))
 	assert.Equal(t, 0, ws.broadcastCount())
 }
 
+func TestProcessReading_rateLimited_windowStartsAtLastNotification(t *testing.T) {
+	db := newTestDB(t)
+	sensorID := insertSensor(t, db, "garage")
+	mtID := getMeasurementTypeID(t, db, "temperature")
+	insertNumericAlertRule(t, db, sensorID, mtID, 30.0, 10.0, true, 2)
+
+	p := newProcessor(t, db, nil, nil)
+	episode := func() {
+		processTemperature(t, p, sensorID, 35.0)
+		processTemperature(t, p, sensorID, 20.0)
+	}
+
+	// Each episode starts just under the limit after the previous one: the second is
+	// limited, but the third comes after the limit since the first one notified.
+	episode()
+	time.Sleep(1200 * time.Millisecond)
+	episode()
+	assert.Equal(t, 2, countNotifications(t, db), "alert and resolved notifications of the first episode")
+	time.Sleep(1200 * time.Millisecond)
+	episode()
+
+	assert.Equal(t, 3, countAlertHistory(t, db))
+	assert.Equal(t, 4, countNotifications(t, db))
+}
+
 // ============================================================
 // Concurrent reads for the same rule fire exactly once (in-memory rate limit)
 // ============================================================
@@ -473,6 +520,48 @@ func TestProcessReading_emailGatedByPreference(t *testing.T) {
 	_ = userA
 }
 
+// userDeferrer holds back the emails of the given users.
+type userDeferrer struct {
+	users map[int]bool
+}
+
+func (d *userDeferrer) DeferEmail(ctx context.Context, notif notifications.Notification, userID int, pref *notifications.ChannelPreference) (bool, error) {
+	return d.users[userID], nil
+}
+
+func (d *userDeferrer) InQuietHours(ctx context.Context, notif notifications.Notification, userID int) (bool, error) {
+	return d.users[userID], nil
+}
+
+func TestProcessReading_deferredEmailNotSent(t *testing.T) {
+	db := newTestDB(t)
+	sensorID := insertSensor(t, db, "kitchen")
+	mtID := getMeasurementTypeID(t, db, "temperature")
+	insertNumericAlertRule(t, db, sensorID, mtID, 30.0, 10.0, true, 0)
+
+	insertUserWithRole(t, db, "user-a", "a@example.com", "admin")
+	userB := insertUserWithRole(t, db, "user-b", "b@example.com", "admin") // in quiet hours
+
+	ws := &recordingWS{}
+	email := &recordingEmail{}
+	p := newProcessor(t, db, ws, email)
+	p.SetDeferrer(&userDeferrer{users: map[int]bool{userB: true}})
+
+	err := p.ProcessReading(context.Background(), alerting.ReadingAlert{
+		SensorID:        sensorID,
+		SensorName:      "kitchen",
+		MeasurementType: "temperature",
+		NumericValue:    35.0,
+	})
+
+	require.NoError(t, err)
+	// Deferral only affects email; both users still get the in-app notification
+	assert.Equal(t, 2, ws.broadcastCount())
+	time.Sleep(100 * time.Millisecond)
+	assert.Equal(t, 1, email.sendCount())
+	assert.Equal(t, "a@example.com", email.calls[0].recipient)
+}
+
 // ============================================================
 // Notification persist failure propagates error
 // ============================================================
@@ -487,7 +576,7 @@ func TestProcessReading_notificationPersistFails_propagatesError(t *testing.T) {
 	failingNotifRepo := &failingCreateNotificationRepo{}
 	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
 	alertRepo := database.NewAlertRepository(db, logger)
-	p := alerting.NewThresholdAlertProcessor(alertRepo, failingNotifRepo, nil, nil, logger)
+	p := alerting.NewThresholdAlertProcessor(alertRepo, database.NewReadingsRepository(db, logger), failingNotifRepo, nil, nil, logger)
 
 	err := p.ProcessReading(context.Background(), alerting.ReadingAlert{
 		SensorID:        sensorID,
@@ -510,15 +599,24 @@ var errCreateFailed = errors.New("db: simulated write failure")
 func (f *failingCreateNotificationRepo) CreateNotification(_ context.Context, _ notifications.Notification) (int, error) {
 	return 0, errCreateFailed
 }
+func (f *failingCreateNotificationRepo) AssignNotificationToUser(_ context.Context, _, _ int) error {
+	return nil
+}
 func (f *failingCreateNotificationRepo) AssignNotificationToUsersWithPermission(_ context.Context, _ int, _ string) error {
 	return nil
 }
 func (f *failingCreateNotificationRepo) GetUserIDsWithPermission(_ context.Context, _ string) ([]int, error) {
 	return nil, nil
 }
+func (f *failingCreateNotificationRepo) GetUserIDsWithRole(_ context.Context, _ string) ([]int, error) {
+	return nil, nil
+}
 func (f *failingCreateNotificationRepo) GetUsersWithPermissionAndEmail(_ context.Context, _ string) ([]alerting.UserEmailInfo, error) {
 	return nil, nil
 }
+func (f *failingCreateNotificationRepo) GetUsersWithEmail(_ context.Context, _ []int) ([]alerting.UserEmailInfo, error) {
+	return nil, nil
+}
 func (f *failingCreateNotificationRepo) GetChannelPreference(_ context.Context, _ int, _ notifications.NotificationCategory) (*notifications.ChannelPreference, error) {
 	return nil, nil
 }
@@ -2,6 +2,7 @@ package alerting
 
 import (
 	"fmt"
+	"math"
 	"time"
 )
 
@@ -10,8 +11,50 @@ type AlertType string
 const (
 	AlertTypeNumericRange AlertType = "numeric_range"
 	AlertTypeStatusBased  AlertType = "status_based"
+	// AlertTypeSustainedRange fires when the value has stayed outside
+	// [LowThreshold, HighThreshold] for at least DurationSeconds.
+	AlertTypeSustainedRange AlertType = "sustained_range"
+	// AlertTypeRateOfChange fires when the value has moved by more than
+	// ChangeThreshold within the last DurationSeconds.
+	AlertTypeRateOfChange AlertType = "rate_of_change"
 )
 
+// AlertState tracks whether a rule is currently breached.
+type AlertState string
+
+const (
+	// AlertStateOK is the state of a rule that has never fired.
+	AlertStateOK AlertState = "ok"
+	// AlertStateFiring is the state of a rule between its first breaching reading and
+	// the reading that brings the value back within range.
+	AlertStateFiring AlertState = "firing"
+	// AlertStateResolved is the state of a rule whose last firing has ended.
+	AlertStateResolved AlertState = "resolved"
+)
+
+// AlertEpisode is one firing period of a rule.
+type AlertEpisode struct {
+	StartedAt  time.Time
+	ResolvedAt time.Time
+	PeakValue  float64
+	// Acknowledged is set when a user acknowledged the episode while it was firing.
+	Acknowledged bool
+	// RateLimited is set when the episode started within the rule's rate limit and sent
+	// no notification.
+	RateLimited bool
+}
+
+// Duration is how long the rule was firing.
+func (e *AlertEpisode) Duration() time.Duration {
+	return e.ResolvedAt.Sub(e.StartedAt)
+}
+
+// ReadingSample is one stored numeric reading used by look-back alert types.
+type ReadingSample struct {
+	Time  time.Time
+	Value float64
+}
+
 type AlertRule struct {
 	ID                int        `json:"ID"`
 	SensorID          int        `json:"SensorID"`
@@ -24,6 +67,11 @@ type AlertRule struct {
 	TriggerStatus     string     `json:"TriggerStatus"`
 	Enabled           bool       `json:"Enabled"`
 	RateLimitSeconds  int        `json:"RateLimitSeconds"`
+	DurationSeconds   int        `json:"DurationSeconds"`
+	ChangeThreshold   float64    `json:"ChangeThreshold"`
+	Hysteresis        float64    `json:"Hysteresis"`
+	State             AlertState `json:"State"`
+	SnoozedUntil      *time.Time `json:"SnoozedUntil"`
 	LastAlertSentAt   *time.Time `json:"LastAlertSentAt"`
 }
 
@@ -43,25 +91,114 @@ func (r *AlertRule) Validate() error {
 		return fmt.Errorf("rate limit seconds cannot be negative")
 	}
 
-	// Validate alert type
-	if r.AlertType != AlertTypeNumericRange && r.AlertType != AlertTypeStatusBased {
-		return fmt.Errorf("invalid alert type: must be 'numeric_range' or 'status_based'")
+	if r.Hysteresis < 0 {
+		return fmt.Errorf("hysteresis cannot be negative")
 	}
 
 	// Type-specific validation
-	if r.AlertType == AlertTypeNumericRange {
+	switch r.AlertType {
+	case AlertTypeNumericRange:
 		if r.HighThreshold <= r.LowThreshold {
 			return fmt.Errorf("high threshold must be greater than low threshold")
 		}
-	}
-	if r.AlertType == AlertTypeStatusBased {
+		if 2*r.Hysteresis >= r.HighThreshold-r.LowThreshold {
+			return fmt.Errorf("hysteresis must be less than half the gap between the thresholds")
+		}
+	case AlertTypeStatusBased:
 		if r.TriggerStatus == "" {
 			return fmt.Errorf("trigger status must be set for status-based alerts")
 		}
+	case AlertTypeSustainedRange:
+		if r.HighThreshold <= r.LowThreshold {
+			return fmt.Errorf("high threshold must be greater than low threshold")
+		}
+		if 2*r.Hysteresis >= r.HighThreshold-r.LowThreshold {
+			return fmt.Errorf("hysteresis must be less than half the gap between the thresholds")
+		}
+		if r.DurationSeconds <= 0 {
+			return fmt.Errorf("duration seconds must be positive for sustained-range alerts")
+		}
+	case AlertTypeRateOfChange:
+		if r.ChangeThreshold <= 0 {
+			return fmt.Errorf("change threshold must be positive for rate-of-change alerts")
+		}
+		if r.Hysteresis >= r.ChangeThreshold {
+			return fmt.Errorf("hysteresis must be less than the change threshold")
+		}
+		if r.DurationSeconds <= 0 {
+			return fmt.Errorf("duration seconds must be positive for rate-of-change alerts")
+		}
+	default:
+		return fmt.Errorf("invalid alert type: must be 'numeric_range', 'status_based', 'sustained_range' or 'rate_of_change'")
 	}
 	return nil
 }
 
+// NeedsHistory reports whether the rule is evaluated against recent readings rather than
+// the single incoming value.
+func (r *AlertRule) NeedsHistory() bool {
+	return r.AlertType == AlertTypeSustainedRange || r.AlertType == AlertTypeRateOfChange
+}
+
+// Window is the look-back period for sustained_range and rate_of_change rules.
+func (r *AlertRule) Window() time.Duration {
+	return time.Duration(r.DurationSeconds) * time.Second
+}
+
+// ShouldAlertOverHistory evaluates a look-back rule. history holds the stored readings
+// from the window, oldest first, optionally preceded by the last reading before it; the
+// incoming value at now is passed separately as current.
+func (r *AlertRule) ShouldAlertOverHistory(now time.Time, current float64, history []ReadingSample) (bool, string) {
+	if !r.Enabled {
+		return false, ""
+	}
+
+	windowStart := now.Add(-r.Window())
+
+	switch r.AlertType {
+	case AlertTypeSustainedRange:
+		above := current > r.HighThreshold
+		if !above && current >= r.LowThreshold {
+			return false, ""
+		}
+		// The value must be known to have been out of range since the start of the window.
+		if len(history) == 0 || history[0].Time.After(windowStart) {
+			return false, ""
+		}
+		for _, s := range history {
+			if above && s.Value <= r.HighThreshold || !above && s.Value >= r.LowThreshold {
+				return false, ""
+			}
+		}
+		if above {
+			return true, fmt.Sprintf("value %.2f has been above high threshold %.2f for at least %s", current, r.HighThreshold, r.Window())
+		}
+		return true, fmt.Sprintf("value %.2f has been below low threshold %.2f for at least %s", current, r.LowThreshold, r.Window())
+
+	case AlertTypeRateOfChange:
+		var delta float64
+		for _, s := range history {
+			if s.Time.Before(windowStart) {
+				continue
+			}
+			if d := current - s.Value; math.Abs(d) > math.Abs(delta) {
+				delta = d
+			}
+		}
+		if math.Abs(delta) <= r.ChangeThreshold {
+			return false, ""
+		}
+		direction := "rose"
+		if delta < 0 {
+			direction = "fell"
+		}
+		return true, fmt.Sprintf("value %s by %.2f to %.2f within %s (limit %.2f)", direction, math.Abs(delta), current, r.Window(), r.ChangeThreshold)
+
+	default:
+		return r.ShouldAlert(current, "")
+	}
+}
+
 func (r *AlertRule) ShouldAlert(numericValue float64, statusValue string) (bool, string) {
 	if !r.Enabled {
 		return false, ""
@@ -88,6 +225,57 @@ func (r *AlertRule) ShouldAlert(numericValue float64, statusValue string) (bool,
 	}
 }
 
+// ShouldResolve reports whether a firing rule has recovered. Range rules resolve once the
+// value is back inside the thresholds by at least Hysteresis, rate-of-change rules once the
+// change within the window has dropped below ChangeThreshold minus Hysteresis, and
+// status rules as soon as the status differs from TriggerStatus.
+func (r *AlertRule) ShouldResolve(now time.Time, numericValue float64, statusValue string, history []ReadingSample) bool {
+	switch r.AlertType {
+	case AlertTypeNumericRange, AlertTypeSustainedRange:
+		return numericValue <= r.HighThreshold-r.Hysteresis && numericValue >= r.LowThreshold+r.Hysteresis
+	case AlertTypeStatusBased:
+		return statusValue != r.TriggerStatus
+	case AlertTypeRateOfChange:
+		relaxed := *r
+		relaxed.Enabled = true
+		relaxed.ChangeThreshold = r.ChangeThreshold - r.Hysteresis
+		breached, _ := relaxed.ShouldAlertOverHistory(now, numericValue, history)
+		return !breached
+	default:
+		return true
+	}
+}
+
+// ResolvedReason describes the reading that resolved the rule.
+func (r *AlertRule) ResolvedReason(numericValue float64, statusValue string) string {
+	switch r.AlertType {
+	case AlertTypeStatusBased:
+		return fmt.Sprintf("status is %s (no longer %s)", statusValue, r.TriggerStatus)
+	case AlertTypeRateOfChange:
+		return fmt.Sprintf("value %.2f is no longer changing by more than %.2f within %s", numericValue, r.ChangeThreshold, r.Window())
+	default:
+		return fmt.Sprintf("value %.2f is back within %.2f to %.2f", numericValue, r.LowThreshold, r.HighThreshold)
+	}
+}
+
+// TracksPeak reports whether the rule records the most extreme value of each firing
+// episode. Only range rules have a meaningful direction to measure it in.
+func (r *AlertRule) TracksPeak() bool {
+	return r.AlertType == AlertTypeNumericRange || r.AlertType == AlertTypeSustainedRange
+}
+
+// BreachedHigh reports whether a value breaching a range rule is on the high side. Values
+// on either side of the midpoint belong to that side's episode, which holds for every
+// value that has not yet resolved because Hysteresis is under half the threshold gap.
+func (r *AlertRule) BreachedHigh(numericValue float64) bool {
+	return numericValue > (r.HighThreshold+r.LowThreshold)/2
+}
+
+// IsSnoozed reports whether the rule's notifications are snoozed at now.
+func (r *AlertRule) IsSnoozed(now time.Time) bool {
+	return r.SnoozedUntil != nil && now.Before(*r.SnoozedUntil)
+}
+
 func (r *AlertRule) IsRateLimited() bool {
 	if r.RateLimitSeconds == 0 {
 		return false
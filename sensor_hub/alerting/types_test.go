@@ -214,3 +214,216 @@ func TestAlertRule_IsRateLimited_OldEnough(t *testing.T) {
 
 	assert.False(t, rule.IsRateLimited())
 }
+
+func TestAlertRule_ValidateSustainedRange_RequiresDuration(t *testing.T) {
+	rule := AlertRule{
+		SensorID:          1,
+		MeasurementTypeId: 1,
+		AlertType:         AlertTypeSustainedRange,
+		HighThreshold:     30.0,
+		LowThreshold:      10.0,
+		Enabled:           true,
+	}
+
+	err := rule.Validate()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "duration seconds must be positive")
+
+	rule.DurationSeconds = 600
+	assert.NoError(t, rule.Validate())
+}
+
+func TestAlertRule_ValidateRateOfChange_RequiresChangeThreshold(t *testing.T) {
+	rule := AlertRule{
+		SensorID:          1,
+		MeasurementTypeId: 1,
+		AlertType:         AlertTypeRateOfChange,
+		DurationSeconds:   600,
+		Enabled:           true,
+	}
+
+	err := rule.Validate()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "change threshold must be positive")
+
+	rule.ChangeThreshold = 2
+	assert.NoError(t, rule.Validate())
+}
+
+func TestAlertRule_ShouldAlertOverHistory_SustainedHigh(t *testing.T) {
+	now := time.Now()
+	rule := AlertRule{
+		AlertType:       AlertTypeSustainedRange,
+		HighThreshold:   30.0,
+		LowThreshold:    10.0,
+		DurationSeconds: 600,
+		Enabled:         true,
+	}
+	history := []ReadingSample{
+		{Time: now.Add(-12 * time.Minute), Value: 30.5},
+		{Time: now.Add(-6 * time.Minute), Value: 31.0},
+		{Time: now, Value: 31.2},
+	}
+
+	shouldAlert, reason := rule.ShouldAlertOverHistory(now, 31.2, history)
+	assert.True(t, shouldAlert)
+	assert.Contains(t, reason, "has been above high threshold 30.00 for at least 10m0s")
+}
+
+func TestAlertRule_ShouldAlertOverHistory_SustainedSingleSpike(t *testing.T) {
+	now := time.Now()
+	rule := AlertRule{
+		AlertType:       AlertTypeSustainedRange,
+		HighThreshold:   30.0,
+		LowThreshold:    10.0,
+		DurationSeconds: 600,
+		Enabled:         true,
+	}
+	history := []ReadingSample{
+		{Time: now.Add(-12 * time.Minute), Value: 29.0},
+		{Time: now.Add(-6 * time.Minute), Value: 29.5},
+		{Time: now, Value: 30.1},
+	}
+
+	shouldAlert, _ := rule.ShouldAlertOverHistory(now, 30.1, history)
+	assert.False(t, shouldAlert)
+}
+
+func TestAlertRule_ShouldAlertOverHistory_SustainedNotLongEnough(t *testing.T) {
+	now := time.Now()
+	rule := AlertRule{
+		AlertType:       AlertTypeSustainedRange,
+		HighThreshold:   30.0,
+		LowThreshold:    10.0,
+		DurationSeconds: 600,
+		Enabled:         true,
+	}
+	history := []ReadingSample{
+		{Time: now.Add(-5 * time.Minute), Value: 5.0},
+		{Time: now, Value: 4.0},
+	}
+
+	shouldAlert, _ := rule.ShouldAlertOverHistory(now, 4.0, history)
+	assert.False(t, shouldAlert, "readings only cover half of the window")
+}
+
+func TestAlertRule_ShouldAlertOverHistory_RateOfChangeRise(t *testing.T) {
+	now := time.Now()
+	rule := AlertRule{
+		AlertType:       AlertTypeRateOfChange,
+		ChangeThreshold: 3.0,
+		DurationSeconds: 900,
+		Enabled:         true,
+	}
+	history := []ReadingSample{
+		{Time: now.Add(-20 * time.Minute), Value: -30.0},
+		{Time: now.Add(-10 * time.Minute), Value: -18.0},
+		{Time: now, Value: -14.0},
+	}
+
+	shouldAlert, reason := rule.ShouldAlertOverHistory(now, -14.0, history)
+	assert.True(t, shouldAlert)
+	assert.Equal(t, "value rose by 4.00 to -14.00 within 15m0s (limit 3.00)", reason)
+}
+
+func TestAlertRule_ShouldAlertOverHistory_RateOfChangeIgnoresOlderReadings(t *testing.T) {
+	now := time.Now()
+	rule := AlertRule{
+		AlertType:       AlertTypeRateOfChange,
+		ChangeThreshold: 100,
+		DurationSeconds: 60,
+		Enabled:         true,
+	}
+	history := []ReadingSample{
+		{Time: now.Add(-5 * time.Minute), Value: 50},
+		{Time: now.Add(-30 * time.Second), Value: 1200},
+	}
+
+	shouldAlert, _ := rule.ShouldAlertOverHistory(now, 1250, history)
+	assert.False(t, shouldAlert)
+
+	shouldAlert, reason := rule.ShouldAlertOverHistory(now, 1000, history)
+	assert.True(t, shouldAlert)
+	assert.Contains(t, reason, "fell by 200.00")
+}
+
+func TestAlertRule_Validate_RejectsWideHysteresis(t *testing.T) {
+	rule := AlertRule{
+		SensorID:          1,
+		MeasurementTypeId: 1,
+		AlertType:         AlertTypeNumericRange,
+		HighThreshold:     30.0,
+		LowThreshold:      0.0,
+		Hysteresis:        15.0,
+		Enabled:           true,
+	}
+
+	err := rule.Validate()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "hysteresis must be less than half the gap")
+
+	rule.Hysteresis = 2.0
+	assert.NoError(t, rule.Validate())
+
+	rule.Hysteresis = -1.0
+	assert.Error(t, rule.Validate())
+}
+
+func TestAlertRule_ShouldResolve_RangeWithHysteresis(t *testing.T) {
+	rule := AlertRule{
+		AlertType:     AlertTypeNumericRange,
+		HighThreshold: 30.0,
+		LowThreshold:  0.0,
+		Hysteresis:    1.5,
+		Enabled:       true,
+	}
+
+	assert.False(t, rule.ShouldResolve(time.Now(), -0.5, "", nil))
+	assert.False(t, rule.ShouldResolve(time.Now(), 1.0, "", nil), "inside the thresholds but not past the hysteresis margin")
+	assert.True(t, rule.ShouldResolve(time.Now(), 1.5, "", nil))
+	assert.False(t, rule.ShouldResolve(time.Now(), 29.0, "", nil))
+}
+
+func TestAlertRule_ShouldResolve_Status(t *testing.T) {
+	rule := AlertRule{AlertType: AlertTypeStatusBased, TriggerStatus: "open", Enabled: true}
+
+	assert.False(t, rule.ShouldResolve(time.Now(), 0, "open", nil))
+	assert.True(t, rule.ShouldResolve(time.Now(), 0, "closed", nil))
+}
+
+func TestAlertRule_ShouldResolve_RateOfChangeWithHysteresis(t *testing.T) {
+	now := time.Now()
+	rule := AlertRule{
+		AlertType:       AlertTypeRateOfChange,
+		ChangeThreshold: 3.0,
+		Hysteresis:      1.0,
+		DurationSeconds: 900,
+		Enabled:         true,
+	}
+	history := []ReadingSample{
+		{Time: now.Add(-10 * time.Minute), Value: -18.0},
+		{Time: now, Value: -15.5},
+	}
+
+	assert.False(t, rule.ShouldResolve(now, -15.5, "", history), "a 2.5 change is still above the relaxed 2.0 limit")
+
+	history[1].Value = -16.5
+	assert.True(t, rule.ShouldResolve(now, -16.5, "", history))
+}
+
+func TestValidateEscalationSteps(t *testing.T) {
+	assert.NoError(t, ValidateEscalationSteps(nil))
+	assert.NoError(t, ValidateEscalationSteps([]EscalationStep{
+		{DelayMinutes: 10},
+		{DelayMinutes: 30, Role: "admin", Channels: []EscalationChannel{EscalationChannelEmail, EscalationChannelPush}},
+	}))
+
+	assert.ErrorContains(t, ValidateEscalationSteps([]EscalationStep{{DelayMinutes: 0}}), "delay minutes must be positive")
+	assert.ErrorContains(t, ValidateEscalationSteps([]EscalationStep{
+		{DelayMinutes: 5, Channels: []EscalationChannel{"sms"}},
+	}), "invalid channel")
+	assert.ErrorContains(t, ValidateEscalationSteps([]EscalationStep{
+		{DelayMinutes: 5, Channels: []EscalationChannel{EscalationChannelPush, EscalationChannelPush}},
+	}), "listed twice")
+	assert.ErrorContains(t, ValidateEscalationSteps(make([]EscalationStep, MaxEscalationSteps+1)), "at most")
+}
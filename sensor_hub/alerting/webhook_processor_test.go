@@ -0,0 +1,73 @@
+package alerting_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	database "example/sensorHub/db"
+	"example/sensorHub/notifications"
+	"example/sensorHub/webhook"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProcessReading_deliversToEnabledWebhooks(t *testing.T) {
+	db := newTestDB(t)
+	sensorID := insertSensor(t, db, "garage")
+	mtID := getMeasurementTypeID(t, db, "temperature")
+	insertNumericAlertRule(t, db, sensorID, mtID, 30.0, 0.0, true, 0)
+	alice := insertUserWithRole(t, db, "alice", "alice@example.com", "admin")
+	bob := insertUserWithRole(t, db, "bob", "bob@example.com", "admin")
+	_, err := db.Exec(`INSERT INTO notification_channel_preferences (user_id, category, email_enabled, inapp_enabled, webhook_enabled)
+		VALUES (?, ?, 1, 1, 0)`, bob, notifications.CategoryThresholdAlert)
+	require.NoError(t, err)
+
+	var mu sync.Mutex
+	received := map[string][]byte{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		mu.Lock()
+		received[r.URL.Path] = body
+		mu.Unlock()
+	}))
+	t.Cleanup(server.Close)
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	webhookRepo := database.NewWebhookRepository(db, logger)
+	for _, target := range []webhook.Target{
+		{UserID: &alice, Name: "alice", URL: server.URL + "/alice", Enabled: true},
+		{UserID: &bob, Name: "bob", URL: server.URL + "/bob", Enabled: true},
+		{Name: "ops", URL: server.URL + "/ops", Enabled: true},
+	} {
+		require.NoError(t, webhookRepo.CreateWebhookTarget(context.Background(), &target))
+	}
+
+	p := newProcessor(t, db, nil, nil)
+	p.SetWebhookNotifier(webhook.NewNotifier(webhookRepo, logger))
+	processTemperature(t, p, sensorID, -2.0)
+
+	require.Eventually(t, func() bool {
+		var n int
+		require.NoError(t, db.QueryRow("SELECT COUNT(*) FROM webhook_deliveries WHERE status = 'delivered'").Scan(&n))
+		return n == 2
+	}, 2*time.Second, 10*time.Millisecond)
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Contains(t, received, "/alice")
+	assert.Contains(t, received, "/ops")
+	assert.NotContains(t, received, "/bob")
+
+	var payload webhook.Payload
+	require.NoError(t, json.Unmarshal(received["/ops"], &payload))
+	assert.Equal(t, "Alert: garage", payload.Title)
+	assert.Equal(t, "threshold_alert", payload.Category)
+}
@@ -1,16 +1,21 @@
 package api
 
 import (
+	"context"
 	"example/sensorHub/alerting"
+	db "example/sensorHub/db"
 	gen "example/sensorHub/gen"
+	"fmt"
 	"log/slog"
 	"net/http"
+	"slices"
+	"time"
 
 	"github.com/gin-gonic/gin"
 )
 
 func toAlertingRule(r gen.AlertRule) alerting.AlertRule {
-	return alerting.AlertRule{
+	rule := alerting.AlertRule{
 		ID:                r.ID,
 		SensorID:          r.SensorID,
 		SensorName:        r.SensorName,
@@ -24,6 +29,16 @@ func toAlertingRule(r gen.AlertRule) alerting.AlertRule {
 		RateLimitSeconds:  r.RateLimitSeconds,
 		LastAlertSentAt:   r.LastAlertSentAt,
 	}
+	if r.DurationSeconds != nil {
+		rule.DurationSeconds = *r.DurationSeconds
+	}
+	if r.ChangeThreshold != nil {
+		rule.ChangeThreshold = *r.ChangeThreshold
+	}
+	if r.Hysteresis != nil {
+		rule.Hysteresis = *r.Hysteresis
+	}
+	return rule
 }
 
 func (s *Server) GetAllAlertRules(c *gin.Context) {
@@ -109,6 +124,16 @@ func (s *Server) UpdateAlertRule(c *gin.Context, id int) {
 	// Preserve them from the existing rule so clients only need to send mutable fields.
 	rule.SensorID = existing.SensorID
 	rule.MeasurementTypeId = existing.MeasurementTypeId
+	// Look-back settings are optional in the body; keep the stored values when omitted.
+	if genRule.DurationSeconds == nil {
+		rule.DurationSeconds = existing.DurationSeconds
+	}
+	if genRule.ChangeThreshold == nil {
+		rule.ChangeThreshold = existing.ChangeThreshold
+	}
+	if genRule.Hysteresis == nil {
+		rule.Hysteresis = existing.Hysteresis
+	}
 
 	if err := rule.Validate(); err != nil {
 		c.IndentedJSON(http.StatusBadRequest, gin.H{"message": "Invalid alert rule", "error": err.Error()})
@@ -151,3 +176,800 @@ func (s *Server) GetAlertHistory(c *gin.Context, sensorId int, params gen.GetAle
 	c.IndentedJSON(http.StatusOK, history)
 }
 
+func (s *Server) AcknowledgeAlertRule(c *gin.Context, id int) {
+	ctx := c.Request.Context()
+	userID := c.MustGet("currentUser").(*gen.User).Id
+
+	rule, err := s.alertService.ServiceGetAlertRuleByID(ctx, id)
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, gin.H{"message": "Error fetching alert rule", "error": err.Error()})
+		return
+	}
+	if rule == nil {
+		c.IndentedJSON(http.StatusNotFound, gin.H{"message": "Alert rule not found"})
+		return
+	}
+
+	acknowledged, err := s.alertService.ServiceAcknowledgeAlertRule(ctx, id, userID)
+	if err != nil {
+		slog.Error("error acknowledging alert rule", "id", id, "error", err)
+		c.IndentedJSON(http.StatusInternalServerError, gin.H{"message": "Error acknowledging alert rule", "error": err.Error()})
+		return
+	}
+	if !acknowledged {
+		c.IndentedJSON(http.StatusConflict, gin.H{"message": "Alert rule is not firing"})
+		return
+	}
+	c.IndentedJSON(http.StatusOK, gin.H{"message": "Alert acknowledged"})
+}
+
+func (s *Server) AcknowledgeAlertHistoryEntry(c *gin.Context, id int) {
+	ctx := c.Request.Context()
+	userID := c.MustGet("currentUser").(*gen.User).Id
+
+	acknowledged, err := s.alertService.ServiceAcknowledgeAlertHistoryEntry(ctx, id, userID)
+	if err != nil {
+		slog.Error("error acknowledging alert history entry", "id", id, "error", err)
+		c.IndentedJSON(http.StatusInternalServerError, gin.H{"message": "Error acknowledging alert history entry", "error": err.Error()})
+		return
+	}
+	if !acknowledged {
+		c.IndentedJSON(http.StatusNotFound, gin.H{"message": "Alert history entry not found"})
+		return
+	}
+	c.IndentedJSON(http.StatusOK, gin.H{"message": "Alert history entry acknowledged"})
+}
+
+func (s *Server) SnoozeAlertRule(c *gin.Context, id int) {
+	var req gen.AlertSnoozeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.IndentedJSON(http.StatusBadRequest, gin.H{"message": "Invalid request body", "error": err.Error()})
+		return
+	}
+	if !req.Until.After(time.Now()) {
+		c.IndentedJSON(http.StatusBadRequest, gin.H{"message": "Snooze time must be in the future"})
+		return
+	}
+	s.setAlertRuleSnooze(c, id, &req.Until, "Alert rule snoozed")
+}
+
+func (s *Server) UnsnoozeAlertRule(c *gin.Context, id int) {
+	s.setAlertRuleSnooze(c, id, nil, "Alert rule snooze cancelled")
+}
+
+func (s *Server) setAlertRuleSnooze(c *gin.Context, id int, until *time.Time, message string) {
+	ctx := c.Request.Context()
+	rule, err := s.alertService.ServiceGetAlertRuleByID(ctx, id)
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, gin.H{"message": "Error fetching alert rule", "error": err.Error()})
+		return
+	}
+	if rule == nil {
+		c.IndentedJSON(http.StatusNotFound, gin.H{"message": "Alert rule not found"})
+		return
+	}
+
+	if err := s.alertService.ServiceSnoozeAlertRule(ctx, id, until); err != nil {
+		slog.Error("error snoozing alert rule", "id", id, "error", err)
+		c.IndentedJSON(http.StatusInternalServerError, gin.H{"message": "Error snoozing alert rule", "error": err.Error()})
+		return
+	}
+	c.IndentedJSON(http.StatusOK, gin.H{"message": message})
+}
+
+func (s *Server) GetAlertEscalationPolicy(c *gin.Context, id int) {
+	ctx := c.Request.Context()
+	if !s.alertRuleExists(c, id) {
+		return
+	}
+
+	steps, err := s.alertService.ServiceGetEscalationSteps(ctx, id)
+	if err != nil {
+		slog.Error("error fetching escalation policy", "id", id, "error", err)
+		c.IndentedJSON(http.StatusInternalServerError, gin.H{"message": "Error fetching escalation policy", "error": err.Error()})
+		return
+	}
+	c.IndentedJSON(http.StatusOK, gin.H{"Steps": steps})
+}
+
+func (s *Server) SetAlertEscalationPolicy(c *gin.Context, id int) {
+	var req gen.EscalationPolicy
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.IndentedJSON(http.StatusBadRequest, gin.H{"message": "Invalid request body", "error": err.Error()})
+		return
+	}
+	if !s.alertRuleExists(c, id) {
+		return
+	}
+
+	steps, ok := s.validEscalationSteps(c, req.Steps)
+	if !ok {
+		return
+	}
+	s.setEscalationSteps(c, s.alertService.ServiceSetEscalationSteps, id, steps, "Escalation policy updated")
+}
+
+func (s *Server) DeleteAlertEscalationPolicy(c *gin.Context, id int) {
+	if !s.alertRuleExists(c, id) {
+		return
+	}
+	s.setEscalationSteps(c, s.alertService.ServiceSetEscalationSteps, id, nil, "Escalation policy deleted")
+}
+
+// validEscalationSteps converts and validates a requested escalation policy, writing the
+// error response when it is invalid.
+func (s *Server) validEscalationSteps(c *gin.Context, genSteps []gen.EscalationStep) ([]alerting.EscalationStep, bool) {
+	steps := toAlertingEscalationSteps(genSteps)
+	if err := alerting.ValidateEscalationSteps(steps); err != nil {
+		c.IndentedJSON(http.StatusBadRequest, gin.H{"message": "Invalid escalation policy", "error": err.Error()})
+		return nil, false
+	}
+	if len(steps) > 0 {
+		roles, err := s.roleService.ListRoles(c.Request.Context())
+		if err != nil {
+			c.IndentedJSON(http.StatusInternalServerError, gin.H{"message": "Error fetching roles", "error": err.Error()})
+			return nil, false
+		}
+		for _, step := range steps {
+			if step.Role != "" && !slices.ContainsFunc(roles, func(r db.RoleInfo) bool { return r.Name == step.Role }) {
+				c.IndentedJSON(http.StatusBadRequest, gin.H{"message": "Invalid escalation policy", "error": fmt.Sprintf("unknown role %q", step.Role)})
+				return nil, false
+			}
+		}
+	}
+	return steps, true
+}
+
+// setEscalationSteps stores steps with set, the service call for a simple or a compound
+// rule.
+func (s *Server) setEscalationSteps(c *gin.Context, set func(context.Context, int, []alerting.EscalationStep) error, id int, steps []alerting.EscalationStep, message string) {
+	if err := set(c.Request.Context(), id, steps); err != nil {
+		slog.Error("error updating escalation policy", "id", id, "error", err)
+		c.IndentedJSON(http.StatusInternalServerError, gin.H{"message": "Error updating escalation policy", "error": err.Error()})
+		return
+	}
+	c.IndentedJSON(http.StatusOK, gin.H{"message": message})
+}
+
+// alertRuleExists reports whether the rule exists, writing the error response when it
+// does not or cannot be fetched.
+func (s *Server) alertRuleExists(c *gin.Context, id int) bool {
+	rule, err := s.alertService.ServiceGetAlertRuleByID(c.Request.Context(), id)
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, gin.H{"message": "Error fetching alert rule", "error": err.Error()})
+		return false
+	}
+	if rule == nil {
+		c.IndentedJSON(http.StatusNotFound, gin.H{"message": "Alert rule not found"})
+		return false
+	}
+	return true
+}
+
+func toAlertingEscalationSteps(genSteps []gen.EscalationStep) []alerting.EscalationStep {
+	steps := make([]alerting.EscalationStep, 0, len(genSteps))
+	for _, g := range genSteps {
+		step := alerting.EscalationStep{DelayMinutes: g.DelayMinutes, Channels: []alerting.EscalationChannel{}}
+		if g.Role != nil {
+			step.Role = *g.Role
+		}
+		if g.Channels != nil {
+			for _, channel := range *g.Channels {
+				step.Channels = append(step.Channels, alerting.EscalationChannel(channel))
+			}
+		}
+		steps = append(steps, step)
+	}
+	return steps
+}
+
+func (s *Server) GetAlertRecipients(c *gin.Context, id int) {
+	ctx := c.Request.Context()
+	if !s.alertRuleExists(c, id) {
+		return
+	}
+
+	recipients, err := s.alertService.ServiceGetAlertRecipients(ctx, id)
+	if err != nil {
+		slog.Error("error fetching alert recipients", "id", id, "error", err)
+		c.IndentedJSON(http.StatusInternalServerError, gin.H{"message": "Error fetching alert recipients", "error": err.Error()})
+		return
+	}
+	c.IndentedJSON(http.StatusOK, recipients)
+}
+
+func (s *Server) SetAlertRecipients(c *gin.Context, id int) {
+	var req gen.AlertRecipients
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.IndentedJSON(http.StatusBadRequest, gin.H{"message": "Invalid request body", "error": err.Error()})
+		return
+	}
+	if !s.alertRuleExists(c, id) {
+		return
+	}
+
+	recipients, ok := s.validAlertRecipients(c, req)
+	if !ok {
+		return
+	}
+	s.setAlertRecipients(c, s.alertService.ServiceSetAlertRecipients, id, recipients, "Alert recipients updated")
+}
+
+func (s *Server) DeleteAlertRecipients(c *gin.Context, id int) {
+	if !s.alertRuleExists(c, id) {
+		return
+	}
+	s.setAlertRecipients(c, s.alertService.ServiceSetAlertRecipients, id, alerting.AlertRecipients{}, "Alert recipients deleted")
+}
+
+// validAlertRecipients converts and validates requested recipients, writing the error
+// response when they are invalid.
+func (s *Server) validAlertRecipients(c *gin.Context, req gen.AlertRecipients) (alerting.AlertRecipients, bool) {
+	recipients := toAlertingRecipients(req)
+	if err := recipients.Validate(); err != nil {
+		c.IndentedJSON(http.StatusBadRequest, gin.H{"message": "Invalid alert recipients", "error": err.Error()})
+		return recipients, false
+	}
+	problem, err := s.unknownRecipient(c.Request.Context(), recipients)
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, gin.H{"message": "Error checking alert recipients", "error": err.Error()})
+		return recipients, false
+	}
+	if problem != "" {
+		c.IndentedJSON(http.StatusBadRequest, gin.H{"message": "Invalid alert recipients", "error": problem})
+		return recipients, false
+	}
+	return recipients, true
+}
+
+// setAlertRecipients stores recipients with set, the service call for a simple or a
+// compound rule.
+func (s *Server) setAlertRecipients(c *gin.Context, set func(context.Context, int, alerting.AlertRecipients) error, id int, recipients alerting.AlertRecipients, message string) {
+	if err := set(c.Request.Context(), id, recipients); err != nil {
+		slog.Error("error updating alert recipients", "id", id, "error", err)
+		c.IndentedJSON(http.StatusInternalServerError, gin.H{"message": "Error updating alert recipients", "error": err.Error()})
+		return
+	}
+	c.IndentedJSON(http.StatusOK, gin.H{"message": message})
+}
+
+// unknownRecipient describes the first user, role or contact group in recipients that
+// does not exist, or returns "" when they all do.
+func (s *Server) unknownRecipient(ctx context.Context, recipients alerting.AlertRecipients) (string, error) {
+	if problem, err := s.unknownUser(ctx, recipients.UserIDs); problem != "" || err != nil {
+		return problem, err
+	}
+	if len(recipients.Roles) > 0 {
+		roles, err := s.roleService.ListRoles(ctx)
+		if err != nil {
+			return "", err
+		}
+		for _, role := range recipients.Roles {
+			if !slices.ContainsFunc(roles, func(r db.RoleInfo) bool { return r.Name == role }) {
+				return fmt.Sprintf("unknown role %q", role), nil
+			}
+		}
+	}
+	if len(recipients.ContactGroupIDs) > 0 {
+		groups, err := s.alertService.ServiceGetAllContactGroups(ctx)
+		if err != nil {
+			return "", err
+		}
+		for _, groupID := range recipients.ContactGroupIDs {
+			if !slices.ContainsFunc(groups, func(g alerting.ContactGroup) bool { return g.ID == groupID }) {
+				return fmt.Sprintf("unknown contact group %d", groupID), nil
+			}
+		}
+	}
+	return "", nil
+}
+
+// unknownUser describes the first of userIDs that does not exist, or returns "" when they
+// all do.
+func (s *Server) unknownUser(ctx context.Context, userIDs []int) (string, error) {
+	if len(userIDs) == 0 {
+		return "", nil
+	}
+	users, err := s.userService.ListUsers(ctx)
+	if err != nil {
+		return "", err
+	}
+	for _, userID := range userIDs {
+		if !slices.ContainsFunc(users, func(u gen.User) bool { return u.Id == userID }) {
+			return fmt.Sprintf("unknown user %d", userID), nil
+		}
+	}
+	return "", nil
+}
+
+func toAlertingRecipients(r gen.AlertRecipients) alerting.AlertRecipients {
+	recipients := alerting.AlertRecipients{UserIDs: []int{}, Roles: []string{}, ContactGroupIDs: []int{}}
+	if r.UserIDs != nil {
+		recipients.UserIDs = *r.UserIDs
+	}
+	if r.Roles != nil {
+		recipients.Roles = *r.Roles
+	}
+	if r.ContactGroupIDs != nil {
+		recipients.ContactGroupIDs = *r.ContactGroupIDs
+	}
+	return recipients
+}
+
+func toAlertingSilenceWindow(w gen.SilenceWindow) alerting.SilenceWindow {
+	window := alerting.SilenceWindow{
+		Name:      w.Name,
+		SensorID:  w.SensorID,
+		StartTime: w.StartTime,
+		EndTime:   w.EndTime,
+		Enabled:   w.Enabled,
+		Days:      []string{},
+	}
+	if w.Days != nil {
+		for _, day := range *w.Days {
+			window.Days = append(window.Days, string(day))
+		}
+	}
+	return window
+}
+
+func (s *Server) GetAllSilenceWindows(c *gin.Context) {
+	ctx := c.Request.Context()
+	windows, err := s.alertService.ServiceGetAllSilenceWindows(ctx)
+	if err != nil {
+		slog.Error("error fetching silence windows", "error", err)
+		c.IndentedJSON(http.StatusInternalServerError, gin.H{"message": "Error fetching silence windows", "error": err.Error()})
+		return
+	}
+	if windows == nil {
+		windows = []alerting.SilenceWindow{}
+	}
+	c.IndentedJSON(http.StatusOK, windows)
+}
+
+func (s *Server) CreateSilenceWindow(c *gin.Context) {
+	ctx := c.Request.Context()
+	var genWindow gen.SilenceWindow
+	if err := c.ShouldBindJSON(&genWindow); err != nil {
+		c.IndentedJSON(http.StatusBadRequest, gin.H{"message": "Invalid request body", "error": err.Error()})
+		return
+	}
+
+	window := toAlertingSilenceWindow(genWindow)
+	if err := window.Validate(); err != nil {
+		c.IndentedJSON(http.StatusBadRequest, gin.H{"message": "Invalid silence window", "error": err.Error()})
+		return
+	}
+
+	if err := s.alertService.ServiceCreateSilenceWindow(ctx, &window); err != nil {
+		slog.Error("error creating silence window", "error", err)
+		c.IndentedJSON(http.StatusInternalServerError, gin.H{"message": "Error creating silence window", "error": err.Error()})
+		return
+	}
+
+	c.IndentedJSON(http.StatusCreated, window)
+}
+
+func (s *Server) UpdateSilenceWindow(c *gin.Context, id int) {
+	ctx := c.Request.Context()
+	var genWindow gen.SilenceWindow
+	if err := c.ShouldBindJSON(&genWindow); err != nil {
+		c.IndentedJSON(http.StatusBadRequest, gin.H{"message": "Invalid request body", "error": err.Error()})
+		return
+	}
+
+	existing, err := s.alertService.ServiceGetSilenceWindowByID(ctx, id)
+	if err != nil {
+		slog.Error("error fetching silence window for update", "id", id, "error", err)
+		c.IndentedJSON(http.StatusInternalServerError, gin.H{"message": "Error fetching silence window", "error": err.Error()})
+		return
+	}
+	if existing == nil {
+		c.IndentedJSON(http.StatusNotFound, gin.H{"message": "Silence window not found"})
+		return
+	}
+
+	window := toAlertingSilenceWindow(genWindow)
+	window.ID = id
+	if err := window.Validate(); err != nil {
+		c.IndentedJSON(http.StatusBadRequest, gin.H{"message": "Invalid silence window", "error": err.Error()})
+		return
+	}
+
+	if err := s.alertService.ServiceUpdateSilenceWindow(ctx, &window); err != nil {
+		slog.Error("error updating silence window", "error", err)
+		c.IndentedJSON(http.StatusInternalServerError, gin.H{"message": "Error updating silence window", "error": err.Error()})
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, gin.H{"message": "Silence window updated successfully"})
+}
+
+func (s *Server) DeleteSilenceWindow(c *gin.Context, id int) {
+	ctx := c.Request.Context()
+	if err := s.alertService.ServiceDeleteSilenceWindow(ctx, id); err != nil {
+		slog.Error("error deleting silence window", "error", err)
+		c.IndentedJSON(http.StatusInternalServerError, gin.H{"message": "Error deleting silence window", "error": err.Error()})
+		return
+	}
+	c.IndentedJSON(http.StatusOK, gin.H{"message": "Silence window deleted successfully"})
+}
+
+func (s *Server) GetAllContactGroups(c *gin.Context) {
+	ctx := c.Request.Context()
+	groups, err := s.alertService.ServiceGetAllContactGroups(ctx)
+	if err != nil {
+		slog.Error("error fetching contact groups", "error", err)
+		c.IndentedJSON(http.StatusInternalServerError, gin.H{"message": "Error fetching contact groups", "error": err.Error()})
+		return
+	}
+	if groups == nil {
+		groups = []alerting.ContactGroup{}
+	}
+	c.IndentedJSON(http.StatusOK, groups)
+}
+
+func (s *Server) CreateContactGroup(c *gin.Context) {
+	ctx := c.Request.Context()
+	var genGroup gen.ContactGroup
+	if err := c.ShouldBindJSON(&genGroup); err != nil {
+		c.IndentedJSON(http.StatusBadRequest, gin.H{"message": "Invalid request body", "error": err.Error()})
+		return
+	}
+
+	group := alerting.ContactGroup{Name: genGroup.Name, UserIDs: genGroup.UserIDs}
+	if !s.validContactGroup(c, &group) {
+		return
+	}
+
+	if err := s.alertService.ServiceCreateContactGroup(ctx, &group); err != nil {
+		if isDuplicateError(err) {
+			c.IndentedJSON(http.StatusConflict, gin.H{"message": "A contact group with that name already exists"})
+			return
+		}
+		slog.Error("error creating contact group", "error", err)
+		c.IndentedJSON(http.StatusInternalServerError, gin.H{"message": "Error creating contact group", "error": err.Error()})
+		return
+	}
+
+	c.IndentedJSON(http.StatusCreated, group)
+}
+
+func (s *Server) UpdateContactGroup(c *gin.Context, id int) {
+	ctx := c.Request.Context()
+	var genGroup gen.ContactGroup
+	if err := c.ShouldBindJSON(&genGroup); err != nil {
+		c.IndentedJSON(http.StatusBadRequest, gin.H{"message": "Invalid request body", "error": err.Error()})
+		return
+	}
+
+	existing, err := s.alertService.ServiceGetContactGroupByID(ctx, id)
+	if err != nil {
+		slog.Error("error fetching contact group for update", "id", id, "error", err)
+		c.IndentedJSON(http.StatusInternalServerError, gin.H{"message": "Error fetching contact group", "error": err.Error()})
+		return
+	}
+	if existing == nil {
+		c.IndentedJSON(http.StatusNotFound, gin.H{"message": "Contact group not found"})
+		return
+	}
+
+	group := alerting.ContactGroup{ID: id, Name: genGroup.Name, UserIDs: genGroup.UserIDs}
+	if !s.validContactGroup(c, &group) {
+		return
+	}
+
+	if err := s.alertService.ServiceUpdateContactGroup(ctx, &group); err != nil {
+		if isDuplicateError(err) {
+			c.IndentedJSON(http.StatusConflict, gin.H{"message": "A contact group with that name already exists"})
+			return
+		}
+		slog.Error("error updating contact group", "error", err)
+		c.IndentedJSON(http.StatusInternalServerError, gin.H{"message": "Error updating contact group", "error": err.Error()})
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, gin.H{"message": "Contact group updated successfully"})
+}
+
+func (s *Server) DeleteContactGroup(c *gin.Context, id int) {
+	ctx := c.Request.Context()
+	if err := s.alertService.ServiceDeleteContactGroup(ctx, id); err != nil {
+		slog.Error("error deleting contact group", "error", err)
+		c.IndentedJSON(http.StatusInternalServerError, gin.H{"message": "Error deleting contact group", "error": err.Error()})
+		return
+	}
+	c.IndentedJSON(http.StatusOK, gin.H{"message": "Contact group deleted successfully"})
+}
+
+// validContactGroup reports whether group is valid and its members exist, writing the
+// error response when it is not.
+func (s *Server) validContactGroup(c *gin.Context, group *alerting.ContactGroup) bool {
+	if group.UserIDs == nil {
+		group.UserIDs = []int{}
+	}
+	if err := group.Validate(); err != nil {
+		c.IndentedJSON(http.StatusBadRequest, gin.H{"message": "Invalid contact group", "error": err.Error()})
+		return false
+	}
+	problem, err := s.unknownUser(c.Request.Context(), group.UserIDs)
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, gin.H{"message": "Error checking contact group members", "error": err.Error()})
+		return false
+	}
+	if problem != "" {
+		c.IndentedJSON(http.StatusBadRequest, gin.H{"message": "Invalid contact group", "error": problem})
+		return false
+	}
+	return true
+}
+
+func toAlertingCompoundRule(r gen.CompoundAlertRule) alerting.CompoundAlertRule {
+	rule := alerting.CompoundAlertRule{
+		Name:             r.Name,
+		Operator:         alerting.CompoundOperator(r.Operator),
+		Enabled:          r.Enabled,
+		RateLimitSeconds: r.RateLimitSeconds,
+		Conditions:       make([]alerting.AlertCondition, 0, len(r.Conditions)),
+	}
+	if r.ID != nil {
+		rule.ID = *r.ID
+	}
+	if r.Hysteresis != nil {
+		rule.Hysteresis = *r.Hysteresis
+	}
+	for _, c := range r.Conditions {
+		condition := alerting.AlertCondition{
+			SensorID:          c.SensorID,
+			MeasurementTypeId: c.MeasurementTypeID,
+			Comparison:        alerting.ConditionComparison(c.Comparison),
+		}
+		if c.Threshold != nil {
+			condition.Threshold = *c.Threshold
+		}
+		if c.Status != nil {
+			condition.Status = *c.Status
+		}
+		rule.Conditions = append(rule.Conditions, condition)
+	}
+	return rule
+}
+
+func (s *Server) GetAllCompoundAlertRules(c *gin.Context) {
+	ctx := c.Request.Context()
+	rules, err := s.alertService.ServiceGetAllCompoundAlertRules(ctx)
+	if err != nil {
+		slog.Error("error fetching compound alert rules", "error", err)
+		c.IndentedJSON(http.StatusInternalServerError, gin.H{"message": "Error fetching compound alert rules", "error": err.Error()})
+		return
+	}
+	if rules == nil {
+		rules = []alerting.CompoundAlertRule{}
+	}
+	c.IndentedJSON(http.StatusOK, rules)
+}
+
+func (s *Server) GetCompoundAlertRuleById(c *gin.Context, id int) {
+	ctx := c.Request.Context()
+	rule, err := s.alertService.ServiceGetCompoundAlertRuleByID(ctx, id)
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, gin.H{"message": "Error fetching compound alert rule", "error": err.Error()})
+		return
+	}
+	if rule == nil {
+		c.IndentedJSON(http.StatusNotFound, gin.H{"message": "Compound alert rule not found"})
+		return
+	}
+	c.IndentedJSON(http.StatusOK, rule)
+}
+
+func (s *Server) CreateCompoundAlertRule(c *gin.Context) {
+	ctx := c.Request.Context()
+	var genRule gen.CompoundAlertRule
+	if err := c.ShouldBindJSON(&genRule); err != nil {
+		c.IndentedJSON(http.StatusBadRequest, gin.H{"message": "Invalid request body", "error": err.Error()})
+		return
+	}
+
+	rule := toAlertingCompoundRule(genRule)
+	rule.ID = 0
+	if err := rule.Validate(); err != nil {
+		c.IndentedJSON(http.StatusBadRequest, gin.H{"message": "Invalid compound alert rule", "error": err.Error()})
+		return
+	}
+
+	if err := s.alertService.ServiceCreateCompoundAlertRule(ctx, &rule); err != nil {
+		slog.Error("error creating compound alert rule", "error", err)
+		c.IndentedJSON(http.StatusInternalServerError, gin.H{"message": "Error creating compound alert rule", "error": err.Error()})
+		return
+	}
+
+	c.IndentedJSON(http.StatusCreated, rule)
+}
+
+func (s *Server) UpdateCompoundAlertRule(c *gin.Context, id int) {
+	ctx := c.Request.Context()
+	var genRule gen.CompoundAlertRule
+	if err := c.ShouldBindJSON(&genRule); err != nil {
+		c.IndentedJSON(http.StatusBadRequest, gin.H{"message": "Invalid request body", "error": err.Error()})
+		return
+	}
+
+	existing, err := s.alertService.ServiceGetCompoundAlertRuleByID(ctx, id)
+	if err != nil {
+		slog.Error("error fetching compound alert rule for update", "id", id, "error", err)
+		c.IndentedJSON(http.StatusInternalServerError, gin.H{"message": "Error fetching compound alert rule", "error": err.Error()})
+		return
+	}
+	if existing == nil {
+		c.IndentedJSON(http.StatusNotFound, gin.H{"message": "Compound alert rule not found"})
+		return
+	}
+
+	rule := toAlertingCompoundRule(genRule)
+	rule.ID = id
+	if err := rule.Validate(); err != nil {
+		c.IndentedJSON(http.StatusBadRequest, gin.H{"message": "Invalid compound alert rule", "error": err.Error()})
+		return
+	}
+
+	if err := s.alertService.ServiceUpdateCompoundAlertRule(ctx, &rule); err != nil {
+		slog.Error("error updating compound alert rule", "error", err)
+		c.IndentedJSON(http.StatusInternalServerError, gin.H{"message": "Error updating compound alert rule", "error": err.Error()})
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, gin.H{"message": "Compound alert rule updated successfully"})
+}
+
+func (s *Server) DeleteCompoundAlertRule(c *gin.Context, id int) {
+	ctx := c.Request.Context()
+	if err := s.alertService.ServiceDeleteCompoundAlertRule(ctx, id); err != nil {
+		slog.Error("error deleting compound alert rule", "error", err)
+		c.IndentedJSON(http.StatusInternalServerError, gin.H{"message": "Error deleting compound alert rule", "error": err.Error()})
+		return
+	}
+	c.IndentedJSON(http.StatusOK, gin.H{"message": "Compound alert rule deleted successfully"})
+}
+
+func (s *Server) AcknowledgeCompoundAlertRule(c *gin.Context, id int) {
+	ctx := c.Request.Context()
+	userID := c.MustGet("currentUser").(*gen.User).Id
+	if !s.compoundAlertRuleExists(c, id) {
+		return
+	}
+
+	acknowledged, err := s.alertService.ServiceAcknowledgeCompoundAlertRule(ctx, id, userID)
+	if err != nil {
+		slog.Error("error acknowledging compound alert rule", "id", id, "error", err)
+		c.IndentedJSON(http.StatusInternalServerError, gin.H{"message": "Error acknowledging compound alert rule", "error": err.Error()})
+		return
+	}
+	if !acknowledged {
+		c.IndentedJSON(http.StatusConflict, gin.H{"message": "Compound alert rule is not firing"})
+		return
+	}
+	c.IndentedJSON(http.StatusOK, gin.H{"message": "Alert acknowledged"})
+}
+
+func (s *Server) SnoozeCompoundAlertRule(c *gin.Context, id int) {
+	var req gen.AlertSnoozeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.IndentedJSON(http.StatusBadRequest, gin.H{"message": "Invalid request body", "error": err.Error()})
+		return
+	}
+	if !req.Until.After(time.Now()) {
+		c.IndentedJSON(http.StatusBadRequest, gin.H{"message": "Snooze time must be in the future"})
+		return
+	}
+	s.setCompoundAlertRuleSnooze(c, id, &req.Until, "Compound alert rule snoozed")
+}
+
+func (s *Server) UnsnoozeCompoundAlertRule(c *gin.Context, id int) {
+	s.setCompoundAlertRuleSnooze(c, id, nil, "Compound alert rule snooze cancelled")
+}
+
+func (s *Server) setCompoundAlertRuleSnooze(c *gin.Context, id int, until *time.Time, message string) {
+	if !s.compoundAlertRuleExists(c, id) {
+		return
+	}
+	if err := s.alertService.ServiceSnoozeCompoundAlertRule(c.Request.Context(), id, until); err != nil {
+		slog.Error("error snoozing compound alert rule", "id", id, "error", err)
+		c.IndentedJSON(http.StatusInternalServerError, gin.H{"message": "Error snoozing compound alert rule", "error": err.Error()})
+		return
+	}
+	c.IndentedJSON(http.StatusOK, gin.H{"message": message})
+}
+
+func (s *Server) GetCompoundAlertEscalationPolicy(c *gin.Context, id int) {
+	ctx := c.Request.Context()
+	if !s.compoundAlertRuleExists(c, id) {
+		return
+	}
+
+	steps, err := s.alertService.ServiceGetCompoundEscalationSteps(ctx, id)
+	if err != nil {
+		slog.Error("error fetching compound escalation policy", "id", id, "error", err)
+		c.IndentedJSON(http.StatusInternalServerError, gin.H{"message": "Error fetching escalation policy", "error": err.Error()})
+		return
+	}
+	c.IndentedJSON(http.StatusOK, gin.H{"Steps": steps})
+}
+
+func (s *Server) SetCompoundAlertEscalationPolicy(c *gin.Context, id int) {
+	var req gen.EscalationPolicy
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.IndentedJSON(http.StatusBadRequest, gin.H{"message": "Invalid request body", "error": err.Error()})
+		return
+	}
+	if !s.compoundAlertRuleExists(c, id) {
+		return
+	}
+
+	steps, ok := s.validEscalationSteps(c, req.Steps)
+	if !ok {
+		return
+	}
+	s.setEscalationSteps(c, s.alertService.ServiceSetCompoundEscalationSteps, id, steps, "Escalation policy updated")
+}
+
+func (s *Server) DeleteCompoundAlertEscalationPolicy(c *gin.Context, id int) {
+	if !s.compoundAlertRuleExists(c, id) {
+		return
+	}
+	s.setEscalationSteps(c, s.alertService.ServiceSetCompoundEscalationSteps, id, nil, "Escalation policy deleted")
+}
+
+func (s *Server) GetCompoundAlertRecipients(c *gin.Context, id int) {
+	ctx := c.Request.Context()
+	if !s.compoundAlertRuleExists(c, id) {
+		return
+	}
+
+	recipients, err := s.alertService.ServiceGetCompoundAlertRecipients(ctx, id)
+	if err != nil {
+		slog.Error("error fetching compound alert recipients", "id", id, "error", err)
+		c.IndentedJSON(http.StatusInternalServerError, gin.H{"message": "Error fetching alert recipients", "error": err.Error()})
+		return
+	}
+	c.IndentedJSON(http.StatusOK, recipients)
+}
+
+func (s *Server) SetCompoundAlertRecipients(c *gin.Context, id int) {
+	var req gen.AlertRecipients
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.IndentedJSON(http.StatusBadRequest, gin.H{"message": "Invalid request body", "error": err.Error()})
+		return
+	}
+	if !s.compoundAlertRuleExists(c, id) {
+		return
+	}
+
+	recipients, ok := s.validAlertRecipients(c, req)
+	if !ok {
+		return
+	}
+	s.setAlertRecipients(c, s.alertService.ServiceSetCompoundAlertRecipients, id, recipients, "Alert recipients updated")
+}
+
+func (s *Server) DeleteCompoundAlertRecipients(c *gin.Context, id int) {
+	if !s.compoundAlertRuleExists(c, id) {
+		return
+	}
+	s.setAlertRecipients(c, s.alertService.ServiceSetCompoundAlertRecipients, id, alerting.AlertRecipients{}, "Alert recipients deleted")
+}
+
+// compoundAlertRuleExists reports whether the compound rule exists, writing the error
+// response when it does not or cannot be fetched.
+func (s *Server) compoundAlertRuleExists(c *gin.Context, id int) bool {
+	rule, err := s.alertService.ServiceGetCompoundAlertRuleByID(c.Request.Context(), id)
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, gin.H{"message": "Error fetching compound alert rule", "error": err.Error()})
+		return false
+	}
+	if rule == nil {
+		c.IndentedJSON(http.StatusNotFound, gin.H{"message": "Compound alert rule not found"})
+		return false
+	}
+	return true
+}
@@ -5,6 +5,7 @@ import (
 	"context"
 	"encoding/json"
 	"example/sensorHub/alerting"
+	db "example/sensorHub/db"
 	gen "example/sensorHub/gen"
 	"fmt"
 	"net/http"
@@ -68,6 +69,158 @@ func (m *mockAlertManagementService) ServiceGetAlertHistory(ctx context.Context,
 	return args.Get(0).([]gen.AlertHistoryEntry), args.Error(1)
 }
 
+func (m *mockAlertManagementService) ServiceGetAllCompoundAlertRules(ctx context.Context) ([]alerting.CompoundAlertRule, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]alerting.CompoundAlertRule), args.Error(1)
+}
+
+func (m *mockAlertManagementService) ServiceGetCompoundAlertRuleByID(ctx context.Context, ruleID int) (*alerting.CompoundAlertRule, error) {
+	args := m.Called(ctx, ruleID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*alerting.CompoundAlertRule), args.Error(1)
+}
+
+func (m *mockAlertManagementService) ServiceCreateCompoundAlertRule(ctx context.Context, rule *alerting.CompoundAlertRule) error {
+	args := m.Called(ctx, rule)
+	return args.Error(0)
+}
+
+func (m *mockAlertManagementService) ServiceUpdateCompoundAlertRule(ctx context.Context, rule *alerting.CompoundAlertRule) error {
+	args := m.Called(ctx, rule)
+	return args.Error(0)
+}
+
+func (m *mockAlertManagementService) ServiceDeleteCompoundAlertRule(ctx context.Context, ruleID int) error {
+	args := m.Called(ctx, ruleID)
+	return args.Error(0)
+}
+
+func (m *mockAlertManagementService) ServiceAcknowledgeAlertRule(ctx context.Context, ruleID, userID int) (bool, error) {
+	args := m.Called(ctx, ruleID, userID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *mockAlertManagementService) ServiceAcknowledgeAlertHistoryEntry(ctx context.Context, entryID, userID int) (bool, error) {
+	args := m.Called(ctx, entryID, userID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *mockAlertManagementService) ServiceSnoozeAlertRule(ctx context.Context, ruleID int, until *time.Time) error {
+	args := m.Called(ctx, ruleID, until)
+	return args.Error(0)
+}
+
+func (m *mockAlertManagementService) ServiceAcknowledgeCompoundAlertRule(ctx context.Context, ruleID, userID int) (bool, error) {
+	args := m.Called(ctx, ruleID, userID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *mockAlertManagementService) ServiceSnoozeCompoundAlertRule(ctx context.Context, ruleID int, until *time.Time) error {
+	args := m.Called(ctx, ruleID, until)
+	return args.Error(0)
+}
+
+func (m *mockAlertManagementService) ServiceGetAllSilenceWindows(ctx context.Context) ([]alerting.SilenceWindow, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]alerting.SilenceWindow), args.Error(1)
+}
+
+func (m *mockAlertManagementService) ServiceGetSilenceWindowByID(ctx context.Context, windowID int) (*alerting.SilenceWindow, error) {
+	args := m.Called(ctx, windowID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*alerting.SilenceWindow), args.Error(1)
+}
+
+func (m *mockAlertManagementService) ServiceCreateSilenceWindow(ctx context.Context, window *alerting.SilenceWindow) error {
+	args := m.Called(ctx, window)
+	return args.Error(0)
+}
+
+func (m *mockAlertManagementService) ServiceUpdateSilenceWindow(ctx context.Context, window *alerting.SilenceWindow) error {
+	args := m.Called(ctx, window)
+	return args.Error(0)
+}
+
+func (m *mockAlertManagementService) ServiceDeleteSilenceWindow(ctx context.Context, windowID int) error {
+	args := m.Called(ctx, windowID)
+	return args.Error(0)
+}
+
+func (m *mockAlertManagementService) ServiceGetEscalationSteps(ctx context.Context, ruleID int) ([]alerting.EscalationStep, error) {
+	args := m.Called(ctx, ruleID)
+	return args.Get(0).([]alerting.EscalationStep), args.Error(1)
+}
+
+func (m *mockAlertManagementService) ServiceSetEscalationSteps(ctx context.Context, ruleID int, steps []alerting.EscalationStep) error {
+	args := m.Called(ctx, ruleID, steps)
+	return args.Error(0)
+}
+
+func (m *mockAlertManagementService) ServiceGetAlertRecipients(ctx context.Context, ruleID int) (alerting.AlertRecipients, error) {
+	args := m.Called(ctx, ruleID)
+	return args.Get(0).(alerting.AlertRecipients), args.Error(1)
+}
+
+func (m *mockAlertManagementService) ServiceSetAlertRecipients(ctx context.Context, ruleID int, recipients alerting.AlertRecipients) error {
+	args := m.Called(ctx, ruleID, recipients)
+	return args.Error(0)
+}
+
+func (m *mockAlertManagementService) ServiceGetCompoundEscalationSteps(ctx context.Context, ruleID int) ([]alerting.EscalationStep, error) {
+	args := m.Called(ctx, ruleID)
+	return args.Get(0).([]alerting.EscalationStep), args.Error(1)
+}
+
+func (m *mockAlertManagementService) ServiceSetCompoundEscalationSteps(ctx context.Context, ruleID int, steps []alerting.EscalationStep) error {
+	args := m.Called(ctx, ruleID, steps)
+	return args.Error(0)
+}
+
+func (m *mockAlertManagementService) ServiceGetCompoundAlertRecipients(ctx context.Context, ruleID int) (alerting.AlertRecipients, error) {
+	args := m.Called(ctx, ruleID)
+	return args.Get(0).(alerting.AlertRecipients), args.Error(1)
+}
+
+func (m *mockAlertManagementService) ServiceSetCompoundAlertRecipients(ctx context.Context, ruleID int, recipients alerting.AlertRecipients) error {
+	args := m.Called(ctx, ruleID, recipients)
+	return args.Error(0)
+}
+
+func (m *mockAlertManagementService) ServiceGetAllContactGroups(ctx context.Context) ([]alerting.ContactGroup, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]alerting.ContactGroup), args.Error(1)
+}
+
+func (m *mockAlertManagementService) ServiceGetContactGroupByID(ctx context.Context, groupID int) (*alerting.ContactGroup, error) {
+	args := m.Called(ctx, groupID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*alerting.ContactGroup), args.Error(1)
+}
+
+func (m *mockAlertManagementService) ServiceCreateContactGroup(ctx context.Context, group *alerting.ContactGroup) error {
+	args := m.Called(ctx, group)
+	return args.Error(0)
+}
+
+func (m *mockAlertManagementService) ServiceUpdateContactGroup(ctx context.Context, group *alerting.ContactGroup) error {
+	args := m.Called(ctx, group)
+	return args.Error(0)
+}
+
+func (m *mockAlertManagementService) ServiceDeleteContactGroup(ctx context.Context, groupID int) error {
+	args := m.Called(ctx, groupID)
+	return args.Error(0)
+}
+
 // setupAlertByIDRoute wraps GetAlertRuleById in the route closure that parses the path id.
 func setupAlertByIDRoute(s *Server) *gin.Engine {
 	router := gin.New()
@@ -448,6 +601,33 @@ func TestUpdateAlertRule(t *testing.T) {
 	mockService.AssertExpectations(t)
 }
 
+func TestUpdateAlertRule_KeepsLookbackSettingsWhenOmitted(t *testing.T) {
+	mockService := new(mockAlertManagementService)
+	s := &Server{alertService: mockService}
+
+	existing := &alerting.AlertRule{ID: 1, SensorID: 1, MeasurementTypeId: 1, AlertType: alerting.AlertTypeSustainedRange, HighThreshold: 30, LowThreshold: 10, DurationSeconds: 600}
+	mockService.On("ServiceGetAlertRuleByID", mock.Anything, 1).Return(existing, nil)
+	mockService.On("ServiceUpdateAlertRule", mock.Anything, mock.MatchedBy(func(r *alerting.AlertRule) bool {
+		return r.AlertType == alerting.AlertTypeSustainedRange && r.DurationSeconds == 600 && r.HighThreshold == 32
+	})).Return(nil)
+
+	router := setupUpdateAlertRoute(s)
+
+	body, _ := json.Marshal(gen.AlertRule{
+		AlertType:     gen.SustainedRange,
+		HighThreshold: 32.0,
+		LowThreshold:  10.0,
+		Enabled:       true,
+	})
+	req := httptest.NewRequest("PUT", "/api/alerts/1", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	mockService.AssertExpectations(t)
+}
+
 func TestUpdateAlertRule_InvalidID(t *testing.T) {
 	s := new(Server)
 	router := setupUpdateAlertRoute(s)
@@ -712,3 +892,489 @@ func TestGetAlertHistory_ServiceError(t *testing.T) {
 	mockService.AssertExpectations(t)
 }
 
+
+// ─── Compound alert rules ────────────────────────────────────────────────────
+
+func windowOpenInTheColdBody() map[string]any {
+	return map[string]any{
+		"Name":             "Window open in the cold",
+		"Operator":         "and",
+		"Enabled":          true,
+		"RateLimitSeconds": 600,
+		"Conditions": []map[string]any{
+			{"SensorID": 1, "MeasurementTypeID": 3, "Comparison": "==", "Status": "false"},
+			{"SensorID": 2, "MeasurementTypeID": 1, "Comparison": "<", "Threshold": 5.0},
+		},
+	}
+}
+
+func TestGetAllCompoundAlertRules(t *testing.T) {
+	mockService := new(mockAlertManagementService)
+	s := &Server{alertService: mockService}
+
+	mockService.On("ServiceGetAllCompoundAlertRules", mock.Anything).Return([]alerting.CompoundAlertRule{
+		{ID: 1, Name: "Window open in the cold", Operator: alerting.CompoundOperatorAnd},
+	}, nil)
+
+	router := setupTestRouter("/alerts/compound", s.GetAllCompoundAlertRules)
+	req := httptest.NewRequest("GET", "/api/alerts/compound", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), "Window open in the cold")
+	mockService.AssertExpectations(t)
+}
+
+func TestCreateCompoundAlertRule(t *testing.T) {
+	mockService := new(mockAlertManagementService)
+	s := &Server{alertService: mockService}
+
+	var captured *alerting.CompoundAlertRule
+	mockService.On("ServiceCreateCompoundAlertRule", mock.Anything, mock.AnythingOfType("*alerting.CompoundAlertRule")).
+		Run(func(args mock.Arguments) {
+			captured = args.Get(1).(*alerting.CompoundAlertRule)
+			captured.ID = 9
+		}).
+		Return(nil)
+
+	router := gin.New()
+	router.Group("/api").POST("/alerts/compound", s.CreateCompoundAlertRule)
+
+	body, _ := json.Marshal(windowOpenInTheColdBody())
+	req := httptest.NewRequest("POST", "/api/alerts/compound", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+	assert.Contains(t, w.Body.String(), `"ID": 9`)
+	if assert.NotNil(t, captured) {
+		assert.Equal(t, alerting.CompoundOperatorAnd, captured.Operator)
+		assert.Len(t, captured.Conditions, 2)
+		assert.Equal(t, "false", captured.Conditions[0].Status)
+		assert.Equal(t, alerting.ComparisonLessThan, captured.Conditions[1].Comparison)
+		assert.Equal(t, 5.0, captured.Conditions[1].Threshold)
+	}
+	mockService.AssertExpectations(t)
+}
+
+func TestCreateCompoundAlertRule_ValidationError(t *testing.T) {
+	s := new(Server)
+	router := gin.New()
+	router.Group("/api").POST("/alerts/compound", s.CreateCompoundAlertRule)
+
+	rule := windowOpenInTheColdBody()
+	rule["Conditions"] = rule["Conditions"].([]map[string]any)[:1]
+	body, _ := json.Marshal(rule)
+	req := httptest.NewRequest("POST", "/api/alerts/compound", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), "at least two conditions")
+}
+
+func TestUpdateCompoundAlertRule_NotFound(t *testing.T) {
+	mockService := new(mockAlertManagementService)
+	s := &Server{alertService: mockService}
+
+	mockService.On("ServiceGetCompoundAlertRuleByID", mock.Anything, 999).Return(nil, nil)
+
+	router := gin.New()
+	router.Group("/api").PUT("/alerts/compound/:id", func(c *gin.Context) {
+		id, _ := strconv.Atoi(c.Param("id"))
+		s.UpdateCompoundAlertRule(c, id)
+	})
+
+	body, _ := json.Marshal(windowOpenInTheColdBody())
+	req := httptest.NewRequest("PUT", "/api/alerts/compound/999", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	mockService.AssertExpectations(t)
+}
+
+func alertRouterAsUser(method, path string, handler gin.HandlerFunc) *gin.Engine {
+	router := gin.New()
+	router.Group("/api").Handle(method, path, func(c *gin.Context) {
+		c.Set("currentUser", &gen.User{Id: 7})
+		handler(c)
+	})
+	return router
+}
+
+func TestAcknowledgeAlertRule_Success(t *testing.T) {
+	mockService := new(mockAlertManagementService)
+	s := &Server{alertService: mockService}
+
+	mockService.On("ServiceGetAlertRuleByID", mock.Anything, 3).Return(&alerting.AlertRule{ID: 3, State: alerting.AlertStateFiring}, nil)
+	mockService.On("ServiceAcknowledgeAlertRule", mock.Anything, 3, 7).Return(true, nil)
+
+	router := alertRouterAsUser("POST", "/alerts/:id/acknowledge", func(c *gin.Context) { s.AcknowledgeAlertRule(c, 3) })
+	req := httptest.NewRequest("POST", "/api/alerts/3/acknowledge", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	mockService.AssertExpectations(t)
+}
+
+func TestAcknowledgeAlertRule_NotFiring(t *testing.T) {
+	mockService := new(mockAlertManagementService)
+	s := &Server{alertService: mockService}
+
+	mockService.On("ServiceGetAlertRuleByID", mock.Anything, 3).Return(&alerting.AlertRule{ID: 3, State: alerting.AlertStateOK}, nil)
+	mockService.On("ServiceAcknowledgeAlertRule", mock.Anything, 3, 7).Return(false, nil)
+
+	router := alertRouterAsUser("POST", "/alerts/:id/acknowledge", func(c *gin.Context) { s.AcknowledgeAlertRule(c, 3) })
+	req := httptest.NewRequest("POST", "/api/alerts/3/acknowledge", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusConflict, w.Code)
+}
+
+func TestAcknowledgeAlertHistoryEntry_NotFound(t *testing.T) {
+	mockService := new(mockAlertManagementService)
+	s := &Server{alertService: mockService}
+
+	mockService.On("ServiceAcknowledgeAlertHistoryEntry", mock.Anything, 42, 7).Return(false, nil)
+
+	router := alertRouterAsUser("POST", "/alerts/history/:id/acknowledge", func(c *gin.Context) { s.AcknowledgeAlertHistoryEntry(c, 42) })
+	req := httptest.NewRequest("POST", "/api/alerts/history/42/acknowledge", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestSnoozeAlertRule_Success(t *testing.T) {
+	mockService := new(mockAlertManagementService)
+	s := &Server{alertService: mockService}
+	until := time.Now().Add(2 * time.Hour).UTC().Truncate(time.Second)
+
+	mockService.On("ServiceGetAlertRuleByID", mock.Anything, 3).Return(&alerting.AlertRule{ID: 3}, nil)
+	mockService.On("ServiceSnoozeAlertRule", mock.Anything, 3, mock.MatchedBy(func(t *time.Time) bool {
+		return t != nil && t.Equal(until)
+	})).Return(nil)
+
+	router := gin.New()
+	router.Group("/api").POST("/alerts/:id/snooze", func(c *gin.Context) { s.SnoozeAlertRule(c, 3) })
+	body, _ := json.Marshal(map[string]any{"until": until.Format(time.RFC3339)})
+	req := httptest.NewRequest("POST", "/api/alerts/3/snooze", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	mockService.AssertExpectations(t)
+}
+
+func TestSnoozeAlertRule_RejectsPastTime(t *testing.T) {
+	s := &Server{alertService: new(mockAlertManagementService)}
+
+	router := gin.New()
+	router.Group("/api").POST("/alerts/:id/snooze", func(c *gin.Context) { s.SnoozeAlertRule(c, 3) })
+	body, _ := json.Marshal(map[string]any{"until": time.Now().Add(-time.Hour).Format(time.RFC3339)})
+	req := httptest.NewRequest("POST", "/api/alerts/3/snooze", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestAcknowledgeCompoundAlertRule_Success(t *testing.T) {
+	mockService := new(mockAlertManagementService)
+	s := &Server{alertService: mockService}
+
+	mockService.On("ServiceGetCompoundAlertRuleByID", mock.Anything, 4).Return(&alerting.CompoundAlertRule{ID: 4, State: alerting.AlertStateFiring}, nil)
+	mockService.On("ServiceAcknowledgeCompoundAlertRule", mock.Anything, 4, 7).Return(true, nil)
+
+	router := alertRouterAsUser("POST", "/alerts/compound/:id/acknowledge", func(c *gin.Context) { s.AcknowledgeCompoundAlertRule(c, 4) })
+	req := httptest.NewRequest("POST", "/api/alerts/compound/4/acknowledge", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	mockService.AssertExpectations(t)
+}
+
+func TestSnoozeCompoundAlertRule_NotFound(t *testing.T) {
+	mockService := new(mockAlertManagementService)
+	s := &Server{alertService: mockService}
+
+	mockService.On("ServiceGetCompoundAlertRuleByID", mock.Anything, 4).Return(nil, nil)
+
+	router := gin.New()
+	router.Group("/api").POST("/alerts/compound/:id/snooze", func(c *gin.Context) { s.SnoozeCompoundAlertRule(c, 4) })
+	body, _ := json.Marshal(map[string]any{"until": time.Now().Add(time.Hour).Format(time.RFC3339)})
+	req := httptest.NewRequest("POST", "/api/alerts/compound/4/snooze", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	mockService.AssertNotCalled(t, "ServiceSnoozeCompoundAlertRule", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestCreateSilenceWindow_Success(t *testing.T) {
+	mockService := new(mockAlertManagementService)
+	s := &Server{alertService: mockService}
+
+	var captured *alerting.SilenceWindow
+	mockService.On("ServiceCreateSilenceWindow", mock.Anything, mock.AnythingOfType("*alerting.SilenceWindow")).
+		Run(func(args mock.Arguments) {
+			captured = args.Get(1).(*alerting.SilenceWindow)
+			captured.ID = 4
+		}).
+		Return(nil)
+
+	router := gin.New()
+	router.Group("/api").POST("/alerts/silences", s.CreateSilenceWindow)
+	body, _ := json.Marshal(map[string]any{
+		"Name": "Garage door mornings", "SensorID": 3, "Days": []string{"mon", "tue", "wed", "thu", "fri"},
+		"StartTime": "07:00", "EndTime": "09:00", "Enabled": true,
+	})
+	req := httptest.NewRequest("POST", "/api/alerts/silences", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+	assert.Contains(t, w.Body.String(), `"ID": 4`)
+	if assert.NotNil(t, captured) {
+		assert.Equal(t, 3, *captured.SensorID)
+		assert.Len(t, captured.Days, 5)
+	}
+}
+
+func TestCreateSilenceWindow_ValidationError(t *testing.T) {
+	s := new(Server)
+	router := gin.New()
+	router.Group("/api").POST("/alerts/silences", s.CreateSilenceWindow)
+	body, _ := json.Marshal(map[string]any{"Name": "Bad", "StartTime": "7am", "EndTime": "09:00", "Enabled": true})
+	req := httptest.NewRequest("POST", "/api/alerts/silences", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), "must be HH:MM")
+}
+
+func TestSetAlertEscalationPolicy_Success(t *testing.T) {
+	mockService := new(mockAlertManagementService)
+	mockRoles := new(MockRoleService)
+	s := &Server{alertService: mockService, roleService: mockRoles}
+
+	mockService.On("ServiceGetAlertRuleByID", mock.Anything, 3).Return(&alerting.AlertRule{ID: 3}, nil)
+	mockRoles.On("ListRoles", mock.Anything).Return([]db.RoleInfo{{Id: 1, Name: "admin"}, {Id: 2, Name: "user"}}, nil)
+	mockService.On("ServiceSetEscalationSteps", mock.Anything, 3, []alerting.EscalationStep{
+		{DelayMinutes: 10, Channels: []alerting.EscalationChannel{}},
+		{DelayMinutes: 20, Role: "admin", Channels: []alerting.EscalationChannel{alerting.EscalationChannelPush}},
+	}).Return(nil)
+
+	router := gin.New()
+	router.Group("/api").PUT("/alerts/:id/escalation", func(c *gin.Context) { s.SetAlertEscalationPolicy(c, 3) })
+	body, _ := json.Marshal(map[string]any{"Steps": []map[string]any{
+		{"DelayMinutes": 10},
+		{"DelayMinutes": 20, "Role": "admin", "Channels": []string{"push"}},
+	}})
+	req := httptest.NewRequest("PUT", "/api/alerts/3/escalation", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	mockService.AssertExpectations(t)
+}
+
+func TestSetAlertEscalationPolicy_UnknownRole(t *testing.T) {
+	mockService := new(mockAlertManagementService)
+	mockRoles := new(MockRoleService)
+	s := &Server{alertService: mockService, roleService: mockRoles}
+
+	mockService.On("ServiceGetAlertRuleByID", mock.Anything, 3).Return(&alerting.AlertRule{ID: 3}, nil)
+	mockRoles.On("ListRoles", mock.Anything).Return([]db.RoleInfo{{Id: 1, Name: "admin"}}, nil)
+
+	router := gin.New()
+	router.Group("/api").PUT("/alerts/:id/escalation", func(c *gin.Context) { s.SetAlertEscalationPolicy(c, 3) })
+	body, _ := json.Marshal(map[string]any{"Steps": []map[string]any{{"DelayMinutes": 10, "Role": "on-call"}}})
+	req := httptest.NewRequest("PUT", "/api/alerts/3/escalation", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), "unknown role")
+	mockService.AssertNotCalled(t, "ServiceSetEscalationSteps", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestSetAlertEscalationPolicy_InvalidDelay(t *testing.T) {
+	mockService := new(mockAlertManagementService)
+	s := &Server{alertService: mockService}
+
+	mockService.On("ServiceGetAlertRuleByID", mock.Anything, 3).Return(&alerting.AlertRule{ID: 3}, nil)
+
+	router := gin.New()
+	router.Group("/api").PUT("/alerts/:id/escalation", func(c *gin.Context) { s.SetAlertEscalationPolicy(c, 3) })
+	body, _ := json.Marshal(map[string]any{"Steps": []map[string]any{{"DelayMinutes": 0}}})
+	req := httptest.NewRequest("PUT", "/api/alerts/3/escalation", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), "delay minutes must be positive")
+}
+
+func TestGetAlertEscalationPolicy_NotFound(t *testing.T) {
+	mockService := new(mockAlertManagementService)
+	s := &Server{alertService: mockService}
+
+	mockService.On("ServiceGetAlertRuleByID", mock.Anything, 9).Return(nil, nil)
+
+	router := gin.New()
+	router.Group("/api").GET("/alerts/:id/escalation", func(c *gin.Context) { s.GetAlertEscalationPolicy(c, 9) })
+	req := httptest.NewRequest("GET", "/api/alerts/9/escalation", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestSetAlertRecipients_Success(t *testing.T) {
+	mockService := new(mockAlertManagementService)
+	mockUsers := new(MockUserService)
+	mockRoles := new(MockRoleService)
+	s := &Server{alertService: mockService, userService: mockUsers, roleService: mockRoles}
+
+	mockService.On("ServiceGetAlertRuleByID", mock.Anything, 3).Return(&alerting.AlertRule{ID: 3}, nil)
+	mockUsers.On("ListUsers", mock.Anything).Return([]gen.User{{Id: 2, Username: "bob"}}, nil)
+	mockRoles.On("ListRoles", mock.Anything).Return([]db.RoleInfo{{Id: 1, Name: "admin"}}, nil)
+	mockService.On("ServiceGetAllContactGroups", mock.Anything).Return([]alerting.ContactGroup{{ID: 7, Name: "Freezer on-call"}}, nil)
+	mockService.On("ServiceSetAlertRecipients", mock.Anything, 3, alerting.AlertRecipients{
+		UserIDs:         []int{2},
+		Roles:           []string{"admin"},
+		ContactGroupIDs: []int{7},
+	}).Return(nil)
+
+	router := gin.New()
+	router.Group("/api").PUT("/alerts/:id/recipients", func(c *gin.Context) { s.SetAlertRecipients(c, 3) })
+	body, _ := json.Marshal(map[string]any{"UserIDs": []int{2}, "Roles": []string{"admin"}, "ContactGroupIDs": []int{7}})
+	req := httptest.NewRequest("PUT", "/api/alerts/3/recipients", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	mockService.AssertExpectations(t)
+}
+
+func TestSetAlertRecipients_UnknownContactGroup(t *testing.T) {
+	mockService := new(mockAlertManagementService)
+	s := &Server{alertService: mockService}
+
+	mockService.On("ServiceGetAlertRuleByID", mock.Anything, 3).Return(&alerting.AlertRule{ID: 3}, nil)
+	mockService.On("ServiceGetAllContactGroups", mock.Anything).Return([]alerting.ContactGroup{}, nil)
+
+	router := gin.New()
+	router.Group("/api").PUT("/alerts/:id/recipients", func(c *gin.Context) { s.SetAlertRecipients(c, 3) })
+	body, _ := json.Marshal(map[string]any{"ContactGroupIDs": []int{7}})
+	req := httptest.NewRequest("PUT", "/api/alerts/3/recipients", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), "unknown contact group 7")
+	mockService.AssertNotCalled(t, "ServiceSetAlertRecipients", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestSetCompoundAlertRecipients_Success(t *testing.T) {
+	mockService := new(mockAlertManagementService)
+	mockRoles := new(MockRoleService)
+	s := &Server{alertService: mockService, roleService: mockRoles}
+
+	mockService.On("ServiceGetCompoundAlertRuleByID", mock.Anything, 4).Return(&alerting.CompoundAlertRule{ID: 4}, nil)
+	mockRoles.On("ListRoles", mock.Anything).Return([]db.RoleInfo{{Id: 1, Name: "admin"}}, nil)
+	mockService.On("ServiceSetCompoundAlertRecipients", mock.Anything, 4, alerting.AlertRecipients{
+		UserIDs:         []int{},
+		Roles:           []string{"admin"},
+		ContactGroupIDs: []int{},
+	}).Return(nil)
+
+	router := gin.New()
+	router.Group("/api").PUT("/alerts/compound/:id/recipients", func(c *gin.Context) { s.SetCompoundAlertRecipients(c, 4) })
+	body, _ := json.Marshal(map[string]any{"Roles": []string{"admin"}})
+	req := httptest.NewRequest("PUT", "/api/alerts/compound/4/recipients", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	mockService.AssertExpectations(t)
+}
+
+func TestSetCompoundAlertEscalationPolicy_NotFound(t *testing.T) {
+	mockService := new(mockAlertManagementService)
+	s := &Server{alertService: mockService}
+
+	mockService.On("ServiceGetCompoundAlertRuleByID", mock.Anything, 4).Return(nil, nil)
+
+	router := gin.New()
+	router.Group("/api").PUT("/alerts/compound/:id/escalation", func(c *gin.Context) { s.SetCompoundAlertEscalationPolicy(c, 4) })
+	body, _ := json.Marshal(map[string]any{"Steps": []map[string]any{{"DelayMinutes": 10}}})
+	req := httptest.NewRequest("PUT", "/api/alerts/compound/4/escalation", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	mockService.AssertNotCalled(t, "ServiceSetCompoundEscalationSteps", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestCreateContactGroup_UnknownUser(t *testing.T) {
+	mockService := new(mockAlertManagementService)
+	mockUsers := new(MockUserService)
+	s := &Server{alertService: mockService, userService: mockUsers}
+
+	mockUsers.On("ListUsers", mock.Anything).Return([]gen.User{{Id: 2, Username: "bob"}}, nil)
+
+	router := gin.New()
+	router.Group("/api").POST("/alerts/contact-groups", s.CreateContactGroup)
+	body, _ := json.Marshal(map[string]any{"Name": "Freezer on-call", "UserIDs": []int{2, 9}})
+	req := httptest.NewRequest("POST", "/api/alerts/contact-groups", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), "unknown user 9")
+	mockService.AssertNotCalled(t, "ServiceCreateContactGroup", mock.Anything, mock.Anything)
+}
+
+func TestCreateContactGroup_DuplicateName(t *testing.T) {
+	mockService := new(mockAlertManagementService)
+	mockUsers := new(MockUserService)
+	s := &Server{alertService: mockService, userService: mockUsers}
+
+	mockUsers.On("ListUsers", mock.Anything).Return([]gen.User{{Id: 2, Username: "bob"}}, nil)
+	mockService.On("ServiceCreateContactGroup", mock.Anything, mock.Anything).
+		Return(fmt.Errorf("failed to create contact group: UNIQUE constraint failed: contact_groups.name"))
+
+	router := gin.New()
+	router.Group("/api").POST("/alerts/contact-groups", s.CreateContactGroup)
+	body, _ := json.Marshal(map[string]any{"Name": "Freezer on-call", "UserIDs": []int{2}})
+	req := httptest.NewRequest("POST", "/api/alerts/contact-groups", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusConflict, w.Code)
+}
@@ -0,0 +1,202 @@
+package api
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"example/sensorHub/automation"
+	gen "example/sensorHub/gen"
+	"example/sensorHub/scheduling"
+	"example/sensorHub/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+func toAutomationRule(r gen.AutomationRule) automation.Rule {
+	rule := automation.Rule{
+		Name:              r.Name,
+		Enabled:           r.Enabled,
+		TriggerType:       automation.TriggerType(r.TriggerType),
+		SensorID:          r.SensorId,
+		MeasurementTypeID: r.MeasurementTypeId,
+		Threshold:         r.Threshold,
+		AlertRuleID:       r.AlertRuleId,
+		ScheduleDays:      []string{},
+		ScheduleHoliday:   scheduling.HolidaySkip,
+		TargetSensorID:    r.TargetSensorId,
+		SceneID:           r.SceneId,
+	}
+	if r.Property != nil {
+		rule.Property = *r.Property
+	}
+	if r.Value != nil {
+		rule.Value = *r.Value
+	}
+	if r.Comparison != nil {
+		rule.Comparison = automation.Comparison(*r.Comparison)
+	}
+	if r.Status != nil {
+		rule.Status = *r.Status
+	}
+	if r.ScheduleTime != nil {
+		rule.ScheduleTime = *r.ScheduleTime
+	}
+	if r.ScheduleDays != nil {
+		for _, day := range *r.ScheduleDays {
+			rule.ScheduleDays = append(rule.ScheduleDays, string(day))
+		}
+	}
+	if r.ScheduleHoliday != nil {
+		rule.ScheduleHoliday = scheduling.HolidayBehaviour(*r.ScheduleHoliday)
+	}
+	return rule
+}
+
+func toGenAutomationRule(r automation.Rule) gen.AutomationRule {
+	id := r.ID
+	active := r.Active
+	sensorName := r.SensorName
+	measurementType := r.MeasurementType
+	comparison := gen.AutomationRuleComparison(r.Comparison)
+	status := r.Status
+	scheduleTime := r.ScheduleTime
+	scheduleHoliday := gen.AutomationRuleScheduleHoliday(r.ScheduleHoliday)
+	days := make([]gen.AutomationRuleScheduleDays, len(r.ScheduleDays))
+	for i, day := range r.ScheduleDays {
+		days[i] = gen.AutomationRuleScheduleDays(day)
+	}
+	rule := gen.AutomationRule{
+		Id:                &id,
+		Name:              r.Name,
+		Enabled:           r.Enabled,
+		TriggerType:       gen.AutomationRuleTriggerType(r.TriggerType),
+		SensorId:          r.SensorID,
+		SensorName:        &sensorName,
+		MeasurementTypeId: r.MeasurementTypeID,
+		MeasurementType:   &measurementType,
+		Threshold:         r.Threshold,
+		Status:            &status,
+		AlertRuleId:       r.AlertRuleID,
+		ScheduleTime:      &scheduleTime,
+		ScheduleDays:      &days,
+		ScheduleHoliday:   &scheduleHoliday,
+		TargetSensorId:    r.TargetSensorID,
+		SceneId:           r.SceneID,
+		Active:            &active,
+		LastTriggeredAt:   r.LastTriggeredAt,
+	}
+	if r.Comparison != "" {
+		rule.Comparison = &comparison
+	}
+	if r.SceneID != nil {
+		sceneName := r.SceneName
+		rule.SceneName = &sceneName
+	} else {
+		targetSensorName := r.TargetSensorName
+		property := r.Property
+		value := r.Value
+		rule.TargetSensorName = &targetSensorName
+		rule.Property = &property
+		rule.Value = &value
+	}
+	return rule
+}
+
+// automationErrorStatus maps automation service errors to HTTP statuses.
+func automationErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, service.ErrAutomationRuleNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, service.ErrInvalidAutomationRule):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+func (s *Server) GetAllAutomationRules(c *gin.Context) {
+	ctx := c.Request.Context()
+	rules, err := s.automationService.ServiceGetAllAutomationRules(ctx)
+	if err != nil {
+		slog.Error("error listing automation rules", "error", err)
+		c.IndentedJSON(http.StatusInternalServerError, gin.H{"message": "Error listing automation rules", "error": err.Error()})
+		return
+	}
+	result := make([]gen.AutomationRule, len(rules))
+	for i, r := range rules {
+		result[i] = toGenAutomationRule(r)
+	}
+	c.IndentedJSON(http.StatusOK, result)
+}
+
+func (s *Server) GetAutomationRuleById(c *gin.Context, id int) {
+	ctx := c.Request.Context()
+	rule, err := s.automationService.ServiceGetAutomationRuleByID(ctx, id)
+	if err != nil {
+		c.IndentedJSON(automationErrorStatus(err), gin.H{"message": "Error fetching automation rule", "error": err.Error()})
+		return
+	}
+	c.IndentedJSON(http.StatusOK, toGenAutomationRule(*rule))
+}
+
+func (s *Server) CreateAutomationRule(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req gen.AutomationRule
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.IndentedJSON(http.StatusBadRequest, gin.H{"message": "Invalid request body", "error": err.Error()})
+		return
+	}
+	rule := toAutomationRule(req)
+	if err := rule.Validate(); err != nil {
+		c.IndentedJSON(http.StatusBadRequest, gin.H{"message": "Invalid automation rule", "error": err.Error()})
+		return
+	}
+
+	if err := s.automationService.ServiceCreateAutomationRule(ctx, &rule); err != nil {
+		slog.Error("error creating automation rule", "error", err)
+		c.IndentedJSON(automationErrorStatus(err), gin.H{"message": "Error creating automation rule", "error": err.Error()})
+		return
+	}
+	created, err := s.automationService.ServiceGetAutomationRuleByID(ctx, rule.ID)
+	if err != nil {
+		c.IndentedJSON(http.StatusCreated, toGenAutomationRule(rule))
+		return
+	}
+	c.IndentedJSON(http.StatusCreated, toGenAutomationRule(*created))
+}
+
+func (s *Server) UpdateAutomationRule(c *gin.Context, id int) {
+	ctx := c.Request.Context()
+
+	var req gen.AutomationRule
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.IndentedJSON(http.StatusBadRequest, gin.H{"message": "Invalid request body", "error": err.Error()})
+		return
+	}
+	rule := toAutomationRule(req)
+	rule.ID = id
+	if err := rule.Validate(); err != nil {
+		c.IndentedJSON(http.StatusBadRequest, gin.H{"message": "Invalid automation rule", "error": err.Error()})
+		return
+	}
+
+	if err := s.automationService.ServiceUpdateAutomationRule(ctx, &rule); err != nil {
+		slog.Error("error updating automation rule", "id", id, "error", err)
+		c.IndentedJSON(automationErrorStatus(err), gin.H{"message": "Error updating automation rule", "error": err.Error()})
+		return
+	}
+	c.IndentedJSON(http.StatusOK, gin.H{"message": "Automation rule updated successfully"})
+}
+
+func (s *Server) DeleteAutomationRule(c *gin.Context, id int) {
+	ctx := c.Request.Context()
+
+	if err := s.automationService.ServiceDeleteAutomationRule(ctx, id); err != nil {
+		slog.Error("error deleting automation rule", "id", id, "error", err)
+		c.IndentedJSON(automationErrorStatus(err), gin.H{"message": "Error deleting automation rule", "error": err.Error()})
+		return
+	}
+	c.IndentedJSON(http.StatusOK, gin.H{"message": "Automation rule deleted successfully"})
+}
@@ -0,0 +1,219 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"example/sensorHub/automation"
+	gen "example/sensorHub/gen"
+	"example/sensorHub/service"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type mockAutomationService struct {
+	mock.Mock
+}
+
+func (m *mockAutomationService) ServiceGetAllAutomationRules(ctx context.Context) ([]automation.Rule, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]automation.Rule), args.Error(1)
+}
+
+func (m *mockAutomationService) ServiceGetAutomationRuleByID(ctx context.Context, ruleID int) (*automation.Rule, error) {
+	args := m.Called(ctx, ruleID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*automation.Rule), args.Error(1)
+}
+
+func (m *mockAutomationService) ServiceCreateAutomationRule(ctx context.Context, rule *automation.Rule) error {
+	args := m.Called(ctx, rule)
+	return args.Error(0)
+}
+
+func (m *mockAutomationService) ServiceUpdateAutomationRule(ctx context.Context, rule *automation.Rule) error {
+	args := m.Called(ctx, rule)
+	return args.Error(0)
+}
+
+func (m *mockAutomationService) ServiceDeleteAutomationRule(ctx context.Context, ruleID int) error {
+	args := m.Called(ctx, ruleID)
+	return args.Error(0)
+}
+
+func setupAutomationRouter(method, path string, handler gin.HandlerFunc) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Handle(method, path, handler)
+	return router
+}
+
+func withAutomationID(h func(*gin.Context, int)) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, _ := strconv.Atoi(c.Param("id"))
+		h(c, id)
+	}
+}
+
+func sampleAutomationRule() automation.Rule {
+	sensorID, measurementTypeID, threshold, targetSensorID := 1, 3, 75.0, 2
+	return automation.Rule{
+		ID: 4, Name: "Dehumidifier on", Enabled: true, TriggerType: automation.TriggerReading,
+		SensorID: &sensorID, SensorName: "bathroom", MeasurementTypeID: &measurementTypeID, MeasurementType: "humidity",
+		Comparison: automation.ComparisonGreaterThan, Threshold: &threshold, ScheduleDays: []string{},
+		TargetSensorID: &targetSensorID, TargetSensorName: "dehumidifier-plug", Property: "state", Value: "ON",
+	}
+}
+
+const automationRuleBody = `{"name":"Dehumidifier on","enabled":true,"trigger_type":"reading","sensor_id":1,
+	"measurement_type_id":3,"comparison":">","threshold":75,"target_sensor_id":2,"property":"state","value":"ON"}`
+
+func TestGetAllAutomationRulesHandler(t *testing.T) {
+	mockSvc := new(mockAutomationService)
+	s := &Server{automationService: mockSvc}
+	mockSvc.On("ServiceGetAllAutomationRules", mock.Anything).Return([]automation.Rule{sampleAutomationRule()}, nil)
+
+	router := setupAutomationRouter("GET", "/automations", s.GetAllAutomationRules)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest("GET", "/automations", nil))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	var rules []gen.AutomationRule
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &rules))
+	require.Len(t, rules, 1)
+	assert.Equal(t, "dehumidifier-plug", *rules[0].TargetSensorName)
+	assert.Equal(t, gen.AutomationComparisonGreaterThan, *rules[0].Comparison)
+	mockSvc.AssertExpectations(t)
+}
+
+func TestGetAutomationRuleByIdHandler_NotFound(t *testing.T) {
+	mockSvc := new(mockAutomationService)
+	s := &Server{automationService: mockSvc}
+	mockSvc.On("ServiceGetAutomationRuleByID", mock.Anything, 99).Return(nil, service.ErrAutomationRuleNotFound)
+
+	router := setupAutomationRouter("GET", "/automations/:id", withAutomationID(s.GetAutomationRuleById))
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest("GET", "/automations/99", nil))
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestCreateAutomationRuleHandler(t *testing.T) {
+	mockSvc := new(mockAutomationService)
+	s := &Server{automationService: mockSvc}
+	created := sampleAutomationRule()
+	mockSvc.On("ServiceCreateAutomationRule", mock.Anything, mock.MatchedBy(func(r *automation.Rule) bool {
+		return r.Name == "Dehumidifier on" && *r.Threshold == 75 && r.Comparison == automation.ComparisonGreaterThan
+	})).Run(func(args mock.Arguments) {
+		args.Get(1).(*automation.Rule).ID = 4
+	}).Return(nil)
+	mockSvc.On("ServiceGetAutomationRuleByID", mock.Anything, 4).Return(&created, nil)
+
+	router := setupAutomationRouter("POST", "/automations", s.CreateAutomationRule)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest("POST", "/automations", bytes.NewBufferString(automationRuleBody)))
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+	assert.Contains(t, w.Body.String(), `"id": 4`)
+	mockSvc.AssertExpectations(t)
+}
+
+func TestCreateAutomationRuleHandler_InvalidRule(t *testing.T) {
+	mockSvc := new(mockAutomationService)
+	s := &Server{automationService: mockSvc}
+
+	// A reading rule without a threshold.
+	body := `{"name":"Broken","enabled":true,"trigger_type":"reading","sensor_id":1,"measurement_type_id":3,
+		"comparison":">","target_sensor_id":2,"property":"state","value":"ON"}`
+	router := setupAutomationRouter("POST", "/automations", s.CreateAutomationRule)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest("POST", "/automations", bytes.NewBufferString(body)))
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	mockSvc.AssertNotCalled(t, "ServiceCreateAutomationRule", mock.Anything, mock.Anything)
+}
+
+func TestCreateAutomationRuleHandler_UncontrollableTarget(t *testing.T) {
+	mockSvc := new(mockAutomationService)
+	s := &Server{automationService: mockSvc}
+	mockSvc.On("ServiceCreateAutomationRule", mock.Anything, mock.Anything).
+		Return(fmt.Errorf("%w: target sensor 2 is not controllable", service.ErrInvalidAutomationRule))
+
+	router := setupAutomationRouter("POST", "/automations", s.CreateAutomationRule)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest("POST", "/automations", bytes.NewBufferString(automationRuleBody)))
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), "not controllable")
+}
+
+func TestCreateAutomationRuleHandler_SceneTarget(t *testing.T) {
+	mockSvc := new(mockAutomationService)
+	s := &Server{automationService: mockSvc}
+	created := sampleAutomationRule()
+	sceneID := 6
+	created.TargetSensorID, created.TargetSensorName, created.Property, created.Value = nil, "", "", ""
+	created.SceneID, created.SceneName = &sceneID, "Dry out"
+	mockSvc.On("ServiceCreateAutomationRule", mock.Anything, mock.MatchedBy(func(r *automation.Rule) bool {
+		return r.TargetSensorID == nil && *r.SceneID == 6
+	})).Run(func(args mock.Arguments) {
+		args.Get(1).(*automation.Rule).ID = 4
+	}).Return(nil)
+	mockSvc.On("ServiceGetAutomationRuleByID", mock.Anything, 4).Return(&created, nil)
+
+	body := `{"name":"Dry out","enabled":true,"trigger_type":"reading","sensor_id":1,
+		"measurement_type_id":3,"comparison":">","threshold":75,"scene_id":6}`
+	router := setupAutomationRouter("POST", "/automations", s.CreateAutomationRule)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest("POST", "/automations", bytes.NewBufferString(body)))
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+	var rule gen.AutomationRule
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &rule))
+	assert.Equal(t, "Dry out", *rule.SceneName)
+	assert.Nil(t, rule.TargetSensorId)
+	assert.Nil(t, rule.Property)
+	mockSvc.AssertExpectations(t)
+}
+
+func TestUpdateAutomationRuleHandler(t *testing.T) {
+	mockSvc := new(mockAutomationService)
+	s := &Server{automationService: mockSvc}
+	mockSvc.On("ServiceUpdateAutomationRule", mock.Anything, mock.MatchedBy(func(r *automation.Rule) bool {
+		return r.ID == 4
+	})).Return(nil)
+
+	router := setupAutomationRouter("PUT", "/automations/:id", withAutomationID(s.UpdateAutomationRule))
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest("PUT", "/automations/4", bytes.NewBufferString(automationRuleBody)))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	mockSvc.AssertExpectations(t)
+}
+
+func TestDeleteAutomationRuleHandler(t *testing.T) {
+	mockSvc := new(mockAutomationService)
+	s := &Server{automationService: mockSvc}
+	mockSvc.On("ServiceDeleteAutomationRule", mock.Anything, 4).Return(nil)
+
+	router := setupAutomationRouter("DELETE", "/automations/:id", withAutomationID(s.DeleteAutomationRule))
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest("DELETE", "/automations/4", nil))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	mockSvc.AssertExpectations(t)
+}
@@ -0,0 +1,93 @@
+package api
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"example/sensorHub/desiredstate"
+	gen "example/sensorHub/gen"
+	"example/sensorHub/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+func toGenDesiredState(d desiredstate.DesiredState) gen.DesiredState {
+	return gen.DesiredState{
+		SensorId:      d.SensorID,
+		SensorName:    d.SensorName,
+		Property:      d.Property,
+		Value:         d.Value,
+		Status:        gen.DesiredStateStatus(d.Status),
+		Attempts:      d.Attempts,
+		NextRetryAt:   d.NextRetryAt,
+		CommandId:     d.CommandID,
+		ReportedValue: d.ReportedValue,
+		LastError:     d.LastError,
+		UpdatedAt:     d.UpdatedAt,
+	}
+}
+
+// desiredStateErrorStatus maps desired state service errors to HTTP statuses.
+func desiredStateErrorStatus(err error) int {
+	var commandErr *service.CommandError
+	switch {
+	case errors.As(err, &commandErr):
+		return commandErr.StatusCode
+	case errors.Is(err, service.ErrDesiredStateNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, service.ErrInvalidDesiredState):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+func (s *Server) GetSensorDesiredStates(c *gin.Context, id int) {
+	states, err := s.desiredStateService.ServiceGetDesiredStates(c.Request.Context(), id)
+	if err != nil {
+		c.IndentedJSON(desiredStateErrorStatus(err), gin.H{"message": "Error retrieving desired states", "error": err.Error()})
+		return
+	}
+	result := make([]gen.DesiredState, len(states))
+	for i, state := range states {
+		result[i] = toGenDesiredState(state)
+	}
+	c.IndentedJSON(http.StatusOK, result)
+}
+
+func (s *Server) SetSensorDesiredState(c *gin.Context, id int, property string) {
+	var req gen.DesiredStateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.IndentedJSON(http.StatusBadRequest, gin.H{"message": "Invalid request body", "error": err.Error()})
+		return
+	}
+
+	currentUser, exists := c.Get("currentUser")
+	if !exists {
+		c.IndentedJSON(http.StatusUnauthorized, gin.H{"message": "Not authenticated"})
+		return
+	}
+	actor, ok := currentUser.(*gen.User)
+	if !ok || actor == nil {
+		c.IndentedJSON(http.StatusUnauthorized, gin.H{"message": "Not authenticated"})
+		return
+	}
+
+	state, err := s.desiredStateService.ServiceSetDesiredState(c.Request.Context(), id, actor, property, req.Value)
+	if err != nil {
+		slog.Error("error setting desired state", "sensor_id", id, "property", property, "error", err)
+		c.IndentedJSON(desiredStateErrorStatus(err), gin.H{"message": "Error setting desired state", "error": err.Error()})
+		return
+	}
+	c.IndentedJSON(http.StatusOK, toGenDesiredState(*state))
+}
+
+func (s *Server) DeleteSensorDesiredState(c *gin.Context, id int, property string) {
+	if err := s.desiredStateService.ServiceDeleteDesiredState(c.Request.Context(), id, property); err != nil {
+		slog.Error("error clearing desired state", "sensor_id", id, "property", property, "error", err)
+		c.IndentedJSON(desiredStateErrorStatus(err), gin.H{"message": "Error clearing desired state", "error": err.Error()})
+		return
+	}
+	c.IndentedJSON(http.StatusOK, gin.H{"message": "Desired state cleared successfully"})
+}
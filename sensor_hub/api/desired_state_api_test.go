@@ -0,0 +1,148 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"example/sensorHub/desiredstate"
+	gen "example/sensorHub/gen"
+	"example/sensorHub/service"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type mockDesiredStateService struct {
+	mock.Mock
+}
+
+func (m *mockDesiredStateService) ServiceGetDesiredStates(ctx context.Context, sensorID int) ([]desiredstate.DesiredState, error) {
+	args := m.Called(ctx, sensorID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]desiredstate.DesiredState), args.Error(1)
+}
+
+func (m *mockDesiredStateService) ServiceSetDesiredState(ctx context.Context, sensorID int, actor *gen.User, property, value string) (*desiredstate.DesiredState, error) {
+	args := m.Called(ctx, sensorID, actor, property, value)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*desiredstate.DesiredState), args.Error(1)
+}
+
+func (m *mockDesiredStateService) ServiceDeleteDesiredState(ctx context.Context, sensorID int, property string) error {
+	args := m.Called(ctx, sensorID, property)
+	return args.Error(0)
+}
+
+func setupDesiredStateRouter(method, path string, handler gin.HandlerFunc) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Handle(method, path, handler)
+	return router
+}
+
+func withDesiredStateParams(h func(*gin.Context, int, string)) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, _ := strconv.Atoi(c.Param("id"))
+		h(c, id, c.Param("property"))
+	}
+}
+
+func TestGetSensorDesiredStatesHandler(t *testing.T) {
+	mockSvc := new(mockDesiredStateService)
+	s := &Server{desiredStateService: mockSvc}
+	retryAt := time.Date(2026, 3, 2, 21, 0, 10, 0, time.UTC)
+	mockSvc.On("ServiceGetDesiredStates", mock.Anything, 14).Return([]desiredstate.DesiredState{{
+		SensorID: 14, SensorName: "heater-plug", Property: "state", Value: "ON",
+		Status: desiredstate.StatusRetrying, Attempts: 1, NextRetryAt: &retryAt, LastError: "command 41 timed out",
+	}}, nil)
+
+	router := setupDesiredStateRouter("GET", "/sensors/by-id/:id/desired-states", func(c *gin.Context) {
+		id, _ := strconv.Atoi(c.Param("id"))
+		s.GetSensorDesiredStates(c, id)
+	})
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest("GET", "/sensors/by-id/14/desired-states", nil))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	var result []gen.DesiredState
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &result))
+	require.Len(t, result, 1)
+	assert.Equal(t, gen.DesiredStateRetrying, result[0].Status)
+	assert.Equal(t, "command 41 timed out", result[0].LastError)
+	require.NotNil(t, result[0].NextRetryAt)
+	assert.True(t, result[0].NextRetryAt.Equal(retryAt))
+}
+
+func TestSetSensorDesiredStateHandler(t *testing.T) {
+	mockSvc := new(mockDesiredStateService)
+	s := &Server{desiredStateService: mockSvc}
+	actor := &gen.User{Id: 7, Permissions: []string{"control_sensors"}}
+	mockSvc.On("ServiceSetDesiredState", mock.Anything, 14, actor, "state", "ON").Return(&desiredstate.DesiredState{
+		SensorID: 14, SensorName: "heater-plug", Property: "state", Value: "ON", Status: desiredstate.StatusPending,
+	}, nil)
+
+	router := setupDesiredStateRouter("PUT", "/sensors/by-id/:id/desired-states/:property", func(c *gin.Context) {
+		c.Set("currentUser", actor)
+		withDesiredStateParams(s.SetSensorDesiredState)(c)
+	})
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest("PUT", "/sensors/by-id/14/desired-states/state", bytes.NewBufferString(`{"value":"ON"}`)))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"status": "pending"`)
+	mockSvc.AssertExpectations(t)
+}
+
+func TestSetSensorDesiredStateHandler_InvalidValue(t *testing.T) {
+	mockSvc := new(mockDesiredStateService)
+	s := &Server{desiredStateService: mockSvc}
+	actor := &gen.User{Id: 7, Permissions: []string{"control_sensors"}}
+	mockSvc.On("ServiceSetDesiredState", mock.Anything, 14, actor, "state", "MAYBE").
+		Return(nil, fmt.Errorf("%w: invalid value for state", service.ErrInvalidDesiredState))
+
+	router := setupDesiredStateRouter("PUT", "/sensors/by-id/:id/desired-states/:property", func(c *gin.Context) {
+		c.Set("currentUser", actor)
+		withDesiredStateParams(s.SetSensorDesiredState)(c)
+	})
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest("PUT", "/sensors/by-id/14/desired-states/state", bytes.NewBufferString(`{"value":"MAYBE"}`)))
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestSetSensorDesiredStateHandler_NotAuthenticated(t *testing.T) {
+	mockSvc := new(mockDesiredStateService)
+	s := &Server{desiredStateService: mockSvc}
+
+	router := setupDesiredStateRouter("PUT", "/sensors/by-id/:id/desired-states/:property", withDesiredStateParams(s.SetSensorDesiredState))
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest("PUT", "/sensors/by-id/14/desired-states/state", bytes.NewBufferString(`{"value":"ON"}`)))
+
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+	mockSvc.AssertNotCalled(t, "ServiceSetDesiredState", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestDeleteSensorDesiredStateHandler_NotFound(t *testing.T) {
+	mockSvc := new(mockDesiredStateService)
+	s := &Server{desiredStateService: mockSvc}
+	mockSvc.On("ServiceDeleteDesiredState", mock.Anything, 14, "brightness").Return(service.ErrDesiredStateNotFound)
+
+	router := setupDesiredStateRouter("DELETE", "/sensors/by-id/:id/desired-states/:property", withDesiredStateParams(s.DeleteSensorDesiredState))
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest("DELETE", "/sensors/by-id/14/desired-states/brightness", nil))
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
@@ -231,6 +231,15 @@ func (m *MockSensorService) ServiceStartPeriodicSensorCollection(ctx context.Con
 	m.Called(ctx)
 }
 
+func (m *MockSensorService) ServiceStartStaleSensorWatchdog(ctx context.Context) {
+	m.Called(ctx)
+}
+
+func (m *MockSensorService) ServiceSetSensorExpectedInterval(ctx context.Context, sensorId int, expectedIntervalSeconds *int) error {
+	args := m.Called(ctx, sensorId, expectedIntervalSeconds)
+	return args.Error(0)
+}
+
 func (m *MockSensorService) ServiceDiscoverSensors(ctx context.Context) error {
 	args := m.Called(ctx)
 	return args.Error(0)
@@ -290,6 +299,13 @@ func (m *MockSensorService) ServiceGetAllMeasurementTypesWithReadings(ctx contex
 	args := m.Called(ctx)
 	return args.Get(0).([]gen.MeasurementType), args.Error(1)
 }
+func (m *MockSensorService) ServiceSetMeasurementTypeDownsampling(ctx context.Context, name string, interval *string) (*gen.MeasurementType, error) {
+	args := m.Called(ctx, name, interval)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*gen.MeasurementType), args.Error(1)
+}
 func (m *MockSensorService) ServiceGetSensorByExternalId(ctx context.Context, externalId string) (*gen.Sensor, error) {
 	args := m.Called(ctx, externalId)
 	if args.Get(0) == nil {
@@ -1,6 +1,7 @@
 package api
 
 import (
+	"context"
 	gen "example/sensorHub/gen"
 	"example/sensorHub/notifications"
 	"example/sensorHub/ws"
@@ -124,15 +125,40 @@ func (s *Server) SetChannelPreference(c *gin.Context) {
 	if req.InappEnabled != nil {
 		inappEnabled = *req.InappEnabled
 	}
+	if req.EmailDigest != nil && !notifications.IsValidDigestMode(notifications.DigestMode(*req.EmailDigest)) {
+		c.IndentedJSON(http.StatusBadRequest, gin.H{"message": "invalid email_digest: must be off, hourly or daily"})
+		return
+	}
+
+	category := notifications.NotificationCategory(req.Category)
+	webhookEnabled, err := s.channelSetting(ctx, userID, category, "webhook", req.WebhookEnabled)
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, gin.H{"message": "failed to set preference", "error": err.Error()})
+		return
+	}
+	pushEnabled, err := s.channelSetting(ctx, userID, category, "push", req.PushEnabled)
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, gin.H{"message": "failed to set preference", "error": err.Error()})
+		return
+	}
+
+	emailDigest, err := s.emailDigestSetting(ctx, userID, category, req.EmailDigest)
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, gin.H{"message": "failed to set preference", "error": err.Error()})
+		return
+	}
 
 	pref := notifications.ChannelPreference{
-		UserID:       userID,
-		Category:     notifications.NotificationCategory(req.Category),
-		EmailEnabled: emailEnabled,
-		InAppEnabled: inappEnabled,
+		UserID:         userID,
+		Category:       category,
+		EmailEnabled:   emailEnabled,
+		InAppEnabled:   inappEnabled,
+		WebhookEnabled: webhookEnabled,
+		PushEnabled:    pushEnabled,
+		EmailDigest:    emailDigest,
 	}
 
-	err := s.notificationService.SetChannelPreference(ctx, userID, pref)
+	err = s.notificationService.SetChannelPreference(ctx, userID, pref)
 	if err != nil {
 		c.IndentedJSON(http.StatusInternalServerError, gin.H{"message": "failed to set preference", "error": err.Error()})
 		return
@@ -140,6 +166,33 @@ func (s *Server) SetChannelPreference(c *gin.Context) {
 	c.IndentedJSON(http.StatusOK, gin.H{"message": "preference saved"})
 }
 
+// channelSetting returns requested when set. Clients that predate a channel omit it, so
+// otherwise the user's current setting for the channel is kept.
+func (s *Server) channelSetting(ctx context.Context, userID int, category notifications.NotificationCategory, channel string, requested *bool) (bool, error) {
+	if requested != nil {
+		return *requested, nil
+	}
+	return s.notificationService.ShouldNotifyChannel(ctx, userID, category, channel)
+}
+
+// emailDigestSetting returns requested when set, and otherwise the user's current digest
+// mode for category, like channelSetting.
+func (s *Server) emailDigestSetting(ctx context.Context, userID int, category notifications.NotificationCategory, requested *gen.ChannelPreferenceEmailDigest) (notifications.DigestMode, error) {
+	if requested != nil {
+		return notifications.DigestMode(*requested), nil
+	}
+	prefs, err := s.notificationService.GetChannelPreferences(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+	for _, pref := range prefs {
+		if pref.Category == category {
+			return pref.EmailDigest, nil
+		}
+	}
+	return notifications.DigestOff, nil
+}
+
 func (s *Server) NotificationsWebSocket(ctx *gin.Context) {
 	userID := ctx.MustGet("currentUser").(*gen.User).Id
 
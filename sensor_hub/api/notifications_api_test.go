@@ -351,10 +351,51 @@ func TestSetChannelPreference_Success(t *testing.T) {
 	jsonBody, _ := json.Marshal(reqBody)
 
 	expectedPref := notifications.ChannelPreference{
-		UserID:       1,
-		Category:     "threshold_alert",
-		EmailEnabled: true,
-		InAppEnabled: false,
+		UserID:         1,
+		Category:       "threshold_alert",
+		EmailEnabled:   true,
+		InAppEnabled:   false,
+		WebhookEnabled: true,
+		PushEnabled:    true,
+		EmailDigest:    notifications.DigestHourly,
+	}
+	mockService.On("ShouldNotifyChannel", mock.Anything, 1, notifications.CategoryThresholdAlert, "webhook").Return(true, nil)
+	mockService.On("ShouldNotifyChannel", mock.Anything, 1, notifications.CategoryThresholdAlert, "push").Return(true, nil)
+	mockService.On("GetChannelPreferences", mock.Anything, 1).Return([]notifications.ChannelPreference{
+		{Category: notifications.CategoryThresholdAlert, EmailDigest: notifications.DigestHourly},
+	}, nil)
+	mockService.On("SetChannelPreference", mock.Anything, 1, expectedPref).Return(nil)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest("POST", "/api/notifications/preferences", bytes.NewBuffer(jsonBody))
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	mockService.AssertExpectations(t)
+}
+
+func TestSetChannelPreference_WebhookEnabled(t *testing.T) {
+	router, api, s, mockService := setupNotifRouter()
+	api.POST("/notifications/preferences", func(c *gin.Context) {
+		c.Set("currentUser", &gen.User{Id: 1})
+		s.SetChannelPreference(c)
+	})
+
+	webhookEnabled := false
+	pushEnabled := false
+	emailDigest := gen.Off
+	reqBody := gen.SetChannelPreferenceJSONRequestBody{
+		Category:       "config_change",
+		WebhookEnabled: &webhookEnabled,
+		PushEnabled:    &pushEnabled,
+		EmailDigest:    &emailDigest,
+	}
+	jsonBody, _ := json.Marshal(reqBody)
+
+	expectedPref := notifications.ChannelPreference{
+		UserID:      1,
+		Category:    "config_change",
+		EmailDigest: notifications.DigestOff,
 	}
 	mockService.On("SetChannelPreference", mock.Anything, 1, expectedPref).Return(nil)
 
@@ -363,6 +404,58 @@ func TestSetChannelPreference_Success(t *testing.T) {
 	router.ServeHTTP(w, req)
 
 	assert.Equal(t, http.StatusOK, w.Code)
+	mockService.AssertNotCalled(t, "ShouldNotifyChannel", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestSetChannelPreference_PushEnabled(t *testing.T) {
+	router, api, s, mockService := setupNotifRouter()
+	api.POST("/notifications/preferences", func(c *gin.Context) {
+		c.Set("currentUser", &gen.User{Id: 1})
+		s.SetChannelPreference(c)
+	})
+
+	pushEnabled := true
+	reqBody := gen.SetChannelPreferenceJSONRequestBody{
+		Category:    "threshold_alert",
+		PushEnabled: &pushEnabled,
+	}
+	jsonBody, _ := json.Marshal(reqBody)
+
+	expectedPref := notifications.ChannelPreference{
+		UserID:         1,
+		Category:       "threshold_alert",
+		WebhookEnabled: true,
+		PushEnabled:    true,
+		EmailDigest:    notifications.DigestOff,
+	}
+	mockService.On("ShouldNotifyChannel", mock.Anything, 1, notifications.CategoryThresholdAlert, "webhook").Return(true, nil)
+	mockService.On("GetChannelPreferences", mock.Anything, 1).Return([]notifications.ChannelPreference{
+		{Category: notifications.CategoryThresholdAlert, EmailDigest: notifications.DigestOff},
+	}, nil)
+	mockService.On("SetChannelPreference", mock.Anything, 1, expectedPref).Return(nil)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest("POST", "/api/notifications/preferences", bytes.NewBuffer(jsonBody))
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	mockService.AssertNotCalled(t, "ShouldNotifyChannel", mock.Anything, 1, notifications.CategoryThresholdAlert, "push")
+}
+
+func TestSetChannelPreference_InvalidEmailDigest(t *testing.T) {
+	router, api, s, mockService := setupNotifRouter()
+	api.POST("/notifications/preferences", func(c *gin.Context) {
+		c.Set("currentUser", &gen.User{Id: 1})
+		s.SetChannelPreference(c)
+	})
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest("POST", "/api/notifications/preferences",
+		bytes.NewBufferString(`{"category":"threshold_alert","email_digest":"weekly"}`))
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	mockService.AssertNotCalled(t, "SetChannelPreference", mock.Anything, mock.Anything, mock.Anything)
 }
 
 func TestSetChannelPreference_InvalidJSON(t *testing.T) {
@@ -389,6 +482,9 @@ func TestSetChannelPreference_ServiceError(t *testing.T) {
 	reqBody := gen.SetChannelPreferenceJSONRequestBody{Category: "threshold_alert"}
 	jsonBody, _ := json.Marshal(reqBody)
 
+	mockService.On("ShouldNotifyChannel", mock.Anything, 1, notifications.CategoryThresholdAlert, "webhook").Return(false, nil)
+	mockService.On("ShouldNotifyChannel", mock.Anything, 1, notifications.CategoryThresholdAlert, "push").Return(false, nil)
+	mockService.On("GetChannelPreferences", mock.Anything, 1).Return([]notifications.ChannelPreference{}, nil)
 	mockService.On("SetChannelPreference", mock.Anything, 1, mock.AnythingOfType("notifications.ChannelPreference")).
 		Return(errors.New("db error"))
 
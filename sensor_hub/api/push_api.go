@@ -0,0 +1,110 @@
+package api
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	gen "example/sensorHub/gen"
+	"example/sensorHub/push"
+	"example/sensorHub/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+func toPushSubscription(s gen.PushSubscription) push.Subscription {
+	sub := push.Subscription{
+		Provider:  push.Provider(s.Provider),
+		ServerURL: s.ServerUrl,
+		Enabled:   s.Enabled,
+	}
+	if s.Topic != nil {
+		sub.Topic = *s.Topic
+	}
+	return sub
+}
+
+// toGenPushSubscription converts a subscription for a response. The token itself is never returned.
+func toGenPushSubscription(s push.Subscription) gen.PushSubscription {
+	topic := s.Topic
+	hasToken := s.Token != ""
+	return gen.PushSubscription{
+		Provider:  gen.PushSubscriptionProvider(s.Provider),
+		ServerUrl: s.ServerURL,
+		Topic:     &topic,
+		HasToken:  &hasToken,
+		Enabled:   s.Enabled,
+	}
+}
+
+// pushErrorStatus maps push service errors to HTTP statuses.
+func pushErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, service.ErrPushSubscriptionNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, service.ErrInvalidPushSubscription):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+func (s *Server) GetPushSubscription(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID := c.MustGet("currentUser").(*gen.User).Id
+
+	sub, err := s.pushService.ServiceGetPushSubscription(ctx, userID)
+	if err != nil {
+		c.IndentedJSON(pushErrorStatus(err), gin.H{"message": "Error getting push subscription", "error": err.Error()})
+		return
+	}
+	c.IndentedJSON(http.StatusOK, toGenPushSubscription(*sub))
+}
+
+func (s *Server) SetPushSubscription(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID := c.MustGet("currentUser").(*gen.User).Id
+
+	var req gen.PushSubscription
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.IndentedJSON(http.StatusBadRequest, gin.H{"message": "Invalid request body", "error": err.Error()})
+		return
+	}
+	sub := toPushSubscription(req)
+
+	if err := s.pushService.ServiceSetPushSubscription(ctx, userID, &sub, req.Token); err != nil {
+		slog.Error("error setting push subscription", "user_id", userID, "error", err)
+		c.IndentedJSON(pushErrorStatus(err), gin.H{"message": "Error setting push subscription", "error": err.Error()})
+		return
+	}
+	c.IndentedJSON(http.StatusOK, toGenPushSubscription(sub))
+}
+
+func (s *Server) DeletePushSubscription(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID := c.MustGet("currentUser").(*gen.User).Id
+
+	if err := s.pushService.ServiceDeletePushSubscription(ctx, userID); err != nil {
+		slog.Error("error deleting push subscription", "user_id", userID, "error", err)
+		c.IndentedJSON(pushErrorStatus(err), gin.H{"message": "Error deleting push subscription", "error": err.Error()})
+		return
+	}
+	c.IndentedJSON(http.StatusOK, gin.H{"message": "Push subscription deleted successfully"})
+}
+
+func (s *Server) TestPushSubscription(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID := c.MustGet("currentUser").(*gen.User).Id
+
+	err := s.pushService.ServiceTestPushSubscription(ctx, userID)
+	if errors.Is(err, service.ErrPushSubscriptionNotFound) {
+		c.IndentedJSON(http.StatusNotFound, gin.H{"message": "Error testing push subscription", "error": err.Error()})
+		return
+	}
+	if err != nil {
+		// Anything else is the push server rejecting or not answering the request.
+		c.IndentedJSON(http.StatusBadGateway, gin.H{"message": "Push server did not accept the test notification", "error": err.Error()})
+		return
+	}
+	c.IndentedJSON(http.StatusOK, gin.H{"message": "Test notification sent"})
+}
@@ -0,0 +1,118 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	gen "example/sensorHub/gen"
+	"example/sensorHub/push"
+	"example/sensorHub/service"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type mockPushService struct {
+	mock.Mock
+}
+
+func (m *mockPushService) ServiceGetPushSubscription(ctx context.Context, userID int) (*push.Subscription, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*push.Subscription), args.Error(1)
+}
+
+func (m *mockPushService) ServiceSetPushSubscription(ctx context.Context, userID int, sub *push.Subscription, token *string) error {
+	return m.Called(ctx, userID, sub, token).Error(0)
+}
+
+func (m *mockPushService) ServiceDeletePushSubscription(ctx context.Context, userID int) error {
+	return m.Called(ctx, userID).Error(0)
+}
+
+func (m *mockPushService) ServiceTestPushSubscription(ctx context.Context, userID int) error {
+	return m.Called(ctx, userID).Error(0)
+}
+
+func TestGetPushSubscription_HidesToken(t *testing.T) {
+	mockService := new(mockPushService)
+	s := &Server{pushService: mockService}
+	mockService.On("ServiceGetPushSubscription", mock.Anything, 7).Return(&push.Subscription{
+		UserID: 7, Provider: push.ProviderNtfy, ServerURL: "https://ntfy.sh", Topic: "leaks", Token: "tk_123", Enabled: true,
+	}, nil)
+
+	router := alertRouterAsUser(http.MethodGet, "/notifications/push", s.GetPushSubscription)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/notifications/push", nil))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.NotContains(t, w.Body.String(), "tk_123")
+	var sub gen.PushSubscription
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &sub))
+	assert.Equal(t, "leaks", *sub.Topic)
+	assert.True(t, *sub.HasToken)
+}
+
+func TestGetPushSubscription_NotFound(t *testing.T) {
+	mockService := new(mockPushService)
+	s := &Server{pushService: mockService}
+	mockService.On("ServiceGetPushSubscription", mock.Anything, 7).Return(nil, service.ErrPushSubscriptionNotFound)
+
+	router := alertRouterAsUser(http.MethodGet, "/notifications/push", s.GetPushSubscription)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/notifications/push", nil))
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestSetPushSubscription_PassesToken(t *testing.T) {
+	mockService := new(mockPushService)
+	s := &Server{pushService: mockService}
+	mockService.On("ServiceSetPushSubscription", mock.Anything, 7, mock.MatchedBy(func(sub *push.Subscription) bool {
+		return sub.Provider == push.ProviderGotify && sub.ServerURL == "https://gotify.example.com"
+	}), mock.MatchedBy(func(token *string) bool { return token != nil && *token == "AbCdEf" })).Return(nil)
+
+	body := `{"provider":"gotify","server_url":"https://gotify.example.com","token":"AbCdEf","enabled":true}`
+	router := alertRouterAsUser(http.MethodPut, "/notifications/push", s.SetPushSubscription)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodPut, "/api/notifications/push", bytes.NewBufferString(body)))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.NotContains(t, w.Body.String(), "AbCdEf")
+	mockService.AssertExpectations(t)
+}
+
+func TestSetPushSubscription_Invalid(t *testing.T) {
+	mockService := new(mockPushService)
+	s := &Server{pushService: mockService}
+	mockService.On("ServiceSetPushSubscription", mock.Anything, 7, mock.Anything, (*string)(nil)).
+		Return(errors.Join(service.ErrInvalidPushSubscription, errors.New("topic is required")))
+
+	body := `{"provider":"ntfy","server_url":"https://ntfy.sh","enabled":true}`
+	router := alertRouterAsUser(http.MethodPut, "/notifications/push", s.SetPushSubscription)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodPut, "/api/notifications/push", bytes.NewBufferString(body)))
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestTestPushSubscription_ServerRejects(t *testing.T) {
+	mockService := new(mockPushService)
+	s := &Server{pushService: mockService}
+	mockService.On("ServiceTestPushSubscription", mock.Anything, 7).Return(errors.New("ntfy server responded with status 403"))
+
+	router := alertRouterAsUser(http.MethodPost, "/notifications/push/test", s.TestPushSubscription)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/api/notifications/push/test", nil))
+
+	assert.Equal(t, http.StatusBadGateway, w.Code)
+	assert.Contains(t, w.Body.String(), "status 403")
+}
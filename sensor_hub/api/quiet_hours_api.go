@@ -0,0 +1,68 @@
+package api
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	gen "example/sensorHub/gen"
+	"example/sensorHub/notifications"
+	"example/sensorHub/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// quietHoursErrorStatus maps digest service errors to HTTP statuses.
+func quietHoursErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, service.ErrQuietHoursNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, service.ErrInvalidQuietHours):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+func (s *Server) GetQuietHours(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID := c.MustGet("currentUser").(*gen.User).Id
+
+	quiet, err := s.digestService.ServiceGetQuietHours(ctx, userID)
+	if err != nil {
+		c.IndentedJSON(quietHoursErrorStatus(err), gin.H{"message": "Error getting quiet hours", "error": err.Error()})
+		return
+	}
+	c.IndentedJSON(http.StatusOK, gen.QuietHours{Start: quiet.Start, End: quiet.End, Enabled: quiet.Enabled})
+}
+
+func (s *Server) SetQuietHours(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID := c.MustGet("currentUser").(*gen.User).Id
+
+	var req gen.QuietHours
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.IndentedJSON(http.StatusBadRequest, gin.H{"message": "Invalid request body", "error": err.Error()})
+		return
+	}
+	quiet := notifications.QuietHours{Start: req.Start, End: req.End, Enabled: req.Enabled}
+
+	if err := s.digestService.ServiceSetQuietHours(ctx, userID, &quiet); err != nil {
+		slog.Error("error setting quiet hours", "user_id", userID, "error", err)
+		c.IndentedJSON(quietHoursErrorStatus(err), gin.H{"message": "Error setting quiet hours", "error": err.Error()})
+		return
+	}
+	c.IndentedJSON(http.StatusOK, req)
+}
+
+func (s *Server) DeleteQuietHours(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID := c.MustGet("currentUser").(*gen.User).Id
+
+	if err := s.digestService.ServiceDeleteQuietHours(ctx, userID); err != nil {
+		slog.Error("error deleting quiet hours", "user_id", userID, "error", err)
+		c.IndentedJSON(quietHoursErrorStatus(err), gin.H{"message": "Error deleting quiet hours", "error": err.Error()})
+		return
+	}
+	c.IndentedJSON(http.StatusOK, gin.H{"message": "Quiet hours deleted successfully"})
+}
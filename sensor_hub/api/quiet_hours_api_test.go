@@ -0,0 +1,104 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	gen "example/sensorHub/gen"
+	"example/sensorHub/notifications"
+	"example/sensorHub/service"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type mockDigestService struct {
+	mock.Mock
+}
+
+func (m *mockDigestService) ServiceGetQuietHours(ctx context.Context, userID int) (*notifications.QuietHours, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*notifications.QuietHours), args.Error(1)
+}
+
+func (m *mockDigestService) ServiceSetQuietHours(ctx context.Context, userID int, quietHours *notifications.QuietHours) error {
+	return m.Called(ctx, userID, quietHours).Error(0)
+}
+
+func (m *mockDigestService) ServiceDeleteQuietHours(ctx context.Context, userID int) error {
+	return m.Called(ctx, userID).Error(0)
+}
+
+func TestGetQuietHours_Success(t *testing.T) {
+	mockService := new(mockDigestService)
+	s := &Server{digestService: mockService}
+	mockService.On("ServiceGetQuietHours", mock.Anything, 7).Return(&notifications.QuietHours{UserID: 7, Start: "22:00", End: "07:00", Enabled: true}, nil)
+
+	router := alertRouterAsUser(http.MethodGet, "/notifications/quiet-hours", s.GetQuietHours)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/notifications/quiet-hours", nil))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	var quiet gen.QuietHours
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &quiet))
+	assert.Equal(t, gen.QuietHours{Start: "22:00", End: "07:00", Enabled: true}, quiet)
+}
+
+func TestGetQuietHours_NotFound(t *testing.T) {
+	mockService := new(mockDigestService)
+	s := &Server{digestService: mockService}
+	mockService.On("ServiceGetQuietHours", mock.Anything, 7).Return(nil, service.ErrQuietHoursNotFound)
+
+	router := alertRouterAsUser(http.MethodGet, "/notifications/quiet-hours", s.GetQuietHours)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/notifications/quiet-hours", nil))
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestSetQuietHours_Success(t *testing.T) {
+	mockService := new(mockDigestService)
+	s := &Server{digestService: mockService}
+	mockService.On("ServiceSetQuietHours", mock.Anything, 7, &notifications.QuietHours{Start: "22:00", End: "07:00", Enabled: true}).Return(nil)
+
+	body, _ := json.Marshal(gen.QuietHours{Start: "22:00", End: "07:00", Enabled: true})
+	router := alertRouterAsUser(http.MethodPut, "/notifications/quiet-hours", s.SetQuietHours)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodPut, "/api/notifications/quiet-hours", bytes.NewReader(body)))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	mockService.AssertExpectations(t)
+}
+
+func TestSetQuietHours_Invalid(t *testing.T) {
+	mockService := new(mockDigestService)
+	s := &Server{digestService: mockService}
+	mockService.On("ServiceSetQuietHours", mock.Anything, 7, mock.Anything).Return(service.ErrInvalidQuietHours)
+
+	body, _ := json.Marshal(gen.QuietHours{Start: "22:00", End: "22:00", Enabled: true})
+	router := alertRouterAsUser(http.MethodPut, "/notifications/quiet-hours", s.SetQuietHours)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodPut, "/api/notifications/quiet-hours", bytes.NewReader(body)))
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestDeleteQuietHours_Success(t *testing.T) {
+	mockService := new(mockDigestService)
+	s := &Server{digestService: mockService}
+	mockService.On("ServiceDeleteQuietHours", mock.Anything, 7).Return(nil)
+
+	router := alertRouterAsUser(http.MethodDelete, "/notifications/quiet-hours", s.DeleteQuietHours)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodDelete, "/api/notifications/quiet-hours", nil))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+}
@@ -6,6 +6,7 @@ import (
 	"example/sensorHub/utils"
 	"example/sensorHub/ws"
 	gen "example/sensorHub/gen"
+	"fmt"
 	"log/slog"
 	"net/http"
 
@@ -49,9 +50,19 @@ func (s *Server) GetReadingsBetweenDates(c *gin.Context, params gen.GetReadingsB
 	if params.AggregationFunction != nil {
 		overrideFunction = string(*params.AggregationFunction)
 	}
+	var statistics []string
+	if params.Statistics != nil {
+		for _, stat := range *params.Statistics {
+			if !stat.Valid() {
+				c.JSON(http.StatusBadRequest, gin.H{"message": fmt.Sprintf("Invalid statistic %q", stat)})
+				return
+			}
+			statistics = append(statistics, string(stat))
+		}
+	}
 
-	slog.Debug("fetching readings between dates", "start", startStr, "end", endStr, "sensor", sensorName, "type", measurementType, "aggregation", overrideInterval, "aggregation_function", overrideFunction)
-	response, err := s.readingsService.ServiceGetBetweenDates(ctx, startStr, endStr, sensorName, measurementType, overrideInterval, overrideFunction)
+	slog.Debug("fetching readings between dates", "start", startStr, "end", endStr, "sensor", sensorName, "type", measurementType, "aggregation", overrideInterval, "aggregation_function", overrideFunction, "statistics", statistics)
+	response, err := s.readingsService.ServiceGetBetweenDates(ctx, startStr, endStr, sensorName, measurementType, overrideInterval, overrideFunction, statistics)
 
 	if err != nil {
 		var unsupported *service.ErrUnsupportedAggregationFunction
@@ -14,12 +14,12 @@ import (
 )
 
 type mockReadingsService struct {
-	ServiceGetBetweenDatesFunc func(context.Context, string, string, string, string, string, string) (*gen.AggregatedReadingsResponse, error)
+	ServiceGetBetweenDatesFunc func(context.Context, string, string, string, string, string, string, []string) (*gen.AggregatedReadingsResponse, error)
 	ServiceGetLatestFunc       func(context.Context) ([]gen.Reading, error)
 }
 
-func (m *mockReadingsService) ServiceGetBetweenDates(ctx context.Context, startDate, endDate, sensorName, measurementType string, overrideInterval, overrideFunction string) (*gen.AggregatedReadingsResponse, error) {
-	return m.ServiceGetBetweenDatesFunc(ctx, startDate, endDate, sensorName, measurementType, overrideInterval, overrideFunction)
+func (m *mockReadingsService) ServiceGetBetweenDates(ctx context.Context, startDate, endDate, sensorName, measurementType string, overrideInterval, overrideFunction string, statistics []string) (*gen.AggregatedReadingsResponse, error) {
+	return m.ServiceGetBetweenDatesFunc(ctx, startDate, endDate, sensorName, measurementType, overrideInterval, overrideFunction, statistics)
 }
 func (m *mockReadingsService) ServiceGetLatest(ctx context.Context) ([]gen.Reading, error) {
 	return m.ServiceGetLatestFunc(ctx)
@@ -43,7 +43,7 @@ func mockGetLatestReadingsSuccessful() ([]gen.Reading, error) {
 	}, nil
 }
 
-func mockGetReadingsBetweenDatesSuccessful(ctx context.Context, startDate, endDate, sensorName, measurementType string, overrideInterval, overrideFunction string) (*gen.AggregatedReadingsResponse, error) {
+func mockGetReadingsBetweenDatesSuccessful(ctx context.Context, startDate, endDate, sensorName, measurementType string, overrideInterval, overrideFunction string, statistics []string) (*gen.AggregatedReadingsResponse, error) {
 	v1 := 22.5
 	v2 := 24.5
 	v3 := 23.5
@@ -60,7 +60,7 @@ func mockGetReadingsBetweenDatesSuccessful(ctx context.Context, startDate, endDa
 	}, nil
 }
 
-func mockGetReadingsBetweenDatesError(ctx context.Context, startDate, endDate, sensorName, measurementType string, overrideInterval, overrideFunction string) (*gen.AggregatedReadingsResponse, error) {
+func mockGetReadingsBetweenDatesError(ctx context.Context, startDate, endDate, sensorName, measurementType string, overrideInterval, overrideFunction string, statistics []string) (*gen.AggregatedReadingsResponse, error) {
 	return nil, fmt.Errorf("failed to fetch readings")
 }
 
@@ -153,7 +153,7 @@ func TestGetReadingsBetweenDates_ServiceError(t *testing.T) {
 
 func TestGetReadingsBetweenDates_InvalidAggregationFunction(t *testing.T) {
 	s := &Server{readingsService: &mockReadingsService{
-		ServiceGetBetweenDatesFunc: func(ctx context.Context, startDate, endDate, sensorName, measurementType, overrideInterval, overrideFunction string) (*gen.AggregatedReadingsResponse, error) {
+		ServiceGetBetweenDatesFunc: func(ctx context.Context, startDate, endDate, sensorName, measurementType, overrideInterval, overrideFunction string, statistics []string) (*gen.AggregatedReadingsResponse, error) {
 			return nil, &service.ErrUnsupportedAggregationFunction{Function: overrideFunction}
 		},
 	}}
@@ -177,7 +177,7 @@ func TestGetReadingsBetweenDates_WithSensorFilter(t *testing.T) {
 	var capturedSensor string
 	val := 21.0
 	s := &Server{readingsService: &mockReadingsService{
-		ServiceGetBetweenDatesFunc: func(ctx context.Context, startDate, endDate, sensorName, measurementType string, overrideInterval, overrideFunction string) (*gen.AggregatedReadingsResponse, error) {
+		ServiceGetBetweenDatesFunc: func(ctx context.Context, startDate, endDate, sensorName, measurementType string, overrideInterval, overrideFunction string, statistics []string) (*gen.AggregatedReadingsResponse, error) {
 			capturedSensor = sensorName
 			return &gen.AggregatedReadingsResponse{
 				AggregationInterval: "raw",
@@ -206,7 +206,7 @@ func TestGetReadingsBetweenDates_NoSensorFilter(t *testing.T) {
 	var capturedSensor string
 	val := 22.5
 	s := &Server{readingsService: &mockReadingsService{
-		ServiceGetBetweenDatesFunc: func(ctx context.Context, startDate, endDate, sensorName, measurementType string, overrideInterval, overrideFunction string) (*gen.AggregatedReadingsResponse, error) {
+		ServiceGetBetweenDatesFunc: func(ctx context.Context, startDate, endDate, sensorName, measurementType string, overrideInterval, overrideFunction string, statistics []string) (*gen.AggregatedReadingsResponse, error) {
 			capturedSensor = sensorName
 			return &gen.AggregatedReadingsResponse{
 				AggregationInterval: "raw",
@@ -232,7 +232,7 @@ func TestGetReadingsBetweenDates_NoSensorFilter(t *testing.T) {
 func TestGetReadingsBetweenDates_ISODatetime(t *testing.T) {
 	var capturedStart, capturedEnd string
 	s := &Server{readingsService: &mockReadingsService{
-		ServiceGetBetweenDatesFunc: func(ctx context.Context, startDate, endDate, sensorName, measurementType string, overrideInterval, overrideFunction string) (*gen.AggregatedReadingsResponse, error) {
+		ServiceGetBetweenDatesFunc: func(ctx context.Context, startDate, endDate, sensorName, measurementType string, overrideInterval, overrideFunction string, statistics []string) (*gen.AggregatedReadingsResponse, error) {
 			capturedStart = startDate
 			capturedEnd = endDate
 			return &gen.AggregatedReadingsResponse{AggregationInterval: "raw", AggregationFunction: "none", Readings: []gen.Reading{}}, nil
@@ -254,7 +254,7 @@ func TestGetReadingsBetweenDates_ISODatetime(t *testing.T) {
 func TestGetReadingsBetweenDates_ISODatetimeWithOffset(t *testing.T) {
 	var capturedStart, capturedEnd string
 	s := &Server{readingsService: &mockReadingsService{
-		ServiceGetBetweenDatesFunc: func(ctx context.Context, startDate, endDate, sensorName, measurementType string, overrideInterval, overrideFunction string) (*gen.AggregatedReadingsResponse, error) {
+		ServiceGetBetweenDatesFunc: func(ctx context.Context, startDate, endDate, sensorName, measurementType string, overrideInterval, overrideFunction string, statistics []string) (*gen.AggregatedReadingsResponse, error) {
 			capturedStart = startDate
 			capturedEnd = endDate
 			return &gen.AggregatedReadingsResponse{AggregationInterval: "raw", AggregationFunction: "none", Readings: []gen.Reading{}}, nil
@@ -276,7 +276,7 @@ func TestGetReadingsBetweenDates_ISODatetimeWithOffset(t *testing.T) {
 func TestGetReadingsBetweenDates_DateOnlyExpandsToFullDay(t *testing.T) {
 	var capturedStart, capturedEnd string
 	s := &Server{readingsService: &mockReadingsService{
-		ServiceGetBetweenDatesFunc: func(ctx context.Context, startDate, endDate, sensorName, measurementType string, overrideInterval, overrideFunction string) (*gen.AggregatedReadingsResponse, error) {
+		ServiceGetBetweenDatesFunc: func(ctx context.Context, startDate, endDate, sensorName, measurementType string, overrideInterval, overrideFunction string, statistics []string) (*gen.AggregatedReadingsResponse, error) {
 			capturedStart = startDate
 			capturedEnd = endDate
 			return &gen.AggregatedReadingsResponse{AggregationInterval: "raw", AggregationFunction: "none", Readings: []gen.Reading{}}, nil
@@ -298,7 +298,7 @@ func TestGetReadingsBetweenDates_DateOnlyExpandsToFullDay(t *testing.T) {
 func TestGetReadingsBetweenDates_TypedAggregationParams(t *testing.T) {
 	var capturedInterval, capturedFunction string
 	s := &Server{readingsService: &mockReadingsService{
-		ServiceGetBetweenDatesFunc: func(ctx context.Context, startDate, endDate, sensorName, measurementType string, overrideInterval, overrideFunction string) (*gen.AggregatedReadingsResponse, error) {
+		ServiceGetBetweenDatesFunc: func(ctx context.Context, startDate, endDate, sensorName, measurementType string, overrideInterval, overrideFunction string, statistics []string) (*gen.AggregatedReadingsResponse, error) {
 			capturedInterval = overrideInterval
 			capturedFunction = overrideFunction
 			return &gen.AggregatedReadingsResponse{
@@ -328,3 +328,49 @@ func TestGetReadingsBetweenDates_TypedAggregationParams(t *testing.T) {
 	assert.Equal(t, "count", capturedFunction)
 }
 
+
+func TestGetReadingsBetweenDates_Statistics(t *testing.T) {
+	var capturedStatistics []string
+	s := &Server{readingsService: &mockReadingsService{
+		ServiceGetBetweenDatesFunc: func(ctx context.Context, startDate, endDate, sensorName, measurementType string, overrideInterval, overrideFunction string, statistics []string) (*gen.AggregatedReadingsResponse, error) {
+			capturedStatistics = statistics
+			val := 21.2
+			stats := map[string]float64{"min": 20.1, "max": 22.8}
+			return &gen.AggregatedReadingsResponse{
+				AggregationInterval: "PT1H",
+				AggregationFunction: "avg",
+				Statistics:          &statistics,
+				Readings: []gen.Reading{
+					{SensorName: "Office", MeasurementType: "temperature", Unit: "°C", NumericValue: &val, Time: "2024-01-01 10:00:00", Statistics: &stats},
+				},
+			}, nil
+		},
+	}}
+
+	statistics := []gen.GetReadingsBetweenDatesParamsStatistics{gen.StatisticMin, gen.StatisticMax}
+	params := gen.GetReadingsBetweenDatesParams{Start: "2024-01-01", End: "2024-01-04", Statistics: &statistics}
+	router := setupReadingsBetweenRoute(s, params)
+
+	req := httptest.NewRequest("GET", "/api/readings/between", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, []string{"min", "max"}, capturedStatistics)
+	assert.Contains(t, w.Body.String(), `"max": 22.8`)
+}
+
+func TestGetReadingsBetweenDates_InvalidStatistic(t *testing.T) {
+	s := &Server{readingsService: &mockReadingsService{}}
+
+	statistics := []gen.GetReadingsBetweenDatesParamsStatistics{"last"}
+	params := gen.GetReadingsBetweenDatesParams{Start: "2024-01-01", End: "2024-01-04", Statistics: &statistics}
+	router := setupReadingsBetweenRoute(s, params)
+
+	req := httptest.NewRequest("GET", "/api/readings/between", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), "last")
+}
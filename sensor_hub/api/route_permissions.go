@@ -12,13 +12,45 @@ import (
 // or are fully public (no CookieAuthScopes set by the generated wrapper).
 var routePermissions = map[string]string{
 	// Alerts
-	"GET /api/alerts":                          "view_alerts",
-	"POST /api/alerts":                         "manage_alerts",
-	"GET /api/alerts/sensor/:sensorId":         "view_alerts",
-	"GET /api/alerts/sensor/:sensorId/history": "view_alerts",
-	"GET /api/alerts/:id":                      "view_alerts",
-	"PUT /api/alerts/:id":                      "manage_alerts",
-	"DELETE /api/alerts/:id":                   "manage_alerts",
+	"GET /api/alerts":                            "view_alerts",
+	"POST /api/alerts":                           "manage_alerts",
+	"GET /api/alerts/sensor/:sensorId":           "view_alerts",
+	"GET /api/alerts/sensor/:sensorId/history":   "view_alerts",
+	"GET /api/alerts/:id":                        "view_alerts",
+	"PUT /api/alerts/:id":                        "manage_alerts",
+	"DELETE /api/alerts/:id":                     "manage_alerts",
+	"POST /api/alerts/:id/acknowledge":           "view_alerts",
+	"POST /api/alerts/:id/snooze":                "manage_alerts",
+	"DELETE /api/alerts/:id/snooze":              "manage_alerts",
+	"GET /api/alerts/:id/escalation":             "view_alerts",
+	"PUT /api/alerts/:id/escalation":             "manage_alerts",
+	"DELETE /api/alerts/:id/escalation":          "manage_alerts",
+	"GET /api/alerts/:id/recipients":             "view_alerts",
+	"PUT /api/alerts/:id/recipients":             "manage_alerts",
+	"DELETE /api/alerts/:id/recipients":          "manage_alerts",
+	"POST /api/alerts/history/:id/acknowledge":   "view_alerts",
+	"GET /api/alerts/compound":                   "view_alerts",
+	"POST /api/alerts/compound":                  "manage_alerts",
+	"GET /api/alerts/compound/:id":               "view_alerts",
+	"PUT /api/alerts/compound/:id":               "manage_alerts",
+	"DELETE /api/alerts/compound/:id":            "manage_alerts",
+	"POST /api/alerts/compound/:id/acknowledge":  "view_alerts",
+	"POST /api/alerts/compound/:id/snooze":       "manage_alerts",
+	"DELETE /api/alerts/compound/:id/snooze":     "manage_alerts",
+	"GET /api/alerts/compound/:id/escalation":    "view_alerts",
+	"PUT /api/alerts/compound/:id/escalation":    "manage_alerts",
+	"DELETE /api/alerts/compound/:id/escalation": "manage_alerts",
+	"GET /api/alerts/compound/:id/recipients":    "view_alerts",
+	"PUT /api/alerts/compound/:id/recipients":    "manage_alerts",
+	"DELETE /api/alerts/compound/:id/recipients": "manage_alerts",
+	"GET /api/alerts/silences":                   "view_alerts",
+	"POST /api/alerts/silences":                  "manage_alerts",
+	"PUT /api/alerts/silences/:id":               "manage_alerts",
+	"DELETE /api/alerts/silences/:id":            "manage_alerts",
+	"GET /api/alerts/contact-groups":             "view_alerts",
+	"POST /api/alerts/contact-groups":            "manage_alerts",
+	"PUT /api/alerts/contact-groups/:id":         "manage_alerts",
+	"DELETE /api/alerts/contact-groups/:id":      "manage_alerts",
 
 	// API Keys
 	"GET /api/api-keys":              "manage_api_keys",
@@ -27,6 +59,30 @@ var routePermissions = map[string]string{
 	"POST /api/api-keys/:id/revoke":  "manage_api_keys",
 	"DELETE /api/api-keys/:id":       "manage_api_keys",
 
+	// Automations
+	"GET /api/automations":        "view_automations",
+	"POST /api/automations":       "manage_automations",
+	"GET /api/automations/:id":    "view_automations",
+	"PUT /api/automations/:id":    "manage_automations",
+	"DELETE /api/automations/:id": "manage_automations",
+
+	// Schedules
+	"GET /api/schedules":              "view_schedules",
+	"POST /api/schedules":             "manage_schedules",
+	"GET /api/schedules/holiday-mode": "view_schedules",
+	"PUT /api/schedules/holiday-mode": "manage_schedules",
+	"GET /api/schedules/:id":          "view_schedules",
+	"PUT /api/schedules/:id":          "manage_schedules",
+	"DELETE /api/schedules/:id":       "manage_schedules",
+
+	// Scenes
+	"GET /api/scenes":               "view_scenes",
+	"POST /api/scenes":              "manage_scenes",
+	"GET /api/scenes/:id":           "view_scenes",
+	"PUT /api/scenes/:id":           "manage_scenes",
+	"DELETE /api/scenes/:id":        "manage_scenes",
+	"POST /api/scenes/:id/activate": "control_sensors",
+
 	// Dashboards
 	"GET /api/dashboards":             "view_dashboards",
 	"POST /api/dashboards":            "manage_dashboards",
@@ -64,6 +120,25 @@ var routePermissions = map[string]string{
 	"POST /api/notifications/preferences":  "manage_notifications",
 	"GET /api/notifications/ws":            "view_notifications",
 
+	// Notification webhooks
+	"GET /api/notifications/webhooks":                "view_notifications",
+	"POST /api/notifications/webhooks":               "manage_notifications",
+	"PUT /api/notifications/webhooks/:id":            "manage_notifications",
+	"DELETE /api/notifications/webhooks/:id":         "manage_notifications",
+	"GET /api/notifications/webhooks/:id/deliveries": "view_notifications",
+	"POST /api/notifications/webhooks/:id/test":      "manage_notifications",
+
+	// Push notification subscription
+	"GET /api/notifications/push":       "view_notifications",
+	"PUT /api/notifications/push":       "manage_notifications",
+	"DELETE /api/notifications/push":    "manage_notifications",
+	"POST /api/notifications/push/test": "manage_notifications",
+
+	// Notification quiet hours
+	"GET /api/notifications/quiet-hours":    "view_notifications",
+	"PUT /api/notifications/quiet-hours":    "manage_notifications",
+	"DELETE /api/notifications/quiet-hours": "manage_notifications",
+
 	// OAuth
 	"GET /api/oauth/status":       "manage_oauth",
 	"GET /api/oauth/authorize":    "manage_oauth",
@@ -108,8 +183,15 @@ var routePermissions = map[string]string{
 	"POST /api/sensors/dismiss/:id":                "manage_sensors",
 	"GET /api/sensors/by-id/:id/measurement-types": "view_sensors",
 
+	// Desired states
+	"GET /api/sensors/by-id/:id/desired-states":              "view_sensors",
+	"PUT /api/sensors/by-id/:id/desired-states/:property":    "control_sensors",
+	"DELETE /api/sensors/by-id/:id/desired-states/:property": "control_sensors",
+
 	// Measurement types
-	"GET /api/measurement-types": "view_sensors",
+	"GET /api/measurement-types":                       "view_sensors",
+	"PUT /api/measurement-types/:name/downsampling":    "manage_sensors",
+	"DELETE /api/measurement-types/:name/downsampling": "manage_sensors",
 
 	// Users
 	"GET /api/users":                   "view_users",
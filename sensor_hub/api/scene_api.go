@@ -0,0 +1,195 @@
+package api
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	gen "example/sensorHub/gen"
+	"example/sensorHub/scenes"
+	"example/sensorHub/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+func toScene(s gen.Scene) scenes.Scene {
+	scene := scenes.Scene{
+		Name:     s.Name,
+		Commands: make([]scenes.Command, len(s.Commands)),
+	}
+	if s.Description != nil {
+		scene.Description = *s.Description
+	}
+	for i, c := range s.Commands {
+		scene.Commands[i] = scenes.Command{SensorID: c.SensorId, Property: c.Property, Value: c.Value}
+	}
+	return scene
+}
+
+func toGenScene(s scenes.Scene) gen.Scene {
+	id := s.ID
+	description := s.Description
+	commands := make([]gen.SceneCommand, len(s.Commands))
+	for i, c := range s.Commands {
+		sensorName := c.SensorName
+		commands[i] = gen.SceneCommand{SensorId: c.SensorID, SensorName: &sensorName, Property: c.Property, Value: c.Value}
+	}
+	scene := gen.Scene{
+		Id:              &id,
+		Name:            s.Name,
+		Description:     &description,
+		Commands:        commands,
+		LastActivatedAt: s.LastActivatedAt,
+	}
+	if s.LastActivationStatus != "" {
+		status := string(s.LastActivationStatus)
+		scene.LastActivationStatus = &status
+	}
+	return scene
+}
+
+func toGenSceneActivation(a scenes.Activation) gen.SceneActivation {
+	commands := make([]gen.SceneCommandOutcome, len(a.Commands))
+	for i, c := range a.Commands {
+		commands[i] = gen.SceneCommandOutcome{
+			SensorId:          c.SensorID,
+			SensorName:        c.SensorName,
+			Property:          c.Property,
+			Value:             c.Value,
+			CommandId:         c.CommandID,
+			Status:            gen.SceneCommandOutcomeStatus(c.Status),
+			AcknowledgedValue: c.AcknowledgedValue,
+		}
+		if c.Error != "" {
+			errorMessage := c.Error
+			commands[i].Error = &errorMessage
+		}
+	}
+	return gen.SceneActivation{
+		SceneId:     a.SceneID,
+		SceneName:   a.SceneName,
+		ActivatedAt: a.ActivatedAt,
+		Status:      gen.SceneActivationStatus(a.Status),
+		Commands:    commands,
+	}
+}
+
+// sceneErrorStatus maps scene service errors to HTTP statuses.
+func sceneErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, service.ErrSceneNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, service.ErrInvalidScene):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+func (s *Server) GetAllScenes(c *gin.Context) {
+	ctx := c.Request.Context()
+	all, err := s.sceneService.ServiceGetAllScenes(ctx)
+	if err != nil {
+		slog.Error("error listing scenes", "error", err)
+		c.IndentedJSON(http.StatusInternalServerError, gin.H{"message": "Error listing scenes", "error": err.Error()})
+		return
+	}
+	result := make([]gen.Scene, len(all))
+	for i, scene := range all {
+		result[i] = toGenScene(scene)
+	}
+	c.IndentedJSON(http.StatusOK, result)
+}
+
+func (s *Server) GetSceneById(c *gin.Context, id int) {
+	ctx := c.Request.Context()
+	scene, err := s.sceneService.ServiceGetSceneByID(ctx, id)
+	if err != nil {
+		c.IndentedJSON(sceneErrorStatus(err), gin.H{"message": "Error fetching scene", "error": err.Error()})
+		return
+	}
+	c.IndentedJSON(http.StatusOK, toGenScene(*scene))
+}
+
+func (s *Server) CreateScene(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req gen.Scene
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.IndentedJSON(http.StatusBadRequest, gin.H{"message": "Invalid request body", "error": err.Error()})
+		return
+	}
+	scene := toScene(req)
+	if err := scene.Validate(); err != nil {
+		c.IndentedJSON(http.StatusBadRequest, gin.H{"message": "Invalid scene", "error": err.Error()})
+		return
+	}
+
+	if err := s.sceneService.ServiceCreateScene(ctx, &scene); err != nil {
+		slog.Error("error creating scene", "error", err)
+		c.IndentedJSON(sceneErrorStatus(err), gin.H{"message": "Error creating scene", "error": err.Error()})
+		return
+	}
+	created, err := s.sceneService.ServiceGetSceneByID(ctx, scene.ID)
+	if err != nil {
+		c.IndentedJSON(http.StatusCreated, toGenScene(scene))
+		return
+	}
+	c.IndentedJSON(http.StatusCreated, toGenScene(*created))
+}
+
+func (s *Server) UpdateScene(c *gin.Context, id int) {
+	ctx := c.Request.Context()
+
+	var req gen.Scene
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.IndentedJSON(http.StatusBadRequest, gin.H{"message": "Invalid request body", "error": err.Error()})
+		return
+	}
+	scene := toScene(req)
+	scene.ID = id
+	if err := scene.Validate(); err != nil {
+		c.IndentedJSON(http.StatusBadRequest, gin.H{"message": "Invalid scene", "error": err.Error()})
+		return
+	}
+
+	if err := s.sceneService.ServiceUpdateScene(ctx, &scene); err != nil {
+		slog.Error("error updating scene", "id", id, "error", err)
+		c.IndentedJSON(sceneErrorStatus(err), gin.H{"message": "Error updating scene", "error": err.Error()})
+		return
+	}
+	c.IndentedJSON(http.StatusOK, gin.H{"message": "Scene updated successfully"})
+}
+
+func (s *Server) DeleteScene(c *gin.Context, id int) {
+	ctx := c.Request.Context()
+
+	if err := s.sceneService.ServiceDeleteScene(ctx, id); err != nil {
+		slog.Error("error deleting scene", "id", id, "error", err)
+		c.IndentedJSON(sceneErrorStatus(err), gin.H{"message": "Error deleting scene", "error": err.Error()})
+		return
+	}
+	c.IndentedJSON(http.StatusOK, gin.H{"message": "Scene deleted successfully"})
+}
+
+func (s *Server) ActivateScene(c *gin.Context, id int, params gen.ActivateSceneParams) {
+	currentUser, exists := c.Get("currentUser")
+	if !exists {
+		c.IndentedJSON(http.StatusUnauthorized, gin.H{"message": "Not authenticated"})
+		return
+	}
+	actor, ok := currentUser.(*gen.User)
+	if !ok || actor == nil {
+		c.IndentedJSON(http.StatusUnauthorized, gin.H{"message": "Not authenticated"})
+		return
+	}
+
+	wait := params.Wait != nil && *params.Wait
+	activation, err := s.sceneService.ServiceActivateScene(c.Request.Context(), id, actor, wait)
+	if err != nil {
+		slog.Error("error activating scene", "id", id, "error", err)
+		c.IndentedJSON(sceneErrorStatus(err), gin.H{"message": "Error activating scene", "error": err.Error()})
+		return
+	}
+	c.IndentedJSON(http.StatusOK, toGenSceneActivation(*activation))
+}
@@ -0,0 +1,221 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	gen "example/sensorHub/gen"
+	"example/sensorHub/scenes"
+	"example/sensorHub/service"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type mockSceneService struct {
+	mock.Mock
+}
+
+func (m *mockSceneService) ServiceGetAllScenes(ctx context.Context) ([]scenes.Scene, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]scenes.Scene), args.Error(1)
+}
+
+func (m *mockSceneService) ServiceGetSceneByID(ctx context.Context, sceneID int) (*scenes.Scene, error) {
+	args := m.Called(ctx, sceneID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*scenes.Scene), args.Error(1)
+}
+
+func (m *mockSceneService) ServiceCreateScene(ctx context.Context, scene *scenes.Scene) error {
+	args := m.Called(ctx, scene)
+	return args.Error(0)
+}
+
+func (m *mockSceneService) ServiceUpdateScene(ctx context.Context, scene *scenes.Scene) error {
+	args := m.Called(ctx, scene)
+	return args.Error(0)
+}
+
+func (m *mockSceneService) ServiceDeleteScene(ctx context.Context, sceneID int) error {
+	args := m.Called(ctx, sceneID)
+	return args.Error(0)
+}
+
+func (m *mockSceneService) ServiceActivateScene(ctx context.Context, sceneID int, actor *gen.User, wait bool) (*scenes.Activation, error) {
+	args := m.Called(ctx, sceneID, actor, wait)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*scenes.Activation), args.Error(1)
+}
+
+func setupSceneRouter(method, path string, handler gin.HandlerFunc) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Handle(method, path, handler)
+	return router
+}
+
+func withSceneID(h func(*gin.Context, int)) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, _ := strconv.Atoi(c.Param("id"))
+		h(c, id)
+	}
+}
+
+func sampleScene() scenes.Scene {
+	return scenes.Scene{
+		ID: 3, Name: "Movie night",
+		Commands: []scenes.Command{
+			{SensorID: 14, SensorName: "lamp", Property: "state", Value: "OFF"},
+			{SensorID: 15, SensorName: "tv-plug", Property: "state", Value: "ON"},
+		},
+	}
+}
+
+const sceneBody = `{"name":"Movie night","commands":[
+	{"sensor_id":14,"property":"state","value":"OFF"},{"sensor_id":15,"property":"state","value":"ON"}]}`
+
+func TestGetAllScenesHandler(t *testing.T) {
+	mockSvc := new(mockSceneService)
+	s := &Server{sceneService: mockSvc}
+	mockSvc.On("ServiceGetAllScenes", mock.Anything).Return([]scenes.Scene{sampleScene()}, nil)
+
+	router := setupSceneRouter("GET", "/scenes", s.GetAllScenes)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest("GET", "/scenes", nil))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	var result []gen.Scene
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &result))
+	require.Len(t, result, 1)
+	require.Len(t, result[0].Commands, 2)
+	assert.Equal(t, "tv-plug", *result[0].Commands[1].SensorName)
+	assert.Nil(t, result[0].LastActivationStatus)
+}
+
+func TestCreateSceneHandler(t *testing.T) {
+	mockSvc := new(mockSceneService)
+	s := &Server{sceneService: mockSvc}
+	created := sampleScene()
+	mockSvc.On("ServiceCreateScene", mock.Anything, mock.MatchedBy(func(scene *scenes.Scene) bool {
+		return scene.Name == "Movie night" && len(scene.Commands) == 2 && scene.Commands[1].SensorID == 15
+	})).Run(func(args mock.Arguments) {
+		args.Get(1).(*scenes.Scene).ID = 3
+	}).Return(nil)
+	mockSvc.On("ServiceGetSceneByID", mock.Anything, 3).Return(&created, nil)
+
+	router := setupSceneRouter("POST", "/scenes", s.CreateScene)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest("POST", "/scenes", bytes.NewBufferString(sceneBody)))
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+	assert.Contains(t, w.Body.String(), `"id": 3`)
+	mockSvc.AssertExpectations(t)
+}
+
+func TestCreateSceneHandler_InvalidScene(t *testing.T) {
+	mockSvc := new(mockSceneService)
+	s := &Server{sceneService: mockSvc}
+
+	body := `{"name":"Twice","commands":[
+		{"sensor_id":14,"property":"state","value":"OFF"},{"sensor_id":14,"property":"state","value":"ON"}]}`
+	router := setupSceneRouter("POST", "/scenes", s.CreateScene)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest("POST", "/scenes", bytes.NewBufferString(body)))
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), "already set by the scene")
+	mockSvc.AssertNotCalled(t, "ServiceCreateScene", mock.Anything, mock.Anything)
+}
+
+func TestUpdateSceneHandler_UncontrollableSensor(t *testing.T) {
+	mockSvc := new(mockSceneService)
+	s := &Server{sceneService: mockSvc}
+	mockSvc.On("ServiceUpdateScene", mock.Anything, mock.MatchedBy(func(scene *scenes.Scene) bool {
+		return scene.ID == 3
+	})).Return(fmt.Errorf("%w: sensor 15 is not controllable", service.ErrInvalidScene))
+
+	router := setupSceneRouter("PUT", "/scenes/:id", withSceneID(s.UpdateScene))
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest("PUT", "/scenes/3", bytes.NewBufferString(sceneBody)))
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), "not controllable")
+}
+
+func TestDeleteSceneHandler_NotFound(t *testing.T) {
+	mockSvc := new(mockSceneService)
+	s := &Server{sceneService: mockSvc}
+	mockSvc.On("ServiceDeleteScene", mock.Anything, 99).Return(service.ErrSceneNotFound)
+
+	router := setupSceneRouter("DELETE", "/scenes/:id", withSceneID(s.DeleteScene))
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest("DELETE", "/scenes/99", nil))
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestActivateSceneHandler(t *testing.T) {
+	mockSvc := new(mockSceneService)
+	s := &Server{sceneService: mockSvc}
+	actor := &gen.User{Id: 7, Permissions: []string{"control_sensors"}}
+	commandID, acknowledged := 41, "ON"
+	activation := &scenes.Activation{
+		SceneID: 3, SceneName: "Movie night", ActivatedAt: time.Date(2026, 3, 2, 20, 0, 0, 0, time.UTC),
+		Status: scenes.ActivationPartial,
+		Commands: []scenes.CommandOutcome{
+			{Command: sampleScene().Commands[0], Status: "failed", Error: "sensor 14 is not in a controllable state"},
+			{Command: sampleScene().Commands[1], CommandID: &commandID, Status: "acknowledged", AcknowledgedValue: &acknowledged},
+		},
+	}
+	mockSvc.On("ServiceActivateScene", mock.Anything, 3, actor, true).Return(activation, nil)
+
+	router := setupSceneRouter("POST", "/scenes/:id/activate", func(c *gin.Context) {
+		c.Set("currentUser", actor)
+		id, _ := strconv.Atoi(c.Param("id"))
+		s.ActivateScene(c, id, gen.ActivateSceneParams{Wait: func() *bool { wait := true; return &wait }()})
+	})
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest("POST", "/scenes/3/activate?wait=true", nil))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	var result gen.SceneActivation
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &result))
+	assert.Equal(t, gen.SceneActivationPartial, result.Status)
+	require.Len(t, result.Commands, 2)
+	assert.Nil(t, result.Commands[0].CommandId)
+	assert.Equal(t, "sensor 14 is not in a controllable state", *result.Commands[0].Error)
+	assert.Equal(t, gen.SceneCommandAcknowledged, result.Commands[1].Status)
+	assert.Equal(t, "ON", *result.Commands[1].AcknowledgedValue)
+	mockSvc.AssertExpectations(t)
+}
+
+func TestActivateSceneHandler_NotAuthenticated(t *testing.T) {
+	mockSvc := new(mockSceneService)
+	s := &Server{sceneService: mockSvc}
+
+	router := setupSceneRouter("POST", "/scenes/:id/activate", func(c *gin.Context) {
+		s.ActivateScene(c, 3, gen.ActivateSceneParams{})
+	})
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest("POST", "/scenes/3/activate", nil))
+
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+	mockSvc.AssertNotCalled(t, "ServiceActivateScene", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
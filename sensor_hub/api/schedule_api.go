@@ -0,0 +1,181 @@
+package api
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	gen "example/sensorHub/gen"
+	"example/sensorHub/scheduling"
+	"example/sensorHub/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+func toSchedule(s gen.CommandSchedule) scheduling.Schedule {
+	schedule := scheduling.Schedule{
+		Name:     s.Name,
+		Enabled:  s.Enabled,
+		SensorID: s.SensorId,
+		Property: s.Property,
+		Value:    s.Value,
+		Cron:     s.Cron,
+		Holiday:  scheduling.HolidaySkip,
+	}
+	if s.Holiday != nil {
+		schedule.Holiday = scheduling.HolidayBehaviour(*s.Holiday)
+	}
+	return schedule
+}
+
+func toGenSchedule(s scheduling.Schedule) gen.CommandSchedule {
+	id := s.ID
+	sensorName := s.SensorName
+	holiday := gen.CommandScheduleHoliday(s.Holiday)
+	lastError := s.LastError
+	return gen.CommandSchedule{
+		Id:            &id,
+		Name:          s.Name,
+		Enabled:       s.Enabled,
+		SensorId:      s.SensorID,
+		SensorName:    &sensorName,
+		Property:      s.Property,
+		Value:         s.Value,
+		Cron:          s.Cron,
+		Holiday:       &holiday,
+		NextRunAt:     s.NextRunAt,
+		LastRunAt:     s.LastRunAt,
+		LastCommandId: s.LastCommandID,
+		LastError:     &lastError,
+	}
+}
+
+// scheduleErrorStatus maps schedule service errors to HTTP statuses.
+func scheduleErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, service.ErrScheduleNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, service.ErrInvalidSchedule):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+func (s *Server) GetAllSchedules(c *gin.Context) {
+	ctx := c.Request.Context()
+	schedules, err := s.scheduleService.ServiceGetAllSchedules(ctx)
+	if err != nil {
+		slog.Error("error listing command schedules", "error", err)
+		c.IndentedJSON(http.StatusInternalServerError, gin.H{"message": "Error listing command schedules", "error": err.Error()})
+		return
+	}
+	result := make([]gen.CommandSchedule, len(schedules))
+	for i, schedule := range schedules {
+		result[i] = toGenSchedule(schedule)
+	}
+	c.IndentedJSON(http.StatusOK, result)
+}
+
+func (s *Server) GetScheduleById(c *gin.Context, id int) {
+	ctx := c.Request.Context()
+	schedule, err := s.scheduleService.ServiceGetScheduleByID(ctx, id)
+	if err != nil {
+		c.IndentedJSON(scheduleErrorStatus(err), gin.H{"message": "Error fetching command schedule", "error": err.Error()})
+		return
+	}
+	c.IndentedJSON(http.StatusOK, toGenSchedule(*schedule))
+}
+
+func (s *Server) CreateSchedule(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req gen.CommandSchedule
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.IndentedJSON(http.StatusBadRequest, gin.H{"message": "Invalid request body", "error": err.Error()})
+		return
+	}
+	schedule := toSchedule(req)
+	if err := schedule.Validate(); err != nil {
+		c.IndentedJSON(http.StatusBadRequest, gin.H{"message": "Invalid command schedule", "error": err.Error()})
+		return
+	}
+
+	if err := s.scheduleService.ServiceCreateSchedule(ctx, &schedule); err != nil {
+		slog.Error("error creating command schedule", "error", err)
+		c.IndentedJSON(scheduleErrorStatus(err), gin.H{"message": "Error creating command schedule", "error": err.Error()})
+		return
+	}
+	created, err := s.scheduleService.ServiceGetScheduleByID(ctx, schedule.ID)
+	if err != nil {
+		c.IndentedJSON(http.StatusCreated, toGenSchedule(schedule))
+		return
+	}
+	c.IndentedJSON(http.StatusCreated, toGenSchedule(*created))
+}
+
+func (s *Server) UpdateSchedule(c *gin.Context, id int) {
+	ctx := c.Request.Context()
+
+	var req gen.CommandSchedule
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.IndentedJSON(http.StatusBadRequest, gin.H{"message": "Invalid request body", "error": err.Error()})
+		return
+	}
+	schedule := toSchedule(req)
+	schedule.ID = id
+	if err := schedule.Validate(); err != nil {
+		c.IndentedJSON(http.StatusBadRequest, gin.H{"message": "Invalid command schedule", "error": err.Error()})
+		return
+	}
+
+	if err := s.scheduleService.ServiceUpdateSchedule(ctx, &schedule); err != nil {
+		slog.Error("error updating command schedule", "id", id, "error", err)
+		c.IndentedJSON(scheduleErrorStatus(err), gin.H{"message": "Error updating command schedule", "error": err.Error()})
+		return
+	}
+	c.IndentedJSON(http.StatusOK, gin.H{"message": "Command schedule updated successfully"})
+}
+
+func (s *Server) DeleteSchedule(c *gin.Context, id int) {
+	ctx := c.Request.Context()
+
+	if err := s.scheduleService.ServiceDeleteSchedule(ctx, id); err != nil {
+		slog.Error("error deleting command schedule", "id", id, "error", err)
+		c.IndentedJSON(scheduleErrorStatus(err), gin.H{"message": "Error deleting command schedule", "error": err.Error()})
+		return
+	}
+	c.IndentedJSON(http.StatusOK, gin.H{"message": "Command schedule deleted successfully"})
+}
+
+func (s *Server) GetHolidayMode(c *gin.Context) {
+	ctx := c.Request.Context()
+	mode, err := s.scheduleService.ServiceGetHolidayMode(ctx)
+	if err != nil {
+		slog.Error("error getting holiday mode", "error", err)
+		c.IndentedJSON(http.StatusInternalServerError, gin.H{"message": "Error getting holiday mode", "error": err.Error()})
+		return
+	}
+	c.IndentedJSON(http.StatusOK, gen.HolidayMode{Enabled: mode.Enabled, Until: mode.Until})
+}
+
+func (s *Server) SetHolidayMode(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var req gen.HolidayMode
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.IndentedJSON(http.StatusBadRequest, gin.H{"message": "Invalid request body", "error": err.Error()})
+		return
+	}
+	if err := s.scheduleService.ServiceSetHolidayMode(ctx, scheduling.HolidayMode{Enabled: req.Enabled, Until: req.Until}); err != nil {
+		slog.Error("error setting holiday mode", "error", err)
+		c.IndentedJSON(scheduleErrorStatus(err), gin.H{"message": "Error setting holiday mode", "error": err.Error()})
+		return
+	}
+	mode, err := s.scheduleService.ServiceGetHolidayMode(ctx)
+	if err != nil {
+		c.IndentedJSON(http.StatusOK, req)
+		return
+	}
+	c.IndentedJSON(http.StatusOK, gen.HolidayMode{Enabled: mode.Enabled, Until: mode.Until})
+}
@@ -0,0 +1,233 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	gen "example/sensorHub/gen"
+	"example/sensorHub/scheduling"
+	"example/sensorHub/service"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type mockScheduleService struct {
+	mock.Mock
+}
+
+func (m *mockScheduleService) ServiceGetAllSchedules(ctx context.Context) ([]scheduling.Schedule, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]scheduling.Schedule), args.Error(1)
+}
+
+func (m *mockScheduleService) ServiceGetScheduleByID(ctx context.Context, scheduleID int) (*scheduling.Schedule, error) {
+	args := m.Called(ctx, scheduleID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*scheduling.Schedule), args.Error(1)
+}
+
+func (m *mockScheduleService) ServiceCreateSchedule(ctx context.Context, schedule *scheduling.Schedule) error {
+	args := m.Called(ctx, schedule)
+	return args.Error(0)
+}
+
+func (m *mockScheduleService) ServiceUpdateSchedule(ctx context.Context, schedule *scheduling.Schedule) error {
+	args := m.Called(ctx, schedule)
+	return args.Error(0)
+}
+
+func (m *mockScheduleService) ServiceDeleteSchedule(ctx context.Context, scheduleID int) error {
+	args := m.Called(ctx, scheduleID)
+	return args.Error(0)
+}
+
+func (m *mockScheduleService) ServiceGetHolidayMode(ctx context.Context) (scheduling.HolidayMode, error) {
+	args := m.Called(ctx)
+	return args.Get(0).(scheduling.HolidayMode), args.Error(1)
+}
+
+func (m *mockScheduleService) ServiceSetHolidayMode(ctx context.Context, mode scheduling.HolidayMode) error {
+	args := m.Called(ctx, mode)
+	return args.Error(0)
+}
+
+func setupScheduleRouter(method, path string, handler gin.HandlerFunc) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Handle(method, path, handler)
+	return router
+}
+
+func withScheduleID(h func(*gin.Context, int)) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, _ := strconv.Atoi(c.Param("id"))
+		h(c, id)
+	}
+}
+
+func sampleSchedule() scheduling.Schedule {
+	next := time.Date(2026, 3, 2, 6, 30, 0, 0, time.UTC)
+	return scheduling.Schedule{
+		ID: 3, Name: "Heater weekday mornings", Enabled: true, SensorID: 2, SensorName: "heater-plug",
+		Property: "state", Value: "ON", Cron: "30 6 * * mon-fri", Holiday: scheduling.HolidaySkip, NextRunAt: &next,
+	}
+}
+
+const scheduleBody = `{"name":"Heater weekday mornings","enabled":true,"sensor_id":2,"property":"state","value":"ON","cron":"30 6 * * mon-fri"}`
+
+func TestGetAllSchedulesHandler(t *testing.T) {
+	mockSvc := new(mockScheduleService)
+	s := &Server{scheduleService: mockSvc}
+	mockSvc.On("ServiceGetAllSchedules", mock.Anything).Return([]scheduling.Schedule{sampleSchedule()}, nil)
+
+	router := setupScheduleRouter("GET", "/schedules", s.GetAllSchedules)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest("GET", "/schedules", nil))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	var schedules []gen.CommandSchedule
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &schedules))
+	require.Len(t, schedules, 1)
+	assert.Equal(t, "heater-plug", *schedules[0].SensorName)
+	assert.Equal(t, gen.ScheduleHolidaySkip, *schedules[0].Holiday)
+	assert.Equal(t, time.Date(2026, 3, 2, 6, 30, 0, 0, time.UTC), schedules[0].NextRunAt.UTC())
+	mockSvc.AssertExpectations(t)
+}
+
+func TestGetScheduleByIdHandler_NotFound(t *testing.T) {
+	mockSvc := new(mockScheduleService)
+	s := &Server{scheduleService: mockSvc}
+	mockSvc.On("ServiceGetScheduleByID", mock.Anything, 99).Return(nil, service.ErrScheduleNotFound)
+
+	router := setupScheduleRouter("GET", "/schedules/:id", withScheduleID(s.GetScheduleById))
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest("GET", "/schedules/99", nil))
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestCreateScheduleHandler_defaultsHolidayToSkip(t *testing.T) {
+	mockSvc := new(mockScheduleService)
+	s := &Server{scheduleService: mockSvc}
+	created := sampleSchedule()
+	mockSvc.On("ServiceCreateSchedule", mock.Anything, mock.MatchedBy(func(sc *scheduling.Schedule) bool {
+		return sc.Name == "Heater weekday mornings" && sc.Cron == "30 6 * * mon-fri" && sc.Holiday == scheduling.HolidaySkip
+	})).Run(func(args mock.Arguments) {
+		args.Get(1).(*scheduling.Schedule).ID = 3
+	}).Return(nil)
+	mockSvc.On("ServiceGetScheduleByID", mock.Anything, 3).Return(&created, nil)
+
+	router := setupScheduleRouter("POST", "/schedules", s.CreateSchedule)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest("POST", "/schedules", bytes.NewBufferString(scheduleBody)))
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+	assert.Contains(t, w.Body.String(), `"id": 3`)
+	mockSvc.AssertExpectations(t)
+}
+
+func TestCreateScheduleHandler_InvalidCron(t *testing.T) {
+	mockSvc := new(mockScheduleService)
+	s := &Server{scheduleService: mockSvc}
+
+	body := `{"name":"Broken","enabled":true,"sensor_id":2,"property":"state","value":"ON","cron":"61 * * * *"}`
+	router := setupScheduleRouter("POST", "/schedules", s.CreateSchedule)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest("POST", "/schedules", bytes.NewBufferString(body)))
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), "minute")
+	mockSvc.AssertNotCalled(t, "ServiceCreateSchedule", mock.Anything, mock.Anything)
+}
+
+func TestCreateScheduleHandler_UncontrollableSensor(t *testing.T) {
+	mockSvc := new(mockScheduleService)
+	s := &Server{scheduleService: mockSvc}
+	mockSvc.On("ServiceCreateSchedule", mock.Anything, mock.Anything).
+		Return(fmt.Errorf("%w: sensor 2 is not controllable", service.ErrInvalidSchedule))
+
+	router := setupScheduleRouter("POST", "/schedules", s.CreateSchedule)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest("POST", "/schedules", bytes.NewBufferString(scheduleBody)))
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), "not controllable")
+}
+
+func TestUpdateScheduleHandler(t *testing.T) {
+	mockSvc := new(mockScheduleService)
+	s := &Server{scheduleService: mockSvc}
+	mockSvc.On("ServiceUpdateSchedule", mock.Anything, mock.MatchedBy(func(sc *scheduling.Schedule) bool {
+		return sc.ID == 3
+	})).Return(nil)
+
+	router := setupScheduleRouter("PUT", "/schedules/:id", withScheduleID(s.UpdateSchedule))
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest("PUT", "/schedules/3", bytes.NewBufferString(scheduleBody)))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	mockSvc.AssertExpectations(t)
+}
+
+func TestDeleteScheduleHandler(t *testing.T) {
+	mockSvc := new(mockScheduleService)
+	s := &Server{scheduleService: mockSvc}
+	mockSvc.On("ServiceDeleteSchedule", mock.Anything, 3).Return(nil)
+
+	router := setupScheduleRouter("DELETE", "/schedules/:id", withScheduleID(s.DeleteSchedule))
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest("DELETE", "/schedules/3", nil))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	mockSvc.AssertExpectations(t)
+}
+
+func TestSetHolidayModeHandler(t *testing.T) {
+	mockSvc := new(mockScheduleService)
+	s := &Server{scheduleService: mockSvc}
+	until := time.Date(2026, 8, 16, 18, 0, 0, 0, time.UTC)
+	mockSvc.On("ServiceSetHolidayMode", mock.Anything, mock.MatchedBy(func(mode scheduling.HolidayMode) bool {
+		return mode.Enabled && mode.Until != nil && mode.Until.Equal(until)
+	})).Return(nil)
+	mockSvc.On("ServiceGetHolidayMode", mock.Anything).Return(scheduling.HolidayMode{Enabled: true, Until: &until}, nil)
+
+	router := setupScheduleRouter("PUT", "/schedules/holiday-mode", s.SetHolidayMode)
+	w := httptest.NewRecorder()
+	body := `{"enabled":true,"until":"2026-08-16T18:00:00Z"}`
+	router.ServeHTTP(w, httptest.NewRequest("PUT", "/schedules/holiday-mode", bytes.NewBufferString(body)))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	var mode gen.HolidayMode
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &mode))
+	assert.True(t, mode.Enabled)
+	mockSvc.AssertExpectations(t)
+}
+
+func TestSetHolidayModeHandler_EndInPast(t *testing.T) {
+	mockSvc := new(mockScheduleService)
+	s := &Server{scheduleService: mockSvc}
+	mockSvc.On("ServiceSetHolidayMode", mock.Anything, mock.Anything).
+		Return(fmt.Errorf("%w: holiday mode end must be in the future", service.ErrInvalidSchedule))
+
+	router := setupScheduleRouter("PUT", "/schedules/holiday-mode", s.SetHolidayMode)
+	w := httptest.NewRecorder()
+	body := `{"enabled":true,"until":"2020-01-01T00:00:00Z"}`
+	router.ServeHTTP(w, httptest.NewRequest("PUT", "/schedules/holiday-mode", bytes.NewBufferString(body)))
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
@@ -7,6 +7,7 @@ import (
 	gen "example/sensorHub/gen"
 	"example/sensorHub/service"
 	"example/sensorHub/ws"
+	"fmt"
 	"log/slog"
 	"net/http"
 	"strings"
@@ -113,11 +114,36 @@ func (s *Server) UpdateSensorById(c *gin.Context, id int) {
 		}
 	}
 
+	// expected_interval_seconds follows the same explicit-presence semantics as retention_hours.
+	expectedIntervalPresent := false
+	if rawInterval, exists := body["expected_interval_seconds"]; exists {
+		expectedIntervalPresent = true
+		if rawInterval == nil {
+			sensor.ExpectedIntervalSeconds = nil
+		} else if seconds, ok := rawInterval.(float64); ok {
+			if seconds <= 0 {
+				c.IndentedJSON(http.StatusBadRequest, gin.H{"message": "expected_interval_seconds must be a positive integer"})
+				return
+			}
+			sec := int(seconds)
+			sensor.ExpectedIntervalSeconds = &sec
+		} else {
+			c.IndentedJSON(http.StatusBadRequest, gin.H{"message": "expected_interval_seconds must be a positive integer or null"})
+			return
+		}
+	}
+
 	err = s.sensorService.ServiceUpdateSensorById(ctx, sensor, retentionHoursPresent)
 	if err != nil {
 		c.IndentedJSON(http.StatusInternalServerError, gin.H{"message": "Error updating sensor", "error": err.Error()})
 		return
 	}
+	if expectedIntervalPresent {
+		if err := s.sensorService.ServiceSetSensorExpectedInterval(ctx, id, sensor.ExpectedIntervalSeconds); err != nil {
+			c.IndentedJSON(http.StatusInternalServerError, gin.H{"message": "Error updating sensor", "error": err.Error()})
+			return
+		}
+	}
 	c.IndentedJSON(http.StatusOK, gin.H{"message": "Sensor updated successfully"})
 }
 
@@ -462,3 +488,34 @@ func (s *Server) GetAllMeasurementTypes(c *gin.Context, params gen.GetAllMeasure
 	}
 	c.IndentedJSON(http.StatusOK, mts)
 }
+
+func (s *Server) SetMeasurementTypeDownsampling(c *gin.Context, name string) {
+	var body gen.MeasurementTypeDownsampling
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.IndentedJSON(http.StatusBadRequest, gin.H{"message": "Invalid request body"})
+		return
+	}
+	if !body.Interval.Valid() {
+		c.IndentedJSON(http.StatusBadRequest, gin.H{"message": fmt.Sprintf("unsupported downsample interval %q", body.Interval)})
+		return
+	}
+	interval := string(body.Interval)
+	s.setMeasurementTypeDownsampling(c, name, &interval)
+}
+
+func (s *Server) DeleteMeasurementTypeDownsampling(c *gin.Context, name string) {
+	s.setMeasurementTypeDownsampling(c, name, nil)
+}
+
+func (s *Server) setMeasurementTypeDownsampling(c *gin.Context, name string, interval *string) {
+	mt, err := s.sensorService.ServiceSetMeasurementTypeDownsampling(c.Request.Context(), name, interval)
+	if err != nil {
+		c.IndentedJSON(http.StatusInternalServerError, gin.H{"message": err.Error()})
+		return
+	}
+	if mt == nil {
+		c.IndentedJSON(http.StatusNotFound, gin.H{"message": "Measurement type not found"})
+		return
+	}
+	c.IndentedJSON(http.StatusOK, mt)
+}
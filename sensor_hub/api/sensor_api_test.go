@@ -141,6 +141,55 @@ func TestUpdateSensorHandler_IgnoresMetadataFromRequest(t *testing.T) {
 	assert.Equal(t, http.StatusOK, w.Code)
 }
 
+func TestUpdateSensorHandler_SetsExpectedInterval(t *testing.T) {
+	router, api, s, mockService := setupSensorRouter()
+	api.PUT("/sensors/:id", func(c *gin.Context) {
+		var id int
+		if _, err := fmt.Sscan(c.Param("id"), &id); err != nil {
+			c.IndentedJSON(http.StatusBadRequest, gin.H{"message": "Invalid sensor ID"})
+			return
+		}
+		s.UpdateSensorById(c, id)
+	})
+
+	existing := gen.Sensor{Id: 1, Name: "door", SensorDriver: "mqtt-zigbee2mqtt", Config: map[string]string{}, Enabled: true}
+	jsonBody, _ := json.Marshal(map[string]interface{}{"expected_interval_seconds": 3600})
+
+	interval := 3600
+	expected := existing
+	expected.ExpectedIntervalSeconds = &interval
+
+	mockService.On("ServiceGetSensorById", mock.Anything, 1).Return(&existing, nil)
+	mockService.On("ServiceUpdateSensorById", mock.Anything, expected, false).Return(nil)
+	mockService.On("ServiceSetSensorExpectedInterval", mock.Anything, 1, &interval).Return(nil)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest("PUT", "/api/sensors/1", bytes.NewBuffer(jsonBody))
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	mockService.AssertExpectations(t)
+}
+
+func TestUpdateSensorHandler_RejectsNonPositiveExpectedInterval(t *testing.T) {
+	router, api, s, mockService := setupSensorRouter()
+	api.PUT("/sensors/:id", func(c *gin.Context) {
+		s.UpdateSensorById(c, 1)
+	})
+
+	existing := gen.Sensor{Id: 1, Name: "door", SensorDriver: "mqtt-zigbee2mqtt", Config: map[string]string{}, Enabled: true}
+	jsonBody, _ := json.Marshal(map[string]interface{}{"expected_interval_seconds": 0})
+
+	mockService.On("ServiceGetSensorById", mock.Anything, 1).Return(&existing, nil)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest("PUT", "/api/sensors/1", bytes.NewBuffer(jsonBody))
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	mockService.AssertNotCalled(t, "ServiceUpdateSensorById", mock.Anything, mock.Anything, mock.Anything)
+}
+
 func TestDeleteSensorHandler(t *testing.T) {
 	router, api, s, mockService := setupSensorRouter()
 	api.DELETE("/sensors/:name", func(c *gin.Context) {
@@ -949,3 +998,50 @@ func TestGetSensorMeasurementTypes(t *testing.T) {
 	assert.Equal(t, http.StatusOK, w.Code)
 	assert.Contains(t, w.Body.String(), "temperature")
 }
+
+func TestSetMeasurementTypeDownsampling(t *testing.T) {
+	router, _, s, mockService := setupSensorRouter()
+	router.PUT("/api/measurement-types/:name/downsampling", func(c *gin.Context) {
+		s.SetMeasurementTypeDownsampling(c, c.Param("name"))
+	})
+
+	interval := "PT1H"
+	mt := &gen.MeasurementType{Name: "temperature", RetentionDownsampleInterval: &interval}
+	mockService.On("ServiceSetMeasurementTypeDownsampling", mock.Anything, "temperature", &interval).Return(mt, nil)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest("PUT", "/api/measurement-types/temperature/downsampling", bytes.NewBufferString(`{"interval":"PT1H"}`))
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), "PT1H")
+}
+
+func TestSetMeasurementTypeDownsampling_InvalidInterval(t *testing.T) {
+	router, _, s, mockService := setupSensorRouter()
+	router.PUT("/api/measurement-types/:name/downsampling", func(c *gin.Context) {
+		s.SetMeasurementTypeDownsampling(c, c.Param("name"))
+	})
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest("PUT", "/api/measurement-types/temperature/downsampling", bytes.NewBufferString(`{"interval":"PT10S"}`))
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	mockService.AssertNotCalled(t, "ServiceSetMeasurementTypeDownsampling", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestDeleteMeasurementTypeDownsampling_NotFound(t *testing.T) {
+	router, _, s, mockService := setupSensorRouter()
+	router.DELETE("/api/measurement-types/:name/downsampling", func(c *gin.Context) {
+		s.DeleteMeasurementTypeDownsampling(c, c.Param("name"))
+	})
+
+	mockService.On("ServiceSetMeasurementTypeDownsampling", mock.Anything, "unknown", (*string)(nil)).Return(nil, nil)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest("DELETE", "/api/measurement-types/unknown/downsampling", nil)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
@@ -13,12 +13,19 @@ var _ gen.ServerInterface = (*Server)(nil)
 type Server struct {
 	sensorService       service.SensorServiceInterface
 	commandService      service.CommandServiceInterface
+	automationService   service.AutomationServiceInterface
+	scheduleService     service.ScheduleServiceInterface
+	sceneService        service.SceneServiceInterface
+	desiredStateService service.DesiredStateServiceInterface
 	readingsService     service.ReadingsServiceInterface
 	authService         service.AuthServiceInterface
 	userService         service.UserServiceInterface
 	roleService         service.RoleServiceInterface
 	alertService        service.AlertManagementServiceInterface
 	notificationService service.NotificationServiceInterface
+	webhookService      service.WebhookServiceInterface
+	pushService         service.PushServiceInterface
+	digestService       service.DigestServiceInterface
 	apiKeyService       service.ApiKeyServiceInterface
 	dashboardService    service.DashboardServiceInterface
 	propertiesService   service.PropertiesServiceInterface
@@ -31,12 +38,19 @@ type Server struct {
 func NewServer(
 	sensorService service.SensorServiceInterface,
 	commandService service.CommandServiceInterface,
+	automationService service.AutomationServiceInterface,
+	scheduleService service.ScheduleServiceInterface,
+	sceneService service.SceneServiceInterface,
+	desiredStateService service.DesiredStateServiceInterface,
 	readingsService service.ReadingsServiceInterface,
 	authService service.AuthServiceInterface,
 	userService service.UserServiceInterface,
 	roleService service.RoleServiceInterface,
 	alertService service.AlertManagementServiceInterface,
 	notificationService service.NotificationServiceInterface,
+	webhookService service.WebhookServiceInterface,
+	pushService service.PushServiceInterface,
+	digestService service.DigestServiceInterface,
 	apiKeyService service.ApiKeyServiceInterface,
 	dashboardService service.DashboardServiceInterface,
 	propertiesService service.PropertiesServiceInterface,
@@ -47,12 +61,19 @@ func NewServer(
 	return &Server{
 		sensorService:       sensorService,
 		commandService:      commandService,
+		automationService:   automationService,
+		scheduleService:     scheduleService,
+		sceneService:        sceneService,
+		desiredStateService: desiredStateService,
 		readingsService:     readingsService,
 		authService:         authService,
 		userService:         userService,
 		roleService:         roleService,
 		alertService:        alertService,
 		notificationService: notificationService,
+		webhookService:      webhookService,
+		pushService:         pushService,
+		digestService:       digestService,
 		apiKeyService:       apiKeyService,
 		dashboardService:    dashboardService,
 		propertiesService:   propertiesService,
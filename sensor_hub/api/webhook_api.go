@@ -0,0 +1,199 @@
+package api
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	gen "example/sensorHub/gen"
+	"example/sensorHub/notifications"
+	"example/sensorHub/service"
+	"example/sensorHub/webhook"
+
+	"github.com/gin-gonic/gin"
+)
+
+func toWebhookTarget(t gen.WebhookTarget) webhook.Target {
+	target := webhook.Target{
+		Name:       t.Name,
+		URL:        t.Url,
+		Headers:    map[string]string{},
+		Categories: []notifications.NotificationCategory{},
+		Enabled:    t.Enabled,
+	}
+	if t.Secret != nil {
+		target.Secret = *t.Secret
+	}
+	if t.Headers != nil {
+		target.Headers = *t.Headers
+	}
+	if t.BodyTemplate != nil {
+		target.BodyTemplate = *t.BodyTemplate
+	}
+	if t.Categories != nil {
+		for _, c := range *t.Categories {
+			target.Categories = append(target.Categories, notifications.NotificationCategory(c))
+		}
+	}
+	return target
+}
+
+// toGenWebhookTarget converts a target for a response. The secret itself is never returned.
+func toGenWebhookTarget(t webhook.Target) gen.WebhookTarget {
+	id := t.ID
+	hasSecret := t.Secret != ""
+	global := t.UserID == nil
+	headers := t.Headers
+	bodyTemplate := t.BodyTemplate
+	categories := make([]gen.WebhookTargetCategories, len(t.Categories))
+	for i, c := range t.Categories {
+		categories[i] = gen.WebhookTargetCategories(c)
+	}
+	return gen.WebhookTarget{
+		Id:           &id,
+		Name:         t.Name,
+		Url:          t.URL,
+		HasSecret:    &hasSecret,
+		Headers:      &headers,
+		BodyTemplate: &bodyTemplate,
+		Categories:   &categories,
+		Enabled:      t.Enabled,
+		Global:       &global,
+	}
+}
+
+func toGenWebhookDelivery(d webhook.Delivery) gen.WebhookDelivery {
+	return gen.WebhookDelivery{
+		Id:             d.ID,
+		TargetId:       d.TargetID,
+		NotificationId: d.NotificationID,
+		Status:         gen.WebhookDeliveryStatus(d.Status),
+		Attempts:       d.Attempts,
+		ResponseCode:   d.ResponseCode,
+		Error:          d.Error,
+		CreatedAt:      d.CreatedAt,
+	}
+}
+
+// webhookErrorStatus maps webhook service errors to HTTP statuses.
+func webhookErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, service.ErrWebhookTargetNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, service.ErrGlobalWebhookForbidden):
+		return http.StatusForbidden
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+func (s *Server) ListWebhookTargets(c *gin.Context) {
+	ctx := c.Request.Context()
+	user := c.MustGet("currentUser").(*gen.User)
+
+	targets, err := s.webhookService.ServiceListWebhookTargets(ctx, user)
+	if err != nil {
+		slog.Error("error listing webhook targets", "error", err)
+		c.IndentedJSON(http.StatusInternalServerError, gin.H{"message": "Error listing webhook targets", "error": err.Error()})
+		return
+	}
+	result := make([]gen.WebhookTarget, len(targets))
+	for i, t := range targets {
+		result[i] = toGenWebhookTarget(t)
+	}
+	c.IndentedJSON(http.StatusOK, result)
+}
+
+func (s *Server) CreateWebhookTarget(c *gin.Context) {
+	ctx := c.Request.Context()
+	user := c.MustGet("currentUser").(*gen.User)
+
+	var req gen.WebhookTarget
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.IndentedJSON(http.StatusBadRequest, gin.H{"message": "Invalid request body", "error": err.Error()})
+		return
+	}
+	target := toWebhookTarget(req)
+	if err := target.Validate(); err != nil {
+		c.IndentedJSON(http.StatusBadRequest, gin.H{"message": "Invalid webhook target", "error": err.Error()})
+		return
+	}
+
+	global := req.Global != nil && *req.Global
+	if err := s.webhookService.ServiceCreateWebhookTarget(ctx, user, &target, global); err != nil {
+		slog.Error("error creating webhook target", "error", err)
+		c.IndentedJSON(webhookErrorStatus(err), gin.H{"message": "Error creating webhook target", "error": err.Error()})
+		return
+	}
+	c.IndentedJSON(http.StatusCreated, toGenWebhookTarget(target))
+}
+
+func (s *Server) UpdateWebhookTarget(c *gin.Context, id int) {
+	ctx := c.Request.Context()
+	user := c.MustGet("currentUser").(*gen.User)
+
+	var req gen.WebhookTarget
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.IndentedJSON(http.StatusBadRequest, gin.H{"message": "Invalid request body", "error": err.Error()})
+		return
+	}
+	target := toWebhookTarget(req)
+	target.ID = id
+	if err := target.Validate(); err != nil {
+		c.IndentedJSON(http.StatusBadRequest, gin.H{"message": "Invalid webhook target", "error": err.Error()})
+		return
+	}
+
+	if err := s.webhookService.ServiceUpdateWebhookTarget(ctx, user, &target, req.Secret); err != nil {
+		slog.Error("error updating webhook target", "id", id, "error", err)
+		c.IndentedJSON(webhookErrorStatus(err), gin.H{"message": "Error updating webhook target", "error": err.Error()})
+		return
+	}
+	c.IndentedJSON(http.StatusOK, gin.H{"message": "Webhook target updated successfully"})
+}
+
+func (s *Server) DeleteWebhookTarget(c *gin.Context, id int) {
+	ctx := c.Request.Context()
+	user := c.MustGet("currentUser").(*gen.User)
+
+	if err := s.webhookService.ServiceDeleteWebhookTarget(ctx, user, id); err != nil {
+		slog.Error("error deleting webhook target", "id", id, "error", err)
+		c.IndentedJSON(webhookErrorStatus(err), gin.H{"message": "Error deleting webhook target", "error": err.Error()})
+		return
+	}
+	c.IndentedJSON(http.StatusOK, gin.H{"message": "Webhook target deleted successfully"})
+}
+
+func (s *Server) GetWebhookDeliveries(c *gin.Context, id int, params gen.GetWebhookDeliveriesParams) {
+	ctx := c.Request.Context()
+	user := c.MustGet("currentUser").(*gen.User)
+	limit := 50
+	if params.Limit != nil && *params.Limit > 0 {
+		limit = min(*params.Limit, 100)
+	}
+
+	deliveries, err := s.webhookService.ServiceGetWebhookDeliveries(ctx, user, id, limit)
+	if err != nil {
+		slog.Error("error fetching webhook deliveries", "id", id, "error", err)
+		c.IndentedJSON(webhookErrorStatus(err), gin.H{"message": "Error fetching webhook deliveries", "error": err.Error()})
+		return
+	}
+	result := make([]gen.WebhookDelivery, len(deliveries))
+	for i, d := range deliveries {
+		result[i] = toGenWebhookDelivery(d)
+	}
+	c.IndentedJSON(http.StatusOK, result)
+}
+
+func (s *Server) TestWebhookTarget(c *gin.Context, id int) {
+	ctx := c.Request.Context()
+	user := c.MustGet("currentUser").(*gen.User)
+
+	delivery, err := s.webhookService.ServiceTestWebhookTarget(ctx, user, id)
+	if err != nil {
+		slog.Error("error testing webhook target", "id", id, "error", err)
+		c.IndentedJSON(webhookErrorStatus(err), gin.H{"message": "Error testing webhook target", "error": err.Error()})
+		return
+	}
+	c.IndentedJSON(http.StatusOK, toGenWebhookDelivery(*delivery))
+}
@@ -0,0 +1,180 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	gen "example/sensorHub/gen"
+	"example/sensorHub/service"
+	"example/sensorHub/webhook"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type mockWebhookService struct {
+	mock.Mock
+}
+
+func (m *mockWebhookService) ServiceListWebhookTargets(ctx context.Context, actor *gen.User) ([]webhook.Target, error) {
+	args := m.Called(ctx, actor)
+	return args.Get(0).([]webhook.Target), args.Error(1)
+}
+
+func (m *mockWebhookService) ServiceGetWebhookTarget(ctx context.Context, actor *gen.User, targetID int) (*webhook.Target, error) {
+	args := m.Called(ctx, actor, targetID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*webhook.Target), args.Error(1)
+}
+
+func (m *mockWebhookService) ServiceCreateWebhookTarget(ctx context.Context, actor *gen.User, target *webhook.Target, global bool) error {
+	args := m.Called(ctx, actor, target, global)
+	return args.Error(0)
+}
+
+func (m *mockWebhookService) ServiceUpdateWebhookTarget(ctx context.Context, actor *gen.User, target *webhook.Target, secret *string) error {
+	args := m.Called(ctx, actor, target, secret)
+	return args.Error(0)
+}
+
+func (m *mockWebhookService) ServiceDeleteWebhookTarget(ctx context.Context, actor *gen.User, targetID int) error {
+	args := m.Called(ctx, actor, targetID)
+	return args.Error(0)
+}
+
+func (m *mockWebhookService) ServiceGetWebhookDeliveries(ctx context.Context, actor *gen.User, targetID, limit int) ([]webhook.Delivery, error) {
+	args := m.Called(ctx, actor, targetID, limit)
+	return args.Get(0).([]webhook.Delivery), args.Error(1)
+}
+
+func (m *mockWebhookService) ServiceTestWebhookTarget(ctx context.Context, actor *gen.User, targetID int) (*webhook.Delivery, error) {
+	args := m.Called(ctx, actor, targetID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*webhook.Delivery), args.Error(1)
+}
+
+func TestListWebhookTargets_HidesSecret(t *testing.T) {
+	mockService := new(mockWebhookService)
+	s := &Server{webhookService: mockService}
+	userID := 7
+	mockService.On("ServiceListWebhookTargets", mock.Anything, mock.Anything).Return([]webhook.Target{
+		{ID: 1, UserID: &userID, Name: "phone", URL: "https://ntfy.example.com/me", Secret: "s3cret", Enabled: true},
+		{ID: 2, Name: "ops", URL: "https://ops.example.com", Enabled: true},
+	}, nil)
+
+	router := alertRouterAsUser(http.MethodGet, "/notifications/webhooks", s.ListWebhookTargets)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/notifications/webhooks", nil))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.NotContains(t, w.Body.String(), "s3cret")
+	var targets []gen.WebhookTarget
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &targets))
+	require.Len(t, targets, 2)
+	assert.True(t, *targets[0].HasSecret)
+	assert.False(t, *targets[0].Global)
+	assert.True(t, *targets[1].Global)
+}
+
+func TestCreateWebhookTarget_Success(t *testing.T) {
+	mockService := new(mockWebhookService)
+	s := &Server{webhookService: mockService}
+	mockService.On("ServiceCreateWebhookTarget", mock.Anything, mock.Anything, mock.MatchedBy(func(target *webhook.Target) bool {
+		return target.Name == "ops" && target.Secret == "s3cret" && target.Headers["X-Api-Key"] == "abc"
+	}), true).Return(nil)
+
+	body := `{"name":"ops","url":"https://ops.example.com","secret":"s3cret","headers":{"X-Api-Key":"abc"},"enabled":true,"global":true}`
+	router := alertRouterAsUser(http.MethodPost, "/notifications/webhooks", s.CreateWebhookTarget)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/api/notifications/webhooks", bytes.NewBufferString(body)))
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+	assert.NotContains(t, w.Body.String(), "s3cret")
+	mockService.AssertExpectations(t)
+}
+
+func TestCreateWebhookTarget_InvalidURL(t *testing.T) {
+	mockService := new(mockWebhookService)
+	s := &Server{webhookService: mockService}
+
+	body := `{"name":"ops","url":"not a url","enabled":true}`
+	router := alertRouterAsUser(http.MethodPost, "/notifications/webhooks", s.CreateWebhookTarget)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/api/notifications/webhooks", bytes.NewBufferString(body)))
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	mockService.AssertNotCalled(t, "ServiceCreateWebhookTarget", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestCreateWebhookTarget_GlobalForbidden(t *testing.T) {
+	mockService := new(mockWebhookService)
+	s := &Server{webhookService: mockService}
+	mockService.On("ServiceCreateWebhookTarget", mock.Anything, mock.Anything, mock.Anything, true).Return(service.ErrGlobalWebhookForbidden)
+
+	body := `{"name":"ops","url":"https://ops.example.com","enabled":true,"global":true}`
+	router := alertRouterAsUser(http.MethodPost, "/notifications/webhooks", s.CreateWebhookTarget)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/api/notifications/webhooks", bytes.NewBufferString(body)))
+
+	assert.Equal(t, http.StatusForbidden, w.Code)
+}
+
+func TestUpdateWebhookTarget_NotFound(t *testing.T) {
+	mockService := new(mockWebhookService)
+	s := &Server{webhookService: mockService}
+	mockService.On("ServiceUpdateWebhookTarget", mock.Anything, mock.Anything, mock.Anything, (*string)(nil)).Return(service.ErrWebhookTargetNotFound)
+
+	body := `{"name":"ops","url":"https://ops.example.com","enabled":false}`
+	router := alertRouterAsUser(http.MethodPut, "/notifications/webhooks/:id", func(c *gin.Context) { s.UpdateWebhookTarget(c, 9) })
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodPut, "/api/notifications/webhooks/9", bytes.NewBufferString(body)))
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestGetWebhookDeliveries_CapsLimit(t *testing.T) {
+	mockService := new(mockWebhookService)
+	s := &Server{webhookService: mockService}
+	code := 200
+	mockService.On("ServiceGetWebhookDeliveries", mock.Anything, mock.Anything, 3, 100).Return([]webhook.Delivery{
+		{ID: 1, TargetID: 3, Status: webhook.DeliveryStatusDelivered, Attempts: 1, ResponseCode: &code},
+	}, nil)
+
+	limit := 500
+	router := alertRouterAsUser(http.MethodGet, "/notifications/webhooks/:id/deliveries", func(c *gin.Context) {
+		s.GetWebhookDeliveries(c, 3, gen.GetWebhookDeliveriesParams{Limit: &limit})
+	})
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/notifications/webhooks/3/deliveries", nil))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	var deliveries []gen.WebhookDelivery
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &deliveries))
+	require.Len(t, deliveries, 1)
+	assert.Equal(t, gen.WebhookDeliveryStatus("delivered"), deliveries[0].Status)
+}
+
+func TestTestWebhookTarget_ReturnsDelivery(t *testing.T) {
+	mockService := new(mockWebhookService)
+	s := &Server{webhookService: mockService}
+	mockService.On("ServiceTestWebhookTarget", mock.Anything, mock.Anything, 3).Return(&webhook.Delivery{
+		ID: 4, TargetID: 3, Status: webhook.DeliveryStatusFailed, Attempts: 3, Error: "unexpected status 500",
+	}, nil)
+
+	router := alertRouterAsUser(http.MethodPost, "/notifications/webhooks/:id/test", func(c *gin.Context) { s.TestWebhookTarget(c, 3) })
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/api/notifications/webhooks/3/test", nil))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), "unexpected status 500")
+}
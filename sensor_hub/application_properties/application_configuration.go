@@ -6,16 +6,37 @@ import (
 )
 
 type ApplicationConfiguration struct {
-	SensorCollectionInterval   int    `prop:"sensor.collection.interval" default:"300" file:"application" validate:"positive"`
-	SensorDiscoverySkip        bool   `prop:"sensor.discovery.skip" default:"true" file:"application"`
-	OpenAPILocation            string `prop:"openapi.yaml.location" default:"./docker_tests/openapi.yaml" file:"application"`
-	HealthHistoryRetentionDays int    `prop:"health.history.retention.days" default:"30" file:"application" validate:"non_negative"`
-	SensorDataRetentionDays    int    `prop:"sensor.data.retention.days" default:"90" file:"application" validate:"non_negative"`
-	FailedLoginRetentionDays   int    `prop:"failed.login.retention.days" default:"2" file:"application" validate:"non_negative"`
-	AlertHistoryRetentionDays  int    `prop:"alert.history.retention.days" default:"90" file:"application" validate:"non_negative"`
-	DataCleanupIntervalHours   int    `prop:"data.cleanup.interval.hours" default:"1" file:"application" validate:"positive"`
-
-	SMTPUser string `prop:"smtp.user" default:"" file:"smtp"`
+	SensorCollectionInterval     int    `prop:"sensor.collection.interval" default:"300" file:"application" validate:"positive"`
+	SensorDiscoverySkip          bool   `prop:"sensor.discovery.skip" default:"true" file:"application"`
+	OpenAPILocation              string `prop:"openapi.yaml.location" default:"./docker_tests/openapi.yaml" file:"application"`
+	HealthHistoryRetentionDays   int    `prop:"health.history.retention.days" default:"30" file:"application" validate:"non_negative"`
+	SensorDataRetentionDays      int    `prop:"sensor.data.retention.days" default:"90" file:"application" validate:"non_negative"`
+	DownsampledDataRetentionDays int    `prop:"sensor.data.downsampled.retention.days" default:"0" file:"application" validate:"non_negative"`
+	FailedLoginRetentionDays     int    `prop:"failed.login.retention.days" default:"2" file:"application" validate:"non_negative"`
+	AlertHistoryRetentionDays    int    `prop:"alert.history.retention.days" default:"90" file:"application" validate:"non_negative"`
+	DataCleanupIntervalHours     int    `prop:"data.cleanup.interval.hours" default:"1" file:"application" validate:"positive"`
+
+	SensorStaleCheckIntervalSeconds   int `prop:"sensor.stale.check.interval.seconds" default:"300" file:"application" validate:"positive"`
+	SensorStaleDefaultIntervalSeconds int `prop:"sensor.stale.default.interval.seconds" default:"0" file:"application" validate:"non_negative"`
+
+	AlertEscalationCheckIntervalSeconds int `prop:"alert.escalation.check.interval.seconds" default:"60" file:"application" validate:"positive"`
+
+	SMTPUser     string `prop:"smtp.user" default:"" file:"smtp"`
+	SMTPHost     string `prop:"smtp.host" default:"smtp.gmail.com" file:"smtp" validate:"non_empty"`
+	SMTPPort     int    `prop:"smtp.port" default:"587" file:"smtp" validate:"positive"`
+	SMTPAuth     string `prop:"smtp.auth" default:"oauth" file:"smtp" validate:"oneof=oauth plain login none"`
+	SMTPTLS      string `prop:"smtp.tls" default:"starttls" file:"smtp" validate:"oneof=starttls implicit none"`
+	SMTPPassword string `prop:"smtp.password" default:"" file:"smtp" sensitive:"true"`
+	SMTPFrom     string `prop:"smtp.from" default:"" file:"smtp"`
+
+	WebhookTimeoutSeconds      int `prop:"webhook.timeout.seconds" default:"10" file:"application" validate:"positive"`
+	WebhookMaxAttempts         int `prop:"webhook.max.attempts" default:"3" file:"application" validate:"positive"`
+	WebhookRetryBackoffSeconds int `prop:"webhook.retry.backoff.seconds" default:"2" file:"application" validate:"non_negative"`
+
+	PushTimeoutSeconds int `prop:"push.timeout.seconds" default:"10" file:"application" validate:"positive"`
+
+	NotificationDigestDailyHour            int `prop:"notification.digest.daily.hour" default:"8" file:"application" validate:"hour"`
+	NotificationDigestCheckIntervalSeconds int `prop:"notification.digest.check.interval.seconds" default:"60" file:"application" validate:"positive"`
 
 	DatabasePath string `prop:"database.path" default:"data/sensor_hub.db" file:"database" validate:"non_empty"`
 
@@ -42,8 +63,17 @@ type ApplicationConfiguration struct {
 
 	ActuatorCommandTimeoutSeconds int `prop:"actuator.command.timeout_seconds" default:"10" file:"application" validate:"positive"`
 
+	HomeAssistantPublishEnabled         bool   `prop:"homeassistant.publish.enabled" default:"false" file:"application"`
+	HomeAssistantPublishBrokerID        int    `prop:"homeassistant.publish.broker.id" default:"0" file:"application" validate:"non_negative"`
+	HomeAssistantPublishDiscoveryPrefix string `prop:"homeassistant.publish.discovery.prefix" default:"homeassistant" file:"application"`
+	HomeAssistantPublishTopicPrefix     string `prop:"homeassistant.publish.topic.prefix" default:"sensor-hub" file:"application"`
+
 	ReadingsAggregationEnabled bool   `prop:"readings.aggregation.enabled" default:"true" file:"application"`
 	ReadingsAggregationTiers   string `prop:"readings.aggregation.tiers" default:"PT15M:raw,PT1H:PT10S,PT6H:PT1M,P1D:PT5M,P7D:PT15M,P30D:PT1H" file:"application"`
+
+	ReadingsRollupRefreshIntervalSeconds int `prop:"readings.rollup.refresh.interval.seconds" default:"300" file:"application" validate:"positive"`
+	ReadingsRollup5mRetentionDays        int `prop:"readings.rollup.5m.retention.days" default:"365" file:"application" validate:"non_negative"`
+	ReadingsRollup1hRetentionDays        int `prop:"readings.rollup.1h.retention.days" default:"0" file:"application" validate:"non_negative"`
 }
 
 var AppConfig *ApplicationConfiguration
@@ -49,8 +49,8 @@ func validateApplicationProperties() error {
 }
 
 func validateSMTPProperties() error {
-	if smtpProperties["smtp.user"] == "" {
-		slog.Warn("smtp.user is empty, email alerts will not be sent; check smtp.properties file")
+	if smtpProperties["smtp.user"] == "" && smtpProperties["smtp.from"] == "" {
+		slog.Warn("smtp.user and smtp.from are empty, email alerts will not be sent; check smtp.properties file")
 	}
 	return nil
 }
@@ -13,32 +13,47 @@ import (
 // validAppPropsMap returns a complete valid application properties map
 func validAppPropsMap() map[string]string {
 	return map[string]string{
-		"sensor.collection.interval":           "300",
-		"sensor.discovery.skip":                "true",
-		"openapi.yaml.location":                "/path/to/openapi.yaml",
-		"health.history.retention.days":        "180",
-		"sensor.data.retention.days":           "365",
-		"data.cleanup.interval.hours":          "24",
-		"failed.login.retention.days":          "2",
-		"auth.bcrypt.cost":                     "12",
-		"auth.session.ttl.minutes":             "43200",
-		"auth.session.cookie.name":             "sensor_hub_session",
-		"auth.login.backoff.window.minutes":    "15",
-		"auth.login.backoff.threshold":         "5",
-		"auth.login.backoff.base.seconds":      "2",
-		"auth.login.backoff.max.seconds":       "300",
-		"oauth.credentials.file.path":          "configuration/credentials.json",
-		"oauth.token.file.path":                "configuration/token.json",
-		"oauth.token.refresh.interval.minutes": "30",
-		"mqtt.broker.enabled":                  "true",
-		"mqtt.broker.port":                     "1883",
-		"actuator.command.timeout_seconds":     "10",
+		"sensor.collection.interval":                 "300",
+		"sensor.discovery.skip":                      "true",
+		"openapi.yaml.location":                      "/path/to/openapi.yaml",
+		"health.history.retention.days":              "180",
+		"sensor.data.retention.days":                 "365",
+		"data.cleanup.interval.hours":                "24",
+		"failed.login.retention.days":                "2",
+		"auth.bcrypt.cost":                           "12",
+		"auth.session.ttl.minutes":                   "43200",
+		"auth.session.cookie.name":                   "sensor_hub_session",
+		"auth.login.backoff.window.minutes":          "15",
+		"auth.login.backoff.threshold":               "5",
+		"auth.login.backoff.base.seconds":            "2",
+		"auth.login.backoff.max.seconds":             "300",
+		"oauth.credentials.file.path":                "configuration/credentials.json",
+		"oauth.token.file.path":                      "configuration/token.json",
+		"oauth.token.refresh.interval.minutes":       "30",
+		"mqtt.broker.enabled":                        "true",
+		"mqtt.broker.port":                           "1883",
+		"actuator.command.timeout_seconds":           "10",
+		"sensor.stale.check.interval.seconds":        "300",
+		"webhook.timeout.seconds":                    "10",
+		"webhook.max.attempts":                       "3",
+		"push.timeout.seconds":                       "10",
+		"notification.digest.daily.hour":             "8",
+		"notification.digest.check.interval.seconds": "60",
+		"alert.escalation.check.interval.seconds":    "60",
+		"readings.rollup.refresh.interval.seconds":   "300",
+		"readings.rollup.5m.retention.days":          "365",
+		"readings.rollup.1h.retention.days":          "0",
+		"sensor.data.downsampled.retention.days":     "0",
 	}
 }
 
 func validSmtpPropsMap() map[string]string {
 	return map[string]string{
 		"smtp.user": "user@example.com",
+		"smtp.host": "smtp.example.com",
+		"smtp.port": "587",
+		"smtp.auth": "oauth",
+		"smtp.tls":  "starttls",
 	}
 }
 
@@ -324,24 +339,39 @@ func TestConvertConfigurationToMaps_ZeroValues(t *testing.T) {
 
 func TestConvertConfigurationToMaps_RoundTrip(t *testing.T) {
 	original := &ApplicationConfiguration{
-		SensorCollectionInterval:      600,
-		SensorDiscoverySkip:           false,
-		OpenAPILocation:               "/api/spec.yaml",
-		HealthHistoryRetentionDays:    90,
-		SensorDataRetentionDays:       180,
-		DataCleanupIntervalHours:      12,
-		FailedLoginRetentionDays:      7,
-		AuthBcryptCost:                14,
-		AuthSessionTTLMinutes:         60,
-		AuthSessionCookieName:         "test_session",
-		AuthLoginBackoffWindowMinutes: 30,
-		AuthLoginBackoffThreshold:     10,
-		AuthLoginBackoffBaseSeconds:   5,
-		AuthLoginBackoffMaxSeconds:    600,
-		SMTPUser:                      "smtp@test.com",
-		DatabasePath:                  "test/roundtrip.db",
-		MQTTBrokerPort:                1883,
-		ActuatorCommandTimeoutSeconds: 25,
+		SensorCollectionInterval:               600,
+		SensorDiscoverySkip:                    false,
+		OpenAPILocation:                        "/api/spec.yaml",
+		HealthHistoryRetentionDays:             90,
+		SensorDataRetentionDays:                180,
+		DataCleanupIntervalHours:               12,
+		FailedLoginRetentionDays:               7,
+		AuthBcryptCost:                         14,
+		AuthSessionTTLMinutes:                  60,
+		AuthSessionCookieName:                  "test_session",
+		AuthLoginBackoffWindowMinutes:          30,
+		AuthLoginBackoffThreshold:              10,
+		AuthLoginBackoffBaseSeconds:            5,
+		AuthLoginBackoffMaxSeconds:             600,
+		SMTPUser:                               "smtp@test.com",
+		SMTPHost:                               "relay.test.com",
+		SMTPPort:                               465,
+		SMTPAuth:                               "login",
+		SMTPTLS:                                "implicit",
+		DatabasePath:                           "test/roundtrip.db",
+		MQTTBrokerPort:                         1883,
+		ActuatorCommandTimeoutSeconds:          25,
+		SensorStaleCheckIntervalSeconds:        600,
+		WebhookTimeoutSeconds:                  5,
+		WebhookMaxAttempts:                     4,
+		PushTimeoutSeconds:                     15,
+		NotificationDigestDailyHour:            0,
+		NotificationDigestCheckIntervalSeconds: 30,
+		AlertEscalationCheckIntervalSeconds:    45,
+		ReadingsRollupRefreshIntervalSeconds:   120,
+		ReadingsRollup5mRetentionDays:          180,
+		ReadingsRollup1hRetentionDays:          1825,
+		DownsampledDataRetentionDays:           3650,
 	}
 
 	appProps, smtpProps, dbProps := ConvertConfigurationToMaps(original)
@@ -352,8 +382,21 @@ func TestConvertConfigurationToMaps_RoundTrip(t *testing.T) {
 	assert.Equal(t, original.SensorDiscoverySkip, restored.SensorDiscoverySkip)
 	assert.Equal(t, original.AuthBcryptCost, restored.AuthBcryptCost)
 	assert.Equal(t, original.SMTPUser, restored.SMTPUser)
+	assert.Equal(t, original.SMTPHost, restored.SMTPHost)
+	assert.Equal(t, original.SMTPAuth, restored.SMTPAuth)
+	assert.Equal(t, original.SMTPTLS, restored.SMTPTLS)
 	assert.Equal(t, original.DatabasePath, restored.DatabasePath)
 	assert.Equal(t, original.ActuatorCommandTimeoutSeconds, restored.ActuatorCommandTimeoutSeconds)
+	assert.Equal(t, original.SensorStaleCheckIntervalSeconds, restored.SensorStaleCheckIntervalSeconds)
+	assert.Equal(t, original.WebhookMaxAttempts, restored.WebhookMaxAttempts)
+	assert.Equal(t, original.PushTimeoutSeconds, restored.PushTimeoutSeconds)
+	assert.Equal(t, original.NotificationDigestDailyHour, restored.NotificationDigestDailyHour)
+	assert.Equal(t, original.NotificationDigestCheckIntervalSeconds, restored.NotificationDigestCheckIntervalSeconds)
+	assert.Equal(t, original.AlertEscalationCheckIntervalSeconds, restored.AlertEscalationCheckIntervalSeconds)
+	assert.Equal(t, original.ReadingsRollupRefreshIntervalSeconds, restored.ReadingsRollupRefreshIntervalSeconds)
+	assert.Equal(t, original.ReadingsRollup5mRetentionDays, restored.ReadingsRollup5mRetentionDays)
+	assert.Equal(t, original.ReadingsRollup1hRetentionDays, restored.ReadingsRollup1hRetentionDays)
+	assert.Equal(t, original.DownsampledDataRetentionDays, restored.DownsampledDataRetentionDays)
 }
 
 // ============================================================================
@@ -432,6 +475,49 @@ func TestLoadConfigurationFromMaps_EmptyDatabasePath(t *testing.T) {
 	assert.Contains(t, err.Error(), "database.path must not be empty")
 }
 
+func TestLoadConfigurationFromMaps_InvalidSMTPAuth(t *testing.T) {
+	smtpProps := validSmtpPropsMap()
+	smtpProps["smtp.auth"] = "cram-md5"
+
+	cfg, err := LoadConfigurationFromMaps(validAppPropsMap(), smtpProps, validDbPropsMap())
+
+	assert.Error(t, err)
+	assert.Nil(t, cfg)
+	assert.Contains(t, err.Error(), "must be one of oauth, plain, login, none")
+}
+
+func TestLoadConfigurationFromMaps_InvalidNotificationDigestDailyHour(t *testing.T) {
+	appProps := validAppPropsMap()
+	appProps["notification.digest.daily.hour"] = "24"
+
+	cfg, err := LoadConfigurationFromMaps(appProps, validSmtpPropsMap(), validDbPropsMap())
+
+	assert.Error(t, err)
+	assert.Nil(t, cfg)
+	assert.Contains(t, err.Error(), "must be an hour from 0 to 23")
+}
+
+func TestLoadConfigurationFromMaps_GenericSMTPRelay(t *testing.T) {
+	smtpProps := map[string]string{
+		"smtp.user":     "",
+		"smtp.from":     "sensor-hub@example.com",
+		"smtp.host":     "relay.example.com",
+		"smtp.port":     "25",
+		"smtp.auth":     "none",
+		"smtp.tls":      "none",
+		"smtp.password": "",
+	}
+
+	cfg, err := LoadConfigurationFromMaps(validAppPropsMap(), smtpProps, validDbPropsMap())
+
+	assert.NoError(t, err)
+	assert.Equal(t, "relay.example.com", cfg.SMTPHost)
+	assert.Equal(t, 25, cfg.SMTPPort)
+	assert.Equal(t, "none", cfg.SMTPAuth)
+	assert.Equal(t, "none", cfg.SMTPTLS)
+	assert.Equal(t, "sensor-hub@example.com", cfg.SMTPFrom)
+}
+
 // ============================================================================
 // File reading tests (with mocked utils.ReadPropertiesFile)
 // ============================================================================
@@ -682,7 +768,7 @@ func TestReloadConfig_InvalidConfig(t *testing.T) {
 }
 
 func TestSensitivePropertiesKeys(t *testing.T) {
-	assert.Empty(t, SensitiveKeys())
+	assert.Equal(t, []string{"smtp.password"}, SensitiveKeys())
 }
 
 func TestApplicationPropertiesDefaults_HasExpectedKeys(t *testing.T) {
@@ -701,6 +787,10 @@ func TestSmtpPropertiesDefaults_Initial(t *testing.T) {
 
 	_, hasUser := smtpDefaults["smtp.user"]
 	assert.True(t, hasUser)
+	assert.Equal(t, "smtp.gmail.com", smtpDefaults["smtp.host"])
+	assert.Equal(t, "587", smtpDefaults["smtp.port"])
+	assert.Equal(t, "oauth", smtpDefaults["smtp.auth"])
+	assert.Equal(t, "starttls", smtpDefaults["smtp.tls"])
 }
 
 func TestDatabasePropertiesDefaults_Initial(t *testing.T) {
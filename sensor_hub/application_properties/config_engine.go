@@ -5,6 +5,7 @@ import (
 	"log/slog"
 	"os"
 	"reflect"
+	"slices"
 	"strconv"
 	"strings"
 	"sync/atomic"
@@ -20,7 +21,7 @@ type PropertyDef struct {
 	Kind       reflect.Kind
 	Default    string // default value from `default` tag
 	File       string // "application", "smtp", or "database"
-	Validate   string // "positive", "non_negative", or ""
+	Validate   string // "positive", "non_negative", "non_empty", "oneof=a b c", or ""
 	Sensitive  bool   // if true, mask in logs and API responses
 }
 
@@ -155,16 +156,25 @@ func validateInt(def PropertyDef, value int, raw string) error {
 		if value < 0 {
 			return fmt.Errorf("invalid %s value: %s", def.Key, raw)
 		}
+	case "hour":
+		if value < 0 || value > 23 {
+			return fmt.Errorf("invalid %s value: %s. must be an hour from 0 to 23", def.Key, raw)
+		}
 	}
 	return nil
 }
 
 func validateString(def PropertyDef, value string) error {
-	switch def.Validate {
-	case "non_empty":
+	switch {
+	case def.Validate == "non_empty":
 		if value == "" {
 			return fmt.Errorf("%s must not be empty", def.Key)
 		}
+	case strings.HasPrefix(def.Validate, "oneof="):
+		allowed := strings.Fields(strings.TrimPrefix(def.Validate, "oneof="))
+		if !slices.Contains(allowed, value) {
+			return fmt.Errorf("invalid %s value: %s. must be one of %s", def.Key, value, strings.Join(allowed, ", "))
+		}
 	}
 	return nil
 }
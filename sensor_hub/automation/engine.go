@@ -0,0 +1,216 @@
+package automation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	gen "example/sensorHub/gen"
+	"example/sensorHub/periodic"
+	"example/sensorHub/scheduling"
+)
+
+// scheduleCheckInterval is how often schedule rules are checked. It is shorter than a
+// minute so that no scheduled minute is skipped; ClaimScheduledRun stops a rule firing
+// twice in the same minute.
+const scheduleCheckInterval = 20 * time.Second
+
+// Repository is the subset of db.AutomationRepository needed by Engine.
+// Defined locally to avoid an import cycle (db imports automation).
+type Repository interface {
+	GetReadingRulesForSensor(ctx context.Context, sensorID int) ([]Rule, error)
+	GetRulesForAlert(ctx context.Context, alertRuleID int) ([]Rule, error)
+	GetScheduleRules(ctx context.Context) ([]Rule, error)
+	SetRuleActive(ctx context.Context, ruleID int, active bool) (bool, error)
+	RecordRuleTriggered(ctx context.Context, ruleID int, at time.Time) error
+	ClaimScheduledRun(ctx context.Context, ruleID int, minute time.Time) (bool, error)
+}
+
+// HolidayModeSource returns the holiday mode shared with command schedules.
+// db.ScheduleRepository implements it.
+type HolidayModeSource interface {
+	GetHolidayMode(ctx context.Context) (scheduling.HolidayMode, error)
+}
+
+// CommandSender sends a command on behalf of an automation rule and returns the ID of
+// its Command History entry. service.CommandService implements it.
+type CommandSender interface {
+	SendForAutomation(ctx context.Context, ruleID, sensorID int, property, value string) (int, error)
+}
+
+// SceneActivator activates a scene on behalf of an automation rule. service.SceneService
+// implements it.
+type SceneActivator interface {
+	ActivateSceneForAutomation(ctx context.Context, ruleID, sceneID int) error
+}
+
+// Engine evaluates automation rules and sends their commands. It observes stored readings
+// (it is registered with SensorService as a readings observer), is told by the alert
+// processor when alert rules start firing, and checks schedule rules in the background.
+// Schedule rules follow holiday mode like command schedules do.
+//
+// Commands go through CommandSender, so they are persisted in Command History against
+// the rule and their outcome is tracked like any other command. Rules that target a scene
+// activate it through SceneActivator instead. A command that cannot be sent is logged;
+// the rule fires again on its next trigger.
+type Engine struct {
+	repo     Repository
+	holidays HolidayModeSource
+	sender   CommandSender
+	scenes   SceneActivator
+	logger   *slog.Logger
+}
+
+func NewEngine(repo Repository, holidays HolidayModeSource, sender CommandSender, scenes SceneActivator, logger *slog.Logger) *Engine {
+	return &Engine{
+		repo:     repo,
+		holidays: holidays,
+		sender:   sender,
+		scenes:   scenes,
+		logger:   logger.With("component", "automation_engine"),
+	}
+}
+
+// ObserveReadings evaluates the sensor's reading rules against a batch of stored
+// readings. A rule sends its command when a reading meets its condition and the previous
+// one did not.
+func (e *Engine) ObserveReadings(ctx context.Context, sensorID int, readings []gen.Reading) {
+	rules, err := e.repo.GetReadingRulesForSensor(ctx, sensorID)
+	if err != nil {
+		e.logger.Error("failed to load automation rules for sensor", "sensor_id", sensorID, "error", err)
+		return
+	}
+	if len(rules) == 0 {
+		return
+	}
+
+	for _, reading := range readings {
+		for i := range rules {
+			rule := &rules[i]
+			if !rule.Matches(reading.MeasurementType) {
+				continue
+			}
+			if err := e.evaluateReading(ctx, rule, reading); err != nil {
+				e.logger.Error("failed to evaluate automation rule", "rule_id", rule.ID, "sensor_id", sensorID, "error", err)
+			}
+		}
+	}
+}
+
+func (e *Engine) evaluateReading(ctx context.Context, rule *Rule, reading gen.Reading) error {
+	met := rule.ConditionMet(reading.NumericValue, reading.TextState)
+	if met == rule.Active {
+		return nil
+	}
+	changed, err := e.repo.SetRuleActive(ctx, rule.ID, met)
+	if err != nil {
+		return fmt.Errorf("failed to update state of automation rule %d: %w", rule.ID, err)
+	}
+	rule.Active = met
+	if !changed || !met {
+		return nil
+	}
+	return e.fire(ctx, rule, time.Now())
+}
+
+// AlertFired sends the commands of the rules triggered by the alert rule starting to fire.
+func (e *Engine) AlertFired(ctx context.Context, alertRuleID int) {
+	rules, err := e.repo.GetRulesForAlert(ctx, alertRuleID)
+	if err != nil {
+		e.logger.Error("failed to load automation rules for alert", "alert_rule_id", alertRuleID, "error", err)
+		return
+	}
+	now := time.Now()
+	for i := range rules {
+		if err := e.fire(ctx, &rules[i], now); err != nil {
+			e.logger.Error("failed to run automation rule", "rule_id", rules[i].ID, "alert_rule_id", alertRuleID, "error", err)
+		}
+	}
+}
+
+// StartSchedules periodically sends the commands of schedule rules that have fallen due.
+func (e *Engine) StartSchedules(ctx context.Context) {
+	periodic.RunTask(ctx, periodic.TaskConfig{
+		Name:           "automation_schedules",
+		Interval:       scheduleCheckInterval,
+		Logger:         e.logger,
+		RunImmediately: true,
+	}, func(ctx context.Context) error {
+		return e.ProcessSchedules(ctx, time.Now())
+	})
+}
+
+// ProcessSchedules sends the commands of the schedule rules due in the minute containing
+// now, once per rule and minute. Rules that do not run in the current holiday mode are
+// skipped without being recorded as triggered.
+func (e *Engine) ProcessSchedules(ctx context.Context, now time.Time) error {
+	rules, err := e.repo.GetScheduleRules(ctx)
+	if err != nil {
+		return err
+	}
+
+	minute := now.Truncate(time.Minute)
+	var errs []error
+	var holiday *bool
+	for i := range rules {
+		rule := &rules[i]
+		if !rule.Due(now) {
+			continue
+		}
+		if holiday == nil {
+			mode, err := e.holidays.GetHolidayMode(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to get holiday mode: %w", err)
+			}
+			active := mode.ActiveAt(now)
+			holiday = &active
+		}
+		if !rule.ScheduleHoliday.RunsDuring(*holiday) {
+			e.logger.Debug("automation rule not run in current holiday mode", "rule_id", rule.ID, "rule", rule.Name, "holiday", *holiday)
+			continue
+		}
+		claimed, err := e.repo.ClaimScheduledRun(ctx, rule.ID, minute)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to claim scheduled run of automation rule %d: %w", rule.ID, err))
+			continue
+		}
+		if !claimed {
+			continue
+		}
+		if err := e.send(ctx, rule); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// fire records that the rule triggered and sends its command.
+func (e *Engine) fire(ctx context.Context, rule *Rule, now time.Time) error {
+	if err := e.repo.RecordRuleTriggered(ctx, rule.ID, now); err != nil {
+		return fmt.Errorf("failed to record trigger of automation rule %d: %w", rule.ID, err)
+	}
+	return e.send(ctx, rule)
+}
+
+func (e *Engine) send(ctx context.Context, rule *Rule) error {
+	if rule.SceneID != nil {
+		e.logger.Info("automation rule triggered", "rule_id", rule.ID, "rule", rule.Name, "trigger", rule.Describe(),
+			"scene_id", *rule.SceneID)
+		if err := e.scenes.ActivateSceneForAutomation(ctx, rule.ID, *rule.SceneID); err != nil {
+			return fmt.Errorf("automation rule %d failed to activate scene: %w", rule.ID, err)
+		}
+		return nil
+	}
+
+	e.logger.Info("automation rule triggered", "rule_id", rule.ID, "rule", rule.Name, "trigger", rule.Describe(),
+		"target_sensor_id", *rule.TargetSensorID, "property", rule.Property, "value", rule.Value)
+
+	commandID, err := e.sender.SendForAutomation(ctx, rule.ID, *rule.TargetSensorID, rule.Property, rule.Value)
+	if err != nil {
+		return fmt.Errorf("automation rule %d failed to send command: %w", rule.ID, err)
+	}
+	e.logger.Debug("automation command sent", "rule_id", rule.ID, "command_id", commandID)
+	return nil
+}
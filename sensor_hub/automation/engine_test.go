@@ -0,0 +1,223 @@
+package automation
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	gen "example/sensorHub/gen"
+	"example/sensorHub/scheduling"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeRepository keeps rules in memory, mimicking the conditional updates of
+// db.SqlAutomationRepository.
+type fakeRepository struct {
+	rules     []Rule
+	claimed   map[int]time.Time
+	triggered map[int]time.Time
+	holiday   scheduling.HolidayMode
+}
+
+func newFakeRepository(rules ...Rule) *fakeRepository {
+	return &fakeRepository{rules: rules, claimed: map[int]time.Time{}, triggered: map[int]time.Time{}}
+}
+
+func (f *fakeRepository) filter(keep func(Rule) bool) []Rule {
+	var out []Rule
+	for _, r := range f.rules {
+		if r.Enabled && keep(r) {
+			out = append(out, r)
+		}
+	}
+	return out
+}
+
+func (f *fakeRepository) GetReadingRulesForSensor(_ context.Context, sensorID int) ([]Rule, error) {
+	return f.filter(func(r Rule) bool { return r.TriggerType == TriggerReading && *r.SensorID == sensorID }), nil
+}
+
+func (f *fakeRepository) GetRulesForAlert(_ context.Context, alertRuleID int) ([]Rule, error) {
+	return f.filter(func(r Rule) bool { return r.TriggerType == TriggerAlert && *r.AlertRuleID == alertRuleID }), nil
+}
+
+func (f *fakeRepository) GetScheduleRules(context.Context) ([]Rule, error) {
+	return f.filter(func(r Rule) bool { return r.TriggerType == TriggerSchedule }), nil
+}
+
+func (f *fakeRepository) SetRuleActive(_ context.Context, ruleID int, active bool) (bool, error) {
+	for i := range f.rules {
+		if f.rules[i].ID == ruleID && f.rules[i].Active != active {
+			f.rules[i].Active = active
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (f *fakeRepository) RecordRuleTriggered(_ context.Context, ruleID int, at time.Time) error {
+	f.triggered[ruleID] = at
+	return nil
+}
+
+func (f *fakeRepository) ClaimScheduledRun(_ context.Context, ruleID int, minute time.Time) (bool, error) {
+	if last, ok := f.claimed[ruleID]; ok && !last.Before(minute) {
+		return false, nil
+	}
+	f.claimed[ruleID] = minute
+	return true, nil
+}
+
+func (f *fakeRepository) GetHolidayMode(context.Context) (scheduling.HolidayMode, error) {
+	return f.holiday, nil
+}
+
+type sentCommand struct {
+	ruleID, sensorID int
+	property, value  string
+}
+
+type fakeSender struct {
+	sent      []sentCommand
+	activated []sentScene
+	err       error
+}
+
+type sentScene struct {
+	ruleID, sceneID int
+}
+
+func (f *fakeSender) SendForAutomation(_ context.Context, ruleID, sensorID int, property, value string) (int, error) {
+	if f.err != nil {
+		return 0, f.err
+	}
+	f.sent = append(f.sent, sentCommand{ruleID, sensorID, property, value})
+	return len(f.sent), nil
+}
+
+func (f *fakeSender) ActivateSceneForAutomation(_ context.Context, ruleID, sceneID int) error {
+	if f.err != nil {
+		return f.err
+	}
+	f.activated = append(f.activated, sentScene{ruleID, sceneID})
+	return nil
+}
+
+func newTestEngine(repo *fakeRepository, sender *fakeSender) *Engine {
+	return NewEngine(repo, repo, sender, sender, slog.New(slog.NewTextHandler(io.Discard, nil)))
+}
+
+func humidityReading(value float64) gen.Reading {
+	return gen.Reading{MeasurementType: "humidity", NumericValue: &value}
+}
+
+func TestEngine_ObserveReadings_FiresOnlyWhenConditionStartsBeingMet(t *testing.T) {
+	on := validReadingRule()
+	on.ID = 1
+	off := validReadingRule()
+	off.ID, off.Comparison, off.Threshold, off.Value = 2, ComparisonLessThan, ptr(60.0), "OFF"
+	repo := newFakeRepository(on, off)
+	sender := &fakeSender{}
+	engine := newTestEngine(repo, sender)
+	ctx := context.Background()
+
+	engine.ObserveReadings(ctx, 1, []gen.Reading{humidityReading(70), humidityReading(80)})
+	engine.ObserveReadings(ctx, 1, []gen.Reading{humidityReading(85)})
+	assert.Equal(t, []sentCommand{{1, 3, "state", "ON"}}, sender.sent)
+
+	// Between the thresholds neither rule fires, so the dehumidifier stays on.
+	engine.ObserveReadings(ctx, 1, []gen.Reading{humidityReading(65)})
+	assert.Len(t, sender.sent, 1)
+
+	engine.ObserveReadings(ctx, 1, []gen.Reading{humidityReading(55), humidityReading(50)})
+	assert.Equal(t, []sentCommand{{1, 3, "state", "ON"}, {2, 3, "state", "OFF"}}, sender.sent)
+	assert.Contains(t, repo.triggered, 2)
+}
+
+func TestEngine_ObserveReadings_IgnoresOtherMeasurementsAndSensors(t *testing.T) {
+	rule := validReadingRule()
+	rule.ID = 1
+	sender := &fakeSender{}
+	engine := newTestEngine(newFakeRepository(rule), sender)
+	temperature := 90.0
+
+	engine.ObserveReadings(context.Background(), 1, []gen.Reading{{MeasurementType: "temperature", NumericValue: &temperature}})
+	engine.ObserveReadings(context.Background(), 2, []gen.Reading{humidityReading(90)})
+
+	assert.Empty(t, sender.sent)
+}
+
+func TestEngine_AlertFired_SendsCommandsOfMatchingRules(t *testing.T) {
+	fan := Rule{ID: 1, Enabled: true, TriggerType: TriggerAlert, AlertRuleID: ptr(8), TargetSensorID: ptr(3), Property: "state", Value: "ON"}
+	other := Rule{ID: 2, Enabled: true, TriggerType: TriggerAlert, AlertRuleID: ptr(9), TargetSensorID: ptr(4), Property: "state", Value: "ON"}
+	sender := &fakeSender{}
+	engine := newTestEngine(newFakeRepository(fan, other), sender)
+
+	engine.AlertFired(context.Background(), 8)
+
+	assert.Equal(t, []sentCommand{{1, 3, "state", "ON"}}, sender.sent)
+}
+
+func TestEngine_ProcessSchedules_FiresOncePerMinute(t *testing.T) {
+	morning := Rule{ID: 1, Enabled: true, TriggerType: TriggerSchedule, ScheduleTime: "06:30", TargetSensorID: ptr(3), Property: "state", Value: "ON"}
+	evening := Rule{ID: 2, Enabled: true, TriggerType: TriggerSchedule, ScheduleTime: "22:00", TargetSensorID: ptr(3), Property: "state", Value: "OFF"}
+	sender := &fakeSender{}
+	engine := newTestEngine(newFakeRepository(morning, evening), sender)
+	ctx := context.Background()
+	at := time.Date(2026, 3, 2, 6, 30, 5, 0, time.Local)
+
+	require.NoError(t, engine.ProcessSchedules(ctx, at))
+	require.NoError(t, engine.ProcessSchedules(ctx, at.Add(40*time.Second)))
+	assert.Equal(t, []sentCommand{{1, 3, "state", "ON"}}, sender.sent)
+
+	require.NoError(t, engine.ProcessSchedules(ctx, at.AddDate(0, 0, 1)))
+	assert.Len(t, sender.sent, 2, "the rule fires again the next day")
+}
+
+func TestEngine_ProcessSchedules_ReturnsSendErrors(t *testing.T) {
+	rule := Rule{ID: 1, Enabled: true, TriggerType: TriggerSchedule, ScheduleTime: "06:30", TargetSensorID: ptr(3), Property: "state", Value: "ON"}
+	sender := &fakeSender{err: errors.New("sensor 3 is not in a controllable state")}
+	engine := newTestEngine(newFakeRepository(rule), sender)
+
+	err := engine.ProcessSchedules(context.Background(), time.Date(2026, 3, 2, 6, 30, 0, 0, time.Local))
+
+	assert.ErrorContains(t, err, "not in a controllable state")
+}
+
+func TestEngine_ProcessSchedules_FollowsHolidayMode(t *testing.T) {
+	weekday := Rule{ID: 1, Enabled: true, TriggerType: TriggerSchedule, ScheduleTime: "06:30", ScheduleHoliday: scheduling.HolidaySkip, TargetSensorID: ptr(3), Property: "heating", Value: "ON"}
+	always := Rule{ID: 2, Enabled: true, TriggerType: TriggerSchedule, ScheduleTime: "06:30", ScheduleHoliday: scheduling.HolidayRun, TargetSensorID: ptr(4), Property: "state", Value: "ON"}
+	away := Rule{ID: 3, Enabled: true, TriggerType: TriggerSchedule, ScheduleTime: "06:30", ScheduleHoliday: scheduling.HolidayOnly, SceneID: ptr(5)}
+	repo := newFakeRepository(weekday, always, away)
+	sender := &fakeSender{}
+	engine := newTestEngine(repo, sender)
+	at := time.Date(2026, 3, 2, 6, 30, 0, 0, time.Local)
+	until := at.Add(24 * time.Hour)
+	repo.holiday = scheduling.HolidayMode{Enabled: true, Until: &until}
+
+	require.NoError(t, engine.ProcessSchedules(context.Background(), at))
+
+	assert.Equal(t, []sentCommand{{2, 4, "state", "ON"}}, sender.sent)
+	assert.Equal(t, []sentScene{{3, 5}}, sender.activated)
+	assert.NotContains(t, repo.claimed, 1, "a skipped run is not recorded as triggered")
+
+	require.NoError(t, engine.ProcessSchedules(context.Background(), at.Add(48*time.Hour)))
+	assert.Equal(t, []sentCommand{{2, 4, "state", "ON"}, {1, 3, "heating", "ON"}, {2, 4, "state", "ON"}}, sender.sent, "holiday mode has ended")
+	assert.Len(t, sender.activated, 1)
+}
+
+func TestEngine_AlertFired_ActivatesSceneRules(t *testing.T) {
+	rule := Rule{ID: 1, Enabled: true, TriggerType: TriggerAlert, AlertRuleID: ptr(8), SceneID: ptr(5)}
+	sender := &fakeSender{}
+	engine := newTestEngine(newFakeRepository(rule), sender)
+
+	engine.AlertFired(context.Background(), 8)
+
+	assert.Equal(t, []sentScene{{1, 5}}, sender.activated)
+	assert.Empty(t, sender.sent)
+}
@@ -0,0 +1,249 @@
+package automation
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"example/sensorHub/scheduling"
+)
+
+// TriggerType is what makes an automation rule send its command.
+type TriggerType string
+
+const (
+	// TriggerReading fires when a reading of SensorID/MeasurementType starts meeting the
+	// rule's condition.
+	TriggerReading TriggerType = "reading"
+	// TriggerAlert fires when the single-sensor alert rule AlertRuleID starts firing.
+	TriggerAlert TriggerType = "alert"
+	// TriggerSchedule fires when the hub's local time reaches ScheduleTime on one of
+	// ScheduleDays, unless ScheduleHoliday rules it out in the current holiday mode.
+	TriggerSchedule TriggerType = "schedule"
+)
+
+// Comparison is how a reading rule compares the incoming reading with its threshold or
+// status.
+type Comparison string
+
+const (
+	ComparisonGreaterThan        Comparison = ">"
+	ComparisonGreaterThanOrEqual Comparison = ">="
+	ComparisonLessThan           Comparison = "<"
+	ComparisonLessThanOrEqual    Comparison = "<="
+	ComparisonEqual              Comparison = "=="
+	ComparisonNotEqual           Comparison = "!="
+)
+
+// Rule sends Value to the Property capability of the controllable sensor TargetSensorID,
+// or activates the scene SceneID, when its trigger fires. Only the fields of its
+// TriggerType are used.
+//
+// Reading rules are edge-triggered: Active records whether the latest reading met the
+// condition, and the command is sent when it changes from unmet to met. A pair of rules
+// with different thresholds gives hysteresis, e.g. "humidity > 75 then ON" and
+// "humidity < 60 then OFF".
+type Rule struct {
+	ID          int         `json:"id"`
+	Name        string      `json:"name"`
+	Enabled     bool        `json:"enabled"`
+	TriggerType TriggerType `json:"trigger_type"`
+
+	SensorID          *int       `json:"sensor_id"`
+	SensorName        string     `json:"sensor_name"`
+	MeasurementTypeID *int       `json:"measurement_type_id"`
+	MeasurementType   string     `json:"measurement_type"`
+	Comparison        Comparison `json:"comparison"`
+	Threshold         *float64   `json:"threshold"`
+	Status            string     `json:"status"`
+
+	AlertRuleID *int `json:"alert_rule_id"`
+
+	ScheduleTime    string                      `json:"schedule_time"`
+	ScheduleDays    []string                    `json:"schedule_days"`
+	ScheduleHoliday scheduling.HolidayBehaviour `json:"schedule_holiday"`
+
+	TargetSensorID   *int   `json:"target_sensor_id"`
+	TargetSensorName string `json:"target_sensor_name"`
+	Property         string `json:"property"`
+	Value            string `json:"value"`
+
+	SceneID   *int   `json:"scene_id"`
+	SceneName string `json:"scene_name"`
+
+	Active          bool       `json:"active"`
+	LastTriggeredAt *time.Time `json:"last_triggered_at"`
+}
+
+func (r *Rule) Validate() error {
+	if strings.TrimSpace(r.Name) == "" {
+		return fmt.Errorf("name is required")
+	}
+	if err := r.validateTarget(); err != nil {
+		return err
+	}
+
+	switch r.TriggerType {
+	case TriggerReading:
+		return r.validateReadingTrigger()
+	case TriggerAlert:
+		if r.AlertRuleID == nil || *r.AlertRuleID <= 0 {
+			return fmt.Errorf("alert triggers need a positive alert rule ID")
+		}
+		return nil
+	case TriggerSchedule:
+		return r.validateScheduleTrigger()
+	default:
+		return fmt.Errorf("invalid trigger type %q: must be one of reading, alert, schedule", r.TriggerType)
+	}
+}
+
+// validateTarget checks that the rule either sends one command or activates one scene.
+func (r *Rule) validateTarget() error {
+	if r.SceneID != nil {
+		if r.TargetSensorID != nil || r.Property != "" || r.Value != "" {
+			return fmt.Errorf("a rule activates a scene or sends a command, not both")
+		}
+		if *r.SceneID <= 0 {
+			return fmt.Errorf("scene ID must be a positive integer")
+		}
+		return nil
+	}
+	if r.TargetSensorID == nil || *r.TargetSensorID <= 0 {
+		return fmt.Errorf("target sensor ID must be a positive integer")
+	}
+	if strings.TrimSpace(r.Property) == "" {
+		return fmt.Errorf("property is required")
+	}
+	if r.Value == "" {
+		return fmt.Errorf("value is required")
+	}
+	return nil
+}
+
+func (r *Rule) validateReadingTrigger() error {
+	if r.SensorID == nil || *r.SensorID <= 0 {
+		return fmt.Errorf("reading triggers need a positive sensor ID")
+	}
+	if r.MeasurementTypeID == nil || *r.MeasurementTypeID <= 0 {
+		return fmt.Errorf("reading triggers need a positive measurement type ID")
+	}
+	switch r.Comparison {
+	case ComparisonEqual, ComparisonNotEqual:
+		if r.Status == "" && r.Threshold == nil {
+			return fmt.Errorf("reading triggers need a threshold or a status")
+		}
+		return nil
+	case ComparisonGreaterThan, ComparisonGreaterThanOrEqual, ComparisonLessThan, ComparisonLessThanOrEqual:
+		if r.Status != "" {
+			return fmt.Errorf("status conditions only support '==' and '!='")
+		}
+		if r.Threshold == nil {
+			return fmt.Errorf("reading triggers need a threshold")
+		}
+		return nil
+	default:
+		return fmt.Errorf("invalid comparison %q: must be one of >, >=, <, <=, ==, !=", r.Comparison)
+	}
+}
+
+func (r *Rule) validateScheduleTrigger() error {
+	for _, day := range r.ScheduleDays {
+		if _, ok := scheduling.ParseWeekday(day); !ok {
+			return fmt.Errorf("invalid day %q: must be one of mon, tue, wed, thu, fri, sat, sun", day)
+		}
+	}
+	if _, err := scheduling.ParseClock(r.ScheduleTime); err != nil {
+		return fmt.Errorf("invalid schedule time: %w", err)
+	}
+	return r.ScheduleHoliday.Validate()
+}
+
+// Matches reports whether the reading rule watches the given measurement type.
+func (r *Rule) Matches(measurementType string) bool {
+	return strings.EqualFold(r.MeasurementType, measurementType)
+}
+
+// ConditionMet reports whether a reading meets the rule's condition. Status conditions
+// compare the text state; the rest compare the numeric value. A reading without the
+// value being compared never meets the condition.
+func (r *Rule) ConditionMet(numericValue *float64, statusValue *string) bool {
+	if r.Status != "" {
+		if statusValue == nil {
+			return false
+		}
+		equal := *statusValue == r.Status
+		if r.Comparison == ComparisonNotEqual {
+			return !equal
+		}
+		return equal
+	}
+
+	if numericValue == nil || r.Threshold == nil {
+		return false
+	}
+	value, threshold := *numericValue, *r.Threshold
+	switch r.Comparison {
+	case ComparisonGreaterThan:
+		return value > threshold
+	case ComparisonGreaterThanOrEqual:
+		return value >= threshold
+	case ComparisonLessThan:
+		return value < threshold
+	case ComparisonLessThanOrEqual:
+		return value <= threshold
+	case ComparisonEqual:
+		return value == threshold
+	case ComparisonNotEqual:
+		return value != threshold
+	default:
+		return false
+	}
+}
+
+// Due reports whether a schedule rule fires in the minute containing t. The caller
+// passes t in the zone the schedule time is expressed in.
+func (r *Rule) Due(t time.Time) bool {
+	if !r.Enabled || r.TriggerType != TriggerSchedule {
+		return false
+	}
+	at, err := scheduling.ParseClock(r.ScheduleTime)
+	if err != nil {
+		return false
+	}
+	if t.Hour()*60+t.Minute() != at {
+		return false
+	}
+	if len(r.ScheduleDays) == 0 {
+		return true
+	}
+	for _, name := range r.ScheduleDays {
+		if weekday, ok := scheduling.ParseWeekday(name); ok && weekday == t.Weekday() {
+			return true
+		}
+	}
+	return false
+}
+
+// Describe is a short human-readable summary of the rule's trigger, used in logs.
+func (r *Rule) Describe() string {
+	switch r.TriggerType {
+	case TriggerReading:
+		if r.Status != "" {
+			return fmt.Sprintf("%s %s %s %s", r.SensorName, r.MeasurementType, r.Comparison, r.Status)
+		}
+		if r.Threshold != nil {
+			return fmt.Sprintf("%s %s %s %g", r.SensorName, r.MeasurementType, r.Comparison, *r.Threshold)
+		}
+	case TriggerAlert:
+		if r.AlertRuleID != nil {
+			return fmt.Sprintf("alert rule %d fires", *r.AlertRuleID)
+		}
+	case TriggerSchedule:
+		if len(r.ScheduleDays) == 0 {
+			return fmt.Sprintf("daily at %s", r.ScheduleTime)
+		}
+		return fmt.Sprintf("at %s on %s", r.ScheduleTime, strings.Join(r.ScheduleDays, ","))
+	}
+	return string(r.TriggerType)
+}
@@ -0,0 +1,91 @@
+package automation
+
+import (
+	"testing"
+	"time"
+
+	"example/sensorHub/scheduling"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func ptr[T any](v T) *T { return &v }
+
+func validReadingRule() Rule {
+	return Rule{
+		Name: "Dehumidifier on", Enabled: true, TriggerType: TriggerReading,
+		SensorID: ptr(1), MeasurementTypeID: ptr(2), MeasurementType: "humidity",
+		Comparison: ComparisonGreaterThan, Threshold: ptr(75.0),
+		TargetSensorID: ptr(3), Property: "state", Value: "ON",
+	}
+}
+
+func TestRule_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		modify  func(r *Rule)
+		wantErr string
+	}{
+		{name: "valid reading rule", modify: func(r *Rule) {}},
+		{name: "missing name", modify: func(r *Rule) { r.Name = " " }, wantErr: "name is required"},
+		{name: "missing target", modify: func(r *Rule) { r.TargetSensorID = nil }, wantErr: "target sensor"},
+		{name: "scene target", modify: func(r *Rule) { r.TargetSensorID, r.Property, r.Value, r.SceneID = nil, "", "", ptr(2) }},
+		{name: "scene and command", modify: func(r *Rule) { r.SceneID = ptr(2) }, wantErr: "not both"},
+		{name: "missing threshold", modify: func(r *Rule) { r.Threshold = nil }, wantErr: "need a threshold"},
+		{name: "ordered status comparison", modify: func(r *Rule) { r.Status = "open" }, wantErr: "only support"},
+		{name: "status equality", modify: func(r *Rule) { r.Comparison, r.Threshold, r.Status = ComparisonEqual, nil, "open" }},
+		{name: "bad comparison", modify: func(r *Rule) { r.Comparison = "~" }, wantErr: "invalid comparison"},
+		{name: "alert without rule", modify: func(r *Rule) { r.TriggerType = TriggerAlert }, wantErr: "alert rule ID"},
+		{name: "alert rule", modify: func(r *Rule) { r.TriggerType, r.AlertRuleID = TriggerAlert, ptr(4) }},
+		{name: "schedule", modify: func(r *Rule) {
+			r.TriggerType, r.ScheduleTime, r.ScheduleDays, r.ScheduleHoliday = TriggerSchedule, "06:30", []string{"mon", "sat"}, scheduling.HolidayOnly
+		}},
+		{name: "schedule bad time", modify: func(r *Rule) { r.TriggerType, r.ScheduleTime = TriggerSchedule, "6pm" }, wantErr: "HH:MM"},
+		{name: "schedule bad day", modify: func(r *Rule) {
+			r.TriggerType, r.ScheduleTime, r.ScheduleDays = TriggerSchedule, "06:30", []string{"monday"}
+		}, wantErr: "invalid day"},
+		{name: "schedule bad holiday behaviour", modify: func(r *Rule) {
+			r.TriggerType, r.ScheduleTime, r.ScheduleHoliday = TriggerSchedule, "06:30", "sometimes"
+		}, wantErr: "invalid holiday behaviour"},
+		{name: "unknown trigger", modify: func(r *Rule) { r.TriggerType = "webhook" }, wantErr: "invalid trigger type"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule := validReadingRule()
+			tt.modify(&rule)
+			err := rule.Validate()
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRule_ConditionMet(t *testing.T) {
+	rule := validReadingRule()
+	assert.True(t, rule.ConditionMet(ptr(80.0), nil))
+	assert.False(t, rule.ConditionMet(ptr(75.0), nil))
+	assert.False(t, rule.ConditionMet(nil, ptr("80")), "a reading without a numeric value never matches")
+
+	rule.Comparison, rule.Threshold, rule.Status = ComparisonNotEqual, nil, "closed"
+	assert.True(t, rule.ConditionMet(nil, ptr("open")))
+	assert.False(t, rule.ConditionMet(nil, ptr("closed")))
+	assert.False(t, rule.ConditionMet(ptr(1.0), nil))
+}
+
+func TestRule_Due(t *testing.T) {
+	rule := Rule{Enabled: true, TriggerType: TriggerSchedule, ScheduleTime: "06:30", ScheduleDays: []string{"mon"}}
+	monday := time.Date(2026, 3, 2, 6, 30, 45, 0, time.Local)
+
+	assert.True(t, rule.Due(monday))
+	assert.False(t, rule.Due(monday.Add(time.Minute)))
+	assert.False(t, rule.Due(monday.AddDate(0, 0, 1)), "not scheduled on Tuesdays")
+
+	rule.ScheduleDays = nil
+	assert.True(t, rule.Due(monday.AddDate(0, 0, 1)), "no days means every day")
+
+	rule.Enabled = false
+	assert.False(t, rule.Due(monday))
+}
@@ -9,8 +9,8 @@ import (
 )
 
 // notifRepoAdapter bridges database.NotificationRepository to alerting.NotificationRepository.
-// The only difference is that GetUsersWithPermissionAndEmail returns []alerting.UserEmailInfo
-// rather than []database.UserEmailInfo.
+// The only difference is that GetUsersWithPermissionAndEmail and GetUsersWithEmail return
+// []alerting.UserEmailInfo rather than []database.UserEmailInfo.
 type notifRepoAdapter struct {
 	repo database.NotificationRepository
 }
@@ -23,6 +23,14 @@ func (a *notifRepoAdapter) AssignNotificationToUsersWithPermission(ctx context.C
 	return a.repo.AssignNotificationToUsersWithPermission(ctx, notifID, permission)
 }
 
+func (a *notifRepoAdapter) AssignNotificationToUser(ctx context.Context, userID, notifID int) error {
+	return a.repo.AssignNotificationToUser(ctx, userID, notifID)
+}
+
+func (a *notifRepoAdapter) GetUserIDsWithRole(ctx context.Context, role string) ([]int, error) {
+	return a.repo.GetUserIDsWithRole(ctx, role)
+}
+
 func (a *notifRepoAdapter) GetUserIDsWithPermission(ctx context.Context, permission string) ([]int, error) {
 	return a.repo.GetUserIDsWithPermission(ctx, permission)
 }
@@ -39,6 +47,18 @@ func (a *notifRepoAdapter) GetUsersWithPermissionAndEmail(ctx context.Context, p
 	return result, nil
 }
 
+func (a *notifRepoAdapter) GetUsersWithEmail(ctx context.Context, userIDs []int) ([]alerting.UserEmailInfo, error) {
+	users, err := a.repo.GetUsersWithEmail(ctx, userIDs)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]alerting.UserEmailInfo, len(users))
+	for i, u := range users {
+		result[i] = alerting.UserEmailInfo{UserID: u.UserID, Email: u.Email}
+	}
+	return result, nil
+}
+
 func (a *notifRepoAdapter) GetChannelPreference(ctx context.Context, userID int, category notifications.NotificationCategory) (*notifications.ChannelPreference, error) {
 	return a.repo.GetChannelPreference(ctx, userID, category)
 }
@@ -3,6 +3,8 @@ package cmd
 import (
 	"fmt"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -21,6 +23,14 @@ func init() {
 	alertsCmd.AddCommand(alertsUpdateCmd)
 	alertsCmd.AddCommand(alertsDeleteCmd)
 	alertsCmd.AddCommand(alertsHistoryCmd)
+	alertsCmd.AddCommand(alertsCompoundCmd)
+	alertsCmd.AddCommand(alertsAckCmd)
+	alertsCmd.AddCommand(alertsSnoozeCmd)
+	alertsCmd.AddCommand(alertsUnsnoozeCmd)
+	alertsCmd.AddCommand(alertsSilenceCmd)
+	alertsCmd.AddCommand(alertsEscalationCmd)
+	alertsCmd.AddCommand(alertsRecipientsCmd)
+	alertsCmd.AddCommand(alertsContactGroupCmd)
 	rootCmd.AddCommand(alertsCmd)
 }
 
@@ -61,6 +71,7 @@ var alertsCreateCmd = &cobra.Command{
 		measurementTypeID, _ := cmd.Flags().GetInt("measurement-type-id")
 		alertType, _ := cmd.Flags().GetString("type")
 		threshold, _ := cmd.Flags().GetFloat64("threshold")
+		lowThreshold, _ := cmd.Flags().GetFloat64("low-threshold")
 
 		if sensorID == 0 || alertType == "" || measurementTypeID == 0 {
 			return fmt.Errorf("--sensor-id, --measurement-type-id, and --type are required")
@@ -75,7 +86,10 @@ var alertsCreateCmd = &cobra.Command{
 			MeasurementTypeID: measurementTypeID,
 			AlertType:         gen.AlertRuleAlertType(alertType),
 			HighThreshold:     threshold,
+			LowThreshold:      lowThreshold,
 		}
+		body.DurationSeconds, body.ChangeThreshold = lookbackFlags(cmd)
+		body.Hysteresis = hysteresisFlag(cmd)
 		return consumeJSON(client.CreateAlertRule(ctx, body))
 	},
 }
@@ -85,6 +99,39 @@ func init() {
 	alertsCreateCmd.Flags().Int("measurement-type-id", 0, "Measurement type ID (e.g. 1 for temperature)")
 	alertsCreateCmd.Flags().String("type", "", "Alert type")
 	alertsCreateCmd.Flags().Float64("threshold", 0, "Threshold value")
+	alertsCreateCmd.Flags().Float64("low-threshold", 0, "Low threshold (numeric_range and sustained_range)")
+	addLookbackFlags(alertsCreateCmd)
+	alertsCreateCmd.Flags().Float64("hysteresis", 0, "Margin a firing alert must clear before it resolves")
+}
+
+// addLookbackFlags registers the settings used by the sustained_range and rate_of_change types.
+func addLookbackFlags(cmd *cobra.Command) {
+	cmd.Flags().Int("duration-seconds", 0, "Look-back window in seconds (sustained_range, rate_of_change)")
+	cmd.Flags().Float64("change-threshold", 0, "Largest allowed change within the window (rate_of_change)")
+}
+
+// lookbackFlags returns the look-back settings that were set on the command line.
+func lookbackFlags(cmd *cobra.Command) (*int, *float64) {
+	var duration *int
+	var change *float64
+	if cmd.Flags().Changed("duration-seconds") {
+		v, _ := cmd.Flags().GetInt("duration-seconds")
+		duration = &v
+	}
+	if cmd.Flags().Changed("change-threshold") {
+		v, _ := cmd.Flags().GetFloat64("change-threshold")
+		change = &v
+	}
+	return duration, change
+}
+
+// hysteresisFlag returns the hysteresis margin if it was set on the command line.
+func hysteresisFlag(cmd *cobra.Command) *float64 {
+	if !cmd.Flags().Changed("hysteresis") {
+		return nil
+	}
+	v, _ := cmd.Flags().GetFloat64("hysteresis")
+	return &v
 }
 
 var alertsDeleteCmd = &cobra.Command{
@@ -156,6 +203,8 @@ var alertsUpdateCmd = &cobra.Command{
 			Enabled:          enabled,
 			RateLimitSeconds: rateLimitSeconds,
 		}
+		body.DurationSeconds, body.ChangeThreshold = lookbackFlags(cmd)
+		body.Hysteresis = hysteresisFlag(cmd)
 		return consumeJSON(client.UpdateAlertRule(ctx, id, body))
 	},
 }
@@ -166,4 +215,635 @@ func init() {
 	alertsUpdateCmd.Flags().Float64("low-threshold", 0, "Low threshold")
 	alertsUpdateCmd.Flags().Bool("enabled", true, "Whether the alert is enabled")
 	alertsUpdateCmd.Flags().Int("rate-limit-seconds", 0, "Rate limit in seconds (e.g. 3600 = 1 hour)")
+	addLookbackFlags(alertsUpdateCmd)
+	alertsUpdateCmd.Flags().Float64("hysteresis", 0, "Margin a firing alert must clear before it resolves")
+}
+
+var alertsCompoundCmd = &cobra.Command{
+	Use:   "compound",
+	Short: "Manage compound alert rules that combine conditions across sensors",
+}
+
+func init() {
+	alertsCompoundCmd.AddCommand(alertsCompoundListCmd)
+	alertsCompoundCmd.AddCommand(alertsCompoundGetCmd)
+	alertsCompoundCmd.AddCommand(alertsCompoundCreateCmd)
+	alertsCompoundCmd.AddCommand(alertsCompoundDeleteCmd)
+}
+
+var alertsCompoundListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List all compound alert rules",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, ctx, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+		return consumeJSON(client.GetAllCompoundAlertRules(ctx))
+	},
+}
+
+var alertsCompoundGetCmd = &cobra.Command{
+	Use:   "get [id]",
+	Short: "Get a compound alert rule by ID",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := strconv.Atoi(args[0])
+		if err != nil {
+			return fmt.Errorf("alert ID must be a number")
+		}
+		client, ctx, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+		return consumeJSON(client.GetCompoundAlertRuleById(ctx, id))
+	},
+}
+
+var alertsCompoundCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Create a compound alert rule",
+	Example: `  sensor-hub alerts compound create --name "Window open in the cold" --operator and \
+    --condition "3:12:==:false" --condition "5:1:<:5"`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		name, _ := cmd.Flags().GetString("name")
+		operator, _ := cmd.Flags().GetString("operator")
+		rawConditions, _ := cmd.Flags().GetStringArray("condition")
+		rateLimitSeconds, _ := cmd.Flags().GetInt("rate-limit-seconds")
+		enabled, _ := cmd.Flags().GetBool("enabled")
+
+		if name == "" || len(rawConditions) == 0 {
+			return fmt.Errorf("--name and at least one --condition are required")
+		}
+		conditions := make([]gen.AlertCondition, 0, len(rawConditions))
+		for _, raw := range rawConditions {
+			condition, err := parseAlertCondition(raw)
+			if err != nil {
+				return err
+			}
+			conditions = append(conditions, condition)
+		}
+
+		client, ctx, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+		body := gen.CreateCompoundAlertRuleJSONRequestBody{
+			Name:             name,
+			Operator:         gen.CompoundAlertRuleOperator(operator),
+			Conditions:       conditions,
+			Enabled:          enabled,
+			RateLimitSeconds: rateLimitSeconds,
+			Hysteresis:       hysteresisFlag(cmd),
+		}
+		return consumeJSON(client.CreateCompoundAlertRule(ctx, body))
+	},
+}
+
+func init() {
+	alertsCompoundCreateCmd.Flags().String("name", "", "Rule name")
+	alertsCompoundCreateCmd.Flags().String("operator", "and", "How conditions combine: and, or")
+	alertsCompoundCreateCmd.Flags().StringArray("condition", nil, "Condition as sensorId:measurementTypeId:comparison:value (repeatable; comparison is one of >, >=, <, <=, ==, !=)")
+	alertsCompoundCreateCmd.Flags().Int("rate-limit-seconds", 3600, "Rate limit in seconds (e.g. 3600 = 1 hour)")
+	alertsCompoundCreateCmd.Flags().Bool("enabled", true, "Whether the rule is enabled")
+	alertsCompoundCreateCmd.Flags().Float64("hysteresis", 0, "Margin a firing alert must clear before it resolves")
+}
+
+var alertsCompoundDeleteCmd = &cobra.Command{
+	Use:   "delete [id]",
+	Short: "Delete a compound alert rule by ID",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := strconv.Atoi(args[0])
+		if err != nil {
+			return fmt.Errorf("alert ID must be a number")
+		}
+		client, ctx, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+		return consumeJSON(client.DeleteCompoundAlertRule(ctx, id))
+	},
+}
+
+// parseAlertCondition parses "sensorId:measurementTypeId:comparison:value". A numeric value
+// becomes the threshold; anything else is compared as a status.
+func parseAlertCondition(raw string) (gen.AlertCondition, error) {
+	parts := strings.SplitN(raw, ":", 4)
+	if len(parts) != 4 {
+		return gen.AlertCondition{}, fmt.Errorf("invalid condition %q: expected sensorId:measurementTypeId:comparison:value", raw)
+	}
+	sensorID, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return gen.AlertCondition{}, fmt.Errorf("invalid condition %q: sensor ID must be a number", raw)
+	}
+	measurementTypeID, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return gen.AlertCondition{}, fmt.Errorf("invalid condition %q: measurement type ID must be a number", raw)
+	}
+
+	condition := gen.AlertCondition{
+		SensorID:          sensorID,
+		MeasurementTypeID: measurementTypeID,
+		Comparison:        gen.AlertConditionComparison(parts[2]),
+	}
+	if threshold, err := strconv.ParseFloat(parts[3], 64); err == nil {
+		condition.Threshold = &threshold
+	} else {
+		condition.Status = &parts[3]
+	}
+	return condition, nil
+}
+
+var alertsAckCmd = &cobra.Command{
+	Use:   "ack [id]",
+	Short: "Acknowledge a firing alert rule, or an alert history entry with --history",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := strconv.Atoi(args[0])
+		if err != nil {
+			return fmt.Errorf("ID must be a number")
+		}
+		client, ctx, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+		if history, _ := cmd.Flags().GetBool("history"); history {
+			return consumeJSON(client.AcknowledgeAlertHistoryEntry(ctx, id))
+		}
+		if compound, _ := cmd.Flags().GetBool("compound"); compound {
+			return consumeJSON(client.AcknowledgeCompoundAlertRule(ctx, id))
+		}
+		return consumeJSON(client.AcknowledgeAlertRule(ctx, id))
+	},
+}
+
+func init() {
+	alertsAckCmd.Flags().Bool("history", false, "Treat the ID as an alert history entry ID")
+	alertsAckCmd.Flags().Bool("compound", false, "Treat the ID as a compound alert rule ID")
+	alertsAckCmd.MarkFlagsMutuallyExclusive("history", "compound")
+}
+
+var alertsSnoozeCmd = &cobra.Command{
+	Use:   "snooze [id]",
+	Short: "Suppress an alert rule's notifications for a while",
+	Example: `  sensor-hub alerts snooze 3 --for 8h
+  sensor-hub alerts snooze 3 --until 2025-06-02T07:00:00Z
+  sensor-hub alerts snooze 2 --compound --for 1h`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := strconv.Atoi(args[0])
+		if err != nil {
+			return fmt.Errorf("alert ID must be a number")
+		}
+		until, err := snoozeUntil(cmd, time.Now())
+		if err != nil {
+			return err
+		}
+		client, ctx, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+		if compound, _ := cmd.Flags().GetBool("compound"); compound {
+			return consumeJSON(client.SnoozeCompoundAlertRule(ctx, id, gen.SnoozeCompoundAlertRuleJSONRequestBody{Until: until}))
+		}
+		return consumeJSON(client.SnoozeAlertRule(ctx, id, gen.SnoozeAlertRuleJSONRequestBody{Until: until}))
+	},
+}
+
+func init() {
+	alertsSnoozeCmd.Flags().Duration("for", 0, "How long to snooze (e.g. 30m, 8h)")
+	alertsSnoozeCmd.Flags().String("until", "", "Snooze until this RFC 3339 time")
+	alertsSnoozeCmd.Flags().Bool("compound", false, "Treat the ID as a compound alert rule ID")
+}
+
+// snoozeUntil resolves the --for or --until flag into the time the snooze ends.
+func snoozeUntil(cmd *cobra.Command, now time.Time) (time.Time, error) {
+	forChanged, untilChanged := cmd.Flags().Changed("for"), cmd.Flags().Changed("until")
+	if forChanged == untilChanged {
+		return time.Time{}, fmt.Errorf("exactly one of --for or --until is required")
+	}
+	if forChanged {
+		d, _ := cmd.Flags().GetDuration("for")
+		if d <= 0 {
+			return time.Time{}, fmt.Errorf("--for must be positive")
+		}
+		return now.Add(d), nil
+	}
+	raw, _ := cmd.Flags().GetString("until")
+	until, err := time.Parse(time.RFC3339, raw)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid --until %q: expected RFC 3339, e.g. 2025-06-02T07:00:00Z", raw)
+	}
+	return until, nil
+}
+
+var alertsUnsnoozeCmd = &cobra.Command{
+	Use:   "unsnooze [id]",
+	Short: "Resume notifications for a snoozed alert rule",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := strconv.Atoi(args[0])
+		if err != nil {
+			return fmt.Errorf("alert ID must be a number")
+		}
+		client, ctx, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+		if compound, _ := cmd.Flags().GetBool("compound"); compound {
+			return consumeJSON(client.UnsnoozeCompoundAlertRule(ctx, id))
+		}
+		return consumeJSON(client.UnsnoozeAlertRule(ctx, id))
+	},
+}
+
+func init() {
+	alertsUnsnoozeCmd.Flags().Bool("compound", false, "Treat the ID as a compound alert rule ID")
+}
+
+var alertsSilenceCmd = &cobra.Command{
+	Use:   "silence",
+	Short: "Manage recurring windows during which alert notifications are suppressed",
+}
+
+func init() {
+	alertsSilenceCmd.AddCommand(alertsSilenceListCmd)
+	alertsSilenceCmd.AddCommand(alertsSilenceCreateCmd)
+	alertsSilenceCmd.AddCommand(alertsSilenceDeleteCmd)
+}
+
+var alertsSilenceListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List all silence windows",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, ctx, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+		return consumeJSON(client.GetAllSilenceWindows(ctx))
+	},
+}
+
+var alertsSilenceCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Create a silence window",
+	Example: `  sensor-hub alerts silence create --name "Garage door mornings" --sensor-id 3 \
+    --days mon,tue,wed,thu,fri --start 07:00 --end 09:00`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		name, _ := cmd.Flags().GetString("name")
+		days, _ := cmd.Flags().GetStringSlice("days")
+		start, _ := cmd.Flags().GetString("start")
+		end, _ := cmd.Flags().GetString("end")
+		enabled, _ := cmd.Flags().GetBool("enabled")
+
+		if name == "" || start == "" || end == "" {
+			return fmt.Errorf("--name, --start and --end are required")
+		}
+
+		client, ctx, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+		windowDays := make([]gen.SilenceWindowDays, 0, len(days))
+		for _, day := range days {
+			windowDays = append(windowDays, gen.SilenceWindowDays(strings.ToLower(day)))
+		}
+		body := gen.CreateSilenceWindowJSONRequestBody{
+			Name:      name,
+			Days:      &windowDays,
+			StartTime: start,
+			EndTime:   end,
+			Enabled:   enabled,
+		}
+		if cmd.Flags().Changed("sensor-id") {
+			sensorID, _ := cmd.Flags().GetInt("sensor-id")
+			body.SensorID = &sensorID
+		}
+		return consumeJSON(client.CreateSilenceWindow(ctx, body))
+	},
+}
+
+func init() {
+	alertsSilenceCreateCmd.Flags().String("name", "", "Window name")
+	alertsSilenceCreateCmd.Flags().Int("sensor-id", 0, "Sensor to silence (omit to silence every sensor)")
+	alertsSilenceCreateCmd.Flags().StringSlice("days", nil, "Days the window starts on, e.g. mon,tue (omit for every day)")
+	alertsSilenceCreateCmd.Flags().String("start", "", "Start time as HH:MM in the hub's local time")
+	alertsSilenceCreateCmd.Flags().String("end", "", "End time as HH:MM (before --start to run past midnight)")
+	alertsSilenceCreateCmd.Flags().Bool("enabled", true, "Whether the window is enabled")
+}
+
+var alertsSilenceDeleteCmd = &cobra.Command{
+	Use:   "delete [id]",
+	Short: "Delete a silence window by ID",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := strconv.Atoi(args[0])
+		if err != nil {
+			return fmt.Errorf("silence window ID must be a number")
+		}
+		client, ctx, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+		return consumeJSON(client.DeleteSilenceWindow(ctx, id))
+	},
+}
+
+var alertsEscalationCmd = &cobra.Command{
+	Use:   "escalation",
+	Short: "Manage the escalation policy of an alert rule",
+}
+
+func init() {
+	alertsEscalationCmd.AddCommand(alertsEscalationShowCmd)
+	alertsEscalationCmd.AddCommand(alertsEscalationSetCmd)
+	alertsEscalationCmd.AddCommand(alertsEscalationDeleteCmd)
+	alertsEscalationCmd.PersistentFlags().Bool("compound", false, "Treat the ID as a compound alert rule ID")
+}
+
+var alertsEscalationShowCmd = &cobra.Command{
+	Use:   "show [id]",
+	Short: "Show an alert rule's escalation policy",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := strconv.Atoi(args[0])
+		if err != nil {
+			return fmt.Errorf("alert ID must be a number")
+		}
+		client, ctx, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+		if compound, _ := cmd.Flags().GetBool("compound"); compound {
+			return consumeJSON(client.GetCompoundAlertEscalationPolicy(ctx, id))
+		}
+		return consumeJSON(client.GetAlertEscalationPolicy(ctx, id))
+	},
+}
+
+var alertsEscalationSetCmd = &cobra.Command{
+	Use:   "set [id]",
+	Short: "Replace an alert rule's escalation policy",
+	Long: `Replace an alert rule's escalation policy. Each --step is MINUTES[:ROLE[:CHANNELS]]:
+the step is sent MINUTES after the previous notification if nobody has read, dismissed
+or acknowledged the alert, to the users with ROLE (everyone who received the alert when
+empty), over the comma-separated CHANNELS (email, webhook, push) regardless of their
+channel preferences.`,
+	Example: `  sensor-hub alerts escalation set 3 --step 15 --step 30:admin:push,email`,
+	Args:    cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := strconv.Atoi(args[0])
+		if err != nil {
+			return fmt.Errorf("alert ID must be a number")
+		}
+		rawSteps, _ := cmd.Flags().GetStringArray("step")
+		if len(rawSteps) == 0 {
+			return fmt.Errorf("at least one --step is required")
+		}
+		steps := make([]gen.EscalationStep, 0, len(rawSteps))
+		for _, raw := range rawSteps {
+			step, err := parseEscalationStep(raw)
+			if err != nil {
+				return err
+			}
+			steps = append(steps, step)
+		}
+		client, ctx, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+		if compound, _ := cmd.Flags().GetBool("compound"); compound {
+			return consumeJSON(client.SetCompoundAlertEscalationPolicy(ctx, id, gen.SetCompoundAlertEscalationPolicyJSONRequestBody{Steps: steps}))
+		}
+		return consumeJSON(client.SetAlertEscalationPolicy(ctx, id, gen.SetAlertEscalationPolicyJSONRequestBody{Steps: steps}))
+	},
+}
+
+func init() {
+	alertsEscalationSetCmd.Flags().StringArray("step", nil, "Escalation step as MINUTES[:ROLE[:CHANNELS]] (repeatable, in order)")
+}
+
+// parseEscalationStep parses a --step value of the form MINUTES[:ROLE[:CHANNELS]].
+func parseEscalationStep(raw string) (gen.EscalationStep, error) {
+	parts := strings.SplitN(raw, ":", 3)
+	minutes, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return gen.EscalationStep{}, fmt.Errorf("invalid --step %q: delay must be a number of minutes", raw)
+	}
+	step := gen.EscalationStep{DelayMinutes: minutes}
+	if len(parts) > 1 && parts[1] != "" {
+		step.Role = &parts[1]
+	}
+	if len(parts) > 2 && parts[2] != "" {
+		channels := make([]gen.EscalationStepChannels, 0)
+		for _, channel := range strings.Split(parts[2], ",") {
+			channels = append(channels, gen.EscalationStepChannels(strings.ToLower(strings.TrimSpace(channel))))
+		}
+		step.Channels = &channels
+	}
+	return step, nil
+}
+
+var alertsEscalationDeleteCmd = &cobra.Command{
+	Use:   "delete [id]",
+	Short: "Remove an alert rule's escalation policy",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := strconv.Atoi(args[0])
+		if err != nil {
+			return fmt.Errorf("alert ID must be a number")
+		}
+		client, ctx, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+		if compound, _ := cmd.Flags().GetBool("compound"); compound {
+			return consumeJSON(client.DeleteCompoundAlertEscalationPolicy(ctx, id))
+		}
+		return consumeJSON(client.DeleteAlertEscalationPolicy(ctx, id))
+	},
+}
+
+var alertsRecipientsCmd = &cobra.Command{
+	Use:   "recipients",
+	Short: "Manage who an alert rule's notifications go to",
+}
+
+func init() {
+	alertsRecipientsCmd.AddCommand(alertsRecipientsShowCmd)
+	alertsRecipientsCmd.AddCommand(alertsRecipientsSetCmd)
+	alertsRecipientsCmd.AddCommand(alertsRecipientsDeleteCmd)
+	alertsRecipientsCmd.PersistentFlags().Bool("compound", false, "Treat the ID as a compound alert rule ID")
+}
+
+var alertsRecipientsShowCmd = &cobra.Command{
+	Use:   "show [id]",
+	Short: "Show an alert rule's recipients",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := strconv.Atoi(args[0])
+		if err != nil {
+			return fmt.Errorf("alert ID must be a number")
+		}
+		client, ctx, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+		if compound, _ := cmd.Flags().GetBool("compound"); compound {
+			return consumeJSON(client.GetCompoundAlertRecipients(ctx, id))
+		}
+		return consumeJSON(client.GetAlertRecipients(ctx, id))
+	},
+}
+
+var alertsRecipientsSetCmd = &cobra.Command{
+	Use:   "set [id]",
+	Short: "Replace an alert rule's recipients",
+	Long: `Replace an alert rule's recipients. Its notifications go to the listed users, every
+user with one of the listed roles and every member of the listed contact groups, instead
+of every user with view_alerts.`,
+	Example: `  sensor-hub alerts recipients set 3 --group 1
+  sensor-hub alerts recipients set 4 --user 2 --role admin
+  sensor-hub alerts recipients set 2 --compound --role admin`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := strconv.Atoi(args[0])
+		if err != nil {
+			return fmt.Errorf("alert ID must be a number")
+		}
+		userIDs, _ := cmd.Flags().GetIntSlice("user")
+		roles, _ := cmd.Flags().GetStringSlice("role")
+		groupIDs, _ := cmd.Flags().GetIntSlice("group")
+		if len(userIDs) == 0 && len(roles) == 0 && len(groupIDs) == 0 {
+			return fmt.Errorf("at least one of --user, --role or --group is required (use delete to notify everyone with view_alerts)")
+		}
+		client, ctx, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+		body := gen.AlertRecipients{UserIDs: &userIDs, Roles: &roles, ContactGroupIDs: &groupIDs}
+		if compound, _ := cmd.Flags().GetBool("compound"); compound {
+			return consumeJSON(client.SetCompoundAlertRecipients(ctx, id, body))
+		}
+		return consumeJSON(client.SetAlertRecipients(ctx, id, body))
+	},
+}
+
+func init() {
+	alertsRecipientsSetCmd.Flags().IntSlice("user", nil, "User IDs to notify")
+	alertsRecipientsSetCmd.Flags().StringSlice("role", nil, "Roles whose users to notify")
+	alertsRecipientsSetCmd.Flags().IntSlice("group", nil, "Contact group IDs whose members to notify")
+}
+
+var alertsRecipientsDeleteCmd = &cobra.Command{
+	Use:   "delete [id]",
+	Short: "Send an alert rule's notifications to every user with view_alerts again",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := strconv.Atoi(args[0])
+		if err != nil {
+			return fmt.Errorf("alert ID must be a number")
+		}
+		client, ctx, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+		if compound, _ := cmd.Flags().GetBool("compound"); compound {
+			return consumeJSON(client.DeleteCompoundAlertRecipients(ctx, id))
+		}
+		return consumeJSON(client.DeleteAlertRecipients(ctx, id))
+	},
+}
+
+var alertsContactGroupCmd = &cobra.Command{
+	Use:   "contact-group",
+	Short: "Manage named groups of users that alert rules can notify",
+}
+
+func init() {
+	alertsContactGroupCmd.AddCommand(alertsContactGroupListCmd)
+	alertsContactGroupCmd.AddCommand(alertsContactGroupCreateCmd)
+	alertsContactGroupCmd.AddCommand(alertsContactGroupUpdateCmd)
+	alertsContactGroupCmd.AddCommand(alertsContactGroupDeleteCmd)
+}
+
+var alertsContactGroupListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List all contact groups",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, ctx, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+		return consumeJSON(client.GetAllContactGroups(ctx))
+	},
+}
+
+var alertsContactGroupCreateCmd = &cobra.Command{
+	Use:     "create",
+	Short:   "Create a contact group",
+	Example: `  sensor-hub alerts contact-group create --name "Freezer on-call" --user 2 --user 5`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		name, _ := cmd.Flags().GetString("name")
+		userIDs, _ := cmd.Flags().GetIntSlice("user")
+		if name == "" {
+			return fmt.Errorf("--name is required")
+		}
+		client, ctx, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+		return consumeJSON(client.CreateContactGroup(ctx, gen.CreateContactGroupJSONRequestBody{Name: name, UserIDs: userIDs}))
+	},
+}
+
+func init() {
+	alertsContactGroupCreateCmd.Flags().String("name", "", "Group name")
+	alertsContactGroupCreateCmd.Flags().IntSlice("user", nil, "User IDs of the members")
+}
+
+var alertsContactGroupUpdateCmd = &cobra.Command{
+	Use:     "update [id]",
+	Short:   "Rename a contact group and replace its members",
+	Example: `  sensor-hub alerts contact-group update 1 --name "Freezer on-call" --user 3`,
+	Args:    cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := strconv.Atoi(args[0])
+		if err != nil {
+			return fmt.Errorf("contact group ID must be a number")
+		}
+		name, _ := cmd.Flags().GetString("name")
+		userIDs, _ := cmd.Flags().GetIntSlice("user")
+		if name == "" {
+			return fmt.Errorf("--name is required")
+		}
+		client, ctx, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+		return consumeJSON(client.UpdateContactGroup(ctx, id, gen.UpdateContactGroupJSONRequestBody{Name: name, UserIDs: userIDs}))
+	},
+}
+
+func init() {
+	alertsContactGroupUpdateCmd.Flags().String("name", "", "Group name")
+	alertsContactGroupUpdateCmd.Flags().IntSlice("user", nil, "User IDs of the members")
+}
+
+var alertsContactGroupDeleteCmd = &cobra.Command{
+	Use:   "delete [id]",
+	Short: "Delete a contact group by ID",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := strconv.Atoi(args[0])
+		if err != nil {
+			return fmt.Errorf("contact group ID must be a number")
+		}
+		client, ctx, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+		return consumeJSON(client.DeleteContactGroup(ctx, id))
+	},
 }
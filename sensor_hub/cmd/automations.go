@@ -0,0 +1,219 @@
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	gen "example/sensorHub/gen"
+
+	"github.com/spf13/cobra"
+)
+
+var automationsCmd = &cobra.Command{
+	Use:   "automations",
+	Short: "Manage automation rules that send commands on readings, alerts or schedules",
+}
+
+func init() {
+	addAutomationRuleFlags(automationsCreateCmd)
+	addAutomationRuleFlags(automationsUpdateCmd)
+
+	automationsCmd.AddCommand(automationsListCmd)
+	automationsCmd.AddCommand(automationsGetCmd)
+	automationsCmd.AddCommand(automationsCreateCmd)
+	automationsCmd.AddCommand(automationsUpdateCmd)
+	automationsCmd.AddCommand(automationsDeleteCmd)
+	rootCmd.AddCommand(automationsCmd)
+}
+
+func addAutomationRuleFlags(cmd *cobra.Command) {
+	cmd.Flags().String("name", "", "Rule name")
+	cmd.Flags().String("trigger", "", "Trigger type: reading, alert or schedule")
+	cmd.Flags().Int("sensor-id", 0, "Sensor whose readings trigger the rule (reading trigger)")
+	cmd.Flags().Int("measurement-type-id", 0, "Measurement type compared (reading trigger)")
+	cmd.Flags().String("comparison", "", "Comparison: >, >=, <, <=, == or != (reading trigger)")
+	cmd.Flags().Float64("threshold", 0, "Numeric threshold (reading trigger)")
+	cmd.Flags().String("status", "", "Text state compared with == or != instead of a threshold (reading trigger)")
+	cmd.Flags().Int("alert-rule-id", 0, "Alert rule whose firing triggers the rule (alert trigger)")
+	cmd.Flags().String("time", "", "Time as HH:MM in the hub's local time (schedule trigger)")
+	cmd.Flags().StringSlice("days", nil, "Days to run on, e.g. mon,fri (schedule trigger, omit for every day)")
+	cmd.Flags().String("holiday", "skip", "During holiday mode: skip, run or only (schedule trigger)")
+	cmd.Flags().Int("target-sensor-id", 0, "Controllable sensor the command is sent to")
+	cmd.Flags().String("property", "", "Capability to set on the target, e.g. state")
+	cmd.Flags().String("value", "", "Value to set, e.g. ON")
+	cmd.Flags().Int("scene-id", 0, "Scene to activate instead of sending a single command")
+	cmd.Flags().Bool("enabled", true, "Whether the rule is enabled")
+}
+
+// automationRuleFromFlags builds a rule from the flags of create and update. Flags of
+// other trigger types are left unset.
+func automationRuleFromFlags(cmd *cobra.Command) (gen.AutomationRule, error) {
+	name, _ := cmd.Flags().GetString("name")
+	trigger, _ := cmd.Flags().GetString("trigger")
+	targetSensorID, _ := cmd.Flags().GetInt("target-sensor-id")
+	property, _ := cmd.Flags().GetString("property")
+	value, _ := cmd.Flags().GetString("value")
+	sceneID, _ := cmd.Flags().GetInt("scene-id")
+	enabled, _ := cmd.Flags().GetBool("enabled")
+
+	if name == "" || trigger == "" {
+		return gen.AutomationRule{}, fmt.Errorf("--name and --trigger are required")
+	}
+
+	rule := gen.AutomationRule{
+		Name:        name,
+		Enabled:     enabled,
+		TriggerType: gen.AutomationRuleTriggerType(trigger),
+	}
+	if sceneID != 0 {
+		if targetSensorID != 0 || property != "" || value != "" {
+			return gen.AutomationRule{}, fmt.Errorf("--scene-id cannot be combined with --target-sensor-id, --property or --value")
+		}
+		rule.SceneId = &sceneID
+	} else {
+		if targetSensorID == 0 || property == "" || value == "" {
+			return gen.AutomationRule{}, fmt.Errorf("--target-sensor-id, --property and --value are required unless --scene-id is given")
+		}
+		rule.TargetSensorId = &targetSensorID
+		rule.Property = &property
+		rule.Value = &value
+	}
+	if cmd.Flags().Changed("sensor-id") {
+		sensorID, _ := cmd.Flags().GetInt("sensor-id")
+		rule.SensorId = &sensorID
+	}
+	if cmd.Flags().Changed("measurement-type-id") {
+		measurementTypeID, _ := cmd.Flags().GetInt("measurement-type-id")
+		rule.MeasurementTypeId = &measurementTypeID
+	}
+	if cmd.Flags().Changed("comparison") {
+		comparison, _ := cmd.Flags().GetString("comparison")
+		c := gen.AutomationRuleComparison(comparison)
+		rule.Comparison = &c
+	}
+	if cmd.Flags().Changed("threshold") {
+		threshold, _ := cmd.Flags().GetFloat64("threshold")
+		rule.Threshold = &threshold
+	}
+	if cmd.Flags().Changed("status") {
+		status, _ := cmd.Flags().GetString("status")
+		rule.Status = &status
+	}
+	if cmd.Flags().Changed("alert-rule-id") {
+		alertRuleID, _ := cmd.Flags().GetInt("alert-rule-id")
+		rule.AlertRuleId = &alertRuleID
+	}
+	if cmd.Flags().Changed("time") {
+		scheduleTime, _ := cmd.Flags().GetString("time")
+		rule.ScheduleTime = &scheduleTime
+	}
+	days, _ := cmd.Flags().GetStringSlice("days")
+	scheduleDays := make([]gen.AutomationRuleScheduleDays, 0, len(days))
+	for _, day := range days {
+		scheduleDays = append(scheduleDays, gen.AutomationRuleScheduleDays(strings.ToLower(day)))
+	}
+	rule.ScheduleDays = &scheduleDays
+	holiday, _ := cmd.Flags().GetString("holiday")
+	scheduleHoliday := gen.AutomationRuleScheduleHoliday(holiday)
+	rule.ScheduleHoliday = &scheduleHoliday
+	return rule, nil
+}
+
+func parseAutomationRuleID(s string) (int, error) {
+	id, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("automation rule ID must be a number")
+	}
+	return id, nil
+}
+
+var automationsListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List all automation rules",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, ctx, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+		return consumeJSON(client.GetAllAutomationRules(ctx))
+	},
+}
+
+var automationsGetCmd = &cobra.Command{
+	Use:   "get [id]",
+	Short: "Get an automation rule by ID",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := parseAutomationRuleID(args[0])
+		if err != nil {
+			return err
+		}
+		client, ctx, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+		return consumeJSON(client.GetAutomationRuleById(ctx, id))
+	},
+}
+
+var automationsCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Create an automation rule",
+	Example: `  sensor-hub automations create --name "Dehumidifier on" --trigger reading \
+    --sensor-id 4 --measurement-type-id 2 --comparison ">" --threshold 75 \
+    --target-sensor-id 9 --property state --value ON
+  sensor-hub automations create --name "Heating off" --trigger schedule \
+    --time 23:00 --days mon,tue,wed,thu,fri --target-sensor-id 12 --property state --value OFF
+  sensor-hub automations create --name "Leak response" --trigger alert \
+    --alert-rule-id 3 --scene-id 2`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		rule, err := automationRuleFromFlags(cmd)
+		if err != nil {
+			return err
+		}
+		client, ctx, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+		return consumeJSON(client.CreateAutomationRule(ctx, rule))
+	},
+}
+
+var automationsUpdateCmd = &cobra.Command{
+	Use:   "update [id]",
+	Short: "Replace an automation rule's settings",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := parseAutomationRuleID(args[0])
+		if err != nil {
+			return err
+		}
+		rule, err := automationRuleFromFlags(cmd)
+		if err != nil {
+			return err
+		}
+		client, ctx, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+		return consumeJSON(client.UpdateAutomationRule(ctx, id, rule))
+	},
+}
+
+var automationsDeleteCmd = &cobra.Command{
+	Use:   "delete [id]",
+	Short: "Delete an automation rule by ID",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := parseAutomationRuleID(args[0])
+		if err != nil {
+			return err
+		}
+		client, ctx, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+		return consumeJSON(client.DeleteAutomationRule(ctx, id))
+	},
+}
@@ -11,12 +11,15 @@ import (
 
 var measurementTypesCmd = &cobra.Command{
 	Use:   "measurement-types",
-	Short: "Query measurement types",
+	Short: "Query and configure measurement types",
 }
 
 func init() {
 	measurementTypesCmd.AddCommand(measurementTypesListCmd)
 	measurementTypesCmd.AddCommand(measurementTypesForSensorCmd)
+	measurementTypesCmd.AddCommand(measurementTypesDownsamplingCmd)
+	measurementTypesDownsamplingCmd.AddCommand(measurementTypesDownsamplingSetCmd)
+	measurementTypesDownsamplingCmd.AddCommand(measurementTypesDownsamplingClearCmd)
 	rootCmd.AddCommand(measurementTypesCmd)
 }
 
@@ -54,6 +57,41 @@ var measurementTypesForSensorCmd = &cobra.Command{
 	},
 }
 
+var measurementTypesDownsamplingCmd = &cobra.Command{
+	Use:   "downsampling",
+	Short: "Configure downsampling of readings past retention",
+}
+
+var measurementTypesDownsamplingSetCmd = &cobra.Command{
+	Use:   "set [name]",
+	Short: "Downsample a type's expiring readings instead of deleting them",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, ctx, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+		interval, _ := cmd.Flags().GetString("interval")
+		body := gen.MeasurementTypeDownsampling{Interval: gen.MeasurementTypeDownsamplingInterval(interval)}
+		return consumeJSON(client.SetMeasurementTypeDownsampling(ctx, args[0], body))
+	},
+}
+
+var measurementTypesDownsamplingClearCmd = &cobra.Command{
+	Use:   "clear [name]",
+	Short: "Delete a type's expiring readings again",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, ctx, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+		return consumeJSON(client.DeleteMeasurementTypeDownsampling(ctx, args[0]))
+	},
+}
+
 func init() {
+	measurementTypesDownsamplingSetCmd.Flags().String("interval", "", "Bucket width: PT1M, PT5M, PT15M, PT1H or P1D")
+	_ = measurementTypesDownsamplingSetCmd.MarkFlagRequired("interval")
 	measurementTypesListCmd.Flags().Bool("has-readings", false, "Only return types that have at least one reading")
 }
@@ -3,6 +3,7 @@ package cmd
 import (
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/spf13/cobra"
 
@@ -23,6 +24,9 @@ func init() {
 	notificationsCmd.AddCommand(notificationsBulkDismissCmd)
 	notificationsCmd.AddCommand(notificationsPreferencesCmd)
 	notificationsCmd.AddCommand(notificationsSetPreferenceCmd)
+	notificationsCmd.AddCommand(notificationsWebhooksCmd)
+	notificationsCmd.AddCommand(notificationsPushCmd)
+	notificationsCmd.AddCommand(notificationsQuietHoursCmd)
 	rootCmd.AddCommand(notificationsCmd)
 }
 
@@ -162,6 +166,19 @@ var notificationsSetPreferenceCmd = &cobra.Command{
 			EmailEnabled: &emailEnabled,
 			InappEnabled: &inappEnabled,
 		}
+		if cmd.Flags().Changed("webhook-enabled") {
+			webhookEnabled, _ := cmd.Flags().GetBool("webhook-enabled")
+			body.WebhookEnabled = &webhookEnabled
+		}
+		if cmd.Flags().Changed("push-enabled") {
+			pushEnabled, _ := cmd.Flags().GetBool("push-enabled")
+			body.PushEnabled = &pushEnabled
+		}
+		if cmd.Flags().Changed("email-digest") {
+			emailDigest, _ := cmd.Flags().GetString("email-digest")
+			digest := gen.ChannelPreferenceEmailDigest(emailDigest)
+			body.EmailDigest = &digest
+		}
 		return consumeJSON(client.SetChannelPreference(ctx, body))
 	},
 }
@@ -170,5 +187,313 @@ func init() {
 	notificationsSetPreferenceCmd.Flags().String("category", "", "Notification category (required)")
 	notificationsSetPreferenceCmd.Flags().Bool("email-enabled", false, "Enable email notifications")
 	notificationsSetPreferenceCmd.Flags().Bool("inapp-enabled", false, "Enable in-app notifications")
+	notificationsSetPreferenceCmd.Flags().Bool("webhook-enabled", false, "Enable delivery to your webhooks (omit to keep the current setting)")
+	notificationsSetPreferenceCmd.Flags().Bool("push-enabled", false, "Enable ntfy/Gotify push notifications (omit to keep the current setting)")
+	notificationsSetPreferenceCmd.Flags().String("email-digest", "", "Send emails as they happen (off) or as an hourly or daily digest (omit to keep the current setting)")
 	_ = notificationsSetPreferenceCmd.MarkFlagRequired("category")
 }
+
+var notificationsWebhooksCmd = &cobra.Command{
+	Use:   "webhooks",
+	Short: "Manage outbound webhook targets",
+}
+
+func init() {
+	notificationsWebhooksCmd.AddCommand(notificationsWebhooksListCmd)
+	notificationsWebhooksCmd.AddCommand(notificationsWebhooksCreateCmd)
+	notificationsWebhooksCmd.AddCommand(notificationsWebhooksDeleteCmd)
+	notificationsWebhooksCmd.AddCommand(notificationsWebhooksDeliveriesCmd)
+	notificationsWebhooksCmd.AddCommand(notificationsWebhooksTestCmd)
+}
+
+func parseWebhookID(s string) (int, error) {
+	id, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("webhook ID must be a number")
+	}
+	return id, nil
+}
+
+var notificationsWebhooksListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List your webhook targets (and global ones if you can manage them)",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, ctx, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+		return consumeJSON(client.ListWebhookTargets(ctx))
+	},
+}
+
+var notificationsWebhooksCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Create a webhook target",
+	Example: `  sensor-hub notifications webhooks create --name chat --url https://chat.example.com/hooks/abc \
+    --category threshold_alert --body-template '{"text": {{json .Title}}}'`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		name, _ := cmd.Flags().GetString("name")
+		url, _ := cmd.Flags().GetString("url")
+		secret, _ := cmd.Flags().GetString("secret")
+		headerPairs, _ := cmd.Flags().GetStringArray("header")
+		bodyTemplate, _ := cmd.Flags().GetString("body-template")
+		categories, _ := cmd.Flags().GetStringSlice("category")
+		global, _ := cmd.Flags().GetBool("global")
+		enabled, _ := cmd.Flags().GetBool("enabled")
+
+		if name == "" || url == "" {
+			return fmt.Errorf("--name and --url are required")
+		}
+		headers := make(map[string]string, len(headerPairs))
+		for _, pair := range headerPairs {
+			key, value, ok := strings.Cut(pair, "=")
+			if !ok || key == "" {
+				return fmt.Errorf("--header must be NAME=VALUE, got %q", pair)
+			}
+			headers[key] = value
+		}
+		targetCategories := make([]gen.WebhookTargetCategories, 0, len(categories))
+		for _, c := range categories {
+			targetCategories = append(targetCategories, gen.WebhookTargetCategories(c))
+		}
+
+		client, ctx, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+		body := gen.CreateWebhookTargetJSONRequestBody{
+			Name:         name,
+			Url:          url,
+			Headers:      &headers,
+			BodyTemplate: &bodyTemplate,
+			Categories:   &targetCategories,
+			Enabled:      enabled,
+			Global:       &global,
+		}
+		if secret != "" {
+			body.Secret = &secret
+		}
+		return consumeJSON(client.CreateWebhookTarget(ctx, body))
+	},
+}
+
+func init() {
+	notificationsWebhooksCreateCmd.Flags().String("name", "", "Target name")
+	notificationsWebhooksCreateCmd.Flags().String("url", "", "URL notifications are POSTed to")
+	notificationsWebhooksCreateCmd.Flags().String("secret", "", "Secret for the X-SensorHub-Signature-256 HMAC header")
+	notificationsWebhooksCreateCmd.Flags().StringArray("header", nil, "Extra request header as NAME=VALUE (repeatable)")
+	notificationsWebhooksCreateCmd.Flags().String("body-template", "", "Go template for the request body (omit for the default JSON body)")
+	notificationsWebhooksCreateCmd.Flags().StringSlice("category", nil, "Categories to deliver, e.g. threshold_alert (omit for all)")
+	notificationsWebhooksCreateCmd.Flags().Bool("global", false, "Create a global target that receives every user's notifications (requires manage_webhooks)")
+	notificationsWebhooksCreateCmd.Flags().Bool("enabled", true, "Whether the target is enabled")
+}
+
+var notificationsWebhooksDeleteCmd = &cobra.Command{
+	Use:   "delete [id]",
+	Short: "Delete a webhook target",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := parseWebhookID(args[0])
+		if err != nil {
+			return err
+		}
+		client, ctx, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+		return consumeJSON(client.DeleteWebhookTarget(ctx, id))
+	},
+}
+
+var notificationsWebhooksDeliveriesCmd = &cobra.Command{
+	Use:   "deliveries [id]",
+	Short: "Show a webhook target's delivery log",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := parseWebhookID(args[0])
+		if err != nil {
+			return err
+		}
+		client, ctx, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+		params := &gen.GetWebhookDeliveriesParams{}
+		if limit, _ := cmd.Flags().GetInt("limit"); limit > 0 {
+			params.Limit = &limit
+		}
+		return consumeJSON(client.GetWebhookDeliveries(ctx, id, params))
+	},
+}
+
+func init() {
+	notificationsWebhooksDeliveriesCmd.Flags().Int("limit", 0, "Maximum number of deliveries (default 50)")
+}
+
+var notificationsWebhooksTestCmd = &cobra.Command{
+	Use:   "test [id]",
+	Short: "Send a test notification to a webhook target",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := parseWebhookID(args[0])
+		if err != nil {
+			return err
+		}
+		client, ctx, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+		return consumeJSON(client.TestWebhookTarget(ctx, id))
+	},
+}
+
+var notificationsPushCmd = &cobra.Command{
+	Use:   "push",
+	Short: "Manage your ntfy or Gotify push subscription",
+}
+
+func init() {
+	notificationsPushCmd.AddCommand(notificationsPushShowCmd)
+	notificationsPushCmd.AddCommand(notificationsPushSetCmd)
+	notificationsPushCmd.AddCommand(notificationsPushDeleteCmd)
+	notificationsPushCmd.AddCommand(notificationsPushTestCmd)
+}
+
+var notificationsPushShowCmd = &cobra.Command{
+	Use:   "show",
+	Short: "Show your push subscription",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, ctx, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+		return consumeJSON(client.GetPushSubscription(ctx))
+	},
+}
+
+var notificationsPushSetCmd = &cobra.Command{
+	Use:   "set",
+	Short: "Create or replace your push subscription",
+	Example: `  sensor-hub notifications push set --provider ntfy --server https://ntfy.sh --topic my-house-leaks
+  sensor-hub notifications push set --provider gotify --server https://gotify.example.com --token AbCdEf`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		provider, _ := cmd.Flags().GetString("provider")
+		server, _ := cmd.Flags().GetString("server")
+		topic, _ := cmd.Flags().GetString("topic")
+		enabled, _ := cmd.Flags().GetBool("enabled")
+
+		client, ctx, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+		body := gen.SetPushSubscriptionJSONRequestBody{
+			Provider:  gen.PushSubscriptionProvider(provider),
+			ServerUrl: server,
+			Topic:     &topic,
+			Enabled:   enabled,
+		}
+		if cmd.Flags().Changed("token") {
+			token, _ := cmd.Flags().GetString("token")
+			body.Token = &token
+		}
+		return consumeJSON(client.SetPushSubscription(ctx, body))
+	},
+}
+
+func init() {
+	notificationsPushSetCmd.Flags().String("provider", "", "Push server type: ntfy or gotify")
+	notificationsPushSetCmd.Flags().String("server", "", "Push server URL, e.g. https://ntfy.sh")
+	notificationsPushSetCmd.Flags().String("topic", "", "ntfy topic to publish to")
+	notificationsPushSetCmd.Flags().String("token", "", "ntfy access token or Gotify application token (omit to keep the current token, empty to remove it)")
+	notificationsPushSetCmd.Flags().Bool("enabled", true, "Whether push notifications are sent")
+	_ = notificationsPushSetCmd.MarkFlagRequired("provider")
+	_ = notificationsPushSetCmd.MarkFlagRequired("server")
+}
+
+var notificationsPushDeleteCmd = &cobra.Command{
+	Use:   "delete",
+	Short: "Delete your push subscription",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, ctx, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+		return consumeJSON(client.DeletePushSubscription(ctx))
+	},
+}
+
+var notificationsPushTestCmd = &cobra.Command{
+	Use:   "test",
+	Short: "Send a test push notification",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, ctx, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+		return consumeJSON(client.TestPushSubscription(ctx))
+	},
+}
+
+var notificationsQuietHoursCmd = &cobra.Command{
+	Use:   "quiet-hours",
+	Short: "Manage the daily period when your notification emails are held back",
+}
+
+func init() {
+	notificationsQuietHoursCmd.AddCommand(notificationsQuietHoursShowCmd)
+	notificationsQuietHoursCmd.AddCommand(notificationsQuietHoursSetCmd)
+	notificationsQuietHoursCmd.AddCommand(notificationsQuietHoursDeleteCmd)
+}
+
+var notificationsQuietHoursShowCmd = &cobra.Command{
+	Use:   "show",
+	Short: "Show your quiet hours",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, ctx, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+		return consumeJSON(client.GetQuietHours(ctx))
+	},
+}
+
+var notificationsQuietHoursSetCmd = &cobra.Command{
+	Use:     "set",
+	Short:   "Create or replace your quiet hours",
+	Example: `  sensor-hub notifications quiet-hours set --start 22:00 --end 07:00`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		start, _ := cmd.Flags().GetString("start")
+		end, _ := cmd.Flags().GetString("end")
+		enabled, _ := cmd.Flags().GetBool("enabled")
+
+		client, ctx, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+		return consumeJSON(client.SetQuietHours(ctx, gen.SetQuietHoursJSONRequestBody{
+			Start:   start,
+			End:     end,
+			Enabled: enabled,
+		}))
+	},
+}
+
+func init() {
+	notificationsQuietHoursSetCmd.Flags().String("start", "", "Start time, HH:MM in the hub's local time")
+	notificationsQuietHoursSetCmd.Flags().String("end", "", "End time, HH:MM; before the start to run past midnight")
+	notificationsQuietHoursSetCmd.Flags().Bool("enabled", true, "Whether quiet hours apply")
+	_ = notificationsQuietHoursSetCmd.MarkFlagRequired("start")
+	_ = notificationsQuietHoursSetCmd.MarkFlagRequired("end")
+}
+
+var notificationsQuietHoursDeleteCmd = &cobra.Command{
+	Use:   "delete",
+	Short: "Delete your quiet hours",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, ctx, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+		return consumeJSON(client.DeleteQuietHours(ctx))
+	},
+}
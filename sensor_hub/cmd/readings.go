@@ -25,6 +25,7 @@ var readingsBetweenCmd = &cobra.Command{
 		end, _ := cmd.Flags().GetString("end")
 		aggregation, _ := cmd.Flags().GetString("aggregation")
 		aggregationFn, _ := cmd.Flags().GetString("aggregation-function")
+		statistics, _ := cmd.Flags().GetStringSlice("statistics")
 
 		client, ctx, err := newAPIClient(cmd)
 		if err != nil {
@@ -45,6 +46,13 @@ var readingsBetweenCmd = &cobra.Command{
 			f := gen.GetReadingsBetweenDatesParamsAggregationFunction(aggregationFn)
 			params.AggregationFunction = &f
 		}
+		if len(statistics) > 0 {
+			stats := make([]gen.GetReadingsBetweenDatesParamsStatistics, len(statistics))
+			for i, stat := range statistics {
+				stats[i] = gen.GetReadingsBetweenDatesParamsStatistics(stat)
+			}
+			params.Statistics = &stats
+		}
 		return consumeJSON(client.GetReadingsBetweenDates(ctx, params))
 	},
 }
@@ -54,5 +62,6 @@ func init() {
 	readingsBetweenCmd.Flags().String("start", "", "Start date (YYYY-MM-DD) or datetime (ISO 8601, e.g. 2024-01-15T10:30:00Z)")
 	readingsBetweenCmd.Flags().String("end", "", "End date (YYYY-MM-DD) or datetime (ISO 8601, e.g. 2024-01-15T11:30:00Z)")
 	readingsBetweenCmd.Flags().String("aggregation", "", "Override aggregation interval (ISO 8601 duration, e.g. PT1H, PT5M)")
-	readingsBetweenCmd.Flags().String("aggregation-function", "", "Override aggregation function (avg, min, max, sum, count, last)")
+	readingsBetweenCmd.Flags().String("aggregation-function", "", "Override aggregation function (avg, min, max, sum, count, last, p50, p95)")
+	readingsBetweenCmd.Flags().StringSlice("statistics", nil, "Extra per-bucket statistics, e.g. min,avg,max (avg, min, max, sum, count, p50, p95)")
 }
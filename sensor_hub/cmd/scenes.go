@@ -0,0 +1,185 @@
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	gen "example/sensorHub/gen"
+
+	"github.com/spf13/cobra"
+)
+
+var scenesCmd = &cobra.Command{
+	Use:   "scenes",
+	Short: "Manage and activate scenes of grouped device commands",
+}
+
+func init() {
+	addSceneFlags(scenesCreateCmd)
+	addSceneFlags(scenesUpdateCmd)
+	scenesActivateCmd.Flags().Bool("wait", false, "Wait for every command's outcome before printing the result")
+
+	scenesCmd.AddCommand(scenesListCmd)
+	scenesCmd.AddCommand(scenesGetCmd)
+	scenesCmd.AddCommand(scenesCreateCmd)
+	scenesCmd.AddCommand(scenesUpdateCmd)
+	scenesCmd.AddCommand(scenesDeleteCmd)
+	scenesCmd.AddCommand(scenesActivateCmd)
+	rootCmd.AddCommand(scenesCmd)
+}
+
+func addSceneFlags(cmd *cobra.Command) {
+	cmd.Flags().String("name", "", "Scene name")
+	cmd.Flags().String("description", "", "What the scene is for")
+	cmd.Flags().StringArray("command", nil, "Command as sensor_id:property=value, e.g. 9:state=ON (repeat for each command)")
+}
+
+func sceneFromFlags(cmd *cobra.Command) (gen.Scene, error) {
+	name, _ := cmd.Flags().GetString("name")
+	description, _ := cmd.Flags().GetString("description")
+	specs, _ := cmd.Flags().GetStringArray("command")
+
+	if name == "" || len(specs) == 0 {
+		return gen.Scene{}, fmt.Errorf("--name and at least one --command are required")
+	}
+
+	commands := make([]gen.SceneCommand, len(specs))
+	for i, spec := range specs {
+		command, err := parseSceneCommand(spec)
+		if err != nil {
+			return gen.Scene{}, err
+		}
+		commands[i] = command
+	}
+	return gen.Scene{Name: name, Description: &description, Commands: commands}, nil
+}
+
+// parseSceneCommand parses a --command value of the form sensor_id:property=value.
+func parseSceneCommand(spec string) (gen.SceneCommand, error) {
+	sensor, assignment, ok := strings.Cut(spec, ":")
+	if !ok {
+		return gen.SceneCommand{}, fmt.Errorf("--command %q must be sensor_id:property=value", spec)
+	}
+	property, value, ok := strings.Cut(assignment, "=")
+	if !ok || property == "" || value == "" {
+		return gen.SceneCommand{}, fmt.Errorf("--command %q must be sensor_id:property=value", spec)
+	}
+	sensorID, err := strconv.Atoi(sensor)
+	if err != nil {
+		return gen.SceneCommand{}, fmt.Errorf("--command %q: sensor ID must be a number", spec)
+	}
+	return gen.SceneCommand{SensorId: sensorID, Property: property, Value: value}, nil
+}
+
+func parseSceneID(s string) (int, error) {
+	id, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("scene ID must be a number")
+	}
+	return id, nil
+}
+
+var scenesListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List all scenes",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, ctx, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+		return consumeJSON(client.GetAllScenes(ctx))
+	},
+}
+
+var scenesGetCmd = &cobra.Command{
+	Use:   "get [id]",
+	Short: "Get a scene by ID",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := parseSceneID(args[0])
+		if err != nil {
+			return err
+		}
+		client, ctx, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+		return consumeJSON(client.GetSceneById(ctx, id))
+	},
+}
+
+var scenesCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Create a scene",
+	Example: `  sensor-hub scenes create --name "Movie night" \
+    --command 14:state=OFF --command 15:state=ON --command 16:brightness=50`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		scene, err := sceneFromFlags(cmd)
+		if err != nil {
+			return err
+		}
+		client, ctx, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+		return consumeJSON(client.CreateScene(ctx, scene))
+	},
+}
+
+var scenesUpdateCmd = &cobra.Command{
+	Use:   "update [id]",
+	Short: "Replace a scene's name, description and commands",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := parseSceneID(args[0])
+		if err != nil {
+			return err
+		}
+		scene, err := sceneFromFlags(cmd)
+		if err != nil {
+			return err
+		}
+		client, ctx, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+		return consumeJSON(client.UpdateScene(ctx, id, scene))
+	},
+}
+
+var scenesDeleteCmd = &cobra.Command{
+	Use:   "delete [id]",
+	Short: "Delete a scene by ID",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := parseSceneID(args[0])
+		if err != nil {
+			return err
+		}
+		client, ctx, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+		return consumeJSON(client.DeleteScene(ctx, id))
+	},
+}
+
+var scenesActivateCmd = &cobra.Command{
+	Use:     "activate [id]",
+	Short:   "Send all of a scene's commands",
+	Example: `  sensor-hub scenes activate 3 --wait`,
+	Args:    cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := parseSceneID(args[0])
+		if err != nil {
+			return err
+		}
+		wait, _ := cmd.Flags().GetBool("wait")
+		client, ctx, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+		return consumeJSON(client.ActivateScene(ctx, id, &gen.ActivateSceneParams{Wait: &wait}))
+	},
+}
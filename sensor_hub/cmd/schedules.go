@@ -0,0 +1,214 @@
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	gen "example/sensorHub/gen"
+
+	"github.com/spf13/cobra"
+)
+
+var schedulesCmd = &cobra.Command{
+	Use:   "schedules",
+	Short: "Manage command schedules and holiday mode",
+}
+
+var schedulesHolidayCmd = &cobra.Command{
+	Use:   "holiday",
+	Short: "Show or change holiday mode",
+}
+
+func init() {
+	addScheduleFlags(schedulesCreateCmd)
+	addScheduleFlags(schedulesUpdateCmd)
+	schedulesHolidayOnCmd.Flags().String("until", "", "When holiday mode ends, as RFC 3339 (omit to leave it on until turned off)")
+
+	schedulesHolidayCmd.AddCommand(schedulesHolidayShowCmd)
+	schedulesHolidayCmd.AddCommand(schedulesHolidayOnCmd)
+	schedulesHolidayCmd.AddCommand(schedulesHolidayOffCmd)
+
+	schedulesCmd.AddCommand(schedulesListCmd)
+	schedulesCmd.AddCommand(schedulesGetCmd)
+	schedulesCmd.AddCommand(schedulesCreateCmd)
+	schedulesCmd.AddCommand(schedulesUpdateCmd)
+	schedulesCmd.AddCommand(schedulesDeleteCmd)
+	schedulesCmd.AddCommand(schedulesHolidayCmd)
+	rootCmd.AddCommand(schedulesCmd)
+}
+
+func addScheduleFlags(cmd *cobra.Command) {
+	cmd.Flags().String("name", "", "Schedule name")
+	cmd.Flags().Int("sensor-id", 0, "Controllable sensor the command is sent to")
+	cmd.Flags().String("property", "", "Capability to set, e.g. state")
+	cmd.Flags().String("value", "", "Value to set, e.g. ON")
+	cmd.Flags().String("cron", "", `Cron expression in the hub's local time, e.g. "30 6 * * mon-fri"`)
+	cmd.Flags().String("holiday", "skip", "During holiday mode: skip, run or only")
+	cmd.Flags().Bool("enabled", true, "Whether the schedule is enabled")
+}
+
+func scheduleFromFlags(cmd *cobra.Command) (gen.CommandSchedule, error) {
+	name, _ := cmd.Flags().GetString("name")
+	sensorID, _ := cmd.Flags().GetInt("sensor-id")
+	property, _ := cmd.Flags().GetString("property")
+	value, _ := cmd.Flags().GetString("value")
+	cron, _ := cmd.Flags().GetString("cron")
+	holiday, _ := cmd.Flags().GetString("holiday")
+	enabled, _ := cmd.Flags().GetBool("enabled")
+
+	if name == "" || sensorID == 0 || property == "" || value == "" || cron == "" {
+		return gen.CommandSchedule{}, fmt.Errorf("--name, --sensor-id, --property, --value and --cron are required")
+	}
+
+	h := gen.CommandScheduleHoliday(holiday)
+	return gen.CommandSchedule{
+		Name:     name,
+		Enabled:  enabled,
+		SensorId: sensorID,
+		Property: property,
+		Value:    value,
+		Cron:     cron,
+		Holiday:  &h,
+	}, nil
+}
+
+func parseScheduleID(s string) (int, error) {
+	id, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("schedule ID must be a number")
+	}
+	return id, nil
+}
+
+var schedulesListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List all command schedules",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, ctx, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+		return consumeJSON(client.GetAllSchedules(ctx))
+	},
+}
+
+var schedulesGetCmd = &cobra.Command{
+	Use:   "get [id]",
+	Short: "Get a command schedule by ID",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := parseScheduleID(args[0])
+		if err != nil {
+			return err
+		}
+		client, ctx, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+		return consumeJSON(client.GetScheduleById(ctx, id))
+	},
+}
+
+var schedulesCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Create a command schedule",
+	Example: `  sensor-hub schedules create --name "Heater on weekdays" --sensor-id 12 \
+    --property state --value ON --cron "30 6 * * mon-fri"
+  sensor-hub schedules create --name "Lamp on while away" --sensor-id 14 \
+    --property state --value ON --cron "0 19 * * *" --holiday only`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		schedule, err := scheduleFromFlags(cmd)
+		if err != nil {
+			return err
+		}
+		client, ctx, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+		return consumeJSON(client.CreateSchedule(ctx, schedule))
+	},
+}
+
+var schedulesUpdateCmd = &cobra.Command{
+	Use:   "update [id]",
+	Short: "Replace a command schedule's settings",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := parseScheduleID(args[0])
+		if err != nil {
+			return err
+		}
+		schedule, err := scheduleFromFlags(cmd)
+		if err != nil {
+			return err
+		}
+		client, ctx, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+		return consumeJSON(client.UpdateSchedule(ctx, id, schedule))
+	},
+}
+
+var schedulesDeleteCmd = &cobra.Command{
+	Use:   "delete [id]",
+	Short: "Delete a command schedule by ID",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := parseScheduleID(args[0])
+		if err != nil {
+			return err
+		}
+		client, ctx, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+		return consumeJSON(client.DeleteSchedule(ctx, id))
+	},
+}
+
+var schedulesHolidayShowCmd = &cobra.Command{
+	Use:   "show",
+	Short: "Show whether holiday mode is on",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, ctx, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+		return consumeJSON(client.GetHolidayMode(ctx))
+	},
+}
+
+var schedulesHolidayOnCmd = &cobra.Command{
+	Use:     "on",
+	Short:   "Turn holiday mode on",
+	Example: `  sensor-hub schedules holiday on --until 2026-08-16T18:00:00+01:00`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		mode := gen.HolidayMode{Enabled: true}
+		if until, _ := cmd.Flags().GetString("until"); until != "" {
+			t, err := time.Parse(time.RFC3339, until)
+			if err != nil {
+				return fmt.Errorf("--until must be an RFC 3339 time, e.g. 2026-08-16T18:00:00Z")
+			}
+			mode.Until = &t
+		}
+		client, ctx, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+		return consumeJSON(client.SetHolidayMode(ctx, mode))
+	},
+}
+
+var schedulesHolidayOffCmd = &cobra.Command{
+	Use:   "off",
+	Short: "Turn holiday mode off",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		client, ctx, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+		return consumeJSON(client.SetHolidayMode(ctx, gen.HolidayMode{Enabled: false}))
+	},
+}
@@ -34,6 +34,7 @@ func init() {
 	sensorsCmd.AddCommand(sensorsCapabilitiesCmd)
 	sensorsCmd.AddCommand(sensorsCommandCmd)
 	sensorsCmd.AddCommand(sensorsCommandsCmd)
+	sensorsCmd.AddCommand(sensorsDesiredStateCmd)
 	sensorsCmd.AddCommand(sensorsPendingCmd)
 	sensorsCmd.AddCommand(sensorsApproveCmd)
 	sensorsCmd.AddCommand(sensorsDismissCmd)
@@ -84,7 +85,7 @@ var sensorsAddCmd = &cobra.Command{
 	RunE: func(cmd *cobra.Command, args []string) error {
 		name, _ := cmd.Flags().GetString("name")
 		driver, _ := cmd.Flags().GetString("driver")
-		configPairs, _ := cmd.Flags().GetStringSlice("config")
+		configPairs, _ := cmd.Flags().GetStringArray("config")
 		if name == "" || driver == "" {
 			return fmt.Errorf("--name and --driver are required")
 		}
@@ -108,7 +109,7 @@ var sensorsAddCmd = &cobra.Command{
 func init() {
 	sensorsAddCmd.Flags().String("name", "", "Sensor name")
 	sensorsAddCmd.Flags().String("driver", "", "Sensor driver (e.g. sensor-hub-http-temperature)")
-	sensorsAddCmd.Flags().StringSlice("config", nil, "Config key=value pairs (repeatable, e.g. --config url=http://...)")
+	sensorsAddCmd.Flags().StringArray("config", nil, "Config key=value pairs (repeatable, e.g. --config url=http://...)")
 }
 
 var sensorsDeleteCmd = &cobra.Command{
@@ -295,6 +296,72 @@ var sensorsCommandsCmd = &cobra.Command{
 	},
 }
 
+var sensorsDesiredStateCmd = &cobra.Command{
+	Use:   "desired-state",
+	Short: "Keep sensor capabilities at a desired value",
+	Long: "Keep capabilities of controllable sensors at a desired value. Commands that time out or fail\n" +
+		"are retried with backoff, and the value is sent again whenever the device reconnects or reports\n" +
+		"a different one.",
+}
+
+func init() {
+	sensorsDesiredStateCmd.AddCommand(sensorsDesiredStateListCmd)
+	sensorsDesiredStateCmd.AddCommand(sensorsDesiredStateSetCmd)
+	sensorsDesiredStateCmd.AddCommand(sensorsDesiredStateClearCmd)
+}
+
+var sensorsDesiredStateListCmd = &cobra.Command{
+	Use:   "list [id]",
+	Short: "List the desired states of a sensor ID and how far each is from its value",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := parseSensorIDArg(args[0])
+		if err != nil {
+			return err
+		}
+		client, ctx, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+		return consumeJSON(client.GetSensorDesiredStates(ctx, id))
+	},
+}
+
+var sensorsDesiredStateSetCmd = &cobra.Command{
+	Use:     "set [id] [property] [value]",
+	Short:   "Keep a capability of a sensor ID at a value",
+	Example: `  sensor-hub sensors desired-state set 14 state ON`,
+	Args:    cobra.ExactArgs(3),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := parseSensorIDArg(args[0])
+		if err != nil {
+			return err
+		}
+		client, ctx, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+		return consumeJSON(client.SetSensorDesiredState(ctx, id, args[1], gen.DesiredStateRequest{Value: args[2]}))
+	},
+}
+
+var sensorsDesiredStateClearCmd = &cobra.Command{
+	Use:   "clear [id] [property]",
+	Short: "Stop keeping a capability of a sensor ID at a value",
+	Args:  cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := parseSensorIDArg(args[0])
+		if err != nil {
+			return err
+		}
+		client, ctx, err := newAPIClient(cmd)
+		if err != nil {
+			return err
+		}
+		return consumeJSON(client.DeleteSensorDesiredState(ctx, id, args[1]))
+	},
+}
+
 var sensorsUpdateCmd = &cobra.Command{
 	Use:   "update [id]",
 	Short: "Update an existing sensor",
@@ -306,17 +373,27 @@ var sensorsUpdateCmd = &cobra.Command{
 		}
 		name, _ := cmd.Flags().GetString("name")
 		driver, _ := cmd.Flags().GetString("driver")
-		configPairs, _ := cmd.Flags().GetStringSlice("config")
+		configPairs, _ := cmd.Flags().GetStringArray("config")
 		config, err := parseKVPairs(configPairs)
 		if err != nil {
 			return err
 		}
 
+		payload := sensorBody(name, driver, config)
+		if cmd.Flags().Changed("expected-interval") {
+			raw, _ := cmd.Flags().GetString("expected-interval")
+			interval, err := parseExpectedInterval(raw)
+			if err != nil {
+				return err
+			}
+			payload["expected_interval_seconds"] = interval
+		}
+
 		client, ctx, err := newAPIClient(cmd)
 		if err != nil {
 			return err
 		}
-		body, err := rawJSONReader(sensorBody(name, driver, config))
+		body, err := rawJSONReader(payload)
 		if err != nil {
 			return err
 		}
@@ -327,7 +404,21 @@ var sensorsUpdateCmd = &cobra.Command{
 func init() {
 	sensorsUpdateCmd.Flags().String("name", "", "Sensor name")
 	sensorsUpdateCmd.Flags().String("driver", "", "Sensor driver")
-	sensorsUpdateCmd.Flags().StringSlice("config", nil, "Config key=value pairs (repeatable)")
+	sensorsUpdateCmd.Flags().StringArray("config", nil, "Config key=value pairs (repeatable)")
+	sensorsUpdateCmd.Flags().String("expected-interval", "", "Seconds between readings before the sensor is flagged stale, or 'null' to use the driver default")
+}
+
+// parseExpectedInterval converts the --expected-interval flag into a JSON value:
+// nil for "null", otherwise a positive number of seconds.
+func parseExpectedInterval(raw string) (any, error) {
+	if raw == "null" {
+		return nil, nil
+	}
+	seconds, err := strconv.Atoi(raw)
+	if err != nil || seconds <= 0 {
+		return nil, fmt.Errorf("--expected-interval must be a positive number of seconds or 'null'")
+	}
+	return seconds, nil
 }
 
 var sensorsExistsCmd = &cobra.Command{
@@ -209,6 +209,33 @@ func TestSensorsControlHelp_DocumentsIDArgumentConvention(t *testing.T) {
 	assert.Contains(t, commandsHelp, "commands [id]")
 }
 
+func TestSensorsAddCommand_KeepsCommasInConfigValues(t *testing.T) {
+	inputs := `{"t": {"sensor": "office", "measurement_type": "temperature"}, "rh": {"sensor": "office", "measurement_type": "humidity"}}`
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		require.Equal(t, "/api/sensors", r.URL.Path)
+
+		var body gen.Sensor
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "computed", body.SensorDriver)
+		assert.Equal(t, map[string]string{
+			"expression":       "dewpoint(t, rh)",
+			"inputs":           inputs,
+			"measurement_type": "dew_point",
+		}, body.Config)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		require.NoError(t, json.NewEncoder(w).Encode(map[string]string{"message": "Sensor added successfully"}))
+	}))
+	defer server.Close()
+
+	_, _, err := executeRootCommand(t, "--server", server.URL, "sensors", "add", "--name", "office-dew-point", "--driver", "computed",
+		"--config", "expression=dewpoint(t, rh)", "--config", "inputs="+inputs, "--config", "measurement_type=dew_point")
+	require.NoError(t, err)
+}
+
 func executeRootCommand(t *testing.T, args ...string) (string, string, error) {
 	t.Helper()
 
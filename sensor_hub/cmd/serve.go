@@ -8,18 +8,23 @@ import (
 	"example/sensorHub/api"
 	"example/sensorHub/api/middleware"
 	appProps "example/sensorHub/application_properties"
+	"example/sensorHub/automation"
+	"example/sensorHub/computed"
 	database "example/sensorHub/db"
 	_ "example/sensorHub/drivers" // register sensor drivers
 	mqttBrokerPkg "example/sensorHub/mqtt"
 	"example/sensorHub/oauth"
+	"example/sensorHub/push"
 	"example/sensorHub/service"
 	"example/sensorHub/smtp"
 	"example/sensorHub/telemetry"
+	"example/sensorHub/webhook"
 	"example/sensorHub/ws"
 	"fmt"
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	"github.com/spf13/cobra"
 )
@@ -108,7 +113,19 @@ func runServe(cmd *cobra.Command, args []string) error {
 	wsBroadcaster := ws.NewNotificationBroadcaster(logger)
 	notificationService := service.NewNotificationService(notificationRepo, wsBroadcaster, logger)
 	notificationService.SetEmailNotifier(smtpNotifier)
-	thresholdProcessor := alerting.NewThresholdAlertProcessor(alertRepo, &notifRepoAdapter{notificationRepo}, wsBroadcaster, smtpNotifier, logger)
+	webhookRepo := database.NewWebhookRepository(db, logger)
+	webhookNotifier := webhook.NewNotifier(webhookRepo, logger)
+	notificationService.SetWebhookNotifier(webhookNotifier)
+	pushRepo := database.NewPushRepository(db, logger)
+	pushNotifier := push.NewNotifier(pushRepo, logger)
+	notificationService.SetPushNotifier(pushNotifier)
+	digestRepo := database.NewDigestRepository(db, logger)
+	digestService := service.NewDigestService(digestRepo, smtpNotifier, logger)
+	notificationService.SetDeferrer(digestService)
+	thresholdProcessor := alerting.NewThresholdAlertProcessor(alertRepo, readingsRepo, &notifRepoAdapter{notificationRepo}, wsBroadcaster, smtpNotifier, logger)
+	thresholdProcessor.SetWebhookNotifier(webhookNotifier)
+	thresholdProcessor.SetPushNotifier(pushNotifier)
+	thresholdProcessor.SetDeferrer(digestService)
 	sensorService := service.NewSensorService(sensorRepo, readingsRepo, mtRepo, thresholdProcessor, notificationService, logger)
 
 	aggregationTiers, err := service.ParseAggregationTiers(appProps.AppConfig.ReadingsAggregationTiers)
@@ -128,6 +145,8 @@ func runServe(cmd *cobra.Command, args []string) error {
 	authService := service.NewAuthService(userRepo, sessionRepo, failedRepo, roleRepo, logger)
 	roleService := service.NewRoleService(roleRepo, logger)
 	alertManagementService := service.NewAlertManagementService(alertRepo, logger)
+	webhookService := service.NewWebhookService(webhookRepo, webhookNotifier, logger)
+	pushService := service.NewPushService(pushRepo, pushNotifier, logger)
 
 	apiKeyRepo := database.NewApiKeyRepository(db, logger)
 	apiKeyService := service.NewApiKeyService(apiKeyRepo, userRepo, roleRepo, logger)
@@ -144,7 +163,27 @@ func runServe(cmd *cobra.Command, args []string) error {
 	mqttService.SetSubscriptionNotifier(connManager)
 	commandTracker := actuation.NewCommandTracker(commandHistoryRepo, ws.NewCommandStatusBroadcaster(logger), logger)
 	commandService := service.NewCommandService(sensorRepo, mqttSubRepo, commandHistoryRepo, connManager, commandTracker, logger)
-	sensorService.SetReadingsObserver(commandTracker)
+	sensorService.AddReadingsObserver(commandTracker)
+	haPublisher := mqttBrokerPkg.NewHomeAssistantPublisher(sensorService, readingsService, connManager, logger)
+	sensorService.AddReadingsObserver(haPublisher)
+	sensorService.AddSensorsObserver(haPublisher)
+	sceneRepo := database.NewSceneRepository(db, logger)
+	sceneService := service.NewSceneService(sceneRepo, sensorRepo, commandService, ws.NewSceneStatusBroadcaster(logger), logger)
+	commandTracker.AddStatusObserver(sceneService)
+	desiredStateService := service.NewDesiredStateService(database.NewDesiredStateRepository(db, logger), sensorRepo, commandService, logger)
+	commandService.AddSentObserver(desiredStateService)
+	commandTracker.AddStatusObserver(desiredStateService)
+	sensorService.AddReadingsObserver(desiredStateService)
+	automationRepo := database.NewAutomationRepository(db, logger)
+	scheduleRepo := database.NewScheduleRepository(db, logger)
+	automationEngine := automation.NewEngine(automationRepo, scheduleRepo, commandService, sceneService, logger)
+	sensorService.AddReadingsObserver(automationEngine)
+	thresholdProcessor.SetAlertListener(automationEngine)
+	computedEngine := computed.NewEngine(sensorRepo, readingsRepo, sensorService, logger)
+	sensorService.AddReadingsObserver(computedEngine)
+	sensorService.AddSensorsObserver(computedEngine)
+	automationService := service.NewAutomationService(automationRepo, sensorRepo, alertRepo, sceneRepo, logger)
+	scheduleService := service.NewScheduleService(scheduleRepo, sensorRepo, commandService, logger)
 	if err := commandTracker.RecoverPending(ctx); err != nil {
 		return fmt.Errorf("failed to recover pending commands: %w", err)
 	}
@@ -182,6 +221,11 @@ func runServe(cmd *cobra.Command, args []string) error {
 		logger.Error("failed to start MQTT connection manager", "error", err)
 	}
 	defer connManager.Stop()
+	haPublisher.Start(ctx)
+	// Runs missed while the hub was down are sent once brokers are connected.
+	if err := scheduleService.RecoverMissedRuns(ctx); err != nil {
+		return err
+	}
 
 	err = oauth.InitialiseOauth()
 	if err != nil {
@@ -193,12 +237,19 @@ func runServe(cmd *cobra.Command, args []string) error {
 	server := api.NewServer(
 		sensorService,
 		commandService,
+		automationService,
+		scheduleService,
+		sceneService,
+		desiredStateService,
 		readingsService,
 		authService,
 		userService,
 		roleService,
 		alertManagementService,
 		notificationService,
+		webhookService,
+		pushService,
+		digestService,
 		apiKeyService,
 		dashboardService,
 		propertiesService,
@@ -208,6 +259,13 @@ func runServe(cmd *cobra.Command, args []string) error {
 	)
 
 	sensorService.ServiceStartPeriodicSensorCollection(ctx)
+	sensorService.ServiceStartStaleSensorWatchdog(ctx)
+	digestService.ServiceStartDigestDelivery(ctx)
+	readingsService.ServiceStartRollupRefresh(ctx)
+	automationEngine.StartSchedules(ctx)
+	scheduleService.StartScheduler(ctx)
+	desiredStateService.StartReconciler(ctx)
+	thresholdProcessor.StartEscalations(ctx, time.Duration(appProps.AppConfig.AlertEscalationCheckIntervalSeconds)*time.Second)
 
 	cleanupService.StartPeriodicCleanup(ctx)
 
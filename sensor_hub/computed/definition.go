@@ -0,0 +1,123 @@
+package computed
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// DriverType is the driver type of computed sensors.
+const DriverType = "computed"
+
+// Input binds an expression input name to the latest reading of one measurement type
+// from one or more sensors. Sensor is shorthand for a single-element Sensors.
+type Input struct {
+	Sensor          string   `json:"sensor,omitempty"`
+	Sensors         []string `json:"sensors,omitempty"`
+	MeasurementType string   `json:"measurement_type"`
+}
+
+// Definition is a computed sensor's parsed configuration: the expression, what each of
+// its inputs reads, and the measurement type and unit of the readings it produces.
+// MaxAge, when set, is how old an input value may be before it no longer counts.
+type Definition struct {
+	Expression      *Expression
+	Inputs          map[string]Input
+	MeasurementType string
+	Unit            string
+	MaxAge          time.Duration
+}
+
+// ParseDefinition reads a computed sensor's definition from its config. Every input the
+// expression uses must be defined, and every defined input must be used.
+func ParseDefinition(config map[string]string) (*Definition, error) {
+	if strings.TrimSpace(config["expression"]) == "" {
+		return nil, fmt.Errorf("config field 'expression' is required")
+	}
+	expression, err := Parse(config["expression"])
+	if err != nil {
+		return nil, err
+	}
+
+	measurementType := strings.TrimSpace(config["measurement_type"])
+	if measurementType == "" {
+		return nil, fmt.Errorf("config field 'measurement_type' is required")
+	}
+
+	var inputs map[string]Input
+	if err := json.Unmarshal([]byte(config["inputs"]), &inputs); err != nil {
+		return nil, fmt.Errorf("config field 'inputs' must be a JSON object of named inputs: %w", err)
+	}
+	if len(inputs) == 0 {
+		return nil, fmt.Errorf("config field 'inputs' must define at least one input")
+	}
+	for name, input := range inputs {
+		if input.Sensor != "" {
+			input.Sensors = append([]string{input.Sensor}, input.Sensors...)
+			input.Sensor = ""
+		}
+		if len(input.Sensors) == 0 {
+			return nil, fmt.Errorf("input %q has no sensor", name)
+		}
+		for _, sensor := range input.Sensors {
+			if strings.TrimSpace(sensor) == "" {
+				return nil, fmt.Errorf("input %q has an empty sensor name", name)
+			}
+		}
+		if input.MeasurementType == "" {
+			return nil, fmt.Errorf("input %q has no measurement_type", name)
+		}
+		inputs[name] = input
+	}
+
+	used := make(map[string]bool, len(expression.Variables()))
+	for _, name := range expression.Variables() {
+		if _, ok := inputs[name]; !ok {
+			return nil, fmt.Errorf("expression uses %q, which is not defined in inputs", name)
+		}
+		used[name] = true
+	}
+	for name := range inputs {
+		if !used[name] {
+			return nil, fmt.Errorf("input %q is not used by the expression", name)
+		}
+	}
+
+	var maxAge time.Duration
+	if raw := strings.TrimSpace(config["max_age"]); raw != "" {
+		maxAge, err = time.ParseDuration(raw)
+		if err != nil || maxAge <= 0 {
+			return nil, fmt.Errorf("config field 'max_age' must be a positive duration such as 15m, got %q", raw)
+		}
+	}
+
+	return &Definition{
+		Expression:      expression,
+		Inputs:          inputs,
+		MeasurementType: measurementType,
+		Unit:            strings.TrimSpace(config["unit"]),
+		MaxAge:          maxAge,
+	}, nil
+}
+
+// Evaluate computes the definition's value from the latest input values. latest returns
+// the latest numeric value of a measurement type from a sensor and when it was recorded,
+// or false if the sensor has none yet; every input sensor must have a value, no older
+// than MaxAge at now when MaxAge is set.
+func (d *Definition) Evaluate(now time.Time, latest func(sensorName, measurementType string) (float64, time.Time, bool)) (float64, error) {
+	values := make(map[string][]float64, len(d.Inputs))
+	for name, input := range d.Inputs {
+		for _, sensor := range input.Sensors {
+			value, at, ok := latest(sensor, input.MeasurementType)
+			if !ok {
+				return 0, fmt.Errorf("no %s reading from sensor %s yet", input.MeasurementType, sensor)
+			}
+			if d.MaxAge > 0 && now.Sub(at) > d.MaxAge {
+				return 0, fmt.Errorf("latest %s reading from sensor %s is older than %s", input.MeasurementType, sensor, d.MaxAge)
+			}
+			values[name] = append(values[name], value)
+		}
+	}
+	return d.Expression.Evaluate(values)
+}
@@ -0,0 +1,116 @@
+package computed
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func dewPointConfig() map[string]string {
+	return map[string]string{
+		"expression":       "dewpoint(t, rh)",
+		"inputs":           `{"t": {"sensor": "office", "measurement_type": "temperature"}, "rh": {"sensor": "office", "measurement_type": "humidity"}}`,
+		"measurement_type": "dew_point",
+		"unit":             "°C",
+	}
+}
+
+func TestParseDefinition(t *testing.T) {
+	config := map[string]string{
+		"expression":       "sum(plugs) / 1000",
+		"inputs":           `{"plugs": {"sensor": "kettle", "sensors": ["oven", "washer"], "measurement_type": "power"}}`,
+		"measurement_type": "power",
+	}
+
+	definition, err := ParseDefinition(config)
+
+	require.NoError(t, err)
+	assert.Equal(t, "sum(plugs) / 1000", definition.Expression.String())
+	assert.Equal(t, []string{"kettle", "oven", "washer"}, definition.Inputs["plugs"].Sensors)
+	assert.Equal(t, "power", definition.MeasurementType)
+	assert.Empty(t, definition.Unit)
+}
+
+func TestParseDefinition_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		modify  func(config map[string]string)
+		wantErr string
+	}{
+		{name: "missing expression", modify: func(c map[string]string) { delete(c, "expression") }, wantErr: "'expression' is required"},
+		{name: "invalid expression", modify: func(c map[string]string) { c["expression"] = "dewpoint(t, rh" }, wantErr: "invalid expression"},
+		{name: "missing measurement type", modify: func(c map[string]string) { c["measurement_type"] = " " }, wantErr: "'measurement_type' is required"},
+		{name: "inputs not JSON", modify: func(c map[string]string) { c["inputs"] = "t=office" }, wantErr: "must be a JSON object"},
+		{name: "no inputs", modify: func(c map[string]string) { c["inputs"] = "{}" }, wantErr: "at least one input"},
+		{
+			name: "input without sensor",
+			modify: func(c map[string]string) {
+				c["inputs"] = `{"t": {"measurement_type": "temperature"}, "rh": {"sensor": "office", "measurement_type": "humidity"}}`
+			},
+			wantErr: `input "t" has no sensor`,
+		},
+		{
+			name: "input without measurement type",
+			modify: func(c map[string]string) {
+				c["inputs"] = `{"t": {"sensor": "office"}, "rh": {"sensor": "office", "measurement_type": "humidity"}}`
+			},
+			wantErr: `input "t" has no measurement_type`,
+		},
+		{name: "undefined input", modify: func(c map[string]string) { c["expression"] = "dewpoint(t, humidity)" }, wantErr: `"humidity", which is not defined`},
+		{name: "unused input", modify: func(c map[string]string) { c["expression"] = "t * 2" }, wantErr: `input "rh" is not used`},
+		{name: "invalid max age", modify: func(c map[string]string) { c["max_age"] = "15" }, wantErr: "'max_age' must be a positive duration"},
+		{name: "negative max age", modify: func(c map[string]string) { c["max_age"] = "-5m" }, wantErr: "'max_age' must be a positive duration"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := dewPointConfig()
+			tt.modify(config)
+
+			_, err := ParseDefinition(config)
+
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestDefinition_Evaluate(t *testing.T) {
+	definition, err := ParseDefinition(dewPointConfig())
+	require.NoError(t, err)
+	now := time.Date(2026, 3, 2, 21, 0, 0, 0, time.UTC)
+	latest := map[string]float64{"temperature": 20}
+	lookup := func(sensorName, measurementType string) (float64, time.Time, bool) {
+		v, ok := latest[measurementType]
+		return v, now.Add(-24 * time.Hour), ok && sensorName == "office"
+	}
+
+	_, err = definition.Evaluate(now, lookup)
+	assert.EqualError(t, err, "no humidity reading from sensor office yet")
+
+	latest["humidity"] = 50
+	value, err := definition.Evaluate(now, lookup)
+	require.NoError(t, err)
+	assert.InDelta(t, 9.26, value, 0.01, "without max_age any input age counts")
+}
+
+func TestDefinition_Evaluate_MaxAge(t *testing.T) {
+	config := dewPointConfig()
+	config["max_age"] = "15m"
+	definition, err := ParseDefinition(config)
+	require.NoError(t, err)
+	require.Equal(t, 15*time.Minute, definition.MaxAge)
+	now := time.Date(2026, 3, 2, 21, 0, 0, 0, time.UTC)
+	recorded := map[string]time.Time{"temperature": now.Add(-time.Minute), "humidity": now.Add(-20 * time.Minute)}
+	lookup := func(_, measurementType string) (float64, time.Time, bool) {
+		return 50, recorded[measurementType], true
+	}
+
+	_, err = definition.Evaluate(now, lookup)
+	assert.EqualError(t, err, "latest humidity reading from sensor office is older than 15m0s")
+
+	recorded["humidity"] = now.Add(-15 * time.Minute)
+	_, err = definition.Evaluate(now, lookup)
+	assert.NoError(t, err)
+}
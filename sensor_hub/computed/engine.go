@@ -0,0 +1,248 @@
+package computed
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	gen "example/sensorHub/gen"
+)
+
+// SensorLister is the subset of db.SensorRepositoryInterface needed by Engine.
+// Defined locally to avoid an import cycle (the drivers package imports computed).
+type SensorLister interface {
+	GetSensorsByDriver(ctx context.Context, sensorDriver string) ([]gen.Sensor, error)
+}
+
+// LatestReadings returns the latest stored reading of every sensor and measurement
+// type. db.ReadingsRepository implements it.
+type LatestReadings interface {
+	GetLatest(ctx context.Context) ([]gen.Reading, error)
+}
+
+// ReadingsSink stores a computed sensor's readings through the normal readings
+// pipeline, so they are persisted, checked against alert rules and broadcast like any
+// other reading. service.SensorService implements it.
+type ReadingsSink interface {
+	ServiceProcessComputedReadings(ctx context.Context, sensor gen.Sensor, readings []gen.Reading) error
+}
+
+type inputKey struct {
+	sensor          string
+	measurementType string
+}
+
+// inputValue is the latest numeric value of one input and when it was recorded.
+type inputValue struct {
+	value float64
+	at    time.Time
+}
+
+// loadedSensor is an enabled, active computed sensor with its parsed definition.
+type loadedSensor struct {
+	sensor     gen.Sensor
+	definition *Definition
+}
+
+// chainKey is the context key under which Engine records the computed sensors whose
+// readings are being stored, so a computed sensor that (indirectly) reads itself is not
+// evaluated again from its own reading.
+type chainKey struct{}
+
+// Engine evaluates computed sensors. It observes stored readings (it is registered with
+// SensorService as a readings observer) and keeps the latest numeric value of every
+// sensor and measurement type in memory. When a batch contains a value that a computed
+// sensor reads, the sensor's expression is evaluated and the result is stored as a
+// reading of the computed sensor through ReadingsSink.
+//
+// Computed sensors are loaded and parsed once, together with an index from each input
+// to the sensors that read it, and reloaded after SensorsChanged. The in-memory values
+// are seeded from the latest stored readings the first time a computed sensor is
+// evaluated, so inputs that report rarely are known after a restart. A computed sensor
+// is skipped until every one of its inputs has reported, and while any input is older
+// than the sensor's max_age.
+type Engine struct {
+	sensors SensorLister
+	latest  LatestReadings
+	sink    ReadingsSink
+	logger  *slog.Logger
+	now     func() time.Time
+
+	mu       sync.Mutex
+	values   map[inputKey]inputValue
+	seeded   bool
+	loaded   bool
+	computed []loadedSensor
+	readers  map[inputKey][]int // input to indexes into computed
+}
+
+func NewEngine(sensors SensorLister, latest LatestReadings, sink ReadingsSink, logger *slog.Logger) *Engine {
+	return &Engine{
+		sensors: sensors,
+		latest:  latest,
+		sink:    sink,
+		logger:  logger.With("component", "computed_sensor_engine"),
+		now:     time.Now,
+		values:  make(map[inputKey]inputValue),
+	}
+}
+
+// SensorsChanged drops the loaded computed sensors, so the next batch reloads them. The
+// engine is registered with SensorService as a sensors observer.
+func (e *Engine) SensorsChanged(_ context.Context) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.loaded = false
+	e.computed = nil
+	e.readers = nil
+}
+
+// ObserveReadings records the batch's numeric values and evaluates every enabled, active
+// computed sensor that reads one of them.
+func (e *Engine) ObserveReadings(ctx context.Context, sensorID int, readings []gen.Reading) {
+	var changed []inputKey
+	now := e.now()
+	e.mu.Lock()
+	for _, reading := range readings {
+		if reading.NumericValue == nil || reading.SensorName == "" {
+			continue
+		}
+		key := inputKey{sensor: strings.ToLower(reading.SensorName), measurementType: reading.MeasurementType}
+		e.values[key] = inputValue{value: *reading.NumericValue, at: now}
+		changed = append(changed, key)
+	}
+	e.mu.Unlock()
+	if len(changed) == 0 {
+		return
+	}
+
+	chain, _ := ctx.Value(chainKey{}).([]int)
+	for _, computed := range e.readersOf(ctx, changed) {
+		if computed.sensor.Id == sensorID || slices.Contains(chain, computed.sensor.Id) {
+			continue
+		}
+		e.evaluate(ctx, computed.sensor, computed.definition, chain)
+	}
+}
+
+// readersOf returns the computed sensors that read any of the keys, in the order they
+// were loaded, loading them first if needed.
+func (e *Engine) readersOf(ctx context.Context, keys []inputKey) []loadedSensor {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if !e.loaded {
+		if err := e.load(ctx); err != nil {
+			e.logger.Error("failed to load computed sensors", "error", err)
+			return nil
+		}
+	}
+
+	var indexes []int
+	for _, key := range keys {
+		for _, i := range e.readers[key] {
+			if !slices.Contains(indexes, i) {
+				indexes = append(indexes, i)
+			}
+		}
+	}
+	slices.Sort(indexes)
+	readers := make([]loadedSensor, len(indexes))
+	for n, i := range indexes {
+		readers[n] = e.computed[i]
+	}
+	return readers
+}
+
+// load parses every enabled, active computed sensor and indexes them by input. It is
+// called with the lock held.
+func (e *Engine) load(ctx context.Context) error {
+	sensors, err := e.sensors.GetSensorsByDriver(ctx, DriverType)
+	if err != nil {
+		return err
+	}
+	e.computed = nil
+	e.readers = make(map[inputKey][]int)
+	for _, sensor := range sensors {
+		if !sensor.Enabled || sensor.Status != gen.SensorStatusActive {
+			continue
+		}
+		definition, err := ParseDefinition(sensor.Config)
+		if err != nil {
+			e.logger.Warn("computed sensor has an invalid definition", "sensor", sensor.Name, "error", err)
+			continue
+		}
+		i := len(e.computed)
+		e.computed = append(e.computed, loadedSensor{sensor: sensor, definition: definition})
+		for _, input := range definition.Inputs {
+			for _, inputSensor := range input.Sensors {
+				key := inputKey{sensor: strings.ToLower(inputSensor), measurementType: input.MeasurementType}
+				if !slices.Contains(e.readers[key], i) {
+					e.readers[key] = append(e.readers[key], i)
+				}
+			}
+		}
+	}
+	e.loaded = true
+	return nil
+}
+
+// evaluate computes a sensor's value and stores it. The sink is called without holding
+// the lock, because storing the reading notifies this engine again.
+func (e *Engine) evaluate(ctx context.Context, sensor gen.Sensor, definition *Definition, chain []int) {
+	e.seed(ctx)
+
+	now := e.now()
+	e.mu.Lock()
+	value, err := definition.Evaluate(now, func(sensorName, measurementType string) (float64, time.Time, bool) {
+		v, ok := e.values[inputKey{sensor: strings.ToLower(sensorName), measurementType: measurementType}]
+		return v.value, v.at, ok
+	})
+	e.mu.Unlock()
+	if err != nil {
+		e.logger.Debug("computed sensor not evaluated", "sensor", sensor.Name, "reason", err)
+		return
+	}
+
+	reading := gen.Reading{
+		SensorName:      sensor.Name,
+		MeasurementType: definition.MeasurementType,
+		NumericValue:    &value,
+		Unit:            definition.Unit,
+		Time:            now.UTC().Format("2006-01-02 15:04:05"),
+	}
+
+	ctx = context.WithValue(ctx, chainKey{}, append(slices.Clone(chain), sensor.Id))
+	if err := e.sink.ServiceProcessComputedReadings(ctx, sensor, []gen.Reading{reading}); err != nil {
+		e.logger.Error("failed to store computed reading", "sensor", sensor.Name, "error", err)
+	}
+}
+
+// seed loads the latest stored readings once. Values already observed are newer and
+// are kept.
+func (e *Engine) seed(ctx context.Context) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.seeded {
+		return
+	}
+	latest, err := e.latest.GetLatest(ctx)
+	if err != nil {
+		e.logger.Error("failed to load latest readings for computed sensors", "error", err)
+		return
+	}
+	for _, reading := range latest {
+		if reading.NumericValue == nil {
+			continue
+		}
+		key := inputKey{sensor: strings.ToLower(reading.SensorName), measurementType: reading.MeasurementType}
+		if _, ok := e.values[key]; !ok {
+			// an unreadable time counts as very old, so a max_age rejects it
+			at, _ := time.Parse("2006-01-02 15:04:05", reading.Time)
+			e.values[key] = inputValue{value: *reading.NumericValue, at: at}
+		}
+	}
+	e.seeded = true
+}
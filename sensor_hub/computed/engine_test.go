@@ -0,0 +1,184 @@
+package computed
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	gen "example/sensorHub/gen"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeSensorLister struct {
+	sensors []gen.Sensor
+	calls   int
+}
+
+func (f *fakeSensorLister) GetSensorsByDriver(_ context.Context, sensorDriver string) ([]gen.Sensor, error) {
+	if sensorDriver != DriverType {
+		return nil, nil
+	}
+	f.calls++
+	return f.sensors, nil
+}
+
+type fakeLatestReadings struct {
+	readings []gen.Reading
+	calls    int
+}
+
+func (f *fakeLatestReadings) GetLatest(_ context.Context) ([]gen.Reading, error) {
+	f.calls++
+	return f.readings, nil
+}
+
+// fakeReadingsSink records stored readings and, like SensorService, passes them back to
+// the engine as a newly stored batch.
+type fakeReadingsSink struct {
+	engine *Engine
+	stored []gen.Reading
+}
+
+func (f *fakeReadingsSink) ServiceProcessComputedReadings(ctx context.Context, sensor gen.Sensor, readings []gen.Reading) error {
+	f.stored = append(f.stored, readings...)
+	f.engine.ObserveReadings(ctx, sensor.Id, readings)
+	return nil
+}
+
+var engineTestNow = time.Date(2026, 3, 2, 21, 0, 0, 0, time.UTC)
+
+func newEngineForTest(sensors []gen.Sensor, latest []gen.Reading) (*Engine, *fakeReadingsSink, *fakeLatestReadings) {
+	latestReadings := &fakeLatestReadings{readings: latest}
+	sink := &fakeReadingsSink{}
+	engine := NewEngine(&fakeSensorLister{sensors: sensors}, latestReadings, sink, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	engine.now = func() time.Time { return engineTestNow }
+	sink.engine = engine
+	return engine, sink, latestReadings
+}
+
+func computedSensor(id int, name string, config map[string]string) gen.Sensor {
+	return gen.Sensor{Id: id, Name: name, SensorDriver: DriverType, Config: config, Enabled: true, Status: gen.SensorStatusActive}
+}
+
+func numericReading(sensorName, measurementType string, value float64) gen.Reading {
+	return gen.Reading{SensorName: sensorName, MeasurementType: measurementType, NumericValue: &value}
+}
+
+func TestEngine_ObserveReadings_EvaluatesWithSeededInputs(t *testing.T) {
+	sensors := []gen.Sensor{computedSensor(10, "office-dew-point", dewPointConfig())}
+	engine, sink, latest := newEngineForTest(sensors, []gen.Reading{numericReading("Office", "temperature", 20)})
+
+	engine.ObserveReadings(context.Background(), 1, []gen.Reading{numericReading("office", "humidity", 50)})
+	engine.ObserveReadings(context.Background(), 1, []gen.Reading{numericReading("office", "humidity", 60)})
+
+	require.Len(t, sink.stored, 2)
+	assert.Equal(t, "office-dew-point", sink.stored[0].SensorName)
+	assert.Equal(t, "dew_point", sink.stored[0].MeasurementType)
+	assert.Equal(t, "°C", sink.stored[0].Unit)
+	assert.Equal(t, "2026-03-02 21:00:00", sink.stored[0].Time)
+	assert.InDelta(t, 9.26, *sink.stored[0].NumericValue, 0.01)
+	assert.InDelta(t, 12.0, *sink.stored[1].NumericValue, 0.01)
+	assert.Equal(t, 1, latest.calls, "the latest readings are only loaded once")
+}
+
+func TestEngine_ObserveReadings_WaitsForEveryInput(t *testing.T) {
+	config := map[string]string{
+		"expression":       "avg(bedrooms)",
+		"inputs":           `{"bedrooms": {"sensors": ["bedroom-1", "bedroom-2"], "measurement_type": "temperature"}}`,
+		"measurement_type": "temperature",
+	}
+	engine, sink, _ := newEngineForTest([]gen.Sensor{computedSensor(10, "bedrooms", config)}, nil)
+
+	engine.ObserveReadings(context.Background(), 1, []gen.Reading{numericReading("bedroom-1", "temperature", 19)})
+	assert.Empty(t, sink.stored)
+
+	engine.ObserveReadings(context.Background(), 2, []gen.Reading{numericReading("bedroom-2", "temperature", 21)})
+	require.Len(t, sink.stored, 1)
+	assert.Equal(t, 20.0, *sink.stored[0].NumericValue)
+}
+
+func TestEngine_ObserveReadings_SkipsUnrelatedAndInactiveSensors(t *testing.T) {
+	disabled := computedSensor(10, "disabled", dewPointConfig())
+	disabled.Enabled = false
+	pending := computedSensor(11, "pending", dewPointConfig())
+	pending.Status = gen.SensorStatusPending
+	invalid := computedSensor(12, "invalid", map[string]string{"expression": "t +"})
+	engine, sink, latest := newEngineForTest([]gen.Sensor{disabled, pending, invalid}, []gen.Reading{numericReading("office", "temperature", 20)})
+
+	engine.ObserveReadings(context.Background(), 1, []gen.Reading{numericReading("office", "humidity", 50)})
+	engine.ObserveReadings(context.Background(), 1, []gen.Reading{{SensorName: "office", MeasurementType: "state"}})
+
+	assert.Empty(t, sink.stored)
+	assert.Zero(t, latest.calls, "nothing was evaluated")
+}
+
+func TestEngine_ObserveReadings_ChainsComputedSensorsWithoutLooping(t *testing.T) {
+	// delta reads the dew point, and echo reads delta back into its own input
+	delta := computedSensor(11, "delta", map[string]string{
+		"expression":       "t - dp",
+		"inputs":           `{"t": {"sensor": "office", "measurement_type": "temperature"}, "dp": {"sensor": "office-dew-point", "measurement_type": "dew_point"}}`,
+		"measurement_type": "temperature_difference",
+	})
+	echo := computedSensor(12, "office-dew-point-echo", map[string]string{
+		"expression":       "d",
+		"inputs":           `{"d": {"sensor": "delta", "measurement_type": "temperature_difference"}}`,
+		"measurement_type": "dew_point",
+	})
+	dewPoint := computedSensor(10, "office-dew-point", map[string]string{
+		"expression":       "dewpoint(t, rh) + 0 * e",
+		"inputs":           `{"t": {"sensor": "office", "measurement_type": "temperature"}, "rh": {"sensor": "office", "measurement_type": "humidity"}, "e": {"sensor": "office-dew-point-echo", "measurement_type": "dew_point"}}`,
+		"measurement_type": "dew_point",
+	})
+	engine, sink, _ := newEngineForTest([]gen.Sensor{dewPoint, delta, echo}, []gen.Reading{
+		numericReading("office", "temperature", 20),
+		numericReading("office-dew-point-echo", "dew_point", 0),
+	})
+
+	engine.ObserveReadings(context.Background(), 1, []gen.Reading{numericReading("office", "humidity", 50)})
+
+	require.Len(t, sink.stored, 3, "dew point, then delta, then echo; echo does not trigger the dew point again")
+	assert.Equal(t, "office-dew-point", sink.stored[0].SensorName)
+	assert.Equal(t, "delta", sink.stored[1].SensorName)
+	assert.InDelta(t, 10.74, *sink.stored[1].NumericValue, 0.01)
+	assert.Equal(t, "office-dew-point-echo", sink.stored[2].SensorName)
+}
+
+func TestEngine_ObserveReadings_CachesDefinitionsUntilSensorsChange(t *testing.T) {
+	sensors := &fakeSensorLister{sensors: []gen.Sensor{computedSensor(10, "office-dew-point", dewPointConfig())}}
+	sink := &fakeReadingsSink{}
+	engine := NewEngine(sensors, &fakeLatestReadings{readings: []gen.Reading{numericReading("office", "temperature", 20)}}, sink, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	engine.now = func() time.Time { return engineTestNow }
+	sink.engine = engine
+
+	engine.ObserveReadings(context.Background(), 1, []gen.Reading{numericReading("office", "humidity", 50)})
+	engine.ObserveReadings(context.Background(), 2, []gen.Reading{numericReading("garden", "humidity", 80)})
+	engine.ObserveReadings(context.Background(), 1, []gen.Reading{numericReading("office", "humidity", 60)})
+	assert.Equal(t, 1, sensors.calls, "computed sensors are loaded once")
+	require.Len(t, sink.stored, 2)
+
+	sensors.sensors[0].Enabled = false
+	engine.SensorsChanged(context.Background())
+	engine.ObserveReadings(context.Background(), 1, []gen.Reading{numericReading("office", "humidity", 70)})
+
+	assert.Equal(t, 2, sensors.calls, "a sensor change reloads them")
+	assert.Len(t, sink.stored, 2, "the disabled sensor is no longer evaluated")
+}
+
+func TestEngine_ObserveReadings_SkipsInputsOlderThanMaxAge(t *testing.T) {
+	config := dewPointConfig()
+	config["max_age"] = "30m"
+	engine, sink, _ := newEngineForTest([]gen.Sensor{computedSensor(10, "office-dew-point", config)}, []gen.Reading{
+		{SensorName: "office", MeasurementType: "temperature", NumericValue: func() *float64 { v := 20.0; return &v }(), Time: "2026-03-02 19:00:00"},
+	})
+
+	engine.ObserveReadings(context.Background(), 1, []gen.Reading{numericReading("office", "humidity", 50)})
+	assert.Empty(t, sink.stored, "the stored temperature is two hours old")
+
+	engine.ObserveReadings(context.Background(), 1, []gen.Reading{numericReading("office", "temperature", 20)})
+	require.Len(t, sink.stored, 1)
+	assert.InDelta(t, 9.26, *sink.stored[0].NumericValue, 0.01)
+}
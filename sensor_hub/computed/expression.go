@@ -0,0 +1,467 @@
+package computed
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Expression is a parsed arithmetic expression over named inputs, such as
+// "indoor - outdoor" or "dewpoint(t, rh)". Numbers, input names, the operators
+// + - * / ^, parentheses and the functions listed in functions are supported.
+//
+// An input may stand for several sensors (for example every bedroom thermometer).
+// Such an input can only be used as an argument of an aggregate function (sum, avg,
+// min or max), which combine the values of all of its sensors.
+type Expression struct {
+	source    string
+	root      node
+	variables []string
+}
+
+// function describes a function callable from an expression. Aggregate functions take
+// any number of arguments and accept multi-sensor inputs; the others take a fixed
+// number of single values.
+type function struct {
+	aggregate bool
+	minArgs   int
+	maxArgs   int
+	apply     func(args []float64) float64
+}
+
+var functions = map[string]function{
+	"sum": {aggregate: true, minArgs: 1, maxArgs: -1, apply: func(args []float64) float64 {
+		total := 0.0
+		for _, v := range args {
+			total += v
+		}
+		return total
+	}},
+	"avg": {aggregate: true, minArgs: 1, maxArgs: -1, apply: func(args []float64) float64 {
+		total := 0.0
+		for _, v := range args {
+			total += v
+		}
+		return total / float64(len(args))
+	}},
+	"min": {aggregate: true, minArgs: 1, maxArgs: -1, apply: func(args []float64) float64 {
+		result := args[0]
+		for _, v := range args[1:] {
+			result = math.Min(result, v)
+		}
+		return result
+	}},
+	"max": {aggregate: true, minArgs: 1, maxArgs: -1, apply: func(args []float64) float64 {
+		result := args[0]
+		for _, v := range args[1:] {
+			result = math.Max(result, v)
+		}
+		return result
+	}},
+	"abs":  {minArgs: 1, maxArgs: 1, apply: func(args []float64) float64 { return math.Abs(args[0]) }},
+	"sqrt": {minArgs: 1, maxArgs: 1, apply: func(args []float64) float64 { return math.Sqrt(args[0]) }},
+	"exp":  {minArgs: 1, maxArgs: 1, apply: func(args []float64) float64 { return math.Exp(args[0]) }},
+	"ln":   {minArgs: 1, maxArgs: 1, apply: func(args []float64) float64 { return math.Log(args[0]) }},
+	"pow":  {minArgs: 2, maxArgs: 2, apply: func(args []float64) float64 { return math.Pow(args[0], args[1]) }},
+	"round": {minArgs: 1, maxArgs: 2, apply: func(args []float64) float64 {
+		if len(args) == 1 {
+			return math.Round(args[0])
+		}
+		scale := math.Pow(10, math.Round(args[1]))
+		return math.Round(args[0]*scale) / scale
+	}},
+	"dewpoint": {minArgs: 2, maxArgs: 2, apply: func(args []float64) float64 { return DewPoint(args[0], args[1]) }},
+}
+
+// DewPoint returns the dew point in °C for a temperature in °C and a relative
+// humidity in percent, using the Magnus formula.
+func DewPoint(temperature, relativeHumidity float64) float64 {
+	const b, c = 17.62, 243.12
+	gamma := math.Log(relativeHumidity/100) + b*temperature/(c+temperature)
+	return c * gamma / (b - gamma)
+}
+
+// Parse parses an expression. It fails on syntax errors, unknown functions and calls
+// with the wrong number of arguments.
+func Parse(source string) (*Expression, error) {
+	p := &parser{source: source, variables: map[string]bool{}}
+	if err := p.next(); err != nil {
+		return nil, err
+	}
+	root, err := p.parseSum()
+	if err != nil {
+		return nil, err
+	}
+	if p.token.kind != tokenEOF {
+		return nil, p.errorf("unexpected %s", p.token)
+	}
+
+	variables := make([]string, 0, len(p.variables))
+	for name := range p.variables {
+		variables = append(variables, name)
+	}
+	sort.Strings(variables)
+	return &Expression{source: source, root: root, variables: variables}, nil
+}
+
+// String returns the expression as it was written.
+func (e *Expression) String() string {
+	return e.source
+}
+
+// Variables returns the input names the expression uses, sorted.
+func (e *Expression) Variables() []string {
+	return e.variables
+}
+
+// Evaluate computes the expression. values holds the current values of every input it
+// uses; an input with more than one value may only appear inside an aggregate function.
+// A result that is not a finite number, such as after a division by zero, is an error.
+func (e *Expression) Evaluate(values map[string][]float64) (float64, error) {
+	result, err := e.root.eval(values)
+	if err != nil {
+		return 0, err
+	}
+	if math.IsNaN(result) || math.IsInf(result, 0) {
+		return 0, fmt.Errorf("expression %q does not evaluate to a finite number", e.source)
+	}
+	return result, nil
+}
+
+type node interface {
+	eval(values map[string][]float64) (float64, error)
+}
+
+type numberNode float64
+
+func (n numberNode) eval(map[string][]float64) (float64, error) {
+	return float64(n), nil
+}
+
+type variableNode string
+
+func (n variableNode) eval(values map[string][]float64) (float64, error) {
+	inputValues, ok := values[string(n)]
+	switch {
+	case !ok || len(inputValues) == 0:
+		return 0, fmt.Errorf("no value for input %q", string(n))
+	case len(inputValues) > 1:
+		return 0, fmt.Errorf("input %q has %d values; combine them with sum, avg, min or max", string(n), len(inputValues))
+	}
+	return inputValues[0], nil
+}
+
+type negateNode struct {
+	operand node
+}
+
+func (n negateNode) eval(values map[string][]float64) (float64, error) {
+	v, err := n.operand.eval(values)
+	return -v, err
+}
+
+type binaryNode struct {
+	op          byte
+	left, right node
+}
+
+func (n binaryNode) eval(values map[string][]float64) (float64, error) {
+	left, err := n.left.eval(values)
+	if err != nil {
+		return 0, err
+	}
+	right, err := n.right.eval(values)
+	if err != nil {
+		return 0, err
+	}
+	switch n.op {
+	case '+':
+		return left + right, nil
+	case '-':
+		return left - right, nil
+	case '*':
+		return left * right, nil
+	case '/':
+		if right == 0 {
+			return 0, fmt.Errorf("division by zero")
+		}
+		return left / right, nil
+	default:
+		return math.Pow(left, right), nil
+	}
+}
+
+type callNode struct {
+	fn   function
+	args []node
+}
+
+func (n callNode) eval(values map[string][]float64) (float64, error) {
+	var args []float64
+	for _, arg := range n.args {
+		// Aggregates spread a multi-sensor input into one argument per sensor
+		if variable, ok := arg.(variableNode); ok && n.fn.aggregate {
+			inputValues := values[string(variable)]
+			if len(inputValues) == 0 {
+				return 0, fmt.Errorf("no value for input %q", string(variable))
+			}
+			args = append(args, inputValues...)
+			continue
+		}
+		v, err := arg.eval(values)
+		if err != nil {
+			return 0, err
+		}
+		args = append(args, v)
+	}
+	return n.fn.apply(args), nil
+}
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenNumber
+	tokenIdent
+	tokenOperator
+	tokenLeftParen
+	tokenRightParen
+	tokenComma
+)
+
+type token struct {
+	kind  tokenKind
+	text  string
+	value float64
+	pos   int
+}
+
+func (t token) String() string {
+	if t.kind == tokenEOF {
+		return "end of expression"
+	}
+	return fmt.Sprintf("%q", t.text)
+}
+
+// parser is a recursive-descent parser. From lowest to highest precedence:
+//
+//	sum     = product { ("+" | "-") product }
+//	product = unary { ("*" | "/") unary }
+//	unary   = "-" unary | power
+//	power   = primary [ "^" unary ]
+//	primary = number | name | name "(" sum { "," sum } ")" | "(" sum ")"
+type parser struct {
+	source    string
+	pos       int
+	token     token
+	variables map[string]bool
+}
+
+func (p *parser) errorf(format string, args ...any) error {
+	return fmt.Errorf("invalid expression at position %d: %s", p.token.pos+1, fmt.Sprintf(format, args...))
+}
+
+// next reads the token that starts at the current position into p.token.
+func (p *parser) next() error {
+	for p.pos < len(p.source) && unicode.IsSpace(rune(p.source[p.pos])) {
+		p.pos++
+	}
+	start := p.pos
+	if p.pos >= len(p.source) {
+		p.token = token{kind: tokenEOF, pos: start}
+		return nil
+	}
+
+	c := p.source[p.pos]
+	switch {
+	case c >= '0' && c <= '9' || c == '.':
+		for p.pos < len(p.source) && (isDigit(p.source[p.pos]) || p.source[p.pos] == '.') {
+			p.pos++
+		}
+		text := p.source[start:p.pos]
+		value, err := strconv.ParseFloat(text, 64)
+		if err != nil {
+			p.token = token{pos: start}
+			return p.errorf("invalid number %q", text)
+		}
+		p.token = token{kind: tokenNumber, text: text, value: value, pos: start}
+	case isIdentStart(c):
+		for p.pos < len(p.source) && (isIdentStart(p.source[p.pos]) || isDigit(p.source[p.pos])) {
+			p.pos++
+		}
+		p.token = token{kind: tokenIdent, text: p.source[start:p.pos], pos: start}
+	case strings.IndexByte("+-*/^", c) >= 0:
+		p.pos++
+		p.token = token{kind: tokenOperator, text: string(c), pos: start}
+	case c == '(':
+		p.pos++
+		p.token = token{kind: tokenLeftParen, text: "(", pos: start}
+	case c == ')':
+		p.pos++
+		p.token = token{kind: tokenRightParen, text: ")", pos: start}
+	case c == ',':
+		p.pos++
+		p.token = token{kind: tokenComma, text: ",", pos: start}
+	default:
+		p.token = token{pos: start}
+		return p.errorf("unexpected character %q", c)
+	}
+	return nil
+}
+
+func (p *parser) isOperator(ops string) bool {
+	return p.token.kind == tokenOperator && strings.Contains(ops, p.token.text)
+}
+
+func (p *parser) parseSum() (node, error) {
+	left, err := p.parseProduct()
+	if err != nil {
+		return nil, err
+	}
+	for p.isOperator("+-") {
+		op := p.token.text[0]
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+		right, err := p.parseProduct()
+		if err != nil {
+			return nil, err
+		}
+		left = binaryNode{op: op, left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseProduct() (node, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for p.isOperator("*/") {
+		op := p.token.text[0]
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = binaryNode{op: op, left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseUnary() (node, error) {
+	if p.isOperator("-") {
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+		operand, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return negateNode{operand: operand}, nil
+	}
+	return p.parsePower()
+}
+
+func (p *parser) parsePower() (node, error) {
+	base, err := p.parsePrimary()
+	if err != nil {
+		return nil, err
+	}
+	if !p.isOperator("^") {
+		return base, nil
+	}
+	if err := p.next(); err != nil {
+		return nil, err
+	}
+	// Right-associative, and binds tighter than a leading minus: -2^2 is -4
+	exponent, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	return binaryNode{op: '^', left: base, right: exponent}, nil
+}
+
+func (p *parser) parsePrimary() (node, error) {
+	switch p.token.kind {
+	case tokenNumber:
+		value := p.token.value
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+		return numberNode(value), nil
+	case tokenLeftParen:
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+		inner, err := p.parseSum()
+		if err != nil {
+			return nil, err
+		}
+		if p.token.kind != tokenRightParen {
+			return nil, p.errorf("expected \")\" but found %s", p.token)
+		}
+		return inner, p.next()
+	case tokenIdent:
+		name := p.token
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+		if p.token.kind == tokenLeftParen {
+			return p.parseCall(name)
+		}
+		p.variables[name.text] = true
+		return variableNode(name.text), nil
+	default:
+		return nil, p.errorf("unexpected %s", p.token)
+	}
+}
+
+func (p *parser) parseCall(name token) (node, error) {
+	fn, ok := functions[strings.ToLower(name.text)]
+	if !ok {
+		p.token = name
+		return nil, p.errorf("unknown function %q", name.text)
+	}
+	if err := p.next(); err != nil {
+		return nil, err
+	}
+
+	var args []node
+	if p.token.kind != tokenRightParen {
+		for {
+			arg, err := p.parseSum()
+			if err != nil {
+				return nil, err
+			}
+			args = append(args, arg)
+			if p.token.kind != tokenComma {
+				break
+			}
+			if err := p.next(); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if p.token.kind != tokenRightParen {
+		return nil, p.errorf("expected \")\" but found %s", p.token)
+	}
+	if len(args) < fn.minArgs || (fn.maxArgs >= 0 && len(args) > fn.maxArgs) {
+		p.token = name
+		return nil, p.errorf("wrong number of arguments to %s", name.text)
+	}
+	return callNode{fn: fn, args: args}, p.next()
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isIdentStart(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
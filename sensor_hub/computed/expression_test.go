@@ -0,0 +1,113 @@
+package computed
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpression_Evaluate(t *testing.T) {
+	values := map[string][]float64{
+		"indoor":   {21.5},
+		"outdoor":  {-3},
+		"t":        {20},
+		"rh":       {50},
+		"plugs":    {120, 35.5, 4.5},
+		"bedrooms": {19, 21, 20},
+	}
+	tests := []struct {
+		expression string
+		want       float64
+	}{
+		{expression: "indoor - outdoor", want: 24.5},
+		{expression: "2 + 3 * 4", want: 14},
+		{expression: "(2 + 3) * 4", want: 20},
+		{expression: "-2^2", want: -4},
+		{expression: "2^3^2", want: 512},
+		{expression: "10 / 4 - -1", want: 3.5},
+		{expression: "sum(plugs)", want: 160},
+		{expression: "sum(plugs, 40) / 1000", want: 0.2},
+		{expression: "avg(bedrooms)", want: 20},
+		{expression: "max(bedrooms) - min(bedrooms)", want: 2},
+		{expression: "round(indoor * 1.8 + 32)", want: 71},
+		{expression: "round(dewpoint(t, rh), 1)", want: 9.3},
+		{expression: "abs(outdoor) + sqrt(16) + pow(2, 3)", want: 15},
+		{expression: "ln(exp(2))", want: 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expression, func(t *testing.T) {
+			expression, err := Parse(tt.expression)
+			require.NoError(t, err)
+
+			got, err := expression.Evaluate(values)
+
+			require.NoError(t, err)
+			assert.InDelta(t, tt.want, got, 1e-9)
+		})
+	}
+}
+
+func TestExpression_Variables(t *testing.T) {
+	expression, err := Parse("dewpoint(t, rh) - (t - 2 * rh) + t")
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"rh", "t"}, expression.Variables())
+	assert.Equal(t, "dewpoint(t, rh) - (t - 2 * rh) + t", expression.String())
+}
+
+func TestParse_Errors(t *testing.T) {
+	tests := []struct {
+		expression string
+		wantErr    string
+	}{
+		{expression: "", wantErr: "unexpected end of expression"},
+		{expression: "indoor -", wantErr: "unexpected end of expression"},
+		{expression: "(indoor - outdoor", wantErr: `expected ")"`},
+		{expression: "indoor outdoor", wantErr: `position 8: unexpected "outdoor"`},
+		{expression: "indoor % 2", wantErr: "unexpected character '%'"},
+		{expression: "1.2.3", wantErr: `invalid number "1.2.3"`},
+		{expression: "median(plugs)", wantErr: `unknown function "median"`},
+		{expression: "dewpoint(t)", wantErr: "wrong number of arguments to dewpoint"},
+		{expression: "sum()", wantErr: "wrong number of arguments to sum"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expression, func(t *testing.T) {
+			_, err := Parse(tt.expression)
+
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestExpression_Evaluate_Errors(t *testing.T) {
+	values := map[string][]float64{"plugs": {120, 35.5}, "zero": {0}, "rh": {-5}}
+	tests := []struct {
+		expression string
+		wantErr    string
+	}{
+		{expression: "plugs * 2", wantErr: `input "plugs" has 2 values`},
+		{expression: "abs(plugs)", wantErr: `input "plugs" has 2 values`},
+		{expression: "missing + 1", wantErr: `no value for input "missing"`},
+		{expression: "sum(missing)", wantErr: `no value for input "missing"`},
+		{expression: "1 / zero", wantErr: "division by zero"},
+		{expression: "dewpoint(20, rh)", wantErr: "does not evaluate to a finite number"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expression, func(t *testing.T) {
+			expression, err := Parse(tt.expression)
+			require.NoError(t, err)
+
+			_, err = expression.Evaluate(values)
+
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestDewPoint(t *testing.T) {
+	assert.InDelta(t, 9.26, DewPoint(20, 50), 0.01)
+	assert.InDelta(t, 25, DewPoint(25, 100), 1e-9)
+}
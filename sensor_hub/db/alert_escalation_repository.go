@@ -0,0 +1,233 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"example/sensorHub/alerting"
+)
+
+// GetEscalationSteps returns the rule's escalation policy in order; it is empty when the
+// rule does not escalate.
+func (r *AlertRepositoryImpl) GetEscalationSteps(ctx context.Context, ruleID int) ([]alerting.EscalationStep, error) {
+	return r.getEscalationSteps(ctx, alertRuleColumn, ruleID)
+}
+
+// GetCompoundEscalationSteps is GetEscalationSteps for a compound rule.
+func (r *AlertRepositoryImpl) GetCompoundEscalationSteps(ctx context.Context, ruleID int) ([]alerting.EscalationStep, error) {
+	return r.getEscalationSteps(ctx, compoundRuleColumn, ruleID)
+}
+
+func (r *AlertRepositoryImpl) getEscalationSteps(ctx context.Context, ruleColumn string, ruleID int) ([]alerting.EscalationStep, error) {
+	rows, err := r.db.QueryContext(ctx, fmt.Sprintf(`
+		SELECT delay_minutes, role, channels
+		FROM alert_escalation_steps
+		WHERE %s = ?
+		ORDER BY position
+	`, ruleColumn), ruleID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get escalation steps for rule %d: %w", ruleID, err)
+	}
+	defer rows.Close()
+
+	steps := []alerting.EscalationStep{}
+	for rows.Next() {
+		var step alerting.EscalationStep
+		var channels string
+		if err := rows.Scan(&step.DelayMinutes, &step.Role, &channels); err != nil {
+			return nil, fmt.Errorf("failed to scan escalation step: %w", err)
+		}
+		step.Channels = []alerting.EscalationChannel{}
+		if channels != "" {
+			for _, channel := range strings.Split(channels, ",") {
+				step.Channels = append(step.Channels, alerting.EscalationChannel(channel))
+			}
+		}
+		steps = append(steps, step)
+	}
+	return steps, rows.Err()
+}
+
+// SetEscalationSteps replaces the rule's escalation policy. An empty policy removes it;
+// an escalation already in progress stops at its next step.
+func (r *AlertRepositoryImpl) SetEscalationSteps(ctx context.Context, ruleID int, steps []alerting.EscalationStep) error {
+	return r.setEscalationSteps(ctx, alertRuleColumn, ruleID, steps)
+}
+
+// SetCompoundEscalationSteps is SetEscalationSteps for a compound rule.
+func (r *AlertRepositoryImpl) SetCompoundEscalationSteps(ctx context.Context, ruleID int, steps []alerting.EscalationStep) error {
+	return r.setEscalationSteps(ctx, compoundRuleColumn, ruleID, steps)
+}
+
+func (r *AlertRepositoryImpl) setEscalationSteps(ctx context.Context, ruleColumn string, ruleID int, steps []alerting.EscalationStep) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, fmt.Sprintf(`DELETE FROM alert_escalation_steps WHERE %s = ?`, ruleColumn), ruleID); err != nil {
+		return fmt.Errorf("failed to clear escalation steps: %w", err)
+	}
+	for i, step := range steps {
+		channels := make([]string, len(step.Channels))
+		for j, channel := range step.Channels {
+			channels[j] = string(channel)
+		}
+		_, err := tx.ExecContext(ctx, fmt.Sprintf(`
+			INSERT INTO alert_escalation_steps (%s, position, delay_minutes, role, channels)
+			VALUES (?, ?, ?, ?, ?)
+		`, ruleColumn), ruleID, i, step.DelayMinutes, step.Role, strings.Join(channels, ","))
+		if err != nil {
+			return fmt.Errorf("failed to insert escalation step: %w", err)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit escalation steps: %w", err)
+	}
+	return nil
+}
+
+// StartEscalation begins escalating notificationID, the notification sent when the rule
+// started firing, with the first step due at dueAt. It replaces any escalation left over
+// from the rule's previous episode.
+func (r *AlertRepositoryImpl) StartEscalation(ctx context.Context, ruleID, notificationID int, dueAt time.Time) error {
+	return r.startEscalation(ctx, alertRuleColumn, ruleID, notificationID, dueAt)
+}
+
+// StartCompoundEscalation is StartEscalation for a compound rule.
+func (r *AlertRepositoryImpl) StartCompoundEscalation(ctx context.Context, ruleID, notificationID int, dueAt time.Time) error {
+	return r.startEscalation(ctx, compoundRuleColumn, ruleID, notificationID, dueAt)
+}
+
+func (r *AlertRepositoryImpl) startEscalation(ctx context.Context, ruleColumn string, ruleID, notificationID int, dueAt time.Time) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, fmt.Sprintf(`DELETE FROM alert_escalations WHERE %s = ?`, ruleColumn), ruleID); err != nil {
+		return fmt.Errorf("failed to clear previous escalation: %w", err)
+	}
+	result, err := tx.ExecContext(ctx,
+		fmt.Sprintf(`INSERT INTO alert_escalations (%s, next_step, due_at) VALUES (?, 0, ?)`, ruleColumn),
+		ruleID, dueAt.UTC().Format("2006-01-02 15:04:05"))
+	if err != nil {
+		return fmt.Errorf("failed to start escalation: %w", err)
+	}
+	escalationID, err := result.LastInsertId()
+	if err != nil {
+		return fmt.Errorf("failed to get escalation ID: %w", err)
+	}
+	if _, err := tx.ExecContext(ctx,
+		`INSERT INTO alert_escalation_notifications (escalation_id, notification_id) VALUES (?, ?)`,
+		escalationID, notificationID); err != nil {
+		return fmt.Errorf("failed to record escalation notification: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit escalation: %w", err)
+	}
+	return nil
+}
+
+// GetDueEscalations returns the escalations whose next step is due at now, oldest first.
+// A compound rule's escalation reports the sensor of its open episode.
+func (r *AlertRepositoryImpl) GetDueEscalations(ctx context.Context, now time.Time) ([]alerting.Escalation, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT
+			e.id,
+			COALESCE(e.alert_rule_id, e.compound_rule_id),
+			e.compound_rule_id IS NOT NULL,
+			COALESCE(sar.sensor_id, (
+				SELECT h.sensor_id FROM alert_sent_history h
+				WHERE h.compound_rule_id = e.compound_rule_id
+				ORDER BY h.id DESC
+				LIMIT 1
+			), 0),
+			COALESCE(s.name, car.name, ''),
+			COALESCE((
+				SELECT n.message
+				FROM alert_escalation_notifications en
+				JOIN notifications n ON en.notification_id = n.id
+				WHERE en.escalation_id = e.id
+				ORDER BY n.id
+				LIMIT 1
+			), ''),
+			e.next_step,
+			e.started_at,
+			COALESCE(sar.snoozed_until, car.snoozed_until),
+			COALESCE(sar.state, car.state) <> ? OR NOT COALESCE(sar.enabled, car.enabled)
+				OR EXISTS (
+					SELECT 1 FROM alert_sent_history h
+					WHERE (h.alert_rule_id = e.alert_rule_id OR h.compound_rule_id = e.compound_rule_id)
+						AND h.resolved_at IS NULL AND h.acknowledged_at IS NOT NULL
+				)
+				OR EXISTS (
+					SELECT 1
+					FROM alert_escalation_notifications en
+					JOIN user_notifications un ON en.notification_id = un.notification_id
+					WHERE en.escalation_id = e.id AND (un.is_read = 1 OR un.is_dismissed = 1)
+				)
+		FROM alert_escalations e
+		LEFT JOIN sensor_alert_rules sar ON e.alert_rule_id = sar.id
+		LEFT JOIN sensors s ON sar.sensor_id = s.id
+		LEFT JOIN compound_alert_rules car ON e.compound_rule_id = car.id
+		WHERE e.due_at <= ?
+		ORDER BY e.due_at, e.id
+	`, alerting.AlertStateFiring, now.UTC().Format("2006-01-02 15:04:05"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get due escalations: %w", err)
+	}
+	defer rows.Close()
+
+	var escalations []alerting.Escalation
+	for rows.Next() {
+		var e alerting.Escalation
+		var startedAt SQLiteTime
+		var snoozedUntil NullSQLiteTime
+		if err := rows.Scan(&e.ID, &e.RuleID, &e.Compound, &e.SensorID, &e.SensorName, &e.Message, &e.NextStep, &startedAt, &snoozedUntil, &e.Handled); err != nil {
+			return nil, fmt.Errorf("failed to scan escalation: %w", err)
+		}
+		e.StartedAt = startedAt.Time
+		if snoozedUntil.Valid {
+			e.SnoozedUntil = &snoozedUntil.Time
+		}
+		escalations = append(escalations, e)
+	}
+	return escalations, rows.Err()
+}
+
+// AdvanceEscalation records notificationID as sent for the escalation's current step and
+// makes nextStep due at dueAt.
+func (r *AlertRepositoryImpl) AdvanceEscalation(ctx context.Context, escalationID, notificationID, nextStep int, dueAt time.Time) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx,
+		`INSERT INTO alert_escalation_notifications (escalation_id, notification_id) VALUES (?, ?)`,
+		escalationID, notificationID); err != nil {
+		return fmt.Errorf("failed to record escalation notification: %w", err)
+	}
+	if _, err := tx.ExecContext(ctx,
+		`UPDATE alert_escalations SET next_step = ?, due_at = ? WHERE id = ?`,
+		nextStep, dueAt.UTC().Format("2006-01-02 15:04:05"), escalationID); err != nil {
+		return fmt.Errorf("failed to advance escalation: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit escalation: %w", err)
+	}
+	return nil
+}
+
+func (r *AlertRepositoryImpl) EndEscalation(ctx context.Context, escalationID int) error {
+	if _, err := r.db.ExecContext(ctx, `DELETE FROM alert_escalations WHERE id = ?`, escalationID); err != nil {
+		return fmt.Errorf("failed to end escalation: %w", err)
+	}
+	return nil
+}
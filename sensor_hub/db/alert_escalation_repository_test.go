@@ -0,0 +1,127 @@
+package database
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"example/sensorHub/alerting"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAlertRepository_GetEscalationSteps_Success(t *testing.T) {
+	db, dbMock := newMockDB(t)
+	repo := NewAlertRepository(db, slog.Default())
+
+	dbMock.ExpectQuery("FROM alert_escalation_steps").
+		WithArgs(4).
+		WillReturnRows(sqlmock.NewRows([]string{"delay_minutes", "role", "channels"}).
+			AddRow(10, "", "").
+			AddRow(30, "admin", "email,push"))
+
+	steps, err := repo.GetEscalationSteps(context.Background(), 4)
+
+	require.NoError(t, err)
+	require.Len(t, steps, 2)
+	assert.Equal(t, alerting.EscalationStep{DelayMinutes: 10, Channels: []alerting.EscalationChannel{}}, steps[0])
+	assert.Equal(t, "admin", steps[1].Role)
+	assert.Equal(t, []alerting.EscalationChannel{alerting.EscalationChannelEmail, alerting.EscalationChannelPush}, steps[1].Channels)
+	assert.NoError(t, dbMock.ExpectationsWereMet())
+}
+
+func TestAlertRepository_SetEscalationSteps_ReplacesPolicy(t *testing.T) {
+	db, dbMock := newMockDB(t)
+	repo := NewAlertRepository(db, slog.Default())
+
+	dbMock.ExpectBegin()
+	dbMock.ExpectExec("DELETE FROM alert_escalation_steps").WithArgs(4).WillReturnResult(sqlmock.NewResult(0, 1))
+	dbMock.ExpectExec("INSERT INTO alert_escalation_steps").
+		WithArgs(4, 0, 10, "", "").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	dbMock.ExpectExec("INSERT INTO alert_escalation_steps").
+		WithArgs(4, 1, 30, "admin", "webhook,push").
+		WillReturnResult(sqlmock.NewResult(2, 1))
+	dbMock.ExpectCommit()
+
+	err := repo.SetEscalationSteps(context.Background(), 4, []alerting.EscalationStep{
+		{DelayMinutes: 10},
+		{DelayMinutes: 30, Role: "admin", Channels: []alerting.EscalationChannel{alerting.EscalationChannelWebhook, alerting.EscalationChannelPush}},
+	})
+
+	require.NoError(t, err)
+	assert.NoError(t, dbMock.ExpectationsWereMet())
+}
+
+func TestAlertRepository_StartEscalation_RecordsNotification(t *testing.T) {
+	db, dbMock := newMockDB(t)
+	repo := NewAlertRepository(db, slog.Default())
+	dueAt := time.Date(2025, 6, 2, 10, 15, 0, 0, time.UTC)
+
+	dbMock.ExpectBegin()
+	dbMock.ExpectExec("DELETE FROM alert_escalations").WithArgs(4).WillReturnResult(sqlmock.NewResult(0, 0))
+	dbMock.ExpectExec("INSERT INTO alert_escalations").
+		WithArgs(4, "2025-06-02 10:15:00").
+		WillReturnResult(sqlmock.NewResult(9, 1))
+	dbMock.ExpectExec("INSERT INTO alert_escalation_notifications").
+		WithArgs(int64(9), 21).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	dbMock.ExpectCommit()
+
+	err := repo.StartEscalation(context.Background(), 4, 21, dueAt)
+
+	require.NoError(t, err)
+	assert.NoError(t, dbMock.ExpectationsWereMet())
+}
+
+func TestAlertRepository_StartCompoundEscalation_UsesCompoundRule(t *testing.T) {
+	db, dbMock := newMockDB(t)
+	repo := NewAlertRepository(db, slog.Default())
+	dueAt := time.Date(2025, 6, 2, 10, 15, 0, 0, time.UTC)
+
+	dbMock.ExpectBegin()
+	dbMock.ExpectExec("DELETE FROM alert_escalations WHERE compound_rule_id").WithArgs(2).WillReturnResult(sqlmock.NewResult(0, 0))
+	dbMock.ExpectExec("INSERT INTO alert_escalations \\(compound_rule_id").
+		WithArgs(2, "2025-06-02 10:15:00").
+		WillReturnResult(sqlmock.NewResult(10, 1))
+	dbMock.ExpectExec("INSERT INTO alert_escalation_notifications").
+		WithArgs(int64(10), 22).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	dbMock.ExpectCommit()
+
+	err := repo.StartCompoundEscalation(context.Background(), 2, 22, dueAt)
+
+	require.NoError(t, err)
+	assert.NoError(t, dbMock.ExpectationsWereMet())
+}
+
+func TestAlertRepository_GetDueEscalations_Success(t *testing.T) {
+	db, dbMock := newMockDB(t)
+	repo := NewAlertRepository(db, slog.Default())
+	now := time.Date(2025, 6, 2, 10, 15, 0, 0, time.UTC)
+
+	dbMock.ExpectQuery("FROM alert_escalations").
+		WithArgs(alerting.AlertStateFiring, "2025-06-02 10:15:00").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "rule_id", "compound", "sensor_id", "name", "message", "next_step", "started_at", "snoozed_until", "handled"}).
+			AddRow(9, 4, false, 3, "freezer", "value -2.00 is above high threshold -10.00", 1, "2025-06-02 10:00:00", nil, false).
+			AddRow(10, 2, true, 5, "Cold and humid", "temperature < 5.00 AND humidity > 80.00", 0, "2025-06-02 10:05:00", nil, false))
+
+	escalations, err := repo.GetDueEscalations(context.Background(), now)
+
+	require.NoError(t, err)
+	require.Len(t, escalations, 2)
+	assert.Equal(t, 4, escalations[0].RuleID)
+	assert.False(t, escalations[0].Compound)
+	assert.Equal(t, "freezer", escalations[0].SensorName)
+	assert.Equal(t, 1, escalations[0].NextStep)
+	assert.Equal(t, time.Date(2025, 6, 2, 10, 0, 0, 0, time.UTC), escalations[0].StartedAt)
+	assert.Nil(t, escalations[0].SnoozedUntil)
+	assert.False(t, escalations[0].Handled)
+	assert.Equal(t, 2, escalations[1].RuleID)
+	assert.True(t, escalations[1].Compound)
+	assert.Equal(t, "Cold and humid", escalations[1].SensorName)
+	assert.NoError(t, dbMock.ExpectationsWereMet())
+}
@@ -0,0 +1,281 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"example/sensorHub/alerting"
+)
+
+// Recipient and escalation rows belong to either a simple or a compound rule, named by
+// one of these columns.
+const (
+	alertRuleColumn    = "alert_rule_id"
+	compoundRuleColumn = "compound_rule_id"
+)
+
+// GetAlertRecipients returns who the rule's notifications go to; it is empty when they
+// go to every user with view_alerts.
+func (r *AlertRepositoryImpl) GetAlertRecipients(ctx context.Context, ruleID int) (alerting.AlertRecipients, error) {
+	return r.getAlertRecipients(ctx, alertRuleColumn, ruleID)
+}
+
+// GetCompoundAlertRecipients is GetAlertRecipients for a compound rule.
+func (r *AlertRepositoryImpl) GetCompoundAlertRecipients(ctx context.Context, ruleID int) (alerting.AlertRecipients, error) {
+	return r.getAlertRecipients(ctx, compoundRuleColumn, ruleID)
+}
+
+func (r *AlertRepositoryImpl) getAlertRecipients(ctx context.Context, ruleColumn string, ruleID int) (alerting.AlertRecipients, error) {
+	recipients := alerting.AlertRecipients{UserIDs: []int{}, Roles: []string{}, ContactGroupIDs: []int{}}
+	rows, err := r.db.QueryContext(ctx, fmt.Sprintf(`
+		SELECT rr.user_id, ro.name, rr.contact_group_id
+		FROM alert_rule_recipients rr
+		LEFT JOIN roles ro ON rr.role_id = ro.id
+		WHERE rr.%s = ?
+		ORDER BY rr.id
+	`, ruleColumn), ruleID)
+	if err != nil {
+		return recipients, fmt.Errorf("failed to get recipients for rule %d: %w", ruleID, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var userID, groupID sql.NullInt64
+		var role sql.NullString
+		if err := rows.Scan(&userID, &role, &groupID); err != nil {
+			return recipients, fmt.Errorf("failed to scan alert recipient: %w", err)
+		}
+		switch {
+		case userID.Valid:
+			recipients.UserIDs = append(recipients.UserIDs, int(userID.Int64))
+		case role.Valid:
+			recipients.Roles = append(recipients.Roles, role.String)
+		case groupID.Valid:
+			recipients.ContactGroupIDs = append(recipients.ContactGroupIDs, int(groupID.Int64))
+		}
+	}
+	return recipients, rows.Err()
+}
+
+// SetAlertRecipients replaces who the rule's notifications go to. Empty recipients send
+// them to every user with view_alerts again.
+func (r *AlertRepositoryImpl) SetAlertRecipients(ctx context.Context, ruleID int, recipients alerting.AlertRecipients) error {
+	return r.setAlertRecipients(ctx, alertRuleColumn, ruleID, recipients)
+}
+
+// SetCompoundAlertRecipients is SetAlertRecipients for a compound rule.
+func (r *AlertRepositoryImpl) SetCompoundAlertRecipients(ctx context.Context, ruleID int, recipients alerting.AlertRecipients) error {
+	return r.setAlertRecipients(ctx, compoundRuleColumn, ruleID, recipients)
+}
+
+func (r *AlertRepositoryImpl) setAlertRecipients(ctx context.Context, ruleColumn string, ruleID int, recipients alerting.AlertRecipients) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, fmt.Sprintf(`DELETE FROM alert_rule_recipients WHERE %s = ?`, ruleColumn), ruleID); err != nil {
+		return fmt.Errorf("failed to clear alert recipients: %w", err)
+	}
+	for _, userID := range recipients.UserIDs {
+		if _, err := tx.ExecContext(ctx,
+			fmt.Sprintf(`INSERT INTO alert_rule_recipients (%s, user_id) VALUES (?, ?)`, ruleColumn),
+			ruleID, userID); err != nil {
+			return fmt.Errorf("failed to add user %d as alert recipient: %w", userID, err)
+		}
+	}
+	for _, role := range recipients.Roles {
+		if _, err := tx.ExecContext(ctx,
+			fmt.Sprintf(`INSERT INTO alert_rule_recipients (%s, role_id) SELECT ?, id FROM roles WHERE name = ?`, ruleColumn),
+			ruleID, role); err != nil {
+			return fmt.Errorf("failed to add role %s as alert recipient: %w", role, err)
+		}
+	}
+	for _, groupID := range recipients.ContactGroupIDs {
+		if _, err := tx.ExecContext(ctx,
+			fmt.Sprintf(`INSERT INTO alert_rule_recipients (%s, contact_group_id) VALUES (?, ?)`, ruleColumn),
+			ruleID, groupID); err != nil {
+			return fmt.Errorf("failed to add contact group %d as alert recipient: %w", groupID, err)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit alert recipients: %w", err)
+	}
+	return nil
+}
+
+// GetAlertRecipientUserIDs resolves the rule's recipients to the enabled users they
+// name. targeted is false when the rule has no recipients, so its notifications go to
+// every user with view_alerts.
+func (r *AlertRepositoryImpl) GetAlertRecipientUserIDs(ctx context.Context, ruleID int) (userIDs []int, targeted bool, err error) {
+	return r.getAlertRecipientUserIDs(ctx, alertRuleColumn, ruleID)
+}
+
+// GetCompoundAlertRecipientUserIDs is GetAlertRecipientUserIDs for a compound rule.
+func (r *AlertRepositoryImpl) GetCompoundAlertRecipientUserIDs(ctx context.Context, ruleID int) (userIDs []int, targeted bool, err error) {
+	return r.getAlertRecipientUserIDs(ctx, compoundRuleColumn, ruleID)
+}
+
+func (r *AlertRepositoryImpl) getAlertRecipientUserIDs(ctx context.Context, ruleColumn string, ruleID int) (userIDs []int, targeted bool, err error) {
+	if err := r.db.QueryRowContext(ctx,
+		fmt.Sprintf(`SELECT EXISTS (SELECT 1 FROM alert_rule_recipients WHERE %s = ?)`, ruleColumn), ruleID).Scan(&targeted); err != nil {
+		return nil, false, fmt.Errorf("failed to check recipients for rule %d: %w", ruleID, err)
+	}
+	if !targeted {
+		return nil, false, nil
+	}
+
+	rows, err := r.db.QueryContext(ctx, fmt.Sprintf(`
+		SELECT DISTINCT u.id
+		FROM alert_rule_recipients rr
+		LEFT JOIN user_roles ur ON rr.role_id = ur.role_id
+		LEFT JOIN contact_group_members cgm ON rr.contact_group_id = cgm.contact_group_id
+		JOIN users u ON u.id = COALESCE(rr.user_id, ur.user_id, cgm.user_id)
+		WHERE rr.%s = ? AND u.disabled = FALSE
+		ORDER BY u.id
+	`, ruleColumn), ruleID)
+	if err != nil {
+		return nil, true, fmt.Errorf("failed to resolve recipients for rule %d: %w", ruleID, err)
+	}
+	defer rows.Close()
+
+	userIDs = []int{}
+	for rows.Next() {
+		var id int
+		if err := rows.Scan(&id); err != nil {
+			return nil, true, fmt.Errorf("failed to scan recipient user ID: %w", err)
+		}
+		userIDs = append(userIDs, id)
+	}
+	return userIDs, true, rows.Err()
+}
+
+func (r *AlertRepositoryImpl) GetAllContactGroups(ctx context.Context) ([]alerting.ContactGroup, error) {
+	groups, err := r.queryContactGroups(ctx, `SELECT id, name FROM contact_groups ORDER BY name`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get contact groups: %w", err)
+	}
+	return groups, nil
+}
+
+func (r *AlertRepositoryImpl) GetContactGroupByID(ctx context.Context, groupID int) (*alerting.ContactGroup, error) {
+	groups, err := r.queryContactGroups(ctx, `SELECT id, name FROM contact_groups WHERE id = ?`, groupID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get contact group %d: %w", groupID, err)
+	}
+	if len(groups) == 0 {
+		return nil, nil
+	}
+	return &groups[0], nil
+}
+
+func (r *AlertRepositoryImpl) queryContactGroups(ctx context.Context, query string, args ...any) ([]alerting.ContactGroup, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	var groups []alerting.ContactGroup
+	for rows.Next() {
+		g := alerting.ContactGroup{UserIDs: []int{}}
+		if err := rows.Scan(&g.ID, &g.Name); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("failed to scan contact group: %w", err)
+		}
+		groups = append(groups, g)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	for i := range groups {
+		members, err := r.db.QueryContext(ctx,
+			`SELECT user_id FROM contact_group_members WHERE contact_group_id = ? ORDER BY user_id`, groups[i].ID)
+		if err != nil {
+			return nil, err
+		}
+		for members.Next() {
+			var userID int
+			if err := members.Scan(&userID); err != nil {
+				members.Close()
+				return nil, fmt.Errorf("failed to scan contact group member: %w", err)
+			}
+			groups[i].UserIDs = append(groups[i].UserIDs, userID)
+		}
+		members.Close()
+		if err := members.Err(); err != nil {
+			return nil, err
+		}
+	}
+	return groups, nil
+}
+
+func (r *AlertRepositoryImpl) CreateContactGroup(ctx context.Context, group *alerting.ContactGroup) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx, `INSERT INTO contact_groups (name) VALUES (?)`, group.Name)
+	if err != nil {
+		return fmt.Errorf("failed to create contact group: %w", err)
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return fmt.Errorf("failed to get contact group ID: %w", err)
+	}
+	if err := insertContactGroupMembers(ctx, tx, int(id), group.UserIDs); err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit contact group: %w", err)
+	}
+	group.ID = int(id)
+	return nil
+}
+
+// UpdateContactGroup renames the group and replaces its members.
+func (r *AlertRepositoryImpl) UpdateContactGroup(ctx context.Context, group *alerting.ContactGroup) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx,
+		`UPDATE contact_groups SET name = ?, updated_at = datetime('now') WHERE id = ?`,
+		group.Name, group.ID); err != nil {
+		return fmt.Errorf("failed to update contact group: %w", err)
+	}
+	if _, err := tx.ExecContext(ctx, `DELETE FROM contact_group_members WHERE contact_group_id = ?`, group.ID); err != nil {
+		return fmt.Errorf("failed to clear contact group members: %w", err)
+	}
+	if err := insertContactGroupMembers(ctx, tx, group.ID, group.UserIDs); err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit contact group: %w", err)
+	}
+	return nil
+}
+
+func insertContactGroupMembers(ctx context.Context, tx *sql.Tx, groupID int, userIDs []int) error {
+	for _, userID := range userIDs {
+		if _, err := tx.ExecContext(ctx,
+			`INSERT INTO contact_group_members (contact_group_id, user_id) VALUES (?, ?)`,
+			groupID, userID); err != nil {
+			return fmt.Errorf("failed to add user %d to contact group: %w", userID, err)
+		}
+	}
+	return nil
+}
+
+func (r *AlertRepositoryImpl) DeleteContactGroup(ctx context.Context, groupID int) error {
+	if _, err := r.db.ExecContext(ctx, `DELETE FROM contact_groups WHERE id = ?`, groupID); err != nil {
+		return fmt.Errorf("failed to delete contact group: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,152 @@
+package database
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+
+	"example/sensorHub/alerting"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAlertRepository_GetAlertRecipients_Success(t *testing.T) {
+	db, dbMock := newMockDB(t)
+	repo := NewAlertRepository(db, slog.Default())
+
+	dbMock.ExpectQuery("FROM alert_rule_recipients").
+		WithArgs(4).
+		WillReturnRows(sqlmock.NewRows([]string{"user_id", "name", "contact_group_id"}).
+			AddRow(2, nil, nil).
+			AddRow(nil, "admin", nil).
+			AddRow(nil, nil, 7))
+
+	recipients, err := repo.GetAlertRecipients(context.Background(), 4)
+
+	require.NoError(t, err)
+	assert.Equal(t, alerting.AlertRecipients{UserIDs: []int{2}, Roles: []string{"admin"}, ContactGroupIDs: []int{7}}, recipients)
+	assert.NoError(t, dbMock.ExpectationsWereMet())
+}
+
+func TestAlertRepository_SetAlertRecipients_ReplacesRecipients(t *testing.T) {
+	db, dbMock := newMockDB(t)
+	repo := NewAlertRepository(db, slog.Default())
+
+	dbMock.ExpectBegin()
+	dbMock.ExpectExec("DELETE FROM alert_rule_recipients").WithArgs(4).WillReturnResult(sqlmock.NewResult(0, 2))
+	dbMock.ExpectExec("INSERT INTO alert_rule_recipients \\(alert_rule_id, user_id\\)").
+		WithArgs(4, 2).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	dbMock.ExpectExec("INSERT INTO alert_rule_recipients \\(alert_rule_id, role_id\\)").
+		WithArgs(4, "admin").
+		WillReturnResult(sqlmock.NewResult(2, 1))
+	dbMock.ExpectExec("INSERT INTO alert_rule_recipients \\(alert_rule_id, contact_group_id\\)").
+		WithArgs(4, 7).
+		WillReturnResult(sqlmock.NewResult(3, 1))
+	dbMock.ExpectCommit()
+
+	err := repo.SetAlertRecipients(context.Background(), 4, alerting.AlertRecipients{
+		UserIDs:         []int{2},
+		Roles:           []string{"admin"},
+		ContactGroupIDs: []int{7},
+	})
+
+	require.NoError(t, err)
+	assert.NoError(t, dbMock.ExpectationsWereMet())
+}
+
+func TestAlertRepository_SetCompoundAlertRecipients_UsesCompoundRule(t *testing.T) {
+	db, dbMock := newMockDB(t)
+	repo := NewAlertRepository(db, slog.Default())
+
+	dbMock.ExpectBegin()
+	dbMock.ExpectExec("DELETE FROM alert_rule_recipients WHERE compound_rule_id").WithArgs(2).WillReturnResult(sqlmock.NewResult(0, 0))
+	dbMock.ExpectExec("INSERT INTO alert_rule_recipients \\(compound_rule_id, role_id\\)").
+		WithArgs(2, "admin").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	dbMock.ExpectCommit()
+
+	err := repo.SetCompoundAlertRecipients(context.Background(), 2, alerting.AlertRecipients{Roles: []string{"admin"}})
+
+	require.NoError(t, err)
+	assert.NoError(t, dbMock.ExpectationsWereMet())
+}
+
+func TestAlertRepository_GetAlertRecipientUserIDs_NoRecipients(t *testing.T) {
+	db, dbMock := newMockDB(t)
+	repo := NewAlertRepository(db, slog.Default())
+
+	dbMock.ExpectQuery("SELECT EXISTS").
+		WithArgs(4).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+
+	userIDs, targeted, err := repo.GetAlertRecipientUserIDs(context.Background(), 4)
+
+	require.NoError(t, err)
+	assert.False(t, targeted)
+	assert.Nil(t, userIDs)
+	assert.NoError(t, dbMock.ExpectationsWereMet())
+}
+
+func TestAlertRepository_GetAlertRecipientUserIDs_Success(t *testing.T) {
+	db, dbMock := newMockDB(t)
+	repo := NewAlertRepository(db, slog.Default())
+
+	dbMock.ExpectQuery("SELECT EXISTS").
+		WithArgs(4).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+	dbMock.ExpectQuery("SELECT DISTINCT u.id").
+		WithArgs(4).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(2).AddRow(5))
+
+	userIDs, targeted, err := repo.GetAlertRecipientUserIDs(context.Background(), 4)
+
+	require.NoError(t, err)
+	assert.True(t, targeted)
+	assert.Equal(t, []int{2, 5}, userIDs)
+	assert.NoError(t, dbMock.ExpectationsWereMet())
+}
+
+func TestAlertRepository_CreateContactGroup_Success(t *testing.T) {
+	db, dbMock := newMockDB(t)
+	repo := NewAlertRepository(db, slog.Default())
+
+	dbMock.ExpectBegin()
+	dbMock.ExpectExec("INSERT INTO contact_groups").
+		WithArgs("Freezer on-call").
+		WillReturnResult(sqlmock.NewResult(3, 1))
+	dbMock.ExpectExec("INSERT INTO contact_group_members").WithArgs(3, 2).WillReturnResult(sqlmock.NewResult(0, 1))
+	dbMock.ExpectExec("INSERT INTO contact_group_members").WithArgs(3, 5).WillReturnResult(sqlmock.NewResult(0, 1))
+	dbMock.ExpectCommit()
+
+	group := alerting.ContactGroup{Name: "Freezer on-call", UserIDs: []int{2, 5}}
+	err := repo.CreateContactGroup(context.Background(), &group)
+
+	require.NoError(t, err)
+	assert.Equal(t, 3, group.ID)
+	assert.NoError(t, dbMock.ExpectationsWereMet())
+}
+
+func TestAlertRepository_GetAllContactGroups_LoadsMembers(t *testing.T) {
+	db, dbMock := newMockDB(t)
+	repo := NewAlertRepository(db, slog.Default())
+
+	dbMock.ExpectQuery("FROM contact_groups").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(3, "Freezer on-call").AddRow(4, "Greenhouse"))
+	dbMock.ExpectQuery("FROM contact_group_members").
+		WithArgs(3).
+		WillReturnRows(sqlmock.NewRows([]string{"user_id"}).AddRow(2).AddRow(5))
+	dbMock.ExpectQuery("FROM contact_group_members").
+		WithArgs(4).
+		WillReturnRows(sqlmock.NewRows([]string{"user_id"}))
+
+	groups, err := repo.GetAllContactGroups(context.Background())
+
+	require.NoError(t, err)
+	require.Len(t, groups, 2)
+	assert.Equal(t, []int{2, 5}, groups[0].UserIDs)
+	assert.Equal(t, []int{}, groups[1].UserIDs)
+	assert.NoError(t, dbMock.ExpectationsWereMet())
+}
@@ -16,8 +16,15 @@ type AlertRepository interface {
 	GetAlertRuleByID(ctx context.Context, ruleID int) (*alerting.AlertRule, error)
 	GetAlertRuleBySensorID(ctx context.Context, sensorID int) (*alerting.AlertRule, error)
 	GetAlertRulesBySensorID(ctx context.Context, sensorID int) ([]alerting.AlertRule, error)
-	GetAlertRuleForReading(ctx context.Context, sensorID int, measurementTypeName string) (*alerting.AlertRule, error)
+	GetAlertRulesForReading(ctx context.Context, sensorID int, measurementTypeName string) ([]alerting.AlertRule, error)
 	RecordAlertSent(ctx context.Context, ruleID, sensorID, measurementTypeId int, reason string, numericValue float64, statusValue string) error
+	MarkAlertFiring(ctx context.Context, ruleID int) (bool, error)
+	UpdateAlertPeak(ctx context.Context, ruleID int, value float64, higher bool) error
+	MarkAlertRateLimited(ctx context.Context, ruleID int) error
+	ResolveAlert(ctx context.Context, ruleID int) (*alerting.AlertEpisode, error)
+	AcknowledgeAlertRule(ctx context.Context, ruleID, userID int) (bool, error)
+	AcknowledgeAlertHistoryEntry(ctx context.Context, entryID, userID int) (bool, error)
+	SnoozeAlertRule(ctx context.Context, ruleID int, until *time.Time) error
 	GetAllAlertRules(ctx context.Context) ([]alerting.AlertRule, error)
 	GetAlertRuleBySensorName(ctx context.Context, sensorName string) (*alerting.AlertRule, error)
 	CreateAlertRule(ctx context.Context, rule *alerting.AlertRule) error
@@ -25,6 +32,44 @@ type AlertRepository interface {
 	DeleteAlertRule(ctx context.Context, ruleID int) error
 	GetAlertHistory(ctx context.Context, sensorID int, limit int) ([]gen.AlertHistoryEntry, error)
 	DeleteAlertHistoryOlderThan(ctx context.Context, cutoff time.Time) (int64, error)
+	GetAllCompoundAlertRules(ctx context.Context) ([]alerting.CompoundAlertRule, error)
+	GetCompoundAlertRuleByID(ctx context.Context, ruleID int) (*alerting.CompoundAlertRule, error)
+	GetCompoundAlertRulesForReading(ctx context.Context, sensorID int, measurementTypeName string) ([]alerting.CompoundAlertRule, error)
+	CreateCompoundAlertRule(ctx context.Context, rule *alerting.CompoundAlertRule) error
+	UpdateCompoundAlertRule(ctx context.Context, rule *alerting.CompoundAlertRule) error
+	DeleteCompoundAlertRule(ctx context.Context, ruleID int) error
+	RecordCompoundAlertSent(ctx context.Context, ruleID, sensorID, measurementTypeId int, reason string, numericValue float64, statusValue string) error
+	MarkCompoundAlertFiring(ctx context.Context, ruleID int) (bool, error)
+	MarkCompoundAlertRateLimited(ctx context.Context, ruleID int) error
+	ResolveCompoundAlert(ctx context.Context, ruleID int) (*alerting.AlertEpisode, error)
+	AcknowledgeCompoundAlertRule(ctx context.Context, ruleID, userID int) (bool, error)
+	SnoozeCompoundAlertRule(ctx context.Context, ruleID int, until *time.Time) error
+	GetAllSilenceWindows(ctx context.Context) ([]alerting.SilenceWindow, error)
+	GetSilenceWindowByID(ctx context.Context, windowID int) (*alerting.SilenceWindow, error)
+	GetSilenceWindowsForSensor(ctx context.Context, sensorID int) ([]alerting.SilenceWindow, error)
+	CreateSilenceWindow(ctx context.Context, window *alerting.SilenceWindow) error
+	UpdateSilenceWindow(ctx context.Context, window *alerting.SilenceWindow) error
+	DeleteSilenceWindow(ctx context.Context, windowID int) error
+	GetEscalationSteps(ctx context.Context, ruleID int) ([]alerting.EscalationStep, error)
+	SetEscalationSteps(ctx context.Context, ruleID int, steps []alerting.EscalationStep) error
+	StartEscalation(ctx context.Context, ruleID, notificationID int, dueAt time.Time) error
+	GetDueEscalations(ctx context.Context, now time.Time) ([]alerting.Escalation, error)
+	AdvanceEscalation(ctx context.Context, escalationID, notificationID, nextStep int, dueAt time.Time) error
+	EndEscalation(ctx context.Context, escalationID int) error
+	GetAlertRecipients(ctx context.Context, ruleID int) (alerting.AlertRecipients, error)
+	SetAlertRecipients(ctx context.Context, ruleID int, recipients alerting.AlertRecipients) error
+	GetAlertRecipientUserIDs(ctx context.Context, ruleID int) ([]int, bool, error)
+	GetCompoundEscalationSteps(ctx context.Context, ruleID int) ([]alerting.EscalationStep, error)
+	SetCompoundEscalationSteps(ctx context.Context, ruleID int, steps []alerting.EscalationStep) error
+	StartCompoundEscalation(ctx context.Context, ruleID, notificationID int, dueAt time.Time) error
+	GetCompoundAlertRecipients(ctx context.Context, ruleID int) (alerting.AlertRecipients, error)
+	SetCompoundAlertRecipients(ctx context.Context, ruleID int, recipients alerting.AlertRecipients) error
+	GetCompoundAlertRecipientUserIDs(ctx context.Context, ruleID int) ([]int, bool, error)
+	GetAllContactGroups(ctx context.Context) ([]alerting.ContactGroup, error)
+	GetContactGroupByID(ctx context.Context, groupID int) (*alerting.ContactGroup, error)
+	CreateContactGroup(ctx context.Context, group *alerting.ContactGroup) error
+	UpdateContactGroup(ctx context.Context, group *alerting.ContactGroup) error
+	DeleteContactGroup(ctx context.Context, groupID int) error
 }
 
 type AlertRepositoryImpl struct {
@@ -50,6 +95,11 @@ func (r *AlertRepositoryImpl) GetAlertRule(ctx context.Context, sensorID, measur
 			ar.trigger_status,
 			ar.enabled,
 			ar.rate_limit_seconds,
+			ar.duration_seconds,
+			ar.change_threshold,
+			ar.hysteresis,
+			ar.state,
+			ar.snoozed_until,
 			ah.sent_at
 		FROM sensor_alert_rules ar
 		JOIN sensors s ON ar.sensor_id = s.id
@@ -57,6 +107,7 @@ func (r *AlertRepositoryImpl) GetAlertRule(ctx context.Context, sensorID, measur
 		LEFT JOIN (
 			SELECT alert_rule_id, MAX(sent_at) as sent_at
 			FROM alert_sent_history
+			WHERE rate_limited = 0
 			GROUP BY alert_rule_id
 		) ah ON ar.id = ah.alert_rule_id
 		WHERE ar.sensor_id = ? AND ar.measurement_type_id = ? AND ar.enabled = TRUE
@@ -65,6 +116,7 @@ func (r *AlertRepositoryImpl) GetAlertRule(ctx context.Context, sensorID, measur
 
 	var rule alerting.AlertRule
 	var lastAlertSent NullSQLiteTime
+	var snoozedUntil NullSQLiteTime
 	var triggerStatus sql.NullString
 
 	err := r.db.QueryRowContext(ctx, query, sensorID, measurementTypeId).Scan(
@@ -79,6 +131,11 @@ func (r *AlertRepositoryImpl) GetAlertRule(ctx context.Context, sensorID, measur
 		&triggerStatus,
 		&rule.Enabled,
 		&rule.RateLimitSeconds,
+		&rule.DurationSeconds,
+		&rule.ChangeThreshold,
+		&rule.Hysteresis,
+		&rule.State,
+		&snoozedUntil,
 		&lastAlertSent,
 	)
 
@@ -92,6 +149,9 @@ func (r *AlertRepositoryImpl) GetAlertRule(ctx context.Context, sensorID, measur
 	if lastAlertSent.Valid {
 		rule.LastAlertSentAt = &lastAlertSent.Time
 	}
+	if snoozedUntil.Valid {
+		rule.SnoozedUntil = &snoozedUntil.Time
+	}
 	if triggerStatus.Valid {
 		rule.TriggerStatus = triggerStatus.String
 	}
@@ -113,6 +173,11 @@ func (r *AlertRepositoryImpl) GetAlertRuleBySensorID(ctx context.Context, sensor
 			ar.trigger_status,
 			ar.enabled,
 			ar.rate_limit_seconds,
+			ar.duration_seconds,
+			ar.change_threshold,
+			ar.hysteresis,
+			ar.state,
+			ar.snoozed_until,
 			ah.sent_at
 		FROM sensor_alert_rules ar
 		JOIN sensors s ON ar.sensor_id = s.id
@@ -120,6 +185,7 @@ func (r *AlertRepositoryImpl) GetAlertRuleBySensorID(ctx context.Context, sensor
 		LEFT JOIN (
 			SELECT alert_rule_id, MAX(sent_at) as sent_at
 			FROM alert_sent_history
+			WHERE rate_limited = 0
 			GROUP BY alert_rule_id
 		) ah ON ar.id = ah.alert_rule_id
 		WHERE ar.sensor_id = ? AND ar.enabled = TRUE
@@ -128,6 +194,7 @@ func (r *AlertRepositoryImpl) GetAlertRuleBySensorID(ctx context.Context, sensor
 
 	var rule alerting.AlertRule
 	var lastAlertSent NullSQLiteTime
+	var snoozedUntil NullSQLiteTime
 	var triggerStatus sql.NullString
 
 	err := r.db.QueryRowContext(ctx, query, sensorID).Scan(
@@ -142,6 +209,11 @@ func (r *AlertRepositoryImpl) GetAlertRuleBySensorID(ctx context.Context, sensor
 		&triggerStatus,
 		&rule.Enabled,
 		&rule.RateLimitSeconds,
+		&rule.DurationSeconds,
+		&rule.ChangeThreshold,
+		&rule.Hysteresis,
+		&rule.State,
+		&snoozedUntil,
 		&lastAlertSent,
 	)
 
@@ -155,6 +227,9 @@ func (r *AlertRepositoryImpl) GetAlertRuleBySensorID(ctx context.Context, sensor
 	if lastAlertSent.Valid {
 		rule.LastAlertSentAt = &lastAlertSent.Time
 	}
+	if snoozedUntil.Valid {
+		rule.SnoozedUntil = &snoozedUntil.Time
+	}
 
 	if triggerStatus.Valid {
 		rule.TriggerStatus = triggerStatus.String
@@ -163,7 +238,10 @@ func (r *AlertRepositoryImpl) GetAlertRuleBySensorID(ctx context.Context, sensor
 	return &rule, nil
 }
 
-func (r *AlertRepositoryImpl) GetAlertRuleForReading(ctx context.Context, sensorID int, measurementTypeName string) (*alerting.AlertRule, error) {
+// GetAlertRulesForReading returns every enabled rule for the sensor and measurement type.
+// A sensor can carry one rule per alert type, e.g. a sustained_range rule alongside a
+// rate_of_change rule on the same temperature.
+func (r *AlertRepositoryImpl) GetAlertRulesForReading(ctx context.Context, sensorID int, measurementTypeName string) ([]alerting.AlertRule, error) {
 	query := `
 		SELECT 
 			ar.id,
@@ -177,6 +255,11 @@ func (r *AlertRepositoryImpl) GetAlertRuleForReading(ctx context.Context, sensor
 			ar.trigger_status,
 			ar.enabled,
 			ar.rate_limit_seconds,
+			ar.duration_seconds,
+			ar.change_threshold,
+			ar.hysteresis,
+			ar.state,
+			ar.snoozed_until,
 			ah.sent_at
 		FROM sensor_alert_rules ar
 		JOIN sensors s ON ar.sensor_id = s.id
@@ -184,46 +267,60 @@ func (r *AlertRepositoryImpl) GetAlertRuleForReading(ctx context.Context, sensor
 		LEFT JOIN (
 			SELECT alert_rule_id, MAX(sent_at) as sent_at
 			FROM alert_sent_history
+			WHERE rate_limited = 0
 			GROUP BY alert_rule_id
 		) ah ON ar.id = ah.alert_rule_id
 		WHERE ar.sensor_id = ? AND LOWER(mt.name) = LOWER(?) AND ar.enabled = TRUE
-		LIMIT 1
+		ORDER BY ar.id
 	`
 
-	var rule alerting.AlertRule
-	var lastAlertSent NullSQLiteTime
-	var triggerStatus sql.NullString
-
-	err := r.db.QueryRowContext(ctx, query, sensorID, measurementTypeName).Scan(
-		&rule.ID,
-		&rule.SensorID,
-		&rule.SensorName,
-		&rule.MeasurementTypeId,
-		&rule.MeasurementType,
-		&rule.AlertType,
-		&rule.HighThreshold,
-		&rule.LowThreshold,
-		&triggerStatus,
-		&rule.Enabled,
-		&rule.RateLimitSeconds,
-		&lastAlertSent,
-	)
-
-	if err == sql.ErrNoRows {
-		return nil, nil
-	}
+	rows, err := r.db.QueryContext(ctx, query, sensorID, measurementTypeName)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get alert rule for sensor %d measurement %s: %w", sensorID, measurementTypeName, err)
+		return nil, fmt.Errorf("failed to get alert rules for sensor %d measurement %s: %w", sensorID, measurementTypeName, err)
 	}
+	defer rows.Close()
 
-	if lastAlertSent.Valid {
-		rule.LastAlertSentAt = &lastAlertSent.Time
-	}
-	if triggerStatus.Valid {
-		rule.TriggerStatus = triggerStatus.String
+	var rules []alerting.AlertRule
+	for rows.Next() {
+		var rule alerting.AlertRule
+		var lastAlertSent NullSQLiteTime
+		var snoozedUntil NullSQLiteTime
+		var triggerStatus sql.NullString
+		err := rows.Scan(
+			&rule.ID,
+			&rule.SensorID,
+			&rule.SensorName,
+			&rule.MeasurementTypeId,
+			&rule.MeasurementType,
+			&rule.AlertType,
+			&rule.HighThreshold,
+			&rule.LowThreshold,
+			&triggerStatus,
+			&rule.Enabled,
+			&rule.RateLimitSeconds,
+			&rule.DurationSeconds,
+			&rule.ChangeThreshold,
+			&rule.Hysteresis,
+			&rule.State,
+			&snoozedUntil,
+			&lastAlertSent,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan alert rule: %w", err)
+		}
+		if triggerStatus.Valid {
+			rule.TriggerStatus = triggerStatus.String
+		}
+		if lastAlertSent.Valid {
+			rule.LastAlertSentAt = &lastAlertSent.Time
+		}
+		if snoozedUntil.Valid {
+			rule.SnoozedUntil = &snoozedUntil.Time
+		}
+		rules = append(rules, rule)
 	}
 
-	return &rule, nil
+	return rules, rows.Err()
 }
 
 func (r *AlertRepositoryImpl) GetAlertRuleByID(ctx context.Context, ruleID int) (*alerting.AlertRule, error) {
@@ -240,6 +337,11 @@ func (r *AlertRepositoryImpl) GetAlertRuleByID(ctx context.Context, ruleID int)
 			ar.trigger_status,
 			ar.enabled,
 			ar.rate_limit_seconds,
+			ar.duration_seconds,
+			ar.change_threshold,
+			ar.hysteresis,
+			ar.state,
+			ar.snoozed_until,
 			ah.sent_at
 		FROM sensor_alert_rules ar
 		JOIN sensors s ON ar.sensor_id = s.id
@@ -247,6 +349,7 @@ func (r *AlertRepositoryImpl) GetAlertRuleByID(ctx context.Context, ruleID int)
 		LEFT JOIN (
 			SELECT alert_rule_id, MAX(sent_at) as sent_at
 			FROM alert_sent_history
+			WHERE rate_limited = 0
 			GROUP BY alert_rule_id
 		) ah ON ar.id = ah.alert_rule_id
 		WHERE ar.id = ?
@@ -254,6 +357,7 @@ func (r *AlertRepositoryImpl) GetAlertRuleByID(ctx context.Context, ruleID int)
 
 	var rule alerting.AlertRule
 	var lastAlertSent NullSQLiteTime
+	var snoozedUntil NullSQLiteTime
 	var triggerStatus sql.NullString
 
 	err := r.db.QueryRowContext(ctx, query, ruleID).Scan(
@@ -268,6 +372,11 @@ func (r *AlertRepositoryImpl) GetAlertRuleByID(ctx context.Context, ruleID int)
 		&triggerStatus,
 		&rule.Enabled,
 		&rule.RateLimitSeconds,
+		&rule.DurationSeconds,
+		&rule.ChangeThreshold,
+		&rule.Hysteresis,
+		&rule.State,
+		&snoozedUntil,
 		&lastAlertSent,
 	)
 
@@ -281,6 +390,9 @@ func (r *AlertRepositoryImpl) GetAlertRuleByID(ctx context.Context, ruleID int)
 	if lastAlertSent.Valid {
 		rule.LastAlertSentAt = &lastAlertSent.Time
 	}
+	if snoozedUntil.Valid {
+		rule.SnoozedUntil = &snoozedUntil.Time
+	}
 	if triggerStatus.Valid {
 		rule.TriggerStatus = triggerStatus.String
 	}
@@ -302,6 +414,11 @@ func (r *AlertRepositoryImpl) GetAlertRulesBySensorID(ctx context.Context, senso
 			ar.trigger_status,
 			ar.enabled,
 			ar.rate_limit_seconds,
+			ar.duration_seconds,
+			ar.change_threshold,
+			ar.hysteresis,
+			ar.state,
+			ar.snoozed_until,
 			ah.sent_at
 		FROM sensor_alert_rules ar
 		JOIN sensors s ON ar.sensor_id = s.id
@@ -309,6 +426,7 @@ func (r *AlertRepositoryImpl) GetAlertRulesBySensorID(ctx context.Context, senso
 		LEFT JOIN (
 			SELECT alert_rule_id, MAX(sent_at) as sent_at
 			FROM alert_sent_history
+			WHERE rate_limited = 0
 			GROUP BY alert_rule_id
 		) ah ON ar.id = ah.alert_rule_id
 		WHERE ar.sensor_id = ?
@@ -324,6 +442,7 @@ func (r *AlertRepositoryImpl) GetAlertRulesBySensorID(ctx context.Context, senso
 	for rows.Next() {
 		var rule alerting.AlertRule
 		var lastAlertSent NullSQLiteTime
+		var snoozedUntil NullSQLiteTime
 		var triggerStatus sql.NullString
 		err := rows.Scan(
 			&rule.ID,
@@ -337,6 +456,11 @@ func (r *AlertRepositoryImpl) GetAlertRulesBySensorID(ctx context.Context, senso
 			&triggerStatus,
 			&rule.Enabled,
 			&rule.RateLimitSeconds,
+			&rule.DurationSeconds,
+			&rule.ChangeThreshold,
+			&rule.Hysteresis,
+			&rule.State,
+			&snoozedUntil,
 			&lastAlertSent,
 		)
 		if err != nil {
@@ -348,6 +472,9 @@ func (r *AlertRepositoryImpl) GetAlertRulesBySensorID(ctx context.Context, senso
 		if lastAlertSent.Valid {
 			rule.LastAlertSentAt = &lastAlertSent.Time
 		}
+		if snoozedUntil.Valid {
+			rule.SnoozedUntil = &snoozedUntil.Time
+		}
 		rules = append(rules, rule)
 	}
 
@@ -369,6 +496,158 @@ func (r *AlertRepositoryImpl) RecordAlertSent(ctx context.Context, ruleID, senso
 	return nil
 }
 
+// MarkAlertFiring moves the rule to the firing state. It reports false when the rule was
+// already firing, so concurrent readings open at most one episode.
+func (r *AlertRepositoryImpl) MarkAlertFiring(ctx context.Context, ruleID int) (bool, error) {
+	result, err := r.db.ExecContext(ctx,
+		`UPDATE sensor_alert_rules SET state = ? WHERE id = ? AND state <> ?`,
+		alerting.AlertStateFiring, ruleID, alerting.AlertStateFiring)
+	if err != nil {
+		return false, fmt.Errorf("failed to mark alert rule firing: %w", err)
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	return rows > 0, nil
+}
+
+// UpdateAlertPeak records value as the peak of the rule's open episode if it is more
+// extreme than the current peak: larger when higher is set, smaller otherwise.
+func (r *AlertRepositoryImpl) UpdateAlertPeak(ctx context.Context, ruleID int, value float64, higher bool) error {
+	comparison := ">"
+	if !higher {
+		comparison = "<"
+	}
+	query := fmt.Sprintf(`
+		UPDATE alert_sent_history
+		SET peak_value = ?
+		WHERE alert_rule_id = ? AND resolved_at IS NULL AND ? %s COALESCE(peak_value, reading_value)
+	`, comparison)
+
+	if _, err := r.db.ExecContext(ctx, query, value, ruleID, value); err != nil {
+		return fmt.Errorf("failed to update alert peak: %w", err)
+	}
+	return nil
+}
+
+// MarkAlertRateLimited records that the rule's open episode sends no notifications
+// because it started within the rule's rate limit.
+func (r *AlertRepositoryImpl) MarkAlertRateLimited(ctx context.Context, ruleID int) error {
+	if _, err := r.db.ExecContext(ctx,
+		`UPDATE alert_sent_history SET rate_limited = 1 WHERE alert_rule_id = ? AND resolved_at IS NULL`,
+		ruleID); err != nil {
+		return fmt.Errorf("failed to mark alert rate limited: %w", err)
+	}
+	return nil
+}
+
+// ResolveAlert moves a firing rule to the resolved state and closes its open episode. It
+// returns nil when the rule was not firing.
+func (r *AlertRepositoryImpl) ResolveAlert(ctx context.Context, ruleID int) (*alerting.AlertEpisode, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx,
+		`UPDATE sensor_alert_rules SET state = ? WHERE id = ? AND state = ?`,
+		alerting.AlertStateResolved, ruleID, alerting.AlertStateFiring)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve alert rule: %w", err)
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rows == 0 {
+		return nil, nil
+	}
+
+	var startedAt SQLiteTime
+	var peak sql.NullFloat64
+	var acknowledged, rateLimited bool
+	err = tx.QueryRowContext(ctx, `
+		SELECT sent_at, COALESCE(peak_value, reading_value), acknowledged_at IS NOT NULL, rate_limited
+		FROM alert_sent_history
+		WHERE alert_rule_id = ? AND resolved_at IS NULL
+		ORDER BY id DESC
+		LIMIT 1
+	`, ruleID).Scan(&startedAt, &peak, &acknowledged, &rateLimited)
+	if err != nil && err != sql.ErrNoRows {
+		return nil, fmt.Errorf("failed to get open alert episode: %w", err)
+	}
+
+	resolvedAt := time.Now().UTC()
+	if _, err := tx.ExecContext(ctx,
+		`UPDATE alert_sent_history SET resolved_at = ? WHERE alert_rule_id = ? AND resolved_at IS NULL`,
+		resolvedAt.Format("2006-01-02 15:04:05"), ruleID); err != nil {
+		return nil, fmt.Errorf("failed to close alert episode: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit alert resolution: %w", err)
+	}
+
+	episode := &alerting.AlertEpisode{StartedAt: startedAt.Time, ResolvedAt: resolvedAt, PeakValue: peak.Float64, Acknowledged: acknowledged, RateLimited: rateLimited}
+	if startedAt.Time.IsZero() {
+		episode.StartedAt = resolvedAt
+	}
+	return episode, nil
+}
+
+// AcknowledgeAlertRule acknowledges the rule's open episode on behalf of userID. It
+// reports false when the rule is not firing. Acknowledging again keeps the first
+// acknowledgement.
+func (r *AlertRepositoryImpl) AcknowledgeAlertRule(ctx context.Context, ruleID, userID int) (bool, error) {
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE alert_sent_history
+		SET acknowledged_at = COALESCE(acknowledged_at, datetime('now')),
+			acknowledged_by = COALESCE(acknowledged_by, ?)
+		WHERE alert_rule_id = ? AND resolved_at IS NULL
+	`, userID, ruleID)
+	if err != nil {
+		return false, fmt.Errorf("failed to acknowledge alert rule: %w", err)
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	return rows > 0, nil
+}
+
+// AcknowledgeAlertHistoryEntry acknowledges a single alert history entry. It reports
+// false when the entry does not exist.
+func (r *AlertRepositoryImpl) AcknowledgeAlertHistoryEntry(ctx context.Context, entryID, userID int) (bool, error) {
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE alert_sent_history
+		SET acknowledged_at = COALESCE(acknowledged_at, datetime('now')),
+			acknowledged_by = COALESCE(acknowledged_by, ?)
+		WHERE id = ?
+	`, userID, entryID)
+	if err != nil {
+		return false, fmt.Errorf("failed to acknowledge alert history entry: %w", err)
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	return rows > 0, nil
+}
+
+// SnoozeAlertRule suppresses the rule's notifications until the given time. A nil time
+// clears the snooze.
+func (r *AlertRepositoryImpl) SnoozeAlertRule(ctx context.Context, ruleID int, until *time.Time) error {
+	var snoozedUntil sql.NullString
+	if until != nil {
+		snoozedUntil = sql.NullString{String: until.UTC().Format("2006-01-02 15:04:05"), Valid: true}
+	}
+	if _, err := r.db.ExecContext(ctx, `UPDATE sensor_alert_rules SET snoozed_until = ? WHERE id = ?`, snoozedUntil, ruleID); err != nil {
+		return fmt.Errorf("failed to snooze alert rule: %w", err)
+	}
+	return nil
+}
+
 func (r *AlertRepositoryImpl) GetAllAlertRules(ctx context.Context) ([]alerting.AlertRule, error) {
 	query := `
 		SELECT 
@@ -383,6 +662,11 @@ func (r *AlertRepositoryImpl) GetAllAlertRules(ctx context.Context) ([]alerting.
 			sar.trigger_status,
 			sar.enabled,
 			sar.rate_limit_seconds,
+			sar.duration_seconds,
+			sar.change_threshold,
+			sar.hysteresis,
+			sar.state,
+			sar.snoozed_until,
 			ash.sent_at
 		FROM sensor_alert_rules sar
 		INNER JOIN sensors s ON sar.sensor_id = s.id
@@ -390,6 +674,7 @@ func (r *AlertRepositoryImpl) GetAllAlertRules(ctx context.Context) ([]alerting.
 		LEFT JOIN (
 			SELECT alert_rule_id, MAX(sent_at) as sent_at
 			FROM alert_sent_history
+			WHERE rate_limited = 0
 			GROUP BY alert_rule_id
 		) ash ON sar.id = ash.alert_rule_id
 	`
@@ -404,6 +689,7 @@ func (r *AlertRepositoryImpl) GetAllAlertRules(ctx context.Context) ([]alerting.
 	for rows.Next() {
 		var rule alerting.AlertRule
 		var lastAlertSentAt NullSQLiteTime
+		var snoozedUntil NullSQLiteTime
 		var triggerStatus sql.NullString
 		err := rows.Scan(
 			&rule.ID,
@@ -417,6 +703,11 @@ func (r *AlertRepositoryImpl) GetAllAlertRules(ctx context.Context) ([]alerting.
 			&triggerStatus,
 			&rule.Enabled,
 			&rule.RateLimitSeconds,
+			&rule.DurationSeconds,
+			&rule.ChangeThreshold,
+			&rule.Hysteresis,
+			&rule.State,
+			&snoozedUntil,
 			&lastAlertSentAt,
 		)
 		if err != nil {
@@ -428,6 +719,9 @@ func (r *AlertRepositoryImpl) GetAllAlertRules(ctx context.Context) ([]alerting.
 		if lastAlertSentAt.Valid {
 			rule.LastAlertSentAt = &lastAlertSentAt.Time
 		}
+		if snoozedUntil.Valid {
+			rule.SnoozedUntil = &snoozedUntil.Time
+		}
 		rules = append(rules, rule)
 	}
 
@@ -448,6 +742,11 @@ func (r *AlertRepositoryImpl) GetAlertRuleBySensorName(ctx context.Context, sens
 			sar.trigger_status,
 			sar.enabled,
 			sar.rate_limit_seconds,
+			sar.duration_seconds,
+			sar.change_threshold,
+			sar.hysteresis,
+			sar.state,
+			sar.snoozed_until,
 			ash.sent_at
 		FROM sensor_alert_rules sar
 		INNER JOIN sensors s ON sar.sensor_id = s.id
@@ -455,6 +754,7 @@ func (r *AlertRepositoryImpl) GetAlertRuleBySensorName(ctx context.Context, sens
 		LEFT JOIN (
 			SELECT alert_rule_id, MAX(sent_at) as sent_at
 			FROM alert_sent_history
+			WHERE rate_limited = 0
 			GROUP BY alert_rule_id
 		) ash ON sar.id = ash.alert_rule_id
 		WHERE LOWER(s.name) = LOWER(?)
@@ -462,6 +762,7 @@ func (r *AlertRepositoryImpl) GetAlertRuleBySensorName(ctx context.Context, sens
 
 	var rule alerting.AlertRule
 	var lastAlertSentAt NullSQLiteTime
+	var snoozedUntil NullSQLiteTime
 	var triggerStatus sql.NullString
 	err := r.db.QueryRowContext(ctx, query, sensorName).Scan(
 		&rule.ID,
@@ -475,6 +776,11 @@ func (r *AlertRepositoryImpl) GetAlertRuleBySensorName(ctx context.Context, sens
 		&triggerStatus,
 		&rule.Enabled,
 		&rule.RateLimitSeconds,
+		&rule.DurationSeconds,
+		&rule.ChangeThreshold,
+		&rule.Hysteresis,
+		&rule.State,
+		&snoozedUntil,
 		&lastAlertSentAt,
 	)
 
@@ -491,14 +797,17 @@ func (r *AlertRepositoryImpl) GetAlertRuleBySensorName(ctx context.Context, sens
 	if lastAlertSentAt.Valid {
 		rule.LastAlertSentAt = &lastAlertSentAt.Time
 	}
+	if snoozedUntil.Valid {
+		rule.SnoozedUntil = &snoozedUntil.Time
+	}
 	return &rule, nil
 }
 
 func (r *AlertRepositoryImpl) CreateAlertRule(ctx context.Context, rule *alerting.AlertRule) error {
 	query := `
 		INSERT INTO sensor_alert_rules 
-		(sensor_id, measurement_type_id, alert_type, high_threshold, low_threshold, trigger_status, rate_limit_seconds, enabled)
-		VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+		(sensor_id, measurement_type_id, alert_type, high_threshold, low_threshold, trigger_status, rate_limit_seconds, duration_seconds, change_threshold, hysteresis, enabled)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 	`
 
 	_, err := r.db.ExecContext(ctx, query,
@@ -509,6 +818,9 @@ func (r *AlertRepositoryImpl) CreateAlertRule(ctx context.Context, rule *alertin
 		rule.LowThreshold,
 		rule.TriggerStatus,
 		rule.RateLimitSeconds,
+		rule.DurationSeconds,
+		rule.ChangeThreshold,
+		rule.Hysteresis,
 		rule.Enabled,
 	)
 
@@ -527,6 +839,9 @@ func (r *AlertRepositoryImpl) UpdateAlertRule(ctx context.Context, rule *alertin
 			low_threshold = ?,
 			trigger_status = ?,
 			rate_limit_seconds = ?,
+			duration_seconds = ?,
+			change_threshold = ?,
+			hysteresis = ?,
 			enabled = ?
 		WHERE id = ?
 	`
@@ -537,6 +852,9 @@ func (r *AlertRepositoryImpl) UpdateAlertRule(ctx context.Context, rule *alertin
 		rule.LowThreshold,
 		rule.TriggerStatus,
 		rule.RateLimitSeconds,
+		rule.DurationSeconds,
+		rule.ChangeThreshold,
+		rule.Hysteresis,
 		rule.Enabled,
 		rule.ID,
 	)
@@ -562,11 +880,16 @@ func (r *AlertRepositoryImpl) GetAlertHistory(ctx context.Context, sensorID int,
 		SELECT 
 			ash.id, 
 			ash.sensor_id, 
-			sar.alert_type, 
+			COALESCE(sar.alert_type, 'compound'), 
 			ash.reading_value, 
-			ash.sent_at
+			ash.sent_at,
+			ash.resolved_at,
+			COALESCE(ash.peak_value, ash.reading_value),
+			ash.acknowledged_at,
+			u.username
 		FROM alert_sent_history ash
-		JOIN sensor_alert_rules sar ON ash.alert_rule_id = sar.id
+		LEFT JOIN sensor_alert_rules sar ON ash.alert_rule_id = sar.id
+		LEFT JOIN users u ON ash.acknowledged_by = u.id
 		WHERE ash.sensor_id = ?
 		ORDER BY ash.sent_at DESC
 		LIMIT ?
@@ -581,13 +904,28 @@ func (r *AlertRepositoryImpl) GetAlertHistory(ctx context.Context, sensorID int,
 	var history []gen.AlertHistoryEntry
 	for rows.Next() {
 		var entry gen.AlertHistoryEntry
-		var readingValue sql.NullFloat64
+		var readingValue, peakValue sql.NullFloat64
 		var sentAt SQLiteTime
-		err := rows.Scan(&entry.Id, &entry.SensorId, &entry.AlertType, &readingValue, &sentAt)
+		var resolvedAt, acknowledgedAt NullSQLiteTime
+		var acknowledgedBy sql.NullString
+		err := rows.Scan(&entry.Id, &entry.SensorId, &entry.AlertType, &readingValue, &sentAt, &resolvedAt, &peakValue, &acknowledgedAt, &acknowledgedBy)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan alert history entry: %w", err)
 		}
 		entry.SentAt = sentAt.Time
+		if resolvedAt.Valid {
+			entry.ResolvedAt = &resolvedAt.Time
+		}
+		if peakValue.Valid {
+			peak := fmt.Sprintf("%.2f", peakValue.Float64)
+			entry.PeakValue = &peak
+		}
+		if acknowledgedAt.Valid {
+			entry.AcknowledgedAt = &acknowledgedAt.Time
+		}
+		if acknowledgedBy.Valid {
+			entry.AcknowledgedBy = &acknowledgedBy.String
+		}
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan alert history entry: %w", err)
 		}
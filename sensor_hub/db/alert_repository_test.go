@@ -42,12 +42,12 @@ func (m *MockAlertRepository) GetAlertRulesBySensorID(ctx context.Context, senso
 	return args.Get(0).([]alerting.AlertRule), args.Error(1)
 }
 
-func (m *MockAlertRepository) GetAlertRuleForReading(ctx context.Context, sensorID int, measurementTypeName string) (*alerting.AlertRule, error) {
+func (m *MockAlertRepository) GetAlertRulesForReading(ctx context.Context, sensorID int, measurementTypeName string) ([]alerting.AlertRule, error) {
 	args := m.Called(ctx, sensorID, measurementTypeName)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
-	return args.Get(0).(*alerting.AlertRule), args.Error(1)
+	return args.Get(0).([]alerting.AlertRule), args.Error(1)
 }
 
 func (m *MockAlertRepository) UpdateLastAlertSent(ctx context.Context, ruleID int) error {
@@ -60,6 +60,29 @@ func (m *MockAlertRepository) RecordAlertSent(ctx context.Context, ruleID, senso
 	return args.Error(0)
 }
 
+func (m *MockAlertRepository) MarkAlertFiring(ctx context.Context, ruleID int) (bool, error) {
+	args := m.Called(ctx, ruleID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockAlertRepository) UpdateAlertPeak(ctx context.Context, ruleID int, value float64, higher bool) error {
+	args := m.Called(ctx, ruleID, value, higher)
+	return args.Error(0)
+}
+
+func (m *MockAlertRepository) MarkAlertRateLimited(ctx context.Context, ruleID int) error {
+	args := m.Called(ctx, ruleID)
+	return args.Error(0)
+}
+
+func (m *MockAlertRepository) ResolveAlert(ctx context.Context, ruleID int) (*alerting.AlertEpisode, error) {
+	args := m.Called(ctx, ruleID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*alerting.AlertEpisode), args.Error(1)
+}
+
 func (m *MockAlertRepository) GetAllAlertRules(ctx context.Context) ([]alerting.AlertRule, error) {
 	args := m.Called(ctx)
 	return args.Get(0).([]alerting.AlertRule), args.Error(1)
@@ -106,6 +129,247 @@ func (m *MockAlertRepository) GetAlertRule(ctx context.Context, sensorID, measur
 	return args.Get(0).(*alerting.AlertRule), args.Error(1)
 }
 
+func (m *MockAlertRepository) GetAllCompoundAlertRules(ctx context.Context) ([]alerting.CompoundAlertRule, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]alerting.CompoundAlertRule), args.Error(1)
+}
+
+func (m *MockAlertRepository) GetCompoundAlertRuleByID(ctx context.Context, ruleID int) (*alerting.CompoundAlertRule, error) {
+	args := m.Called(ctx, ruleID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*alerting.CompoundAlertRule), args.Error(1)
+}
+
+func (m *MockAlertRepository) GetCompoundAlertRulesForReading(ctx context.Context, sensorID int, measurementTypeName string) ([]alerting.CompoundAlertRule, error) {
+	args := m.Called(ctx, sensorID, measurementTypeName)
+	return args.Get(0).([]alerting.CompoundAlertRule), args.Error(1)
+}
+
+func (m *MockAlertRepository) CreateCompoundAlertRule(ctx context.Context, rule *alerting.CompoundAlertRule) error {
+	args := m.Called(ctx, rule)
+	return args.Error(0)
+}
+
+func (m *MockAlertRepository) UpdateCompoundAlertRule(ctx context.Context, rule *alerting.CompoundAlertRule) error {
+	args := m.Called(ctx, rule)
+	return args.Error(0)
+}
+
+func (m *MockAlertRepository) DeleteCompoundAlertRule(ctx context.Context, ruleID int) error {
+	args := m.Called(ctx, ruleID)
+	return args.Error(0)
+}
+
+func (m *MockAlertRepository) RecordCompoundAlertSent(ctx context.Context, ruleID, sensorID, measurementTypeId int, reason string, numericValue float64, statusValue string) error {
+	args := m.Called(ctx, ruleID, sensorID, measurementTypeId, reason, numericValue, statusValue)
+	return args.Error(0)
+}
+
+func (m *MockAlertRepository) MarkCompoundAlertFiring(ctx context.Context, ruleID int) (bool, error) {
+	args := m.Called(ctx, ruleID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockAlertRepository) MarkCompoundAlertRateLimited(ctx context.Context, ruleID int) error {
+	args := m.Called(ctx, ruleID)
+	return args.Error(0)
+}
+
+func (m *MockAlertRepository) ResolveCompoundAlert(ctx context.Context, ruleID int) (*alerting.AlertEpisode, error) {
+	args := m.Called(ctx, ruleID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*alerting.AlertEpisode), args.Error(1)
+}
+
+func (m *MockAlertRepository) AcknowledgeCompoundAlertRule(ctx context.Context, ruleID, userID int) (bool, error) {
+	args := m.Called(ctx, ruleID, userID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockAlertRepository) SnoozeCompoundAlertRule(ctx context.Context, ruleID int, until *time.Time) error {
+	args := m.Called(ctx, ruleID, until)
+	return args.Error(0)
+}
+
+func (m *MockAlertRepository) AcknowledgeAlertRule(ctx context.Context, ruleID, userID int) (bool, error) {
+	args := m.Called(ctx, ruleID, userID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockAlertRepository) AcknowledgeAlertHistoryEntry(ctx context.Context, entryID, userID int) (bool, error) {
+	args := m.Called(ctx, entryID, userID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockAlertRepository) SnoozeAlertRule(ctx context.Context, ruleID int, until *time.Time) error {
+	args := m.Called(ctx, ruleID, until)
+	return args.Error(0)
+}
+
+func (m *MockAlertRepository) GetAllSilenceWindows(ctx context.Context) ([]alerting.SilenceWindow, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]alerting.SilenceWindow), args.Error(1)
+}
+
+func (m *MockAlertRepository) GetSilenceWindowByID(ctx context.Context, windowID int) (*alerting.SilenceWindow, error) {
+	args := m.Called(ctx, windowID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*alerting.SilenceWindow), args.Error(1)
+}
+
+func (m *MockAlertRepository) GetSilenceWindowsForSensor(ctx context.Context, sensorID int) ([]alerting.SilenceWindow, error) {
+	args := m.Called(ctx, sensorID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]alerting.SilenceWindow), args.Error(1)
+}
+
+func (m *MockAlertRepository) CreateSilenceWindow(ctx context.Context, window *alerting.SilenceWindow) error {
+	args := m.Called(ctx, window)
+	return args.Error(0)
+}
+
+func (m *MockAlertRepository) UpdateSilenceWindow(ctx context.Context, window *alerting.SilenceWindow) error {
+	args := m.Called(ctx, window)
+	return args.Error(0)
+}
+
+func (m *MockAlertRepository) DeleteSilenceWindow(ctx context.Context, windowID int) error {
+	args := m.Called(ctx, windowID)
+	return args.Error(0)
+}
+
+func (m *MockAlertRepository) GetEscalationSteps(ctx context.Context, ruleID int) ([]alerting.EscalationStep, error) {
+	args := m.Called(ctx, ruleID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]alerting.EscalationStep), args.Error(1)
+}
+
+func (m *MockAlertRepository) SetEscalationSteps(ctx context.Context, ruleID int, steps []alerting.EscalationStep) error {
+	args := m.Called(ctx, ruleID, steps)
+	return args.Error(0)
+}
+
+func (m *MockAlertRepository) StartEscalation(ctx context.Context, ruleID, notificationID int, dueAt time.Time) error {
+	args := m.Called(ctx, ruleID, notificationID, dueAt)
+	return args.Error(0)
+}
+
+func (m *MockAlertRepository) GetDueEscalations(ctx context.Context, now time.Time) ([]alerting.Escalation, error) {
+	args := m.Called(ctx, now)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]alerting.Escalation), args.Error(1)
+}
+
+func (m *MockAlertRepository) AdvanceEscalation(ctx context.Context, escalationID, notificationID, nextStep int, dueAt time.Time) error {
+	args := m.Called(ctx, escalationID, notificationID, nextStep, dueAt)
+	return args.Error(0)
+}
+
+func (m *MockAlertRepository) EndEscalation(ctx context.Context, escalationID int) error {
+	args := m.Called(ctx, escalationID)
+	return args.Error(0)
+}
+
+func (m *MockAlertRepository) GetAlertRecipients(ctx context.Context, ruleID int) (alerting.AlertRecipients, error) {
+	args := m.Called(ctx, ruleID)
+	return args.Get(0).(alerting.AlertRecipients), args.Error(1)
+}
+
+func (m *MockAlertRepository) SetAlertRecipients(ctx context.Context, ruleID int, recipients alerting.AlertRecipients) error {
+	args := m.Called(ctx, ruleID, recipients)
+	return args.Error(0)
+}
+
+func (m *MockAlertRepository) GetAlertRecipientUserIDs(ctx context.Context, ruleID int) ([]int, bool, error) {
+	args := m.Called(ctx, ruleID)
+	if args.Get(0) == nil {
+		return nil, args.Bool(1), args.Error(2)
+	}
+	return args.Get(0).([]int), args.Bool(1), args.Error(2)
+}
+
+func (m *MockAlertRepository) GetCompoundEscalationSteps(ctx context.Context, ruleID int) ([]alerting.EscalationStep, error) {
+	args := m.Called(ctx, ruleID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]alerting.EscalationStep), args.Error(1)
+}
+
+func (m *MockAlertRepository) SetCompoundEscalationSteps(ctx context.Context, ruleID int, steps []alerting.EscalationStep) error {
+	args := m.Called(ctx, ruleID, steps)
+	return args.Error(0)
+}
+
+func (m *MockAlertRepository) StartCompoundEscalation(ctx context.Context, ruleID, notificationID int, dueAt time.Time) error {
+	args := m.Called(ctx, ruleID, notificationID, dueAt)
+	return args.Error(0)
+}
+
+func (m *MockAlertRepository) GetCompoundAlertRecipients(ctx context.Context, ruleID int) (alerting.AlertRecipients, error) {
+	args := m.Called(ctx, ruleID)
+	return args.Get(0).(alerting.AlertRecipients), args.Error(1)
+}
+
+func (m *MockAlertRepository) SetCompoundAlertRecipients(ctx context.Context, ruleID int, recipients alerting.AlertRecipients) error {
+	args := m.Called(ctx, ruleID, recipients)
+	return args.Error(0)
+}
+
+func (m *MockAlertRepository) GetCompoundAlertRecipientUserIDs(ctx context.Context, ruleID int) ([]int, bool, error) {
+	args := m.Called(ctx, ruleID)
+	if args.Get(0) == nil {
+		return nil, args.Bool(1), args.Error(2)
+	}
+	return args.Get(0).([]int), args.Bool(1), args.Error(2)
+}
+
+func (m *MockAlertRepository) GetAllContactGroups(ctx context.Context) ([]alerting.ContactGroup, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]alerting.ContactGroup), args.Error(1)
+}
+
+func (m *MockAlertRepository) GetContactGroupByID(ctx context.Context, groupID int) (*alerting.ContactGroup, error) {
+	args := m.Called(ctx, groupID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*alerting.ContactGroup), args.Error(1)
+}
+
+func (m *MockAlertRepository) CreateContactGroup(ctx context.Context, group *alerting.ContactGroup) error {
+	args := m.Called(ctx, group)
+	return args.Error(0)
+}
+
+func (m *MockAlertRepository) UpdateContactGroup(ctx context.Context, group *alerting.ContactGroup) error {
+	args := m.Called(ctx, group)
+	return args.Error(0)
+}
+
+func (m *MockAlertRepository) DeleteContactGroup(ctx context.Context, groupID int) error {
+	args := m.Called(ctx, groupID)
+	return args.Error(0)
+}
+
 // ============================================================================
 // GetAlertRuleBySensorID tests (implementation)
 // ============================================================================
@@ -118,7 +382,7 @@ func TestAlertRepository_GetAlertRuleBySensorID_Success(t *testing.T) {
 	dbMock.ExpectQuery("SELECT").
 		WithArgs(5).
 		WillReturnRows(sqlmock.NewRows(alertRuleColumns).
-			AddRow(1, 5, "sensor-1", 1, "temperature", "numeric_range", 30.0, 10.0, nil, true, 1, now))
+			AddRow(1, 5, "sensor-1", 1, "temperature", "numeric_range", 30.0, 10.0, nil, true, 1, 0, 0.0, 0.0, "ok", nil, now))
 
 	rule, err := repo.GetAlertRuleBySensorID(context.Background(), 5)
 
@@ -156,7 +420,7 @@ func TestAlertRepository_GetAlertRuleBySensorID_NullLastAlertSent(t *testing.T)
 	dbMock.ExpectQuery("SELECT").
 		WithArgs(5).
 		WillReturnRows(sqlmock.NewRows(alertRuleColumns).
-			AddRow(1, 5, "sensor-1", 1, "temperature", "numeric_range", 30.0, 10.0, nil, true, 1, nil))
+			AddRow(1, 5, "sensor-1", 1, "temperature", "numeric_range", 30.0, 10.0, nil, true, 1, 0, 0.0, 0.0, "ok", nil, nil))
 
 	rule, err := repo.GetAlertRuleBySensorID(context.Background(), 5)
 
@@ -173,7 +437,7 @@ func TestAlertRepository_GetAlertRuleBySensorID_WithTriggerStatus(t *testing.T)
 	dbMock.ExpectQuery("SELECT").
 		WithArgs(5).
 		WillReturnRows(sqlmock.NewRows(alertRuleColumns).
-			AddRow(1, 5, "sensor-1", 1, "contact", "status_based", 0.0, 0.0, "bad", true, 1, nil))
+			AddRow(1, 5, "sensor-1", 1, "contact", "status_based", 0.0, 0.0, "bad", true, 1, 0, 0.0, 0.0, "ok", nil, nil))
 
 	rule, err := repo.GetAlertRuleBySensorID(context.Background(), 5)
 
@@ -200,6 +464,30 @@ func TestAlertRepository_GetAlertRuleBySensorID_DBError(t *testing.T) {
 	assert.NoError(t, dbMock.ExpectationsWereMet())
 }
 
+// ============================================================================
+// GetAlertRulesForReading tests
+// ============================================================================
+
+func TestAlertRepository_GetAlertRulesForReading_ReturnsEveryType(t *testing.T) {
+	db, dbMock := newMockDB(t)
+	repo := NewAlertRepository(db, slog.Default())
+
+	dbMock.ExpectQuery("SELECT").
+		WithArgs(5, "temperature").
+		WillReturnRows(sqlmock.NewRows(alertRuleColumns).
+			AddRow(1, 5, "freezer", 1, "temperature", "numeric_range", -10.0, -30.0, nil, true, 0, 0, 0.0, 0.0, "ok", nil, nil).
+			AddRow(2, 5, "freezer", 1, "temperature", "rate_of_change", 0.0, 0.0, nil, true, 0, 900, 3.0, 0.0, "ok", nil, nil))
+
+	rules, err := repo.GetAlertRulesForReading(context.Background(), 5, "temperature")
+
+	require.NoError(t, err)
+	require.Len(t, rules, 2)
+	assert.Equal(t, alerting.AlertTypeRateOfChange, rules[1].AlertType)
+	assert.Equal(t, 900, rules[1].DurationSeconds)
+	assert.Equal(t, 3.0, rules[1].ChangeThreshold)
+	assert.NoError(t, dbMock.ExpectationsWereMet())
+}
+
 // ============================================================================
 // RecordAlertSent tests
 // ============================================================================
@@ -258,8 +546,8 @@ func TestAlertRepository_GetAllAlertRules_Success(t *testing.T) {
 	now := time.Now()
 	dbMock.ExpectQuery("SELECT").
 		WillReturnRows(sqlmock.NewRows(alertRuleColumns).
-			AddRow(1, 1, "sensor-1", 1, "temperature", "numeric_range", 30.0, 10.0, nil, true, 1, now).
-			AddRow(2, 2, "sensor-2", 1, "temperature", "status_based", 0.0, 0.0, "bad", true, 2, nil))
+			AddRow(1, 1, "sensor-1", 1, "temperature", "numeric_range", 30.0, 10.0, nil, true, 1, 0, 0.0, 0.0, "ok", nil, now).
+			AddRow(2, 2, "sensor-2", 1, "temperature", "status_based", 0.0, 0.0, "bad", true, 2, 0, 0.0, 0.0, "ok", nil, nil))
 
 	rules, err := repo.GetAllAlertRules(context.Background())
 
@@ -310,7 +598,7 @@ func TestAlertRepository_GetAlertRuleBySensorName_Success(t *testing.T) {
 	dbMock.ExpectQuery("SELECT").
 		WithArgs("sensor-1").
 		WillReturnRows(sqlmock.NewRows(alertRuleColumns).
-			AddRow(1, 1, "sensor-1", 1, "temperature", "numeric_range", 30.0, 10.0, nil, true, 1, nil))
+			AddRow(1, 1, "sensor-1", 1, "temperature", "numeric_range", 30.0, 10.0, nil, true, 1, 0, 0.0, 0.0, "ok", nil, nil))
 
 	rule, err := repo.GetAlertRuleBySensorName(context.Background(), "sensor-1")
 
@@ -371,7 +659,7 @@ func TestAlertRepository_CreateAlertRule_Success(t *testing.T) {
 	}
 
 	dbMock.ExpectExec("INSERT INTO sensor_alert_rules").
-		WithArgs(1, 1, alerting.AlertTypeNumericRange, 30.0, 10.0, "", 1, true).
+		WithArgs(1, 1, alerting.AlertTypeNumericRange, 30.0, 10.0, "", 1, 0, 0.0, 0.0, true).
 		WillReturnResult(sqlmock.NewResult(1, 1))
 
 	err := repo.CreateAlertRule(context.Background(), rule)
@@ -394,7 +682,7 @@ func TestAlertRepository_CreateAlertRule_StatusBased(t *testing.T) {
 	}
 
 	dbMock.ExpectExec("INSERT INTO sensor_alert_rules").
-		WithArgs(1, 1, alerting.AlertTypeStatusBased, 0.0, 0.0, "bad", 2, true).
+		WithArgs(1, 1, alerting.AlertTypeStatusBased, 0.0, 0.0, "bad", 2, 0, 0.0, 0.0, true).
 		WillReturnResult(sqlmock.NewResult(1, 1))
 
 	err := repo.CreateAlertRule(context.Background(), rule)
@@ -414,7 +702,7 @@ func TestAlertRepository_CreateAlertRule_DBError(t *testing.T) {
 	}
 
 	dbMock.ExpectExec("INSERT INTO sensor_alert_rules").
-		WithArgs(1, 1, alerting.AlertTypeNumericRange, 0.0, 0.0, "", 0, false).
+		WithArgs(1, 1, alerting.AlertTypeNumericRange, 0.0, 0.0, "", 0, 0, 0.0, 0.0, false).
 		WillReturnError(errors.New("duplicate entry"))
 
 	err := repo.CreateAlertRule(context.Background(), rule)
@@ -443,7 +731,7 @@ func TestAlertRepository_UpdateAlertRule_Success(t *testing.T) {
 	}
 
 	dbMock.ExpectExec("UPDATE sensor_alert_rules").
-		WithArgs(alerting.AlertTypeNumericRange, 35.0, 12.0, "", 2, false, 1).
+		WithArgs(alerting.AlertTypeNumericRange, 35.0, 12.0, "", 2, 0, 0.0, 0.0, false, 1).
 		WillReturnResult(sqlmock.NewResult(0, 1))
 
 	err := repo.UpdateAlertRule(context.Background(), rule)
@@ -461,7 +749,7 @@ func TestAlertRepository_UpdateAlertRule_DBError(t *testing.T) {
 	}
 
 	dbMock.ExpectExec("UPDATE sensor_alert_rules").
-		WithArgs(alerting.AlertType(""), 0.0, 0.0, "", 0, false, 1).
+		WithArgs(alerting.AlertType(""), 0.0, 0.0, "", 0, 0, 0.0, 0.0, false, 1).
 		WillReturnError(errors.New("database error"))
 
 	err := repo.UpdateAlertRule(context.Background(), rule)
@@ -518,6 +806,98 @@ func TestAlertRepository_DeleteAlertRule_DBError(t *testing.T) {
 	assert.NoError(t, dbMock.ExpectationsWereMet())
 }
 
+// ============================================================================
+// Alert state tests
+// ============================================================================
+
+func TestAlertRepository_MarkAlertFiring_Transitions(t *testing.T) {
+	db, dbMock := newMockDB(t)
+	repo := NewAlertRepository(db, slog.Default())
+
+	dbMock.ExpectExec("UPDATE sensor_alert_rules SET state").
+		WithArgs(alerting.AlertStateFiring, 1, alerting.AlertStateFiring).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	marked, err := repo.MarkAlertFiring(context.Background(), 1)
+
+	assert.NoError(t, err)
+	assert.True(t, marked)
+	assert.NoError(t, dbMock.ExpectationsWereMet())
+}
+
+func TestAlertRepository_MarkAlertFiring_AlreadyFiring(t *testing.T) {
+	db, dbMock := newMockDB(t)
+	repo := NewAlertRepository(db, slog.Default())
+
+	dbMock.ExpectExec("UPDATE sensor_alert_rules SET state").
+		WithArgs(alerting.AlertStateFiring, 1, alerting.AlertStateFiring).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	marked, err := repo.MarkAlertFiring(context.Background(), 1)
+
+	assert.NoError(t, err)
+	assert.False(t, marked)
+	assert.NoError(t, dbMock.ExpectationsWereMet())
+}
+
+func TestAlertRepository_ResolveAlert_NotFiring(t *testing.T) {
+	db, dbMock := newMockDB(t)
+	repo := NewAlertRepository(db, slog.Default())
+
+	dbMock.ExpectBegin()
+	dbMock.ExpectExec("UPDATE sensor_alert_rules SET state").
+		WithArgs(alerting.AlertStateResolved, 1, alerting.AlertStateFiring).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	dbMock.ExpectRollback()
+
+	episode, err := repo.ResolveAlert(context.Background(), 1)
+
+	assert.NoError(t, err)
+	assert.Nil(t, episode)
+	assert.NoError(t, dbMock.ExpectationsWereMet())
+}
+
+func TestAlertRepository_ResolveAlert_ClosesEpisode(t *testing.T) {
+	db, dbMock := newMockDB(t)
+	repo := NewAlertRepository(db, slog.Default())
+
+	startedAt := time.Now().UTC().Add(-2 * time.Hour).Truncate(time.Second)
+	dbMock.ExpectBegin()
+	dbMock.ExpectExec("UPDATE sensor_alert_rules SET state").
+		WithArgs(alerting.AlertStateResolved, 1, alerting.AlertStateFiring).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	dbMock.ExpectQuery("SELECT sent_at").
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"sent_at", "peak", "acknowledged", "rate_limited"}).AddRow(startedAt, -6.5, true, false))
+	dbMock.ExpectExec("UPDATE alert_sent_history SET resolved_at").
+		WithArgs(sqlmock.AnyArg(), 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	dbMock.ExpectCommit()
+
+	episode, err := repo.ResolveAlert(context.Background(), 1)
+
+	require.NoError(t, err)
+	require.NotNil(t, episode)
+	assert.Equal(t, startedAt, episode.StartedAt)
+	assert.Equal(t, -6.5, episode.PeakValue)
+	assert.True(t, episode.Acknowledged)
+	assert.False(t, episode.RateLimited)
+	assert.GreaterOrEqual(t, episode.Duration(), 2*time.Hour)
+	assert.NoError(t, dbMock.ExpectationsWereMet())
+}
+
+func TestAlertRepository_MarkAlertRateLimited(t *testing.T) {
+	db, dbMock := newMockDB(t)
+	repo := NewAlertRepository(db, slog.Default())
+
+	dbMock.ExpectExec("UPDATE alert_sent_history SET rate_limited = 1").
+		WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	assert.NoError(t, repo.MarkAlertRateLimited(context.Background(), 1))
+	assert.NoError(t, dbMock.ExpectationsWereMet())
+}
+
 // ============================================================================
 // GetAlertHistory tests
 // ============================================================================
@@ -530,14 +910,21 @@ func TestAlertRepository_GetAlertHistory_Success(t *testing.T) {
 	dbMock.ExpectQuery("SELECT").
 		WithArgs(1, 10).
 		WillReturnRows(sqlmock.NewRows(alertHistoryColumns).
-			AddRow(1, 1, "numeric_range", 35.5, now).
-			AddRow(2, 1, "numeric_range", 36.0, now.Add(-time.Hour)))
+			AddRow(1, 1, "numeric_range", 35.5, now, nil, 35.5, now, "alice").
+			AddRow(2, 1, "numeric_range", 36.0, now.Add(-time.Hour), now.Add(-30*time.Minute), 38.2, nil, nil))
 
 	history, err := repo.GetAlertHistory(context.Background(), 1, 10)
 
 	assert.NoError(t, err)
 	assert.Len(t, history, 2)
 	assert.Equal(t, "35.50", history[0].ReadingValue)
+	assert.Nil(t, history[0].ResolvedAt)
+	require.NotNil(t, history[0].AcknowledgedBy)
+	assert.Equal(t, "alice", *history[0].AcknowledgedBy)
+	assert.Nil(t, history[1].AcknowledgedAt)
+	require.NotNil(t, history[1].ResolvedAt)
+	require.NotNil(t, history[1].PeakValue)
+	assert.Equal(t, "38.20", *history[1].PeakValue)
 	assert.NoError(t, dbMock.ExpectationsWereMet())
 }
 
@@ -564,7 +951,7 @@ func TestAlertRepository_GetAlertHistory_NullReadingValue(t *testing.T) {
 	dbMock.ExpectQuery("SELECT").
 		WithArgs(1, 10).
 		WillReturnRows(sqlmock.NewRows(alertHistoryColumns).
-			AddRow(1, 1, "status_based", nil, now))
+			AddRow(1, 1, "status_based", nil, now, now, nil, nil, nil))
 
 	history, err := repo.GetAlertHistory(context.Background(), 1, 10)
 
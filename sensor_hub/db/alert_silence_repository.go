@@ -0,0 +1,128 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"example/sensorHub/alerting"
+)
+
+const silenceWindowSelect = `
+	SELECT
+		w.id,
+		w.name,
+		w.sensor_id,
+		s.name,
+		w.days,
+		w.start_time,
+		w.end_time,
+		w.enabled
+	FROM alert_silence_windows w
+	LEFT JOIN sensors s ON w.sensor_id = s.id
+`
+
+func (r *AlertRepositoryImpl) GetAllSilenceWindows(ctx context.Context) ([]alerting.SilenceWindow, error) {
+	windows, err := r.querySilenceWindows(ctx, silenceWindowSelect+` ORDER BY w.id`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get silence windows: %w", err)
+	}
+	return windows, nil
+}
+
+func (r *AlertRepositoryImpl) GetSilenceWindowByID(ctx context.Context, windowID int) (*alerting.SilenceWindow, error) {
+	windows, err := r.querySilenceWindows(ctx, silenceWindowSelect+` WHERE w.id = ?`, windowID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get silence window %d: %w", windowID, err)
+	}
+	if len(windows) == 0 {
+		return nil, nil
+	}
+	return &windows[0], nil
+}
+
+// GetSilenceWindowsForSensor returns the enabled silence windows that apply to the
+// sensor, including those that apply to every sensor.
+func (r *AlertRepositoryImpl) GetSilenceWindowsForSensor(ctx context.Context, sensorID int) ([]alerting.SilenceWindow, error) {
+	query := silenceWindowSelect + `
+		WHERE w.enabled = TRUE AND (w.sensor_id IS NULL OR w.sensor_id = ?)
+		ORDER BY w.id
+	`
+	windows, err := r.querySilenceWindows(ctx, query, sensorID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get silence windows for sensor %d: %w", sensorID, err)
+	}
+	return windows, nil
+}
+
+func (r *AlertRepositoryImpl) querySilenceWindows(ctx context.Context, query string, args ...any) ([]alerting.SilenceWindow, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var windows []alerting.SilenceWindow
+	for rows.Next() {
+		var w alerting.SilenceWindow
+		var sensorID sql.NullInt64
+		var sensorName sql.NullString
+		var days string
+		if err := rows.Scan(&w.ID, &w.Name, &sensorID, &sensorName, &days, &w.StartTime, &w.EndTime, &w.Enabled); err != nil {
+			return nil, fmt.Errorf("failed to scan silence window: %w", err)
+		}
+		if sensorID.Valid {
+			id := int(sensorID.Int64)
+			w.SensorID = &id
+		}
+		w.SensorName = sensorName.String
+		w.Days = []string{}
+		if days != "" {
+			w.Days = strings.Split(days, ",")
+		}
+		windows = append(windows, w)
+	}
+	return windows, rows.Err()
+}
+
+func (r *AlertRepositoryImpl) CreateSilenceWindow(ctx context.Context, window *alerting.SilenceWindow) error {
+	result, err := r.db.ExecContext(ctx, `
+		INSERT INTO alert_silence_windows (name, sensor_id, days, start_time, end_time, enabled)
+		VALUES (?, ?, ?, ?, ?, ?)
+	`, window.Name, window.SensorID, strings.Join(window.Days, ","), window.StartTime, window.EndTime, window.Enabled)
+	if err != nil {
+		return fmt.Errorf("failed to create silence window: %w", err)
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return fmt.Errorf("failed to get silence window ID: %w", err)
+	}
+	window.ID = int(id)
+	return nil
+}
+
+func (r *AlertRepositoryImpl) UpdateSilenceWindow(ctx context.Context, window *alerting.SilenceWindow) error {
+	_, err := r.db.ExecContext(ctx, `
+		UPDATE alert_silence_windows
+		SET name = ?,
+			sensor_id = ?,
+			days = ?,
+			start_time = ?,
+			end_time = ?,
+			enabled = ?,
+			updated_at = datetime('now')
+		WHERE id = ?
+	`, window.Name, window.SensorID, strings.Join(window.Days, ","), window.StartTime, window.EndTime, window.Enabled, window.ID)
+	if err != nil {
+		return fmt.Errorf("failed to update silence window: %w", err)
+	}
+	return nil
+}
+
+func (r *AlertRepositoryImpl) DeleteSilenceWindow(ctx context.Context, windowID int) error {
+	if _, err := r.db.ExecContext(ctx, `DELETE FROM alert_silence_windows WHERE id = ?`, windowID); err != nil {
+		return fmt.Errorf("failed to delete silence window: %w", err)
+	}
+	return nil
+}
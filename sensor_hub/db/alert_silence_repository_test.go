@@ -0,0 +1,101 @@
+package database
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"example/sensorHub/alerting"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var silenceWindowColumns = []string{"id", "name", "sensor_id", "name", "days", "start_time", "end_time", "enabled"}
+
+func TestAlertRepository_GetSilenceWindowsForSensor_Success(t *testing.T) {
+	db, dbMock := newMockDB(t)
+	repo := NewAlertRepository(db, slog.Default())
+
+	dbMock.ExpectQuery("FROM alert_silence_windows").
+		WithArgs(3).
+		WillReturnRows(sqlmock.NewRows(silenceWindowColumns).
+			AddRow(1, "Garage door mornings", 3, "garage-door", "mon,tue,wed,thu,fri", "07:00", "09:00", true).
+			AddRow(2, "Overnight", nil, nil, "", "23:00", "06:00", true))
+
+	windows, err := repo.GetSilenceWindowsForSensor(context.Background(), 3)
+
+	require.NoError(t, err)
+	require.Len(t, windows, 2)
+	assert.Equal(t, 3, *windows[0].SensorID)
+	assert.Equal(t, "garage-door", windows[0].SensorName)
+	assert.Equal(t, []string{"mon", "tue", "wed", "thu", "fri"}, windows[0].Days)
+	assert.Nil(t, windows[1].SensorID)
+	assert.Empty(t, windows[1].Days)
+	assert.NoError(t, dbMock.ExpectationsWereMet())
+}
+
+func TestAlertRepository_CreateSilenceWindow_Success(t *testing.T) {
+	db, dbMock := newMockDB(t)
+	repo := NewAlertRepository(db, slog.Default())
+	sensorID := 3
+	window := alerting.SilenceWindow{
+		Name: "Garage door mornings", SensorID: &sensorID, Days: []string{"mon", "fri"},
+		StartTime: "07:00", EndTime: "09:00", Enabled: true,
+	}
+
+	dbMock.ExpectExec("INSERT INTO alert_silence_windows").
+		WithArgs("Garage door mornings", &sensorID, "mon,fri", "07:00", "09:00", true).
+		WillReturnResult(sqlmock.NewResult(5, 1))
+
+	err := repo.CreateSilenceWindow(context.Background(), &window)
+
+	require.NoError(t, err)
+	assert.Equal(t, 5, window.ID)
+	assert.NoError(t, dbMock.ExpectationsWereMet())
+}
+
+func TestAlertRepository_GetSilenceWindowByID_NotFound(t *testing.T) {
+	db, dbMock := newMockDB(t)
+	repo := NewAlertRepository(db, slog.Default())
+
+	dbMock.ExpectQuery("FROM alert_silence_windows").
+		WithArgs(99).
+		WillReturnRows(sqlmock.NewRows(silenceWindowColumns))
+
+	window, err := repo.GetSilenceWindowByID(context.Background(), 99)
+
+	assert.NoError(t, err)
+	assert.Nil(t, window)
+	assert.NoError(t, dbMock.ExpectationsWereMet())
+}
+
+func TestAlertRepository_AcknowledgeAlertRule_NotFiring(t *testing.T) {
+	db, dbMock := newMockDB(t)
+	repo := NewAlertRepository(db, slog.Default())
+
+	dbMock.ExpectExec("UPDATE alert_sent_history").
+		WithArgs(7, 3).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	acknowledged, err := repo.AcknowledgeAlertRule(context.Background(), 3, 7)
+
+	assert.NoError(t, err)
+	assert.False(t, acknowledged)
+	assert.NoError(t, dbMock.ExpectationsWereMet())
+}
+
+func TestAlertRepository_SnoozeAlertRule_StoresUTC(t *testing.T) {
+	db, dbMock := newMockDB(t)
+	repo := NewAlertRepository(db, slog.Default())
+	until := time.Date(2025, 6, 2, 9, 30, 0, 0, time.FixedZone("CEST", 2*60*60))
+
+	dbMock.ExpectExec("UPDATE sensor_alert_rules SET snoozed_until").
+		WithArgs("2025-06-02 07:30:00", 3).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	require.NoError(t, repo.SnoozeAlertRule(context.Background(), 3, &until))
+	assert.NoError(t, dbMock.ExpectationsWereMet())
+}
@@ -0,0 +1,298 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"example/sensorHub/automation"
+	"example/sensorHub/scheduling"
+)
+
+const automationRuleSelect = `
+	SELECT
+		ar.id,
+		ar.name,
+		ar.enabled,
+		ar.trigger_type,
+		ar.sensor_id,
+		s.name,
+		ar.measurement_type_id,
+		mt.name,
+		ar.comparison,
+		ar.threshold,
+		ar.status,
+		ar.alert_rule_id,
+		ar.schedule_time,
+		ar.schedule_days,
+		ar.schedule_holiday,
+		ar.target_sensor_id,
+		ts.name,
+		ar.scene_id,
+		sc.name,
+		ar.property,
+		ar.value,
+		ar.active,
+		ar.last_triggered_at
+	FROM automation_rules ar
+	LEFT JOIN sensors s ON ar.sensor_id = s.id
+	LEFT JOIN measurement_types mt ON ar.measurement_type_id = mt.id
+	LEFT JOIN sensors ts ON ar.target_sensor_id = ts.id
+	LEFT JOIN scenes sc ON ar.scene_id = sc.id
+`
+
+type SqlAutomationRepository struct {
+	db     *sql.DB
+	logger *slog.Logger
+}
+
+func NewAutomationRepository(db *sql.DB, logger *slog.Logger) *SqlAutomationRepository {
+	return &SqlAutomationRepository{
+		db:     db,
+		logger: logger.With("component", "automation_repository"),
+	}
+}
+
+func (r *SqlAutomationRepository) GetAllAutomationRules(ctx context.Context) ([]automation.Rule, error) {
+	rules, err := r.queryRules(ctx, automationRuleSelect+` ORDER BY ar.id`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get automation rules: %w", err)
+	}
+	return rules, nil
+}
+
+func (r *SqlAutomationRepository) GetAutomationRuleByID(ctx context.Context, ruleID int) (*automation.Rule, error) {
+	rules, err := r.queryRules(ctx, automationRuleSelect+` WHERE ar.id = ?`, ruleID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get automation rule %d: %w", ruleID, err)
+	}
+	if len(rules) == 0 {
+		return nil, nil
+	}
+	return &rules[0], nil
+}
+
+func (r *SqlAutomationRepository) CreateAutomationRule(ctx context.Context, rule *automation.Rule) error {
+	result, err := r.db.ExecContext(ctx, `
+		INSERT INTO automation_rules (
+			name, enabled, trigger_type, sensor_id, measurement_type_id, comparison, threshold, status,
+			alert_rule_id, schedule_time, schedule_days, schedule_holiday, target_sensor_id, scene_id, property, value
+		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`, automationRuleArgs(rule)...)
+	if err != nil {
+		return fmt.Errorf("failed to create automation rule: %w", err)
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return fmt.Errorf("failed to get automation rule ID: %w", err)
+	}
+	rule.ID = int(id)
+	rule.Active = false
+	return nil
+}
+
+// UpdateAutomationRule replaces a rule's settings. A reading rule's state is reset so
+// that the next reading is evaluated against the new condition from scratch.
+func (r *SqlAutomationRepository) UpdateAutomationRule(ctx context.Context, rule *automation.Rule) error {
+	args := append(automationRuleArgs(rule), rule.ID)
+	_, err := r.db.ExecContext(ctx, `
+		UPDATE automation_rules
+		SET name = ?,
+			enabled = ?,
+			trigger_type = ?,
+			sensor_id = ?,
+			measurement_type_id = ?,
+			comparison = ?,
+			threshold = ?,
+			status = ?,
+			alert_rule_id = ?,
+			schedule_time = ?,
+			schedule_days = ?,
+			schedule_holiday = ?,
+			target_sensor_id = ?,
+			scene_id = ?,
+			property = ?,
+			value = ?,
+			active = 0,
+			updated_at = datetime('now')
+		WHERE id = ?
+	`, args...)
+	if err != nil {
+		return fmt.Errorf("failed to update automation rule: %w", err)
+	}
+	rule.Active = false
+	return nil
+}
+
+func (r *SqlAutomationRepository) DeleteAutomationRule(ctx context.Context, ruleID int) error {
+	if _, err := r.db.ExecContext(ctx, `DELETE FROM automation_rules WHERE id = ?`, ruleID); err != nil {
+		return fmt.Errorf("failed to delete automation rule: %w", err)
+	}
+	return nil
+}
+
+// GetReadingRulesForSensor returns the enabled reading rules that watch the sensor.
+func (r *SqlAutomationRepository) GetReadingRulesForSensor(ctx context.Context, sensorID int) ([]automation.Rule, error) {
+	query := automationRuleSelect + `
+		WHERE ar.enabled = TRUE AND ar.trigger_type = ? AND ar.sensor_id = ?
+		ORDER BY ar.id
+	`
+	rules, err := r.queryRules(ctx, query, automation.TriggerReading, sensorID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get automation rules for sensor %d: %w", sensorID, err)
+	}
+	return rules, nil
+}
+
+// GetRulesForAlert returns the enabled rules triggered by the alert rule starting to fire.
+func (r *SqlAutomationRepository) GetRulesForAlert(ctx context.Context, alertRuleID int) ([]automation.Rule, error) {
+	query := automationRuleSelect + `
+		WHERE ar.enabled = TRUE AND ar.trigger_type = ? AND ar.alert_rule_id = ?
+		ORDER BY ar.id
+	`
+	rules, err := r.queryRules(ctx, query, automation.TriggerAlert, alertRuleID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get automation rules for alert rule %d: %w", alertRuleID, err)
+	}
+	return rules, nil
+}
+
+// GetScheduleRules returns the enabled schedule rules.
+func (r *SqlAutomationRepository) GetScheduleRules(ctx context.Context) ([]automation.Rule, error) {
+	query := automationRuleSelect + `
+		WHERE ar.enabled = TRUE AND ar.trigger_type = ?
+		ORDER BY ar.id
+	`
+	rules, err := r.queryRules(ctx, query, automation.TriggerSchedule)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get schedule automation rules: %w", err)
+	}
+	return rules, nil
+}
+
+// SetRuleActive records whether a reading rule's condition is met. It reports whether
+// the state changed, so that only one of several concurrent readings acts on a change.
+func (r *SqlAutomationRepository) SetRuleActive(ctx context.Context, ruleID int, active bool) (bool, error) {
+	result, err := r.db.ExecContext(ctx,
+		`UPDATE automation_rules SET active = ? WHERE id = ? AND active <> ?`,
+		active, ruleID, active)
+	if err != nil {
+		return false, fmt.Errorf("failed to set automation rule %d state: %w", ruleID, err)
+	}
+	return rowsAffected(result)
+}
+
+func (r *SqlAutomationRepository) RecordRuleTriggered(ctx context.Context, ruleID int, at time.Time) error {
+	if _, err := r.db.ExecContext(ctx,
+		`UPDATE automation_rules SET last_triggered_at = ? WHERE id = ?`,
+		at.UTC().Format("2006-01-02 15:04:05"), ruleID); err != nil {
+		return fmt.Errorf("failed to record automation rule %d trigger: %w", ruleID, err)
+	}
+	return nil
+}
+
+// ClaimScheduledRun records that a schedule rule fired in the minute starting at minute.
+// It reports false when the rule already fired in that minute, such as when an earlier
+// check in the same minute or another instance got there first.
+func (r *SqlAutomationRepository) ClaimScheduledRun(ctx context.Context, ruleID int, minute time.Time) (bool, error) {
+	start := minute.UTC().Format("2006-01-02 15:04:05")
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE automation_rules
+		SET last_triggered_at = ?
+		WHERE id = ? AND (last_triggered_at IS NULL OR last_triggered_at < ?)
+	`, start, ruleID, start)
+	if err != nil {
+		return false, fmt.Errorf("failed to claim scheduled run of automation rule %d: %w", ruleID, err)
+	}
+	return rowsAffected(result)
+}
+
+func automationRuleArgs(rule *automation.Rule) []any {
+	// Rules without a schedule trigger have no holiday behaviour; store the column default.
+	holiday := rule.ScheduleHoliday
+	if holiday == "" {
+		holiday = scheduling.HolidaySkip
+	}
+	return []any{
+		rule.Name, rule.Enabled, rule.TriggerType, rule.SensorID, rule.MeasurementTypeID, rule.Comparison,
+		rule.Threshold, rule.Status, rule.AlertRuleID, rule.ScheduleTime, strings.Join(rule.ScheduleDays, ","),
+		holiday, rule.TargetSensorID, rule.SceneID, rule.Property, rule.Value,
+	}
+}
+
+func (r *SqlAutomationRepository) queryRules(ctx context.Context, query string, args ...any) ([]automation.Rule, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var rules []automation.Rule
+	for rows.Next() {
+		var rule automation.Rule
+		var sensorID, measurementTypeID, alertRuleID, targetSensorID, sceneID sql.NullInt64
+		var sensorName, measurementType, targetSensorName, sceneName sql.NullString
+		var threshold sql.NullFloat64
+		var days string
+		var lastTriggered NullSQLiteTime
+		if err := rows.Scan(
+			&rule.ID,
+			&rule.Name,
+			&rule.Enabled,
+			&rule.TriggerType,
+			&sensorID,
+			&sensorName,
+			&measurementTypeID,
+			&measurementType,
+			&rule.Comparison,
+			&threshold,
+			&rule.Status,
+			&alertRuleID,
+			&rule.ScheduleTime,
+			&days,
+			&rule.ScheduleHoliday,
+			&targetSensorID,
+			&targetSensorName,
+			&sceneID,
+			&sceneName,
+			&rule.Property,
+			&rule.Value,
+			&rule.Active,
+			&lastTriggered,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan automation rule: %w", err)
+		}
+		rule.SensorID = nullIntPtr(sensorID)
+		rule.MeasurementTypeID = nullIntPtr(measurementTypeID)
+		rule.AlertRuleID = nullIntPtr(alertRuleID)
+		rule.TargetSensorID = nullIntPtr(targetSensorID)
+		rule.SceneID = nullIntPtr(sceneID)
+		rule.SensorName = sensorName.String
+		rule.MeasurementType = measurementType.String
+		rule.TargetSensorName = targetSensorName.String
+		rule.SceneName = sceneName.String
+		if threshold.Valid {
+			rule.Threshold = &threshold.Float64
+		}
+		rule.ScheduleDays = []string{}
+		if days != "" {
+			rule.ScheduleDays = strings.Split(days, ",")
+		}
+		if lastTriggered.Valid {
+			rule.LastTriggeredAt = &lastTriggered.Time
+		}
+		rules = append(rules, rule)
+	}
+	return rules, rows.Err()
+}
+
+func nullIntPtr(v sql.NullInt64) *int {
+	if !v.Valid {
+		return nil
+	}
+	id := int(v.Int64)
+	return &id
+}
@@ -0,0 +1,22 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"example/sensorHub/automation"
+)
+
+type AutomationRepository interface {
+	GetAllAutomationRules(ctx context.Context) ([]automation.Rule, error)
+	GetAutomationRuleByID(ctx context.Context, ruleID int) (*automation.Rule, error)
+	CreateAutomationRule(ctx context.Context, rule *automation.Rule) error
+	UpdateAutomationRule(ctx context.Context, rule *automation.Rule) error
+	DeleteAutomationRule(ctx context.Context, ruleID int) error
+	GetReadingRulesForSensor(ctx context.Context, sensorID int) ([]automation.Rule, error)
+	GetRulesForAlert(ctx context.Context, alertRuleID int) ([]automation.Rule, error)
+	GetScheduleRules(ctx context.Context) ([]automation.Rule, error)
+	SetRuleActive(ctx context.Context, ruleID int, active bool) (bool, error)
+	RecordRuleTriggered(ctx context.Context, ruleID int, at time.Time) error
+	ClaimScheduledRun(ctx context.Context, ruleID int, minute time.Time) (bool, error)
+}
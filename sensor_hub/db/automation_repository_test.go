@@ -0,0 +1,188 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"example/sensorHub/automation"
+	"example/sensorHub/scenes"
+	"example/sensorHub/scheduling"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newAutomationTestRepo(t *testing.T) (*SqlAutomationRepository, *sql.DB) {
+	t.Helper()
+	db := newInMemoryDB(t)
+	db.SetMaxOpenConns(1)
+	_, err := db.Exec("PRAGMA foreign_keys = ON")
+	require.NoError(t, err)
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	require.NoError(t, RunMigrations(db, logger))
+	for _, name := range []string{"bathroom", "dehumidifier-plug"} {
+		_, err := db.Exec("INSERT INTO sensors (name, sensor_driver) VALUES (?, 'mqtt-zigbee2mqtt')", name)
+		require.NoError(t, err)
+	}
+	return NewAutomationRepository(db, logger), db
+}
+
+func humidityRule(t *testing.T, db *sql.DB, name string, comparison automation.Comparison, threshold float64, value string) automation.Rule {
+	t.Helper()
+	var humidityID int
+	require.NoError(t, db.QueryRow("SELECT id FROM measurement_types WHERE name = 'humidity'").Scan(&humidityID))
+	sensorID := 1
+	return automation.Rule{
+		Name: name, Enabled: true, TriggerType: automation.TriggerReading,
+		SensorID: &sensorID, MeasurementTypeID: &humidityID, Comparison: comparison, Threshold: &threshold,
+		TargetSensorID: ptr(2), Property: "state", Value: value,
+	}
+}
+
+func TestAutomationRepository_CreateAndGetRule(t *testing.T) {
+	repo, db := newAutomationTestRepo(t)
+	ctx := context.Background()
+
+	rule := humidityRule(t, db, "Dehumidifier on", automation.ComparisonGreaterThan, 75, "ON")
+	require.NoError(t, repo.CreateAutomationRule(ctx, &rule))
+	require.NotZero(t, rule.ID)
+
+	got, err := repo.GetAutomationRuleByID(ctx, rule.ID)
+	require.NoError(t, err)
+	require.NotNil(t, got)
+	assert.Equal(t, "Dehumidifier on", got.Name)
+	assert.Equal(t, automation.TriggerReading, got.TriggerType)
+	assert.Equal(t, "bathroom", got.SensorName)
+	assert.Equal(t, "humidity", got.MeasurementType)
+	assert.Equal(t, 75.0, *got.Threshold)
+	assert.Equal(t, "dehumidifier-plug", got.TargetSensorName)
+	assert.Nil(t, got.AlertRuleID)
+	assert.Empty(t, got.ScheduleDays)
+	assert.Equal(t, scheduling.HolidaySkip, got.ScheduleHoliday)
+	assert.Nil(t, got.LastTriggeredAt)
+
+	missing, err := repo.GetAutomationRuleByID(ctx, 999)
+	require.NoError(t, err)
+	assert.Nil(t, missing)
+}
+
+func TestAutomationRepository_GetRulesByTrigger(t *testing.T) {
+	repo, db := newAutomationTestRepo(t)
+	ctx := context.Background()
+
+	on := humidityRule(t, db, "Dehumidifier on", automation.ComparisonGreaterThan, 75, "ON")
+	disabled := humidityRule(t, db, "Disabled", automation.ComparisonLessThan, 60, "OFF")
+	disabled.Enabled = false
+	schedule := automation.Rule{
+		Name: "Morning", Enabled: true, TriggerType: automation.TriggerSchedule,
+		ScheduleTime: "06:30", ScheduleDays: []string{"mon", "fri"}, ScheduleHoliday: scheduling.HolidayOnly,
+		TargetSensorID: ptr(2), Property: "state", Value: "ON",
+	}
+	for _, rule := range []*automation.Rule{&on, &disabled, &schedule} {
+		require.NoError(t, repo.CreateAutomationRule(ctx, rule))
+	}
+
+	readingRules, err := repo.GetReadingRulesForSensor(ctx, 1)
+	require.NoError(t, err)
+	require.Len(t, readingRules, 1)
+	assert.Equal(t, on.ID, readingRules[0].ID)
+
+	none, err := repo.GetReadingRulesForSensor(ctx, 2)
+	require.NoError(t, err)
+	assert.Empty(t, none)
+
+	scheduleRules, err := repo.GetScheduleRules(ctx)
+	require.NoError(t, err)
+	require.Len(t, scheduleRules, 1)
+	assert.Equal(t, []string{"mon", "fri"}, scheduleRules[0].ScheduleDays)
+	assert.Equal(t, scheduling.HolidayOnly, scheduleRules[0].ScheduleHoliday)
+}
+
+func TestAutomationRepository_SetRuleActive_ReportsChange(t *testing.T) {
+	repo, db := newAutomationTestRepo(t)
+	ctx := context.Background()
+	rule := humidityRule(t, db, "Dehumidifier on", automation.ComparisonGreaterThan, 75, "ON")
+	require.NoError(t, repo.CreateAutomationRule(ctx, &rule))
+
+	changed, err := repo.SetRuleActive(ctx, rule.ID, true)
+	require.NoError(t, err)
+	assert.True(t, changed)
+
+	changed, err = repo.SetRuleActive(ctx, rule.ID, true)
+	require.NoError(t, err)
+	assert.False(t, changed)
+
+	// Updating the rule resets its state.
+	require.NoError(t, repo.UpdateAutomationRule(ctx, &rule))
+	got, err := repo.GetAutomationRuleByID(ctx, rule.ID)
+	require.NoError(t, err)
+	assert.False(t, got.Active)
+}
+
+func TestAutomationRepository_ClaimScheduledRun_OncePerMinute(t *testing.T) {
+	repo, db := newAutomationTestRepo(t)
+	ctx := context.Background()
+	rule := humidityRule(t, db, "Any", automation.ComparisonGreaterThan, 75, "ON")
+	require.NoError(t, repo.CreateAutomationRule(ctx, &rule))
+	minute := time.Date(2026, 3, 2, 6, 30, 0, 0, time.UTC)
+
+	claimed, err := repo.ClaimScheduledRun(ctx, rule.ID, minute)
+	require.NoError(t, err)
+	assert.True(t, claimed)
+
+	claimed, err = repo.ClaimScheduledRun(ctx, rule.ID, minute)
+	require.NoError(t, err)
+	assert.False(t, claimed)
+
+	claimed, err = repo.ClaimScheduledRun(ctx, rule.ID, minute.Add(24*time.Hour))
+	require.NoError(t, err)
+	assert.True(t, claimed)
+
+	got, err := repo.GetAutomationRuleByID(ctx, rule.ID)
+	require.NoError(t, err)
+	require.NotNil(t, got.LastTriggeredAt)
+	assert.True(t, got.LastTriggeredAt.Equal(minute.Add(24*time.Hour)))
+}
+
+func TestAutomationRepository_DeletingTargetSensorDeletesRule(t *testing.T) {
+	repo, db := newAutomationTestRepo(t)
+	ctx := context.Background()
+	rule := humidityRule(t, db, "Dehumidifier on", automation.ComparisonGreaterThan, 75, "ON")
+	require.NoError(t, repo.CreateAutomationRule(ctx, &rule))
+
+	_, err := db.Exec("DELETE FROM sensors WHERE id = 2")
+	require.NoError(t, err)
+
+	rules, err := repo.GetAllAutomationRules(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, rules)
+}
+
+func TestAutomationRepository_SceneRule(t *testing.T) {
+	repo, db := newAutomationTestRepo(t)
+	ctx := context.Background()
+	sceneRepo := NewSceneRepository(db, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	scene := scenes.Scene{Name: "Dry out", Commands: []scenes.Command{{SensorID: 2, Property: "state", Value: "ON"}}}
+	require.NoError(t, sceneRepo.CreateScene(ctx, &scene))
+
+	rule := humidityRule(t, db, "Dry out the bathroom", automation.ComparisonGreaterThan, 75, "")
+	rule.TargetSensorID, rule.Property = nil, ""
+	rule.SceneID = &scene.ID
+	require.NoError(t, repo.CreateAutomationRule(ctx, &rule))
+
+	got, err := repo.GetAutomationRuleByID(ctx, rule.ID)
+	require.NoError(t, err)
+	require.NotNil(t, got)
+	assert.Nil(t, got.TargetSensorID)
+	assert.Equal(t, scene.ID, *got.SceneID)
+	assert.Equal(t, "Dry out", got.SceneName)
+
+	require.NoError(t, sceneRepo.DeleteScene(ctx, scene.ID))
+	rules, err := repo.GetAllAutomationRules(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, rules, "deleting a scene deletes the rules that activate it")
+}
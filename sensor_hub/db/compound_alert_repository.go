@@ -0,0 +1,392 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"example/sensorHub/alerting"
+)
+
+const compoundAlertRuleSelect = `
+	SELECT
+		car.id,
+		car.name,
+		car.operator,
+		car.enabled,
+		car.rate_limit_seconds,
+		car.hysteresis,
+		car.state,
+		car.snoozed_until,
+		ah.sent_at
+	FROM compound_alert_rules car
+	LEFT JOIN (
+		SELECT compound_rule_id, MAX(sent_at) as sent_at
+		FROM alert_sent_history
+		WHERE compound_rule_id IS NOT NULL AND rate_limited = 0
+		GROUP BY compound_rule_id
+	) ah ON car.id = ah.compound_rule_id
+`
+
+func (r *AlertRepositoryImpl) GetAllCompoundAlertRules(ctx context.Context) ([]alerting.CompoundAlertRule, error) {
+	rules, err := r.queryCompoundAlertRules(ctx, compoundAlertRuleSelect+` ORDER BY car.id`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get compound alert rules: %w", err)
+	}
+	return rules, nil
+}
+
+func (r *AlertRepositoryImpl) GetCompoundAlertRuleByID(ctx context.Context, ruleID int) (*alerting.CompoundAlertRule, error) {
+	rules, err := r.queryCompoundAlertRules(ctx, compoundAlertRuleSelect+` WHERE car.id = ?`, ruleID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get compound alert rule %d: %w", ruleID, err)
+	}
+	if len(rules) == 0 {
+		return nil, nil
+	}
+	return &rules[0], nil
+}
+
+// GetCompoundAlertRulesForReading returns the enabled compound rules with at least one
+// condition on the given sensor and measurement type. Each condition carries the latest
+// stored reading for its own sensor and measurement type.
+func (r *AlertRepositoryImpl) GetCompoundAlertRulesForReading(ctx context.Context, sensorID int, measurementTypeName string) ([]alerting.CompoundAlertRule, error) {
+	query := compoundAlertRuleSelect + `
+		WHERE car.enabled = TRUE AND car.id IN (
+			SELECT cac.rule_id
+			FROM compound_alert_conditions cac
+			JOIN measurement_types mt ON cac.measurement_type_id = mt.id
+			WHERE cac.sensor_id = ? AND LOWER(mt.name) = LOWER(?)
+		)
+		ORDER BY car.id
+	`
+	rules, err := r.queryCompoundAlertRules(ctx, query, sensorID, measurementTypeName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get compound alert rules for sensor %d measurement %s: %w", sensorID, measurementTypeName, err)
+	}
+	return rules, nil
+}
+
+func (r *AlertRepositoryImpl) queryCompoundAlertRules(ctx context.Context, query string, args ...any) ([]alerting.CompoundAlertRule, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var rules []alerting.CompoundAlertRule
+	for rows.Next() {
+		var rule alerting.CompoundAlertRule
+		var snoozedUntil, lastAlertSent NullSQLiteTime
+		if err := rows.Scan(&rule.ID, &rule.Name, &rule.Operator, &rule.Enabled, &rule.RateLimitSeconds, &rule.Hysteresis, &rule.State, &snoozedUntil, &lastAlertSent); err != nil {
+			return nil, fmt.Errorf("failed to scan compound alert rule: %w", err)
+		}
+		if snoozedUntil.Valid {
+			rule.SnoozedUntil = &snoozedUntil.Time
+		}
+		if lastAlertSent.Valid {
+			rule.LastAlertSentAt = &lastAlertSent.Time
+		}
+		rules = append(rules, rule)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	rows.Close()
+
+	for i := range rules {
+		conditions, err := r.getCompoundAlertConditions(ctx, rules[i].ID)
+		if err != nil {
+			return nil, err
+		}
+		rules[i].Conditions = conditions
+	}
+	return rules, nil
+}
+
+func (r *AlertRepositoryImpl) getCompoundAlertConditions(ctx context.Context, ruleID int) ([]alerting.AlertCondition, error) {
+	query := `
+		SELECT
+			cac.id,
+			cac.sensor_id,
+			s.name,
+			cac.measurement_type_id,
+			mt.name,
+			cac.comparison,
+			cac.threshold,
+			cac.status,
+			latest.numeric_value,
+			latest.text_state
+		FROM compound_alert_conditions cac
+		JOIN sensors s ON cac.sensor_id = s.id
+		JOIN measurement_types mt ON cac.measurement_type_id = mt.id
+		LEFT JOIN readings latest ON latest.id = (
+			SELECT rd.id FROM readings rd
+			WHERE rd.sensor_id = cac.sensor_id AND rd.measurement_type_id = cac.measurement_type_id
+			ORDER BY rd.time DESC, rd.id DESC
+			LIMIT 1
+		)
+		WHERE cac.rule_id = ?
+		ORDER BY cac.id
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, ruleID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get conditions for compound alert rule %d: %w", ruleID, err)
+	}
+	defer rows.Close()
+
+	var conditions []alerting.AlertCondition
+	for rows.Next() {
+		var c alerting.AlertCondition
+		var threshold, latestNumeric sql.NullFloat64
+		var status, latestStatus sql.NullString
+		err := rows.Scan(
+			&c.ID,
+			&c.SensorID,
+			&c.SensorName,
+			&c.MeasurementTypeId,
+			&c.MeasurementType,
+			&c.Comparison,
+			&threshold,
+			&status,
+			&latestNumeric,
+			&latestStatus,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan compound alert condition: %w", err)
+		}
+		c.Threshold = threshold.Float64
+		c.Status = status.String
+		if latestNumeric.Valid {
+			c.LatestNumericValue = &latestNumeric.Float64
+		}
+		if latestStatus.Valid {
+			c.LatestStatus = &latestStatus.String
+		}
+		conditions = append(conditions, c)
+	}
+	return conditions, rows.Err()
+}
+
+func (r *AlertRepositoryImpl) CreateCompoundAlertRule(ctx context.Context, rule *alerting.CompoundAlertRule) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx, `
+		INSERT INTO compound_alert_rules (name, operator, enabled, rate_limit_seconds, hysteresis)
+		VALUES (?, ?, ?, ?, ?)
+	`, rule.Name, rule.Operator, rule.Enabled, rule.RateLimitSeconds, rule.Hysteresis)
+	if err != nil {
+		return fmt.Errorf("failed to create compound alert rule: %w", err)
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return fmt.Errorf("failed to get compound alert rule ID: %w", err)
+	}
+
+	if err := insertCompoundAlertConditions(ctx, tx, int(id), rule.Conditions); err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit compound alert rule: %w", err)
+	}
+
+	rule.ID = int(id)
+	return nil
+}
+
+// UpdateCompoundAlertRule updates the rule's settings and replaces its conditions.
+func (r *AlertRepositoryImpl) UpdateCompoundAlertRule(ctx context.Context, rule *alerting.CompoundAlertRule) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, `
+		UPDATE compound_alert_rules
+		SET name = ?,
+			operator = ?,
+			enabled = ?,
+			rate_limit_seconds = ?,
+			hysteresis = ?,
+			updated_at = datetime('now')
+		WHERE id = ?
+	`, rule.Name, rule.Operator, rule.Enabled, rule.RateLimitSeconds, rule.Hysteresis, rule.ID)
+	if err != nil {
+		return fmt.Errorf("failed to update compound alert rule: %w", err)
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM compound_alert_conditions WHERE rule_id = ?`, rule.ID); err != nil {
+		return fmt.Errorf("failed to clear compound alert conditions: %w", err)
+	}
+	if err := insertCompoundAlertConditions(ctx, tx, rule.ID, rule.Conditions); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit compound alert rule: %w", err)
+	}
+	return nil
+}
+
+func insertCompoundAlertConditions(ctx context.Context, tx *sql.Tx, ruleID int, conditions []alerting.AlertCondition) error {
+	query := `
+		INSERT INTO compound_alert_conditions
+		(rule_id, sensor_id, measurement_type_id, comparison, threshold, status)
+		VALUES (?, ?, ?, ?, ?, ?)
+	`
+	for _, c := range conditions {
+		var status sql.NullString
+		if c.Status != "" {
+			status = sql.NullString{String: c.Status, Valid: true}
+		}
+		if _, err := tx.ExecContext(ctx, query, ruleID, c.SensorID, c.MeasurementTypeId, c.Comparison, c.Threshold, status); err != nil {
+			return fmt.Errorf("failed to create compound alert condition: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *AlertRepositoryImpl) DeleteCompoundAlertRule(ctx context.Context, ruleID int) error {
+	_, err := r.db.ExecContext(ctx, `DELETE FROM compound_alert_rules WHERE id = ?`, ruleID)
+	if err != nil {
+		return fmt.Errorf("failed to delete compound alert rule: %w", err)
+	}
+	return nil
+}
+
+// RecordCompoundAlertSent opens a firing episode for a compound rule. The history row stays
+// open until ResolveCompoundAlert closes it.
+func (r *AlertRepositoryImpl) RecordCompoundAlertSent(ctx context.Context, ruleID, sensorID, measurementTypeId int, reason string, numericValue float64, statusValue string) error {
+	query := `
+		INSERT INTO alert_sent_history
+		(compound_rule_id, sensor_id, measurement_type_id, alert_reason, reading_value, reading_status)
+		VALUES (?, ?, ?, ?, ?, ?)
+	`
+
+	_, err := r.db.ExecContext(ctx, query, ruleID, sensorID, measurementTypeId, reason, numericValue, statusValue)
+	if err != nil {
+		return fmt.Errorf("failed to record compound alert sent: %w", err)
+	}
+
+	return nil
+}
+
+// MarkCompoundAlertFiring moves the compound rule to the firing state. It reports false
+// when the rule was already firing, so concurrent readings open at most one episode.
+func (r *AlertRepositoryImpl) MarkCompoundAlertFiring(ctx context.Context, ruleID int) (bool, error) {
+	result, err := r.db.ExecContext(ctx,
+		`UPDATE compound_alert_rules SET state = ? WHERE id = ? AND state <> ?`,
+		alerting.AlertStateFiring, ruleID, alerting.AlertStateFiring)
+	if err != nil {
+		return false, fmt.Errorf("failed to mark compound alert rule firing: %w", err)
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	return rows > 0, nil
+}
+
+// MarkCompoundAlertRateLimited records that the compound rule's open episode sends no
+// notifications because it started within the rule's rate limit.
+func (r *AlertRepositoryImpl) MarkCompoundAlertRateLimited(ctx context.Context, ruleID int) error {
+	if _, err := r.db.ExecContext(ctx,
+		`UPDATE alert_sent_history SET rate_limited = 1 WHERE compound_rule_id = ? AND resolved_at IS NULL`,
+		ruleID); err != nil {
+		return fmt.Errorf("failed to mark compound alert rate limited: %w", err)
+	}
+	return nil
+}
+
+// ResolveCompoundAlert moves a firing compound rule to the resolved state and closes its
+// open episode. It returns nil when the rule was not firing.
+func (r *AlertRepositoryImpl) ResolveCompoundAlert(ctx context.Context, ruleID int) (*alerting.AlertEpisode, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx,
+		`UPDATE compound_alert_rules SET state = ? WHERE id = ? AND state = ?`,
+		alerting.AlertStateResolved, ruleID, alerting.AlertStateFiring)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve compound alert rule: %w", err)
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rows == 0 {
+		return nil, nil
+	}
+
+	var startedAt SQLiteTime
+	var acknowledged, rateLimited bool
+	err = tx.QueryRowContext(ctx, `
+		SELECT sent_at, acknowledged_at IS NOT NULL, rate_limited
+		FROM alert_sent_history
+		WHERE compound_rule_id = ? AND resolved_at IS NULL
+		ORDER BY id DESC
+		LIMIT 1
+	`, ruleID).Scan(&startedAt, &acknowledged, &rateLimited)
+	if err != nil && err != sql.ErrNoRows {
+		return nil, fmt.Errorf("failed to get open compound alert episode: %w", err)
+	}
+
+	resolvedAt := time.Now().UTC()
+	if _, err := tx.ExecContext(ctx,
+		`UPDATE alert_sent_history SET resolved_at = ? WHERE compound_rule_id = ? AND resolved_at IS NULL`,
+		resolvedAt.Format("2006-01-02 15:04:05"), ruleID); err != nil {
+		return nil, fmt.Errorf("failed to close compound alert episode: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit compound alert resolution: %w", err)
+	}
+
+	episode := &alerting.AlertEpisode{StartedAt: startedAt.Time, ResolvedAt: resolvedAt, Acknowledged: acknowledged, RateLimited: rateLimited}
+	if startedAt.Time.IsZero() {
+		episode.StartedAt = resolvedAt
+	}
+	return episode, nil
+}
+
+// AcknowledgeCompoundAlertRule acknowledges the compound rule's open episode on behalf of
+// userID. It reports false when the rule is not firing. Acknowledging again keeps the
+// first acknowledgement.
+func (r *AlertRepositoryImpl) AcknowledgeCompoundAlertRule(ctx context.Context, ruleID, userID int) (bool, error) {
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE alert_sent_history
+		SET acknowledged_at = COALESCE(acknowledged_at, datetime('now')),
+			acknowledged_by = COALESCE(acknowledged_by, ?)
+		WHERE compound_rule_id = ? AND resolved_at IS NULL
+	`, userID, ruleID)
+	if err != nil {
+		return false, fmt.Errorf("failed to acknowledge compound alert rule: %w", err)
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	return rows > 0, nil
+}
+
+// SnoozeCompoundAlertRule suppresses the compound rule's notifications until the given
+// time. A nil time clears the snooze.
+func (r *AlertRepositoryImpl) SnoozeCompoundAlertRule(ctx context.Context, ruleID int, until *time.Time) error {
+	var snoozedUntil sql.NullString
+	if until != nil {
+		snoozedUntil = sql.NullString{String: until.UTC().Format("2006-01-02 15:04:05"), Valid: true}
+	}
+	if _, err := r.db.ExecContext(ctx, `UPDATE compound_alert_rules SET snoozed_until = ? WHERE id = ?`, snoozedUntil, ruleID); err != nil {
+		return fmt.Errorf("failed to snooze compound alert rule: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,194 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"example/sensorHub/alerting"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var compoundAlertRuleColumns = []string{"id", "name", "operator", "enabled", "rate_limit_seconds", "hysteresis", "state", "snoozed_until", "sent_at"}
+
+var compoundAlertConditionColumns = []string{
+	"id", "sensor_id", "name", "measurement_type_id", "name", "comparison", "threshold", "status", "numeric_value", "text_state",
+}
+
+func testCompoundAlertRule() alerting.CompoundAlertRule {
+	return alerting.CompoundAlertRule{
+		Name:             "Window open in the cold",
+		Operator:         alerting.CompoundOperatorAnd,
+		Enabled:          true,
+		RateLimitSeconds: 600,
+		Conditions: []alerting.AlertCondition{
+			{SensorID: 1, MeasurementTypeId: 3, Comparison: alerting.ComparisonEqual, Status: "false"},
+			{SensorID: 2, MeasurementTypeId: 1, Comparison: alerting.ComparisonLessThan, Threshold: 5},
+		},
+	}
+}
+
+func TestAlertRepository_GetCompoundAlertRulesForReading_Success(t *testing.T) {
+	db, dbMock := newMockDB(t)
+	repo := NewAlertRepository(db, slog.Default())
+
+	dbMock.ExpectQuery("FROM compound_alert_rules").
+		WithArgs(2, "temperature").
+		WillReturnRows(sqlmock.NewRows(compoundAlertRuleColumns).
+			AddRow(7, "Window open in the cold", "and", true, 600, 1.5, "firing", nil, nil))
+	dbMock.ExpectQuery("FROM compound_alert_conditions").
+		WithArgs(7).
+		WillReturnRows(sqlmock.NewRows(compoundAlertConditionColumns).
+			AddRow(1, 1, "window", 3, "contact", "==", nil, "false", nil, "true").
+			AddRow(2, 2, "outdoor", 1, "temperature", "<", 5.0, nil, 3.5, nil))
+
+	rules, err := repo.GetCompoundAlertRulesForReading(context.Background(), 2, "temperature")
+
+	require.NoError(t, err)
+	require.Len(t, rules, 1)
+	assert.Nil(t, rules[0].LastAlertSentAt)
+	assert.Equal(t, 1.5, rules[0].Hysteresis)
+	assert.Equal(t, alerting.AlertStateFiring, rules[0].State)
+	assert.Nil(t, rules[0].SnoozedUntil)
+	require.Len(t, rules[0].Conditions, 2)
+	assert.Equal(t, "false", rules[0].Conditions[0].Status)
+	assert.Equal(t, "true", *rules[0].Conditions[0].LatestStatus)
+	assert.Nil(t, rules[0].Conditions[0].LatestNumericValue)
+	assert.Equal(t, 5.0, rules[0].Conditions[1].Threshold)
+	assert.Equal(t, 3.5, *rules[0].Conditions[1].LatestNumericValue)
+	assert.NoError(t, dbMock.ExpectationsWereMet())
+}
+
+func TestAlertRepository_GetCompoundAlertRuleByID_NotFound(t *testing.T) {
+	db, dbMock := newMockDB(t)
+	repo := NewAlertRepository(db, slog.Default())
+
+	dbMock.ExpectQuery("FROM compound_alert_rules").
+		WithArgs(99).
+		WillReturnRows(sqlmock.NewRows(compoundAlertRuleColumns))
+
+	rule, err := repo.GetCompoundAlertRuleByID(context.Background(), 99)
+
+	assert.NoError(t, err)
+	assert.Nil(t, rule)
+	assert.NoError(t, dbMock.ExpectationsWereMet())
+}
+
+func TestAlertRepository_CreateCompoundAlertRule_Success(t *testing.T) {
+	db, dbMock := newMockDB(t)
+	repo := NewAlertRepository(db, slog.Default())
+	rule := testCompoundAlertRule()
+
+	dbMock.ExpectBegin()
+	dbMock.ExpectExec("INSERT INTO compound_alert_rules").
+		WithArgs("Window open in the cold", alerting.CompoundOperatorAnd, true, 600, 0.0).
+		WillReturnResult(sqlmock.NewResult(4, 1))
+	dbMock.ExpectExec("INSERT INTO compound_alert_conditions").
+		WithArgs(4, 1, 3, alerting.ComparisonEqual, 0.0, "false").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	dbMock.ExpectExec("INSERT INTO compound_alert_conditions").
+		WithArgs(4, 2, 1, alerting.ComparisonLessThan, 5.0, nil).
+		WillReturnResult(sqlmock.NewResult(2, 1))
+	dbMock.ExpectCommit()
+
+	err := repo.CreateCompoundAlertRule(context.Background(), &rule)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 4, rule.ID)
+	assert.NoError(t, dbMock.ExpectationsWereMet())
+}
+
+func TestAlertRepository_UpdateCompoundAlertRule_ConditionInsertFails(t *testing.T) {
+	db, dbMock := newMockDB(t)
+	repo := NewAlertRepository(db, slog.Default())
+	rule := testCompoundAlertRule()
+	rule.ID = 4
+
+	dbMock.ExpectBegin()
+	dbMock.ExpectExec("UPDATE compound_alert_rules").WillReturnResult(sqlmock.NewResult(0, 1))
+	dbMock.ExpectExec("DELETE FROM compound_alert_conditions").WithArgs(4).WillReturnResult(sqlmock.NewResult(0, 2))
+	dbMock.ExpectExec("INSERT INTO compound_alert_conditions").WillReturnError(errors.New("foreign key constraint failed"))
+	dbMock.ExpectRollback()
+
+	err := repo.UpdateCompoundAlertRule(context.Background(), &rule)
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to create compound alert condition")
+	assert.NoError(t, dbMock.ExpectationsWereMet())
+}
+
+func TestAlertRepository_RecordCompoundAlertSent_Success(t *testing.T) {
+	db, dbMock := newMockDB(t)
+	repo := NewAlertRepository(db, slog.Default())
+
+	dbMock.ExpectExec("INSERT INTO alert_sent_history").
+		WithArgs(4, 2, 1, "outdoor temperature 3.50 < 5.00", 3.5, "").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	err := repo.RecordCompoundAlertSent(context.Background(), 4, 2, 1, "outdoor temperature 3.50 < 5.00", 3.5, "")
+
+	assert.NoError(t, err)
+	assert.NoError(t, dbMock.ExpectationsWereMet())
+}
+
+func TestAlertRepository_MarkCompoundAlertFiring_AlreadyFiring(t *testing.T) {
+	db, dbMock := newMockDB(t)
+	repo := NewAlertRepository(db, slog.Default())
+
+	dbMock.ExpectExec("UPDATE compound_alert_rules SET state").
+		WithArgs(alerting.AlertStateFiring, 4, alerting.AlertStateFiring).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	marked, err := repo.MarkCompoundAlertFiring(context.Background(), 4)
+
+	assert.NoError(t, err)
+	assert.False(t, marked)
+	assert.NoError(t, dbMock.ExpectationsWereMet())
+}
+
+func TestAlertRepository_ResolveCompoundAlert_ClosesEpisode(t *testing.T) {
+	db, dbMock := newMockDB(t)
+	repo := NewAlertRepository(db, slog.Default())
+
+	startedAt := time.Now().UTC().Add(-time.Hour).Truncate(time.Second)
+	dbMock.ExpectBegin()
+	dbMock.ExpectExec("UPDATE compound_alert_rules SET state").
+		WithArgs(alerting.AlertStateResolved, 4, alerting.AlertStateFiring).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	dbMock.ExpectQuery("SELECT sent_at").
+		WithArgs(4).
+		WillReturnRows(sqlmock.NewRows([]string{"sent_at", "acknowledged", "rate_limited"}).AddRow(startedAt, false, true))
+	dbMock.ExpectExec("UPDATE alert_sent_history SET resolved_at").
+		WithArgs(sqlmock.AnyArg(), 4).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	dbMock.ExpectCommit()
+
+	episode, err := repo.ResolveCompoundAlert(context.Background(), 4)
+
+	require.NoError(t, err)
+	require.NotNil(t, episode)
+	assert.Equal(t, startedAt, episode.StartedAt)
+	assert.False(t, episode.Acknowledged)
+	assert.True(t, episode.RateLimited)
+	assert.NoError(t, dbMock.ExpectationsWereMet())
+}
+
+func TestAlertRepository_AcknowledgeCompoundAlertRule_NotFiring(t *testing.T) {
+	db, dbMock := newMockDB(t)
+	repo := NewAlertRepository(db, slog.Default())
+
+	dbMock.ExpectExec("UPDATE alert_sent_history").
+		WithArgs(7, 4).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	acknowledged, err := repo.AcknowledgeCompoundAlertRule(context.Background(), 4, 7)
+
+	assert.NoError(t, err)
+	assert.False(t, acknowledged)
+	assert.NoError(t, dbMock.ExpectationsWereMet())
+}
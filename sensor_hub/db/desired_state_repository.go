@@ -0,0 +1,166 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"example/sensorHub/desiredstate"
+)
+
+const desiredStateSelect = `
+	SELECT
+		ds.sensor_id,
+		s.name,
+		ds.property,
+		ds.value,
+		ds.status,
+		ds.attempts,
+		ds.next_retry_at,
+		ds.reported_value,
+		ds.last_error,
+		ds.command_id,
+		ds.updated_at
+	FROM desired_states ds
+	JOIN sensors s ON ds.sensor_id = s.id
+`
+
+type SqlDesiredStateRepository struct {
+	db     *sql.DB
+	logger *slog.Logger
+}
+
+func NewDesiredStateRepository(db *sql.DB, logger *slog.Logger) *SqlDesiredStateRepository {
+	return &SqlDesiredStateRepository{
+		db:     db,
+		logger: logger.With("component", "desired_state_repository"),
+	}
+}
+
+func (r *SqlDesiredStateRepository) GetDesiredStatesForSensor(ctx context.Context, sensorID int) ([]desiredstate.DesiredState, error) {
+	states, err := r.queryDesiredStates(ctx, desiredStateSelect+` WHERE ds.sensor_id = ? ORDER BY ds.property`, sensorID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get desired states of sensor %d: %w", sensorID, err)
+	}
+	return states, nil
+}
+
+func (r *SqlDesiredStateRepository) GetDesiredState(ctx context.Context, sensorID int, property string) (*desiredstate.DesiredState, error) {
+	states, err := r.queryDesiredStates(ctx, desiredStateSelect+` WHERE ds.sensor_id = ? AND ds.property = ?`, sensorID, property)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get desired %s of sensor %d: %w", property, sensorID, err)
+	}
+	if len(states) == 0 {
+		return nil, nil
+	}
+	return &states[0], nil
+}
+
+// SetDesiredState creates or replaces the desired value of a capability. Its progress
+// starts again from the given state; the value the device last reported is kept.
+func (r *SqlDesiredStateRepository) SetDesiredState(ctx context.Context, state *desiredstate.DesiredState) error {
+	_, err := r.db.ExecContext(ctx, `
+		INSERT INTO desired_states (sensor_id, property, value, status, attempts, next_retry_at, last_error, command_id)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+		ON CONFLICT (sensor_id, property) DO UPDATE SET
+			value = excluded.value,
+			status = excluded.status,
+			attempts = excluded.attempts,
+			next_retry_at = excluded.next_retry_at,
+			last_error = excluded.last_error,
+			command_id = excluded.command_id,
+			updated_at = datetime('now')
+	`, state.SensorID, state.Property, state.Value, state.Status, state.Attempts,
+		sqliteTimeArg(state.NextRetryAt), state.LastError, state.CommandID)
+	if err != nil {
+		return fmt.Errorf("failed to set desired state: %w", err)
+	}
+	return nil
+}
+
+// UpdateDesiredStateProgress records how far a capability is from its desired value. It
+// reports false when the desired value is no longer state.Value, because it was changed
+// or cleared meanwhile, and leaves the newer desired state alone.
+func (r *SqlDesiredStateRepository) UpdateDesiredStateProgress(ctx context.Context, state *desiredstate.DesiredState) (bool, error) {
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE desired_states
+		SET status = ?,
+			attempts = ?,
+			next_retry_at = ?,
+			reported_value = ?,
+			last_error = ?,
+			command_id = ?,
+			updated_at = datetime('now')
+		WHERE sensor_id = ? AND property = ? AND value = ?
+	`, state.Status, state.Attempts, sqliteTimeArg(state.NextRetryAt), state.ReportedValue, state.LastError, state.CommandID,
+		state.SensorID, state.Property, state.Value)
+	if err != nil {
+		return false, fmt.Errorf("failed to update desired %s of sensor %d: %w", state.Property, state.SensorID, err)
+	}
+	return rowsAffected(result)
+}
+
+func (r *SqlDesiredStateRepository) DeleteDesiredState(ctx context.Context, sensorID int, property string) error {
+	if _, err := r.db.ExecContext(ctx, `DELETE FROM desired_states WHERE sensor_id = ? AND property = ?`, sensorID, property); err != nil {
+		return fmt.Errorf("failed to delete desired state: %w", err)
+	}
+	return nil
+}
+
+// GetDueDesiredStates returns the retrying desired states whose next retry is at or
+// before now.
+func (r *SqlDesiredStateRepository) GetDueDesiredStates(ctx context.Context, now time.Time) ([]desiredstate.DesiredState, error) {
+	query := desiredStateSelect + `
+		WHERE ds.status = ? AND ds.next_retry_at IS NOT NULL AND ds.next_retry_at <= ?
+		ORDER BY ds.next_retry_at, ds.sensor_id, ds.property
+	`
+	states, err := r.queryDesiredStates(ctx, query, desiredstate.StatusRetrying, now.UTC().Format("2006-01-02 15:04:05"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get due desired states: %w", err)
+	}
+	return states, nil
+}
+
+func (r *SqlDesiredStateRepository) queryDesiredStates(ctx context.Context, query string, args ...any) ([]desiredstate.DesiredState, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var states []desiredstate.DesiredState
+	for rows.Next() {
+		var state desiredstate.DesiredState
+		var nextRetry, updatedAt NullSQLiteTime
+		var commandID sql.NullInt64
+		if err := rows.Scan(
+			&state.SensorID,
+			&state.SensorName,
+			&state.Property,
+			&state.Value,
+			&state.Status,
+			&state.Attempts,
+			&nextRetry,
+			&state.ReportedValue,
+			&state.LastError,
+			&commandID,
+			&updatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan desired state: %w", err)
+		}
+		if nextRetry.Valid {
+			state.NextRetryAt = &nextRetry.Time
+		}
+		if commandID.Valid {
+			id := int(commandID.Int64)
+			state.CommandID = &id
+		}
+		if updatedAt.Valid {
+			state.UpdatedAt = updatedAt.Time
+		}
+		states = append(states, state)
+	}
+	return states, rows.Err()
+}
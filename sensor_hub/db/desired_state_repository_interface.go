@@ -0,0 +1,17 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"example/sensorHub/desiredstate"
+)
+
+type DesiredStateRepository interface {
+	GetDesiredStatesForSensor(ctx context.Context, sensorID int) ([]desiredstate.DesiredState, error)
+	GetDesiredState(ctx context.Context, sensorID int, property string) (*desiredstate.DesiredState, error)
+	SetDesiredState(ctx context.Context, state *desiredstate.DesiredState) error
+	UpdateDesiredStateProgress(ctx context.Context, state *desiredstate.DesiredState) (bool, error)
+	DeleteDesiredState(ctx context.Context, sensorID int, property string) error
+	GetDueDesiredStates(ctx context.Context, now time.Time) ([]desiredstate.DesiredState, error)
+}
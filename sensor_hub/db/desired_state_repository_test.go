@@ -0,0 +1,127 @@
+package database
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"example/sensorHub/desiredstate"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newDesiredStateTestRepo(t *testing.T) *SqlDesiredStateRepository {
+	t.Helper()
+	db := newInMemoryDB(t)
+	db.SetMaxOpenConns(1)
+	_, err := db.Exec("PRAGMA foreign_keys = ON")
+	require.NoError(t, err)
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	require.NoError(t, RunMigrations(db, logger))
+	_, err = db.Exec("INSERT INTO sensors (name, sensor_driver) VALUES ('heater-plug', 'mqtt-zigbee2mqtt')")
+	require.NoError(t, err)
+	return NewDesiredStateRepository(db, logger)
+}
+
+func TestDesiredStateRepository_SetAndGetDesiredState(t *testing.T) {
+	repo := newDesiredStateTestRepo(t)
+	ctx := context.Background()
+
+	state := desiredstate.DesiredState{SensorID: 1, Property: "state", Value: "ON", Status: desiredstate.StatusPending}
+	require.NoError(t, repo.SetDesiredState(ctx, &state))
+
+	got, err := repo.GetDesiredState(ctx, 1, "state")
+	require.NoError(t, err)
+	require.NotNil(t, got)
+	assert.Equal(t, "heater-plug", got.SensorName)
+	assert.Equal(t, "ON", got.Value)
+	assert.Equal(t, desiredstate.StatusPending, got.Status)
+	assert.Nil(t, got.NextRetryAt)
+	assert.False(t, got.UpdatedAt.IsZero())
+
+	missing, err := repo.GetDesiredState(ctx, 1, "brightness")
+	require.NoError(t, err)
+	assert.Nil(t, missing)
+}
+
+func TestDesiredStateRepository_SetDesiredState_ReplacesValueAndProgress(t *testing.T) {
+	repo := newDesiredStateTestRepo(t)
+	ctx := context.Background()
+	retryAt := time.Date(2026, 3, 2, 6, 30, 0, 0, time.UTC)
+
+	state := desiredstate.DesiredState{SensorID: 1, Property: "state", Value: "ON", Status: desiredstate.StatusPending}
+	require.NoError(t, repo.SetDesiredState(ctx, &state))
+	state.Status, state.Attempts, state.NextRetryAt = desiredstate.StatusRetrying, 3, &retryAt
+	state.ReportedValue, state.LastError = "OFF", "command 12 timed out"
+	commandID := 12
+	state.CommandID = &commandID
+	updated, err := repo.UpdateDesiredStateProgress(ctx, &state)
+	require.NoError(t, err)
+	require.True(t, updated)
+	got, err := repo.GetDesiredState(ctx, 1, "state")
+	require.NoError(t, err)
+	require.NotNil(t, got.CommandID)
+	assert.Equal(t, 12, *got.CommandID)
+
+	replacement := desiredstate.DesiredState{SensorID: 1, Property: "state", Value: "OFF", Status: desiredstate.StatusPending}
+	require.NoError(t, repo.SetDesiredState(ctx, &replacement))
+
+	got, err = repo.GetDesiredState(ctx, 1, "state")
+	require.NoError(t, err)
+	assert.Equal(t, "OFF", got.Value)
+	assert.Equal(t, desiredstate.StatusPending, got.Status)
+	assert.Zero(t, got.Attempts)
+	assert.Nil(t, got.NextRetryAt)
+	assert.Empty(t, got.LastError)
+	assert.Nil(t, got.CommandID)
+	assert.Equal(t, "OFF", got.ReportedValue, "the last reported value is kept")
+
+	updated, err = repo.UpdateDesiredStateProgress(ctx, &state)
+	require.NoError(t, err)
+	assert.False(t, updated, "progress of the replaced value is not recorded")
+}
+
+func TestDesiredStateRepository_GetDueDesiredStates(t *testing.T) {
+	repo := newDesiredStateTestRepo(t)
+	ctx := context.Background()
+	now := time.Date(2026, 3, 2, 6, 31, 0, 0, time.UTC)
+	past, future := now.Add(-time.Minute), now.Add(time.Minute)
+
+	states := []desiredstate.DesiredState{
+		{SensorID: 1, Property: "state", Value: "ON", Status: desiredstate.StatusRetrying, NextRetryAt: &past},
+		{SensorID: 1, Property: "brightness", Value: "50", Status: desiredstate.StatusRetrying, NextRetryAt: &future},
+		{SensorID: 1, Property: "color_temp", Value: "300", Status: desiredstate.StatusWaiting, NextRetryAt: &past},
+	}
+	for i := range states {
+		require.NoError(t, repo.SetDesiredState(ctx, &states[i]))
+	}
+
+	due, err := repo.GetDueDesiredStates(ctx, now)
+	require.NoError(t, err)
+	require.Len(t, due, 1)
+	assert.Equal(t, "state", due[0].Property)
+	require.NotNil(t, due[0].NextRetryAt)
+	assert.True(t, due[0].NextRetryAt.Equal(past))
+
+	all, err := repo.GetDesiredStatesForSensor(ctx, 1)
+	require.NoError(t, err)
+	assert.Len(t, all, 3)
+}
+
+func TestDesiredStateRepository_DeletedWithSensor(t *testing.T) {
+	repo := newDesiredStateTestRepo(t)
+	ctx := context.Background()
+
+	state := desiredstate.DesiredState{SensorID: 1, Property: "state", Value: "ON", Status: desiredstate.StatusPending}
+	require.NoError(t, repo.SetDesiredState(ctx, &state))
+	require.NoError(t, repo.DeleteDesiredState(ctx, 1, "brightness"))
+	_, err := repo.db.Exec("DELETE FROM sensors WHERE id = 1")
+	require.NoError(t, err)
+
+	all, err := repo.GetDesiredStatesForSensor(ctx, 1)
+	require.NoError(t, err)
+	assert.Empty(t, all)
+}
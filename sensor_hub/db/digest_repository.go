@@ -0,0 +1,126 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"example/sensorHub/notifications"
+)
+
+type SqlDigestRepository struct {
+	db     *sql.DB
+	logger *slog.Logger
+}
+
+func NewDigestRepository(db *sql.DB, logger *slog.Logger) *SqlDigestRepository {
+	return &SqlDigestRepository{
+		db:     db,
+		logger: logger.With("component", "digest_repository"),
+	}
+}
+
+// GetQuietHours returns the user's quiet hours, or nil if they have none.
+func (r *SqlDigestRepository) GetQuietHours(ctx context.Context, userID int) (*notifications.QuietHours, error) {
+	var q notifications.QuietHours
+	err := r.db.QueryRowContext(ctx,
+		`SELECT user_id, start_time, end_time, enabled FROM notification_quiet_hours WHERE user_id = ?`, userID,
+	).Scan(&q.UserID, &q.Start, &q.End, &q.Enabled)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get quiet hours for user %d: %w", userID, err)
+	}
+	return &q, nil
+}
+
+// SetQuietHours creates or replaces the user's quiet hours.
+func (r *SqlDigestRepository) SetQuietHours(ctx context.Context, quietHours *notifications.QuietHours) error {
+	_, err := r.db.ExecContext(ctx, `
+		INSERT INTO notification_quiet_hours (user_id, start_time, end_time, enabled)
+		VALUES (?, ?, ?, ?)
+		ON CONFLICT(user_id) DO UPDATE SET
+			start_time = excluded.start_time,
+			end_time = excluded.end_time,
+			enabled = excluded.enabled,
+			updated_at = datetime('now')
+	`, quietHours.UserID, quietHours.Start, quietHours.End, quietHours.Enabled)
+	if err != nil {
+		return fmt.Errorf("failed to set quiet hours: %w", err)
+	}
+	return nil
+}
+
+func (r *SqlDigestRepository) DeleteQuietHours(ctx context.Context, userID int) error {
+	if _, err := r.db.ExecContext(ctx, `DELETE FROM notification_quiet_hours WHERE user_id = ?`, userID); err != nil {
+		return fmt.Errorf("failed to delete quiet hours: %w", err)
+	}
+	return nil
+}
+
+// QueueEmail holds back the email for notificationID to userID until deliverAfter.
+func (r *SqlDigestRepository) QueueEmail(ctx context.Context, userID, notificationID int, deliverAfter time.Time) error {
+	_, err := r.db.ExecContext(ctx,
+		`INSERT INTO notification_email_queue (user_id, notification_id, deliver_after) VALUES (?, ?, ?)`,
+		userID, notificationID, deliverAfter.UTC().Format("2006-01-02 15:04:05"),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to queue email: %w", err)
+	}
+	return nil
+}
+
+// GetDueEmails returns the queued emails whose delivery time has passed at now, ordered by
+// user and then by notification time. Emails for users without an address are skipped.
+func (r *SqlDigestRepository) GetDueEmails(ctx context.Context, now time.Time) ([]QueuedEmail, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT q.id, q.user_id, u.email,
+		       n.id, n.category, n.severity, n.title, n.message, n.metadata, n.created_at
+		FROM notification_email_queue q
+		JOIN users u ON q.user_id = u.id
+		JOIN notifications n ON q.notification_id = n.id
+		WHERE q.deliver_after <= ? AND u.email IS NOT NULL AND u.email != ''
+		ORDER BY q.user_id, n.created_at, q.id
+	`, now.UTC().Format("2006-01-02 15:04:05"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get due emails: %w", err)
+	}
+	defer rows.Close()
+
+	var emails []QueuedEmail
+	for rows.Next() {
+		var e QueuedEmail
+		var metadataJSON []byte
+		var createdAt SQLiteTime
+		err := rows.Scan(&e.ID, &e.UserID, &e.Email,
+			&e.Notification.ID, &e.Notification.Category, &e.Notification.Severity,
+			&e.Notification.Title, &e.Notification.Message, &metadataJSON, &createdAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan queued email: %w", err)
+		}
+		e.Notification.CreatedAt = createdAt.Time
+		e.Notification.Metadata, _ = notifications.ParseMetadataJSON(metadataJSON)
+		emails = append(emails, e)
+	}
+	return emails, rows.Err()
+}
+
+func (r *SqlDigestRepository) DeleteQueuedEmails(ctx context.Context, ids []int) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	args := make([]any, len(ids))
+	for i, id := range ids {
+		args[i] = id
+	}
+	query := `DELETE FROM notification_email_queue WHERE id IN (?` + strings.Repeat(", ?", len(ids)-1) + `)`
+	if _, err := r.db.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("failed to delete queued emails: %w", err)
+	}
+	return nil
+}
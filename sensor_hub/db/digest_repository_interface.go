@@ -0,0 +1,26 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"example/sensorHub/notifications"
+)
+
+// QueuedEmail is a notification email held back by a digest or quiet hours, with the
+// address it is to be delivered to.
+type QueuedEmail struct {
+	ID           int
+	UserID       int
+	Email        string
+	Notification notifications.Notification
+}
+
+type DigestRepository interface {
+	GetQuietHours(ctx context.Context, userID int) (*notifications.QuietHours, error)
+	SetQuietHours(ctx context.Context, quietHours *notifications.QuietHours) error
+	DeleteQuietHours(ctx context.Context, userID int) error
+	QueueEmail(ctx context.Context, userID, notificationID int, deliverAfter time.Time) error
+	GetDueEmails(ctx context.Context, now time.Time) ([]QueuedEmail, error)
+	DeleteQueuedEmails(ctx context.Context, ids []int) error
+}
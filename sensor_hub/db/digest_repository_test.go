@@ -0,0 +1,109 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+	"testing"
+	"time"
+
+	"example/sensorHub/notifications"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDigestRepository_GetQuietHours_Found(t *testing.T) {
+	db, dbMock := newMockDB(t)
+	repo := NewDigestRepository(db, slog.Default())
+
+	dbMock.ExpectQuery(`FROM notification_quiet_hours WHERE user_id = \?`).
+		WithArgs(4).
+		WillReturnRows(sqlmock.NewRows([]string{"user_id", "start_time", "end_time", "enabled"}).
+			AddRow(4, "22:00", "07:00", true))
+
+	q, err := repo.GetQuietHours(context.Background(), 4)
+
+	require.NoError(t, err)
+	require.NotNil(t, q)
+	assert.Equal(t, notifications.QuietHours{UserID: 4, Start: "22:00", End: "07:00", Enabled: true}, *q)
+	assert.NoError(t, dbMock.ExpectationsWereMet())
+}
+
+func TestDigestRepository_GetQuietHours_None(t *testing.T) {
+	db, dbMock := newMockDB(t)
+	repo := NewDigestRepository(db, slog.Default())
+
+	dbMock.ExpectQuery(`FROM notification_quiet_hours`).WithArgs(4).WillReturnError(sql.ErrNoRows)
+
+	q, err := repo.GetQuietHours(context.Background(), 4)
+
+	require.NoError(t, err)
+	assert.Nil(t, q)
+}
+
+func TestDigestRepository_SetQuietHours(t *testing.T) {
+	db, dbMock := newMockDB(t)
+	repo := NewDigestRepository(db, slog.Default())
+
+	dbMock.ExpectExec(`INSERT INTO notification_quiet_hours .* ON CONFLICT\(user_id\) DO UPDATE`).
+		WithArgs(4, "22:00", "07:00", true).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	err := repo.SetQuietHours(context.Background(), &notifications.QuietHours{UserID: 4, Start: "22:00", End: "07:00", Enabled: true})
+
+	require.NoError(t, err)
+	assert.NoError(t, dbMock.ExpectationsWereMet())
+}
+
+func TestDigestRepository_QueueEmail(t *testing.T) {
+	db, dbMock := newMockDB(t)
+	repo := NewDigestRepository(db, slog.Default())
+	deliverAfter := time.Date(2026, 3, 11, 7, 0, 0, 0, time.UTC)
+
+	dbMock.ExpectExec(`INSERT INTO notification_email_queue`).
+		WithArgs(4, 99, "2026-03-11 07:00:00").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	err := repo.QueueEmail(context.Background(), 4, 99, deliverAfter)
+
+	require.NoError(t, err)
+	assert.NoError(t, dbMock.ExpectationsWereMet())
+}
+
+func TestDigestRepository_GetDueEmails(t *testing.T) {
+	db, dbMock := newMockDB(t)
+	repo := NewDigestRepository(db, slog.Default())
+	now := time.Date(2026, 3, 11, 7, 0, 30, 0, time.UTC)
+
+	dbMock.ExpectQuery(`FROM notification_email_queue q.*WHERE q.deliver_after <= \?`).
+		WithArgs("2026-03-11 07:00:30").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "email", "n.id", "category", "severity", "title", "message", "metadata", "created_at"}).
+			AddRow(1, 4, "alice@example.com", 99, "threshold_alert", "warning", "Garage cold", "below 5", []byte(`{"sensor_name":"garage"}`), "2026-03-10 23:15:00").
+			AddRow(2, 4, "alice@example.com", 100, "config_change", "info", "Property changed", "log.level", []byte("null"), "2026-03-11 01:00:00"))
+
+	emails, err := repo.GetDueEmails(context.Background(), now)
+
+	require.NoError(t, err)
+	require.Len(t, emails, 2)
+	assert.Equal(t, "alice@example.com", emails[0].Email)
+	assert.Equal(t, "Garage cold", emails[0].Notification.Title)
+	assert.Equal(t, "garage", emails[0].Notification.Metadata["sensor_name"])
+	assert.Equal(t, time.Date(2026, 3, 10, 23, 15, 0, 0, time.UTC), emails[0].Notification.CreatedAt)
+	assert.Equal(t, notifications.CategoryConfigChange, emails[1].Notification.Category)
+	assert.NoError(t, dbMock.ExpectationsWereMet())
+}
+
+func TestDigestRepository_DeleteQueuedEmails(t *testing.T) {
+	db, dbMock := newMockDB(t)
+	repo := NewDigestRepository(db, slog.Default())
+
+	dbMock.ExpectExec(`DELETE FROM notification_email_queue WHERE id IN \(\?, \?\)`).
+		WithArgs(1, 2).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+
+	require.NoError(t, repo.DeleteQueuedEmails(context.Background(), []int{1, 2}))
+	require.NoError(t, repo.DeleteQueuedEmails(context.Background(), nil))
+	assert.NoError(t, dbMock.ExpectationsWereMet())
+}
@@ -15,6 +15,9 @@ const (
 	TableMQTTBrokers            = "mqtt_brokers"
 	TableMQTTSubscriptions      = "mqtt_subscriptions"
 	TableMeasurementTypeAggregations = "measurement_type_aggregations"
+	TableReadingsRollup5m            = "readings_rollup_5m"
+	TableReadingsRollup1h            = "readings_rollup_1h"
+	TableReadingsRollupState         = "readings_rollup_state"
 )
 
 // ============================================================================
@@ -43,8 +46,35 @@ const (
 	AggregationFunctionAvg   AggregationFunction = "avg"
 	AggregationFunctionCount AggregationFunction = "count"
 	AggregationFunctionLast  AggregationFunction = "last"
+	AggregationFunctionMin   AggregationFunction = "min"
+	AggregationFunctionMax   AggregationFunction = "max"
+	AggregationFunctionSum   AggregationFunction = "sum"
+	AggregationFunctionP50   AggregationFunction = "p50"
+	AggregationFunctionP95   AggregationFunction = "p95"
 )
 
+// RollupFor returns the coarsest rollup whose buckets fit evenly into interval and can
+// answer every one of aggFuncs, or false when the query has to aggregate raw readings.
+// Percentiles always need the raw readings.
+func RollupFor(interval AggregationInterval, aggFuncs ...AggregationFunction) (AggregationInterval, bool) {
+	for _, aggFunc := range aggFuncs {
+		switch aggFunc {
+		case AggregationFunctionAvg, AggregationFunctionCount, AggregationFunctionLast,
+			AggregationFunctionMin, AggregationFunctionMax, AggregationFunctionSum:
+		default:
+			return "", false
+		}
+	}
+	switch interval {
+	case AggregationPT1H, AggregationP1D:
+		return AggregationPT1H, true
+	case AggregationPT5M, AggregationPT15M:
+		return AggregationPT5M, true
+	default:
+		return "", false
+	}
+}
+
 // ============================================================================
 // Measurement type helpers — internal to the db/service boundary
 // ============================================================================
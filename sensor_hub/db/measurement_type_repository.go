@@ -22,7 +22,8 @@ func (r *MeasurementTypeRepositoryImpl) GetAll(ctx context.Context) ([]gen.Measu
 	query := fmt.Sprintf(`
 		SELECT mt.id, mt.name, mt.display_name, mt.category, mt.default_unit,
 			COALESCE(mta.function, 'avg') AS default_aggregation_function,
-			COALESCE((SELECT GROUP_CONCAT(mta2.function, ',') FROM measurement_type_aggregations mta2 WHERE mta2.measurement_type_id = mt.id ORDER BY mta2.function), 'avg') AS supported_aggregation_functions
+			COALESCE((SELECT GROUP_CONCAT(mta2.function, ',') FROM measurement_type_aggregations mta2 WHERE mta2.measurement_type_id = mt.id ORDER BY mta2.function), 'avg') AS supported_aggregation_functions,
+			mt.retention_downsample_interval
 		FROM %s mt
 		LEFT JOIN measurement_type_aggregations mta ON mta.measurement_type_id = mt.id AND mta.is_default = 1
 		ORDER BY mt.name
@@ -37,7 +38,7 @@ func (r *MeasurementTypeRepositoryImpl) GetAll(ctx context.Context) ([]gen.Measu
 	for rows.Next() {
 		var mt gen.MeasurementType
 		var supported string
-		if err := rows.Scan(&mt.Id, &mt.Name, &mt.DisplayName, &mt.Category, &mt.Unit, &mt.DefaultAggregationFunction, &supported); err != nil {
+		if err := rows.Scan(&mt.Id, &mt.Name, &mt.DisplayName, &mt.Category, &mt.Unit, &mt.DefaultAggregationFunction, &supported, &mt.RetentionDownsampleInterval); err != nil {
 			return nil, fmt.Errorf("error scanning measurement type row: %w", err)
 		}
 		mt.SupportedAggregationFunctions = strings.Split(supported, ",")
@@ -50,7 +51,8 @@ func (r *MeasurementTypeRepositoryImpl) GetAllWithReadings(ctx context.Context)
 	query := fmt.Sprintf(`
 		SELECT DISTINCT mt.id, mt.name, mt.display_name, mt.category, mt.default_unit,
 			COALESCE(mta.function, 'avg') AS default_aggregation_function,
-			COALESCE((SELECT GROUP_CONCAT(mta2.function, ',') FROM measurement_type_aggregations mta2 WHERE mta2.measurement_type_id = mt.id ORDER BY mta2.function), 'avg') AS supported_aggregation_functions
+			COALESCE((SELECT GROUP_CONCAT(mta2.function, ',') FROM measurement_type_aggregations mta2 WHERE mta2.measurement_type_id = mt.id ORDER BY mta2.function), 'avg') AS supported_aggregation_functions,
+			mt.retention_downsample_interval
 		FROM %s mt
 		INNER JOIN %s r ON r.measurement_type_id = mt.id
 		LEFT JOIN measurement_type_aggregations mta ON mta.measurement_type_id = mt.id AND mta.is_default = 1
@@ -67,7 +69,7 @@ func (r *MeasurementTypeRepositoryImpl) GetAllWithReadings(ctx context.Context)
 	for rows.Next() {
 		var mt gen.MeasurementType
 		var supported string
-		if err := rows.Scan(&mt.Id, &mt.Name, &mt.DisplayName, &mt.Category, &mt.Unit, &mt.DefaultAggregationFunction, &supported); err != nil {
+		if err := rows.Scan(&mt.Id, &mt.Name, &mt.DisplayName, &mt.Category, &mt.Unit, &mt.DefaultAggregationFunction, &supported, &mt.RetentionDownsampleInterval); err != nil {
 			return nil, fmt.Errorf("error scanning measurement type row: %w", err)
 		}
 		mt.SupportedAggregationFunctions = strings.Split(supported, ",")
@@ -80,14 +82,15 @@ func (r *MeasurementTypeRepositoryImpl) GetByName(ctx context.Context, name stri
 	query := fmt.Sprintf(`
 		SELECT mt.id, mt.name, mt.display_name, mt.category, mt.default_unit,
 			COALESCE(mta.function, 'avg') AS default_aggregation_function,
-			COALESCE((SELECT GROUP_CONCAT(mta2.function, ',') FROM measurement_type_aggregations mta2 WHERE mta2.measurement_type_id = mt.id ORDER BY mta2.function), 'avg') AS supported_aggregation_functions
+			COALESCE((SELECT GROUP_CONCAT(mta2.function, ',') FROM measurement_type_aggregations mta2 WHERE mta2.measurement_type_id = mt.id ORDER BY mta2.function), 'avg') AS supported_aggregation_functions,
+			mt.retention_downsample_interval
 		FROM %s mt
 		LEFT JOIN measurement_type_aggregations mta ON mta.measurement_type_id = mt.id AND mta.is_default = 1
 		WHERE LOWER(mt.name) = LOWER(?)
 	`, TableMeasurementTypes)
 	var mt gen.MeasurementType
 	var supported string
-	err := r.db.QueryRowContext(ctx, query, name).Scan(&mt.Id, &mt.Name, &mt.DisplayName, &mt.Category, &mt.Unit, &mt.DefaultAggregationFunction, &supported)
+	err := r.db.QueryRowContext(ctx, query, name).Scan(&mt.Id, &mt.Name, &mt.DisplayName, &mt.Category, &mt.Unit, &mt.DefaultAggregationFunction, &supported, &mt.RetentionDownsampleInterval)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
@@ -156,7 +159,8 @@ func (r *MeasurementTypeRepositoryImpl) GetMeasurementTypesWithReadings(ctx cont
 		SELECT DISTINCT mt.id, mt.name, mt.display_name, mt.category,
 			COALESCE(NULLIF(smt.unit, ''), mt.default_unit) AS unit,
 			COALESCE(mta.function, 'avg') AS default_aggregation_function,
-			COALESCE((SELECT GROUP_CONCAT(mta2.function, ',') FROM measurement_type_aggregations mta2 WHERE mta2.measurement_type_id = mt.id ORDER BY mta2.function), 'avg') AS supported_aggregation_functions
+			COALESCE((SELECT GROUP_CONCAT(mta2.function, ',') FROM measurement_type_aggregations mta2 WHERE mta2.measurement_type_id = mt.id ORDER BY mta2.function), 'avg') AS supported_aggregation_functions,
+			mt.retention_downsample_interval
 		FROM %s r
 		JOIN %s mt ON r.measurement_type_id = mt.id
 		LEFT JOIN %s smt ON smt.sensor_id = r.sensor_id AND smt.measurement_type_id = mt.id
@@ -175,7 +179,7 @@ func (r *MeasurementTypeRepositoryImpl) GetMeasurementTypesWithReadings(ctx cont
 	for rows.Next() {
 		var mt gen.MeasurementType
 		var supported string
-		if err := rows.Scan(&mt.Id, &mt.Name, &mt.DisplayName, &mt.Category, &mt.Unit, &mt.DefaultAggregationFunction, &supported); err != nil {
+		if err := rows.Scan(&mt.Id, &mt.Name, &mt.DisplayName, &mt.Category, &mt.Unit, &mt.DefaultAggregationFunction, &supported, &mt.RetentionDownsampleInterval); err != nil {
 			return nil, fmt.Errorf("error scanning measurement type row: %w", err)
 		}
 		mt.SupportedAggregationFunctions = strings.Split(supported, ",")
@@ -184,6 +188,16 @@ func (r *MeasurementTypeRepositoryImpl) GetMeasurementTypesWithReadings(ctx cont
 	return mts, rows.Err()
 }
 
+// SetRetentionDownsampleInterval sets the bucket interval the type's expired raw readings
+// are downsampled to; nil deletes them instead.
+func (r *MeasurementTypeRepositoryImpl) SetRetentionDownsampleInterval(ctx context.Context, name string, interval *string) error {
+	query := fmt.Sprintf("UPDATE %s SET retention_downsample_interval = ? WHERE LOWER(name) = LOWER(?)", TableMeasurementTypes)
+	if _, err := r.db.ExecContext(ctx, query, interval, name); err != nil {
+		return fmt.Errorf("error setting downsample interval for measurement type %q: %w", name, err)
+	}
+	return nil
+}
+
 func (r *MeasurementTypeRepositoryImpl) GetAggregationsForMeasurementType(ctx context.Context, name string) (*MeasurementTypeAggregation, error) {
 	query := `
 		SELECT mta.function, mta.is_default
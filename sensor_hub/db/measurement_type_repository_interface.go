@@ -16,4 +16,5 @@ type MeasurementTypeRepository interface {
 	AssignToSensor(ctx context.Context, sensorId, measurementTypeId int, unit string) error
 	RemoveFromSensor(ctx context.Context, sensorId, measurementTypeId int) error
 	GetAggregationsForMeasurementType(ctx context.Context, name string) (*MeasurementTypeAggregation, error)
+	SetRetentionDownsampleInterval(ctx context.Context, name string, interval *string) error
 }
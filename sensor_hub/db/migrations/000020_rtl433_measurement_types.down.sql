@@ -0,0 +1 @@
+DELETE FROM measurement_types WHERE name IN ('wind_speed', 'wind_gust', 'wind_direction', 'rain', 'uv_index');
@@ -0,0 +1,23 @@
+-- Migration 000020: Seed weather station measurement types for the rtl_433 driver.
+
+INSERT OR IGNORE INTO measurement_types (name, display_name, category, default_unit) VALUES
+    ('wind_speed', 'Wind Speed', 'numeric', 'km/h'),
+    ('wind_gust', 'Wind Gust', 'numeric', 'km/h'),
+    ('wind_direction', 'Wind Direction', 'numeric', '°'),
+    ('rain', 'Rain', 'numeric', 'mm'),
+    ('uv_index', 'UV Index', 'numeric', '');
+
+INSERT OR IGNORE INTO measurement_type_aggregations (measurement_type_id, function, is_default)
+SELECT id, 'avg', 1 FROM measurement_types
+WHERE name IN ('wind_speed', 'wind_gust', 'wind_direction', 'uv_index');
+
+INSERT OR IGNORE INTO measurement_type_aggregations (measurement_type_id, function, is_default)
+SELECT id, 'max', 0 FROM measurement_types
+WHERE name IN ('wind_speed', 'wind_gust', 'uv_index');
+
+-- Rain is reported as a running total, so the last value in a bucket is the meaningful one.
+INSERT OR IGNORE INTO measurement_type_aggregations (measurement_type_id, function, is_default)
+SELECT id, 'last', 1 FROM measurement_types WHERE name = 'rain';
+
+INSERT OR IGNORE INTO measurement_type_aggregations (measurement_type_id, function, is_default)
+SELECT id, 'max', 0 FROM measurement_types WHERE name = 'rain';
@@ -0,0 +1,26 @@
+CREATE TABLE alert_sent_history_old (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    alert_rule_id INTEGER NOT NULL,
+    sensor_id INTEGER NOT NULL,
+    sent_at TEXT DEFAULT (datetime('now')),
+    alert_reason TEXT,
+    reading_value REAL,
+    reading_status TEXT,
+    measurement_type_id INTEGER REFERENCES measurement_types(id),
+    FOREIGN KEY (alert_rule_id) REFERENCES sensor_alert_rules(id) ON DELETE CASCADE,
+    FOREIGN KEY (sensor_id) REFERENCES sensors(id) ON DELETE CASCADE
+);
+
+INSERT INTO alert_sent_history_old (id, alert_rule_id, sensor_id, sent_at, alert_reason, reading_value, reading_status, measurement_type_id)
+SELECT id, alert_rule_id, sensor_id, sent_at, alert_reason, reading_value, reading_status, measurement_type_id
+FROM alert_sent_history
+WHERE alert_rule_id IS NOT NULL;
+
+DROP TABLE alert_sent_history;
+ALTER TABLE alert_sent_history_old RENAME TO alert_sent_history;
+
+CREATE INDEX IF NOT EXISTS idx_alert_rule_sent_at ON alert_sent_history (alert_rule_id, sent_at DESC);
+CREATE INDEX IF NOT EXISTS idx_sensor_sent_at ON alert_sent_history (sensor_id, sent_at DESC);
+
+DROP TABLE IF EXISTS compound_alert_conditions;
+DROP TABLE IF EXISTS compound_alert_rules;
@@ -0,0 +1,55 @@
+-- Compound alert rules combine several conditions, possibly across sensors,
+-- with a single AND/OR operator.
+CREATE TABLE IF NOT EXISTS compound_alert_rules (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    name TEXT NOT NULL UNIQUE,
+    operator TEXT NOT NULL CHECK (operator IN ('and', 'or')),
+    enabled INTEGER NOT NULL DEFAULT 1,
+    rate_limit_seconds INTEGER NOT NULL DEFAULT 3600,
+    created_at TEXT DEFAULT (datetime('now')),
+    updated_at TEXT DEFAULT (datetime('now'))
+);
+
+CREATE TABLE IF NOT EXISTS compound_alert_conditions (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    rule_id INTEGER NOT NULL,
+    sensor_id INTEGER NOT NULL,
+    measurement_type_id INTEGER NOT NULL,
+    comparison TEXT NOT NULL,
+    threshold REAL,
+    status TEXT,
+    FOREIGN KEY (rule_id) REFERENCES compound_alert_rules(id) ON DELETE CASCADE,
+    FOREIGN KEY (sensor_id) REFERENCES sensors(id) ON DELETE CASCADE,
+    FOREIGN KEY (measurement_type_id) REFERENCES measurement_types(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_compound_conditions_rule ON compound_alert_conditions (rule_id);
+CREATE INDEX IF NOT EXISTS idx_compound_conditions_sensor_mt ON compound_alert_conditions (sensor_id, measurement_type_id);
+
+-- Rebuild alert_sent_history so a row can belong to either a simple or a compound rule.
+CREATE TABLE alert_sent_history_new (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    alert_rule_id INTEGER,
+    compound_rule_id INTEGER,
+    sensor_id INTEGER NOT NULL,
+    sent_at TEXT DEFAULT (datetime('now')),
+    alert_reason TEXT,
+    reading_value REAL,
+    reading_status TEXT,
+    measurement_type_id INTEGER REFERENCES measurement_types(id),
+    FOREIGN KEY (alert_rule_id) REFERENCES sensor_alert_rules(id) ON DELETE CASCADE,
+    FOREIGN KEY (compound_rule_id) REFERENCES compound_alert_rules(id) ON DELETE CASCADE,
+    FOREIGN KEY (sensor_id) REFERENCES sensors(id) ON DELETE CASCADE,
+    CHECK ((alert_rule_id IS NULL) <> (compound_rule_id IS NULL))
+);
+
+INSERT INTO alert_sent_history_new (id, alert_rule_id, sensor_id, sent_at, alert_reason, reading_value, reading_status, measurement_type_id)
+SELECT id, alert_rule_id, sensor_id, sent_at, alert_reason, reading_value, reading_status, measurement_type_id
+FROM alert_sent_history;
+
+DROP TABLE alert_sent_history;
+ALTER TABLE alert_sent_history_new RENAME TO alert_sent_history;
+
+CREATE INDEX IF NOT EXISTS idx_alert_rule_sent_at ON alert_sent_history (alert_rule_id, sent_at DESC);
+CREATE INDEX IF NOT EXISTS idx_compound_rule_sent_at ON alert_sent_history (compound_rule_id, sent_at DESC);
+CREATE INDEX IF NOT EXISTS idx_sensor_sent_at ON alert_sent_history (sensor_id, sent_at DESC);
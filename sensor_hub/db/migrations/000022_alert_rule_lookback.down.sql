@@ -0,0 +1,3 @@
+DELETE FROM sensor_alert_rules WHERE alert_type IN ('sustained_range', 'rate_of_change');
+ALTER TABLE sensor_alert_rules DROP COLUMN change_threshold;
+ALTER TABLE sensor_alert_rules DROP COLUMN duration_seconds;
@@ -0,0 +1,5 @@
+-- Look-back settings for the sustained_range and rate_of_change alert types.
+-- duration_seconds is the window a value must stay out of range (sustained_range)
+-- or the window a change is measured over (rate_of_change).
+ALTER TABLE sensor_alert_rules ADD COLUMN duration_seconds INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE sensor_alert_rules ADD COLUMN change_threshold REAL NOT NULL DEFAULT 0;
@@ -0,0 +1,4 @@
+-- Migration 000023: Per-sensor expected reporting interval
+-- Adds nullable expected_interval_seconds column to sensors table.
+-- NULL means fall back to the driver's default (or sensor.stale.default.interval.seconds).
+ALTER TABLE sensors ADD COLUMN expected_interval_seconds INTEGER DEFAULT NULL;
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_alert_rule_open;
+ALTER TABLE alert_sent_history DROP COLUMN peak_value;
+ALTER TABLE alert_sent_history DROP COLUMN resolved_at;
+ALTER TABLE sensor_alert_rules DROP COLUMN hysteresis;
+ALTER TABLE sensor_alert_rules DROP COLUMN state;
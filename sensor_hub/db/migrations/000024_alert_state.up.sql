@@ -0,0 +1,16 @@
+-- Alert state tracking. Each simple rule is ok (never fired), firing, or resolved.
+-- hysteresis is how far a value must come back inside the thresholds before a
+-- firing rule resolves, so readings hovering around a threshold do not flap.
+ALTER TABLE sensor_alert_rules ADD COLUMN state TEXT NOT NULL DEFAULT 'ok';
+ALTER TABLE sensor_alert_rules ADD COLUMN hysteresis REAL NOT NULL DEFAULT 0;
+
+-- A history row now spans a firing episode: sent_at is when it started, resolved_at
+-- when it ended, and peak_value the most extreme reading seen while firing (NULL
+-- until a reading exceeds the one that started the episode).
+ALTER TABLE alert_sent_history ADD COLUMN resolved_at TEXT;
+ALTER TABLE alert_sent_history ADD COLUMN peak_value REAL;
+
+-- Earlier alerts were one-off events.
+UPDATE alert_sent_history SET resolved_at = sent_at;
+
+CREATE INDEX IF NOT EXISTS idx_alert_rule_open ON alert_sent_history (alert_rule_id) WHERE resolved_at IS NULL;
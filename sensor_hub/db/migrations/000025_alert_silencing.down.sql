@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_alert_silence_windows_sensor;
+DROP TABLE IF EXISTS alert_silence_windows;
+ALTER TABLE alert_sent_history DROP COLUMN acknowledged_by;
+ALTER TABLE alert_sent_history DROP COLUMN acknowledged_at;
+ALTER TABLE sensor_alert_rules DROP COLUMN snoozed_until;
@@ -0,0 +1,26 @@
+-- A snoozed rule keeps tracking state and history but sends no notifications until
+-- snoozed_until has passed.
+ALTER TABLE sensor_alert_rules ADD COLUMN snoozed_until TEXT;
+
+-- Acknowledging a firing episode stops any further notifications for it, including
+-- the one sent when it resolves.
+ALTER TABLE alert_sent_history ADD COLUMN acknowledged_at TEXT;
+ALTER TABLE alert_sent_history ADD COLUMN acknowledged_by INTEGER REFERENCES users(id) ON DELETE SET NULL;
+
+-- Recurring daily periods during which alert notifications are suppressed, for one
+-- sensor or (sensor_id NULL) for every sensor. days is a comma-separated list of
+-- mon..sun, empty for every day; start_time/end_time are HH:MM in the hub's local time.
+CREATE TABLE IF NOT EXISTS alert_silence_windows (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    name TEXT NOT NULL,
+    sensor_id INTEGER,
+    days TEXT NOT NULL DEFAULT '',
+    start_time TEXT NOT NULL,
+    end_time TEXT NOT NULL,
+    enabled INTEGER NOT NULL DEFAULT 1,
+    created_at TEXT DEFAULT (datetime('now')),
+    updated_at TEXT DEFAULT (datetime('now')),
+    FOREIGN KEY (sensor_id) REFERENCES sensors(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_alert_silence_windows_sensor ON alert_silence_windows (sensor_id);
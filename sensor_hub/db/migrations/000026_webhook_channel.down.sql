@@ -0,0 +1,14 @@
+DELETE FROM role_permissions
+WHERE permission_id IN (
+    SELECT id FROM permissions WHERE name = 'manage_webhooks'
+);
+
+DELETE FROM permissions
+WHERE name = 'manage_webhooks';
+
+DROP INDEX IF EXISTS idx_webhook_deliveries_target;
+DROP TABLE IF EXISTS webhook_deliveries;
+DROP INDEX IF EXISTS idx_webhook_targets_user;
+DROP TABLE IF EXISTS webhook_targets;
+ALTER TABLE notification_channel_preferences DROP COLUMN webhook_enabled;
+ALTER TABLE notification_channel_defaults DROP COLUMN webhook_enabled;
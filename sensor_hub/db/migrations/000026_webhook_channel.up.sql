@@ -0,0 +1,59 @@
+-- Webhooks are a third notification channel alongside email and in-app.
+ALTER TABLE notification_channel_defaults ADD COLUMN webhook_enabled INTEGER NOT NULL DEFAULT 0;
+UPDATE notification_channel_defaults SET webhook_enabled = 1 WHERE category = 'threshold_alert';
+ALTER TABLE notification_channel_preferences ADD COLUMN webhook_enabled INTEGER NOT NULL DEFAULT 0;
+UPDATE notification_channel_preferences
+SET webhook_enabled = COALESCE((
+    SELECT d.webhook_enabled FROM notification_channel_defaults d
+    WHERE d.category = notification_channel_preferences.category
+), 0);
+
+-- Outbound webhook targets. A target with a user_id receives that user's notifications
+-- when their webhook channel is enabled for the category; a target without one is global
+-- and receives every notification in its categories (comma-separated, empty for all).
+-- headers is a JSON object; body_template is a Go text/template, empty for the default
+-- JSON body.
+CREATE TABLE IF NOT EXISTS webhook_targets (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    user_id INTEGER,
+    name TEXT NOT NULL,
+    url TEXT NOT NULL,
+    secret TEXT NOT NULL DEFAULT '',
+    headers TEXT NOT NULL DEFAULT '{}',
+    body_template TEXT NOT NULL DEFAULT '',
+    categories TEXT NOT NULL DEFAULT '',
+    enabled INTEGER NOT NULL DEFAULT 1,
+    created_at TEXT DEFAULT (datetime('now')),
+    updated_at TEXT DEFAULT (datetime('now')),
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_webhook_targets_user ON webhook_targets (user_id);
+
+-- One row per notification delivered to a target, recording the final outcome after
+-- retries. Rows go when their target or notification is deleted.
+CREATE TABLE IF NOT EXISTS webhook_deliveries (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    target_id INTEGER NOT NULL,
+    notification_id INTEGER,
+    status TEXT NOT NULL,
+    attempts INTEGER NOT NULL,
+    response_code INTEGER,
+    error TEXT NOT NULL DEFAULT '',
+    created_at TEXT DEFAULT (datetime('now')),
+    FOREIGN KEY (target_id) REFERENCES webhook_targets(id) ON DELETE CASCADE,
+    FOREIGN KEY (notification_id) REFERENCES notifications(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_target ON webhook_deliveries (target_id, created_at DESC);
+
+-- Global webhook targets receive every user's notifications, so managing them is
+-- reserved for administrators.
+INSERT OR IGNORE INTO permissions (name, description)
+VALUES ('manage_webhooks', 'Create and manage global notification webhooks');
+
+INSERT OR IGNORE INTO role_permissions (role_id, permission_id)
+SELECT r.id, p.id
+FROM roles r
+JOIN permissions p ON p.name = 'manage_webhooks'
+WHERE r.name = 'admin';
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS push_subscriptions;
+
+ALTER TABLE notification_channel_preferences DROP COLUMN push_enabled;
+ALTER TABLE notification_channel_defaults DROP COLUMN push_enabled;
@@ -0,0 +1,25 @@
+-- Push notifications (ntfy / Gotify) are a fourth channel alongside email, in-app and
+-- webhooks. Like webhooks they are on for threshold alerts by default, so configuring a
+-- push subscription is enough to start receiving alerts on a phone.
+ALTER TABLE notification_channel_defaults ADD COLUMN push_enabled INTEGER NOT NULL DEFAULT 0;
+UPDATE notification_channel_defaults SET push_enabled = 1 WHERE category = 'threshold_alert';
+ALTER TABLE notification_channel_preferences ADD COLUMN push_enabled INTEGER NOT NULL DEFAULT 0;
+UPDATE notification_channel_preferences
+SET push_enabled = COALESCE((
+    SELECT d.push_enabled FROM notification_channel_defaults d
+    WHERE d.category = notification_channel_preferences.category
+), 0);
+
+-- One push subscription per user. For ntfy, topic is the topic published to and token an
+-- optional access token; for Gotify, token is the application token and topic is unused.
+CREATE TABLE IF NOT EXISTS push_subscriptions (
+    user_id INTEGER PRIMARY KEY,
+    provider TEXT NOT NULL CHECK (provider IN ('ntfy', 'gotify')),
+    server_url TEXT NOT NULL,
+    topic TEXT NOT NULL DEFAULT '',
+    token TEXT NOT NULL DEFAULT '',
+    enabled INTEGER NOT NULL DEFAULT 1,
+    created_at TEXT DEFAULT (datetime('now')),
+    updated_at TEXT DEFAULT (datetime('now')),
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
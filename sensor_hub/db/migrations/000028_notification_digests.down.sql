@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_notification_email_queue_deliver_after;
+DROP TABLE IF EXISTS notification_email_queue;
+DROP TABLE IF EXISTS notification_quiet_hours;
+
+ALTER TABLE notification_channel_preferences DROP COLUMN email_digest;
+ALTER TABLE notification_channel_defaults DROP COLUMN email_digest;
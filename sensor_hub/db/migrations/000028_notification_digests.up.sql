@@ -0,0 +1,30 @@
+-- Email can be batched per category into an hourly or daily digest instead of being sent
+-- as each notification is created. 'off' keeps the existing behaviour.
+ALTER TABLE notification_channel_defaults ADD COLUMN email_digest TEXT NOT NULL DEFAULT 'off'
+    CHECK (email_digest IN ('off', 'hourly', 'daily'));
+ALTER TABLE notification_channel_preferences ADD COLUMN email_digest TEXT NOT NULL DEFAULT 'off'
+    CHECK (email_digest IN ('off', 'hourly', 'daily'));
+
+-- One quiet hours period per user, as "HH:MM" times in the hub's local time.
+CREATE TABLE IF NOT EXISTS notification_quiet_hours (
+    user_id INTEGER PRIMARY KEY,
+    start_time TEXT NOT NULL,
+    end_time TEXT NOT NULL,
+    enabled INTEGER NOT NULL DEFAULT 1,
+    created_at TEXT DEFAULT (datetime('now')),
+    updated_at TEXT DEFAULT (datetime('now')),
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+-- Emails held back by a digest or quiet hours, sent together once deliver_after passes.
+CREATE TABLE IF NOT EXISTS notification_email_queue (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    user_id INTEGER NOT NULL,
+    notification_id INTEGER NOT NULL,
+    deliver_after TEXT NOT NULL,
+    created_at TEXT DEFAULT (datetime('now')),
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    FOREIGN KEY (notification_id) REFERENCES notifications(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_notification_email_queue_deliver_after ON notification_email_queue (deliver_after);
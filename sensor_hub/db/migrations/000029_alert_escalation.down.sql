@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS alert_escalation_notifications;
+DROP INDEX IF EXISTS idx_alert_escalations_due_at;
+DROP TABLE IF EXISTS alert_escalations;
+DROP TABLE IF EXISTS alert_escalation_steps;
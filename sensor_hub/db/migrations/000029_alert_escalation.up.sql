@@ -0,0 +1,37 @@
+-- Escalation policy of an alert rule. While the rule's firing notification has not been
+-- read, dismissed or acknowledged, each step sends it again delay_minutes after the
+-- previous notification. role narrows the recipients to users with that role (empty for
+-- everyone the alert went to); channels is a comma-separated list of email, webhook and
+-- push that overrides the recipients' channel preferences (empty to follow them).
+CREATE TABLE IF NOT EXISTS alert_escalation_steps (
+    alert_rule_id INTEGER NOT NULL,
+    position INTEGER NOT NULL,
+    delay_minutes INTEGER NOT NULL,
+    role TEXT NOT NULL DEFAULT '',
+    channels TEXT NOT NULL DEFAULT '',
+    PRIMARY KEY (alert_rule_id, position),
+    FOREIGN KEY (alert_rule_id) REFERENCES sensor_alert_rules(id) ON DELETE CASCADE
+);
+
+-- A firing notification working through its rule's escalation policy. next_step is the
+-- position of the step that falls due at due_at. A rule has at most one escalation.
+CREATE TABLE IF NOT EXISTS alert_escalations (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    alert_rule_id INTEGER NOT NULL UNIQUE,
+    next_step INTEGER NOT NULL DEFAULT 0,
+    due_at TEXT NOT NULL,
+    started_at TEXT DEFAULT (datetime('now')),
+    FOREIGN KEY (alert_rule_id) REFERENCES sensor_alert_rules(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_alert_escalations_due_at ON alert_escalations (due_at);
+
+-- The notifications sent for an escalation, starting with the one that fired it. Reading
+-- or dismissing any of them ends the escalation.
+CREATE TABLE IF NOT EXISTS alert_escalation_notifications (
+    escalation_id INTEGER NOT NULL,
+    notification_id INTEGER NOT NULL,
+    PRIMARY KEY (escalation_id, notification_id),
+    FOREIGN KEY (escalation_id) REFERENCES alert_escalations(id) ON DELETE CASCADE,
+    FOREIGN KEY (notification_id) REFERENCES notifications(id) ON DELETE CASCADE
+);
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_alert_rule_recipients_rule;
+DROP TABLE IF EXISTS alert_rule_recipients;
+DROP TABLE IF EXISTS contact_group_members;
+DROP TABLE IF EXISTS contact_groups;
@@ -0,0 +1,33 @@
+-- Named sets of users that alert rules can notify together.
+CREATE TABLE IF NOT EXISTS contact_groups (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    name TEXT NOT NULL UNIQUE,
+    created_at TEXT DEFAULT (datetime('now')),
+    updated_at TEXT DEFAULT (datetime('now'))
+);
+
+CREATE TABLE IF NOT EXISTS contact_group_members (
+    contact_group_id INTEGER NOT NULL,
+    user_id INTEGER NOT NULL,
+    PRIMARY KEY (contact_group_id, user_id),
+    FOREIGN KEY (contact_group_id) REFERENCES contact_groups(id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+-- Who an alert rule's notifications go to. Each row names exactly one user, role or
+-- contact group. A rule with no rows notifies every user with view_alerts, which is also
+-- what happens once every recipient it named has been deleted.
+CREATE TABLE IF NOT EXISTS alert_rule_recipients (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    alert_rule_id INTEGER NOT NULL,
+    user_id INTEGER,
+    role_id INTEGER,
+    contact_group_id INTEGER,
+    FOREIGN KEY (alert_rule_id) REFERENCES sensor_alert_rules(id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    FOREIGN KEY (role_id) REFERENCES roles(id) ON DELETE CASCADE,
+    FOREIGN KEY (contact_group_id) REFERENCES contact_groups(id) ON DELETE CASCADE,
+    CHECK ((user_id IS NOT NULL) + (role_id IS NOT NULL) + (contact_group_id IS NOT NULL) = 1)
+);
+
+CREATE INDEX IF NOT EXISTS idx_alert_rule_recipients_rule ON alert_rule_recipients (alert_rule_id);
@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS readings_rollup_state;
+DROP INDEX IF EXISTS idx_readings_rollup_1h_time;
+DROP TABLE IF EXISTS readings_rollup_1h;
+DROP INDEX IF EXISTS idx_readings_rollup_5m_time;
+DROP TABLE IF EXISTS readings_rollup_5m;
@@ -0,0 +1,49 @@
+-- Pre-aggregated readings for long-range charts. Each row summarises one sensor's readings
+-- of one measurement type within a 5-minute or 1-hour bucket. The rows are kept up to date
+-- by a background task and are not removed by raw readings retention.
+CREATE TABLE IF NOT EXISTS readings_rollup_5m (
+    sensor_id INTEGER NOT NULL,
+    measurement_type_id INTEGER NOT NULL,
+    bucket_time TEXT NOT NULL,
+    reading_count INTEGER NOT NULL,
+    numeric_count INTEGER NOT NULL,
+    numeric_sum REAL NOT NULL,
+    min_value REAL,
+    max_value REAL,
+    last_time TEXT NOT NULL,
+    last_numeric_value REAL,
+    last_text_state TEXT,
+    PRIMARY KEY (sensor_id, measurement_type_id, bucket_time),
+    FOREIGN KEY (sensor_id) REFERENCES sensors(id) ON DELETE CASCADE,
+    FOREIGN KEY (measurement_type_id) REFERENCES measurement_types(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_readings_rollup_5m_time ON readings_rollup_5m (bucket_time);
+
+CREATE TABLE IF NOT EXISTS readings_rollup_1h (
+    sensor_id INTEGER NOT NULL,
+    measurement_type_id INTEGER NOT NULL,
+    bucket_time TEXT NOT NULL,
+    reading_count INTEGER NOT NULL,
+    numeric_count INTEGER NOT NULL,
+    numeric_sum REAL NOT NULL,
+    min_value REAL,
+    max_value REAL,
+    last_time TEXT NOT NULL,
+    last_numeric_value REAL,
+    last_text_state TEXT,
+    PRIMARY KEY (sensor_id, measurement_type_id, bucket_time),
+    FOREIGN KEY (sensor_id) REFERENCES sensors(id) ON DELETE CASCADE,
+    FOREIGN KEY (measurement_type_id) REFERENCES measurement_types(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_readings_rollup_1h_time ON readings_rollup_1h (bucket_time);
+
+-- The highest readings ID already folded into the rollups. Readings above it are still
+-- aggregated at query time. Starting at 0 rolls up existing readings on the first refresh.
+CREATE TABLE IF NOT EXISTS readings_rollup_state (
+    id INTEGER PRIMARY KEY CHECK (id = 1),
+    last_reading_id INTEGER NOT NULL
+);
+
+INSERT OR IGNORE INTO readings_rollup_state (id, last_reading_id) VALUES (1, 0);
@@ -0,0 +1,3 @@
+DELETE FROM readings WHERE downsampled = 1;
+ALTER TABLE readings DROP COLUMN downsampled;
+ALTER TABLE measurement_types DROP COLUMN retention_downsample_interval;
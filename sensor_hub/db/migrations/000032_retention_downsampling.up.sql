@@ -0,0 +1,7 @@
+-- Bucket interval (ISO 8601, e.g. PT1H) that raw readings of this measurement type are
+-- downsampled to when they expire, instead of being deleted. NULL deletes them.
+ALTER TABLE measurement_types ADD COLUMN retention_downsample_interval TEXT;
+
+-- Readings produced by downsampling expired raw readings. Raw readings retention leaves
+-- them alone; they are already counted in the readings rollups.
+ALTER TABLE readings ADD COLUMN downsampled INTEGER NOT NULL DEFAULT 0;
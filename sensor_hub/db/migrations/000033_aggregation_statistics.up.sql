@@ -0,0 +1,23 @@
+-- Migration 000033: Percentile aggregation functions
+-- SQLite cannot alter a CHECK constraint, so the table is rebuilt to allow p50 and p95.
+
+CREATE TABLE measurement_type_aggregations_new (
+    measurement_type_id INTEGER NOT NULL,
+    function TEXT NOT NULL CHECK (function IN ('avg', 'count', 'last', 'min', 'max', 'sum', 'p50', 'p95')),
+    is_default INTEGER NOT NULL DEFAULT 0,
+    PRIMARY KEY (measurement_type_id, function),
+    FOREIGN KEY (measurement_type_id) REFERENCES measurement_types(id) ON DELETE CASCADE
+);
+
+INSERT INTO measurement_type_aggregations_new (measurement_type_id, function, is_default)
+SELECT measurement_type_id, function, is_default FROM measurement_type_aggregations;
+
+DROP TABLE measurement_type_aggregations;
+ALTER TABLE measurement_type_aggregations_new RENAME TO measurement_type_aggregations;
+
+-- Averaged numeric types also support the band and distribution statistics.
+INSERT OR IGNORE INTO measurement_type_aggregations (measurement_type_id, function, is_default)
+SELECT a.measurement_type_id, f.function, 0
+FROM measurement_type_aggregations a
+CROSS JOIN (SELECT 'min' AS function UNION ALL SELECT 'max' UNION ALL SELECT 'p50' UNION ALL SELECT 'p95') f
+WHERE a.function = 'avg';
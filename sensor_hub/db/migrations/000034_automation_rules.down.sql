@@ -0,0 +1,13 @@
+DELETE FROM role_permissions
+WHERE permission_id IN (
+    SELECT id FROM permissions WHERE name IN ('view_automations', 'manage_automations')
+);
+
+DELETE FROM permissions
+WHERE name IN ('view_automations', 'manage_automations');
+
+ALTER TABLE sensor_command_history DROP COLUMN automation_rule_id;
+
+DROP INDEX IF EXISTS idx_automation_rules_alert;
+DROP INDEX IF EXISTS idx_automation_rules_sensor;
+DROP TABLE IF EXISTS automation_rules;
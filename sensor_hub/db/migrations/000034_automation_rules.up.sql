@@ -0,0 +1,59 @@
+-- Automation rules send a command to one capability (property) of a controllable sensor
+-- when their trigger fires:
+--   reading:  a reading of sensor_id/measurement_type_id starts meeting comparison
+--             against threshold, or against status for text states;
+--   alert:    the single-sensor alert rule alert_rule_id starts firing;
+--   schedule: the hub's local time reaches schedule_time (HH:MM) on one of
+--             schedule_days (comma-separated, empty for every day).
+-- active records whether a reading rule's condition was met by the latest reading, so the
+-- command is sent once when the condition becomes met rather than on every reading.
+CREATE TABLE IF NOT EXISTS automation_rules (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    name TEXT NOT NULL UNIQUE,
+    enabled INTEGER NOT NULL DEFAULT 1,
+    trigger_type TEXT NOT NULL CHECK (trigger_type IN ('reading', 'alert', 'schedule')),
+    sensor_id INTEGER,
+    measurement_type_id INTEGER,
+    comparison TEXT NOT NULL DEFAULT '',
+    threshold REAL,
+    status TEXT NOT NULL DEFAULT '',
+    alert_rule_id INTEGER,
+    schedule_time TEXT NOT NULL DEFAULT '',
+    schedule_days TEXT NOT NULL DEFAULT '',
+    target_sensor_id INTEGER NOT NULL,
+    property TEXT NOT NULL,
+    value TEXT NOT NULL,
+    active INTEGER NOT NULL DEFAULT 0,
+    last_triggered_at DATETIME,
+    created_at TEXT DEFAULT (datetime('now')),
+    updated_at TEXT DEFAULT (datetime('now')),
+    FOREIGN KEY (sensor_id) REFERENCES sensors(id) ON DELETE CASCADE,
+    FOREIGN KEY (measurement_type_id) REFERENCES measurement_types(id) ON DELETE CASCADE,
+    FOREIGN KEY (alert_rule_id) REFERENCES sensor_alert_rules(id) ON DELETE CASCADE,
+    FOREIGN KEY (target_sensor_id) REFERENCES sensors(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_automation_rules_sensor ON automation_rules (sensor_id, measurement_type_id);
+CREATE INDEX IF NOT EXISTS idx_automation_rules_alert ON automation_rules (alert_rule_id);
+
+-- The rule that issued a command; NULL for commands sent by a user. It is not a foreign
+-- key so that Command History keeps the rule ID after the rule is deleted.
+ALTER TABLE sensor_command_history ADD COLUMN automation_rule_id INTEGER;
+
+INSERT OR IGNORE INTO permissions (name, description)
+VALUES ('view_automations', 'View automation rules');
+
+INSERT OR IGNORE INTO permissions (name, description)
+VALUES ('manage_automations', 'Create and manage automation rules that send commands to controllable sensors');
+
+INSERT OR IGNORE INTO role_permissions (role_id, permission_id)
+SELECT r.id, p.id
+FROM roles r
+JOIN permissions p ON p.name = 'view_automations'
+WHERE r.name IN ('admin', 'user', 'viewer');
+
+INSERT OR IGNORE INTO role_permissions (role_id, permission_id)
+SELECT r.id, p.id
+FROM roles r
+JOIN permissions p ON p.name = 'manage_automations'
+WHERE r.name = 'admin';
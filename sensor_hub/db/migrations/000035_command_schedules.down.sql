@@ -0,0 +1,13 @@
+DELETE FROM role_permissions
+WHERE permission_id IN (
+    SELECT id FROM permissions WHERE name IN ('view_schedules', 'manage_schedules')
+);
+
+DELETE FROM permissions
+WHERE name IN ('view_schedules', 'manage_schedules');
+
+ALTER TABLE sensor_command_history DROP COLUMN command_schedule_id;
+
+DROP TABLE IF EXISTS schedule_holiday_mode;
+DROP INDEX IF EXISTS idx_command_schedules_next_run;
+DROP TABLE IF EXISTS command_schedules;
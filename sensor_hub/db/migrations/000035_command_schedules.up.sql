@@ -0,0 +1,55 @@
+-- Command schedules send value to one capability (property) of a controllable sensor at
+-- every minute matching cron_expression (minute hour day-of-month month day-of-week) in
+-- the hub's local time. next_run_at is kept up to date so that a run that fell due while
+-- the hub was down is noticed at startup. holiday is what the schedule does while holiday
+-- mode is on: skip it, run anyway, or run only then.
+CREATE TABLE IF NOT EXISTS command_schedules (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    name TEXT NOT NULL UNIQUE,
+    enabled INTEGER NOT NULL DEFAULT 1,
+    sensor_id INTEGER NOT NULL,
+    property TEXT NOT NULL,
+    value TEXT NOT NULL,
+    cron_expression TEXT NOT NULL,
+    holiday TEXT NOT NULL DEFAULT 'skip' CHECK (holiday IN ('skip', 'run', 'only')),
+    next_run_at DATETIME,
+    last_run_at DATETIME,
+    last_command_id INTEGER,
+    last_error TEXT NOT NULL DEFAULT '',
+    created_at TEXT DEFAULT (datetime('now')),
+    updated_at TEXT DEFAULT (datetime('now')),
+    FOREIGN KEY (sensor_id) REFERENCES sensors(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_command_schedules_next_run ON command_schedules (next_run_at);
+
+-- Holiday mode is a single row; until, when set, ends it automatically.
+CREATE TABLE IF NOT EXISTS schedule_holiday_mode (
+    id INTEGER PRIMARY KEY CHECK (id = 1),
+    enabled INTEGER NOT NULL DEFAULT 0,
+    until DATETIME
+);
+
+INSERT OR IGNORE INTO schedule_holiday_mode (id, enabled) VALUES (1, 0);
+
+-- The schedule that issued a command; NULL for other commands. It is not a foreign key so
+-- that Command History keeps the schedule ID after the schedule is deleted.
+ALTER TABLE sensor_command_history ADD COLUMN command_schedule_id INTEGER;
+
+INSERT OR IGNORE INTO permissions (name, description)
+VALUES ('view_schedules', 'View command schedules and holiday mode');
+
+INSERT OR IGNORE INTO permissions (name, description)
+VALUES ('manage_schedules', 'Create and manage command schedules and switch holiday mode');
+
+INSERT OR IGNORE INTO role_permissions (role_id, permission_id)
+SELECT r.id, p.id
+FROM roles r
+JOIN permissions p ON p.name = 'view_schedules'
+WHERE r.name IN ('admin', 'user', 'viewer');
+
+INSERT OR IGNORE INTO role_permissions (role_id, permission_id)
+SELECT r.id, p.id
+FROM roles r
+JOIN permissions p ON p.name = 'manage_schedules'
+WHERE r.name = 'admin';
@@ -0,0 +1,57 @@
+DELETE FROM role_permissions
+WHERE permission_id IN (
+    SELECT id FROM permissions WHERE name IN ('view_scenes', 'manage_scenes')
+);
+
+DELETE FROM permissions
+WHERE name IN ('view_scenes', 'manage_scenes');
+
+-- Rules that activate a scene cannot be expressed without scenes and are dropped.
+CREATE TABLE automation_rules_old (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    name TEXT NOT NULL UNIQUE,
+    enabled INTEGER NOT NULL DEFAULT 1,
+    trigger_type TEXT NOT NULL CHECK (trigger_type IN ('reading', 'alert', 'schedule')),
+    sensor_id INTEGER,
+    measurement_type_id INTEGER,
+    comparison TEXT NOT NULL DEFAULT '',
+    threshold REAL,
+    status TEXT NOT NULL DEFAULT '',
+    alert_rule_id INTEGER,
+    schedule_time TEXT NOT NULL DEFAULT '',
+    schedule_days TEXT NOT NULL DEFAULT '',
+    target_sensor_id INTEGER NOT NULL,
+    property TEXT NOT NULL,
+    value TEXT NOT NULL,
+    active INTEGER NOT NULL DEFAULT 0,
+    last_triggered_at DATETIME,
+    created_at TEXT DEFAULT (datetime('now')),
+    updated_at TEXT DEFAULT (datetime('now')),
+    FOREIGN KEY (sensor_id) REFERENCES sensors(id) ON DELETE CASCADE,
+    FOREIGN KEY (measurement_type_id) REFERENCES measurement_types(id) ON DELETE CASCADE,
+    FOREIGN KEY (alert_rule_id) REFERENCES sensor_alert_rules(id) ON DELETE CASCADE,
+    FOREIGN KEY (target_sensor_id) REFERENCES sensors(id) ON DELETE CASCADE
+);
+
+INSERT INTO automation_rules_old (
+    id, name, enabled, trigger_type, sensor_id, measurement_type_id, comparison, threshold, status,
+    alert_rule_id, schedule_time, schedule_days, target_sensor_id, property, value, active,
+    last_triggered_at, created_at, updated_at
+)
+SELECT
+    id, name, enabled, trigger_type, sensor_id, measurement_type_id, comparison, threshold, status,
+    alert_rule_id, schedule_time, schedule_days, target_sensor_id, property, value, active,
+    last_triggered_at, created_at, updated_at
+FROM automation_rules
+WHERE target_sensor_id IS NOT NULL;
+
+DROP INDEX IF EXISTS idx_automation_rules_alert;
+DROP INDEX IF EXISTS idx_automation_rules_sensor;
+DROP TABLE automation_rules;
+ALTER TABLE automation_rules_old RENAME TO automation_rules;
+
+CREATE INDEX IF NOT EXISTS idx_automation_rules_sensor ON automation_rules (sensor_id, measurement_type_id);
+CREATE INDEX IF NOT EXISTS idx_automation_rules_alert ON automation_rules (alert_rule_id);
+
+DROP TABLE IF EXISTS scene_commands;
+DROP TABLE IF EXISTS scenes;
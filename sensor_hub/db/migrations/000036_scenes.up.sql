@@ -0,0 +1,94 @@
+-- Scenes are named sets of commands, one per capability (property) of a controllable
+-- sensor, that are sent together when the scene is activated.
+-- last_activation_status is the aggregated outcome of the last activation whose commands
+-- all finished: succeeded, partial or failed.
+CREATE TABLE IF NOT EXISTS scenes (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    name TEXT NOT NULL UNIQUE,
+    description TEXT NOT NULL DEFAULT '',
+    last_activated_at DATETIME,
+    last_activation_status TEXT NOT NULL DEFAULT '',
+    created_at TEXT DEFAULT (datetime('now')),
+    updated_at TEXT DEFAULT (datetime('now'))
+);
+
+CREATE TABLE IF NOT EXISTS scene_commands (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    scene_id INTEGER NOT NULL,
+    position INTEGER NOT NULL,
+    sensor_id INTEGER NOT NULL,
+    property TEXT NOT NULL,
+    value TEXT NOT NULL,
+    UNIQUE (scene_id, sensor_id, property),
+    FOREIGN KEY (scene_id) REFERENCES scenes(id) ON DELETE CASCADE,
+    FOREIGN KEY (sensor_id) REFERENCES sensors(id) ON DELETE CASCADE
+);
+
+-- Automation rules may activate a scene instead of sending a single command. SQLite
+-- cannot drop a NOT NULL constraint, so the table is rebuilt with the command target
+-- optional and exactly one of target_sensor_id and scene_id set.
+CREATE TABLE automation_rules_new (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    name TEXT NOT NULL UNIQUE,
+    enabled INTEGER NOT NULL DEFAULT 1,
+    trigger_type TEXT NOT NULL CHECK (trigger_type IN ('reading', 'alert', 'schedule')),
+    sensor_id INTEGER,
+    measurement_type_id INTEGER,
+    comparison TEXT NOT NULL DEFAULT '',
+    threshold REAL,
+    status TEXT NOT NULL DEFAULT '',
+    alert_rule_id INTEGER,
+    schedule_time TEXT NOT NULL DEFAULT '',
+    schedule_days TEXT NOT NULL DEFAULT '',
+    target_sensor_id INTEGER,
+    property TEXT NOT NULL DEFAULT '',
+    value TEXT NOT NULL DEFAULT '',
+    scene_id INTEGER,
+    active INTEGER NOT NULL DEFAULT 0,
+    last_triggered_at DATETIME,
+    created_at TEXT DEFAULT (datetime('now')),
+    updated_at TEXT DEFAULT (datetime('now')),
+    CHECK ((target_sensor_id IS NULL) <> (scene_id IS NULL)),
+    FOREIGN KEY (sensor_id) REFERENCES sensors(id) ON DELETE CASCADE,
+    FOREIGN KEY (measurement_type_id) REFERENCES measurement_types(id) ON DELETE CASCADE,
+    FOREIGN KEY (alert_rule_id) REFERENCES sensor_alert_rules(id) ON DELETE CASCADE,
+    FOREIGN KEY (target_sensor_id) REFERENCES sensors(id) ON DELETE CASCADE,
+    FOREIGN KEY (scene_id) REFERENCES scenes(id) ON DELETE CASCADE
+);
+
+INSERT INTO automation_rules_new (
+    id, name, enabled, trigger_type, sensor_id, measurement_type_id, comparison, threshold, status,
+    alert_rule_id, schedule_time, schedule_days, target_sensor_id, property, value, active,
+    last_triggered_at, created_at, updated_at
+)
+SELECT
+    id, name, enabled, trigger_type, sensor_id, measurement_type_id, comparison, threshold, status,
+    alert_rule_id, schedule_time, schedule_days, target_sensor_id, property, value, active,
+    last_triggered_at, created_at, updated_at
+FROM automation_rules;
+
+DROP INDEX IF EXISTS idx_automation_rules_alert;
+DROP INDEX IF EXISTS idx_automation_rules_sensor;
+DROP TABLE automation_rules;
+ALTER TABLE automation_rules_new RENAME TO automation_rules;
+
+CREATE INDEX IF NOT EXISTS idx_automation_rules_sensor ON automation_rules (sensor_id, measurement_type_id);
+CREATE INDEX IF NOT EXISTS idx_automation_rules_alert ON automation_rules (alert_rule_id);
+
+INSERT OR IGNORE INTO permissions (name, description)
+VALUES ('view_scenes', 'View scenes');
+
+INSERT OR IGNORE INTO permissions (name, description)
+VALUES ('manage_scenes', 'Create and manage scenes of commands to controllable sensors');
+
+INSERT OR IGNORE INTO role_permissions (role_id, permission_id)
+SELECT r.id, p.id
+FROM roles r
+JOIN permissions p ON p.name = 'view_scenes'
+WHERE r.name IN ('admin', 'user', 'viewer');
+
+INSERT OR IGNORE INTO role_permissions (role_id, permission_id)
+SELECT r.id, p.id
+FROM roles r
+JOIN permissions p ON p.name = 'manage_scenes'
+WHERE r.name = 'admin';
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_desired_states_next_retry;
+DROP TABLE IF EXISTS desired_states;
@@ -0,0 +1,21 @@
+-- A desired state is the value the hub keeps one capability (property) of a controllable
+-- sensor at. status is pending while a command awaits its outcome, in_sync once the
+-- device reports value, retrying while waiting for next_retry_at to send it again, and
+-- waiting while the device cannot be reached. attempts counts the retries since the
+-- capability was last in sync.
+CREATE TABLE IF NOT EXISTS desired_states (
+    sensor_id INTEGER NOT NULL,
+    property TEXT NOT NULL,
+    value TEXT NOT NULL,
+    status TEXT NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'in_sync', 'retrying', 'waiting')),
+    attempts INTEGER NOT NULL DEFAULT 0,
+    next_retry_at DATETIME,
+    reported_value TEXT NOT NULL DEFAULT '',
+    last_error TEXT NOT NULL DEFAULT '',
+    created_at TEXT DEFAULT (datetime('now')),
+    updated_at TEXT DEFAULT (datetime('now')),
+    PRIMARY KEY (sensor_id, property),
+    FOREIGN KEY (sensor_id) REFERENCES sensors(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_desired_states_next_retry ON desired_states (next_retry_at);
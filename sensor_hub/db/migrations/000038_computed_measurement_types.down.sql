@@ -0,0 +1 @@
+DELETE FROM measurement_types WHERE name IN ('dew_point', 'temperature_difference');
@@ -0,0 +1,15 @@
+-- Migration 000038: Seed measurement types produced by computed sensors.
+
+INSERT OR IGNORE INTO measurement_types (name, display_name, category, default_unit) VALUES
+    ('dew_point', 'Dew Point', 'numeric', '°C'),
+    ('temperature_difference', 'Temperature Difference', 'numeric', '°C');
+
+INSERT OR IGNORE INTO measurement_type_aggregations (measurement_type_id, function, is_default)
+SELECT id, 'avg', 1 FROM measurement_types
+WHERE name IN ('dew_point', 'temperature_difference');
+
+INSERT OR IGNORE INTO measurement_type_aggregations (measurement_type_id, function, is_default)
+SELECT mt.id, f.function, 0
+FROM measurement_types mt
+CROSS JOIN (SELECT 'min' AS function UNION ALL SELECT 'max' UNION ALL SELECT 'p50' UNION ALL SELECT 'p95') f
+WHERE mt.name IN ('dew_point', 'temperature_difference');
@@ -0,0 +1,11 @@
+DELETE FROM measurement_type_aggregations
+WHERE measurement_type_id IN (SELECT id FROM measurement_types WHERE name = 'wind_direction');
+
+INSERT OR IGNORE INTO measurement_type_aggregations (measurement_type_id, function, is_default)
+SELECT id, 'avg', 1 FROM measurement_types WHERE name = 'wind_direction';
+
+INSERT OR IGNORE INTO measurement_type_aggregations (measurement_type_id, function, is_default)
+SELECT mt.id, f.function, 0
+FROM measurement_types mt
+CROSS JOIN (SELECT 'min' AS function UNION ALL SELECT 'max' UNION ALL SELECT 'p50' UNION ALL SELECT 'p95') f
+WHERE mt.name = 'wind_direction';
@@ -0,0 +1,10 @@
+-- Migration 000039: Stop averaging wind direction.
+-- An arithmetic mean of angles is wrong across north (350° and 10° average to 180°), and
+-- so are the band and percentile statistics 000033 derived from it. The last direction in
+-- a bucket is used instead.
+
+DELETE FROM measurement_type_aggregations
+WHERE measurement_type_id IN (SELECT id FROM measurement_types WHERE name = 'wind_direction');
+
+INSERT OR IGNORE INTO measurement_type_aggregations (measurement_type_id, function, is_default)
+SELECT id, 'last', 1 FROM measurement_types WHERE name = 'wind_direction';
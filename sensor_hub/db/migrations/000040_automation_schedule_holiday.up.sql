@@ -0,0 +1,3 @@
+-- schedule_holiday is what a schedule rule does while holiday mode is on, as for command
+-- schedules: skip it, run anyway, or run only then.
+ALTER TABLE automation_rules ADD COLUMN schedule_holiday TEXT NOT NULL DEFAULT 'skip' CHECK (schedule_holiday IN ('skip', 'run', 'only'));
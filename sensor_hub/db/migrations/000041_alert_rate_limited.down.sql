@@ -0,0 +1 @@
+ALTER TABLE alert_sent_history DROP COLUMN rate_limited;
@@ -0,0 +1,3 @@
+-- A rate-limited episode still moves the rule to firing and is recorded here, but sends
+-- no notifications, not even the one when it resolves.
+ALTER TABLE alert_sent_history ADD COLUMN rate_limited INTEGER NOT NULL DEFAULT 0;
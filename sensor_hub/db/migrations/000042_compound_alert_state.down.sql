@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_compound_rule_open;
+ALTER TABLE compound_alert_rules DROP COLUMN hysteresis;
+ALTER TABLE compound_alert_rules DROP COLUMN state;
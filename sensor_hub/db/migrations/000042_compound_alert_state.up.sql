@@ -0,0 +1,7 @@
+-- Compound rules track state like simple rules: ok (never fired), firing, or resolved.
+-- hysteresis is how far each numeric condition's value must come back past its
+-- threshold before a firing rule resolves.
+ALTER TABLE compound_alert_rules ADD COLUMN state TEXT NOT NULL DEFAULT 'ok';
+ALTER TABLE compound_alert_rules ADD COLUMN hysteresis REAL NOT NULL DEFAULT 0;
+
+CREATE INDEX IF NOT EXISTS idx_compound_rule_open ON alert_sent_history (compound_rule_id) WHERE resolved_at IS NULL;
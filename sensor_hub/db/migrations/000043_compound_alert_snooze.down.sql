@@ -0,0 +1 @@
+ALTER TABLE compound_alert_rules DROP COLUMN snoozed_until;
@@ -0,0 +1,3 @@
+-- Compound rules can be snoozed like simple rules: they keep tracking state and history
+-- but send no notifications until snoozed_until has passed.
+ALTER TABLE compound_alert_rules ADD COLUMN snoozed_until TEXT;
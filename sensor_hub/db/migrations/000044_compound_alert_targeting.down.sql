@@ -0,0 +1,78 @@
+CREATE TABLE alert_rule_recipients_old (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    alert_rule_id INTEGER NOT NULL,
+    user_id INTEGER,
+    role_id INTEGER,
+    contact_group_id INTEGER,
+    FOREIGN KEY (alert_rule_id) REFERENCES sensor_alert_rules(id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    FOREIGN KEY (role_id) REFERENCES roles(id) ON DELETE CASCADE,
+    FOREIGN KEY (contact_group_id) REFERENCES contact_groups(id) ON DELETE CASCADE,
+    CHECK ((user_id IS NOT NULL) + (role_id IS NOT NULL) + (contact_group_id IS NOT NULL) = 1)
+);
+
+INSERT INTO alert_rule_recipients_old (id, alert_rule_id, user_id, role_id, contact_group_id)
+SELECT id, alert_rule_id, user_id, role_id, contact_group_id
+FROM alert_rule_recipients
+WHERE alert_rule_id IS NOT NULL;
+
+DROP TABLE alert_rule_recipients;
+ALTER TABLE alert_rule_recipients_old RENAME TO alert_rule_recipients;
+
+CREATE INDEX IF NOT EXISTS idx_alert_rule_recipients_rule ON alert_rule_recipients (alert_rule_id);
+
+CREATE TABLE alert_escalation_steps_old (
+    alert_rule_id INTEGER NOT NULL,
+    position INTEGER NOT NULL,
+    delay_minutes INTEGER NOT NULL,
+    role TEXT NOT NULL DEFAULT '',
+    channels TEXT NOT NULL DEFAULT '',
+    PRIMARY KEY (alert_rule_id, position),
+    FOREIGN KEY (alert_rule_id) REFERENCES sensor_alert_rules(id) ON DELETE CASCADE
+);
+
+INSERT INTO alert_escalation_steps_old (alert_rule_id, position, delay_minutes, role, channels)
+SELECT alert_rule_id, position, delay_minutes, role, channels
+FROM alert_escalation_steps
+WHERE alert_rule_id IS NOT NULL;
+
+DROP TABLE alert_escalation_steps;
+ALTER TABLE alert_escalation_steps_old RENAME TO alert_escalation_steps;
+
+CREATE TABLE alert_escalations_old (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    alert_rule_id INTEGER NOT NULL UNIQUE,
+    next_step INTEGER NOT NULL DEFAULT 0,
+    due_at TEXT NOT NULL,
+    started_at TEXT DEFAULT (datetime('now')),
+    FOREIGN KEY (alert_rule_id) REFERENCES sensor_alert_rules(id) ON DELETE CASCADE
+);
+
+INSERT INTO alert_escalations_old (id, alert_rule_id, next_step, due_at, started_at)
+SELECT id, alert_rule_id, next_step, due_at, started_at
+FROM alert_escalations
+WHERE alert_rule_id IS NOT NULL;
+
+CREATE TABLE alert_escalation_notifications_saved AS
+SELECT en.escalation_id, en.notification_id
+FROM alert_escalation_notifications en
+JOIN alert_escalations_old e ON en.escalation_id = e.id;
+
+DROP TABLE alert_escalation_notifications;
+DROP TABLE alert_escalations;
+ALTER TABLE alert_escalations_old RENAME TO alert_escalations;
+
+CREATE INDEX IF NOT EXISTS idx_alert_escalations_due_at ON alert_escalations (due_at);
+
+CREATE TABLE alert_escalation_notifications (
+    escalation_id INTEGER NOT NULL,
+    notification_id INTEGER NOT NULL,
+    PRIMARY KEY (escalation_id, notification_id),
+    FOREIGN KEY (escalation_id) REFERENCES alert_escalations(id) ON DELETE CASCADE,
+    FOREIGN KEY (notification_id) REFERENCES notifications(id) ON DELETE CASCADE
+);
+
+INSERT INTO alert_escalation_notifications (escalation_id, notification_id)
+SELECT escalation_id, notification_id FROM alert_escalation_notifications_saved;
+
+DROP TABLE alert_escalation_notifications_saved;
@@ -0,0 +1,25 @@
+CREATE TABLE desired_states_old (
+    sensor_id INTEGER NOT NULL,
+    property TEXT NOT NULL,
+    value TEXT NOT NULL,
+    status TEXT NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'in_sync', 'retrying', 'waiting')),
+    attempts INTEGER NOT NULL DEFAULT 0,
+    next_retry_at DATETIME,
+    reported_value TEXT NOT NULL DEFAULT '',
+    last_error TEXT NOT NULL DEFAULT '',
+    created_at TEXT DEFAULT (datetime('now')),
+    updated_at TEXT DEFAULT (datetime('now')),
+    PRIMARY KEY (sensor_id, property),
+    FOREIGN KEY (sensor_id) REFERENCES sensors(id) ON DELETE CASCADE
+);
+
+-- Failed desired states wait for the device to report again instead.
+INSERT INTO desired_states_old (sensor_id, property, value, status, attempts, next_retry_at, reported_value, last_error, created_at, updated_at)
+SELECT sensor_id, property, value, CASE WHEN status = 'failed' THEN 'waiting' ELSE status END,
+    attempts, next_retry_at, reported_value, last_error, created_at, updated_at
+FROM desired_states;
+
+DROP TABLE desired_states;
+ALTER TABLE desired_states_old RENAME TO desired_states;
+
+CREATE INDEX IF NOT EXISTS idx_desired_states_next_retry ON desired_states (next_retry_at);
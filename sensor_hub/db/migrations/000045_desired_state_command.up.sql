@@ -0,0 +1,28 @@
+-- command_id is the command last sent for a desired state, whose outcome settles it. A
+-- desired state whose retries run out is failed until it is set again or the device
+-- reports the desired value. SQLite cannot change a CHECK constraint, so the table is
+-- rebuilt.
+CREATE TABLE desired_states_new (
+    sensor_id INTEGER NOT NULL,
+    property TEXT NOT NULL,
+    value TEXT NOT NULL,
+    status TEXT NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'in_sync', 'retrying', 'waiting', 'failed')),
+    attempts INTEGER NOT NULL DEFAULT 0,
+    next_retry_at DATETIME,
+    reported_value TEXT NOT NULL DEFAULT '',
+    last_error TEXT NOT NULL DEFAULT '',
+    command_id INTEGER,
+    created_at TEXT DEFAULT (datetime('now')),
+    updated_at TEXT DEFAULT (datetime('now')),
+    PRIMARY KEY (sensor_id, property),
+    FOREIGN KEY (sensor_id) REFERENCES sensors(id) ON DELETE CASCADE
+);
+
+INSERT INTO desired_states_new (sensor_id, property, value, status, attempts, next_retry_at, reported_value, last_error, created_at, updated_at)
+SELECT sensor_id, property, value, status, attempts, next_retry_at, reported_value, last_error, created_at, updated_at
+FROM desired_states;
+
+DROP TABLE desired_states;
+ALTER TABLE desired_states_new RENAME TO desired_states;
+
+CREATE INDEX IF NOT EXISTS idx_desired_states_next_retry ON desired_states (next_retry_at);
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"fmt"
 	"log/slog"
+	"strings"
 	"time"
 
 	"example/sensorHub/notifications"
@@ -20,7 +21,9 @@ type NotificationRepository interface {
 	AssignNotificationToUser(ctx context.Context, userID, notificationID int) error
 	AssignNotificationToUsersWithPermission(ctx context.Context, notificationID int, permission string) error
 	GetUserIDsWithPermission(ctx context.Context, permission string) ([]int, error)
+	GetUserIDsWithRole(ctx context.Context, role string) ([]int, error)
 	GetUsersWithPermissionAndEmail(ctx context.Context, permission string) ([]UserEmailInfo, error)
+	GetUsersWithEmail(ctx context.Context, userIDs []int) ([]UserEmailInfo, error)
 	GetNotificationsForUser(ctx context.Context, userID int, limit, offset int, includeDismissed bool) ([]notifications.UserNotification, error)
 	GetUnreadCountForUser(ctx context.Context, userID int) (int, error)
 	MarkAsRead(ctx context.Context, userID, notificationID int) error
@@ -112,6 +115,29 @@ func (r *SqlNotificationRepository) GetUserIDsWithPermission(ctx context.Context
 	return userIDs, rows.Err()
 }
 
+func (r *SqlNotificationRepository) GetUserIDsWithRole(ctx context.Context, role string) ([]int, error) {
+	query := `
+		SELECT DISTINCT ur.user_id
+		FROM user_roles ur
+		JOIN roles r ON ur.role_id = r.id
+		WHERE LOWER(r.name) = LOWER(?)`
+	rows, err := r.db.QueryContext(ctx, query, role)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var userIDs []int
+	for rows.Next() {
+		var id int
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		userIDs = append(userIDs, id)
+	}
+	return userIDs, rows.Err()
+}
+
 func (r *SqlNotificationRepository) GetUsersWithPermissionAndEmail(ctx context.Context, permission string) ([]UserEmailInfo, error) {
 	query := `
 		SELECT DISTINCT ur.user_id, u.email
@@ -137,6 +163,38 @@ func (r *SqlNotificationRepository) GetUsersWithPermissionAndEmail(ctx context.C
 	return users, rows.Err()
 }
 
+// GetUsersWithEmail returns the enabled users among userIDs that have an email address.
+func (r *SqlNotificationRepository) GetUsersWithEmail(ctx context.Context, userIDs []int) ([]UserEmailInfo, error) {
+	if len(userIDs) == 0 {
+		return nil, nil
+	}
+	query := `
+		SELECT id, email
+		FROM users
+		WHERE id IN (?` + strings.Repeat(", ?", len(userIDs)-1) + `)
+			AND email IS NOT NULL AND email != '' AND disabled = FALSE
+		ORDER BY id`
+	args := make([]any, len(userIDs))
+	for i, id := range userIDs {
+		args[i] = id
+	}
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var users []UserEmailInfo
+	for rows.Next() {
+		var info UserEmailInfo
+		if err := rows.Scan(&info.UserID, &info.Email); err != nil {
+			return nil, err
+		}
+		users = append(users, info)
+	}
+	return users, rows.Err()
+}
+
 func (r *SqlNotificationRepository) GetNotificationsForUser(ctx context.Context, userID int, limit, offset int, includeDismissed bool) ([]notifications.UserNotification, error) {
 	dismissedFilter := "AND un.is_dismissed = FALSE"
 	if includeDismissed {
@@ -240,9 +298,9 @@ func (r *SqlNotificationRepository) DeleteOldNotifications(ctx context.Context,
 func (r *SqlNotificationRepository) GetChannelPreference(ctx context.Context, userID int, category notifications.NotificationCategory) (*notifications.ChannelPreference, error) {
 	var pref notifications.ChannelPreference
 	err := r.db.QueryRowContext(ctx,
-		"SELECT user_id, category, email_enabled, inapp_enabled FROM notification_channel_preferences WHERE user_id = ? AND LOWER(category) = LOWER(?)",
+		"SELECT user_id, category, email_enabled, inapp_enabled, webhook_enabled, push_enabled, email_digest FROM notification_channel_preferences WHERE user_id = ? AND LOWER(category) = LOWER(?)",
 		userID, category,
-	).Scan(&pref.UserID, &pref.Category, &pref.EmailEnabled, &pref.InAppEnabled)
+	).Scan(&pref.UserID, &pref.Category, &pref.EmailEnabled, &pref.InAppEnabled, &pref.WebhookEnabled, &pref.PushEnabled, &pref.EmailDigest)
 
 	if err == sql.ErrNoRows {
 		return r.GetDefaultChannelPreference(ctx, category)
@@ -257,9 +315,9 @@ func (r *SqlNotificationRepository) GetDefaultChannelPreference(ctx context.Cont
 	var pref notifications.ChannelPreference
 	pref.Category = category
 	err := r.db.QueryRowContext(ctx,
-		"SELECT email_enabled, inapp_enabled FROM notification_channel_defaults WHERE LOWER(category) = LOWER(?)",
+		"SELECT email_enabled, inapp_enabled, webhook_enabled, push_enabled, email_digest FROM notification_channel_defaults WHERE LOWER(category) = LOWER(?)",
 		category,
-	).Scan(&pref.EmailEnabled, &pref.InAppEnabled)
+	).Scan(&pref.EmailEnabled, &pref.InAppEnabled, &pref.WebhookEnabled, &pref.PushEnabled, &pref.EmailDigest)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get default preference: %w", err)
 	}
@@ -285,11 +343,15 @@ func (r *SqlNotificationRepository) GetAllChannelPreferences(ctx context.Context
 }
 
 func (r *SqlNotificationRepository) SetChannelPreference(ctx context.Context, pref notifications.ChannelPreference) error {
+	digest := pref.EmailDigest
+	if digest == "" {
+		digest = notifications.DigestOff
+	}
 	_, err := r.db.ExecContext(ctx,
-		`INSERT INTO notification_channel_preferences (user_id, category, email_enabled, inapp_enabled)
-		 VALUES (?, ?, ?, ?)
-		 ON CONFLICT(user_id, category) DO UPDATE SET email_enabled = excluded.email_enabled, inapp_enabled = excluded.inapp_enabled`,
-		pref.UserID, pref.Category, pref.EmailEnabled, pref.InAppEnabled,
+		`INSERT INTO notification_channel_preferences (user_id, category, email_enabled, inapp_enabled, webhook_enabled, push_enabled, email_digest)
+		 VALUES (?, ?, ?, ?, ?, ?, ?)
+		 ON CONFLICT(user_id, category) DO UPDATE SET email_enabled = excluded.email_enabled, inapp_enabled = excluded.inapp_enabled, webhook_enabled = excluded.webhook_enabled, push_enabled = excluded.push_enabled, email_digest = excluded.email_digest`,
+		pref.UserID, pref.Category, pref.EmailEnabled, pref.InAppEnabled, pref.WebhookEnabled, pref.PushEnabled, digest,
 	)
 	return err
 }
@@ -143,13 +143,16 @@ func TestNotificationRepository_GetChannelPreference_UserOverride(t *testing.T)
 
 	mock.ExpectQuery("SELECT .* FROM notification_channel_preferences").
 		WithArgs(1, "user_management").
-		WillReturnRows(sqlmock.NewRows([]string{"user_id", "category", "email_enabled", "inapp_enabled"}).
-			AddRow(1, "user_management", false, true))
+		WillReturnRows(sqlmock.NewRows([]string{"user_id", "category", "email_enabled", "inapp_enabled", "webhook_enabled", "push_enabled", "email_digest"}).
+			AddRow(1, "user_management", false, true, true, true, "daily"))
 
 	pref, err := repo.GetChannelPreference(context.Background(), 1, notifications.CategoryUserManagement)
 	require.NoError(t, err)
 	require.False(t, pref.EmailEnabled)
 	require.True(t, pref.InAppEnabled)
+	require.True(t, pref.WebhookEnabled)
+	require.True(t, pref.PushEnabled)
+	require.Equal(t, notifications.DigestDaily, pref.EmailDigest)
 }
 
 func TestNotificationRepository_GetChannelPreference_FallbackToDefault(t *testing.T) {
@@ -162,8 +165,8 @@ func TestNotificationRepository_GetChannelPreference_FallbackToDefault(t *testin
 
 	mock.ExpectQuery("SELECT .* FROM notification_channel_defaults").
 		WithArgs("threshold_alert").
-		WillReturnRows(sqlmock.NewRows([]string{"email_enabled", "inapp_enabled"}).
-			AddRow(true, true))
+		WillReturnRows(sqlmock.NewRows([]string{"email_enabled", "inapp_enabled", "webhook_enabled", "push_enabled", "email_digest"}).
+			AddRow(true, true, false, true, "off"))
 
 	pref, err := repo.GetChannelPreference(context.Background(), 1, notifications.CategoryThresholdAlert)
 	require.NoError(t, err)
@@ -176,14 +179,15 @@ func TestNotificationRepository_SetChannelPreference(t *testing.T) {
 	repo := NewNotificationRepository(db, slog.Default())
 
 	mock.ExpectExec("INSERT INTO notification_channel_preferences").
-		WithArgs(1, "user_management", false, true).
+		WithArgs(1, "user_management", false, true, true, false, notifications.DigestOff).
 		WillReturnResult(sqlmock.NewResult(1, 1))
 
 	pref := notifications.ChannelPreference{
-		UserID:       1,
-		Category:     notifications.CategoryUserManagement,
-		EmailEnabled: false,
-		InAppEnabled: true,
+		UserID:         1,
+		Category:       notifications.CategoryUserManagement,
+		EmailEnabled:   false,
+		InAppEnabled:   true,
+		WebhookEnabled: true,
 	}
 	err := repo.SetChannelPreference(context.Background(), pref)
 	require.NoError(t, err)
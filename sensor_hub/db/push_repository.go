@@ -0,0 +1,103 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"example/sensorHub/push"
+)
+
+const pushSubscriptionSelect = `
+	SELECT user_id, provider, server_url, topic, token, enabled
+	FROM push_subscriptions
+`
+
+type SqlPushRepository struct {
+	db     *sql.DB
+	logger *slog.Logger
+}
+
+func NewPushRepository(db *sql.DB, logger *slog.Logger) *SqlPushRepository {
+	return &SqlPushRepository{
+		db:     db,
+		logger: logger.With("component", "push_repository"),
+	}
+}
+
+// GetPushSubscriptionsForUsers returns the enabled subscriptions belonging to any of userIDs.
+func (r *SqlPushRepository) GetPushSubscriptionsForUsers(ctx context.Context, userIDs []int) ([]push.Subscription, error) {
+	if len(userIDs) == 0 {
+		return nil, nil
+	}
+	args := make([]any, len(userIDs))
+	for i, id := range userIDs {
+		args[i] = id
+	}
+	query := pushSubscriptionSelect + ` WHERE enabled = TRUE AND user_id IN (?` + strings.Repeat(", ?", len(userIDs)-1) + `) ORDER BY user_id`
+
+	subs, err := r.querySubscriptions(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get push subscriptions for users: %w", err)
+	}
+	return subs, nil
+}
+
+// GetPushSubscription returns the user's subscription, or nil if they have none.
+func (r *SqlPushRepository) GetPushSubscription(ctx context.Context, userID int) (*push.Subscription, error) {
+	subs, err := r.querySubscriptions(ctx, pushSubscriptionSelect+` WHERE user_id = ?`, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get push subscription for user %d: %w", userID, err)
+	}
+	if len(subs) == 0 {
+		return nil, nil
+	}
+	return &subs[0], nil
+}
+
+func (r *SqlPushRepository) querySubscriptions(ctx context.Context, query string, args ...any) ([]push.Subscription, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var subs []push.Subscription
+	for rows.Next() {
+		var s push.Subscription
+		if err := rows.Scan(&s.UserID, &s.Provider, &s.ServerURL, &s.Topic, &s.Token, &s.Enabled); err != nil {
+			return nil, fmt.Errorf("failed to scan push subscription: %w", err)
+		}
+		subs = append(subs, s)
+	}
+	return subs, rows.Err()
+}
+
+// SetPushSubscription creates or replaces the user's subscription.
+func (r *SqlPushRepository) SetPushSubscription(ctx context.Context, sub *push.Subscription) error {
+	_, err := r.db.ExecContext(ctx, `
+		INSERT INTO push_subscriptions (user_id, provider, server_url, topic, token, enabled)
+		VALUES (?, ?, ?, ?, ?, ?)
+		ON CONFLICT(user_id) DO UPDATE SET
+			provider = excluded.provider,
+			server_url = excluded.server_url,
+			topic = excluded.topic,
+			token = excluded.token,
+			enabled = excluded.enabled,
+			updated_at = datetime('now')
+	`, sub.UserID, sub.Provider, sub.ServerURL, sub.Topic, sub.Token, sub.Enabled)
+	if err != nil {
+		return fmt.Errorf("failed to set push subscription: %w", err)
+	}
+	r.logger.Debug("set push subscription", "user_id", sub.UserID, "provider", sub.Provider)
+	return nil
+}
+
+func (r *SqlPushRepository) DeletePushSubscription(ctx context.Context, userID int) error {
+	if _, err := r.db.ExecContext(ctx, `DELETE FROM push_subscriptions WHERE user_id = ?`, userID); err != nil {
+		return fmt.Errorf("failed to delete push subscription: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,14 @@
+package database
+
+import (
+	"context"
+
+	"example/sensorHub/push"
+)
+
+type PushRepository interface {
+	GetPushSubscriptionsForUsers(ctx context.Context, userIDs []int) ([]push.Subscription, error)
+	GetPushSubscription(ctx context.Context, userID int) (*push.Subscription, error)
+	SetPushSubscription(ctx context.Context, sub *push.Subscription) error
+	DeletePushSubscription(ctx context.Context, userID int) error
+}
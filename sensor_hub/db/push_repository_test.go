@@ -0,0 +1,78 @@
+package database
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+
+	"example/sensorHub/push"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var pushSubscriptionColumns = []string{"user_id", "provider", "server_url", "topic", "token", "enabled"}
+
+func TestPushRepository_GetPushSubscriptionsForUsers_Success(t *testing.T) {
+	db, dbMock := newMockDB(t)
+	repo := NewPushRepository(db, slog.Default())
+
+	dbMock.ExpectQuery(`FROM push_subscriptions\s+WHERE enabled = TRUE AND user_id IN \(\?, \?\)`).
+		WithArgs(3, 7).
+		WillReturnRows(sqlmock.NewRows(pushSubscriptionColumns).
+			AddRow(3, "ntfy", "https://ntfy.sh", "garage-leaks", "", true).
+			AddRow(7, "gotify", "https://gotify.example.com", "", "AbCdEf", true))
+
+	subs, err := repo.GetPushSubscriptionsForUsers(context.Background(), []int{3, 7})
+
+	require.NoError(t, err)
+	require.Len(t, subs, 2)
+	assert.Equal(t, push.ProviderNtfy, subs[0].Provider)
+	assert.Equal(t, "garage-leaks", subs[0].Topic)
+	assert.Equal(t, push.ProviderGotify, subs[1].Provider)
+	assert.Equal(t, "AbCdEf", subs[1].Token)
+	assert.NoError(t, dbMock.ExpectationsWereMet())
+}
+
+func TestPushRepository_GetPushSubscriptionsForUsers_NoUsers(t *testing.T) {
+	db, dbMock := newMockDB(t)
+	repo := NewPushRepository(db, slog.Default())
+
+	subs, err := repo.GetPushSubscriptionsForUsers(context.Background(), nil)
+
+	require.NoError(t, err)
+	assert.Empty(t, subs)
+	assert.NoError(t, dbMock.ExpectationsWereMet())
+}
+
+func TestPushRepository_GetPushSubscription_None(t *testing.T) {
+	db, dbMock := newMockDB(t)
+	repo := NewPushRepository(db, slog.Default())
+
+	dbMock.ExpectQuery(`FROM push_subscriptions\s+WHERE user_id = \?`).
+		WithArgs(7).
+		WillReturnRows(sqlmock.NewRows(pushSubscriptionColumns))
+
+	sub, err := repo.GetPushSubscription(context.Background(), 7)
+
+	require.NoError(t, err)
+	assert.Nil(t, sub)
+	assert.NoError(t, dbMock.ExpectationsWereMet())
+}
+
+func TestPushRepository_SetPushSubscription_Upserts(t *testing.T) {
+	db, dbMock := newMockDB(t)
+	repo := NewPushRepository(db, slog.Default())
+
+	dbMock.ExpectExec(`INSERT INTO push_subscriptions .* ON CONFLICT\(user_id\) DO UPDATE`).
+		WithArgs(7, push.ProviderNtfy, "https://ntfy.sh", "garage-leaks", "tk_123", true).
+		WillReturnResult(sqlmock.NewResult(7, 1))
+
+	err := repo.SetPushSubscription(context.Background(), &push.Subscription{
+		UserID: 7, Provider: push.ProviderNtfy, ServerURL: "https://ntfy.sh", Topic: "garage-leaks", Token: "tk_123", Enabled: true,
+	})
+
+	require.NoError(t, err)
+	assert.NoError(t, dbMock.ExpectationsWereMet())
+}
@@ -3,10 +3,12 @@ package database
 import (
 	"context"
 	"database/sql"
+	"example/sensorHub/alerting"
 	gen "example/sensorHub/gen"
 	"example/sensorHub/utils"
 	"fmt"
 	"log/slog"
+	"slices"
 	"strings"
 	"time"
 )
@@ -49,11 +51,14 @@ func (r *ReadingsRepositoryImpl) Add(ctx context.Context, readings []gen.Reading
 	return nil
 }
 
-func (r *ReadingsRepositoryImpl) GetBetweenDates(ctx context.Context, startDate, endDate, sensorName, measurementType string, interval AggregationInterval, aggFunc AggregationFunction) ([]gen.Reading, error) {
+// GetBetweenDates returns the readings in the range, bucketed by interval and reduced
+// with aggFunc unless interval is raw. Each aggregated reading also carries the
+// requested statistics of its bucket.
+func (r *ReadingsRepositoryImpl) GetBetweenDates(ctx context.Context, startDate, endDate, sensorName, measurementType string, interval AggregationInterval, aggFunc AggregationFunction, statistics []AggregationFunction) ([]gen.Reading, error) {
 	if interval == AggregationRaw || interval == "" {
 		return r.getRawBetweenDates(ctx, startDate, endDate, sensorName, measurementType)
 	}
-	return r.getAggregatedBetweenDates(ctx, startDate, endDate, sensorName, measurementType, interval, aggFunc)
+	return r.getAggregatedBetweenDates(ctx, startDate, endDate, sensorName, measurementType, interval, aggFunc, statistics)
 }
 
 func (r *ReadingsRepositoryImpl) getRawBetweenDates(ctx context.Context, startDate, endDate, sensorName, measurementType string) ([]gen.Reading, error) {
@@ -88,87 +93,118 @@ func (r *ReadingsRepositoryImpl) getRawBetweenDates(ctx context.Context, startDa
 	return scanReadings(rows)
 }
 
-func (r *ReadingsRepositoryImpl) getAggregatedBetweenDates(ctx context.Context, startDate, endDate, sensorName, measurementType string, interval AggregationInterval, aggFunc AggregationFunction) ([]gen.Reading, error) {
+func (r *ReadingsRepositoryImpl) getAggregatedBetweenDates(ctx context.Context, startDate, endDate, sensorName, measurementType string, interval AggregationInterval, aggFunc AggregationFunction, statistics []AggregationFunction) ([]gen.Reading, error) {
 	bucket, err := timeBucketExpression(interval)
 	if err != nil {
 		return nil, err
 	}
-
-	if aggFunc == AggregationFunctionLast {
-		return r.getLastBetweenDates(ctx, startDate, endDate, sensorName, measurementType, bucket)
+	value, err := aggregateExpression(aggFunc)
+	if err != nil {
+		return nil, err
 	}
-
-	sqlAgg := "ROUND(AVG(r.numeric_value), 2)"
-	if aggFunc == AggregationFunctionCount {
-		sqlAgg = "COUNT(*)"
+	statColumns, err := statisticColumns(statistics, aggregateExpression)
+	if err != nil {
+		return nil, err
+	}
+	id, state := "0", "NULL"
+	if aggFunc == AggregationFunctionLast {
+		id = "COALESCE(MAX(CASE WHEN r.last_rank = 1 THEN r.id END), 0)"
+		state = "MAX(CASE WHEN r.last_rank = 1 THEN r.text_state END)"
 	}
 
-	query := fmt.Sprintf(`
-		SELECT 0 AS id, s.name, mt.name, %s, NULL, COALESCE(smt.unit, mt.default_unit), %s AS bucket_time
-		FROM %s r
+	from := fmt.Sprintf(`%s r
 		JOIN sensors s ON r.sensor_id = s.id
 		JOIN %s mt ON r.measurement_type_id = mt.id
 		LEFT JOIN %s smt ON smt.sensor_id = s.id AND smt.measurement_type_id = mt.id
-		WHERE r.time BETWEEN ? AND ?
-	`, sqlAgg, bucket, TableReadings, TableMeasurementTypes, TableSensorMeasurementTypes)
+		WHERE r.time BETWEEN ? AND ?`, TableReadings, TableMeasurementTypes, TableSensorMeasurementTypes)
 
 	args := []any{startDate, endDate}
 
 	if sensorName != "" {
-		query += " AND LOWER(s.name) = LOWER(?)"
+		from += " AND LOWER(s.name) = LOWER(?)"
 		args = append(args, sensorName)
 	}
 	if measurementType != "" {
-		query += " AND LOWER(mt.name) = LOWER(?)"
+		from += " AND LOWER(mt.name) = LOWER(?)"
 		args = append(args, measurementType)
 	}
 
-	query += fmt.Sprintf(" GROUP BY s.name, mt.name, bucket_time ORDER BY bucket_time ASC")
+	source := bucketedReadings(
+		"r.id, r.numeric_value, r.text_state, s.name AS sensor_name, mt.name AS measurement_type, COALESCE(smt.unit, mt.default_unit) AS unit",
+		from, bucket, append([]AggregationFunction{aggFunc}, statistics...))
+	query := fmt.Sprintf(`
+		SELECT %s, r.sensor_name, r.measurement_type, %s, %s, r.unit, r.bucket_time%s
+		FROM %s
+		GROUP BY r.sensor_name, r.measurement_type, r.bucket_time
+		ORDER BY r.bucket_time ASC
+	`, id, value, state, statColumns, source)
 
 	rows, err := r.db.QueryContext(ctx, query, args...)
 	if err != nil {
-		return nil, fmt.Errorf("error fetching aggregated readings between %s and %s: %w", startDate, endDate, err)
+		return nil, fmt.Errorf("error fetching %s readings between %s and %s: %w", aggFunc, startDate, endDate, err)
 	}
 	defer func() { _ = rows.Close() }()
 
-	return scanReadings(rows)
+	return scanReadingsWithStatistics(rows, statistics)
 }
 
-func (r *ReadingsRepositoryImpl) getLastBetweenDates(ctx context.Context, startDate, endDate, sensorName, measurementType, bucket string) ([]gen.Reading, error) {
-	query := fmt.Sprintf(`
-		SELECT sub.id, sub.sensor_name, sub.measurement_type, sub.numeric_value, sub.text_state, sub.unit, sub.bucket_time
-		FROM (
-			SELECT r.id, s.name AS sensor_name, mt.name AS measurement_type,
-				r.numeric_value, r.text_state, COALESCE(smt.unit, mt.default_unit) AS unit,
-				%s AS bucket_time,
-				ROW_NUMBER() OVER (PARTITION BY s.name, mt.name, %s ORDER BY r.time DESC) AS rn
-			FROM %s r
-			JOIN sensors s ON r.sensor_id = s.id
-			JOIN %s mt ON r.measurement_type_id = mt.id
-			LEFT JOIN %s smt ON smt.sensor_id = s.id AND smt.measurement_type_id = mt.id
-			WHERE r.time BETWEEN ? AND ?
-	`, bucket, bucket, TableReadings, TableMeasurementTypes, TableSensorMeasurementTypes)
-
-	args := []any{startDate, endDate}
-
-	if sensorName != "" {
-		query += " AND LOWER(s.name) = LOWER(?)"
-		args = append(args, sensorName)
+// bucketedReadings selects columns from from, the readings table aliased r with any joins
+// and filters, as a subquery aliased r. It adds each reading's bucket_time and the ranks
+// within its bucket that the last and percentile aggregates of funcs pick values by.
+func bucketedReadings(columns, from, bucket string, funcs []AggregationFunction) string {
+	partition := fmt.Sprintf("PARTITION BY r.sensor_id, r.measurement_type_id, %s", bucket)
+	columns += fmt.Sprintf(", %s AS bucket_time", bucket)
+	if slices.Contains(funcs, AggregationFunctionLast) {
+		columns += fmt.Sprintf(", ROW_NUMBER() OVER (%s ORDER BY r.time DESC, r.id DESC) AS last_rank", partition)
 	}
-	if measurementType != "" {
-		query += " AND LOWER(mt.name) = LOWER(?)"
-		args = append(args, measurementType)
+	if slices.Contains(funcs, AggregationFunctionP50) || slices.Contains(funcs, AggregationFunctionP95) {
+		columns += fmt.Sprintf(", ROW_NUMBER() OVER (%[1]s ORDER BY r.numeric_value NULLS LAST) AS value_rank, COUNT(r.numeric_value) OVER (%[1]s) AS value_count", partition)
 	}
+	return fmt.Sprintf("(SELECT %s FROM %s) r", columns, from)
+}
 
-	query += ") sub WHERE sub.rn = 1 ORDER BY sub.bucket_time ASC"
-
-	rows, err := r.db.QueryContext(ctx, query, args...)
-	if err != nil {
-		return nil, fmt.Errorf("error fetching last-value readings between %s and %s: %w", startDate, endDate, err)
+// aggregateExpression returns the SQL aggregate computing fn over a bucket of the rows
+// built by bucketedReadings.
+func aggregateExpression(fn AggregationFunction) (string, error) {
+	switch fn {
+	case AggregationFunctionAvg:
+		return "ROUND(AVG(r.numeric_value), 2)", nil
+	case AggregationFunctionCount:
+		return "COUNT(*)", nil
+	case AggregationFunctionLast:
+		return "MAX(CASE WHEN r.last_rank = 1 THEN r.numeric_value END)", nil
+	case AggregationFunctionMin:
+		return "MIN(r.numeric_value)", nil
+	case AggregationFunctionMax:
+		return "MAX(r.numeric_value)", nil
+	case AggregationFunctionSum:
+		return "ROUND(SUM(r.numeric_value), 2)", nil
+	case AggregationFunctionP50:
+		return percentileExpression(50), nil
+	case AggregationFunctionP95:
+		return percentileExpression(95), nil
+	default:
+		return "", fmt.Errorf("unsupported aggregation function: %q", fn)
 	}
-	defer func() { _ = rows.Close() }()
+}
 
-	return scanReadings(rows)
+// percentileExpression picks the nearest-rank percentile: the smallest value whose rank
+// among the bucket's numeric values is at least percent of their count.
+func percentileExpression(percent int) string {
+	return fmt.Sprintf("MIN(CASE WHEN r.value_rank * 100 >= %d * r.value_count THEN r.numeric_value END)", percent)
+}
+
+// statisticColumns returns one extra select column per statistic, in order, built by expr.
+func statisticColumns(statistics []AggregationFunction, expr func(AggregationFunction) (string, error)) (string, error) {
+	columns := ""
+	for _, fn := range statistics {
+		column, err := expr(fn)
+		if err != nil {
+			return "", err
+		}
+		columns += ", " + column
+	}
+	return columns, nil
 }
 
 func timeBucketExpression(interval AggregationInterval) (string, error) {
@@ -214,6 +250,75 @@ func (r *ReadingsRepositoryImpl) GetLatest(ctx context.Context) ([]gen.Reading,
 	return scanReadings(rows)
 }
 
+// GetRecentNumericReadings returns the sensor's numeric readings of the given measurement
+// type taken at or after since, oldest first. The last reading before since is included
+// as the first sample so callers know the value in effect at the start of the window.
+func (r *ReadingsRepositoryImpl) GetRecentNumericReadings(ctx context.Context, sensorID int, measurementTypeName string, since time.Time) ([]alerting.ReadingSample, error) {
+	query := fmt.Sprintf(`
+		SELECT time, numeric_value FROM (
+			SELECT r.time, r.numeric_value
+			FROM %[1]s r
+			JOIN %[2]s mt ON r.measurement_type_id = mt.id
+			WHERE r.sensor_id = ? AND LOWER(mt.name) = LOWER(?) AND r.numeric_value IS NOT NULL AND r.time < ?
+			ORDER BY r.time DESC
+			LIMIT 1
+		)
+		UNION ALL
+		SELECT r.time, r.numeric_value
+		FROM %[1]s r
+		JOIN %[2]s mt ON r.measurement_type_id = mt.id
+		WHERE r.sensor_id = ? AND LOWER(mt.name) = LOWER(?) AND r.numeric_value IS NOT NULL AND r.time >= ?
+		ORDER BY time ASC
+	`, TableReadings, TableMeasurementTypes)
+
+	sinceStr := since.UTC().Format("2006-01-02 15:04:05")
+	rows, err := r.db.QueryContext(ctx, query, sensorID, measurementTypeName, sinceStr, sensorID, measurementTypeName, sinceStr)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching recent readings for sensor %d: %w", sensorID, err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var samples []alerting.ReadingSample
+	for rows.Next() {
+		var t SQLiteTime
+		var sample alerting.ReadingSample
+		if err := rows.Scan(&t, &sample.Value); err != nil {
+			return nil, fmt.Errorf("error scanning recent reading row: %w", err)
+		}
+		sample.Time = t.Time
+		samples = append(samples, sample)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating over recent reading rows: %w", err)
+	}
+	return samples, nil
+}
+
+// GetLatestReadingTimes returns the time of each sensor's most recent reading, keyed by
+// sensor ID. Sensors that have never reported are absent from the map.
+func (r *ReadingsRepositoryImpl) GetLatestReadingTimes(ctx context.Context) (map[int]time.Time, error) {
+	query := fmt.Sprintf("SELECT sensor_id, MAX(time) FROM %s GROUP BY sensor_id", TableReadings)
+	rows, err := r.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching latest reading times: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	latest := make(map[int]time.Time)
+	for rows.Next() {
+		var sensorID int
+		var t SQLiteTime
+		if err := rows.Scan(&sensorID, &t); err != nil {
+			return nil, fmt.Errorf("error scanning latest reading time: %w", err)
+		}
+		latest[sensorID] = t.Time
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating over latest reading times: %w", err)
+	}
+	return latest, nil
+}
+
 func (r *ReadingsRepositoryImpl) GetTotalReadingsBySensorId(ctx context.Context, sensorId int) (int, error) {
 	query := fmt.Sprintf("SELECT COUNT(*) FROM %s WHERE sensor_id = ?", TableReadings)
 	var count int
@@ -225,16 +330,14 @@ func (r *ReadingsRepositoryImpl) GetTotalReadingsBySensorId(ctx context.Context,
 }
 
 func (r *ReadingsRepositoryImpl) DeleteReadingsOlderThan(ctx context.Context, cutoffDateTime time.Time) error {
-	query := fmt.Sprintf("DELETE FROM %s WHERE time < ?", TableReadings)
-	if _, err := r.db.ExecContext(ctx, query, cutoffDateTime); err != nil {
+	if err := r.expireReadings(ctx, cutoffDateTime, "", nil); err != nil {
 		return fmt.Errorf("error deleting old readings: %w", err)
 	}
 	return nil
 }
 
 func (r *ReadingsRepositoryImpl) DeleteReadingsOlderThanForSensor(ctx context.Context, cutoffDateTime time.Time, sensorId int) error {
-	query := fmt.Sprintf("DELETE FROM %s WHERE sensor_id = ? AND time < ?", TableReadings)
-	if _, err := r.db.ExecContext(ctx, query, sensorId, cutoffDateTime); err != nil {
+	if err := r.expireReadings(ctx, cutoffDateTime, " AND sensor_id = ?", []any{sensorId}); err != nil {
 		return fmt.Errorf("error deleting old readings for sensor %d: %w", sensorId, err)
 	}
 	return nil
@@ -246,18 +349,128 @@ func (r *ReadingsRepositoryImpl) DeleteReadingsOlderThanExcludingSensors(ctx con
 	}
 	placeholders := strings.Repeat("?,", len(excludedSensorIds))
 	placeholders = placeholders[:len(placeholders)-1]
-	query := fmt.Sprintf("DELETE FROM %s WHERE time < ? AND sensor_id NOT IN (%s)", TableReadings, placeholders)
-	args := make([]any, 0, 1+len(excludedSensorIds))
-	args = append(args, cutoffDateTime)
+	args := make([]any, 0, len(excludedSensorIds))
 	for _, id := range excludedSensorIds {
 		args = append(args, id)
 	}
-	if _, err := r.db.ExecContext(ctx, query, args...); err != nil {
+	if err := r.expireReadings(ctx, cutoffDateTime, fmt.Sprintf(" AND sensor_id NOT IN (%s)", placeholders), args); err != nil {
 		return fmt.Errorf("error deleting old readings: %w", err)
 	}
 	return nil
 }
 
+// DeleteDownsampledReadingsOlderThan removes readings produced by retention downsampling
+// that are older than cutoffDateTime.
+func (r *ReadingsRepositoryImpl) DeleteDownsampledReadingsOlderThan(ctx context.Context, cutoffDateTime time.Time) error {
+	query := fmt.Sprintf("DELETE FROM %s WHERE downsampled = 1 AND time < ?", TableReadings)
+	if _, err := r.db.ExecContext(ctx, query, cutoffDateTime); err != nil {
+		return fmt.Errorf("error deleting old downsampled readings: %w", err)
+	}
+	return nil
+}
+
+// expireReadings removes the raw readings older than cutoff that match scope, an extra
+// WHERE condition on sensor_id. Readings of a measurement type with a retention
+// downsample interval are first replaced by one downsampled reading per sensor and
+// bucket, using the type's default aggregation function. Only whole buckets are
+// downsampled; the raw readings of the bucket containing cutoff wait for a later run.
+func (r *ReadingsRepositoryImpl) expireReadings(ctx context.Context, cutoff time.Time, scope string, scopeArgs []any) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	policies, err := downsamplePolicies(ctx, tx)
+	if err != nil {
+		return err
+	}
+	for _, p := range policies {
+		if err := downsampleReadings(ctx, tx, p, cutoff, scope, scopeArgs); err != nil {
+			return err
+		}
+	}
+
+	query := fmt.Sprintf(`
+		DELETE FROM %s
+		WHERE downsampled = 0 AND time < ?%s
+			AND measurement_type_id NOT IN (SELECT id FROM %s WHERE retention_downsample_interval IS NOT NULL)
+	`, TableReadings, scope, TableMeasurementTypes)
+	if _, err := tx.ExecContext(ctx, query, append([]any{cutoff}, scopeArgs...)...); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+type downsamplePolicy struct {
+	measurementTypeID int
+	interval          AggregationInterval
+	function          AggregationFunction
+}
+
+func downsamplePolicies(ctx context.Context, tx *sql.Tx) ([]downsamplePolicy, error) {
+	rows, err := tx.QueryContext(ctx, fmt.Sprintf(`
+		SELECT mt.id, mt.retention_downsample_interval, COALESCE(mta.function, 'avg')
+		FROM %s mt
+		LEFT JOIN %s mta ON mta.measurement_type_id = mt.id AND mta.is_default = 1
+		WHERE mt.retention_downsample_interval IS NOT NULL
+	`, TableMeasurementTypes, TableMeasurementTypeAggregations))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get downsample policies: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var policies []downsamplePolicy
+	for rows.Next() {
+		var p downsamplePolicy
+		if err := rows.Scan(&p.measurementTypeID, &p.interval, &p.function); err != nil {
+			return nil, fmt.Errorf("failed to scan downsample policy: %w", err)
+		}
+		policies = append(policies, p)
+	}
+	return policies, rows.Err()
+}
+
+func downsampleReadings(ctx context.Context, tx *sql.Tx, p downsamplePolicy, cutoff time.Time, scope string, scopeArgs []any) error {
+	bucket, err := timeBucketExpression(p.interval)
+	if err != nil {
+		return err
+	}
+	width, err := utils.ParseISO8601Duration(string(p.interval))
+	if err != nil {
+		return err
+	}
+	cutoff = cutoff.UTC().Truncate(width)
+
+	value, err := aggregateExpression(p.function)
+	if err != nil {
+		return err
+	}
+	state := "NULL"
+	if p.function == AggregationFunctionLast {
+		state = "MAX(CASE WHEN r.last_rank = 1 THEN r.text_state END)"
+	}
+
+	args := append([]any{p.measurementTypeID, cutoff}, scopeArgs...)
+	from := fmt.Sprintf("%s r WHERE measurement_type_id = ? AND downsampled = 0 AND time < ?%s", TableReadings, scope)
+	source := bucketedReadings("r.sensor_id, r.measurement_type_id, r.numeric_value, r.text_state", from, bucket, []AggregationFunction{p.function})
+	query := fmt.Sprintf(`
+		INSERT INTO %s (sensor_id, measurement_type_id, numeric_value, text_state, time, downsampled)
+		SELECT r.sensor_id, r.measurement_type_id, %s, %s, r.bucket_time, 1
+		FROM %s
+		GROUP BY r.sensor_id, r.measurement_type_id, r.bucket_time
+	`, TableReadings, value, state, source)
+	if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("failed to downsample %s readings: %w", p.interval, err)
+	}
+
+	query = fmt.Sprintf("DELETE FROM %s WHERE measurement_type_id = ? AND downsampled = 0 AND time < ?%s", TableReadings, scope)
+	if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("failed to delete downsampled raw readings: %w", err)
+	}
+	return nil
+}
+
 func (r *ReadingsRepositoryImpl) resolveMeasurementTypeID(ctx context.Context, name string) (int, error) {
 	var id int
 	err := r.db.QueryRowContext(ctx, fmt.Sprintf("SELECT id FROM %s WHERE LOWER(name) = LOWER(?)", TableMeasurementTypes), name).Scan(&id)
@@ -277,13 +490,32 @@ func (r *ReadingsRepositoryImpl) resolveSensorID(ctx context.Context, name strin
 }
 
 func scanReadings(rows *sql.Rows) ([]gen.Reading, error) {
+	return scanReadingsWithStatistics(rows, nil)
+}
+
+// scanReadingsWithStatistics scans reading rows followed by one column per statistic into
+// each reading's Statistics. Statistics that are NULL for a bucket are left out.
+func scanReadingsWithStatistics(rows *sql.Rows, statistics []AggregationFunction) ([]gen.Reading, error) {
 	var readings []gen.Reading
 	for rows.Next() {
 		var reading gen.Reading
-		err := rows.Scan(&reading.Id, &reading.SensorName, &reading.MeasurementType, &reading.NumericValue, &reading.TextState, &reading.Unit, &reading.Time)
-		if err != nil {
+		values := make([]sql.NullFloat64, len(statistics))
+		dest := []any{&reading.Id, &reading.SensorName, &reading.MeasurementType, &reading.NumericValue, &reading.TextState, &reading.Unit, &reading.Time}
+		for i := range values {
+			dest = append(dest, &values[i])
+		}
+		if err := rows.Scan(dest...); err != nil {
 			return nil, fmt.Errorf("error scanning reading row: %w", err)
 		}
+		if len(statistics) > 0 {
+			stats := make(map[string]float64, len(statistics))
+			for i, fn := range statistics {
+				if values[i].Valid {
+					stats[string(fn)] = values[i].Float64
+				}
+			}
+			reading.Statistics = &stats
+		}
 		reading.Time = utils.NormalizeTimeToSpaceFormat(reading.Time)
 		readings = append(readings, reading)
 	}
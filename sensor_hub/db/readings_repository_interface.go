@@ -2,16 +2,23 @@ package database
 
 import (
 	"context"
+	"example/sensorHub/alerting"
 	gen "example/sensorHub/gen"
 	"time"
 )
 
 type ReadingsRepository interface {
 	Add(ctx context.Context, readings []gen.Reading) error
-	GetBetweenDates(ctx context.Context, startDate, endDate, sensorName, measurementType string, interval AggregationInterval, aggFunc AggregationFunction) ([]gen.Reading, error)
+	GetBetweenDates(ctx context.Context, startDate, endDate, sensorName, measurementType string, interval AggregationInterval, aggFunc AggregationFunction, statistics []AggregationFunction) ([]gen.Reading, error)
 	GetLatest(ctx context.Context) ([]gen.Reading, error)
+	GetRecentNumericReadings(ctx context.Context, sensorID int, measurementTypeName string, since time.Time) ([]alerting.ReadingSample, error)
+	GetLatestReadingTimes(ctx context.Context) (map[int]time.Time, error)
 	GetTotalReadingsBySensorId(ctx context.Context, sensorId int) (int, error)
 	DeleteReadingsOlderThan(ctx context.Context, cutoffDate time.Time) error
 	DeleteReadingsOlderThanForSensor(ctx context.Context, cutoffDate time.Time, sensorId int) error
 	DeleteReadingsOlderThanExcludingSensors(ctx context.Context, cutoffDate time.Time, excludedSensorIds []int) error
+	DeleteDownsampledReadingsOlderThan(ctx context.Context, cutoffDate time.Time) error
+	RefreshRollups(ctx context.Context) (int, error)
+	GetRollupBetweenDates(ctx context.Context, startDate, endDate, sensorName, measurementType string, rollup, interval AggregationInterval, aggFunc AggregationFunction, statistics []AggregationFunction) ([]gen.Reading, error)
+	DeleteRollupsOlderThan(ctx context.Context, rollup AggregationInterval, cutoff time.Time) error
 }
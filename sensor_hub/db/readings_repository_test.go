@@ -0,0 +1,178 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	gen "example/sensorHub/gen"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type storedReading struct {
+	time        string
+	value       *float64
+	state       *string
+	downsampled bool
+}
+
+func storedReadings(t *testing.T, db *sql.DB, measurementType string) []storedReading {
+	t.Helper()
+	rows, err := db.Query(`
+		SELECT r.time, r.numeric_value, r.text_state, r.downsampled
+		FROM readings r JOIN measurement_types mt ON r.measurement_type_id = mt.id
+		WHERE mt.name = ? ORDER BY r.time, r.id`, measurementType)
+	require.NoError(t, err)
+	defer rows.Close()
+	var readings []storedReading
+	for rows.Next() {
+		var r storedReading
+		require.NoError(t, rows.Scan(&r.time, &r.value, &r.state, &r.downsampled))
+		readings = append(readings, r)
+	}
+	require.NoError(t, rows.Err())
+	return readings
+}
+
+func setDownsampleInterval(t *testing.T, db *sql.DB, measurementType, interval string) {
+	t.Helper()
+	repo := NewMeasurementTypeRepository(db, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	require.NoError(t, repo.SetRetentionDownsampleInterval(context.Background(), measurementType, &interval))
+}
+
+func TestDeleteReadingsOlderThan_DownsamplesWholeBuckets(t *testing.T) {
+	repo, db := newReadingsTestRepo(t)
+	setDownsampleInterval(t, db, "temperature", "PT1H")
+
+	addReading(t, repo, "greenhouse", "temperature", ptr(20.0), nil, "2026-01-10 10:05:00")
+	addReading(t, repo, "greenhouse", "temperature", ptr(22.0), nil, "2026-01-10 10:35:00")
+	addReading(t, repo, "porch", "temperature", ptr(5.0), nil, "2026-01-10 10:50:00")
+	addReading(t, repo, "greenhouse", "temperature", ptr(30.0), nil, "2026-01-10 11:10:00")
+	addReading(t, repo, "greenhouse", "humidity", ptr(60.0), nil, "2026-01-10 10:05:00")
+
+	require.NoError(t, repo.DeleteReadingsOlderThan(context.Background(), time.Date(2026, 1, 10, 11, 30, 0, 0, time.UTC)))
+
+	// The 11:00 bucket is not over at the cutoff, so its raw reading stays for now.
+	assert.Equal(t, []storedReading{
+		{time: "2026-01-10 10:00:00", value: ptr(21.0), downsampled: true},
+		{time: "2026-01-10 10:00:00", value: ptr(5.0), downsampled: true},
+		{time: "2026-01-10 11:10:00", value: ptr(30.0)},
+	}, storedReadings(t, db, "temperature"))
+	assert.Empty(t, storedReadings(t, db, "humidity"))
+}
+
+func TestDeleteReadingsOlderThan_UsesDefaultAggregationFunction(t *testing.T) {
+	repo, db := newReadingsTestRepo(t)
+	setDownsampleInterval(t, db, "motion", "PT15M")
+	setDownsampleInterval(t, db, "contact", "PT15M")
+	_, err := db.Exec(`UPDATE measurement_type_aggregations SET is_default = (function = 'last')
+		WHERE measurement_type_id = (SELECT id FROM measurement_types WHERE name = 'contact')`)
+	require.NoError(t, err)
+
+	for i, at := range []string{"2026-01-10 10:01:00", "2026-01-10 10:07:00", "2026-01-10 10:12:00"} {
+		state := []string{"on", "off", "on"}[i]
+		addReading(t, repo, "porch", "motion", nil, &state, at)
+		addReading(t, repo, "porch", "contact", nil, &state, at)
+	}
+
+	require.NoError(t, repo.DeleteReadingsOlderThan(context.Background(), time.Date(2026, 1, 11, 0, 0, 0, 0, time.UTC)))
+
+	assert.Equal(t, []storedReading{{time: "2026-01-10 10:00:00", value: ptr(3.0), downsampled: true}}, storedReadings(t, db, "motion"))
+	assert.Equal(t, []storedReading{{time: "2026-01-10 10:00:00", state: ptr("on"), downsampled: true}}, storedReadings(t, db, "contact"))
+}
+
+func TestDeleteReadingsOlderThanForSensor_KeepsDownsampledReadingsOutOfRollups(t *testing.T) {
+	repo, db := newReadingsTestRepo(t)
+	ctx := context.Background()
+	setDownsampleInterval(t, db, "temperature", "P1D")
+
+	addReading(t, repo, "greenhouse", "temperature", ptr(20.0), nil, "2026-01-10 10:05:00")
+	addReading(t, repo, "greenhouse", "temperature", ptr(24.0), nil, "2026-01-10 16:05:00")
+	addReading(t, repo, "porch", "temperature", ptr(5.0), nil, "2026-01-10 10:50:00")
+	_, err := repo.RefreshRollups(ctx)
+	require.NoError(t, err)
+
+	var greenhouseID int
+	require.NoError(t, db.QueryRow("SELECT id FROM sensors WHERE name = 'greenhouse'").Scan(&greenhouseID))
+	cutoff := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+	require.NoError(t, repo.DeleteReadingsOlderThanForSensor(ctx, cutoff, greenhouseID))
+	// A second run leaves the downsampled reading alone.
+	require.NoError(t, repo.DeleteReadingsOlderThanForSensor(ctx, cutoff, greenhouseID))
+
+	assert.Equal(t, []storedReading{
+		{time: "2026-01-10 00:00:00", value: ptr(22.0), downsampled: true},
+		{time: "2026-01-10 10:50:00", value: ptr(5.0)},
+	}, storedReadings(t, db, "temperature"))
+
+	folded, err := repo.RefreshRollups(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, folded)
+	rolled, err := repo.GetRollupBetweenDates(ctx, "2026-01-10 00:00:00", "2026-01-11 00:00:00", "greenhouse", "temperature", AggregationPT1H, AggregationP1D, AggregationFunctionCount, nil)
+	require.NoError(t, err)
+	require.Len(t, rolled, 1)
+	assert.Equal(t, 2.0, *rolled[0].NumericValue)
+}
+
+func TestDeleteDownsampledReadingsOlderThan(t *testing.T) {
+	repo, db := newReadingsTestRepo(t)
+	ctx := context.Background()
+	setDownsampleInterval(t, db, "temperature", "PT1H")
+
+	addReading(t, repo, "greenhouse", "temperature", ptr(20.0), nil, "2024-01-10 10:05:00")
+	addReading(t, repo, "greenhouse", "temperature", ptr(21.0), nil, "2026-01-10 10:05:00")
+	require.NoError(t, repo.DeleteReadingsOlderThan(ctx, time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)))
+
+	require.NoError(t, repo.DeleteDownsampledReadingsOlderThan(ctx, time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)))
+
+	assert.Equal(t, []storedReading{{time: "2026-01-10 10:00:00", value: ptr(21.0), downsampled: true}}, storedReadings(t, db, "temperature"))
+}
+
+func TestGetBetweenDates_StatisticsAndPercentiles(t *testing.T) {
+	repo, _ := newReadingsTestRepo(t)
+	ctx := context.Background()
+
+	for i := 1; i <= 20; i++ {
+		// Added out of order so the percentiles cannot come from insertion order.
+		v := float64((i*7)%20 + 1)
+		addReading(t, repo, "greenhouse", "temperature", ptr(v), nil, fmt.Sprintf("2026-01-10 10:%02d:00", i))
+	}
+	addReading(t, repo, "porch", "temperature", ptr(200.0), nil, "2026-01-10 10:01:00")
+	addReading(t, repo, "porch", "temperature", ptr(100.0), nil, "2026-01-10 10:02:00")
+
+	readings, err := repo.GetBetweenDates(ctx, "2026-01-10 00:00:00", "2026-01-11 00:00:00", "", "temperature", AggregationPT1H, AggregationFunctionP50,
+		[]AggregationFunction{AggregationFunctionMin, AggregationFunctionMax, AggregationFunctionSum, AggregationFunctionP95})
+	require.NoError(t, err)
+	require.Len(t, readings, 2)
+
+	bySensor := map[string]gen.Reading{}
+	for _, r := range readings {
+		bySensor[r.SensorName] = r
+	}
+	greenhouse := bySensor["greenhouse"]
+	assert.Equal(t, 10.0, *greenhouse.NumericValue)
+	assert.Equal(t, map[string]float64{"min": 1, "max": 20, "sum": 210, "p95": 19}, *greenhouse.Statistics)
+	porch := bySensor["porch"]
+	assert.Equal(t, 100.0, *porch.NumericValue)
+	assert.Equal(t, map[string]float64{"min": 100, "max": 200, "sum": 300, "p95": 200}, *porch.Statistics)
+}
+
+func TestGetBetweenDates_StatisticsOmittedForTextReadings(t *testing.T) {
+	repo, _ := newReadingsTestRepo(t)
+
+	addReading(t, repo, "porch", "motion", nil, ptr("on"), "2026-01-10 10:01:00")
+	addReading(t, repo, "porch", "motion", nil, ptr("off"), "2026-01-10 10:02:00")
+
+	readings, err := repo.GetBetweenDates(context.Background(), "2026-01-10 00:00:00", "2026-01-11 00:00:00", "", "motion", AggregationPT1H, AggregationFunctionLast,
+		[]AggregationFunction{AggregationFunctionCount, AggregationFunctionP95})
+	require.NoError(t, err)
+	require.Len(t, readings, 1)
+	assert.Equal(t, "off", *readings[0].TextState)
+	assert.NotZero(t, readings[0].Id)
+	assert.Equal(t, map[string]float64{"count": 2}, *readings[0].Statistics)
+}
@@ -0,0 +1,226 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	gen "example/sensorHub/gen"
+)
+
+// rollupBatchSize caps how many readings one refresh transaction folds into the rollups,
+// so catching up on a large backlog does not hold the write lock for long.
+const rollupBatchSize = 10000
+
+func rollupTable(rollup AggregationInterval) (string, error) {
+	switch rollup {
+	case AggregationPT5M:
+		return TableReadingsRollup5m, nil
+	case AggregationPT1H:
+		return TableReadingsRollup1h, nil
+	default:
+		return "", fmt.Errorf("no rollup table for interval %q", rollup)
+	}
+}
+
+// RefreshRollups folds the readings added since the last refresh into the 5-minute and
+// 1-hour rollups and returns how many readings it folded in.
+func (r *ReadingsRepositoryImpl) RefreshRollups(ctx context.Context) (int, error) {
+	total := 0
+	for {
+		n, more, err := r.refreshRollupBatch(ctx)
+		if err != nil {
+			return total, err
+		}
+		total += n
+		if !more {
+			return total, nil
+		}
+	}
+}
+
+// refreshRollupBatch folds up to rollupBatchSize reading IDs into the rollups. more is
+// true while newer readings remain.
+func (r *ReadingsRepositoryImpl) refreshRollupBatch(ctx context.Context) (folded int, more bool, err error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	var from, maxID int64
+	if err := tx.QueryRowContext(ctx, fmt.Sprintf("SELECT last_reading_id FROM %s WHERE id = 1", TableReadingsRollupState)).Scan(&from); err != nil {
+		return 0, false, fmt.Errorf("failed to read rollup state: %w", err)
+	}
+	if err := tx.QueryRowContext(ctx, fmt.Sprintf("SELECT COALESCE(MAX(id), 0) FROM %s", TableReadings)).Scan(&maxID); err != nil {
+		return 0, false, fmt.Errorf("failed to read latest reading ID: %w", err)
+	}
+	if maxID <= from {
+		return 0, false, nil
+	}
+	to := min(maxID, from+rollupBatchSize)
+
+	if err := tx.QueryRowContext(ctx, fmt.Sprintf("SELECT COUNT(*) FROM %s WHERE id > ? AND id <= ? AND downsampled = 0", TableReadings), from, to).Scan(&folded); err != nil {
+		return 0, false, fmt.Errorf("failed to count readings to roll up: %w", err)
+	}
+
+	for _, rollup := range []AggregationInterval{AggregationPT5M, AggregationPT1H} {
+		table, _ := rollupTable(rollup)
+		bucket, err := timeBucketExpression(rollup)
+		if err != nil {
+			return 0, false, err
+		}
+		// Each batch is aggregated on its own and then merged into any bucket an earlier
+		// batch already started, so readings that arrive late still land in their bucket.
+		query := fmt.Sprintf(`
+			INSERT INTO %[1]s (sensor_id, measurement_type_id, bucket_time, reading_count, numeric_count,
+				numeric_sum, min_value, max_value, last_time, last_numeric_value, last_text_state)
+			SELECT sensor_id, measurement_type_id, bucket_time, COUNT(*), COUNT(numeric_value),
+				TOTAL(numeric_value), MIN(numeric_value), MAX(numeric_value), MAX(time),
+				MAX(CASE WHEN rn = 1 THEN numeric_value END), MAX(CASE WHEN rn = 1 THEN text_state END)
+			FROM (
+				SELECT r.sensor_id, r.measurement_type_id, r.numeric_value, r.text_state, r.time,
+					%[2]s AS bucket_time,
+					ROW_NUMBER() OVER (PARTITION BY r.sensor_id, r.measurement_type_id, %[2]s ORDER BY r.time DESC, r.id DESC) AS rn
+				FROM %[3]s r
+				WHERE r.id > ? AND r.id <= ? AND r.downsampled = 0
+			)
+			WHERE true
+			GROUP BY sensor_id, measurement_type_id, bucket_time
+			ON CONFLICT (sensor_id, measurement_type_id, bucket_time) DO UPDATE SET
+				reading_count = reading_count + excluded.reading_count,
+				numeric_count = numeric_count + excluded.numeric_count,
+				numeric_sum = numeric_sum + excluded.numeric_sum,
+				min_value = MIN(COALESCE(min_value, excluded.min_value), COALESCE(excluded.min_value, min_value)),
+				max_value = MAX(COALESCE(max_value, excluded.max_value), COALESCE(excluded.max_value, max_value)),
+				last_numeric_value = CASE WHEN excluded.last_time >= last_time THEN excluded.last_numeric_value ELSE last_numeric_value END,
+				last_text_state = CASE WHEN excluded.last_time >= last_time THEN excluded.last_text_state ELSE last_text_state END,
+				last_time = MAX(last_time, excluded.last_time)
+		`, table, bucket, TableReadings)
+		if _, err := tx.ExecContext(ctx, query, from, to); err != nil {
+			return 0, false, fmt.Errorf("failed to refresh %s rollup: %w", rollup, err)
+		}
+	}
+
+	if _, err := tx.ExecContext(ctx, fmt.Sprintf("UPDATE %s SET last_reading_id = ? WHERE id = 1", TableReadingsRollupState), to); err != nil {
+		return 0, false, fmt.Errorf("failed to update rollup state: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, false, fmt.Errorf("failed to commit rollup refresh: %w", err)
+	}
+	return folded, to < maxID, nil
+}
+
+// GetRollupBetweenDates answers the same query as GetBetweenDates from the given rollup
+// instead of raw readings. Readings not yet folded into the rollup are aggregated
+// alongside it, so the result is as fresh as a raw query. The interval must be a whole
+// number of rollup buckets, and aggFunc and statistics ones that RollupFor accepts.
+func (r *ReadingsRepositoryImpl) GetRollupBetweenDates(ctx context.Context, startDate, endDate, sensorName, measurementType string, rollup, interval AggregationInterval, aggFunc AggregationFunction, statistics []AggregationFunction) ([]gen.Reading, error) {
+	table, err := rollupTable(rollup)
+	if err != nil {
+		return nil, err
+	}
+	bucket, err := timeBucketExpression(interval)
+	if err != nil {
+		return nil, err
+	}
+	value, err := rollupAggregateExpression(aggFunc)
+	if err != nil {
+		return nil, err
+	}
+	statColumns, err := statisticColumns(statistics, rollupAggregateExpression)
+	if err != nil {
+		return nil, err
+	}
+	state, lastRank := "NULL", ""
+	if aggFunc == AggregationFunctionLast {
+		state = "MAX(CASE WHEN r.last_rank = 1 THEN r.last_text_state END)"
+	}
+	if aggFunc == AggregationFunctionLast || slices.Contains(statistics, AggregationFunctionLast) {
+		lastRank = fmt.Sprintf(", ROW_NUMBER() OVER (PARTITION BY s.name, mt.name, %s ORDER BY r.last_time DESC) AS last_rank", bucket)
+	}
+
+	// Rollup rows and not-yet-rolled-up readings share one shape, aliased as r so the
+	// bucket expression applies to both.
+	source := fmt.Sprintf(`(
+				SELECT sensor_id, measurement_type_id, bucket_time AS time, reading_count, numeric_count,
+					numeric_sum, min_value, max_value, last_time, last_numeric_value, last_text_state
+				FROM %[1]s
+				WHERE bucket_time BETWEEN ? AND ?
+				UNION ALL
+				SELECT sensor_id, measurement_type_id, time, 1, numeric_value IS NOT NULL,
+					COALESCE(numeric_value, 0), numeric_value, numeric_value, time, numeric_value, text_state
+				FROM %[2]s
+				WHERE time BETWEEN ? AND ? AND downsampled = 0
+					AND id > (SELECT last_reading_id FROM %[3]s WHERE id = 1)
+			) r
+			JOIN sensors s ON r.sensor_id = s.id
+			JOIN %[4]s mt ON r.measurement_type_id = mt.id
+			LEFT JOIN %[5]s smt ON smt.sensor_id = s.id AND smt.measurement_type_id = mt.id
+			WHERE true`, table, TableReadings, TableReadingsRollupState, TableMeasurementTypes, TableSensorMeasurementTypes)
+
+	args := []any{startDate, endDate, startDate, endDate}
+	if sensorName != "" {
+		source += " AND LOWER(s.name) = LOWER(?)"
+		args = append(args, sensorName)
+	}
+	if measurementType != "" {
+		source += " AND LOWER(mt.name) = LOWER(?)"
+		args = append(args, measurementType)
+	}
+
+	query := fmt.Sprintf(`
+		SELECT 0, r.sensor_name, r.measurement_type, %[1]s, %[2]s, r.unit, r.bucket_time%[3]s
+		FROM (
+			SELECT s.name AS sensor_name, mt.name AS measurement_type, COALESCE(smt.unit, mt.default_unit) AS unit,
+				r.reading_count, r.numeric_count, r.numeric_sum, r.min_value, r.max_value,
+				r.last_numeric_value, r.last_text_state, %[4]s AS bucket_time%[5]s
+			FROM %[6]s
+		) r
+		GROUP BY r.sensor_name, r.measurement_type, r.bucket_time
+		ORDER BY r.bucket_time ASC
+	`, value, state, statColumns, bucket, lastRank, source)
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching %s rollup readings between %s and %s: %w", rollup, startDate, endDate, err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	return scanReadingsWithStatistics(rows, statistics)
+}
+
+// rollupAggregateExpression is aggregateExpression for the rows of GetRollupBetweenDates,
+// where each row summarises a rollup bucket or a single unrolled reading.
+func rollupAggregateExpression(fn AggregationFunction) (string, error) {
+	switch fn {
+	case AggregationFunctionAvg:
+		return "ROUND(SUM(r.numeric_sum) / NULLIF(SUM(r.numeric_count), 0), 2)", nil
+	case AggregationFunctionCount:
+		return "SUM(r.reading_count)", nil
+	case AggregationFunctionLast:
+		return "MAX(CASE WHEN r.last_rank = 1 THEN r.last_numeric_value END)", nil
+	case AggregationFunctionMin:
+		return "MIN(r.min_value)", nil
+	case AggregationFunctionMax:
+		return "MAX(r.max_value)", nil
+	case AggregationFunctionSum:
+		return "CASE WHEN SUM(r.numeric_count) > 0 THEN ROUND(SUM(r.numeric_sum), 2) END", nil
+	default:
+		return "", fmt.Errorf("aggregation function %q cannot be answered from a rollup", fn)
+	}
+}
+
+// DeleteRollupsOlderThan removes the rollup's buckets that start before cutoff.
+func (r *ReadingsRepositoryImpl) DeleteRollupsOlderThan(ctx context.Context, rollup AggregationInterval, cutoff time.Time) error {
+	table, err := rollupTable(rollup)
+	if err != nil {
+		return err
+	}
+	query := fmt.Sprintf("DELETE FROM %s WHERE bucket_time < ?", table)
+	if _, err := r.db.ExecContext(ctx, query, cutoff.UTC().Format("2006-01-02 15:04:05")); err != nil {
+		return fmt.Errorf("error deleting old %s rollups: %w", rollup, err)
+	}
+	return nil
+}
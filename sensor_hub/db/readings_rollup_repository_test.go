@@ -0,0 +1,178 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	gen "example/sensorHub/gen"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newReadingsTestRepo(t *testing.T) (*ReadingsRepositoryImpl, *sql.DB) {
+	t.Helper()
+	db := newInMemoryDB(t)
+	db.SetMaxOpenConns(1)
+	_, err := db.Exec("PRAGMA foreign_keys = ON")
+	require.NoError(t, err)
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	require.NoError(t, RunMigrations(db, logger))
+	for _, name := range []string{"greenhouse", "porch"} {
+		_, err := db.Exec("INSERT INTO sensors (name, sensor_driver) VALUES (?, 'sensor-hub-http-temperature')", name)
+		require.NoError(t, err)
+	}
+	return NewReadingsRepository(db, logger).(*ReadingsRepositoryImpl), db
+}
+
+func addReading(t *testing.T, repo *ReadingsRepositoryImpl, sensor, measurementType string, value *float64, state *string, at string) {
+	t.Helper()
+	require.NoError(t, repo.Add(context.Background(), []gen.Reading{{
+		SensorName: sensor, MeasurementType: measurementType, NumericValue: value, TextState: state, Time: at,
+	}}))
+}
+
+func ptr[T any](v T) *T { return &v }
+
+func TestRollups_MatchRawAggregation(t *testing.T) {
+	repo, _ := newReadingsTestRepo(t)
+	ctx := context.Background()
+
+	start := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	for i := range 300 {
+		at := start.Add(time.Duration(i) * 7 * time.Minute).Format("2006-01-02 15:04:05")
+		addReading(t, repo, "greenhouse", "temperature", ptr(15+float64(i%13)*0.7), nil, at)
+		addReading(t, repo, "porch", "motion", nil, ptr([]string{"on", "off"}[i%2]), at)
+	}
+	_, err := repo.RefreshRollups(ctx)
+	require.NoError(t, err)
+	// A late reading for an already rolled-up bucket and a reading after the refresh
+	// are both still unrolled when the queries run.
+	addReading(t, repo, "greenhouse", "temperature", ptr(40.0), nil, "2026-03-01 02:01:00")
+	addReading(t, repo, "greenhouse", "temperature", ptr(-3.0), nil, "2026-03-02 10:59:00")
+
+	from, to := "2026-03-01 00:00:00", "2026-03-03 00:00:00"
+	cases := []struct {
+		rollup, interval AggregationInterval
+		aggFunc          AggregationFunction
+		measurementType  string
+		statistics       []AggregationFunction
+	}{
+		{AggregationPT5M, AggregationPT15M, AggregationFunctionAvg, "temperature", nil},
+		{AggregationPT5M, AggregationPT5M, AggregationFunctionCount, "motion", nil},
+		{AggregationPT5M, AggregationPT15M, AggregationFunctionLast, "motion", nil},
+		{AggregationPT1H, AggregationPT1H, AggregationFunctionAvg, "temperature", nil},
+		{AggregationPT1H, AggregationP1D, AggregationFunctionCount, "", nil},
+		{AggregationPT1H, AggregationP1D, AggregationFunctionLast, "temperature", nil},
+		{AggregationPT5M, AggregationPT15M, AggregationFunctionMin, "temperature", nil},
+		{AggregationPT1H, AggregationP1D, AggregationFunctionMax, "temperature", nil},
+		{AggregationPT1H, AggregationPT1H, AggregationFunctionSum, "", nil},
+		{AggregationPT1H, AggregationPT1H, AggregationFunctionAvg, "temperature", []AggregationFunction{AggregationFunctionMin, AggregationFunctionMax, AggregationFunctionCount}},
+		{AggregationPT5M, AggregationPT15M, AggregationFunctionLast, "", []AggregationFunction{AggregationFunctionSum, AggregationFunctionAvg}},
+	}
+	for _, tc := range cases {
+		t.Run(fmt.Sprintf("%s/%s%v", tc.interval, tc.aggFunc, tc.statistics), func(t *testing.T) {
+			raw, err := repo.GetBetweenDates(ctx, from, to, "", tc.measurementType, tc.interval, tc.aggFunc, tc.statistics)
+			require.NoError(t, err)
+			rolled, err := repo.GetRollupBetweenDates(ctx, from, to, "", tc.measurementType, tc.rollup, tc.interval, tc.aggFunc, tc.statistics)
+			require.NoError(t, err)
+
+			require.NotEmpty(t, raw)
+			require.Len(t, rolled, len(raw))
+			for i := range raw {
+				raw[i].Id = 0
+			}
+			assert.Equal(t, raw, rolled)
+		})
+	}
+}
+
+func TestRollups_SurviveRawRetention(t *testing.T) {
+	repo, _ := newReadingsTestRepo(t)
+	ctx := context.Background()
+
+	addReading(t, repo, "greenhouse", "temperature", ptr(20.0), nil, "2026-01-10 10:05:00")
+	addReading(t, repo, "greenhouse", "temperature", ptr(22.0), nil, "2026-01-10 10:35:00")
+	folded, err := repo.RefreshRollups(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, folded)
+
+	require.NoError(t, repo.DeleteReadingsOlderThan(ctx, time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)))
+	raw, err := repo.GetBetweenDates(ctx, "2026-01-10 00:00:00", "2026-01-11 00:00:00", "", "temperature", AggregationPT1H, AggregationFunctionAvg, nil)
+	require.NoError(t, err)
+	assert.Empty(t, raw)
+
+	rolled, err := repo.GetRollupBetweenDates(ctx, "2026-01-10 00:00:00", "2026-01-11 00:00:00", "", "temperature", AggregationPT1H, AggregationPT1H, AggregationFunctionAvg, nil)
+	require.NoError(t, err)
+	require.Len(t, rolled, 1)
+	assert.Equal(t, "2026-01-10 10:00:00", rolled[0].Time)
+	assert.Equal(t, 21.0, *rolled[0].NumericValue)
+}
+
+func TestRollups_RefreshCatchesUpInBatches(t *testing.T) {
+	repo, db := newReadingsTestRepo(t)
+	ctx := context.Background()
+
+	addReading(t, repo, "greenhouse", "temperature", ptr(20.0), nil, "2026-01-10 10:05:00")
+	// Jump the ID past a whole batch, as if the readings in between had been deleted.
+	_, err := db.Exec("UPDATE sqlite_sequence SET seq = ? WHERE name = 'readings'", rollupBatchSize*2)
+	require.NoError(t, err)
+	addReading(t, repo, "greenhouse", "temperature", ptr(24.0), nil, "2026-01-10 10:06:00")
+
+	folded, err := repo.RefreshRollups(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, folded)
+
+	var count, numericCount int
+	var sum float64
+	require.NoError(t, db.QueryRow("SELECT reading_count, numeric_count, numeric_sum FROM readings_rollup_5m").Scan(&count, &numericCount, &sum))
+	assert.Equal(t, 2, count)
+	assert.Equal(t, 2, numericCount)
+	assert.Equal(t, 44.0, sum)
+
+	folded, err = repo.RefreshRollups(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, folded)
+}
+
+func TestRollups_DeleteRollupsOlderThan(t *testing.T) {
+	repo, db := newReadingsTestRepo(t)
+	ctx := context.Background()
+
+	addReading(t, repo, "greenhouse", "temperature", ptr(20.0), nil, "2025-06-01 12:00:00")
+	addReading(t, repo, "greenhouse", "temperature", ptr(21.0), nil, "2026-06-01 12:00:00")
+	_, err := repo.RefreshRollups(ctx)
+	require.NoError(t, err)
+
+	require.NoError(t, repo.DeleteRollupsOlderThan(ctx, AggregationPT5M, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)))
+
+	var fiveMinute, hourly int
+	require.NoError(t, db.QueryRow("SELECT COUNT(*) FROM readings_rollup_5m").Scan(&fiveMinute))
+	require.NoError(t, db.QueryRow("SELECT COUNT(*) FROM readings_rollup_1h").Scan(&hourly))
+	assert.Equal(t, 1, fiveMinute)
+	assert.Equal(t, 2, hourly)
+
+	assert.Error(t, repo.DeleteRollupsOlderThan(ctx, AggregationP1D, time.Now()))
+}
+
+func TestRollupFor(t *testing.T) {
+	rollup, ok := RollupFor(AggregationPT15M, AggregationFunctionAvg)
+	assert.True(t, ok)
+	assert.Equal(t, AggregationPT5M, rollup)
+
+	rollup, ok = RollupFor(AggregationP1D, AggregationFunctionLast)
+	assert.True(t, ok)
+	assert.Equal(t, AggregationPT1H, rollup)
+
+	_, ok = RollupFor(AggregationPT1M, AggregationFunctionAvg)
+	assert.False(t, ok)
+	_, ok = RollupFor(AggregationPT1H, AggregationFunctionAvg, AggregationFunctionP95)
+	assert.False(t, ok)
+	_, ok = RollupFor(AggregationRaw, AggregationFunctionNone)
+	assert.False(t, ok)
+}
@@ -0,0 +1,185 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"example/sensorHub/scenes"
+)
+
+type SqlSceneRepository struct {
+	db     *sql.DB
+	logger *slog.Logger
+}
+
+func NewSceneRepository(db *sql.DB, logger *slog.Logger) *SqlSceneRepository {
+	return &SqlSceneRepository{
+		db:     db,
+		logger: logger.With("component", "scene_repository"),
+	}
+}
+
+func (r *SqlSceneRepository) GetAllScenes(ctx context.Context) ([]scenes.Scene, error) {
+	result, err := r.queryScenes(ctx, `ORDER BY id`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get scenes: %w", err)
+	}
+	return result, nil
+}
+
+func (r *SqlSceneRepository) GetSceneByID(ctx context.Context, sceneID int) (*scenes.Scene, error) {
+	result, err := r.queryScenes(ctx, `WHERE id = ?`, sceneID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get scene %d: %w", sceneID, err)
+	}
+	if len(result) == 0 {
+		return nil, nil
+	}
+	return &result[0], nil
+}
+
+func (r *SqlSceneRepository) CreateScene(ctx context.Context, scene *scenes.Scene) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx,
+		`INSERT INTO scenes (name, description) VALUES (?, ?)`,
+		scene.Name, scene.Description)
+	if err != nil {
+		return fmt.Errorf("failed to create scene: %w", err)
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return fmt.Errorf("failed to get scene ID: %w", err)
+	}
+	if err := insertSceneCommands(ctx, tx, int(id), scene.Commands); err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit scene: %w", err)
+	}
+	scene.ID = int(id)
+	return nil
+}
+
+// UpdateScene replaces a scene's name, description and commands. The outcome of its last
+// activation is kept.
+func (r *SqlSceneRepository) UpdateScene(ctx context.Context, scene *scenes.Scene) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `
+		UPDATE scenes SET name = ?, description = ?, updated_at = datetime('now') WHERE id = ?
+	`, scene.Name, scene.Description, scene.ID); err != nil {
+		return fmt.Errorf("failed to update scene: %w", err)
+	}
+	if _, err := tx.ExecContext(ctx, `DELETE FROM scene_commands WHERE scene_id = ?`, scene.ID); err != nil {
+		return fmt.Errorf("failed to clear scene commands: %w", err)
+	}
+	if err := insertSceneCommands(ctx, tx, scene.ID, scene.Commands); err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit scene: %w", err)
+	}
+	return nil
+}
+
+func (r *SqlSceneRepository) DeleteScene(ctx context.Context, sceneID int) error {
+	if _, err := r.db.ExecContext(ctx, `DELETE FROM scenes WHERE id = ?`, sceneID); err != nil {
+		return fmt.Errorf("failed to delete scene: %w", err)
+	}
+	return nil
+}
+
+// RecordSceneActivation records the aggregated outcome of an activation once all of its
+// commands have finished.
+func (r *SqlSceneRepository) RecordSceneActivation(ctx context.Context, sceneID int, activatedAt time.Time, status scenes.ActivationStatus) error {
+	if _, err := r.db.ExecContext(ctx,
+		`UPDATE scenes SET last_activated_at = ?, last_activation_status = ? WHERE id = ?`,
+		activatedAt.UTC().Format("2006-01-02 15:04:05"), status, sceneID); err != nil {
+		return fmt.Errorf("failed to record activation of scene %d: %w", sceneID, err)
+	}
+	return nil
+}
+
+func insertSceneCommands(ctx context.Context, tx *sql.Tx, sceneID int, commands []scenes.Command) error {
+	for i, c := range commands {
+		if _, err := tx.ExecContext(ctx, `
+			INSERT INTO scene_commands (scene_id, position, sensor_id, property, value) VALUES (?, ?, ?, ?, ?)
+		`, sceneID, i, c.SensorID, c.Property, c.Value); err != nil {
+			return fmt.Errorf("failed to add command %d to scene: %w", i+1, err)
+		}
+	}
+	return nil
+}
+
+// queryScenes loads the scenes matching the clause, then their commands in order.
+func (r *SqlSceneRepository) queryScenes(ctx context.Context, clause string, args ...any) ([]scenes.Scene, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT id, name, description, last_activated_at, last_activation_status FROM scenes
+	`+clause, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []scenes.Scene
+	index := make(map[int]int)
+	for rows.Next() {
+		var scene scenes.Scene
+		var lastActivated NullSQLiteTime
+		if err := rows.Scan(&scene.ID, &scene.Name, &scene.Description, &lastActivated, &scene.LastActivationStatus); err != nil {
+			return nil, fmt.Errorf("failed to scan scene: %w", err)
+		}
+		if lastActivated.Valid {
+			scene.LastActivatedAt = &lastActivated.Time
+		}
+		scene.Commands = []scenes.Command{}
+		index[scene.ID] = len(result)
+		result = append(result, scene)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if len(result) == 0 {
+		return result, nil
+	}
+
+	ids := make([]any, len(result))
+	for i, scene := range result {
+		ids[i] = scene.ID
+	}
+	commandRows, err := r.db.QueryContext(ctx, `
+		SELECT sc.scene_id, sc.sensor_id, s.name, sc.property, sc.value
+		FROM scene_commands sc
+		JOIN sensors s ON sc.sensor_id = s.id
+		WHERE sc.scene_id IN (?`+strings.Repeat(", ?", len(ids)-1)+`)
+		ORDER BY sc.scene_id, sc.position
+	`, ids...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get scene commands: %w", err)
+	}
+	defer commandRows.Close()
+	for commandRows.Next() {
+		var sceneID int
+		var c scenes.Command
+		if err := commandRows.Scan(&sceneID, &c.SensorID, &c.SensorName, &c.Property, &c.Value); err != nil {
+			return nil, fmt.Errorf("failed to scan scene command: %w", err)
+		}
+		if i, ok := index[sceneID]; ok {
+			result[i].Commands = append(result[i].Commands, c)
+		}
+	}
+	return result, commandRows.Err()
+}
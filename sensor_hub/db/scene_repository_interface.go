@@ -0,0 +1,17 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"example/sensorHub/scenes"
+)
+
+type SceneRepository interface {
+	GetAllScenes(ctx context.Context) ([]scenes.Scene, error)
+	GetSceneByID(ctx context.Context, sceneID int) (*scenes.Scene, error)
+	CreateScene(ctx context.Context, scene *scenes.Scene) error
+	UpdateScene(ctx context.Context, scene *scenes.Scene) error
+	DeleteScene(ctx context.Context, sceneID int) error
+	RecordSceneActivation(ctx context.Context, sceneID int, activatedAt time.Time, status scenes.ActivationStatus) error
+}
@@ -0,0 +1,112 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"example/sensorHub/scenes"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newSceneTestRepo(t *testing.T) (*SqlSceneRepository, *sql.DB) {
+	t.Helper()
+	db := newInMemoryDB(t)
+	db.SetMaxOpenConns(1)
+	_, err := db.Exec("PRAGMA foreign_keys = ON")
+	require.NoError(t, err)
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	require.NoError(t, RunMigrations(db, logger))
+	for _, name := range []string{"lamp", "tv-plug"} {
+		_, err := db.Exec("INSERT INTO sensors (name, sensor_driver) VALUES (?, 'mqtt-zigbee2mqtt')", name)
+		require.NoError(t, err)
+	}
+	return NewSceneRepository(db, logger), db
+}
+
+func movieNightScene() scenes.Scene {
+	return scenes.Scene{
+		Name: "Movie night", Description: "Dim the room and switch the TV on",
+		Commands: []scenes.Command{
+			{SensorID: 2, Property: "state", Value: "ON"},
+			{SensorID: 1, Property: "state", Value: "OFF"},
+		},
+	}
+}
+
+func TestSceneRepository_CreateAndGetScene(t *testing.T) {
+	repo, _ := newSceneTestRepo(t)
+	ctx := context.Background()
+
+	scene := movieNightScene()
+	require.NoError(t, repo.CreateScene(ctx, &scene))
+	require.NotZero(t, scene.ID)
+
+	got, err := repo.GetSceneByID(ctx, scene.ID)
+	require.NoError(t, err)
+	require.NotNil(t, got)
+	assert.Equal(t, "Movie night", got.Name)
+	assert.Equal(t, "Dim the room and switch the TV on", got.Description)
+	require.Len(t, got.Commands, 2)
+	assert.Equal(t, "tv-plug", got.Commands[0].SensorName, "commands keep their order")
+	assert.Equal(t, "lamp", got.Commands[1].SensorName)
+	assert.Nil(t, got.LastActivatedAt)
+	assert.Empty(t, got.LastActivationStatus)
+
+	missing, err := repo.GetSceneByID(ctx, 999)
+	require.NoError(t, err)
+	assert.Nil(t, missing)
+}
+
+func TestSceneRepository_UpdateSceneReplacesCommands(t *testing.T) {
+	repo, _ := newSceneTestRepo(t)
+	ctx := context.Background()
+	scene := movieNightScene()
+	require.NoError(t, repo.CreateScene(ctx, &scene))
+
+	scene.Name = "Bedtime"
+	scene.Commands = []scenes.Command{{SensorID: 1, Property: "state", Value: "OFF"}}
+	require.NoError(t, repo.UpdateScene(ctx, &scene))
+
+	all, err := repo.GetAllScenes(ctx)
+	require.NoError(t, err)
+	require.Len(t, all, 1)
+	assert.Equal(t, "Bedtime", all[0].Name)
+	assert.Equal(t, []scenes.Command{{SensorID: 1, SensorName: "lamp", Property: "state", Value: "OFF"}}, all[0].Commands)
+}
+
+func TestSceneRepository_RecordSceneActivation(t *testing.T) {
+	repo, _ := newSceneTestRepo(t)
+	ctx := context.Background()
+	scene := movieNightScene()
+	require.NoError(t, repo.CreateScene(ctx, &scene))
+	activatedAt := time.Date(2026, 3, 2, 20, 0, 0, 0, time.UTC)
+
+	require.NoError(t, repo.RecordSceneActivation(ctx, scene.ID, activatedAt, scenes.ActivationPartial))
+
+	got, err := repo.GetSceneByID(ctx, scene.ID)
+	require.NoError(t, err)
+	require.NotNil(t, got.LastActivatedAt)
+	assert.True(t, got.LastActivatedAt.Equal(activatedAt))
+	assert.Equal(t, scenes.ActivationPartial, got.LastActivationStatus)
+}
+
+func TestSceneRepository_DeletingSensorRemovesItsCommands(t *testing.T) {
+	repo, db := newSceneTestRepo(t)
+	ctx := context.Background()
+	scene := movieNightScene()
+	require.NoError(t, repo.CreateScene(ctx, &scene))
+
+	_, err := db.Exec("DELETE FROM sensors WHERE id = 2")
+	require.NoError(t, err)
+
+	got, err := repo.GetSceneByID(ctx, scene.ID)
+	require.NoError(t, err)
+	require.Len(t, got.Commands, 1)
+	assert.Equal(t, "lamp", got.Commands[0].SensorName)
+}
@@ -0,0 +1,238 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"example/sensorHub/scheduling"
+)
+
+const scheduleSelect = `
+	SELECT
+		cs.id,
+		cs.name,
+		cs.enabled,
+		cs.sensor_id,
+		s.name,
+		cs.property,
+		cs.value,
+		cs.cron_expression,
+		cs.holiday,
+		cs.next_run_at,
+		cs.last_run_at,
+		cs.last_command_id,
+		cs.last_error
+	FROM command_schedules cs
+	JOIN sensors s ON cs.sensor_id = s.id
+`
+
+type SqlScheduleRepository struct {
+	db     *sql.DB
+	logger *slog.Logger
+}
+
+func NewScheduleRepository(db *sql.DB, logger *slog.Logger) *SqlScheduleRepository {
+	return &SqlScheduleRepository{
+		db:     db,
+		logger: logger.With("component", "schedule_repository"),
+	}
+}
+
+func (r *SqlScheduleRepository) GetAllSchedules(ctx context.Context) ([]scheduling.Schedule, error) {
+	schedules, err := r.querySchedules(ctx, scheduleSelect+` ORDER BY cs.id`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get command schedules: %w", err)
+	}
+	return schedules, nil
+}
+
+func (r *SqlScheduleRepository) GetScheduleByID(ctx context.Context, scheduleID int) (*scheduling.Schedule, error) {
+	schedules, err := r.querySchedules(ctx, scheduleSelect+` WHERE cs.id = ?`, scheduleID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get command schedule %d: %w", scheduleID, err)
+	}
+	if len(schedules) == 0 {
+		return nil, nil
+	}
+	return &schedules[0], nil
+}
+
+func (r *SqlScheduleRepository) CreateSchedule(ctx context.Context, schedule *scheduling.Schedule) error {
+	result, err := r.db.ExecContext(ctx, `
+		INSERT INTO command_schedules (name, enabled, sensor_id, property, value, cron_expression, holiday, next_run_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+	`, schedule.Name, schedule.Enabled, schedule.SensorID, schedule.Property, schedule.Value, schedule.Cron,
+		schedule.Holiday, sqliteTimeArg(schedule.NextRunAt))
+	if err != nil {
+		return fmt.Errorf("failed to create command schedule: %w", err)
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return fmt.Errorf("failed to get command schedule ID: %w", err)
+	}
+	schedule.ID = int(id)
+	return nil
+}
+
+// UpdateSchedule replaces a schedule's settings and its next run. The outcome of its last
+// run is kept.
+func (r *SqlScheduleRepository) UpdateSchedule(ctx context.Context, schedule *scheduling.Schedule) error {
+	_, err := r.db.ExecContext(ctx, `
+		UPDATE command_schedules
+		SET name = ?,
+			enabled = ?,
+			sensor_id = ?,
+			property = ?,
+			value = ?,
+			cron_expression = ?,
+			holiday = ?,
+			next_run_at = ?,
+			updated_at = datetime('now')
+		WHERE id = ?
+	`, schedule.Name, schedule.Enabled, schedule.SensorID, schedule.Property, schedule.Value, schedule.Cron,
+		schedule.Holiday, sqliteTimeArg(schedule.NextRunAt), schedule.ID)
+	if err != nil {
+		return fmt.Errorf("failed to update command schedule: %w", err)
+	}
+	return nil
+}
+
+func (r *SqlScheduleRepository) DeleteSchedule(ctx context.Context, scheduleID int) error {
+	if _, err := r.db.ExecContext(ctx, `DELETE FROM command_schedules WHERE id = ?`, scheduleID); err != nil {
+		return fmt.Errorf("failed to delete command schedule: %w", err)
+	}
+	return nil
+}
+
+// GetDueSchedules returns the enabled schedules whose next run is at or before now.
+func (r *SqlScheduleRepository) GetDueSchedules(ctx context.Context, now time.Time) ([]scheduling.Schedule, error) {
+	query := scheduleSelect + `
+		WHERE cs.enabled = TRUE AND cs.next_run_at IS NOT NULL AND cs.next_run_at <= ?
+		ORDER BY cs.next_run_at, cs.id
+	`
+	schedules, err := r.querySchedules(ctx, query, now.UTC().Format("2006-01-02 15:04:05"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get due command schedules: %w", err)
+	}
+	return schedules, nil
+}
+
+// ClaimScheduleRun moves a schedule's next run from dueAt to nextRunAt. It reports false
+// when the next run is no longer dueAt, because another check or instance already
+// claimed the run or the schedule was edited meanwhile.
+func (r *SqlScheduleRepository) ClaimScheduleRun(ctx context.Context, scheduleID int, dueAt, nextRunAt time.Time) (bool, error) {
+	result, err := r.db.ExecContext(ctx,
+		`UPDATE command_schedules SET next_run_at = ? WHERE id = ? AND next_run_at = ?`,
+		sqliteTimeArg(&nextRunAt), scheduleID, dueAt.UTC().Format("2006-01-02 15:04:05"))
+	if err != nil {
+		return false, fmt.Errorf("failed to claim run of command schedule %d: %w", scheduleID, err)
+	}
+	return rowsAffected(result)
+}
+
+// RecordScheduleRun records the outcome of a run: the command it sent, or why it did not
+// send one. The command's Command History entry is linked to the schedule.
+func (r *SqlScheduleRepository) RecordScheduleRun(ctx context.Context, scheduleID int, ranAt time.Time, commandID *int, runErr string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `
+		UPDATE command_schedules
+		SET last_run_at = ?, last_command_id = ?, last_error = ?
+		WHERE id = ?
+	`, ranAt.UTC().Format("2006-01-02 15:04:05"), commandID, runErr, scheduleID); err != nil {
+		return fmt.Errorf("failed to record run of command schedule %d: %w", scheduleID, err)
+	}
+	if commandID != nil {
+		if _, err := tx.ExecContext(ctx,
+			`UPDATE sensor_command_history SET command_schedule_id = ? WHERE id = ?`,
+			scheduleID, *commandID); err != nil {
+			return fmt.Errorf("failed to link command %d to schedule %d: %w", *commandID, scheduleID, err)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit run of command schedule %d: %w", scheduleID, err)
+	}
+	return nil
+}
+
+func (r *SqlScheduleRepository) GetHolidayMode(ctx context.Context) (scheduling.HolidayMode, error) {
+	var mode scheduling.HolidayMode
+	var until NullSQLiteTime
+	err := r.db.QueryRowContext(ctx, `SELECT enabled, until FROM schedule_holiday_mode WHERE id = 1`).Scan(&mode.Enabled, &until)
+	if err == sql.ErrNoRows {
+		return mode, nil
+	}
+	if err != nil {
+		return mode, fmt.Errorf("failed to get holiday mode: %w", err)
+	}
+	if until.Valid {
+		mode.Until = &until.Time
+	}
+	return mode, nil
+}
+
+func (r *SqlScheduleRepository) SetHolidayMode(ctx context.Context, mode scheduling.HolidayMode) error {
+	if _, err := r.db.ExecContext(ctx, `
+		INSERT INTO schedule_holiday_mode (id, enabled, until) VALUES (1, ?, ?)
+		ON CONFLICT (id) DO UPDATE SET enabled = excluded.enabled, until = excluded.until
+	`, mode.Enabled, sqliteTimeArg(mode.Until)); err != nil {
+		return fmt.Errorf("failed to set holiday mode: %w", err)
+	}
+	return nil
+}
+
+func (r *SqlScheduleRepository) querySchedules(ctx context.Context, query string, args ...any) ([]scheduling.Schedule, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var schedules []scheduling.Schedule
+	for rows.Next() {
+		var schedule scheduling.Schedule
+		var nextRun, lastRun NullSQLiteTime
+		var lastCommandID sql.NullInt64
+		if err := rows.Scan(
+			&schedule.ID,
+			&schedule.Name,
+			&schedule.Enabled,
+			&schedule.SensorID,
+			&schedule.SensorName,
+			&schedule.Property,
+			&schedule.Value,
+			&schedule.Cron,
+			&schedule.Holiday,
+			&nextRun,
+			&lastRun,
+			&lastCommandID,
+			&schedule.LastError,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan command schedule: %w", err)
+		}
+		if nextRun.Valid {
+			schedule.NextRunAt = &nextRun.Time
+		}
+		if lastRun.Valid {
+			schedule.LastRunAt = &lastRun.Time
+		}
+		schedule.LastCommandID = nullIntPtr(lastCommandID)
+		schedules = append(schedules, schedule)
+	}
+	return schedules, rows.Err()
+}
+
+// sqliteTimeArg formats an optional time for a DATETIME column.
+func sqliteTimeArg(t *time.Time) any {
+	if t == nil {
+		return nil
+	}
+	return t.UTC().Format("2006-01-02 15:04:05")
+}
@@ -0,0 +1,21 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"example/sensorHub/scheduling"
+)
+
+type ScheduleRepository interface {
+	GetAllSchedules(ctx context.Context) ([]scheduling.Schedule, error)
+	GetScheduleByID(ctx context.Context, scheduleID int) (*scheduling.Schedule, error)
+	CreateSchedule(ctx context.Context, schedule *scheduling.Schedule) error
+	UpdateSchedule(ctx context.Context, schedule *scheduling.Schedule) error
+	DeleteSchedule(ctx context.Context, scheduleID int) error
+	GetDueSchedules(ctx context.Context, now time.Time) ([]scheduling.Schedule, error)
+	ClaimScheduleRun(ctx context.Context, scheduleID int, dueAt, nextRunAt time.Time) (bool, error)
+	RecordScheduleRun(ctx context.Context, scheduleID int, ranAt time.Time, commandID *int, runErr string) error
+	GetHolidayMode(ctx context.Context) (scheduling.HolidayMode, error)
+	SetHolidayMode(ctx context.Context, mode scheduling.HolidayMode) error
+}
@@ -0,0 +1,175 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"example/sensorHub/scheduling"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newScheduleTestRepo(t *testing.T) (*SqlScheduleRepository, *sql.DB) {
+	t.Helper()
+	db := newInMemoryDB(t)
+	db.SetMaxOpenConns(1)
+	_, err := db.Exec("PRAGMA foreign_keys = ON")
+	require.NoError(t, err)
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	require.NoError(t, RunMigrations(db, logger))
+	_, err = db.Exec("INSERT INTO sensors (name, sensor_driver) VALUES ('heater-plug', 'mqtt-zigbee2mqtt')")
+	require.NoError(t, err)
+	return NewScheduleRepository(db, logger), db
+}
+
+func heaterSchedule(name string, nextRunAt time.Time) scheduling.Schedule {
+	return scheduling.Schedule{
+		Name: name, Enabled: true, SensorID: 1, Property: "state", Value: "ON",
+		Cron: "30 6 * * mon-fri", Holiday: scheduling.HolidaySkip, NextRunAt: &nextRunAt,
+	}
+}
+
+func TestScheduleRepository_CreateAndGetSchedule(t *testing.T) {
+	repo, _ := newScheduleTestRepo(t)
+	ctx := context.Background()
+	next := time.Date(2026, 3, 2, 6, 30, 0, 0, time.UTC)
+
+	schedule := heaterSchedule("Heater weekday mornings", next)
+	require.NoError(t, repo.CreateSchedule(ctx, &schedule))
+	require.NotZero(t, schedule.ID)
+
+	got, err := repo.GetScheduleByID(ctx, schedule.ID)
+	require.NoError(t, err)
+	require.NotNil(t, got)
+	assert.Equal(t, "Heater weekday mornings", got.Name)
+	assert.Equal(t, "heater-plug", got.SensorName)
+	assert.Equal(t, "30 6 * * mon-fri", got.Cron)
+	assert.Equal(t, scheduling.HolidaySkip, got.Holiday)
+	require.NotNil(t, got.NextRunAt)
+	assert.True(t, got.NextRunAt.Equal(next))
+	assert.Nil(t, got.LastRunAt)
+
+	missing, err := repo.GetScheduleByID(ctx, 999)
+	require.NoError(t, err)
+	assert.Nil(t, missing)
+}
+
+func TestScheduleRepository_GetDueSchedules(t *testing.T) {
+	repo, _ := newScheduleTestRepo(t)
+	ctx := context.Background()
+	now := time.Date(2026, 3, 2, 6, 31, 0, 0, time.UTC)
+
+	due := heaterSchedule("due", now.Add(-time.Minute))
+	later := heaterSchedule("later", now.Add(time.Hour))
+	disabled := heaterSchedule("disabled", now.Add(-time.Minute))
+	disabled.Enabled = false
+	for _, s := range []*scheduling.Schedule{&due, &later, &disabled} {
+		require.NoError(t, repo.CreateSchedule(ctx, s))
+	}
+
+	schedules, err := repo.GetDueSchedules(ctx, now)
+	require.NoError(t, err)
+	require.Len(t, schedules, 1)
+	assert.Equal(t, "due", schedules[0].Name)
+}
+
+func TestScheduleRepository_ClaimScheduleRun_OnlyOnce(t *testing.T) {
+	repo, _ := newScheduleTestRepo(t)
+	ctx := context.Background()
+	dueAt := time.Date(2026, 3, 2, 6, 30, 0, 0, time.UTC)
+	next := dueAt.Add(24 * time.Hour)
+
+	schedule := heaterSchedule("Heater weekday mornings", dueAt)
+	require.NoError(t, repo.CreateSchedule(ctx, &schedule))
+
+	claimed, err := repo.ClaimScheduleRun(ctx, schedule.ID, dueAt, next)
+	require.NoError(t, err)
+	assert.True(t, claimed)
+
+	claimed, err = repo.ClaimScheduleRun(ctx, schedule.ID, dueAt, next)
+	require.NoError(t, err)
+	assert.False(t, claimed, "a run must only be claimed once")
+
+	got, err := repo.GetScheduleByID(ctx, schedule.ID)
+	require.NoError(t, err)
+	assert.True(t, got.NextRunAt.Equal(next))
+}
+
+func TestScheduleRepository_RecordScheduleRun_LinksCommandHistory(t *testing.T) {
+	repo, db := newScheduleTestRepo(t)
+	ctx := context.Background()
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	historyRepo := NewSensorCommandHistoryRepository(db, logger)
+	ranAt := time.Date(2026, 3, 2, 6, 30, 5, 0, time.UTC)
+
+	schedule := heaterSchedule("Heater weekday mornings", ranAt)
+	require.NoError(t, repo.CreateSchedule(ctx, &schedule))
+	commandID, err := historyRepo.AddSentCommand(ctx, 1, nil, nil, "state", "ON", "zigbee2mqtt/heater-plug/set", `{"state":"ON"}`, 10, ranAt)
+	require.NoError(t, err)
+
+	require.NoError(t, repo.RecordScheduleRun(ctx, schedule.ID, ranAt, &commandID, ""))
+
+	got, err := repo.GetScheduleByID(ctx, schedule.ID)
+	require.NoError(t, err)
+	require.NotNil(t, got.LastRunAt)
+	assert.True(t, got.LastRunAt.Equal(ranAt))
+	assert.Equal(t, commandID, *got.LastCommandID)
+	assert.Empty(t, got.LastError)
+
+	history, err := historyRepo.ListBySensorID(ctx, 1, 10)
+	require.NoError(t, err)
+	require.Len(t, history, 1)
+	require.NotNil(t, history[0].Schedule)
+	assert.Equal(t, schedule.ID, history[0].Schedule.Id)
+	assert.Equal(t, "Heater weekday mornings", history[0].Schedule.Name)
+	assert.Nil(t, history[0].User)
+
+	require.NoError(t, repo.RecordScheduleRun(ctx, schedule.ID, ranAt.Add(time.Hour), nil, "sensor 1 is not in a controllable state"))
+	got, err = repo.GetScheduleByID(ctx, schedule.ID)
+	require.NoError(t, err)
+	assert.Nil(t, got.LastCommandID)
+	assert.Equal(t, "sensor 1 is not in a controllable state", got.LastError)
+}
+
+func TestScheduleRepository_HolidayMode(t *testing.T) {
+	repo, _ := newScheduleTestRepo(t)
+	ctx := context.Background()
+
+	mode, err := repo.GetHolidayMode(ctx)
+	require.NoError(t, err)
+	assert.False(t, mode.Enabled)
+	assert.Nil(t, mode.Until)
+
+	until := time.Date(2026, 8, 16, 18, 0, 0, 0, time.UTC)
+	require.NoError(t, repo.SetHolidayMode(ctx, scheduling.HolidayMode{Enabled: true, Until: &until}))
+	mode, err = repo.GetHolidayMode(ctx)
+	require.NoError(t, err)
+	assert.True(t, mode.Enabled)
+	require.NotNil(t, mode.Until)
+	assert.True(t, mode.Until.Equal(until))
+
+	require.NoError(t, repo.SetHolidayMode(ctx, scheduling.HolidayMode{}))
+	mode, err = repo.GetHolidayMode(ctx)
+	require.NoError(t, err)
+	assert.False(t, mode.Enabled)
+	assert.Nil(t, mode.Until)
+}
+
+func TestScheduleRepository_DeletedWithSensor(t *testing.T) {
+	repo, db := newScheduleTestRepo(t)
+	ctx := context.Background()
+
+	schedule := heaterSchedule("Heater weekday mornings", time.Now())
+	require.NoError(t, repo.CreateSchedule(ctx, &schedule))
+	_, err := db.Exec("DELETE FROM sensors WHERE id = 1")
+	require.NoError(t, err)
+
+	schedules, err := repo.GetAllSchedules(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, schedules)
+}
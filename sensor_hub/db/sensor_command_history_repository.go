@@ -31,16 +31,21 @@ func NewSensorCommandHistoryRepository(db *sql.DB, logger *slog.Logger) *SensorC
 	return &SensorCommandHistoryRepository{db: db, logger: logger.With("component", "sensor_command_history_repository")}
 }
 
-func (r *SensorCommandHistoryRepository) AddSentCommand(ctx context.Context, sensorID int, userID *int, property string, value string, mqttTopic string, mqttPayload string, timeoutSeconds int, sentAt time.Time) (int, error) {
-	var userIDValue any
+// AddSentCommand records a sent command. Exactly one of userID and automationRuleID is
+// normally set: the user who sent the command, or the automation rule that issued it.
+func (r *SensorCommandHistoryRepository) AddSentCommand(ctx context.Context, sensorID int, userID *int, automationRuleID *int, property string, value string, mqttTopic string, mqttPayload string, timeoutSeconds int, sentAt time.Time) (int, error) {
+	var userIDValue, automationRuleIDValue any
 	if userID != nil {
 		userIDValue = *userID
 	}
+	if automationRuleID != nil {
+		automationRuleIDValue = *automationRuleID
+	}
 
 	query := `INSERT INTO sensor_command_history
-		(sensor_id, user_id, property, value, status, mqtt_topic, mqtt_payload, timeout_seconds, sent_at)
-		VALUES (?, ?, ?, ?, 'sent', ?, ?, ?, ?)`
-	result, err := r.db.ExecContext(ctx, query, sensorID, userIDValue, property, value, mqttTopic, mqttPayload, timeoutSeconds, sentAt)
+		(sensor_id, user_id, automation_rule_id, property, value, status, mqtt_topic, mqtt_payload, timeout_seconds, sent_at)
+		VALUES (?, ?, ?, ?, ?, 'sent', ?, ?, ?, ?)`
+	result, err := r.db.ExecContext(ctx, query, sensorID, userIDValue, automationRuleIDValue, property, value, mqttTopic, mqttPayload, timeoutSeconds, sentAt)
 	if err != nil {
 		return 0, fmt.Errorf("error inserting sensor command history: %w", err)
 	}
@@ -141,9 +146,12 @@ func (r *SensorCommandHistoryRepository) ListPendingCommands(ctx context.Context
 
 func (r *SensorCommandHistoryRepository) ListBySensorID(ctx context.Context, sensorID int, limit int) ([]gen.CommandHistoryEntry, error) {
 	query := `SELECT h.id, h.property, h.value, h.status, h.sent_at, h.acknowledged_at, h.acknowledged_value,
-			h.timeout_seconds, h.mqtt_topic, h.mqtt_payload, u.id, u.username
+			h.timeout_seconds, h.mqtt_topic, h.mqtt_payload, u.id, u.username, h.automation_rule_id, ar.name,
+			h.command_schedule_id, cs.name
 		FROM sensor_command_history h
 		LEFT JOIN users u ON u.id = h.user_id
+		LEFT JOIN automation_rules ar ON ar.id = h.automation_rule_id
+		LEFT JOIN command_schedules cs ON cs.id = h.command_schedule_id
 		WHERE h.sensor_id = ?
 		ORDER BY h.sent_at DESC, h.id DESC
 		LIMIT ?`
@@ -161,6 +169,10 @@ func (r *SensorCommandHistoryRepository) ListBySensorID(ctx context.Context, sen
 		var acknowledgedValue sql.NullString
 		var userID sql.NullInt64
 		var username sql.NullString
+		var automationRuleID sql.NullInt64
+		var automationRuleName sql.NullString
+		var scheduleID sql.NullInt64
+		var scheduleName sql.NullString
 		if err := rows.Scan(
 			&entry.Id,
 			&entry.Property,
@@ -174,6 +186,10 @@ func (r *SensorCommandHistoryRepository) ListBySensorID(ctx context.Context, sen
 			&entry.MqttPayload,
 			&userID,
 			&username,
+			&automationRuleID,
+			&automationRuleName,
+			&scheduleID,
+			&scheduleName,
 		); err != nil {
 			return nil, fmt.Errorf("error scanning sensor command history row: %w", err)
 		}
@@ -193,6 +209,19 @@ func (r *SensorCommandHistoryRepository) ListBySensorID(ctx context.Context, sen
 				entry.User.Username = username.String
 			}
 		}
+		if automationRuleID.Valid {
+			// The name is empty once the rule has been deleted.
+			entry.AutomationRule = &gen.CommandHistoryAutomationRule{
+				Id:   int(automationRuleID.Int64),
+				Name: automationRuleName.String,
+			}
+		}
+		if scheduleID.Valid {
+			entry.Schedule = &gen.CommandHistorySchedule{
+				Id:   int(scheduleID.Int64),
+				Name: scheduleName.String,
+			}
+		}
 		history = append(history, entry)
 	}
 	if err := rows.Err(); err != nil {
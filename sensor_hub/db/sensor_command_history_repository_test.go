@@ -20,15 +20,31 @@ func TestSensorCommandHistoryRepository_AddSentCommand_Success(t *testing.T) {
 	sentAt := time.Date(2026, 5, 9, 12, 0, 0, 0, time.UTC)
 
 	mock.ExpectExec("INSERT INTO sensor_command_history").
-		WithArgs(7, &userID, "state", "ON", "zigbee2mqtt/office-plug/set", `{"state":"ON"}`, 10, sentAt).
+		WithArgs(7, &userID, nil, "state", "ON", "zigbee2mqtt/office-plug/set", `{"state":"ON"}`, 10, sentAt).
 		WillReturnResult(sqlmock.NewResult(42, 1))
 
-	id, err := repo.AddSentCommand(context.Background(), 7, &userID, "state", "ON", "zigbee2mqtt/office-plug/set", `{"state":"ON"}`, 10, sentAt)
+	id, err := repo.AddSentCommand(context.Background(), 7, &userID, nil, "state", "ON", "zigbee2mqtt/office-plug/set", `{"state":"ON"}`, 10, sentAt)
 	assert.NoError(t, err)
 	assert.Equal(t, 42, id)
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
 
+func TestSensorCommandHistoryRepository_AddSentCommand_RecordsAutomationRule(t *testing.T) {
+	db, mock := newMockDB(t)
+	repo := NewSensorCommandHistoryRepository(db, slog.Default())
+	ruleID := 5
+	sentAt := time.Date(2026, 5, 9, 12, 0, 0, 0, time.UTC)
+
+	mock.ExpectExec("INSERT INTO sensor_command_history").
+		WithArgs(7, nil, &ruleID, "state", "ON", "zigbee2mqtt/office-plug/set", `{"state":"ON"}`, 10, sentAt).
+		WillReturnResult(sqlmock.NewResult(43, 1))
+
+	id, err := repo.AddSentCommand(context.Background(), 7, nil, &ruleID, "state", "ON", "zigbee2mqtt/office-plug/set", `{"state":"ON"}`, 10, sentAt)
+	assert.NoError(t, err)
+	assert.Equal(t, 43, id)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
 func TestSensorCommandHistoryRepository_HasPendingCommand_ReturnsTrue(t *testing.T) {
 	db, mock := newMockDB(t)
 	repo := NewSensorCommandHistoryRepository(db, slog.Default())
@@ -48,10 +64,10 @@ func TestSensorCommandHistoryRepository_AddSentCommand_DBError(t *testing.T) {
 	repo := NewSensorCommandHistoryRepository(db, slog.Default())
 
 	mock.ExpectExec("INSERT INTO sensor_command_history").
-		WithArgs(7, nil, "state", "ON", "zigbee2mqtt/office-plug/set", `{"state":"ON"}`, 10, sqlmock.AnyArg()).
+		WithArgs(7, nil, nil, "state", "ON", "zigbee2mqtt/office-plug/set", `{"state":"ON"}`, 10, sqlmock.AnyArg()).
 		WillReturnError(errors.New("write failed"))
 
-	_, err := repo.AddSentCommand(context.Background(), 7, nil, "state", "ON", "zigbee2mqtt/office-plug/set", `{"state":"ON"}`, 10, time.Now().UTC())
+	_, err := repo.AddSentCommand(context.Background(), 7, nil, nil, "state", "ON", "zigbee2mqtt/office-plug/set", `{"state":"ON"}`, 10, time.Now().UTC())
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "error inserting sensor command history")
 	assert.NoError(t, mock.ExpectationsWereMet())
@@ -103,13 +119,14 @@ func TestSensorCommandHistoryRepository_ListBySensorID_ReturnsNewestEntriesWithU
 	acknowledgedAt := sentAt.Add(2 * time.Second)
 	ackValue := "true"
 
-	mock.ExpectQuery("SELECT h.id, h.property, h.value, h.status, h.sent_at, h.acknowledged_at, h.acknowledged_value, h.timeout_seconds, h.mqtt_topic, h.mqtt_payload, u.id, u.username").
+	mock.ExpectQuery("SELECT h.id, h.property, h.value, h.status, h.sent_at, h.acknowledged_at, h.acknowledged_value, h.timeout_seconds, h.mqtt_topic, h.mqtt_payload, u.id, u.username, h.automation_rule_id, ar.name").
 		WithArgs(7, 50).
 		WillReturnRows(sqlmock.NewRows([]string{
-			"id", "property", "value", "status", "sent_at", "acknowledged_at", "acknowledged_value", "timeout_seconds", "mqtt_topic", "mqtt_payload", "user_id", "username",
+			"id", "property", "value", "status", "sent_at", "acknowledged_at", "acknowledged_value", "timeout_seconds", "mqtt_topic", "mqtt_payload", "user_id", "username", "automation_rule_id", "automation_rule_name", "command_schedule_id", "command_schedule_name",
 		}).
-			AddRow(42, "state", "ON", "acknowledged", sentAt, acknowledgedAt, ackValue, 10, "zigbee2mqtt/office-plug/set", `{"state":"ON"}`, 99, "admin").
-			AddRow(41, "state", "OFF", "timed_out", sentAt.Add(-time.Minute), nil, nil, 10, "zigbee2mqtt/office-plug/set", `{"state":"OFF"}`, nil, nil))
+			AddRow(42, "state", "ON", "acknowledged", sentAt, acknowledgedAt, ackValue, 10, "zigbee2mqtt/office-plug/set", `{"state":"ON"}`, 99, "admin", nil, nil, nil, nil).
+			AddRow(41, "state", "OFF", "timed_out", sentAt.Add(-time.Minute), nil, nil, 10, "zigbee2mqtt/office-plug/set", `{"state":"OFF"}`, nil, nil, 5, "Dehumidifier off", nil, nil).
+			AddRow(40, "state", "ON", "sent", sentAt.Add(-2*time.Minute), nil, nil, 10, "zigbee2mqtt/office-plug/set", `{"state":"ON"}`, nil, nil, nil, nil, 3, "Heater weekday mornings"))
 
 	history, err := repo.ListBySensorID(context.Background(), 7, 50)
 	assert.NoError(t, err)
@@ -139,6 +156,24 @@ func TestSensorCommandHistoryRepository_ListBySensorID_ReturnsNewestEntriesWithU
 			TimeoutSeconds: 10,
 			MqttTopic:      "zigbee2mqtt/office-plug/set",
 			MqttPayload:    `{"state":"OFF"}`,
+			AutomationRule: &gen.CommandHistoryAutomationRule{
+				Id:   5,
+				Name: "Dehumidifier off",
+			},
+		},
+		{
+			Id:             40,
+			Property:       "state",
+			Value:          "ON",
+			Status:         gen.CommandHistoryEntryStatusSent,
+			SentAt:         sentAt.Add(-2 * time.Minute),
+			TimeoutSeconds: 10,
+			MqttTopic:      "zigbee2mqtt/office-plug/set",
+			MqttPayload:    `{"state":"ON"}`,
+			Schedule: &gen.CommandHistorySchedule{
+				Id:   3,
+				Name: "Heater weekday mornings",
+			},
 		},
 	}, history)
 	assert.NoError(t, mock.ExpectationsWereMet())
@@ -265,7 +265,7 @@ func (s *SensorRepository) DeleteSensorByName(ctx context.Context, name string)
 }
 
 func (s *SensorRepository) GetSensorsByDriver(ctx context.Context, sensorDriver string) ([]gen.Sensor, error) {
-	query := "SELECT id, name, external_id, sensor_driver, config, health_status, health_reason, enabled, status, retention_hours, metadata FROM sensors WHERE LOWER(sensor_driver) = LOWER(?)"
+	query := "SELECT id, name, external_id, sensor_driver, config, health_status, health_reason, enabled, status, retention_hours, expected_interval_seconds, metadata FROM sensors WHERE LOWER(sensor_driver) = LOWER(?)"
 	rows, err := s.db.QueryContext(ctx, query, sensorDriver)
 	if err != nil {
 		return nil, fmt.Errorf("error querying sensors by driver: %w", err)
@@ -348,7 +348,7 @@ func (s *SensorRepository) AddSensor(ctx context.Context, sensor gen.Sensor) err
 }
 
 func (s *SensorRepository) GetSensorById(ctx context.Context, id int) (*gen.Sensor, error) {
-	query := "SELECT id, name, external_id, sensor_driver, config, health_status, health_reason, enabled, status, retention_hours, metadata FROM sensors WHERE id = ?"
+	query := "SELECT id, name, external_id, sensor_driver, config, health_status, health_reason, enabled, status, retention_hours, expected_interval_seconds, metadata FROM sensors WHERE id = ?"
 	sensor, err := scanSensorRow(s.db.QueryRowContext(ctx, query, id))
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -360,7 +360,7 @@ func (s *SensorRepository) GetSensorById(ctx context.Context, id int) (*gen.Sens
 }
 
 func (s *SensorRepository) GetSensorByName(ctx context.Context, name string) (*gen.Sensor, error) {
-	query := "SELECT id, name, external_id, sensor_driver, config, health_status, health_reason, enabled, status, retention_hours, metadata FROM sensors WHERE LOWER(name) = LOWER(?)"
+	query := "SELECT id, name, external_id, sensor_driver, config, health_status, health_reason, enabled, status, retention_hours, expected_interval_seconds, metadata FROM sensors WHERE LOWER(name) = LOWER(?)"
 	sensor, err := scanSensorRow(s.db.QueryRowContext(ctx, query, name))
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -372,7 +372,7 @@ func (s *SensorRepository) GetSensorByName(ctx context.Context, name string) (*g
 }
 
 func (s *SensorRepository) GetSensorByExternalId(ctx context.Context, externalId string) (*gen.Sensor, error) {
-	query := "SELECT id, name, external_id, sensor_driver, config, health_status, health_reason, enabled, status, retention_hours, metadata FROM sensors WHERE LOWER(external_id) = LOWER(?)"
+	query := "SELECT id, name, external_id, sensor_driver, config, health_status, health_reason, enabled, status, retention_hours, expected_interval_seconds, metadata FROM sensors WHERE LOWER(external_id) = LOWER(?)"
 	sensor, err := scanSensorRow(s.db.QueryRowContext(ctx, query, externalId))
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -394,7 +394,7 @@ func (s *SensorRepository) SensorExistsByExternalId(ctx context.Context, externa
 }
 
 func (s *SensorRepository) GetAllSensors(ctx context.Context) ([]gen.Sensor, error) {
-	query := "SELECT id, name, external_id, sensor_driver, config, health_status, health_reason, enabled, status, retention_hours, metadata FROM sensors"
+	query := "SELECT id, name, external_id, sensor_driver, config, health_status, health_reason, enabled, status, retention_hours, expected_interval_seconds, metadata FROM sensors"
 	rows, err := s.db.QueryContext(ctx, query)
 	if err != nil {
 		return nil, fmt.Errorf("error querying all sensors: %w", err)
@@ -458,7 +458,7 @@ type scannable interface {
 }
 
 // scanSensorRow scans a sensor row (columns: id, name, external_id, sensor_driver, config,
-// health_status, health_reason, enabled, status, retention_hours, metadata) and unmarshals
+// health_status, health_reason, enabled, status, retention_hours, expected_interval_seconds, metadata) and unmarshals
 // the JSON config/metadata columns into the Sensor maps.
 func scanSensorRow(row scannable) (gen.Sensor, error) {
 	var s gen.Sensor
@@ -466,7 +466,8 @@ func scanSensorRow(row scannable) (gen.Sensor, error) {
 	var metadataJSON string
 	var externalId sql.NullString
 	var retentionHours sql.NullInt64
-	err := row.Scan(&s.Id, &s.Name, &externalId, &s.SensorDriver, &configJSON, &s.HealthStatus, &s.HealthReason, &s.Enabled, &s.Status, &retentionHours, &metadataJSON)
+	var expectedInterval sql.NullInt64
+	err := row.Scan(&s.Id, &s.Name, &externalId, &s.SensorDriver, &configJSON, &s.HealthStatus, &s.HealthReason, &s.Enabled, &s.Status, &retentionHours, &expectedInterval, &metadataJSON)
 	if err != nil {
 		return s, err
 	}
@@ -477,6 +478,10 @@ func scanSensorRow(row scannable) (gen.Sensor, error) {
 		v := int(retentionHours.Int64)
 		s.RetentionHours = &v
 	}
+	if expectedInterval.Valid {
+		v := int(expectedInterval.Int64)
+		s.ExpectedIntervalSeconds = &v
+	}
 	if configJSON != "" {
 		if err := json.Unmarshal([]byte(configJSON), &s.Config); err != nil {
 			return s, fmt.Errorf("failed to unmarshal sensor config: %w", err)
@@ -503,7 +508,7 @@ func sensorMetadataValue(metadata *map[string]interface{}) map[string]interface{
 }
 
 func (sr *SensorRepository) GetSensorsByStatus(ctx context.Context, status string) ([]gen.Sensor, error) {
-	query := "SELECT id, name, external_id, sensor_driver, config, health_status, health_reason, enabled, status, retention_hours, metadata FROM sensors WHERE LOWER(status) = LOWER(?)"
+	query := "SELECT id, name, external_id, sensor_driver, config, health_status, health_reason, enabled, status, retention_hours, expected_interval_seconds, metadata FROM sensors WHERE LOWER(status) = LOWER(?)"
 	rows, err := sr.db.QueryContext(ctx, query, status)
 	if err != nil {
 		return nil, fmt.Errorf("error querying sensors by status: %w", err)
@@ -526,7 +531,7 @@ func (sr *SensorRepository) GetSensorsByStatus(ctx context.Context, status strin
 
 // GetSensorsWithRetention returns all sensors that have a custom retention_hours set.
 func (sr *SensorRepository) GetSensorsWithRetention(ctx context.Context) ([]gen.Sensor, error) {
-	query := "SELECT id, name, external_id, sensor_driver, config, health_status, health_reason, enabled, status, retention_hours, metadata FROM sensors WHERE retention_hours IS NOT NULL"
+	query := "SELECT id, name, external_id, sensor_driver, config, health_status, health_reason, enabled, status, retention_hours, expected_interval_seconds, metadata FROM sensors WHERE retention_hours IS NOT NULL"
 	rows, err := sr.db.QueryContext(ctx, query)
 	if err != nil {
 		return nil, fmt.Errorf("error querying sensors with custom retention: %w", err)
@@ -547,6 +552,24 @@ func (sr *SensorRepository) GetSensorsWithRetention(ctx context.Context) ([]gen.
 	return sensors, nil
 }
 
+// UpdateSensorExpectedInterval sets the sensor's expected reporting interval. A nil value
+// clears the override so the driver or global default applies.
+func (sr *SensorRepository) UpdateSensorExpectedInterval(ctx context.Context, sensorId int, expectedIntervalSeconds *int) error {
+	query := "UPDATE sensors SET expected_interval_seconds = ? WHERE id = ?"
+	result, err := sr.db.ExecContext(ctx, query, expectedIntervalSeconds, sensorId)
+	if err != nil {
+		return fmt.Errorf("error updating sensor expected interval: %w", err)
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("error fetching rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return fmt.Errorf("sensor with id %d not found", sensorId)
+	}
+	return nil
+}
+
 func (sr *SensorRepository) UpdateSensorStatus(ctx context.Context, sensorId int, status string) error {
 	query := "UPDATE sensors SET status = ?, enabled = CASE WHEN ? = 'active' THEN 1 ELSE enabled END WHERE id = ?"
 	result, err := sr.db.ExecContext(ctx, query, status, status, sensorId)
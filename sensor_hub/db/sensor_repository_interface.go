@@ -26,4 +26,5 @@ type SensorRepositoryInterface[T any] interface {
 	GetSensorHealthHistoryById(ctx context.Context, sensorId int, since time.Time) ([]gen.SensorHealthHistory, error)
 	DeleteHealthHistoryOlderThan(ctx context.Context, cutoffDate time.Time) error
 	GetSensorsWithRetention(ctx context.Context) ([]gen.Sensor, error)
+	UpdateSensorExpectedInterval(ctx context.Context, sensorId int, expectedIntervalSeconds *int) error
 }
@@ -138,10 +138,10 @@ func TestSensorRepository_GetSensorByName_Success(t *testing.T) {
 	db, mock := newMockDB(t)
 	repo := NewSensorRepository(db, slog.Default())
 
-	mock.ExpectQuery("SELECT id, name, external_id, sensor_driver, config, health_status, health_reason, enabled, status, retention_hours, metadata FROM sensors WHERE LOWER\\(name\\) = LOWER\\(\\?\\)").
+	mock.ExpectQuery("SELECT id, name, external_id, sensor_driver, config, health_status, health_reason, enabled, status, retention_hours, expected_interval_seconds, metadata FROM sensors WHERE LOWER\\(name\\) = LOWER\\(\\?\\)").
 		WithArgs("test-sensor").
 		WillReturnRows(sqlmock.NewRows(sensorColumns).
-			AddRow(1, "test-sensor", nil, "temperature", `{"url":"http://localhost:8080"}`, "good", "ok", true, "active", nil, `{}`))
+			AddRow(1, "test-sensor", nil, "temperature", `{"url":"http://localhost:8080"}`, "good", "ok", true, "active", nil, nil, `{}`))
 
 	sensor, err := repo.GetSensorByName(context.Background(), "test-sensor")
 
@@ -160,10 +160,10 @@ func TestSensorRepository_GetSensorByName_IncludesMetadata(t *testing.T) {
 	db, mock := newMockDB(t)
 	repo := NewSensorRepository(db, slog.Default())
 
-	mock.ExpectQuery("SELECT id, name, external_id, sensor_driver, config, health_status, health_reason, enabled, status, retention_hours, metadata FROM sensors WHERE LOWER\\(name\\) = LOWER\\(\\?\\)").
+	mock.ExpectQuery("SELECT id, name, external_id, sensor_driver, config, health_status, health_reason, enabled, status, retention_hours, expected_interval_seconds, metadata FROM sensors WHERE LOWER\\(name\\) = LOWER\\(\\?\\)").
 		WithArgs("test-sensor").
 		WillReturnRows(sqlmock.NewRows(sensorColumns).
-			AddRow(1, "test-sensor", nil, "mqtt-zigbee2mqtt", `{"base_topic":"zigbee2mqtt"}`, "good", "ok", true, "active", nil, `{"manufacturer":"Aqara","model":"MCCGQ11LM"}`))
+			AddRow(1, "test-sensor", nil, "mqtt-zigbee2mqtt", `{"base_topic":"zigbee2mqtt"}`, "good", "ok", true, "active", nil, nil, `{"manufacturer":"Aqara","model":"MCCGQ11LM"}`))
 
 	sensor, err := repo.GetSensorByName(context.Background(), "test-sensor")
 
@@ -179,7 +179,7 @@ func TestSensorRepository_GetSensorByName_NotFound(t *testing.T) {
 	db, mock := newMockDB(t)
 	repo := NewSensorRepository(db, slog.Default())
 
-	mock.ExpectQuery("SELECT id, name, external_id, sensor_driver, config, health_status, health_reason, enabled, status, retention_hours, metadata FROM sensors WHERE LOWER\\(name\\) = LOWER\\(\\?\\)").
+	mock.ExpectQuery("SELECT id, name, external_id, sensor_driver, config, health_status, health_reason, enabled, status, retention_hours, expected_interval_seconds, metadata FROM sensors WHERE LOWER\\(name\\) = LOWER\\(\\?\\)").
 		WithArgs("nonexistent").
 		WillReturnError(sql.ErrNoRows)
 
@@ -195,7 +195,7 @@ func TestSensorRepository_GetSensorByName_DBError(t *testing.T) {
 	db, mock := newMockDB(t)
 	repo := NewSensorRepository(db, slog.Default())
 
-	mock.ExpectQuery("SELECT id, name, external_id, sensor_driver, config, health_status, health_reason, enabled, status, retention_hours, metadata FROM sensors WHERE LOWER\\(name\\) = LOWER\\(\\?\\)").
+	mock.ExpectQuery("SELECT id, name, external_id, sensor_driver, config, health_status, health_reason, enabled, status, retention_hours, expected_interval_seconds, metadata FROM sensors WHERE LOWER\\(name\\) = LOWER\\(\\?\\)").
 		WithArgs("test-sensor").
 		WillReturnError(errors.New("connection error"))
 
@@ -215,10 +215,10 @@ func TestSensorRepository_GetAllSensors_Success(t *testing.T) {
 	db, mock := newMockDB(t)
 	repo := NewSensorRepository(db, slog.Default())
 
-	mock.ExpectQuery("SELECT id, name, external_id, sensor_driver, config, health_status, health_reason, enabled, status, retention_hours, metadata FROM sensors").
+	mock.ExpectQuery("SELECT id, name, external_id, sensor_driver, config, health_status, health_reason, enabled, status, retention_hours, expected_interval_seconds, metadata FROM sensors").
 		WillReturnRows(sqlmock.NewRows(sensorColumns).
-			AddRow(1, "sensor-1", nil, "temperature", `{"url":"http://localhost:8081"}`, "good", "ok", true, "active", nil, `{}`).
-			AddRow(2, "sensor-2", nil, "temperature", `{"url":"http://localhost:8082"}`, "bad", "timeout", false, "active", nil, `{}`))
+			AddRow(1, "sensor-1", nil, "temperature", `{"url":"http://localhost:8081"}`, "good", "ok", true, "active", nil, nil, `{}`).
+			AddRow(2, "sensor-2", nil, "temperature", `{"url":"http://localhost:8082"}`, "bad", "timeout", false, "active", nil, nil, `{}`))
 
 	sensors, err := repo.GetAllSensors(context.Background())
 
@@ -233,7 +233,7 @@ func TestSensorRepository_GetAllSensors_EmptyTable(t *testing.T) {
 	db, mock := newMockDB(t)
 	repo := NewSensorRepository(db, slog.Default())
 
-	mock.ExpectQuery("SELECT id, name, external_id, sensor_driver, config, health_status, health_reason, enabled, status, retention_hours, metadata FROM sensors").
+	mock.ExpectQuery("SELECT id, name, external_id, sensor_driver, config, health_status, health_reason, enabled, status, retention_hours, expected_interval_seconds, metadata FROM sensors").
 		WillReturnRows(sqlmock.NewRows(sensorColumns))
 
 	sensors, err := repo.GetAllSensors(context.Background())
@@ -247,7 +247,7 @@ func TestSensorRepository_GetAllSensors_DBError(t *testing.T) {
 	db, mock := newMockDB(t)
 	repo := NewSensorRepository(db, slog.Default())
 
-	mock.ExpectQuery("SELECT id, name, external_id, sensor_driver, config, health_status, health_reason, enabled, status, retention_hours, metadata FROM sensors").
+	mock.ExpectQuery("SELECT id, name, external_id, sensor_driver, config, health_status, health_reason, enabled, status, retention_hours, expected_interval_seconds, metadata FROM sensors").
 		WillReturnError(errors.New("database error"))
 
 	sensors, err := repo.GetAllSensors(context.Background())
@@ -266,11 +266,11 @@ func TestSensorRepository_GetSensorsByDriver_Success(t *testing.T) {
 	db, mock := newMockDB(t)
 	repo := NewSensorRepository(db, slog.Default())
 
-	mock.ExpectQuery("SELECT id, name, external_id, sensor_driver, config, health_status, health_reason, enabled, status, retention_hours, metadata FROM sensors WHERE LOWER\\(sensor_driver\\) = LOWER\\(\\?\\)").
+	mock.ExpectQuery("SELECT id, name, external_id, sensor_driver, config, health_status, health_reason, enabled, status, retention_hours, expected_interval_seconds, metadata FROM sensors WHERE LOWER\\(sensor_driver\\) = LOWER\\(\\?\\)").
 		WithArgs("sensor-hub-http-temperature").
 		WillReturnRows(sqlmock.NewRows(sensorColumns).
-			AddRow(1, "temp-sensor-1", nil, "sensor-hub-http-temperature", `{"url":"http://localhost:8081"}`, "good", "ok", true, "active", nil, `{}`).
-			AddRow(2, "temp-sensor-2", nil, "sensor-hub-http-temperature", `{"url":"http://localhost:8082"}`, "good", "ok", true, "active", nil, `{}`))
+			AddRow(1, "temp-sensor-1", nil, "sensor-hub-http-temperature", `{"url":"http://localhost:8081"}`, "good", "ok", true, "active", nil, nil, `{}`).
+			AddRow(2, "temp-sensor-2", nil, "sensor-hub-http-temperature", `{"url":"http://localhost:8082"}`, "good", "ok", true, "active", nil, nil, `{}`))
 
 	sensors, err := repo.GetSensorsByDriver(context.Background(), "sensor-hub-http-temperature")
 
@@ -283,7 +283,7 @@ func TestSensorRepository_GetSensorsByDriver_NoMatches(t *testing.T) {
 	db, mock := newMockDB(t)
 	repo := NewSensorRepository(db, slog.Default())
 
-	mock.ExpectQuery("SELECT id, name, external_id, sensor_driver, config, health_status, health_reason, enabled, status, retention_hours, metadata FROM sensors WHERE LOWER\\(sensor_driver\\) = LOWER\\(\\?\\)").
+	mock.ExpectQuery("SELECT id, name, external_id, sensor_driver, config, health_status, health_reason, enabled, status, retention_hours, expected_interval_seconds, metadata FROM sensors WHERE LOWER\\(sensor_driver\\) = LOWER\\(\\?\\)").
 		WithArgs("humidity").
 		WillReturnRows(sqlmock.NewRows(sensorColumns))
 
@@ -298,7 +298,7 @@ func TestSensorRepository_GetSensorsByDriver_DBError(t *testing.T) {
 	db, mock := newMockDB(t)
 	repo := NewSensorRepository(db, slog.Default())
 
-	mock.ExpectQuery("SELECT id, name, external_id, sensor_driver, config, health_status, health_reason, enabled, status, retention_hours, metadata FROM sensors WHERE LOWER\\(sensor_driver\\) = LOWER\\(\\?\\)").
+	mock.ExpectQuery("SELECT id, name, external_id, sensor_driver, config, health_status, health_reason, enabled, status, retention_hours, expected_interval_seconds, metadata FROM sensors WHERE LOWER\\(sensor_driver\\) = LOWER\\(\\?\\)").
 		WithArgs("sensor-hub-http-temperature").
 		WillReturnError(errors.New("database error"))
 
@@ -910,8 +910,8 @@ func TestSensorRepository_GetSensorsByStatus_Success(t *testing.T) {
 	mock.ExpectQuery("SELECT .* FROM sensors WHERE LOWER\\(status\\) = LOWER\\(\\?\\)").
 		WithArgs("pending").
 		WillReturnRows(sqlmock.NewRows(sensorColumns).
-			AddRow(1, "mqtt-sensor-1", "mqtt-sensor-1", "mqtt-zigbee2mqtt", "{}", "healthy", "", true, "pending", nil, `{}`).
-			AddRow(2, "mqtt-sensor-2", "mqtt-sensor-2", "mqtt-zigbee2mqtt", "{}", "unknown", "", true, "pending", nil, `{}`))
+			AddRow(1, "mqtt-sensor-1", "mqtt-sensor-1", "mqtt-zigbee2mqtt", "{}", "healthy", "", true, "pending", nil, nil, `{}`).
+			AddRow(2, "mqtt-sensor-2", "mqtt-sensor-2", "mqtt-zigbee2mqtt", "{}", "unknown", "", true, "pending", nil, nil, `{}`))
 
 	sensors, err := repo.GetSensorsByStatus(context.Background(), "pending")
 
@@ -1150,3 +1150,33 @@ func TestSensorRepository_GetSensorHealthHistoryById_IncludesBaselineAndLaterTra
 		history[1].RecordedAt.UTC(),
 	})
 }
+
+func TestSensorRepository_UpdateSensorExpectedInterval_Set(t *testing.T) {
+	db, mock := newMockDB(t)
+	repo := NewSensorRepository(db, slog.Default())
+
+	interval := 3600
+	mock.ExpectExec("UPDATE sensors SET expected_interval_seconds = \\? WHERE id = \\?").
+		WithArgs(&interval, 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err := repo.UpdateSensorExpectedInterval(context.Background(), 1, &interval)
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSensorRepository_UpdateSensorExpectedInterval_NotFound(t *testing.T) {
+	db, mock := newMockDB(t)
+	repo := NewSensorRepository(db, slog.Default())
+
+	mock.ExpectExec("UPDATE sensors SET expected_interval_seconds = \\? WHERE id = \\?").
+		WithArgs(nil, 99).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	err := repo.UpdateSensorExpectedInterval(context.Background(), 99, nil)
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "not found")
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
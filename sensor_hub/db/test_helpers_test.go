@@ -130,7 +130,7 @@ func testSensorHealthHistory() gen.SensorHealthHistory {
 
 // Column definitions for sqlmock rows
 
-var sensorColumns = []string{"id", "name", "external_id", "sensor_driver", "config", "health_status", "health_reason", "enabled", "status", "retention_hours", "metadata"}
+var sensorColumns = []string{"id", "name", "external_id", "sensor_driver", "config", "health_status", "health_reason", "enabled", "status", "retention_hours", "expected_interval_seconds", "metadata"}
 
 var userColumns = []string{"id", "username", "email", "must_change_password", "disabled", "created_at", "updated_at"}
 
@@ -142,11 +142,11 @@ var readingColumns = []string{"id", "sensor_id", "sensor_name", "measurement_typ
 
 var sensorHealthHistoryColumns = []string{"id", "sensor_id", "health_status", "recorded_at"}
 
-var alertRuleColumns = []string{"id", "sensor_id", "name", "measurement_type_id", "measurement_type", "alert_type", "high_threshold", "low_threshold", "trigger_status", "enabled", "rate_limit_seconds", "sent_at"}
+var alertRuleColumns = []string{"id", "sensor_id", "name", "measurement_type_id", "measurement_type", "alert_type", "high_threshold", "low_threshold", "trigger_status", "enabled", "rate_limit_seconds", "duration_seconds", "change_threshold", "hysteresis", "state", "snoozed_until", "sent_at"}
 
-var alertRuleColumnsNoID = []string{"sensor_id", "name", "measurement_type_id", "measurement_type", "alert_type", "high_threshold", "low_threshold", "trigger_status", "enabled", "rate_limit_seconds", "sent_at"}
+var alertRuleColumnsNoID = []string{"sensor_id", "name", "measurement_type_id", "measurement_type", "alert_type", "high_threshold", "low_threshold", "trigger_status", "enabled", "rate_limit_seconds", "duration_seconds", "change_threshold", "hysteresis", "state", "snoozed_until", "sent_at"}
 
-var alertHistoryColumns = []string{"id", "sensor_id", "alert_type", "reading_value", "sent_at"}
+var alertHistoryColumns = []string{"id", "sensor_id", "alert_type", "reading_value", "sent_at", "resolved_at", "peak_value", "acknowledged_at", "acknowledged_by"}
 
 var roleColumns = []string{"id", "name"}
 
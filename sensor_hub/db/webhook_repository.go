@@ -0,0 +1,234 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"example/sensorHub/notifications"
+	"example/sensorHub/webhook"
+)
+
+const webhookTargetSelect = `
+	SELECT id, user_id, name, url, secret, headers, body_template, categories, enabled
+	FROM webhook_targets
+`
+
+type SqlWebhookRepository struct {
+	db     *sql.DB
+	logger *slog.Logger
+}
+
+func NewWebhookRepository(db *sql.DB, logger *slog.Logger) *SqlWebhookRepository {
+	return &SqlWebhookRepository{
+		db:     db,
+		logger: logger.With("component", "webhook_repository"),
+	}
+}
+
+// GetWebhookTargetsForUsers returns the enabled global targets and the enabled targets
+// belonging to any of userIDs.
+func (r *SqlWebhookRepository) GetWebhookTargetsForUsers(ctx context.Context, userIDs []int) ([]webhook.Target, error) {
+	query := webhookTargetSelect + ` WHERE enabled = TRUE AND (user_id IS NULL`
+	args := make([]any, 0, len(userIDs))
+	if len(userIDs) > 0 {
+		query += ` OR user_id IN (?` + strings.Repeat(", ?", len(userIDs)-1) + `)`
+		for _, id := range userIDs {
+			args = append(args, id)
+		}
+	}
+	query += `) ORDER BY id`
+
+	targets, err := r.queryTargets(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get webhook targets for users: %w", err)
+	}
+	return targets, nil
+}
+
+// GetWebhookTargetsVisibleTo returns the user's own targets, followed by the global
+// targets when includeGlobal is set.
+func (r *SqlWebhookRepository) GetWebhookTargetsVisibleTo(ctx context.Context, userID int, includeGlobal bool) ([]webhook.Target, error) {
+	query := webhookTargetSelect + ` WHERE user_id = ?`
+	if includeGlobal {
+		query += ` OR user_id IS NULL`
+	}
+	query += ` ORDER BY user_id IS NULL, id`
+
+	targets, err := r.queryTargets(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get webhook targets for user %d: %w", userID, err)
+	}
+	return targets, nil
+}
+
+func (r *SqlWebhookRepository) GetWebhookTargetByID(ctx context.Context, targetID int) (*webhook.Target, error) {
+	targets, err := r.queryTargets(ctx, webhookTargetSelect+` WHERE id = ?`, targetID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get webhook target %d: %w", targetID, err)
+	}
+	if len(targets) == 0 {
+		return nil, nil
+	}
+	return &targets[0], nil
+}
+
+func (r *SqlWebhookRepository) queryTargets(ctx context.Context, query string, args ...any) ([]webhook.Target, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var targets []webhook.Target
+	for rows.Next() {
+		var t webhook.Target
+		var userID sql.NullInt64
+		var headers, categories string
+		if err := rows.Scan(&t.ID, &userID, &t.Name, &t.URL, &t.Secret, &headers, &t.BodyTemplate, &categories, &t.Enabled); err != nil {
+			return nil, fmt.Errorf("failed to scan webhook target: %w", err)
+		}
+		if userID.Valid {
+			id := int(userID.Int64)
+			t.UserID = &id
+		}
+		t.Headers = map[string]string{}
+		if err := json.Unmarshal([]byte(headers), &t.Headers); err != nil {
+			return nil, fmt.Errorf("failed to parse headers of webhook target %d: %w", t.ID, err)
+		}
+		t.Categories = []notifications.NotificationCategory{}
+		if categories != "" {
+			for _, c := range strings.Split(categories, ",") {
+				t.Categories = append(t.Categories, notifications.NotificationCategory(c))
+			}
+		}
+		targets = append(targets, t)
+	}
+	return targets, rows.Err()
+}
+
+func (r *SqlWebhookRepository) CreateWebhookTarget(ctx context.Context, target *webhook.Target) error {
+	headers, err := marshalWebhookHeaders(target.Headers)
+	if err != nil {
+		return err
+	}
+	result, err := r.db.ExecContext(ctx, `
+		INSERT INTO webhook_targets (user_id, name, url, secret, headers, body_template, categories, enabled)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+	`, target.UserID, target.Name, target.URL, target.Secret, headers, target.BodyTemplate, joinWebhookCategories(target.Categories), target.Enabled)
+	if err != nil {
+		return fmt.Errorf("failed to create webhook target: %w", err)
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return fmt.Errorf("failed to get webhook target ID: %w", err)
+	}
+	target.ID = int(id)
+	r.logger.Debug("created webhook target", "id", id, "name", target.Name)
+	return nil
+}
+
+// UpdateWebhookTarget updates everything except the owner, which is fixed at creation.
+func (r *SqlWebhookRepository) UpdateWebhookTarget(ctx context.Context, target *webhook.Target) error {
+	headers, err := marshalWebhookHeaders(target.Headers)
+	if err != nil {
+		return err
+	}
+	_, err = r.db.ExecContext(ctx, `
+		UPDATE webhook_targets
+		SET name = ?,
+			url = ?,
+			secret = ?,
+			headers = ?,
+			body_template = ?,
+			categories = ?,
+			enabled = ?,
+			updated_at = datetime('now')
+		WHERE id = ?
+	`, target.Name, target.URL, target.Secret, headers, target.BodyTemplate, joinWebhookCategories(target.Categories), target.Enabled, target.ID)
+	if err != nil {
+		return fmt.Errorf("failed to update webhook target: %w", err)
+	}
+	return nil
+}
+
+func (r *SqlWebhookRepository) DeleteWebhookTarget(ctx context.Context, targetID int) error {
+	if _, err := r.db.ExecContext(ctx, `DELETE FROM webhook_targets WHERE id = ?`, targetID); err != nil {
+		return fmt.Errorf("failed to delete webhook target: %w", err)
+	}
+	return nil
+}
+
+func (r *SqlWebhookRepository) RecordWebhookDelivery(ctx context.Context, delivery *webhook.Delivery) error {
+	result, err := r.db.ExecContext(ctx, `
+		INSERT INTO webhook_deliveries (target_id, notification_id, status, attempts, response_code, error)
+		VALUES (?, ?, ?, ?, ?, ?)
+	`, delivery.TargetID, delivery.NotificationID, delivery.Status, delivery.Attempts, delivery.ResponseCode, delivery.Error)
+	if err != nil {
+		return fmt.Errorf("failed to record webhook delivery: %w", err)
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return fmt.Errorf("failed to get webhook delivery ID: %w", err)
+	}
+	delivery.ID = int(id)
+	return nil
+}
+
+// GetWebhookDeliveries returns the target's most recent deliveries, newest first.
+func (r *SqlWebhookRepository) GetWebhookDeliveries(ctx context.Context, targetID, limit int) ([]webhook.Delivery, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT id, target_id, notification_id, status, attempts, response_code, error, created_at
+		FROM webhook_deliveries
+		WHERE target_id = ?
+		ORDER BY created_at DESC, id DESC
+		LIMIT ?
+	`, targetID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get webhook deliveries: %w", err)
+	}
+	defer rows.Close()
+
+	var deliveries []webhook.Delivery
+	for rows.Next() {
+		var d webhook.Delivery
+		var notificationID, responseCode sql.NullInt64
+		var createdAt SQLiteTime
+		if err := rows.Scan(&d.ID, &d.TargetID, &notificationID, &d.Status, &d.Attempts, &responseCode, &d.Error, &createdAt); err != nil {
+			return nil, fmt.Errorf("failed to scan webhook delivery: %w", err)
+		}
+		if notificationID.Valid {
+			id := int(notificationID.Int64)
+			d.NotificationID = &id
+		}
+		if responseCode.Valid {
+			code := int(responseCode.Int64)
+			d.ResponseCode = &code
+		}
+		d.CreatedAt = createdAt.Time
+		deliveries = append(deliveries, d)
+	}
+	return deliveries, rows.Err()
+}
+
+func marshalWebhookHeaders(headers map[string]string) (string, error) {
+	if headers == nil {
+		return "{}", nil
+	}
+	b, err := json.Marshal(headers)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode webhook headers: %w", err)
+	}
+	return string(b), nil
+}
+
+func joinWebhookCategories(categories []notifications.NotificationCategory) string {
+	names := make([]string, len(categories))
+	for i, c := range categories {
+		names[i] = string(c)
+	}
+	return strings.Join(names, ",")
+}
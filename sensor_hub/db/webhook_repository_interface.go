@@ -0,0 +1,18 @@
+package database
+
+import (
+	"context"
+
+	"example/sensorHub/webhook"
+)
+
+type WebhookRepository interface {
+	GetWebhookTargetsForUsers(ctx context.Context, userIDs []int) ([]webhook.Target, error)
+	GetWebhookTargetsVisibleTo(ctx context.Context, userID int, includeGlobal bool) ([]webhook.Target, error)
+	GetWebhookTargetByID(ctx context.Context, targetID int) (*webhook.Target, error)
+	CreateWebhookTarget(ctx context.Context, target *webhook.Target) error
+	UpdateWebhookTarget(ctx context.Context, target *webhook.Target) error
+	DeleteWebhookTarget(ctx context.Context, targetID int) error
+	RecordWebhookDelivery(ctx context.Context, delivery *webhook.Delivery) error
+	GetWebhookDeliveries(ctx context.Context, targetID, limit int) ([]webhook.Delivery, error)
+}
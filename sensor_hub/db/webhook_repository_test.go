@@ -0,0 +1,112 @@
+package database
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+
+	"example/sensorHub/notifications"
+	"example/sensorHub/webhook"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var webhookTargetColumns = []string{"id", "user_id", "name", "url", "secret", "headers", "body_template", "categories", "enabled"}
+
+func TestWebhookRepository_GetWebhookTargetsForUsers_Success(t *testing.T) {
+	db, dbMock := newMockDB(t)
+	repo := NewWebhookRepository(db, slog.Default())
+
+	dbMock.ExpectQuery(`FROM webhook_targets\s+WHERE enabled = TRUE AND \(user_id IS NULL OR user_id IN \(\?, \?\)\)`).
+		WithArgs(3, 7).
+		WillReturnRows(sqlmock.NewRows(webhookTargetColumns).
+			AddRow(1, nil, "ops", "https://ops.example.com", "s3cret", `{"X-Api-Key":"abc"}`, "", "threshold_alert,config_change", true).
+			AddRow(2, 7, "phone", "https://ntfy.example.com/me", "", "{}", `{{.Title}}`, "", true))
+
+	targets, err := repo.GetWebhookTargetsForUsers(context.Background(), []int{3, 7})
+
+	require.NoError(t, err)
+	require.Len(t, targets, 2)
+	assert.Nil(t, targets[0].UserID)
+	assert.Equal(t, "s3cret", targets[0].Secret)
+	assert.Equal(t, map[string]string{"X-Api-Key": "abc"}, targets[0].Headers)
+	assert.Equal(t, []notifications.NotificationCategory{notifications.CategoryThresholdAlert, notifications.CategoryConfigChange}, targets[0].Categories)
+	assert.Equal(t, 7, *targets[1].UserID)
+	assert.Empty(t, targets[1].Categories)
+	assert.NoError(t, dbMock.ExpectationsWereMet())
+}
+
+func TestWebhookRepository_GetWebhookTargetsForUsers_NoUsers(t *testing.T) {
+	db, dbMock := newMockDB(t)
+	repo := NewWebhookRepository(db, slog.Default())
+
+	dbMock.ExpectQuery(`WHERE enabled = TRUE AND \(user_id IS NULL\)`).
+		WillReturnRows(sqlmock.NewRows(webhookTargetColumns))
+
+	targets, err := repo.GetWebhookTargetsForUsers(context.Background(), nil)
+
+	require.NoError(t, err)
+	assert.Empty(t, targets)
+	assert.NoError(t, dbMock.ExpectationsWereMet())
+}
+
+func TestWebhookRepository_CreateWebhookTarget_Success(t *testing.T) {
+	db, dbMock := newMockDB(t)
+	repo := NewWebhookRepository(db, slog.Default())
+	userID := 7
+	target := webhook.Target{
+		UserID: &userID, Name: "phone", URL: "https://ntfy.example.com/me",
+		Headers:    map[string]string{"Priority": "high"},
+		Categories: []notifications.NotificationCategory{notifications.CategoryThresholdAlert},
+		Enabled:    true,
+	}
+
+	dbMock.ExpectExec("INSERT INTO webhook_targets").
+		WithArgs(&userID, "phone", "https://ntfy.example.com/me", "", `{"Priority":"high"}`, "", "threshold_alert", true).
+		WillReturnResult(sqlmock.NewResult(4, 1))
+
+	err := repo.CreateWebhookTarget(context.Background(), &target)
+
+	require.NoError(t, err)
+	assert.Equal(t, 4, target.ID)
+	assert.NoError(t, dbMock.ExpectationsWereMet())
+}
+
+func TestWebhookRepository_GetWebhookTargetByID_NotFound(t *testing.T) {
+	db, dbMock := newMockDB(t)
+	repo := NewWebhookRepository(db, slog.Default())
+
+	dbMock.ExpectQuery("FROM webhook_targets").
+		WithArgs(9).
+		WillReturnRows(sqlmock.NewRows(webhookTargetColumns))
+
+	target, err := repo.GetWebhookTargetByID(context.Background(), 9)
+
+	require.NoError(t, err)
+	assert.Nil(t, target)
+	assert.NoError(t, dbMock.ExpectationsWereMet())
+}
+
+func TestWebhookRepository_GetWebhookDeliveries_Success(t *testing.T) {
+	db, dbMock := newMockDB(t)
+	repo := NewWebhookRepository(db, slog.Default())
+
+	dbMock.ExpectQuery("FROM webhook_deliveries").
+		WithArgs(1, 50).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "target_id", "notification_id", "status", "attempts", "response_code", "error", "created_at"}).
+			AddRow(2, 1, 12, "failed", 3, 503, "unexpected status 503", "2025-06-01 12:00:00").
+			AddRow(1, 1, nil, "delivered", 1, 200, "", "2025-06-01 11:00:00"))
+
+	deliveries, err := repo.GetWebhookDeliveries(context.Background(), 1, 50)
+
+	require.NoError(t, err)
+	require.Len(t, deliveries, 2)
+	assert.Equal(t, webhook.DeliveryStatusFailed, deliveries[0].Status)
+	assert.Equal(t, 12, *deliveries[0].NotificationID)
+	assert.Equal(t, 503, *deliveries[0].ResponseCode)
+	assert.Nil(t, deliveries[1].NotificationID)
+	assert.Equal(t, 2025, deliveries[1].CreatedAt.Year())
+	assert.NoError(t, dbMock.ExpectationsWereMet())
+}
@@ -0,0 +1,44 @@
+package database
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// windDirectionAggregations returns wind direction's aggregation functions and whether
+// each is the default.
+func windDirectionAggregations(t *testing.T, db *sql.DB) map[string]bool {
+	t.Helper()
+	rows, err := db.Query(`SELECT a.function, a.is_default FROM measurement_type_aggregations a
+		JOIN measurement_types mt ON mt.id = a.measurement_type_id WHERE mt.name = 'wind_direction'`)
+	require.NoError(t, err)
+	defer rows.Close()
+
+	result := map[string]bool{}
+	for rows.Next() {
+		var function string
+		var isDefault bool
+		require.NoError(t, rows.Scan(&function, &isDefault))
+		result[function] = isDefault
+	}
+	require.NoError(t, rows.Err())
+	return result
+}
+
+func TestWindDirectionMigration_UsesLastInsteadOfAverage(t *testing.T) {
+	db := newInMemoryDB(t)
+	m := newTestMigrator(t, db)
+
+	require.NoError(t, m.Migrate(38))
+	before := windDirectionAggregations(t, db)
+	assert.True(t, before["avg"], "wind direction was averaged before migration 39")
+
+	require.NoError(t, m.Migrate(39))
+	assert.Equal(t, map[string]bool{"last": true}, windDirectionAggregations(t, db))
+
+	require.NoError(t, m.Migrate(38))
+	assert.Equal(t, before, windDirectionAggregations(t, db))
+}
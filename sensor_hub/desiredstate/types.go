@@ -0,0 +1,93 @@
+package desiredstate
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// RetryBaseDelay is the wait before the first retry of a desired state. Each further
+	// retry waits twice as long, up to RetryMaxDelay.
+	RetryBaseDelay = 10 * time.Second
+	RetryMaxDelay  = 5 * time.Minute
+	// MaxAttempts is how many retries a desired state gets before it is failed.
+	MaxAttempts = 10
+)
+
+// Status is how far a capability is from its desired value.
+type Status string
+
+const (
+	// StatusPending is reported while a command setting the desired value awaits its
+	// outcome.
+	StatusPending Status = "pending"
+	// StatusInSync is reported when the device last reported the desired value.
+	StatusInSync Status = "in_sync"
+	// StatusRetrying is reported when a command timed out, failed or was answered with
+	// another value, and is sent again at NextRetryAt.
+	StatusRetrying Status = "retrying"
+	// StatusWaiting is reported while the device cannot be reached. The desired value is
+	// sent again when the device next reports.
+	StatusWaiting Status = "waiting"
+	// StatusFailed is reported once MaxAttempts retries have not reached the desired
+	// value. Nothing more is sent until the value is set again; the state is in sync
+	// again if the device reports the value.
+	StatusFailed Status = "failed"
+)
+
+// DesiredState is the value the hub keeps the Property capability of the controllable
+// sensor SensorID at. Commands that do not leave the device at Value are retried with
+// backoff, and the value is sent again whenever the device reports a different one. A
+// command for the property sent by anyone else replaces Value.
+type DesiredState struct {
+	SensorID   int    `json:"sensor_id"`
+	SensorName string `json:"sensor_name"`
+	Property   string `json:"property"`
+	Value      string `json:"value"`
+
+	Status        Status     `json:"status"`
+	Attempts      int        `json:"attempts"`
+	NextRetryAt   *time.Time `json:"next_retry_at"`
+	ReportedValue string     `json:"reported_value"`
+	LastError     string     `json:"last_error"`
+	// CommandID is the command last sent for the property. Only its outcome settles a
+	// pending state.
+	CommandID *int      `json:"command_id"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func (d *DesiredState) Validate() error {
+	if d.SensorID <= 0 {
+		return fmt.Errorf("sensor ID must be a positive integer")
+	}
+	if strings.TrimSpace(d.Property) == "" {
+		return fmt.Errorf("property is required")
+	}
+	if d.Value == "" {
+		return fmt.Errorf("value is required")
+	}
+	return nil
+}
+
+// Matches reports whether a value reported by the device is the desired value. Numbers
+// are compared by value, so that a reported 50 matches a desired "50.0", and text
+// ignores case, as Zigbee2MQTT may report "on" for a requested "ON".
+func (d *DesiredState) Matches(reported string) bool {
+	want, wantErr := strconv.ParseFloat(d.Value, 64)
+	got, gotErr := strconv.ParseFloat(reported, 64)
+	if wantErr == nil && gotErr == nil {
+		return want == got
+	}
+	return strings.EqualFold(strings.TrimSpace(d.Value), strings.TrimSpace(reported))
+}
+
+// RetryDelay is the wait before the given retry, counting from 1.
+func RetryDelay(attempt int) time.Duration {
+	delay := RetryBaseDelay
+	for i := 1; i < attempt && delay < RetryMaxDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, RetryMaxDelay)
+}
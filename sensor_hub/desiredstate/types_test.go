@@ -0,0 +1,59 @@
+package desiredstate
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDesiredState_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		state   DesiredState
+		wantErr string
+	}{
+		{name: "valid", state: DesiredState{SensorID: 2, Property: "state", Value: "ON"}},
+		{name: "missing sensor", state: DesiredState{Property: "state", Value: "ON"}, wantErr: "sensor ID"},
+		{name: "missing property", state: DesiredState{SensorID: 2, Property: " ", Value: "ON"}, wantErr: "property"},
+		{name: "missing value", state: DesiredState{SensorID: 2, Property: "state"}, wantErr: "value"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.state.Validate()
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestDesiredState_Matches(t *testing.T) {
+	tests := []struct {
+		desired, reported string
+		want              bool
+	}{
+		{desired: "ON", reported: "ON", want: true},
+		{desired: "ON", reported: "on", want: true},
+		{desired: "ON", reported: "OFF", want: false},
+		{desired: "50.0", reported: "50", want: true},
+		{desired: "50", reported: "51", want: false},
+		{desired: "50", reported: "fifty", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.desired+"/"+tt.reported, func(t *testing.T) {
+			state := DesiredState{Value: tt.desired}
+			assert.Equal(t, tt.want, state.Matches(tt.reported))
+		})
+	}
+}
+
+func TestRetryDelay(t *testing.T) {
+	assert.Equal(t, 10*time.Second, RetryDelay(1))
+	assert.Equal(t, 20*time.Second, RetryDelay(2))
+	assert.Equal(t, 160*time.Second, RetryDelay(5))
+	assert.Equal(t, RetryMaxDelay, RetryDelay(6))
+	assert.Equal(t, RetryMaxDelay, RetryDelay(100))
+}
@@ -0,0 +1,64 @@
+package drivers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"example/sensorHub/computed"
+	"example/sensorHub/gen"
+)
+
+func init() {
+	Register(&ComputedDriver{})
+}
+
+// ComputedDriver describes virtual sensors whose readings are calculated from other
+// sensors' readings, such as a dew point from a temperature and a humidity or a total
+// power from several plugs.
+//
+// It is neither a pull nor a push driver: nothing is collected or received. Readings
+// are produced by computed.Engine whenever a reading of one of the sensor's inputs is
+// stored, and then go through the normal readings pipeline.
+type ComputedDriver struct{}
+
+var _ SensorDriver = (*ComputedDriver)(nil)
+
+func (d *ComputedDriver) Type() string        { return computed.DriverType }
+func (d *ComputedDriver) DisplayName() string { return "Computed" }
+func (d *ComputedDriver) Description() string {
+	return "Virtual sensor calculated from other sensors' readings — dew point, indoor–outdoor difference, total power, room averages"
+}
+
+func (d *ComputedDriver) ConfigFields() []ConfigFieldSpec {
+	return []ConfigFieldSpec{
+		{Key: "expression", Label: "Expression", Description: "Expression over the named inputs, e.g. dewpoint(t, rh), indoor - outdoor or sum(plugs)", Required: true},
+		{Key: "inputs", Label: "Inputs", Description: `JSON object of named inputs, e.g. {"t": {"sensor": "office", "measurement_type": "temperature"}, "plugs": {"sensors": ["kettle", "oven"], "measurement_type": "power"}}`, Required: true},
+		{Key: "measurement_type", Label: "Measurement Type", Description: "Measurement type of the computed readings (e.g. dew_point)", Required: true},
+		{Key: "unit", Label: "Unit", Description: "Unit of the computed readings; the measurement type's default unit is used when empty"},
+		{Key: "max_age", Label: "Max Input Age", Description: "Skip evaluation while any input is older than this duration (e.g. 15m); inputs never expire when empty"},
+	}
+}
+
+// SupportedMeasurementTypes is empty because the produced type is set by each
+// sensor's config.
+func (d *ComputedDriver) SupportedMeasurementTypes() []gen.MeasurementType {
+	return []gen.MeasurementType{}
+}
+
+// ValidateSensor parses the sensor's definition and rejects inputs that read the
+// sensor itself.
+func (d *ComputedDriver) ValidateSensor(_ context.Context, sensor gen.Sensor) error {
+	definition, err := computed.ParseDefinition(sensor.Config)
+	if err != nil {
+		return err
+	}
+	for name, input := range definition.Inputs {
+		for _, inputSensor := range input.Sensors {
+			if strings.EqualFold(inputSensor, sensor.Name) {
+				return fmt.Errorf("input %q reads sensor %s itself", name, sensor.Name)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,46 @@
+package drivers
+
+import (
+	"context"
+	"testing"
+
+	gen "example/sensorHub/gen"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestComputedDriver_Metadata(t *testing.T) {
+	d := &ComputedDriver{}
+
+	assert.Equal(t, "computed", d.Type())
+	assert.Equal(t, "Computed", d.DisplayName())
+	assert.NotEmpty(t, d.Description())
+	assert.Empty(t, d.SupportedMeasurementTypes())
+
+	keys := make(map[string]ConfigFieldSpec)
+	for _, f := range d.ConfigFields() {
+		keys[f.Key] = f
+	}
+	assert.True(t, keys["expression"].Required)
+	assert.True(t, keys["inputs"].Required)
+	assert.True(t, keys["measurement_type"].Required)
+	assert.False(t, keys["unit"].Required)
+}
+
+func TestComputedDriver_ValidateSensor(t *testing.T) {
+	d := &ComputedDriver{}
+	sensor := gen.Sensor{Name: "indoor-outdoor", Config: map[string]string{
+		"expression":       "indoor - outdoor",
+		"inputs":           `{"indoor": {"sensor": "living-room", "measurement_type": "temperature"}, "outdoor": {"sensor": "garden", "measurement_type": "temperature"}}`,
+		"measurement_type": "temperature_difference",
+	}}
+
+	assert.NoError(t, d.ValidateSensor(context.Background(), sensor))
+
+	sensor.Config["expression"] = "indoor - outside"
+	assert.ErrorContains(t, d.ValidateSensor(context.Background(), sensor), `"outside", which is not defined`)
+
+	sensor.Config["expression"] = "indoor - outdoor"
+	sensor.Config["inputs"] = `{"indoor": {"sensor": "living-room", "measurement_type": "temperature"}, "outdoor": {"sensor": "Indoor-Outdoor", "measurement_type": "temperature_difference"}}`
+	assert.ErrorContains(t, d.ValidateSensor(context.Background(), sensor), "reads sensor indoor-outdoor itself")
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"sync"
+	"time"
 
 	"example/sensorHub/gen"
 )
@@ -77,6 +78,13 @@ type SystemMessageHandler interface {
 	ParseSystemMessage(topic string, payload []byte) []DeviceMetadata
 }
 
+// ExpectedIntervalReporter is optionally implemented by push drivers whose devices
+// report on a predictable cadence. The stale-sensor watchdog uses the interval as
+// the default for sensors that have no per-sensor expected interval configured.
+type ExpectedIntervalReporter interface {
+	ExpectedInterval() time.Duration
+}
+
 var (
 	mu       sync.RWMutex
 	registry = make(map[string]SensorDriver)
@@ -0,0 +1,655 @@
+package drivers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"example/sensorHub/gen"
+)
+
+func init() {
+	Register(newHomeAssistantDriver())
+}
+
+// haSensorClasses maps Home Assistant sensor device classes to measurement
+// types. Entities without a device class, or with one not listed here, are
+// ignored.
+var haSensorClasses = map[string]fieldMapping{
+	"temperature":                      knownFields["temperature"],
+	"humidity":                         knownFields["humidity"],
+	"pressure":                         knownFields["pressure"],
+	"atmospheric_pressure":             knownFields["pressure"],
+	"battery":                          knownFields["battery"],
+	"voltage":                          knownFields["voltage"],
+	"current":                          knownFields["current"],
+	"power":                            knownFields["power"],
+	"energy":                           knownFields["energy"],
+	"illuminance":                      knownFields["illuminance"],
+	"carbon_dioxide":                   knownFields["co2"],
+	"volatile_organic_compounds_parts": knownFields["voc"],
+	"pm25":                             knownFields["pm25"],
+	"moisture":                         knownFields["soil_moisture"],
+	"wind_speed":                       {MeasurementType: "wind_speed", DisplayName: "Wind Speed", Unit: "km/h", Category: "numeric"},
+}
+
+// haBinaryMapping maps a Home Assistant binary_sensor device class to a binary
+// measurement type. Invert is set where Home Assistant's "on" means the
+// opposite of the hub's "true" — an open door is "on" in Home Assistant but a
+// false contact in the hub.
+type haBinaryMapping struct {
+	fieldMapping
+	Invert bool
+}
+
+var haBinarySensorClasses = map[string]haBinaryMapping{
+	"motion":          {fieldMapping: knownFields["occupancy"]},
+	"occupancy":       {fieldMapping: knownFields["occupancy"]},
+	"presence":        {fieldMapping: knownFields["occupancy"]},
+	"moisture":        {fieldMapping: knownFields["water_leak"]},
+	"smoke":           {fieldMapping: knownFields["smoke"]},
+	"carbon_monoxide": {fieldMapping: knownFields["carbon_monoxide"]},
+	"tamper":          {fieldMapping: knownFields["tamper"]},
+	"battery":         {fieldMapping: knownFields["battery_low"]},
+	"vibration":       {fieldMapping: knownFields["vibration"]},
+	"door":            {fieldMapping: knownFields["contact"], Invert: true},
+	"garage_door":     {fieldMapping: knownFields["contact"], Invert: true},
+	"opening":         {fieldMapping: knownFields["contact"], Invert: true},
+	"window":          {fieldMapping: knownFields["contact"], Invert: true},
+}
+
+// haUnitConversions converts values reported in a non-canonical unit into the
+// unit of their measurement type. Units not listed are stored unchanged.
+var haUnitConversions = map[string]map[string]func(float64) float64{
+	"temperature": {
+		"°F": fahrenheitToCelsius,
+		"K":  func(v float64) float64 { return v - 273.15 },
+	},
+	"pressure": {
+		"Pa":   func(v float64) float64 { return v / 100 },
+		"kPa":  func(v float64) float64 { return v * 10 },
+		"inHg": func(v float64) float64 { return v * 33.8639 },
+	},
+	"power":      {"kW": func(v float64) float64 { return v * 1000 }},
+	"energy":     {"Wh": func(v float64) float64 { return v / 1000 }},
+	"wind_speed": {"m/s": metresPerSecondToKmh, "mph": milesPerHourToKmh},
+}
+
+// haAbbreviations expands the abbreviated discovery keys that ESPHome and
+// Tasmota publish to keep payloads small.
+var haAbbreviations = map[string]string{
+	"dev":          "device",
+	"dev_cla":      "device_class",
+	"obj_id":       "object_id",
+	"pl_off":       "payload_off",
+	"pl_on":        "payload_on",
+	"stat_off":     "state_off",
+	"stat_on":      "state_on",
+	"stat_t":       "state_topic",
+	"uniq_id":      "unique_id",
+	"unit_of_meas": "unit_of_measurement",
+	"val_tpl":      "value_template",
+	"ids":          "identifiers",
+	"mf":           "manufacturer",
+	"mdl":          "model",
+	"sw":           "sw_version",
+}
+
+// haEntity is a Home Assistant entity learned from a discovery config.
+type haEntity struct {
+	DeviceName string
+	Device     DeviceMetadata
+	StateTopic string
+	Mapping    fieldMapping
+	SourceUnit string
+	Template   haValueTemplate
+	PayloadOn  string
+	PayloadOff string
+	Invert     bool
+}
+
+// HomeAssistantDriver ingests devices that announce themselves using Home
+// Assistant MQTT discovery, such as ESPHome, Tasmota and Shelly Gen2.
+//
+// Discovery configs are published (retained) to
+// <prefix>/<component>/[<node_id>/]<object_id>/config. Each config names a
+// state_topic, a device_class and a unit; the driver remembers the entity and
+// turns later messages on that state topic into readings. Devices are
+// identified by the device name in their discovery configs.
+type HomeAssistantDriver struct {
+	mu       sync.RWMutex
+	entities map[string]haEntity // keyed by discovery topic
+}
+
+var _ PushDriver = (*HomeAssistantDriver)(nil)
+var _ SystemMessageHandler = (*HomeAssistantDriver)(nil)
+
+func newHomeAssistantDriver() *HomeAssistantDriver {
+	return &HomeAssistantDriver{entities: make(map[string]haEntity)}
+}
+
+func (d *HomeAssistantDriver) Type() string        { return "mqtt-homeassistant" }
+func (d *HomeAssistantDriver) DisplayName() string { return "Home Assistant MQTT discovery" }
+func (d *HomeAssistantDriver) Description() string {
+	return "Devices that publish Home Assistant MQTT discovery configs — ESPHome, Tasmota, Shelly Gen2, and more"
+}
+
+func (d *HomeAssistantDriver) ConfigFields() []ConfigFieldSpec {
+	return nil // Push drivers have no sensor-level config
+}
+
+func (d *HomeAssistantDriver) SupportedMeasurementTypes() []gen.MeasurementType {
+	var mts []gen.MeasurementType
+	seen := make(map[string]bool)
+	add := func(fm fieldMapping) {
+		if seen[fm.MeasurementType] {
+			return
+		}
+		seen[fm.MeasurementType] = true
+		mts = append(mts, gen.MeasurementType{
+			Name:        fm.MeasurementType,
+			DisplayName: fm.DisplayName,
+			Unit:        fm.Unit,
+			Category:    gen.MeasurementTypeCategory(fm.Category),
+		})
+	}
+	for _, fm := range haSensorClasses {
+		add(fm)
+	}
+	for _, bm := range haBinarySensorClasses {
+		add(bm.fieldMapping)
+	}
+	add(knownFields["state"])
+	return mts
+}
+
+func (d *HomeAssistantDriver) ValidateSensor(_ context.Context, _ gen.Sensor) error {
+	return nil // Push-based sensors have nothing to validate locally
+}
+
+// ParseSystemMessage learns (or forgets, on an empty payload) the entity
+// described by a discovery config. Every discovery topic is consumed here,
+// including unsupported components, so config messages never reach
+// auto-discovery as if they were readings.
+func (d *HomeAssistantDriver) ParseSystemMessage(topic string, payload []byte) []DeviceMetadata {
+	component, ok := parseHADiscoveryTopic(topic)
+	if !ok {
+		return nil
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	entity, err := parseHADiscoveryConfig(component, topic, payload)
+	if err != nil {
+		delete(d.entities, topic)
+		return []DeviceMetadata{}
+	}
+	d.entities[topic] = entity
+
+	return []DeviceMetadata{entity.Device}
+}
+
+// IdentifyDevice returns the name of the device that owns the given state
+// topic. Topics that no discovery config has referenced are rejected.
+func (d *HomeAssistantDriver) IdentifyDevice(topic string, _ []byte) (string, error) {
+	entities := d.entitiesForStateTopic(topic)
+	if len(entities) == 0 {
+		return "", fmt.Errorf("no discovered entity for state topic: %s", topic)
+	}
+	return entities[0].DeviceName, nil
+}
+
+// ParseMessage renders each entity bound to the state topic and converts the
+// result into a reading. Entities whose value is missing or unavailable are
+// skipped.
+func (d *HomeAssistantDriver) ParseMessage(topic string, payload []byte) ([]gen.Reading, error) {
+	entities := d.entitiesForStateTopic(topic)
+	if len(entities) == 0 {
+		return nil, fmt.Errorf("no discovered entity for state topic: %s", topic)
+	}
+
+	now := time.Now().UTC().Format("2006-01-02 15:04:05")
+	var readings []gen.Reading
+	seen := make(map[string]bool)
+
+	for _, entity := range entities {
+		if seen[entity.Mapping.MeasurementType] {
+			continue
+		}
+
+		value, err := entity.Template.render(payload)
+		if err != nil {
+			continue
+		}
+
+		reading := gen.Reading{
+			MeasurementType: entity.Mapping.MeasurementType,
+			Unit:            entity.Mapping.Unit,
+			Time:            now,
+		}
+
+		switch entity.Mapping.Category {
+		case "numeric":
+			numVal, ok := jsonNumericValue(value)
+			if !ok {
+				continue
+			}
+			if convert, ok := haUnitConversions[entity.Mapping.MeasurementType][entity.SourceUnit]; ok {
+				numVal = convert(numVal)
+			}
+			reading.NumericValue = &numVal
+		case "binary":
+			textVal, ok := entity.binaryState(value)
+			if !ok {
+				continue
+			}
+			reading.TextState = &textVal
+		}
+
+		seen[entity.Mapping.MeasurementType] = true
+		readings = append(readings, reading)
+	}
+
+	return readings, nil
+}
+
+// entitiesForStateTopic returns the entities bound to a state topic, ordered
+// by discovery topic so results are deterministic.
+func (d *HomeAssistantDriver) entitiesForStateTopic(topic string) []haEntity {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	var entities []haEntity
+	for _, discoveryTopic := range slices.Sorted(maps.Keys(d.entities)) {
+		if entity := d.entities[discoveryTopic]; entity.StateTopic == topic {
+			entities = append(entities, entity)
+		}
+	}
+	return entities
+}
+
+// binaryState compares a rendered value with the entity's on/off payloads.
+func (e haEntity) binaryState(value any) (string, bool) {
+	rendered := haRenderedString(value)
+	var on bool
+	switch {
+	case strings.EqualFold(rendered, e.PayloadOn):
+		on = true
+	case strings.EqualFold(rendered, e.PayloadOff):
+		on = false
+	default:
+		return "", false
+	}
+	if on != e.Invert {
+		return "true", true
+	}
+	return "false", true
+}
+
+// parseHADiscoveryTopic matches <prefix>/<component>/[<node_id>/]<object_id>/config
+// and returns the component.
+func parseHADiscoveryTopic(topic string) (string, bool) {
+	parts := strings.Split(topic, "/")
+	if (len(parts) != 4 && len(parts) != 5) || parts[len(parts)-1] != "config" {
+		return "", false
+	}
+	return parts[1], true
+}
+
+func parseHADiscoveryConfig(component, topic string, payload []byte) (haEntity, error) {
+	if len(payload) == 0 {
+		return haEntity{}, fmt.Errorf("entity removed")
+	}
+
+	var raw map[string]any
+	if err := json.Unmarshal(payload, &raw); err != nil {
+		return haEntity{}, fmt.Errorf("invalid discovery payload: %w", err)
+	}
+	config := expandHAConfig(raw)
+
+	stateTopic := haConfigString(config, "state_topic")
+	if stateTopic == "" {
+		return haEntity{}, fmt.Errorf("discovery config has no state_topic")
+	}
+
+	entity := haEntity{
+		StateTopic: stateTopic,
+		SourceUnit: haConfigString(config, "unit_of_measurement"),
+		PayloadOn:  "ON",
+		PayloadOff: "OFF",
+	}
+
+	deviceClass := haConfigString(config, "device_class")
+	switch component {
+	case "sensor":
+		fm, ok := haSensorClasses[deviceClass]
+		if !ok {
+			return haEntity{}, fmt.Errorf("unsupported sensor device class %q", deviceClass)
+		}
+		entity.Mapping = fm
+	case "binary_sensor":
+		bm, ok := haBinarySensorClasses[deviceClass]
+		if !ok {
+			return haEntity{}, fmt.Errorf("unsupported binary_sensor device class %q", deviceClass)
+		}
+		entity.Mapping = bm.fieldMapping
+		entity.Invert = bm.Invert
+	case "switch":
+		entity.Mapping = knownFields["state"]
+	default:
+		return haEntity{}, fmt.Errorf("unsupported component %q", component)
+	}
+
+	for _, key := range []string{"payload_on", "state_on"} {
+		if v := haConfigString(config, key); v != "" {
+			entity.PayloadOn = v
+		}
+	}
+	for _, key := range []string{"payload_off", "state_off"} {
+		if v := haConfigString(config, key); v != "" {
+			entity.PayloadOff = v
+		}
+	}
+
+	template, err := parseHAValueTemplate(haConfigString(config, "value_template"))
+	if err != nil {
+		return haEntity{}, err
+	}
+	entity.Template = template
+
+	entity.Device = haDeviceMetadata(config, topic)
+	entity.DeviceName = entity.Device.FriendlyName
+	return entity, nil
+}
+
+// haDeviceMetadata describes the device an entity belongs to. Entities
+// without a device block are treated as standalone devices named after their
+// unique_id, falling back to the object id in the discovery topic.
+func haDeviceMetadata(config map[string]any, topic string) DeviceMetadata {
+	device, _ := config["device"].(map[string]any)
+
+	name := haConfigString(device, "name")
+	if name == "" {
+		switch ids := device["identifiers"].(type) {
+		case string:
+			name = ids
+		case []any:
+			if len(ids) > 0 {
+				name = haRenderedString(ids[0])
+			}
+		}
+	}
+	if name == "" {
+		name = haConfigString(config, "unique_id")
+	}
+	if name == "" {
+		parts := strings.Split(topic, "/")
+		name = parts[len(parts)-2]
+	}
+
+	return DeviceMetadata{
+		FriendlyName: name,
+		Metadata: map[string]string{
+			"manufacturer": haConfigString(device, "manufacturer"),
+			"model":        haConfigString(device, "model"),
+			"sw_version":   haConfigString(device, "sw_version"),
+		},
+	}
+}
+
+// expandHAConfig expands abbreviated keys and the "~" base topic shorthand.
+func expandHAConfig(raw map[string]any) map[string]any {
+	config := make(map[string]any, len(raw))
+	for key, value := range raw {
+		if full, ok := haAbbreviations[key]; ok {
+			key = full
+		}
+		if nested, ok := value.(map[string]any); ok && key == "device" {
+			value = expandHAConfig(nested)
+		}
+		config[key] = value
+	}
+
+	if base, ok := config["~"].(string); ok {
+		for key, value := range config {
+			topic, ok := value.(string)
+			if !ok || !strings.HasSuffix(key, "_topic") {
+				continue
+			}
+			if strings.HasPrefix(topic, "~") {
+				config[key] = base + topic[1:]
+			} else if strings.HasSuffix(topic, "~") {
+				config[key] = topic[:len(topic)-1] + base
+			}
+		}
+	}
+
+	return config
+}
+
+func haConfigString(config map[string]any, key string) string {
+	value, ok := config[key]
+	if !ok || value == nil {
+		return ""
+	}
+	return haRenderedString(value)
+}
+
+// haRenderedString formats a value the way a rendered template would look.
+func haRenderedString(value any) string {
+	switch v := value.(type) {
+	case string:
+		return strings.TrimSpace(v)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
+
+// haValueTemplate is the subset of Home Assistant's Jinja value templates
+// that devices use in practice: "{{ value }}" or "{{ value_json.path }}",
+// optionally followed by the float, int and round filters, each operand or
+// filter followed by arithmetic with constants ("/ 10"). A filter applies to
+// everything before it. An empty template passes the payload through.
+type haValueTemplate struct {
+	path  string // empty for the raw payload
+	json  bool
+	steps []haTemplateStep
+}
+
+type haTemplateStep struct {
+	op  string // "+", "-", "*", "/", "float", "int" or "round"
+	arg float64
+}
+
+// haTemplateTokens splits a stage of the expression into its operand or filter,
+// operators and numbers, so "value_json.x/10" reads the same as "value_json.x / 10".
+// Operator characters inside a bracketed path such as value_json['temp-c'] or filter
+// arguments stay part of the operand, and a "-" right after an operator starts a
+// negative number.
+func haTemplateTokens(expr string) []string {
+	var tokens []string
+	var current strings.Builder
+	flush := func() {
+		if current.Len() > 0 {
+			tokens = append(tokens, current.String())
+			current.Reset()
+		}
+	}
+	depth := 0
+	for _, r := range expr {
+		switch {
+		case r == '[' || r == '(':
+			depth++
+			current.WriteRune(r)
+		case r == ']' || r == ')':
+			depth--
+			current.WriteRune(r)
+		case depth > 0:
+			current.WriteRune(r)
+		case r == ' ' || r == '\t':
+			flush()
+		case strings.ContainsRune("+-*/", r):
+			if r == '-' && current.Len() == 0 && len(tokens) > 0 && strings.Contains("+-*/", tokens[len(tokens)-1]) {
+				current.WriteRune(r)
+				continue
+			}
+			flush()
+			tokens = append(tokens, string(r))
+		default:
+			current.WriteRune(r)
+		}
+	}
+	flush()
+	return tokens
+}
+
+func parseHAValueTemplate(raw string) (haValueTemplate, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return haValueTemplate{}, nil
+	}
+	if !strings.HasPrefix(raw, "{{") || !strings.HasSuffix(raw, "}}") {
+		return haValueTemplate{}, fmt.Errorf("unsupported value_template %q", raw)
+	}
+
+	stages := strings.Split(strings.TrimSpace(raw[2:len(raw)-2]), "|")
+
+	var t haValueTemplate
+	tokens := haTemplateTokens(stages[0])
+	if len(tokens) == 0 {
+		return haValueTemplate{}, fmt.Errorf("empty value_template")
+	}
+	switch operand := tokens[0]; {
+	case operand == "value":
+	case operand == "value_json":
+		t.json = true
+	case strings.HasPrefix(operand, "value_json.") || strings.HasPrefix(operand, "value_json["):
+		t.json = true
+		t.path = strings.TrimPrefix(operand, "value_json")
+	default:
+		return haValueTemplate{}, fmt.Errorf("unsupported value_template operand %q", operand)
+	}
+
+	if err := t.appendArithmetic(raw, tokens[1:]); err != nil {
+		return haValueTemplate{}, err
+	}
+
+	for _, stage := range stages[1:] {
+		tokens := haTemplateTokens(stage)
+		if len(tokens) == 0 {
+			return haValueTemplate{}, fmt.Errorf("empty filter in value_template")
+		}
+		name, args, _ := strings.Cut(tokens[0], "(")
+		args = strings.TrimSuffix(strings.TrimSpace(args), ")")
+		switch name {
+		case "float", "int":
+			t.steps = append(t.steps, haTemplateStep{op: name})
+		case "round":
+			digits := 0.0
+			if args != "" {
+				n, err := strconv.Atoi(args)
+				if err != nil {
+					return haValueTemplate{}, fmt.Errorf("invalid round precision %q in value_template", args)
+				}
+				digits = float64(n)
+			}
+			t.steps = append(t.steps, haTemplateStep{op: "round", arg: digits})
+		default:
+			return haValueTemplate{}, fmt.Errorf("unsupported filter %q in value_template", name)
+		}
+		if err := t.appendArithmetic(raw, tokens[1:]); err != nil {
+			return haValueTemplate{}, err
+		}
+	}
+
+	return t, nil
+}
+
+// appendArithmetic adds the steps for the operator and number tokens that follow an
+// operand or filter, honouring * and / before + and -. Only the first term holds the
+// value, so its multiplications and divisions apply to the value in order and each
+// later term is a constant, folded into one addition or subtraction.
+func (t *haValueTemplate) appendArithmetic(raw string, tokens []string) error {
+	if len(tokens)%2 != 0 {
+		return fmt.Errorf("unsupported value_template %q", raw)
+	}
+	term := -1 // index of the step holding the current constant term
+	for i := 0; i < len(tokens); i += 2 {
+		op := tokens[i]
+		if !strings.Contains("+-*/", op) || len(op) != 1 {
+			return fmt.Errorf("unsupported operator %q in value_template", op)
+		}
+		arg, err := strconv.ParseFloat(tokens[i+1], 64)
+		if err != nil {
+			return fmt.Errorf("unsupported operand %q in value_template", tokens[i+1])
+		}
+		switch {
+		case op == "+" || op == "-":
+			t.steps = append(t.steps, haTemplateStep{op: op, arg: arg})
+			term = len(t.steps) - 1
+		case term < 0:
+			t.steps = append(t.steps, haTemplateStep{op: op, arg: arg})
+		case op == "*":
+			t.steps[term].arg *= arg
+		default:
+			if arg == 0 {
+				return fmt.Errorf("division by zero in value_template")
+			}
+			t.steps[term].arg /= arg
+		}
+	}
+	return nil
+}
+
+// render evaluates the template against an MQTT payload.
+func (t haValueTemplate) render(payload []byte) (any, error) {
+	var value any = string(payload)
+	if t.json {
+		var document any
+		if err := json.Unmarshal(payload, &document); err != nil {
+			return nil, fmt.Errorf("invalid JSON payload: %w", err)
+		}
+		var ok bool
+		if value, ok = lookupJSONPath(document, t.path); !ok {
+			return nil, fmt.Errorf("path %q not found in payload", t.path)
+		}
+	}
+
+	for _, step := range t.steps {
+		n, ok := jsonNumericValue(value)
+		if !ok {
+			return nil, fmt.Errorf("value %v is not numeric", value)
+		}
+		switch step.op {
+		case "+":
+			n += step.arg
+		case "-":
+			n -= step.arg
+		case "*":
+			n *= step.arg
+		case "/":
+			if step.arg == 0 {
+				return nil, fmt.Errorf("division by zero in value_template")
+			}
+			n /= step.arg
+		case "int":
+			n = math.Trunc(n)
+		case "round":
+			pow := math.Pow(10, step.arg)
+			n = math.Round(n*pow) / pow
+		}
+		value = n
+	}
+
+	return value, nil
+}
@@ -0,0 +1,197 @@
+package drivers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const esphomeTemperatureConfig = `{"dev_cla":"temperature","unit_of_meas":"°F","stat_t":"~/sensor/temperature/state",` +
+	`"~":"living-room","uniq_id":"esp-temp","dev":{"ids":"aabbccddeeff","name":"living-room","mf":"Espressif","mdl":"esp32dev","sw":"2024.6.0"}}`
+
+const tasmotaHumidityConfig = `{"device_class":"humidity","unit_of_measurement":"%","state_topic":"tele/garage/SENSOR",` +
+	`"value_template":"{{ value_json['AM2301'].Humidity | float | round(1) }}","device":{"identifiers":["garage_ABC123"],"manufacturer":"Tasmota"}}`
+
+const tasmotaTemperatureConfig = `{"device_class":"temperature","unit_of_measurement":"°C","state_topic":"tele/garage/SENSOR",` +
+	`"value_template":"{{ value_json.AM2301.Temperature }}","device":{"identifiers":["garage_ABC123"]}}`
+
+func newDiscoveredHomeAssistantDriver(t *testing.T, configs map[string]string) *HomeAssistantDriver {
+	t.Helper()
+	d := newHomeAssistantDriver()
+	for topic, payload := range configs {
+		require.NotNil(t, d.ParseSystemMessage(topic, []byte(payload)), "expected %s to be consumed", topic)
+	}
+	return d
+}
+
+func TestHomeAssistantDriver_Metadata(t *testing.T) {
+	d := newHomeAssistantDriver()
+
+	assert.Equal(t, "mqtt-homeassistant", d.Type())
+	assert.Equal(t, "Home Assistant MQTT discovery", d.DisplayName())
+	assert.NotEmpty(t, d.Description())
+	assert.Nil(t, d.ConfigFields())
+
+	names := make(map[string]bool)
+	for _, mt := range d.SupportedMeasurementTypes() {
+		assert.False(t, names[mt.Name], "duplicate measurement type %s", mt.Name)
+		names[mt.Name] = true
+	}
+	for _, expected := range []string{"temperature", "humidity", "co2", "occupancy", "contact", "state"} {
+		assert.True(t, names[expected], "expected %s to be supported", expected)
+	}
+}
+
+func TestHomeAssistantDriver_ParseSystemMessage(t *testing.T) {
+	d := newHomeAssistantDriver()
+
+	metadata := d.ParseSystemMessage("homeassistant/sensor/living-room/temperature/config", []byte(esphomeTemperatureConfig))
+	require.Len(t, metadata, 1)
+	assert.Equal(t, "living-room", metadata[0].FriendlyName)
+	assert.Equal(t, "Espressif", metadata[0].Metadata["manufacturer"])
+	assert.Equal(t, "esp32dev", metadata[0].Metadata["model"])
+	assert.Equal(t, "2024.6.0", metadata[0].Metadata["sw_version"])
+
+	metadata = d.ParseSystemMessage("homeassistant/sensor/garage_humidity/config", []byte(tasmotaHumidityConfig))
+	require.Len(t, metadata, 1)
+	assert.Equal(t, "garage_ABC123", metadata[0].FriendlyName)
+
+	// Unsupported entities are still consumed so they never reach auto-discovery.
+	metadata = d.ParseSystemMessage("homeassistant/light/kitchen/config", []byte(`{"state_topic":"kitchen/light"}`))
+	assert.NotNil(t, metadata)
+	assert.Empty(t, metadata)
+
+	assert.Nil(t, d.ParseSystemMessage("living-room/sensor/temperature/state", []byte("21.5")))
+	assert.Nil(t, d.ParseSystemMessage("tele/garage/SENSOR", []byte(`{}`)))
+}
+
+func TestHomeAssistantDriver_IdentifyDevice(t *testing.T) {
+	d := newDiscoveredHomeAssistantDriver(t, map[string]string{
+		"homeassistant/sensor/living-room/temperature/config": esphomeTemperatureConfig,
+	})
+
+	name, err := d.IdentifyDevice("living-room/sensor/temperature/state", []byte("70.7"))
+	require.NoError(t, err)
+	assert.Equal(t, "living-room", name)
+
+	_, err = d.IdentifyDevice("living-room/sensor/humidity/state", []byte("40"))
+	assert.Error(t, err)
+
+	_, err = d.IdentifyDevice("homeassistant/sensor/living-room/temperature/config", []byte(esphomeTemperatureConfig))
+	assert.Error(t, err)
+}
+
+func TestHomeAssistantDriver_ParseMessage_RawStateWithUnitConversion(t *testing.T) {
+	d := newDiscoveredHomeAssistantDriver(t, map[string]string{
+		"homeassistant/sensor/living-room/temperature/config": esphomeTemperatureConfig,
+	})
+
+	readings, err := d.ParseMessage("living-room/sensor/temperature/state", []byte("68.0"))
+	require.NoError(t, err)
+	require.Len(t, readings, 1)
+	assert.Equal(t, "temperature", readings[0].MeasurementType)
+	assert.Equal(t, "°C", readings[0].Unit)
+	assert.InDelta(t, 20.0, *readings[0].NumericValue, 0.001)
+
+	readings, err = d.ParseMessage("living-room/sensor/temperature/state", []byte("unavailable"))
+	require.NoError(t, err)
+	assert.Empty(t, readings)
+}
+
+func TestHomeAssistantDriver_ParseMessage_SharedJSONStateTopic(t *testing.T) {
+	d := newDiscoveredHomeAssistantDriver(t, map[string]string{
+		"homeassistant/sensor/garage_humidity/config":    tasmotaHumidityConfig,
+		"homeassistant/sensor/garage_temperature/config": tasmotaTemperatureConfig,
+	})
+
+	readings, err := d.ParseMessage("tele/garage/SENSOR", []byte(`{"Time":"2025-01-01T12:00:00","AM2301":{"Temperature":18.4,"Humidity":61.27}}`))
+	require.NoError(t, err)
+
+	byType := readingsByType(readings)
+	require.Len(t, byType, 2)
+	assert.InDelta(t, 61.3, *byType["humidity"].NumericValue, 0.001)
+	assert.InDelta(t, 18.4, *byType["temperature"].NumericValue, 0.001)
+}
+
+func TestHomeAssistantDriver_ParseMessage_BinarySensors(t *testing.T) {
+	d := newDiscoveredHomeAssistantDriver(t, map[string]string{
+		"homeassistant/binary_sensor/hall/door/config": `{"device_class":"door","state_topic":"hall/door/state","device":{"name":"hall"}}`,
+		"homeassistant/binary_sensor/hall/motion/config": `{"device_class":"motion","state_topic":"hall/motion","pl_on":"1","pl_off":"0",` +
+			`"val_tpl":"{{ value_json.motion }}","device":{"name":"hall"}}`,
+	})
+
+	readings, err := d.ParseMessage("hall/door/state", []byte("ON"))
+	require.NoError(t, err)
+	require.Len(t, readings, 1)
+	assert.Equal(t, "contact", readings[0].MeasurementType)
+	assert.Equal(t, "false", *readings[0].TextState, "an open door is not in contact")
+
+	readings, err = d.ParseMessage("hall/motion", []byte(`{"motion":1}`))
+	require.NoError(t, err)
+	require.Len(t, readings, 1)
+	assert.Equal(t, "occupancy", readings[0].MeasurementType)
+	assert.Equal(t, "true", *readings[0].TextState)
+}
+
+func TestHomeAssistantDriver_EmptyConfigRemovesEntity(t *testing.T) {
+	d := newDiscoveredHomeAssistantDriver(t, map[string]string{
+		"homeassistant/sensor/living-room/temperature/config": esphomeTemperatureConfig,
+	})
+
+	assert.NotNil(t, d.ParseSystemMessage("homeassistant/sensor/living-room/temperature/config", nil))
+
+	_, err := d.ParseMessage("living-room/sensor/temperature/state", []byte("68.0"))
+	assert.Error(t, err)
+}
+
+func TestParseHAValueTemplate(t *testing.T) {
+	tests := []struct {
+		name     string
+		template string
+		payload  string
+		want     any
+	}{
+		{"empty passes payload through", "", "21.5", "21.5"},
+		{"raw value", "{{ value }}", "on", "on"},
+		{"json path", "{{ value_json.temperature }}", `{"temperature":21.5}`, 21.5},
+		{"bracket path", "{{ value_json['ENERGY']['Power'] }}", `{"ENERGY":{"Power":42}}`, 42.0},
+		{"arithmetic", "{{ value_json.t / 10 }}", `{"t":215}`, 21.5},
+		{"arithmetic without spaces", "{{value_json.t/10}}", `{"t":215}`, 21.5},
+		{"negative constant", "{{ value_json.t * -1 }}", `{"t":3}`, -3.0},
+		{"operator characters in a bracket path", "{{ value_json['temp-c']-1 }}", `{"temp-c":22}`, 21.0},
+		{"filters", "{{ value | float | round(1) }}", "21.46", 21.5},
+		{"int filter", "{{ value_json.v | int }}", `{"v":"7.9"}`, 7.0},
+		{"arithmetic after a filter", "{{ value_json.x | float / 10 }}", `{"x":"215"}`, 21.5},
+		{"arithmetic after int", "{{ value | int * 2 }}", "7.9", 14.0},
+		{"arithmetic between filters", "{{ value | float * 1.8 + 32 | round(1) }}", "21.46", 70.6},
+		{"multiplication before addition", "{{ value + 1 * 2 }}", "5", 7.0},
+		{"division before subtraction", "{{ value_json.t * 2 - 10 / 4 }}", `{"t":3}`, 3.5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			template, err := parseHAValueTemplate(tt.template)
+			require.NoError(t, err)
+			got, err := template.render([]byte(tt.payload))
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestParseHAValueTemplate_Unsupported(t *testing.T) {
+	for _, template := range []string{
+		"{% if value == 'ON' %}1{% endif %}",
+		"{{ states('sensor.x') }}",
+		"{{ value_json.x | timestamp_local }}",
+		"{{ value_json.x ** 2 }}",
+		"{{ value_json.x/y }}",
+		"{{ value_json.x 10 }}",
+		"{{ value | float 10 }}",
+		"{{ value + 1 / 0 }}",
+	} {
+		_, err := parseHAValueTemplate(template)
+		assert.Error(t, err, template)
+	}
+}
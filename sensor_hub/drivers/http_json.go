@@ -0,0 +1,266 @@
+package drivers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"example/sensorHub/gen"
+	"example/sensorHub/utils"
+
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+)
+
+func init() {
+	Register(&HTTPJSONDriver{
+		client: &http.Client{
+			Transport: otelhttp.NewTransport(http.DefaultTransport),
+			Timeout:   10 * time.Second,
+		},
+	})
+}
+
+// jsonFieldMapping maps a value located by a path in the response body to a
+// measurement type. Numeric values are converted with value*scale + offset.
+type jsonFieldMapping struct {
+	Path            string   `json:"path"`
+	MeasurementType string   `json:"measurement_type"`
+	Unit            string   `json:"unit"`
+	Scale           *float64 `json:"scale,omitempty"`
+	Offset          float64  `json:"offset,omitempty"`
+}
+
+// HTTPJSONDriver polls an arbitrary HTTP endpoint that returns JSON and maps
+// fields of the response to readings using configurable paths.
+//
+// Paths use a dotted syntax with optional JSONPath-style decoration, so
+// "StatusSNS.DS18B20.Temperature", "$.sensors[0].value" and "sensors.0.value"
+// are all accepted.
+type HTTPJSONDriver struct {
+	client *http.Client
+}
+
+var _ PullDriver = (*HTTPJSONDriver)(nil)
+
+func (d *HTTPJSONDriver) Type() string        { return "http-json" }
+func (d *HTTPJSONDriver) DisplayName() string { return "HTTP JSON" }
+func (d *HTTPJSONDriver) Description() string {
+	return "Generic HTTP endpoint returning JSON — map response fields to measurement types (ESPHome, Shelly, Tasmota and similar)"
+}
+
+func (d *HTTPJSONDriver) ConfigFields() []ConfigFieldSpec {
+	return []ConfigFieldSpec{
+		{Key: "url", Label: "URL", Description: "Full URL to request (e.g. http://192.168.1.60/status)", Required: true},
+		{Key: "method", Label: "HTTP Method", Description: "HTTP method to use (GET or POST)", Default: http.MethodGet},
+		{Key: "headers", Label: "Headers", Description: `JSON object of request headers (e.g. {"Authorization": "Bearer abc"})`, Sensitive: true},
+		{Key: "mappings", Label: "Field Mappings", Description: `JSON array of mappings, e.g. [{"path": "$.tmp.tC", "measurement_type": "temperature", "unit": "°C", "scale": 1}]`, Required: true},
+		{Key: "time_path", Label: "Time Path", Description: "Optional path to a timestamp in the response; the collection time is used when empty"},
+	}
+}
+
+// SupportedMeasurementTypes is empty because the produced types are entirely
+// determined by each sensor's mappings.
+func (d *HTTPJSONDriver) SupportedMeasurementTypes() []gen.MeasurementType {
+	return []gen.MeasurementType{}
+}
+
+func (d *HTTPJSONDriver) ValidateSensor(ctx context.Context, sensor gen.Sensor) error {
+	if _, err := parseJSONFieldMappings(sensor.Config["mappings"]); err != nil {
+		return err
+	}
+	_, err := d.CollectReadings(ctx, sensor)
+	return err
+}
+
+func (d *HTTPJSONDriver) CollectReadings(ctx context.Context, sensor gen.Sensor) ([]gen.Reading, error) {
+	sensorURL := sensor.Config["url"]
+	if sensorURL == "" {
+		return nil, fmt.Errorf("sensor %s has no 'url' in config", sensor.Name)
+	}
+
+	mappings, err := parseJSONFieldMappings(sensor.Config["mappings"])
+	if err != nil {
+		return nil, fmt.Errorf("sensor %s: %w", sensor.Name, err)
+	}
+
+	body, err := d.fetch(ctx, sensor.Config)
+	if err != nil {
+		return nil, err
+	}
+
+	var document any
+	if err := json.Unmarshal(body, &document); err != nil {
+		return nil, fmt.Errorf("error decoding JSON response from sensor at %s: %w", sensorURL, err)
+	}
+
+	readingTime := time.Now().UTC().Format("2006-01-02 15:04:05")
+	if timePath := sensor.Config["time_path"]; timePath != "" {
+		if value, ok := lookupJSONPath(document, timePath); ok {
+			if s, isString := value.(string); isString && s != "" {
+				readingTime = utils.NormalizeTimeToSpaceFormat(s)
+			}
+		}
+	}
+
+	readings := make([]gen.Reading, 0, len(mappings))
+	for _, mapping := range mappings {
+		value, ok := lookupJSONPath(document, mapping.Path)
+		if !ok || value == nil {
+			continue
+		}
+
+		reading := gen.Reading{
+			SensorName:      sensor.Name,
+			MeasurementType: mapping.MeasurementType,
+			Unit:            mapping.Unit,
+			Time:            readingTime,
+		}
+
+		if numVal, isNumeric := jsonNumericValue(value); isNumeric {
+			scaled := numVal*mapping.scale() + mapping.Offset
+			reading.NumericValue = &scaled
+		} else {
+			textVal := toBoolString(value)
+			reading.TextState = &textVal
+		}
+		readings = append(readings, reading)
+	}
+
+	if len(readings) == 0 {
+		return nil, fmt.Errorf("no mapped fields found in response from sensor at %s", sensorURL)
+	}
+	return readings, nil
+}
+
+func (d *HTTPJSONDriver) fetch(ctx context.Context, config map[string]string) ([]byte, error) {
+	sensorURL := config["url"]
+	method := strings.ToUpper(strings.TrimSpace(config["method"]))
+	if method == "" {
+		method = http.MethodGet
+	}
+	if method != http.MethodGet && method != http.MethodPost {
+		return nil, fmt.Errorf("unsupported HTTP method %q", method)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, sensorURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request to sensor at %s: %w", sensorURL, err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	if rawHeaders := strings.TrimSpace(config["headers"]); rawHeaders != "" {
+		var headers map[string]string
+		if err := json.Unmarshal([]byte(rawHeaders), &headers); err != nil {
+			return nil, fmt.Errorf("invalid 'headers' config, expected a JSON object of strings: %w", err)
+		}
+		for key, value := range headers {
+			req.Header.Set(key, value)
+		}
+	}
+
+	response, err := d.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error making %s request to sensor at %s: %w", method, sensorURL, err)
+	}
+	defer func() { _ = response.Body.Close() }()
+
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("received non-200 response from sensor at %s: %d", sensorURL, response.StatusCode)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(response.Body, 1<<20))
+	if err != nil {
+		return nil, fmt.Errorf("error reading response from sensor at %s: %w", sensorURL, err)
+	}
+	return body, nil
+}
+
+func (m jsonFieldMapping) scale() float64 {
+	if m.Scale == nil {
+		return 1
+	}
+	return *m.Scale
+}
+
+func parseJSONFieldMappings(raw string) ([]jsonFieldMapping, error) {
+	if strings.TrimSpace(raw) == "" {
+		return nil, fmt.Errorf("'mappings' config is required")
+	}
+
+	var mappings []jsonFieldMapping
+	if err := json.Unmarshal([]byte(raw), &mappings); err != nil {
+		return nil, fmt.Errorf("invalid 'mappings' config, expected a JSON array: %w", err)
+	}
+	if len(mappings) == 0 {
+		return nil, fmt.Errorf("'mappings' config must contain at least one mapping")
+	}
+
+	for i, mapping := range mappings {
+		if mapping.Path == "" {
+			return nil, fmt.Errorf("mapping %d has no 'path'", i)
+		}
+		if mapping.MeasurementType == "" {
+			return nil, fmt.Errorf("mapping %d has no 'measurement_type'", i)
+		}
+	}
+	return mappings, nil
+}
+
+// splitJSONPath turns "$.a.b[0].c" or "a.b.0.c" into ["a", "b", "0", "c"].
+func splitJSONPath(path string) []string {
+	path = strings.TrimSpace(path)
+	path = strings.TrimPrefix(path, "$")
+	path = strings.ReplaceAll(path, "[", ".")
+	path = strings.ReplaceAll(path, "]", "")
+
+	var segments []string
+	for _, segment := range strings.Split(path, ".") {
+		segment = strings.Trim(segment, `'"`)
+		if segment != "" {
+			segments = append(segments, segment)
+		}
+	}
+	return segments
+}
+
+// lookupJSONPath walks a decoded JSON document following the given path.
+func lookupJSONPath(document any, path string) (any, bool) {
+	current := document
+	for _, segment := range splitJSONPath(path) {
+		switch node := current.(type) {
+		case map[string]any:
+			value, ok := node[segment]
+			if !ok {
+				return nil, false
+			}
+			current = value
+		case []any:
+			index, err := strconv.Atoi(segment)
+			if err != nil || index < 0 || index >= len(node) {
+				return nil, false
+			}
+			current = node[index]
+		default:
+			return nil, false
+		}
+	}
+	return current, true
+}
+
+// jsonNumericValue returns the numeric form of a decoded JSON value. Numeric
+// strings such as "21.5" are accepted because many devices quote numbers.
+func jsonNumericValue(value any) (float64, bool) {
+	if f, ok := toFloat64(value); ok {
+		return f, true
+	}
+	if s, ok := value.(string); ok {
+		f, err := strconv.ParseFloat(strings.TrimSpace(s), 64)
+		return f, err == nil
+	}
+	return 0, false
+}
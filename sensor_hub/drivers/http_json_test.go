@@ -0,0 +1,184 @@
+package drivers
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	gen "example/sensorHub/gen"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const shellyStatusJSON = `{
+	"tmp": {"tC": 21.5, "is_valid": true},
+	"meters": [{"power": 12.25, "total": 3600}],
+	"relays": [{"ison": true}],
+	"hum": {"value": "48.5"},
+	"time": "2025-01-01T12:00:00Z"
+}`
+
+func TestHTTPJSONDriver_Metadata(t *testing.T) {
+	d := &HTTPJSONDriver{client: http.DefaultClient}
+
+	assert.Equal(t, "http-json", d.Type())
+	assert.Equal(t, "HTTP JSON", d.DisplayName())
+	assert.NotEmpty(t, d.Description())
+	assert.Empty(t, d.SupportedMeasurementTypes())
+
+	keys := make(map[string]ConfigFieldSpec)
+	for _, f := range d.ConfigFields() {
+		keys[f.Key] = f
+	}
+	assert.True(t, keys["url"].Required)
+	assert.True(t, keys["mappings"].Required)
+	assert.Equal(t, "GET", keys["method"].Default)
+	assert.True(t, keys["headers"].Sensitive)
+}
+
+func TestHTTPJSONDriver_CollectReadings_MapsFields(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/status", r.URL.Path)
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+		w.Write([]byte(shellyStatusJSON))
+	}))
+	defer server.Close()
+
+	d := &HTTPJSONDriver{client: server.Client()}
+	sensor := gen.Sensor{Name: "shelly", Config: map[string]string{
+		"url":     server.URL + "/status",
+		"headers": `{"Authorization": "Bearer secret"}`,
+		"mappings": `[
+			{"path": "$.tmp.tC", "measurement_type": "temperature", "unit": "°C"},
+			{"path": "meters[0].power", "measurement_type": "power", "unit": "W"},
+			{"path": "meters.0.total", "measurement_type": "energy", "unit": "kWh", "scale": 0.000016667},
+			{"path": "hum.value", "measurement_type": "humidity", "unit": "%"},
+			{"path": "relays[0].ison", "measurement_type": "state"},
+			{"path": "missing.field", "measurement_type": "pressure"}
+		]`,
+		"time_path": "time",
+	}}
+
+	readings, err := d.CollectReadings(context.Background(), sensor)
+	require.NoError(t, err)
+	require.Len(t, readings, 5)
+
+	byType := make(map[string]gen.Reading)
+	for _, r := range readings {
+		assert.Equal(t, "shelly", r.SensorName)
+		assert.Equal(t, "2025-01-01 12:00:00", r.Time)
+		byType[r.MeasurementType] = r
+	}
+
+	assert.InDelta(t, 21.5, *byType["temperature"].NumericValue, 0.001)
+	assert.Equal(t, "°C", byType["temperature"].Unit)
+	assert.InDelta(t, 12.25, *byType["power"].NumericValue, 0.001)
+	assert.InDelta(t, 0.06, *byType["energy"].NumericValue, 0.001)
+	assert.InDelta(t, 48.5, *byType["humidity"].NumericValue, 0.001)
+	require.NotNil(t, byType["state"].TextState)
+	assert.Equal(t, "true", *byType["state"].TextState)
+}
+
+func TestHTTPJSONDriver_CollectReadings_ScaleAndOffset(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		w.Write([]byte(`{"raw": 215}`))
+	}))
+	defer server.Close()
+
+	d := &HTTPJSONDriver{client: server.Client()}
+	sensor := gen.Sensor{Name: "scaled", Config: map[string]string{
+		"url":      server.URL,
+		"method":   "post",
+		"mappings": `[{"path": "raw", "measurement_type": "temperature", "unit": "°C", "scale": 0.1, "offset": -1}]`,
+	}}
+
+	readings, err := d.CollectReadings(context.Background(), sensor)
+	require.NoError(t, err)
+	require.Len(t, readings, 1)
+	assert.InDelta(t, 20.5, *readings[0].NumericValue, 0.001)
+}
+
+func TestHTTPJSONDriver_CollectReadings_NoMappedFields(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"other": 1}`))
+	}))
+	defer server.Close()
+
+	d := &HTTPJSONDriver{client: server.Client()}
+	sensor := gen.Sensor{Name: "empty", Config: map[string]string{
+		"url":      server.URL,
+		"mappings": `[{"path": "temperature", "measurement_type": "temperature"}]`,
+	}}
+
+	_, err := d.CollectReadings(context.Background(), sensor)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "no mapped fields")
+}
+
+func TestHTTPJSONDriver_CollectReadings_Non200(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	d := &HTTPJSONDriver{client: server.Client()}
+	sensor := gen.Sensor{Name: "down", Config: map[string]string{
+		"url":      server.URL,
+		"mappings": `[{"path": "t", "measurement_type": "temperature"}]`,
+	}}
+
+	_, err := d.CollectReadings(context.Background(), sensor)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "non-200")
+}
+
+func TestHTTPJSONDriver_InvalidConfig(t *testing.T) {
+	d := &HTTPJSONDriver{client: http.DefaultClient}
+
+	tests := []struct {
+		name   string
+		config map[string]string
+		errMsg string
+	}{
+		{"missing url", map[string]string{"mappings": `[{"path":"a","measurement_type":"temperature"}]`}, "no 'url'"},
+		{"missing mappings", map[string]string{"url": "http://localhost"}, "'mappings' config is required"},
+		{"mappings not json", map[string]string{"url": "http://localhost", "mappings": "temperature=a"}, "expected a JSON array"},
+		{"mapping without type", map[string]string{"url": "http://localhost", "mappings": `[{"path":"a"}]`}, "no 'measurement_type'"},
+		{"bad method", map[string]string{"url": "http://localhost", "method": "DELETE", "mappings": `[{"path":"a","measurement_type":"temperature"}]`}, "unsupported HTTP method"},
+		{"bad headers", map[string]string{"url": "http://localhost", "headers": "X-Key: 1", "mappings": `[{"path":"a","measurement_type":"temperature"}]`}, "invalid 'headers'"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := d.CollectReadings(context.Background(), gen.Sensor{Name: "bad", Config: tt.config})
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errMsg)
+		})
+	}
+}
+
+func TestLookupJSONPath(t *testing.T) {
+	document := map[string]any{
+		"a": map[string]any{
+			"b": []any{map[string]any{"c": 1.0}, "second"},
+		},
+	}
+
+	value, ok := lookupJSONPath(document, "$.a.b[0].c")
+	assert.True(t, ok)
+	assert.Equal(t, 1.0, value)
+
+	value, ok = lookupJSONPath(document, "a.b.1")
+	assert.True(t, ok)
+	assert.Equal(t, "second", value)
+
+	_, ok = lookupJSONPath(document, "a.b[5]")
+	assert.False(t, ok)
+
+	_, ok = lookupJSONPath(document, "a.x")
+	assert.False(t, ok)
+}
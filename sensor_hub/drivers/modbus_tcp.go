@@ -0,0 +1,309 @@
+package drivers
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"example/sensorHub/gen"
+)
+
+func init() {
+	Register(&ModbusTCPDriver{dialer: &net.Dialer{Timeout: 5 * time.Second}})
+}
+
+const (
+	modbusDefaultPort       = "502"
+	modbusRequestTimeout    = 5 * time.Second
+	modbusFuncReadHolding   = 0x03
+	modbusFuncReadInput     = 0x04
+	modbusExceptionBit      = 0x80
+	modbusMaxResponseLength = 260
+)
+
+// modbusRegisterMapping describes one value read from a Modbus device.
+type modbusRegisterMapping struct {
+	Address         uint16   `json:"address"`
+	RegisterType    string   `json:"register_type"` // "holding" (default) or "input"
+	DataType        string   `json:"data_type"`     // int16, uint16, int32, uint32, float32
+	WordOrder       string   `json:"word_order"`    // "big" (default, high word first) or "little"
+	Scale           *float64 `json:"scale,omitempty"`
+	Offset          float64  `json:"offset,omitempty"`
+	MeasurementType string   `json:"measurement_type"`
+	Unit            string   `json:"unit"`
+}
+
+// ModbusTCPDriver polls holding or input registers from a Modbus TCP device
+// such as an energy meter or heat pump controller.
+type ModbusTCPDriver struct {
+	dialer *net.Dialer
+}
+
+var _ PullDriver = (*ModbusTCPDriver)(nil)
+
+func (d *ModbusTCPDriver) Type() string        { return "modbus-tcp" }
+func (d *ModbusTCPDriver) DisplayName() string { return "Modbus TCP" }
+func (d *ModbusTCPDriver) Description() string {
+	return "Modbus TCP devices such as energy meters and HVAC controllers — read holding or input registers"
+}
+
+func (d *ModbusTCPDriver) ConfigFields() []ConfigFieldSpec {
+	return []ConfigFieldSpec{
+		{Key: "address", Label: "Device Address", Description: "Host and port of the Modbus TCP device (e.g. 192.168.1.70:502)", Required: true},
+		{Key: "unit_id", Label: "Unit ID", Description: "Modbus unit (slave) identifier", Default: "1"},
+		{Key: "registers", Label: "Registers", Description: `JSON array of registers, e.g. [{"address": 0, "register_type": "input", "data_type": "float32", "word_order": "big", "scale": 1, "measurement_type": "voltage", "unit": "V"}]`, Required: true},
+	}
+}
+
+// SupportedMeasurementTypes is empty because the produced types are entirely
+// determined by each sensor's register mappings.
+func (d *ModbusTCPDriver) SupportedMeasurementTypes() []gen.MeasurementType {
+	return []gen.MeasurementType{}
+}
+
+func (d *ModbusTCPDriver) ValidateSensor(ctx context.Context, sensor gen.Sensor) error {
+	_, err := d.CollectReadings(ctx, sensor)
+	return err
+}
+
+func (d *ModbusTCPDriver) CollectReadings(ctx context.Context, sensor gen.Sensor) ([]gen.Reading, error) {
+	address := sensor.Config["address"]
+	if address == "" {
+		return nil, fmt.Errorf("sensor %s has no 'address' in config", sensor.Name)
+	}
+	if _, _, err := net.SplitHostPort(address); err != nil {
+		address = net.JoinHostPort(address, modbusDefaultPort)
+	}
+
+	unitID, err := parseModbusUnitID(sensor.Config["unit_id"])
+	if err != nil {
+		return nil, fmt.Errorf("sensor %s: %w", sensor.Name, err)
+	}
+
+	mappings, err := parseModbusRegisterMappings(sensor.Config["registers"])
+	if err != nil {
+		return nil, fmt.Errorf("sensor %s: %w", sensor.Name, err)
+	}
+
+	conn, err := d.dialer.DialContext(ctx, "tcp", address)
+	if err != nil {
+		return nil, fmt.Errorf("error connecting to modbus device at %s: %w", address, err)
+	}
+	defer func() { _ = conn.Close() }()
+
+	client := &modbusClient{conn: conn, unitID: unitID}
+	now := time.Now().UTC().Format("2006-01-02 15:04:05")
+
+	readings := make([]gen.Reading, 0, len(mappings))
+	for _, mapping := range mappings {
+		deadline := time.Now().Add(modbusRequestTimeout)
+		if ctxDeadline, ok := ctx.Deadline(); ok && ctxDeadline.Before(deadline) {
+			deadline = ctxDeadline
+		}
+		if err := conn.SetDeadline(deadline); err != nil {
+			return nil, fmt.Errorf("error setting deadline on modbus connection: %w", err)
+		}
+
+		registers, err := client.readRegisters(mapping.functionCode(), mapping.Address, mapping.registerCount())
+		if err != nil {
+			return nil, fmt.Errorf("error reading register %d from modbus device at %s: %w", mapping.Address, address, err)
+		}
+
+		value, err := decodeModbusValue(registers, mapping.DataType, mapping.WordOrder)
+		if err != nil {
+			return nil, fmt.Errorf("error decoding register %d: %w", mapping.Address, err)
+		}
+		value = value*mapping.scale() + mapping.Offset
+
+		readings = append(readings, gen.Reading{
+			SensorName:      sensor.Name,
+			MeasurementType: mapping.MeasurementType,
+			NumericValue:    &value,
+			Unit:            mapping.Unit,
+			Time:            now,
+		})
+	}
+
+	return readings, nil
+}
+
+func parseModbusUnitID(raw string) (byte, error) {
+	if strings.TrimSpace(raw) == "" {
+		return 1, nil
+	}
+	unitID, err := strconv.ParseUint(strings.TrimSpace(raw), 10, 8)
+	if err != nil {
+		return 0, fmt.Errorf("invalid 'unit_id' %q: must be between 0 and 255", raw)
+	}
+	return byte(unitID), nil
+}
+
+func parseModbusRegisterMappings(raw string) ([]modbusRegisterMapping, error) {
+	if strings.TrimSpace(raw) == "" {
+		return nil, fmt.Errorf("'registers' config is required")
+	}
+
+	var mappings []modbusRegisterMapping
+	if err := json.Unmarshal([]byte(raw), &mappings); err != nil {
+		return nil, fmt.Errorf("invalid 'registers' config, expected a JSON array: %w", err)
+	}
+	if len(mappings) == 0 {
+		return nil, fmt.Errorf("'registers' config must contain at least one register")
+	}
+
+	for i := range mappings {
+		m := &mappings[i]
+		if m.MeasurementType == "" {
+			return nil, fmt.Errorf("register %d has no 'measurement_type'", i)
+		}
+		if m.RegisterType == "" {
+			m.RegisterType = "holding"
+		}
+		if m.RegisterType != "holding" && m.RegisterType != "input" {
+			return nil, fmt.Errorf("register %d has invalid 'register_type' %q (expected holding or input)", i, m.RegisterType)
+		}
+		if m.DataType == "" {
+			m.DataType = "uint16"
+		}
+		if m.registerCount() == 0 {
+			return nil, fmt.Errorf("register %d has unsupported 'data_type' %q", i, m.DataType)
+		}
+		if m.WordOrder == "" {
+			m.WordOrder = "big"
+		}
+		if m.WordOrder != "big" && m.WordOrder != "little" {
+			return nil, fmt.Errorf("register %d has invalid 'word_order' %q (expected big or little)", i, m.WordOrder)
+		}
+		if m.Scale != nil && *m.Scale == 0 {
+			return nil, fmt.Errorf("register %d has 'scale' 0, which would zero every value; omit it to leave values unscaled", i)
+		}
+	}
+	return mappings, nil
+}
+
+func (m modbusRegisterMapping) scale() float64 {
+	if m.Scale == nil {
+		return 1
+	}
+	return *m.Scale
+}
+
+func (m modbusRegisterMapping) functionCode() byte {
+	if m.RegisterType == "input" {
+		return modbusFuncReadInput
+	}
+	return modbusFuncReadHolding
+}
+
+// registerCount returns the number of 16-bit registers occupied by the
+// mapping's data type, or 0 when the data type is unknown.
+func (m modbusRegisterMapping) registerCount() uint16 {
+	switch m.DataType {
+	case "int16", "uint16":
+		return 1
+	case "int32", "uint32", "float32":
+		return 2
+	default:
+		return 0
+	}
+}
+
+// decodeModbusValue converts raw registers into a number. Bytes within a
+// register are always big-endian; wordOrder controls the register order of
+// 32-bit values ("little" means the low word comes first).
+func decodeModbusValue(registers []uint16, dataType, wordOrder string) (float64, error) {
+	switch dataType {
+	case "int16":
+		return float64(int16(registers[0])), nil
+	case "uint16":
+		return float64(registers[0]), nil
+	}
+
+	if len(registers) < 2 {
+		return 0, fmt.Errorf("data type %s needs 2 registers, got %d", dataType, len(registers))
+	}
+	high, low := registers[0], registers[1]
+	if wordOrder == "little" {
+		high, low = low, high
+	}
+	raw := uint32(high)<<16 | uint32(low)
+
+	switch dataType {
+	case "int32":
+		return float64(int32(raw)), nil
+	case "uint32":
+		return float64(raw), nil
+	case "float32":
+		return float64(math.Float32frombits(raw)), nil
+	default:
+		return 0, fmt.Errorf("unsupported data type %q", dataType)
+	}
+}
+
+// modbusClient is a minimal Modbus TCP client supporting the register read
+// functions. Requests are issued sequentially over a single connection.
+type modbusClient struct {
+	conn          net.Conn
+	unitID        byte
+	transactionID uint16
+}
+
+func (c *modbusClient) readRegisters(function byte, address, quantity uint16) ([]uint16, error) {
+	c.transactionID++
+
+	request := make([]byte, 12)
+	binary.BigEndian.PutUint16(request[0:2], c.transactionID)
+	binary.BigEndian.PutUint16(request[2:4], 0) // protocol identifier
+	binary.BigEndian.PutUint16(request[4:6], 6) // unit id + PDU length
+	request[6] = c.unitID
+	request[7] = function
+	binary.BigEndian.PutUint16(request[8:10], address)
+	binary.BigEndian.PutUint16(request[10:12], quantity)
+
+	if _, err := c.conn.Write(request); err != nil {
+		return nil, fmt.Errorf("write request: %w", err)
+	}
+
+	header := make([]byte, 7)
+	if _, err := io.ReadFull(c.conn, header); err != nil {
+		return nil, fmt.Errorf("read response header: %w", err)
+	}
+	if got := binary.BigEndian.Uint16(header[0:2]); got != c.transactionID {
+		return nil, fmt.Errorf("unexpected transaction id %d (expected %d)", got, c.transactionID)
+	}
+	length := binary.BigEndian.Uint16(header[4:6])
+	if length < 2 || length > modbusMaxResponseLength {
+		return nil, fmt.Errorf("invalid response length %d", length)
+	}
+
+	pdu := make([]byte, length-1)
+	if _, err := io.ReadFull(c.conn, pdu); err != nil {
+		return nil, fmt.Errorf("read response body: %w", err)
+	}
+
+	if pdu[0] == function|modbusExceptionBit {
+		if len(pdu) < 2 {
+			return nil, fmt.Errorf("malformed exception response")
+		}
+		return nil, fmt.Errorf("device returned modbus exception code %d", pdu[1])
+	}
+	if pdu[0] != function {
+		return nil, fmt.Errorf("unexpected function code %d in response", pdu[0])
+	}
+	if len(pdu) < 2 || int(pdu[1]) != int(quantity)*2 || len(pdu) < 2+int(pdu[1]) {
+		return nil, fmt.Errorf("unexpected byte count in response")
+	}
+
+	registers := make([]uint16, quantity)
+	for i := range registers {
+		registers[i] = binary.BigEndian.Uint16(pdu[2+i*2 : 4+i*2])
+	}
+	return registers, nil
+}
@@ -0,0 +1,250 @@
+package drivers
+
+import (
+	"context"
+	"encoding/binary"
+	"io"
+	"math"
+	"net"
+	"testing"
+	"time"
+
+	gen "example/sensorHub/gen"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeModbusServer is an in-process Modbus TCP stand-in that serves
+// register reads from fixed holding and input register tables.
+type fakeModbusServer struct {
+	listener net.Listener
+	unitID   byte
+	holding  map[uint16]uint16
+	input    map[uint16]uint16
+}
+
+func newFakeModbusServer(t *testing.T, unitID byte) *fakeModbusServer {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	s := &fakeModbusServer{
+		listener: listener,
+		unitID:   unitID,
+		holding:  make(map[uint16]uint16),
+		input:    make(map[uint16]uint16),
+	}
+	go s.serve()
+	t.Cleanup(func() { _ = listener.Close() })
+	return s
+}
+
+func (s *fakeModbusServer) addr() string { return s.listener.Addr().String() }
+
+func (s *fakeModbusServer) serve() {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		go s.handle(conn)
+	}
+}
+
+func (s *fakeModbusServer) handle(conn net.Conn) {
+	defer conn.Close()
+	for {
+		request := make([]byte, 12)
+		if _, err := io.ReadFull(conn, request); err != nil {
+			return
+		}
+		function := request[7]
+		address := binary.BigEndian.Uint16(request[8:10])
+		quantity := binary.BigEndian.Uint16(request[10:12])
+
+		var pdu []byte
+		table := s.holding
+		if function == modbusFuncReadInput {
+			table = s.input
+		}
+
+		switch {
+		case request[6] != s.unitID:
+			pdu = []byte{function | modbusExceptionBit, 0x0B}
+		case function != modbusFuncReadHolding && function != modbusFuncReadInput:
+			pdu = []byte{function | modbusExceptionBit, 0x01}
+		default:
+			pdu = []byte{function, byte(quantity * 2)}
+			for i := uint16(0); i < quantity; i++ {
+				value, ok := table[address+i]
+				if !ok {
+					pdu = []byte{function | modbusExceptionBit, 0x02}
+					break
+				}
+				pdu = binary.BigEndian.AppendUint16(pdu, value)
+			}
+		}
+
+		response := make([]byte, 7, 7+len(pdu))
+		copy(response[0:2], request[0:2])
+		binary.BigEndian.PutUint16(response[4:6], uint16(len(pdu)+1))
+		response[6] = request[6]
+		response = append(response, pdu...)
+		if _, err := conn.Write(response); err != nil {
+			return
+		}
+	}
+}
+
+func newTestModbusDriver() *ModbusTCPDriver {
+	return &ModbusTCPDriver{dialer: &net.Dialer{Timeout: time.Second}}
+}
+
+func TestModbusTCPDriver_Metadata(t *testing.T) {
+	d := newTestModbusDriver()
+
+	assert.Equal(t, "modbus-tcp", d.Type())
+	assert.Equal(t, "Modbus TCP", d.DisplayName())
+	assert.NotEmpty(t, d.Description())
+	assert.Empty(t, d.SupportedMeasurementTypes())
+
+	keys := make(map[string]ConfigFieldSpec)
+	for _, f := range d.ConfigFields() {
+		keys[f.Key] = f
+	}
+	assert.True(t, keys["address"].Required)
+	assert.True(t, keys["registers"].Required)
+	assert.Equal(t, "1", keys["unit_id"].Default)
+}
+
+func TestModbusTCPDriver_CollectReadings_DecodesRegisters(t *testing.T) {
+	server := newFakeModbusServer(t, 3)
+
+	voltage := math.Float32bits(230.5)
+	server.input[0] = uint16(voltage >> 16)
+	server.input[1] = uint16(voltage)
+
+	energy := uint32(1234567)
+	server.holding[10] = uint16(energy) // little word order: low word first
+	server.holding[11] = uint16(energy >> 16)
+
+	server.holding[20] = uint16(0xFF38) // int16 -200
+
+	d := newTestModbusDriver()
+	sensor := gen.Sensor{Name: "meter", Config: map[string]string{
+		"address": server.addr(),
+		"unit_id": "3",
+		"registers": `[
+			{"address": 0, "register_type": "input", "data_type": "float32", "measurement_type": "voltage", "unit": "V"},
+			{"address": 10, "data_type": "uint32", "word_order": "little", "scale": 0.01, "measurement_type": "energy", "unit": "kWh"},
+			{"address": 20, "data_type": "int16", "scale": 0.1, "offset": 1, "measurement_type": "temperature", "unit": "°C"}
+		]`,
+	}}
+
+	readings, err := d.CollectReadings(context.Background(), sensor)
+	require.NoError(t, err)
+	require.Len(t, readings, 3)
+
+	assert.Equal(t, "meter", readings[0].SensorName)
+	assert.Equal(t, "voltage", readings[0].MeasurementType)
+	assert.InDelta(t, 230.5, *readings[0].NumericValue, 0.001)
+	assert.Equal(t, "V", readings[0].Unit)
+
+	assert.Equal(t, "energy", readings[1].MeasurementType)
+	assert.InDelta(t, 12345.67, *readings[1].NumericValue, 0.001)
+
+	assert.Equal(t, "temperature", readings[2].MeasurementType)
+	assert.InDelta(t, -19.0, *readings[2].NumericValue, 0.001)
+}
+
+func TestModbusTCPDriver_CollectReadings_Exception(t *testing.T) {
+	server := newFakeModbusServer(t, 1)
+
+	d := newTestModbusDriver()
+	sensor := gen.Sensor{Name: "meter", Config: map[string]string{
+		"address":   server.addr(),
+		"registers": `[{"address": 99, "measurement_type": "power"}]`,
+	}}
+
+	_, err := d.CollectReadings(context.Background(), sensor)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "exception code 2")
+}
+
+func TestModbusTCPDriver_CollectReadings_WrongUnitID(t *testing.T) {
+	server := newFakeModbusServer(t, 1)
+	server.holding[0] = 1
+
+	d := newTestModbusDriver()
+	sensor := gen.Sensor{Name: "meter", Config: map[string]string{
+		"address":   server.addr(),
+		"unit_id":   "7",
+		"registers": `[{"address": 0, "measurement_type": "power"}]`,
+	}}
+
+	_, err := d.CollectReadings(context.Background(), sensor)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "exception code 11")
+}
+
+func TestModbusTCPDriver_CollectReadings_ConnectionRefused(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	addr := listener.Addr().String()
+	listener.Close()
+
+	d := newTestModbusDriver()
+	sensor := gen.Sensor{Name: "meter", Config: map[string]string{
+		"address":   addr,
+		"registers": `[{"address": 0, "measurement_type": "power"}]`,
+	}}
+
+	_, err = d.CollectReadings(context.Background(), sensor)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "error connecting")
+}
+
+func TestModbusTCPDriver_InvalidConfig(t *testing.T) {
+	d := newTestModbusDriver()
+
+	tests := []struct {
+		name   string
+		config map[string]string
+		errMsg string
+	}{
+		{"missing address", map[string]string{"registers": `[{"address":0,"measurement_type":"power"}]`}, "no 'address'"},
+		{"bad unit id", map[string]string{"address": "localhost:502", "unit_id": "300", "registers": `[{"address":0,"measurement_type":"power"}]`}, "invalid 'unit_id'"},
+		{"missing registers", map[string]string{"address": "localhost:502"}, "'registers' config is required"},
+		{"bad data type", map[string]string{"address": "localhost:502", "registers": `[{"address":0,"data_type":"float64","measurement_type":"power"}]`}, "unsupported 'data_type'"},
+		{"bad register type", map[string]string{"address": "localhost:502", "registers": `[{"address":0,"register_type":"coil","measurement_type":"power"}]`}, "invalid 'register_type'"},
+		{"bad word order", map[string]string{"address": "localhost:502", "registers": `[{"address":0,"word_order":"middle","measurement_type":"power"}]`}, "invalid 'word_order'"},
+		{"missing measurement type", map[string]string{"address": "localhost:502", "registers": `[{"address":0}]`}, "no 'measurement_type'"},
+		{"zero scale", map[string]string{"address": "localhost:502", "registers": `[{"address":0,"scale":0,"measurement_type":"power"}]`}, "has 'scale' 0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := d.CollectReadings(context.Background(), gen.Sensor{Name: "bad", Config: tt.config})
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errMsg)
+		})
+	}
+}
+
+func TestDecodeModbusValue(t *testing.T) {
+	v, err := decodeModbusValue([]uint16{0xFFFF, 0xFFFE}, "int32", "big")
+	require.NoError(t, err)
+	assert.Equal(t, -2.0, v)
+
+	v, err = decodeModbusValue([]uint16{0x0002, 0x0001}, "uint32", "little")
+	require.NoError(t, err)
+	assert.Equal(t, float64(0x00010002), v)
+
+	v, err = decodeModbusValue([]uint16{0x8000}, "uint16", "big")
+	require.NoError(t, err)
+	assert.Equal(t, 32768.0, v)
+
+	_, err = decodeModbusValue([]uint16{1}, "float32", "big")
+	assert.Error(t, err)
+}
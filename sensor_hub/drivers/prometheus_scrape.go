@@ -0,0 +1,457 @@
+package drivers
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"example/sensorHub/gen"
+
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+	"github.com/prometheus/common/model"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+)
+
+func init() {
+	Register(&PrometheusScrapeDriver{
+		client: &http.Client{
+			Transport: otelhttp.NewTransport(http.DefaultTransport),
+			Timeout:   10 * time.Second,
+		},
+	})
+}
+
+const prometheusAcceptHeader = "application/openmetrics-text;version=1.0.0,text/plain;version=0.0.4;q=0.5,*/*;q=0.1"
+
+// prometheusSeriesMapping selects one series from a scrape and maps it to a
+// measurement type. Selector uses PromQL syntax without functions, e.g.
+// node_hwmon_temp_celsius{chip="thermal_zone0",sensor=~"temp.*"}.
+type prometheusSeriesMapping struct {
+	Selector        string   `json:"selector"`
+	MeasurementType string   `json:"measurement_type"`
+	Unit            string   `json:"unit"`
+	Scale           *float64 `json:"scale,omitempty"`
+}
+
+type prometheusLabelMatcher struct {
+	name     string
+	operator string // =, !=, =~, !~
+	value    string
+	regex    *regexp.Regexp
+}
+
+type prometheusSelector struct {
+	metric   string
+	matchers []prometheusLabelMatcher
+}
+
+type prometheusSample struct {
+	metric string
+	labels map[string]string
+	value  float64
+}
+
+// PrometheusScrapeDriver scrapes a Prometheus or OpenMetrics text endpoint
+// and converts selected series into readings.
+type PrometheusScrapeDriver struct {
+	client *http.Client
+}
+
+var _ PullDriver = (*PrometheusScrapeDriver)(nil)
+
+func (d *PrometheusScrapeDriver) Type() string        { return "prometheus-scrape" }
+func (d *PrometheusScrapeDriver) DisplayName() string { return "Prometheus / OpenMetrics" }
+func (d *PrometheusScrapeDriver) Description() string {
+	return "Scrape a Prometheus or OpenMetrics endpoint (node_exporter, Shelly and similar) and map selected series to measurement types"
+}
+
+func (d *PrometheusScrapeDriver) ConfigFields() []ConfigFieldSpec {
+	return []ConfigFieldSpec{
+		{Key: "url", Label: "Metrics URL", Description: "Full URL of the metrics endpoint (e.g. http://192.168.1.20:9100/metrics)", Required: true},
+		{Key: "series", Label: "Series", Description: `JSON array of series selectors, e.g. [{"selector": "node_hwmon_temp_celsius{sensor=\"temp1\"}", "measurement_type": "temperature", "unit": "°C"}]`, Required: true},
+	}
+}
+
+// SupportedMeasurementTypes is empty because the produced types are entirely
+// determined by each sensor's series mappings.
+func (d *PrometheusScrapeDriver) SupportedMeasurementTypes() []gen.MeasurementType {
+	return []gen.MeasurementType{}
+}
+
+func (d *PrometheusScrapeDriver) ValidateSensor(ctx context.Context, sensor gen.Sensor) error {
+	_, err := d.CollectReadings(ctx, sensor)
+	return err
+}
+
+func (d *PrometheusScrapeDriver) CollectReadings(ctx context.Context, sensor gen.Sensor) ([]gen.Reading, error) {
+	metricsURL := sensor.Config["url"]
+	if metricsURL == "" {
+		return nil, fmt.Errorf("sensor %s has no 'url' in config", sensor.Name)
+	}
+
+	mappings, selectors, err := parsePrometheusSeriesMappings(sensor.Config["series"])
+	if err != nil {
+		return nil, fmt.Errorf("sensor %s: %w", sensor.Name, err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, metricsURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request to metrics endpoint at %s: %w", metricsURL, err)
+	}
+	req.Header.Set("Accept", prometheusAcceptHeader)
+
+	response, err := d.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error making GET request to metrics endpoint at %s: %w", metricsURL, err)
+	}
+	defer func() { _ = response.Body.Close() }()
+
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("received non-200 response from metrics endpoint at %s: %d", metricsURL, response.StatusCode)
+	}
+
+	samples, skipped, err := parsePrometheusText(io.LimitReader(response.Body, 16<<20))
+	if err != nil {
+		return nil, fmt.Errorf("error parsing metrics from %s: %w", metricsURL, err)
+	}
+
+	now := time.Now().UTC().Format("2006-01-02 15:04:05")
+	readings := make([]gen.Reading, 0, len(mappings))
+	for i, mapping := range mappings {
+		sample, ok := selectors[i].firstMatch(samples)
+		if !ok || math.IsNaN(sample.value) || math.IsInf(sample.value, 0) {
+			continue
+		}
+
+		value := sample.value
+		if mapping.Scale != nil {
+			value *= *mapping.Scale
+		}
+		readings = append(readings, gen.Reading{
+			SensorName:      sensor.Name,
+			MeasurementType: mapping.MeasurementType,
+			NumericValue:    &value,
+			Unit:            mapping.Unit,
+			Time:            now,
+		})
+	}
+
+	if len(readings) == 0 {
+		// Malformed lines are skipped silently unless they may hold the missing series.
+		if len(skipped) > 0 {
+			return nil, fmt.Errorf("no configured series found in metrics from %s, skipped %d malformed lines: %w", metricsURL, len(skipped), skipped[0])
+		}
+		return nil, fmt.Errorf("no configured series found in metrics from %s", metricsURL)
+	}
+	return readings, nil
+}
+
+func parsePrometheusSeriesMappings(raw string) ([]prometheusSeriesMapping, []prometheusSelector, error) {
+	if strings.TrimSpace(raw) == "" {
+		return nil, nil, fmt.Errorf("'series' config is required")
+	}
+
+	var mappings []prometheusSeriesMapping
+	if err := json.Unmarshal([]byte(raw), &mappings); err != nil {
+		return nil, nil, fmt.Errorf("invalid 'series' config, expected a JSON array: %w", err)
+	}
+	if len(mappings) == 0 {
+		return nil, nil, fmt.Errorf("'series' config must contain at least one series")
+	}
+
+	selectors := make([]prometheusSelector, len(mappings))
+	for i, mapping := range mappings {
+		if mapping.MeasurementType == "" {
+			return nil, nil, fmt.Errorf("series %d has no 'measurement_type'", i)
+		}
+		selector, err := parsePrometheusSelector(mapping.Selector)
+		if err != nil {
+			return nil, nil, fmt.Errorf("series %d: %w", i, err)
+		}
+		selectors[i] = selector
+	}
+	return mappings, selectors, nil
+}
+
+// parsePrometheusSelector parses metric_name{label="value",other=~"re.*"}.
+func parsePrometheusSelector(raw string) (prometheusSelector, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return prometheusSelector{}, fmt.Errorf("selector is required")
+	}
+
+	name, rest, hasLabels := strings.Cut(raw, "{")
+	selector := prometheusSelector{metric: strings.TrimSpace(name)}
+	if selector.metric == "" {
+		return prometheusSelector{}, fmt.Errorf("selector %q has no metric name", raw)
+	}
+	if !hasLabels {
+		return selector, nil
+	}
+
+	body, ok := strings.CutSuffix(strings.TrimSpace(rest), "}")
+	if !ok {
+		return prometheusSelector{}, fmt.Errorf("selector %q is missing a closing brace", raw)
+	}
+
+	pairs, err := parsePrometheusLabelPairs(body)
+	if err != nil {
+		return prometheusSelector{}, fmt.Errorf("selector %q: %w", raw, err)
+	}
+	for _, pair := range pairs {
+		matcher := prometheusLabelMatcher{name: pair.name, operator: pair.operator, value: pair.value}
+		if pair.operator == "=~" || pair.operator == "!~" {
+			re, err := regexp.Compile("^(?:" + pair.value + ")$")
+			if err != nil {
+				return prometheusSelector{}, fmt.Errorf("selector %q has an invalid regex for label %s: %w", raw, pair.name, err)
+			}
+			matcher.regex = re
+		}
+		selector.matchers = append(selector.matchers, matcher)
+	}
+	return selector, nil
+}
+
+func (s prometheusSelector) matches(sample prometheusSample) bool {
+	if sample.metric != s.metric {
+		return false
+	}
+	for _, m := range s.matchers {
+		value := sample.labels[m.name]
+		switch m.operator {
+		case "=":
+			if value != m.value {
+				return false
+			}
+		case "!=":
+			if value == m.value {
+				return false
+			}
+		case "=~":
+			if !m.regex.MatchString(value) {
+				return false
+			}
+		case "!~":
+			if m.regex.MatchString(value) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// firstMatch returns the first sample in exposition order that satisfies the selector.
+func (s prometheusSelector) firstMatch(samples []prometheusSample) (prometheusSample, bool) {
+	for _, sample := range samples {
+		if s.matches(sample) {
+			return sample, true
+		}
+	}
+	return prometheusSample{}, false
+}
+
+// parsePrometheusText parses the Prometheus text exposition format and its
+// OpenMetrics superset with expfmt.TextParser. Comments, metadata, timestamps and
+// exemplars are dropped first, so every series keeps its exposed name (foo_sum and
+// foo_bucket are not folded into a summary or histogram) and OpenMetrics-only syntax
+// does not trip the parser. Malformed lines are skipped and returned as errors rather
+// than failing the whole scrape.
+func parsePrometheusText(r io.Reader) ([]prometheusSample, []error, error) {
+	var lines []string
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1<<20)
+	var lineNumbers []int
+	lineNo := 0
+	for scanner.Scan() {
+		lineNo++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		lines = append(lines, stripPrometheusSampleSuffix(line))
+		lineNumbers = append(lineNumbers, lineNo)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	var skipped []error
+	for {
+		parser := expfmt.NewTextParser(model.UTF8Validation)
+		families, err := parser.TextToMetricFamilies(strings.NewReader(strings.Join(lines, "\n") + "\n"))
+		if err == nil {
+			return prometheusSamples(families), skipped, nil
+		}
+		var parseErr expfmt.ParseError
+		if !errors.As(err, &parseErr) || parseErr.Line < 1 || parseErr.Line > len(lines) {
+			return nil, skipped, err
+		}
+		i := parseErr.Line - 1
+		skipped = append(skipped, fmt.Errorf("line %d: %s", lineNumbers[i], parseErr.Msg))
+		lines = append(lines[:i], lines[i+1:]...)
+		lineNumbers = append(lineNumbers[:i], lineNumbers[i+1:]...)
+	}
+}
+
+// stripPrometheusSampleSuffix drops everything after the value of a sample line: the
+// timestamp, which OpenMetrics writes in fractional seconds, and any exemplar.
+func stripPrometheusSampleSuffix(line string) string {
+	nameEnd := strings.IndexAny(line, "{ \t")
+	if nameEnd < 0 {
+		return line
+	}
+	rest := line[nameEnd:]
+	if strings.HasPrefix(rest, "{") {
+		closing := findLabelSetEnd(rest)
+		if closing < 0 {
+			return line
+		}
+		rest = rest[closing+1:]
+	}
+	fields := strings.Fields(rest)
+	if len(fields) == 0 {
+		return line
+	}
+	return line[:len(line)-len(rest)] + " " + fields[0]
+}
+
+// prometheusSamples flattens parsed families into samples, keeping each family's
+// series in exposition order.
+func prometheusSamples(families map[string]*dto.MetricFamily) []prometheusSample {
+	names := make([]string, 0, len(families))
+	for name := range families {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var samples []prometheusSample
+	for _, name := range names {
+		for _, metric := range families[name].GetMetric() {
+			sample := prometheusSample{metric: name, labels: map[string]string{}}
+			for _, pair := range metric.GetLabel() {
+				sample.labels[pair.GetName()] = pair.GetValue()
+			}
+			switch {
+			case metric.Untyped != nil:
+				sample.value = metric.GetUntyped().GetValue()
+			case metric.Gauge != nil:
+				sample.value = metric.GetGauge().GetValue()
+			case metric.Counter != nil:
+				sample.value = metric.GetCounter().GetValue()
+			default:
+				continue
+			}
+			samples = append(samples, sample)
+		}
+	}
+	return samples
+}
+
+// findLabelSetEnd returns the index of the brace closing the label set that
+// starts at s[0], honouring quoted label values.
+func findLabelSetEnd(s string) int {
+	inQuotes := false
+	for i := 1; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			if inQuotes {
+				i++
+			}
+		case '"':
+			inQuotes = !inQuotes
+		case '}':
+			if !inQuotes {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+type prometheusLabelPair struct {
+	name     string
+	operator string
+	value    string
+}
+
+// parsePrometheusLabelPairs parses the label matchers inside a selector's braces.
+func parsePrometheusLabelPairs(s string) ([]prometheusLabelPair, error) {
+	var pairs []prometheusLabelPair
+	i := 0
+	for {
+		for i < len(s) && (s[i] == ' ' || s[i] == ',' || s[i] == '\t') {
+			i++
+		}
+		if i >= len(s) {
+			return pairs, nil
+		}
+
+		nameStart := i
+		for i < len(s) && s[i] != '=' && s[i] != '!' && s[i] != '~' && s[i] != ' ' {
+			i++
+		}
+		name := s[nameStart:i]
+		for i < len(s) && s[i] == ' ' {
+			i++
+		}
+
+		operator := ""
+		for _, op := range []string{"=~", "!~", "!=", "="} {
+			if strings.HasPrefix(s[i:], op) {
+				operator = op
+				break
+			}
+		}
+		if name == "" || operator == "" {
+			return nil, fmt.Errorf("malformed label set %q", s)
+		}
+		i += len(operator)
+		for i < len(s) && s[i] == ' ' {
+			i++
+		}
+
+		if i >= len(s) || s[i] != '"' {
+			return nil, fmt.Errorf("label %s value must be quoted", name)
+		}
+		i++
+		var value strings.Builder
+		closed := false
+		for i < len(s) {
+			c := s[i]
+			if c == '\\' && i+1 < len(s) {
+				i++
+				switch s[i] {
+				case 'n':
+					value.WriteByte('\n')
+				case '\\', '"':
+					value.WriteByte(s[i])
+				default:
+					// keep regex escapes such as \d intact
+					value.WriteByte('\\')
+					value.WriteByte(s[i])
+				}
+			} else if c == '"' {
+				closed = true
+				i++
+				break
+			} else {
+				value.WriteByte(c)
+			}
+			i++
+		}
+		if !closed {
+			return nil, fmt.Errorf("unterminated value for label %s", name)
+		}
+		pairs = append(pairs, prometheusLabelPair{name: name, operator: operator, value: value.String()})
+	}
+}
@@ -0,0 +1,202 @@
+package drivers
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	gen "example/sensorHub/gen"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const nodeExporterMetrics = `# HELP node_hwmon_temp_celsius Hardware monitor for temperature (input)
+# TYPE node_hwmon_temp_celsius gauge
+node_hwmon_temp_celsius{chip="thermal_thermal_zone0",sensor="temp0"} 48.15
+node_hwmon_temp_celsius{chip="thermal_thermal_zone0",sensor="temp1"} 51.2
+# HELP node_load1 1m load average.
+# TYPE node_load1 gauge
+node_load1 0.42
+node_filesystem_avail_bytes{device="/dev/root",fstype="ext4",mountpoint="/"} 1.073741824e+10 1700000000000
+shelly_power{label="a \"quoted\" name"} 12.5
+node_weird NaN
+`
+
+func TestPrometheusScrapeDriver_Metadata(t *testing.T) {
+	d := &PrometheusScrapeDriver{client: http.DefaultClient}
+
+	assert.Equal(t, "prometheus-scrape", d.Type())
+	assert.Equal(t, "Prometheus / OpenMetrics", d.DisplayName())
+	assert.NotEmpty(t, d.Description())
+	assert.Empty(t, d.SupportedMeasurementTypes())
+
+	cf := d.ConfigFields()
+	require.Len(t, cf, 2)
+	assert.Equal(t, "url", cf[0].Key)
+	assert.Equal(t, "series", cf[1].Key)
+	assert.True(t, cf[1].Required)
+}
+
+func TestPrometheusScrapeDriver_CollectReadings_SelectsSeries(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Contains(t, r.Header.Get("Accept"), "application/openmetrics-text")
+		w.Write([]byte(nodeExporterMetrics))
+	}))
+	defer server.Close()
+
+	d := &PrometheusScrapeDriver{client: server.Client()}
+	sensor := gen.Sensor{Name: "pi", Config: map[string]string{
+		"url": server.URL + "/metrics",
+		"series": `[
+			{"selector": "node_hwmon_temp_celsius{sensor=\"temp1\"}", "measurement_type": "temperature", "unit": "°C"},
+			{"selector": "node_load1", "measurement_type": "load"},
+			{"selector": "node_filesystem_avail_bytes{mountpoint=~\"/|/boot\", fstype!=\"tmpfs\"}", "measurement_type": "disk_free", "unit": "GB", "scale": 1e-9},
+			{"selector": "shelly_power{label=\"a \\\"quoted\\\" name\"}", "measurement_type": "power", "unit": "W"},
+			{"selector": "node_weird", "measurement_type": "voltage"},
+			{"selector": "missing_metric", "measurement_type": "humidity"}
+		]`,
+	}}
+
+	readings, err := d.CollectReadings(context.Background(), sensor)
+	require.NoError(t, err)
+	require.Len(t, readings, 4)
+
+	assert.Equal(t, "pi", readings[0].SensorName)
+	assert.Equal(t, "temperature", readings[0].MeasurementType)
+	assert.InDelta(t, 51.2, *readings[0].NumericValue, 0.001)
+	assert.Equal(t, "°C", readings[0].Unit)
+
+	assert.Equal(t, "load", readings[1].MeasurementType)
+	assert.InDelta(t, 0.42, *readings[1].NumericValue, 0.001)
+
+	assert.Equal(t, "disk_free", readings[2].MeasurementType)
+	assert.InDelta(t, 10.737, *readings[2].NumericValue, 0.001)
+
+	assert.Equal(t, "power", readings[3].MeasurementType)
+	assert.InDelta(t, 12.5, *readings[3].NumericValue, 0.001)
+}
+
+func TestPrometheusScrapeDriver_CollectReadings_NoSeriesMatched(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(nodeExporterMetrics))
+	}))
+	defer server.Close()
+
+	d := &PrometheusScrapeDriver{client: server.Client()}
+	sensor := gen.Sensor{Name: "pi", Config: map[string]string{
+		"url":    server.URL,
+		"series": `[{"selector": "node_hwmon_temp_celsius{sensor=\"temp9\"}", "measurement_type": "temperature"}]`,
+	}}
+
+	_, err := d.CollectReadings(context.Background(), sensor)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "no configured series")
+	assert.NotContains(t, err.Error(), "malformed")
+}
+
+func TestPrometheusScrapeDriver_CollectReadings_NoSeriesMatchedReportsMalformedLines(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("node_hwmon_temp_celsius{sensor=\"temp1\" 48\nnode_load1 0.5\n"))
+	}))
+	defer server.Close()
+
+	d := &PrometheusScrapeDriver{client: server.Client()}
+	sensor := gen.Sensor{Name: "pi", Config: map[string]string{
+		"url":    server.URL,
+		"series": `[{"selector": "node_hwmon_temp_celsius{sensor=\"temp1\"}", "measurement_type": "temperature"}]`,
+	}}
+
+	_, err := d.CollectReadings(context.Background(), sensor)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "skipped 1 malformed lines: line 1")
+}
+
+func TestPrometheusScrapeDriver_CollectReadings_Non200(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	d := &PrometheusScrapeDriver{client: server.Client()}
+	sensor := gen.Sensor{Name: "pi", Config: map[string]string{
+		"url":    server.URL,
+		"series": `[{"selector": "up", "measurement_type": "state"}]`,
+	}}
+
+	_, err := d.CollectReadings(context.Background(), sensor)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "non-200")
+}
+
+func TestPrometheusScrapeDriver_InvalidConfig(t *testing.T) {
+	d := &PrometheusScrapeDriver{client: http.DefaultClient}
+
+	tests := []struct {
+		name   string
+		config map[string]string
+		errMsg string
+	}{
+		{"missing url", map[string]string{"series": `[{"selector":"up","measurement_type":"state"}]`}, "no 'url'"},
+		{"missing series", map[string]string{"url": "http://localhost"}, "'series' config is required"},
+		{"empty selector", map[string]string{"url": "http://localhost", "series": `[{"measurement_type":"state"}]`}, "selector is required"},
+		{"unclosed selector", map[string]string{"url": "http://localhost", "series": `[{"selector":"up{job=\"a\"","measurement_type":"state"}]`}, "closing brace"},
+		{"bad regex", map[string]string{"url": "http://localhost", "series": `[{"selector":"up{job=~\"(\"}","measurement_type":"state"}]`}, "invalid regex"},
+		{"missing measurement type", map[string]string{"url": "http://localhost", "series": `[{"selector":"up"}]`}, "no 'measurement_type'"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := d.CollectReadings(context.Background(), gen.Sensor{Name: "bad", Config: tt.config})
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errMsg)
+		})
+	}
+}
+
+func TestParsePrometheusText_OpenMetrics(t *testing.T) {
+	input := `# TYPE shelly_temperature gauge
+# UNIT shelly_temperature celsius
+shelly_temperature{id="0"} 23.4 1700000000.123
+# TYPE shelly_energy counter
+shelly_energy_total{id="0"} 1027.5 # {trace_id="abc"} 1.0 1700000000.0
+# EOF
+`
+	samples, skipped, err := parsePrometheusText(strings.NewReader(input))
+	require.NoError(t, err)
+	assert.Empty(t, skipped)
+	require.Len(t, samples, 2)
+	assert.Equal(t, "shelly_energy_total", samples[0].metric)
+	assert.InDelta(t, 1027.5, samples[0].value, 0.001)
+	assert.Equal(t, "shelly_temperature", samples[1].metric)
+	assert.Equal(t, "0", samples[1].labels["id"])
+	assert.InDelta(t, 23.4, samples[1].value, 0.001)
+}
+
+func TestParsePrometheusText_SkipsMalformedLines(t *testing.T) {
+	input := `node_load1 0.42
+metric{label="x" 1
+metric notanumber
+node_load5 0.35
+`
+	samples, skipped, err := parsePrometheusText(strings.NewReader(input))
+	require.NoError(t, err)
+	require.Len(t, samples, 2)
+	assert.Equal(t, "node_load1", samples[0].metric)
+	assert.Equal(t, "node_load5", samples[1].metric)
+	require.Len(t, skipped, 2)
+	assert.Contains(t, skipped[0].Error(), "line 2")
+	assert.Contains(t, skipped[1].Error(), "line 3")
+}
+
+func TestParsePrometheusSelector_KeepsRegexEscapes(t *testing.T) {
+	selector, err := parsePrometheusSelector(`node_hwmon_temp_celsius{sensor=~"temp\d", label="a \"quoted\" name"}`)
+	require.NoError(t, err)
+
+	assert.Equal(t, `temp\d`, selector.matchers[0].value)
+	assert.Equal(t, `a "quoted" name`, selector.matchers[1].value)
+	assert.True(t, selector.matches(prometheusSample{metric: "node_hwmon_temp_celsius", labels: map[string]string{"sensor": "temp1", "label": `a "quoted" name`}}))
+	assert.False(t, selector.matches(prometheusSample{metric: "node_hwmon_temp_celsius", labels: map[string]string{"sensor": "tempd", "label": `a "quoted" name`}}))
+}
@@ -0,0 +1,239 @@
+package drivers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"example/sensorHub/gen"
+)
+
+func init() {
+	Register(&RTL433Driver{})
+}
+
+// rtl433FieldMapping maps an rtl_433 JSON field to a measurement type, with
+// an optional conversion into the measurement type's canonical unit.
+type rtl433FieldMapping struct {
+	fieldMapping
+	Convert func(float64) float64
+}
+
+// rtl433Fields maps rtl_433 JSON field names to measurement types. rtl_433
+// suffixes field names with their unit, so the same measurement may arrive
+// under several keys depending on the decoder.
+var rtl433Fields = map[string]rtl433FieldMapping{
+	"temperature_C": {fieldMapping: fieldMapping{MeasurementType: "temperature", DisplayName: "Temperature", Unit: "°C", Category: "numeric"}},
+	"temperature_F": {fieldMapping: fieldMapping{MeasurementType: "temperature", DisplayName: "Temperature", Unit: "°C", Category: "numeric"}, Convert: fahrenheitToCelsius},
+	"humidity":      {fieldMapping: fieldMapping{MeasurementType: "humidity", DisplayName: "Humidity", Unit: "%", Category: "numeric"}},
+	"moisture":      {fieldMapping: fieldMapping{MeasurementType: "soil_moisture", DisplayName: "Soil Moisture", Unit: "%", Category: "numeric"}},
+	"pressure_hPa":  {fieldMapping: fieldMapping{MeasurementType: "pressure", DisplayName: "Pressure", Unit: "hPa", Category: "numeric"}},
+	"wind_avg_km_h": {fieldMapping: fieldMapping{MeasurementType: "wind_speed", DisplayName: "Wind Speed", Unit: "km/h", Category: "numeric"}},
+	"wind_avg_m_s":  {fieldMapping: fieldMapping{MeasurementType: "wind_speed", DisplayName: "Wind Speed", Unit: "km/h", Category: "numeric"}, Convert: metresPerSecondToKmh},
+	"wind_avg_mi_h": {fieldMapping: fieldMapping{MeasurementType: "wind_speed", DisplayName: "Wind Speed", Unit: "km/h", Category: "numeric"}, Convert: milesPerHourToKmh},
+	"wind_max_km_h": {fieldMapping: fieldMapping{MeasurementType: "wind_gust", DisplayName: "Wind Gust", Unit: "km/h", Category: "numeric"}},
+	"wind_max_m_s":  {fieldMapping: fieldMapping{MeasurementType: "wind_gust", DisplayName: "Wind Gust", Unit: "km/h", Category: "numeric"}, Convert: metresPerSecondToKmh},
+	"wind_max_mi_h": {fieldMapping: fieldMapping{MeasurementType: "wind_gust", DisplayName: "Wind Gust", Unit: "km/h", Category: "numeric"}, Convert: milesPerHourToKmh},
+	"wind_dir_deg":  {fieldMapping: fieldMapping{MeasurementType: "wind_direction", DisplayName: "Wind Direction", Unit: "°", Category: "numeric"}},
+	"rain_mm":       {fieldMapping: fieldMapping{MeasurementType: "rain", DisplayName: "Rain", Unit: "mm", Category: "numeric"}},
+	"rain_in":       {fieldMapping: fieldMapping{MeasurementType: "rain", DisplayName: "Rain", Unit: "mm", Category: "numeric"}, Convert: inchesToMillimetres},
+	"light_lux":     {fieldMapping: fieldMapping{MeasurementType: "illuminance", DisplayName: "Illuminance", Unit: "lx", Category: "numeric"}},
+	"uv":            {fieldMapping: fieldMapping{MeasurementType: "uv_index", DisplayName: "UV Index", Unit: "", Category: "numeric"}},
+	"battery_ok":    {fieldMapping: fieldMapping{MeasurementType: "battery_low", DisplayName: "Battery Low", Unit: "", Category: "binary"}},
+	"detect_wet":    {fieldMapping: fieldMapping{MeasurementType: "water_leak", DisplayName: "Water Leak", Unit: "", Category: "binary"}},
+	"tamper":        {fieldMapping: fieldMapping{MeasurementType: "tamper", DisplayName: "Tamper", Unit: "", Category: "binary"}},
+}
+
+func fahrenheitToCelsius(f float64) float64  { return (f - 32) * 5 / 9 }
+func metresPerSecondToKmh(v float64) float64 { return v * 3.6 }
+func milesPerHourToKmh(v float64) float64    { return v * 1.609344 }
+func inchesToMillimetres(v float64) float64  { return v * 25.4 }
+
+// RTL433Driver parses JSON events published by rtl_433, the software-defined
+// radio decoder for 433/868/915 MHz devices such as weather stations.
+//
+// rtl_433 publishes one flat JSON object per decoded transmission to
+// rtl_433/<host>/events when started with -F mqtt. Devices are identified by
+// their model, channel and id fields.
+type RTL433Driver struct{}
+
+var _ PushDriver = (*RTL433Driver)(nil)
+var _ ExpectedIntervalReporter = (*RTL433Driver)(nil)
+
+func (d *RTL433Driver) Type() string        { return "mqtt-rtl433" }
+func (d *RTL433Driver) DisplayName() string { return "rtl_433" }
+func (d *RTL433Driver) Description() string {
+	return "433/868 MHz weather stations and sensors decoded by rtl_433 — temperature, humidity, wind, rain, and battery"
+}
+
+// ExpectedInterval allows for several missed transmissions; 433 MHz weather stations
+// typically transmit every 30–60 seconds.
+func (d *RTL433Driver) ExpectedInterval() time.Duration { return 15 * time.Minute }
+
+func (d *RTL433Driver) ConfigFields() []ConfigFieldSpec {
+	return nil // Push drivers have no sensor-level config
+}
+
+func (d *RTL433Driver) SupportedMeasurementTypes() []gen.MeasurementType {
+	var mts []gen.MeasurementType
+	seen := make(map[string]bool)
+	for _, fm := range rtl433Fields {
+		if seen[fm.MeasurementType] {
+			continue
+		}
+		seen[fm.MeasurementType] = true
+		mts = append(mts, gen.MeasurementType{
+			Name:        fm.MeasurementType,
+			DisplayName: fm.DisplayName,
+			Unit:        fm.Unit,
+			Category:    gen.MeasurementTypeCategory(fm.Category),
+		})
+	}
+	return mts
+}
+
+func (d *RTL433Driver) ValidateSensor(_ context.Context, _ gen.Sensor) error {
+	return nil // Push-based sensors have nothing to validate locally
+}
+
+type rtl433Event struct {
+	Model   string          `json:"model"`
+	ID      json.RawMessage `json:"id"`
+	Channel json.RawMessage `json:"channel"`
+}
+
+// IdentifyDevice builds a stable device name from the model, channel and id
+// fields of an rtl_433 event, e.g. "Acurite-Tower-A-1234". Only messages on
+// .../events topics are treated as device messages.
+func (d *RTL433Driver) IdentifyDevice(topic string, payload []byte) (string, error) {
+	if !isRTL433EventsTopic(topic) {
+		return "", fmt.Errorf("not an rtl_433 events topic: %s", topic)
+	}
+
+	var event rtl433Event
+	if err := json.Unmarshal(payload, &event); err != nil {
+		return "", fmt.Errorf("invalid JSON payload: %w", err)
+	}
+	if event.Model == "" {
+		return "", fmt.Errorf("rtl_433 event has no model: %s", topic)
+	}
+
+	parts := []string{event.Model}
+	if channel := rtl433IdentifierValue(event.Channel); channel != "" {
+		parts = append(parts, channel)
+	}
+	if id := rtl433IdentifierValue(event.ID); id != "" {
+		parts = append(parts, id)
+	}
+
+	return strings.Join(parts, "-"), nil
+}
+
+// ParseMessage extracts readings from an rtl_433 event, converting units to
+// the hub's canonical units and ignoring unknown fields.
+func (d *RTL433Driver) ParseMessage(topic string, payload []byte) ([]gen.Reading, error) {
+	if !isRTL433EventsTopic(topic) {
+		return nil, fmt.Errorf("not an rtl_433 events topic: %s", topic)
+	}
+
+	var data map[string]interface{}
+	if err := json.Unmarshal(payload, &data); err != nil {
+		return nil, fmt.Errorf("invalid JSON payload: %w", err)
+	}
+
+	now := time.Now().UTC().Format("2006-01-02 15:04:05")
+	var readings []gen.Reading
+	seen := make(map[string]bool)
+
+	// Sorted keys make the choice deterministic when a device reports the
+	// same measurement in several units (e.g. temperature_C and temperature_F).
+	for _, key := range slices.Sorted(maps.Keys(data)) {
+		val := data[key]
+		fm, ok := rtl433Fields[key]
+		if !ok || seen[fm.MeasurementType] {
+			continue
+		}
+
+		reading := gen.Reading{
+			MeasurementType: fm.MeasurementType,
+			Unit:            fm.Unit,
+			Time:            now,
+		}
+
+		switch fm.Category {
+		case "numeric":
+			numVal, ok := toFloat64(val)
+			if !ok {
+				continue
+			}
+			if fm.Convert != nil {
+				numVal = fm.Convert(numVal)
+			}
+			reading.NumericValue = &numVal
+		case "binary":
+			textVal := rtl433BoolString(val)
+			if key == "battery_ok" {
+				textVal = rtl433BatteryLow(val)
+			}
+			reading.TextState = &textVal
+		}
+
+		seen[fm.MeasurementType] = true
+		readings = append(readings, reading)
+	}
+
+	return readings, nil
+}
+
+func isRTL433EventsTopic(topic string) bool {
+	parts := strings.Split(topic, "/")
+	return len(parts) >= 2 && parts[len(parts)-1] == "events"
+}
+
+// rtl433IdentifierValue renders an id or channel that may be encoded as a
+// JSON number or string.
+func rtl433IdentifierValue(raw json.RawMessage) string {
+	if len(raw) == 0 || string(raw) == "null" {
+		return ""
+	}
+	var s string
+	if err := json.Unmarshal(raw, &s); err == nil {
+		return s
+	}
+	var n json.Number
+	if err := json.Unmarshal(raw, &n); err == nil {
+		return n.String()
+	}
+	return ""
+}
+
+// rtl433BoolString normalises binary fields, which rtl_433 usually encodes
+// as 0/1 integers rather than JSON booleans.
+func rtl433BoolString(v interface{}) string {
+	if n, ok := toFloat64(v); ok {
+		if n != 0 {
+			return "true"
+		}
+		return "false"
+	}
+	return toBoolString(v)
+}
+
+// rtl433BatteryLow inverts rtl_433's battery_ok flag, which is 1 when the
+// battery is fine and 0 when it is low (some decoders report a 0–1 level).
+func rtl433BatteryLow(v interface{}) string {
+	if level, ok := toFloat64(v); ok {
+		if level < 0.25 {
+			return "true"
+		}
+		return "false"
+	}
+	if rtl433BoolString(v) == "true" {
+		return "false"
+	}
+	return "true"
+}
@@ -0,0 +1,123 @@
+package drivers
+
+import (
+	"testing"
+	"time"
+
+	gen "example/sensorHub/gen"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const acuriteEvent = `{"time":"2025-01-01 12:00:00","model":"Acurite-5n1","id":1234,"channel":"A","battery_ok":1,` +
+	`"temperature_F":68.0,"humidity":45,"wind_avg_km_h":12.5,"wind_max_m_s":5,"wind_dir_deg":270,"rain_in":0.5,"mic":"CHECKSUM"}`
+
+func readingsByType(readings []gen.Reading) map[string]gen.Reading {
+	byType := make(map[string]gen.Reading, len(readings))
+	for _, r := range readings {
+		byType[r.MeasurementType] = r
+	}
+	return byType
+}
+
+func TestRTL433Driver_Metadata(t *testing.T) {
+	d := &RTL433Driver{}
+
+	assert.Equal(t, "mqtt-rtl433", d.Type())
+	assert.Equal(t, "rtl_433", d.DisplayName())
+	assert.NotEmpty(t, d.Description())
+	assert.Nil(t, d.ConfigFields())
+	assert.Equal(t, 15*time.Minute, d.ExpectedInterval())
+
+	names := make(map[string]bool)
+	for _, mt := range d.SupportedMeasurementTypes() {
+		assert.False(t, names[mt.Name], "duplicate measurement type %s", mt.Name)
+		names[mt.Name] = true
+	}
+	for _, expected := range []string{"temperature", "humidity", "wind_speed", "wind_gust", "wind_direction", "rain", "battery_low"} {
+		assert.True(t, names[expected], "expected %s to be supported", expected)
+	}
+}
+
+func TestRTL433Driver_IdentifyDevice(t *testing.T) {
+	d := &RTL433Driver{}
+
+	tests := []struct {
+		name    string
+		topic   string
+		payload string
+		want    string
+		wantErr bool
+	}{
+		{"model channel and id", "rtl_433/pi/events", acuriteEvent, "Acurite-5n1-A-1234", false},
+		{"numeric channel", "rtl_433/pi/events", `{"model":"LaCrosse-TX141THBv2","id":"a5","channel":2}`, "LaCrosse-TX141THBv2-2-a5", false},
+		{"model and id only", "rtl_433/pi/events", `{"model":"Oregon-THGR122N","id":201}`, "Oregon-THGR122N-201", false},
+		{"no model", "rtl_433/pi/events", `{"id":1}`, "", true},
+		{"states topic", "rtl_433/pi/states", acuriteEvent, "", true},
+		{"invalid json", "rtl_433/pi/events", `not json`, "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := d.IdentifyDevice(tt.topic, []byte(tt.payload))
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestRTL433Driver_ParseMessage_WeatherStation(t *testing.T) {
+	d := &RTL433Driver{}
+
+	readings, err := d.ParseMessage("rtl_433/pi/events", []byte(acuriteEvent))
+	require.NoError(t, err)
+
+	byType := readingsByType(readings)
+	require.Len(t, byType, 7)
+
+	assert.InDelta(t, 20.0, *byType["temperature"].NumericValue, 0.001)
+	assert.Equal(t, "°C", byType["temperature"].Unit)
+	assert.InDelta(t, 45.0, *byType["humidity"].NumericValue, 0.001)
+	assert.InDelta(t, 12.5, *byType["wind_speed"].NumericValue, 0.001)
+	assert.InDelta(t, 18.0, *byType["wind_gust"].NumericValue, 0.001)
+	assert.Equal(t, "km/h", byType["wind_gust"].Unit)
+	assert.InDelta(t, 270.0, *byType["wind_direction"].NumericValue, 0.001)
+	assert.InDelta(t, 12.7, *byType["rain"].NumericValue, 0.001)
+	require.NotNil(t, byType["battery_low"].TextState)
+	assert.Equal(t, "false", *byType["battery_low"].TextState)
+}
+
+func TestRTL433Driver_ParseMessage_BatteryLow(t *testing.T) {
+	d := &RTL433Driver{}
+
+	readings, err := d.ParseMessage("rtl_433/pi/events", []byte(`{"model":"X","id":1,"battery_ok":0,"detect_wet":1}`))
+	require.NoError(t, err)
+
+	byType := readingsByType(readings)
+	assert.Equal(t, "true", *byType["battery_low"].TextState)
+	assert.Equal(t, "true", *byType["water_leak"].TextState)
+}
+
+func TestRTL433Driver_ParseMessage_PrefersFirstUnitVariant(t *testing.T) {
+	d := &RTL433Driver{}
+
+	readings, err := d.ParseMessage("rtl_433/pi/events", []byte(`{"model":"X","temperature_C":21.0,"temperature_F":80.0}`))
+	require.NoError(t, err)
+	require.Len(t, readings, 1)
+	assert.InDelta(t, 21.0, *readings[0].NumericValue, 0.001)
+}
+
+func TestRTL433Driver_ParseMessage_Errors(t *testing.T) {
+	d := &RTL433Driver{}
+
+	_, err := d.ParseMessage("rtl_433/pi/events", []byte(`{bad`))
+	assert.Error(t, err)
+
+	_, err = d.ParseMessage("rtl_433/pi/devices/X/1/temperature_C", []byte(`21.0`))
+	assert.Error(t, err)
+}
@@ -66,6 +66,7 @@ type Zigbee2MQTTDriver struct{}
 var _ PushDriver = (*Zigbee2MQTTDriver)(nil)
 var _ CommandDriver = (*Zigbee2MQTTDriver)(nil)
 var _ SystemMessageHandler = (*Zigbee2MQTTDriver)(nil)
+var _ ExpectedIntervalReporter = (*Zigbee2MQTTDriver)(nil)
 
 func (d *Zigbee2MQTTDriver) Type() string        { return "mqtt-zigbee2mqtt" }
 func (d *Zigbee2MQTTDriver) DisplayName() string { return "Zigbee2MQTT" }
@@ -73,6 +74,10 @@ func (d *Zigbee2MQTTDriver) Description() string {
 	return "Zigbee devices via the Zigbee2MQTT bridge — temperature, humidity, contact sensors, smart plugs, and more"
 }
 
+// ExpectedInterval matches Zigbee2MQTT's own availability timeout for battery-powered
+// devices, which may only check in once a day when nothing changes.
+func (d *Zigbee2MQTTDriver) ExpectedInterval() time.Duration { return 25 * time.Hour }
+
 func (d *Zigbee2MQTTDriver) ConfigFields() []ConfigFieldSpec {
 	return nil // Push drivers have no sensor-level config
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"testing"
+	"time"
 
 	gen "example/sensorHub/gen"
 
@@ -16,6 +17,13 @@ func TestZigbee2MQTT_Type(t *testing.T) {
 	assert.Equal(t, "mqtt-zigbee2mqtt", d.Type())
 }
 
+func TestZigbee2MQTT_ExpectedInterval(t *testing.T) {
+	var d SensorDriver = &Zigbee2MQTTDriver{}
+	reporter, ok := d.(ExpectedIntervalReporter)
+	require.True(t, ok)
+	assert.Equal(t, 25*time.Hour, reporter.ExpectedInterval())
+}
+
 func TestZigbee2MQTT_IdentifyDevice_Standard(t *testing.T) {
 	d := &Zigbee2MQTTDriver{}
 
@@ -99,12 +99,99 @@ type ClientInterface interface {
 
 	CreateAlertRule(ctx context.Context, body CreateAlertRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetAllCompoundAlertRules request
+	GetAllCompoundAlertRules(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateCompoundAlertRuleWithBody request with any body
+	CreateCompoundAlertRuleWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateCompoundAlertRule(ctx context.Context, body CreateCompoundAlertRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteCompoundAlertRule request
+	DeleteCompoundAlertRule(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetCompoundAlertRuleById request
+	GetCompoundAlertRuleById(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateCompoundAlertRuleWithBody request with any body
+	UpdateCompoundAlertRuleWithBody(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateCompoundAlertRule(ctx context.Context, id int, body UpdateCompoundAlertRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AcknowledgeCompoundAlertRule request
+	AcknowledgeCompoundAlertRule(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteCompoundAlertEscalationPolicy request
+	DeleteCompoundAlertEscalationPolicy(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetCompoundAlertEscalationPolicy request
+	GetCompoundAlertEscalationPolicy(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SetCompoundAlertEscalationPolicyWithBody request with any body
+	SetCompoundAlertEscalationPolicyWithBody(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SetCompoundAlertEscalationPolicy(ctx context.Context, id int, body SetCompoundAlertEscalationPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteCompoundAlertRecipients request
+	DeleteCompoundAlertRecipients(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetCompoundAlertRecipients request
+	GetCompoundAlertRecipients(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SetCompoundAlertRecipientsWithBody request with any body
+	SetCompoundAlertRecipientsWithBody(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SetCompoundAlertRecipients(ctx context.Context, id int, body SetCompoundAlertRecipientsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UnsnoozeCompoundAlertRule request
+	UnsnoozeCompoundAlertRule(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SnoozeCompoundAlertRuleWithBody request with any body
+	SnoozeCompoundAlertRuleWithBody(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SnoozeCompoundAlertRule(ctx context.Context, id int, body SnoozeCompoundAlertRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAllContactGroups request
+	GetAllContactGroups(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateContactGroupWithBody request with any body
+	CreateContactGroupWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateContactGroup(ctx context.Context, body CreateContactGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteContactGroup request
+	DeleteContactGroup(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateContactGroupWithBody request with any body
+	UpdateContactGroupWithBody(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateContactGroup(ctx context.Context, id int, body UpdateContactGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AcknowledgeAlertHistoryEntry request
+	AcknowledgeAlertHistoryEntry(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetAlertRulesBySensorId request
 	GetAlertRulesBySensorId(ctx context.Context, sensorId int, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// GetAlertHistory request
 	GetAlertHistory(ctx context.Context, sensorId int, params *GetAlertHistoryParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetAllSilenceWindows request
+	GetAllSilenceWindows(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateSilenceWindowWithBody request with any body
+	CreateSilenceWindowWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateSilenceWindow(ctx context.Context, body CreateSilenceWindowJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteSilenceWindow request
+	DeleteSilenceWindow(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateSilenceWindowWithBody request with any body
+	UpdateSilenceWindowWithBody(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateSilenceWindow(ctx context.Context, id int, body UpdateSilenceWindowJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// DeleteAlertRule request
 	DeleteAlertRule(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -116,6 +203,39 @@ type ClientInterface interface {
 
 	UpdateAlertRule(ctx context.Context, id int, body UpdateAlertRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// AcknowledgeAlertRule request
+	AcknowledgeAlertRule(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteAlertEscalationPolicy request
+	DeleteAlertEscalationPolicy(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAlertEscalationPolicy request
+	GetAlertEscalationPolicy(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SetAlertEscalationPolicyWithBody request with any body
+	SetAlertEscalationPolicyWithBody(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SetAlertEscalationPolicy(ctx context.Context, id int, body SetAlertEscalationPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteAlertRecipients request
+	DeleteAlertRecipients(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAlertRecipients request
+	GetAlertRecipients(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SetAlertRecipientsWithBody request with any body
+	SetAlertRecipientsWithBody(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SetAlertRecipients(ctx context.Context, id int, body SetAlertRecipientsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UnsnoozeAlertRule request
+	UnsnoozeAlertRule(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SnoozeAlertRuleWithBody request with any body
+	SnoozeAlertRuleWithBody(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SnoozeAlertRule(ctx context.Context, id int, body SnoozeAlertRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// ListApiKeys request
 	ListApiKeys(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -152,6 +272,25 @@ type ClientInterface interface {
 	// RevokeSession request
 	RevokeSession(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetAllAutomationRules request
+	GetAllAutomationRules(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateAutomationRuleWithBody request with any body
+	CreateAutomationRuleWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateAutomationRule(ctx context.Context, body CreateAutomationRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteAutomationRule request
+	DeleteAutomationRule(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAutomationRuleById request
+	GetAutomationRuleById(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateAutomationRuleWithBody request with any body
+	UpdateAutomationRuleWithBody(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateAutomationRule(ctx context.Context, id int, body UpdateAutomationRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// ListDashboards request
 	ListDashboards(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -188,6 +327,14 @@ type ClientInterface interface {
 	// GetAllMeasurementTypes request
 	GetAllMeasurementTypes(ctx context.Context, params *GetAllMeasurementTypesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// DeleteMeasurementTypeDownsampling request
+	DeleteMeasurementTypeDownsampling(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SetMeasurementTypeDownsamplingWithBody request with any body
+	SetMeasurementTypeDownsamplingWithBody(ctx context.Context, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SetMeasurementTypeDownsampling(ctx context.Context, name string, body SetMeasurementTypeDownsamplingJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// ListMqttBrokers request
 	ListMqttBrokers(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -246,9 +393,56 @@ type ClientInterface interface {
 
 	SetChannelPreference(ctx context.Context, body SetChannelPreferenceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// DeletePushSubscription request
+	DeletePushSubscription(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetPushSubscription request
+	GetPushSubscription(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SetPushSubscriptionWithBody request with any body
+	SetPushSubscriptionWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SetPushSubscription(ctx context.Context, body SetPushSubscriptionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// TestPushSubscription request
+	TestPushSubscription(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteQuietHours request
+	DeleteQuietHours(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetQuietHours request
+	GetQuietHours(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SetQuietHoursWithBody request with any body
+	SetQuietHoursWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SetQuietHours(ctx context.Context, body SetQuietHoursJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetUnreadCount request
 	GetUnreadCount(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ListWebhookTargets request
+	ListWebhookTargets(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateWebhookTargetWithBody request with any body
+	CreateWebhookTargetWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateWebhookTarget(ctx context.Context, body CreateWebhookTargetJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteWebhookTarget request
+	DeleteWebhookTarget(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateWebhookTargetWithBody request with any body
+	UpdateWebhookTargetWithBody(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateWebhookTarget(ctx context.Context, id int, body UpdateWebhookTargetJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetWebhookDeliveries request
+	GetWebhookDeliveries(ctx context.Context, id int, params *GetWebhookDeliveriesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// TestWebhookTarget request
+	TestWebhookTarget(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// NotificationsWebSocket request
 	NotificationsWebSocket(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -309,6 +503,55 @@ type ClientInterface interface {
 	// RemovePermission request
 	RemovePermission(ctx context.Context, id int, pid int, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetAllScenes request
+	GetAllScenes(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateSceneWithBody request with any body
+	CreateSceneWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateScene(ctx context.Context, body CreateSceneJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteScene request
+	DeleteScene(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetSceneById request
+	GetSceneById(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateSceneWithBody request with any body
+	UpdateSceneWithBody(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateScene(ctx context.Context, id int, body UpdateSceneJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ActivateScene request
+	ActivateScene(ctx context.Context, id int, params *ActivateSceneParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAllSchedules request
+	GetAllSchedules(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateScheduleWithBody request with any body
+	CreateScheduleWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateSchedule(ctx context.Context, body CreateScheduleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetHolidayMode request
+	GetHolidayMode(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SetHolidayModeWithBody request with any body
+	SetHolidayModeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SetHolidayMode(ctx context.Context, body SetHolidayModeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteSchedule request
+	DeleteSchedule(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetScheduleById request
+	GetScheduleById(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateScheduleWithBody request with any body
+	UpdateScheduleWithBody(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateSchedule(ctx context.Context, id int, body UpdateScheduleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetAllSensors request
 	GetAllSensors(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -326,6 +569,17 @@ type ClientInterface interface {
 	// GetSensorCommandHistory request
 	GetSensorCommandHistory(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetSensorDesiredStates request
+	GetSensorDesiredStates(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteSensorDesiredState request
+	DeleteSensorDesiredState(ctx context.Context, id int, property string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SetSensorDesiredStateWithBody request with any body
+	SetSensorDesiredStateWithBody(ctx context.Context, id int, property string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SetSensorDesiredState(ctx context.Context, id int, property string, body SetSensorDesiredStateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetSensorMeasurementTypes request
 	GetSensorMeasurementTypes(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -444,8 +698,8 @@ func (c *Client) CreateAlertRule(ctx context.Context, body CreateAlertRuleJSONRe
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetAlertRulesBySensorId(ctx context.Context, sensorId int, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetAlertRulesBySensorIdRequest(c.Server, sensorId)
+func (c *Client) GetAllCompoundAlertRules(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAllCompoundAlertRulesRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -456,8 +710,8 @@ func (c *Client) GetAlertRulesBySensorId(ctx context.Context, sensorId int, reqE
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetAlertHistory(ctx context.Context, sensorId int, params *GetAlertHistoryParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetAlertHistoryRequest(c.Server, sensorId, params)
+func (c *Client) CreateCompoundAlertRuleWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateCompoundAlertRuleRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -468,8 +722,8 @@ func (c *Client) GetAlertHistory(ctx context.Context, sensorId int, params *GetA
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteAlertRule(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteAlertRuleRequest(c.Server, id)
+func (c *Client) CreateCompoundAlertRule(ctx context.Context, body CreateCompoundAlertRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateCompoundAlertRuleRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -480,8 +734,8 @@ func (c *Client) DeleteAlertRule(ctx context.Context, id int, reqEditors ...Requ
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetAlertRuleById(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetAlertRuleByIdRequest(c.Server, id)
+func (c *Client) DeleteCompoundAlertRule(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteCompoundAlertRuleRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
@@ -492,8 +746,8 @@ func (c *Client) GetAlertRuleById(ctx context.Context, id int, reqEditors ...Req
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateAlertRuleWithBody(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateAlertRuleRequestWithBody(c.Server, id, contentType, body)
+func (c *Client) GetCompoundAlertRuleById(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetCompoundAlertRuleByIdRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
@@ -504,8 +758,8 @@ func (c *Client) UpdateAlertRuleWithBody(ctx context.Context, id int, contentTyp
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateAlertRule(ctx context.Context, id int, body UpdateAlertRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateAlertRuleRequest(c.Server, id, body)
+func (c *Client) UpdateCompoundAlertRuleWithBody(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateCompoundAlertRuleRequestWithBody(c.Server, id, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -516,8 +770,8 @@ func (c *Client) UpdateAlertRule(ctx context.Context, id int, body UpdateAlertRu
 	return c.Client.Do(req)
 }
 
-func (c *Client) ListApiKeys(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListApiKeysRequest(c.Server)
+func (c *Client) UpdateCompoundAlertRule(ctx context.Context, id int, body UpdateCompoundAlertRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateCompoundAlertRuleRequest(c.Server, id, body)
 	if err != nil {
 		return nil, err
 	}
@@ -528,8 +782,8 @@ func (c *Client) ListApiKeys(ctx context.Context, reqEditors ...RequestEditorFn)
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateApiKeyWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateApiKeyRequestWithBody(c.Server, contentType, body)
+func (c *Client) AcknowledgeCompoundAlertRule(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAcknowledgeCompoundAlertRuleRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
@@ -540,8 +794,8 @@ func (c *Client) CreateApiKeyWithBody(ctx context.Context, contentType string, b
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateApiKey(ctx context.Context, body CreateApiKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateApiKeyRequest(c.Server, body)
+func (c *Client) DeleteCompoundAlertEscalationPolicy(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteCompoundAlertEscalationPolicyRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
@@ -552,8 +806,8 @@ func (c *Client) CreateApiKey(ctx context.Context, body CreateApiKeyJSONRequestB
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteApiKey(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteApiKeyRequest(c.Server, id)
+func (c *Client) GetCompoundAlertEscalationPolicy(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetCompoundAlertEscalationPolicyRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
@@ -564,8 +818,8 @@ func (c *Client) DeleteApiKey(ctx context.Context, id int, reqEditors ...Request
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateApiKeyExpiryWithBody(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateApiKeyExpiryRequestWithBody(c.Server, id, contentType, body)
+func (c *Client) SetCompoundAlertEscalationPolicyWithBody(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetCompoundAlertEscalationPolicyRequestWithBody(c.Server, id, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -576,8 +830,8 @@ func (c *Client) UpdateApiKeyExpiryWithBody(ctx context.Context, id int, content
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateApiKeyExpiry(ctx context.Context, id int, body UpdateApiKeyExpiryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateApiKeyExpiryRequest(c.Server, id, body)
+func (c *Client) SetCompoundAlertEscalationPolicy(ctx context.Context, id int, body SetCompoundAlertEscalationPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetCompoundAlertEscalationPolicyRequest(c.Server, id, body)
 	if err != nil {
 		return nil, err
 	}
@@ -588,8 +842,8 @@ func (c *Client) UpdateApiKeyExpiry(ctx context.Context, id int, body UpdateApiK
 	return c.Client.Do(req)
 }
 
-func (c *Client) RevokeApiKey(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewRevokeApiKeyRequest(c.Server, id)
+func (c *Client) DeleteCompoundAlertRecipients(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteCompoundAlertRecipientsRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
@@ -600,8 +854,8 @@ func (c *Client) RevokeApiKey(ctx context.Context, id int, reqEditors ...Request
 	return c.Client.Do(req)
 }
 
-func (c *Client) LoginWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewLoginRequestWithBody(c.Server, contentType, body)
+func (c *Client) GetCompoundAlertRecipients(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetCompoundAlertRecipientsRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
@@ -612,8 +866,8 @@ func (c *Client) LoginWithBody(ctx context.Context, contentType string, body io.
 	return c.Client.Do(req)
 }
 
-func (c *Client) Login(ctx context.Context, body LoginJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewLoginRequest(c.Server, body)
+func (c *Client) SetCompoundAlertRecipientsWithBody(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetCompoundAlertRecipientsRequestWithBody(c.Server, id, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -624,8 +878,8 @@ func (c *Client) Login(ctx context.Context, body LoginJSONRequestBody, reqEditor
 	return c.Client.Do(req)
 }
 
-func (c *Client) Logout(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewLogoutRequest(c.Server)
+func (c *Client) SetCompoundAlertRecipients(ctx context.Context, id int, body SetCompoundAlertRecipientsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetCompoundAlertRecipientsRequest(c.Server, id, body)
 	if err != nil {
 		return nil, err
 	}
@@ -636,8 +890,8 @@ func (c *Client) Logout(ctx context.Context, reqEditors ...RequestEditorFn) (*ht
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetCurrentUser(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetCurrentUserRequest(c.Server)
+func (c *Client) UnsnoozeCompoundAlertRule(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUnsnoozeCompoundAlertRuleRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
@@ -648,8 +902,8 @@ func (c *Client) GetCurrentUser(ctx context.Context, reqEditors ...RequestEditor
 	return c.Client.Do(req)
 }
 
-func (c *Client) ListSessions(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListSessionsRequest(c.Server)
+func (c *Client) SnoozeCompoundAlertRuleWithBody(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSnoozeCompoundAlertRuleRequestWithBody(c.Server, id, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -660,8 +914,8 @@ func (c *Client) ListSessions(ctx context.Context, reqEditors ...RequestEditorFn
 	return c.Client.Do(req)
 }
 
-func (c *Client) RevokeSession(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewRevokeSessionRequest(c.Server, id)
+func (c *Client) SnoozeCompoundAlertRule(ctx context.Context, id int, body SnoozeCompoundAlertRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSnoozeCompoundAlertRuleRequest(c.Server, id, body)
 	if err != nil {
 		return nil, err
 	}
@@ -672,8 +926,8 @@ func (c *Client) RevokeSession(ctx context.Context, id int64, reqEditors ...Requ
 	return c.Client.Do(req)
 }
 
-func (c *Client) ListDashboards(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListDashboardsRequest(c.Server)
+func (c *Client) GetAllContactGroups(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAllContactGroupsRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -684,8 +938,8 @@ func (c *Client) ListDashboards(ctx context.Context, reqEditors ...RequestEditor
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateDashboardWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateDashboardRequestWithBody(c.Server, contentType, body)
+func (c *Client) CreateContactGroupWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateContactGroupRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -696,8 +950,8 @@ func (c *Client) CreateDashboardWithBody(ctx context.Context, contentType string
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateDashboard(ctx context.Context, body CreateDashboardJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateDashboardRequest(c.Server, body)
+func (c *Client) CreateContactGroup(ctx context.Context, body CreateContactGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateContactGroupRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -708,8 +962,8 @@ func (c *Client) CreateDashboard(ctx context.Context, body CreateDashboardJSONRe
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteDashboard(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteDashboardRequest(c.Server, id)
+func (c *Client) DeleteContactGroup(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteContactGroupRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
@@ -720,8 +974,8 @@ func (c *Client) DeleteDashboard(ctx context.Context, id int, reqEditors ...Requ
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetDashboard(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetDashboardRequest(c.Server, id)
+func (c *Client) UpdateContactGroupWithBody(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateContactGroupRequestWithBody(c.Server, id, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -732,8 +986,8 @@ func (c *Client) GetDashboard(ctx context.Context, id int, reqEditors ...Request
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateDashboardWithBody(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateDashboardRequestWithBody(c.Server, id, contentType, body)
+func (c *Client) UpdateContactGroup(ctx context.Context, id int, body UpdateContactGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateContactGroupRequest(c.Server, id, body)
 	if err != nil {
 		return nil, err
 	}
@@ -744,8 +998,8 @@ func (c *Client) UpdateDashboardWithBody(ctx context.Context, id int, contentTyp
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateDashboard(ctx context.Context, id int, body UpdateDashboardJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateDashboardRequest(c.Server, id, body)
+func (c *Client) AcknowledgeAlertHistoryEntry(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAcknowledgeAlertHistoryEntryRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
@@ -756,8 +1010,8 @@ func (c *Client) UpdateDashboard(ctx context.Context, id int, body UpdateDashboa
 	return c.Client.Do(req)
 }
 
-func (c *Client) SetDefaultDashboard(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSetDefaultDashboardRequest(c.Server, id)
+func (c *Client) GetAlertRulesBySensorId(ctx context.Context, sensorId int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAlertRulesBySensorIdRequest(c.Server, sensorId)
 	if err != nil {
 		return nil, err
 	}
@@ -768,8 +1022,8 @@ func (c *Client) SetDefaultDashboard(ctx context.Context, id int, reqEditors ...
 	return c.Client.Do(req)
 }
 
-func (c *Client) ShareDashboardWithBody(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewShareDashboardRequestWithBody(c.Server, id, contentType, body)
+func (c *Client) GetAlertHistory(ctx context.Context, sensorId int, params *GetAlertHistoryParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAlertHistoryRequest(c.Server, sensorId, params)
 	if err != nil {
 		return nil, err
 	}
@@ -780,8 +1034,8 @@ func (c *Client) ShareDashboardWithBody(ctx context.Context, id int, contentType
 	return c.Client.Do(req)
 }
 
-func (c *Client) ShareDashboard(ctx context.Context, id int, body ShareDashboardJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewShareDashboardRequest(c.Server, id, body)
+func (c *Client) GetAllSilenceWindows(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAllSilenceWindowsRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -792,8 +1046,8 @@ func (c *Client) ShareDashboard(ctx context.Context, id int, body ShareDashboard
 	return c.Client.Do(req)
 }
 
-func (c *Client) ListDrivers(ctx context.Context, params *ListDriversParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListDriversRequest(c.Server, params)
+func (c *Client) CreateSilenceWindowWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateSilenceWindowRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -804,8 +1058,8 @@ func (c *Client) ListDrivers(ctx context.Context, params *ListDriversParams, req
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetHealth(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetHealthRequest(c.Server)
+func (c *Client) CreateSilenceWindow(ctx context.Context, body CreateSilenceWindowJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateSilenceWindowRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -816,8 +1070,8 @@ func (c *Client) GetHealth(ctx context.Context, reqEditors ...RequestEditorFn) (
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetAllMeasurementTypes(ctx context.Context, params *GetAllMeasurementTypesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetAllMeasurementTypesRequest(c.Server, params)
+func (c *Client) DeleteSilenceWindow(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteSilenceWindowRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
@@ -828,8 +1082,8 @@ func (c *Client) GetAllMeasurementTypes(ctx context.Context, params *GetAllMeasu
 	return c.Client.Do(req)
 }
 
-func (c *Client) ListMqttBrokers(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListMqttBrokersRequest(c.Server)
+func (c *Client) UpdateSilenceWindowWithBody(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateSilenceWindowRequestWithBody(c.Server, id, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -840,8 +1094,8 @@ func (c *Client) ListMqttBrokers(ctx context.Context, reqEditors ...RequestEdito
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateMqttBrokerWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateMqttBrokerRequestWithBody(c.Server, contentType, body)
+func (c *Client) UpdateSilenceWindow(ctx context.Context, id int, body UpdateSilenceWindowJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateSilenceWindowRequest(c.Server, id, body)
 	if err != nil {
 		return nil, err
 	}
@@ -852,8 +1106,8 @@ func (c *Client) CreateMqttBrokerWithBody(ctx context.Context, contentType strin
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateMqttBroker(ctx context.Context, body CreateMqttBrokerJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateMqttBrokerRequest(c.Server, body)
+func (c *Client) DeleteAlertRule(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteAlertRuleRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
@@ -864,8 +1118,8 @@ func (c *Client) CreateMqttBroker(ctx context.Context, body CreateMqttBrokerJSON
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteMqttBroker(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteMqttBrokerRequest(c.Server, id)
+func (c *Client) GetAlertRuleById(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAlertRuleByIdRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
@@ -876,8 +1130,8 @@ func (c *Client) DeleteMqttBroker(ctx context.Context, id int, reqEditors ...Req
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetMqttBroker(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetMqttBrokerRequest(c.Server, id)
+func (c *Client) UpdateAlertRuleWithBody(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateAlertRuleRequestWithBody(c.Server, id, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -888,8 +1142,8 @@ func (c *Client) GetMqttBroker(ctx context.Context, id int, reqEditors ...Reques
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateMqttBrokerWithBody(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateMqttBrokerRequestWithBody(c.Server, id, contentType, body)
+func (c *Client) UpdateAlertRule(ctx context.Context, id int, body UpdateAlertRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateAlertRuleRequest(c.Server, id, body)
 	if err != nil {
 		return nil, err
 	}
@@ -900,8 +1154,8 @@ func (c *Client) UpdateMqttBrokerWithBody(ctx context.Context, id int, contentTy
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateMqttBroker(ctx context.Context, id int, body UpdateMqttBrokerJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateMqttBrokerRequest(c.Server, id, body)
+func (c *Client) AcknowledgeAlertRule(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAcknowledgeAlertRuleRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
@@ -912,8 +1166,8 @@ func (c *Client) UpdateMqttBroker(ctx context.Context, id int, body UpdateMqttBr
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetMqttStats(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetMqttStatsRequest(c.Server)
+func (c *Client) DeleteAlertEscalationPolicy(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteAlertEscalationPolicyRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
@@ -924,8 +1178,8 @@ func (c *Client) GetMqttStats(ctx context.Context, reqEditors ...RequestEditorFn
 	return c.Client.Do(req)
 }
 
-func (c *Client) ListMqttSubscriptions(ctx context.Context, params *ListMqttSubscriptionsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListMqttSubscriptionsRequest(c.Server, params)
+func (c *Client) GetAlertEscalationPolicy(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAlertEscalationPolicyRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
@@ -936,8 +1190,8 @@ func (c *Client) ListMqttSubscriptions(ctx context.Context, params *ListMqttSubs
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateMqttSubscriptionWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateMqttSubscriptionRequestWithBody(c.Server, contentType, body)
+func (c *Client) SetAlertEscalationPolicyWithBody(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetAlertEscalationPolicyRequestWithBody(c.Server, id, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -948,8 +1202,8 @@ func (c *Client) CreateMqttSubscriptionWithBody(ctx context.Context, contentType
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateMqttSubscription(ctx context.Context, body CreateMqttSubscriptionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateMqttSubscriptionRequest(c.Server, body)
+func (c *Client) SetAlertEscalationPolicy(ctx context.Context, id int, body SetAlertEscalationPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetAlertEscalationPolicyRequest(c.Server, id, body)
 	if err != nil {
 		return nil, err
 	}
@@ -960,8 +1214,8 @@ func (c *Client) CreateMqttSubscription(ctx context.Context, body CreateMqttSubs
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteMqttSubscription(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteMqttSubscriptionRequest(c.Server, id)
+func (c *Client) DeleteAlertRecipients(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteAlertRecipientsRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
@@ -972,8 +1226,8 @@ func (c *Client) DeleteMqttSubscription(ctx context.Context, id int, reqEditors
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetMqttSubscription(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetMqttSubscriptionRequest(c.Server, id)
+func (c *Client) GetAlertRecipients(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAlertRecipientsRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
@@ -984,8 +1238,8 @@ func (c *Client) GetMqttSubscription(ctx context.Context, id int, reqEditors ...
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateMqttSubscriptionWithBody(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateMqttSubscriptionRequestWithBody(c.Server, id, contentType, body)
+func (c *Client) SetAlertRecipientsWithBody(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetAlertRecipientsRequestWithBody(c.Server, id, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -996,8 +1250,8 @@ func (c *Client) UpdateMqttSubscriptionWithBody(ctx context.Context, id int, con
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateMqttSubscription(ctx context.Context, id int, body UpdateMqttSubscriptionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateMqttSubscriptionRequest(c.Server, id, body)
+func (c *Client) SetAlertRecipients(ctx context.Context, id int, body SetAlertRecipientsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetAlertRecipientsRequest(c.Server, id, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1008,8 +1262,8 @@ func (c *Client) UpdateMqttSubscription(ctx context.Context, id int, body Update
 	return c.Client.Do(req)
 }
 
-func (c *Client) ListNotifications(ctx context.Context, params *ListNotificationsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListNotificationsRequest(c.Server, params)
+func (c *Client) UnsnoozeAlertRule(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUnsnoozeAlertRuleRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
@@ -1020,8 +1274,8 @@ func (c *Client) ListNotifications(ctx context.Context, params *ListNotification
 	return c.Client.Do(req)
 }
 
-func (c *Client) BulkDismiss(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewBulkDismissRequest(c.Server)
+func (c *Client) SnoozeAlertRuleWithBody(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSnoozeAlertRuleRequestWithBody(c.Server, id, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1032,8 +1286,8 @@ func (c *Client) BulkDismiss(ctx context.Context, reqEditors ...RequestEditorFn)
 	return c.Client.Do(req)
 }
 
-func (c *Client) BulkMarkAsRead(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewBulkMarkAsReadRequest(c.Server)
+func (c *Client) SnoozeAlertRule(ctx context.Context, id int, body SnoozeAlertRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSnoozeAlertRuleRequest(c.Server, id, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1044,8 +1298,8 @@ func (c *Client) BulkMarkAsRead(ctx context.Context, reqEditors ...RequestEditor
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetChannelPreferences(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetChannelPreferencesRequest(c.Server)
+func (c *Client) ListApiKeys(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListApiKeysRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -1056,8 +1310,8 @@ func (c *Client) GetChannelPreferences(ctx context.Context, reqEditors ...Reques
 	return c.Client.Do(req)
 }
 
-func (c *Client) SetChannelPreferenceWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSetChannelPreferenceRequestWithBody(c.Server, contentType, body)
+func (c *Client) CreateApiKeyWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateApiKeyRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1068,8 +1322,8 @@ func (c *Client) SetChannelPreferenceWithBody(ctx context.Context, contentType s
 	return c.Client.Do(req)
 }
 
-func (c *Client) SetChannelPreference(ctx context.Context, body SetChannelPreferenceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSetChannelPreferenceRequest(c.Server, body)
+func (c *Client) CreateApiKey(ctx context.Context, body CreateApiKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateApiKeyRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1080,8 +1334,8 @@ func (c *Client) SetChannelPreference(ctx context.Context, body SetChannelPrefer
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetUnreadCount(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetUnreadCountRequest(c.Server)
+func (c *Client) DeleteApiKey(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteApiKeyRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
@@ -1092,8 +1346,8 @@ func (c *Client) GetUnreadCount(ctx context.Context, reqEditors ...RequestEditor
 	return c.Client.Do(req)
 }
 
-func (c *Client) NotificationsWebSocket(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewNotificationsWebSocketRequest(c.Server)
+func (c *Client) UpdateApiKeyExpiryWithBody(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateApiKeyExpiryRequestWithBody(c.Server, id, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1104,8 +1358,8 @@ func (c *Client) NotificationsWebSocket(ctx context.Context, reqEditors ...Reque
 	return c.Client.Do(req)
 }
 
-func (c *Client) DismissNotification(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDismissNotificationRequest(c.Server, id)
+func (c *Client) UpdateApiKeyExpiry(ctx context.Context, id int, body UpdateApiKeyExpiryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateApiKeyExpiryRequest(c.Server, id, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1116,8 +1370,8 @@ func (c *Client) DismissNotification(ctx context.Context, id int, reqEditors ...
 	return c.Client.Do(req)
 }
 
-func (c *Client) MarkAsRead(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewMarkAsReadRequest(c.Server, id)
+func (c *Client) RevokeApiKey(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRevokeApiKeyRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
@@ -1128,8 +1382,8 @@ func (c *Client) MarkAsRead(ctx context.Context, id int, reqEditors ...RequestEd
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetOAuthAuthorizeUrl(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetOAuthAuthorizeUrlRequest(c.Server)
+func (c *Client) LoginWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLoginRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1140,8 +1394,8 @@ func (c *Client) GetOAuthAuthorizeUrl(ctx context.Context, reqEditors ...Request
 	return c.Client.Do(req)
 }
 
-func (c *Client) ReloadOAuth(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewReloadOAuthRequest(c.Server)
+func (c *Client) Login(ctx context.Context, body LoginJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLoginRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1152,8 +1406,8 @@ func (c *Client) ReloadOAuth(ctx context.Context, reqEditors ...RequestEditorFn)
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetOAuthStatus(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetOAuthStatusRequest(c.Server)
+func (c *Client) Logout(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLogoutRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -1164,8 +1418,8 @@ func (c *Client) GetOAuthStatus(ctx context.Context, reqEditors ...RequestEditor
 	return c.Client.Do(req)
 }
 
-func (c *Client) SubmitOAuthCodeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSubmitOAuthCodeRequestWithBody(c.Server, contentType, body)
+func (c *Client) GetCurrentUser(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetCurrentUserRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -1176,8 +1430,8 @@ func (c *Client) SubmitOAuthCodeWithBody(ctx context.Context, contentType string
 	return c.Client.Do(req)
 }
 
-func (c *Client) SubmitOAuthCode(ctx context.Context, body SubmitOAuthCodeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSubmitOAuthCodeRequest(c.Server, body)
+func (c *Client) ListSessions(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListSessionsRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -1188,9 +1442,9 @@ func (c *Client) SubmitOAuthCode(ctx context.Context, body SubmitOAuthCodeJSONRe
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetOpenApiSpec(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetOpenApiSpecRequest(c.Server)
-	if err != nil {
+func (c *Client) RevokeSession(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRevokeSessionRequest(c.Server, id)
+	if err != nil {
 		return nil, err
 	}
 	req = req.WithContext(ctx)
@@ -1200,8 +1454,8 @@ func (c *Client) GetOpenApiSpec(ctx context.Context, reqEditors ...RequestEditor
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetProperties(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetPropertiesRequest(c.Server)
+func (c *Client) GetAllAutomationRules(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAllAutomationRulesRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -1212,8 +1466,8 @@ func (c *Client) GetProperties(ctx context.Context, reqEditors ...RequestEditorF
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdatePropertiesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdatePropertiesRequestWithBody(c.Server, contentType, body)
+func (c *Client) CreateAutomationRuleWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateAutomationRuleRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1224,8 +1478,8 @@ func (c *Client) UpdatePropertiesWithBody(ctx context.Context, contentType strin
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateProperties(ctx context.Context, body UpdatePropertiesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdatePropertiesRequest(c.Server, body)
+func (c *Client) CreateAutomationRule(ctx context.Context, body CreateAutomationRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateAutomationRuleRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1236,8 +1490,8 @@ func (c *Client) UpdateProperties(ctx context.Context, body UpdatePropertiesJSON
 	return c.Client.Do(req)
 }
 
-func (c *Client) PropertiesWebSocket(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPropertiesWebSocketRequest(c.Server)
+func (c *Client) DeleteAutomationRule(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteAutomationRuleRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
@@ -1248,8 +1502,8 @@ func (c *Client) PropertiesWebSocket(ctx context.Context, reqEditors ...RequestE
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetReadingsBetweenDates(ctx context.Context, params *GetReadingsBetweenDatesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetReadingsBetweenDatesRequest(c.Server, params)
+func (c *Client) GetAutomationRuleById(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAutomationRuleByIdRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
@@ -1260,8 +1514,8 @@ func (c *Client) GetReadingsBetweenDates(ctx context.Context, params *GetReading
 	return c.Client.Do(req)
 }
 
-func (c *Client) SubscribeCurrentReadings(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSubscribeCurrentReadingsRequest(c.Server)
+func (c *Client) UpdateAutomationRuleWithBody(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateAutomationRuleRequestWithBody(c.Server, id, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1272,8 +1526,8 @@ func (c *Client) SubscribeCurrentReadings(ctx context.Context, reqEditors ...Req
 	return c.Client.Do(req)
 }
 
-func (c *Client) ListRoles(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListRolesRequest(c.Server)
+func (c *Client) UpdateAutomationRule(ctx context.Context, id int, body UpdateAutomationRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateAutomationRuleRequest(c.Server, id, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1284,8 +1538,8 @@ func (c *Client) ListRoles(ctx context.Context, reqEditors ...RequestEditorFn) (
 	return c.Client.Do(req)
 }
 
-func (c *Client) ListPermissions(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListPermissionsRequest(c.Server)
+func (c *Client) ListDashboards(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListDashboardsRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -1296,8 +1550,8 @@ func (c *Client) ListPermissions(ctx context.Context, reqEditors ...RequestEdito
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetRolePermissions(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetRolePermissionsRequest(c.Server, id)
+func (c *Client) CreateDashboardWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateDashboardRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1308,8 +1562,8 @@ func (c *Client) GetRolePermissions(ctx context.Context, id int, reqEditors ...R
 	return c.Client.Do(req)
 }
 
-func (c *Client) AssignPermissionWithBody(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAssignPermissionRequestWithBody(c.Server, id, contentType, body)
+func (c *Client) CreateDashboard(ctx context.Context, body CreateDashboardJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateDashboardRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1320,8 +1574,8 @@ func (c *Client) AssignPermissionWithBody(ctx context.Context, id int, contentTy
 	return c.Client.Do(req)
 }
 
-func (c *Client) AssignPermission(ctx context.Context, id int, body AssignPermissionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAssignPermissionRequest(c.Server, id, body)
+func (c *Client) DeleteDashboard(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteDashboardRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
@@ -1332,8 +1586,8 @@ func (c *Client) AssignPermission(ctx context.Context, id int, body AssignPermis
 	return c.Client.Do(req)
 }
 
-func (c *Client) RemovePermission(ctx context.Context, id int, pid int, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewRemovePermissionRequest(c.Server, id, pid)
+func (c *Client) GetDashboard(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDashboardRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
@@ -1344,8 +1598,8 @@ func (c *Client) RemovePermission(ctx context.Context, id int, pid int, reqEdito
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetAllSensors(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetAllSensorsRequest(c.Server)
+func (c *Client) UpdateDashboardWithBody(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateDashboardRequestWithBody(c.Server, id, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1356,8 +1610,8 @@ func (c *Client) GetAllSensors(ctx context.Context, reqEditors ...RequestEditorF
 	return c.Client.Do(req)
 }
 
-func (c *Client) AddSensorWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAddSensorRequestWithBody(c.Server, contentType, body)
+func (c *Client) UpdateDashboard(ctx context.Context, id int, body UpdateDashboardJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateDashboardRequest(c.Server, id, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1368,8 +1622,8 @@ func (c *Client) AddSensorWithBody(ctx context.Context, contentType string, body
 	return c.Client.Do(req)
 }
 
-func (c *Client) AddSensor(ctx context.Context, body AddSensorJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAddSensorRequest(c.Server, body)
+func (c *Client) SetDefaultDashboard(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetDefaultDashboardRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
@@ -1380,8 +1634,8 @@ func (c *Client) AddSensor(ctx context.Context, body AddSensorJSONRequestBody, r
 	return c.Client.Do(req)
 }
 
-func (c *Client) ApproveSensor(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewApproveSensorRequest(c.Server, id)
+func (c *Client) ShareDashboardWithBody(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewShareDashboardRequestWithBody(c.Server, id, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1392,8 +1646,8 @@ func (c *Client) ApproveSensor(ctx context.Context, id int, reqEditors ...Reques
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetSensorCapabilities(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetSensorCapabilitiesRequest(c.Server, id)
+func (c *Client) ShareDashboard(ctx context.Context, id int, body ShareDashboardJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewShareDashboardRequest(c.Server, id, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1404,8 +1658,8 @@ func (c *Client) GetSensorCapabilities(ctx context.Context, id int, reqEditors .
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetSensorCommandHistory(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetSensorCommandHistoryRequest(c.Server, id)
+func (c *Client) ListDrivers(ctx context.Context, params *ListDriversParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListDriversRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1416,8 +1670,8 @@ func (c *Client) GetSensorCommandHistory(ctx context.Context, id int, reqEditors
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetSensorMeasurementTypes(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetSensorMeasurementTypesRequest(c.Server, id)
+func (c *Client) GetHealth(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetHealthRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -1428,8 +1682,8 @@ func (c *Client) GetSensorMeasurementTypes(ctx context.Context, id int, reqEdito
 	return c.Client.Do(req)
 }
 
-func (c *Client) CollectAllSensorReadings(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCollectAllSensorReadingsRequest(c.Server)
+func (c *Client) GetAllMeasurementTypes(ctx context.Context, params *GetAllMeasurementTypesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAllMeasurementTypesRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1440,8 +1694,8 @@ func (c *Client) CollectAllSensorReadings(ctx context.Context, reqEditors ...Req
 	return c.Client.Do(req)
 }
 
-func (c *Client) CollectFromSensor(ctx context.Context, sensorName string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCollectFromSensorRequest(c.Server, sensorName)
+func (c *Client) DeleteMeasurementTypeDownsampling(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteMeasurementTypeDownsamplingRequest(c.Server, name)
 	if err != nil {
 		return nil, err
 	}
@@ -1452,8 +1706,8 @@ func (c *Client) CollectFromSensor(ctx context.Context, sensorName string, reqEd
 	return c.Client.Do(req)
 }
 
-func (c *Client) DisableSensor(ctx context.Context, sensorName string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDisableSensorRequest(c.Server, sensorName)
+func (c *Client) SetMeasurementTypeDownsamplingWithBody(ctx context.Context, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetMeasurementTypeDownsamplingRequestWithBody(c.Server, name, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1464,8 +1718,8 @@ func (c *Client) DisableSensor(ctx context.Context, sensorName string, reqEditor
 	return c.Client.Do(req)
 }
 
-func (c *Client) DismissSensor(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDismissSensorRequest(c.Server, id)
+func (c *Client) SetMeasurementTypeDownsampling(ctx context.Context, name string, body SetMeasurementTypeDownsamplingJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetMeasurementTypeDownsamplingRequest(c.Server, name, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1476,8 +1730,8 @@ func (c *Client) DismissSensor(ctx context.Context, id int, reqEditors ...Reques
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetSensorsByDriver(ctx context.Context, driver string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetSensorsByDriverRequest(c.Server, driver)
+func (c *Client) ListMqttBrokers(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListMqttBrokersRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -1488,8 +1742,8 @@ func (c *Client) GetSensorsByDriver(ctx context.Context, driver string, reqEdito
 	return c.Client.Do(req)
 }
 
-func (c *Client) EnableSensor(ctx context.Context, sensorName string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewEnableSensorRequest(c.Server, sensorName)
+func (c *Client) CreateMqttBrokerWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateMqttBrokerRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1500,8 +1754,8 @@ func (c *Client) EnableSensor(ctx context.Context, sensorName string, reqEditors
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetSensorHealthHistoryByName(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetSensorHealthHistoryByNameRequest(c.Server, name)
+func (c *Client) CreateMqttBroker(ctx context.Context, body CreateMqttBrokerJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateMqttBrokerRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1512,8 +1766,8 @@ func (c *Client) GetSensorHealthHistoryByName(ctx context.Context, name string,
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetTotalReadingsPerSensor(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetTotalReadingsPerSensorRequest(c.Server)
+func (c *Client) DeleteMqttBroker(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteMqttBrokerRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
@@ -1524,8 +1778,8 @@ func (c *Client) GetTotalReadingsPerSensor(ctx context.Context, reqEditors ...Re
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetSensorsByStatus(ctx context.Context, status GetSensorsByStatusParamsStatus, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetSensorsByStatusRequest(c.Server, status)
+func (c *Client) GetMqttBroker(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetMqttBrokerRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
@@ -1536,8 +1790,8 @@ func (c *Client) GetSensorsByStatus(ctx context.Context, status GetSensorsByStat
 	return c.Client.Do(req)
 }
 
-func (c *Client) SubscribeAllSensors(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSubscribeAllSensorsRequest(c.Server)
+func (c *Client) UpdateMqttBrokerWithBody(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateMqttBrokerRequestWithBody(c.Server, id, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1548,8 +1802,8 @@ func (c *Client) SubscribeAllSensors(ctx context.Context, reqEditors ...RequestE
 	return c.Client.Do(req)
 }
 
-func (c *Client) SubscribeSensorsByDriver(ctx context.Context, driver string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSubscribeSensorsByDriverRequest(c.Server, driver)
+func (c *Client) UpdateMqttBroker(ctx context.Context, id int, body UpdateMqttBrokerJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateMqttBrokerRequest(c.Server, id, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1560,8 +1814,8 @@ func (c *Client) SubscribeSensorsByDriver(ctx context.Context, driver string, re
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateSensorByIdWithBody(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateSensorByIdRequestWithBody(c.Server, id, contentType, body)
+func (c *Client) GetMqttStats(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetMqttStatsRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -1572,8 +1826,8 @@ func (c *Client) UpdateSensorByIdWithBody(ctx context.Context, id int, contentTy
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateSensorById(ctx context.Context, id int, body UpdateSensorByIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateSensorByIdRequest(c.Server, id, body)
+func (c *Client) ListMqttSubscriptions(ctx context.Context, params *ListMqttSubscriptionsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListMqttSubscriptionsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1584,8 +1838,8 @@ func (c *Client) UpdateSensorById(ctx context.Context, id int, body UpdateSensor
 	return c.Client.Do(req)
 }
 
-func (c *Client) SendSensorCommandWithBody(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSendSensorCommandRequestWithBody(c.Server, id, contentType, body)
+func (c *Client) CreateMqttSubscriptionWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateMqttSubscriptionRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1596,8 +1850,8 @@ func (c *Client) SendSensorCommandWithBody(ctx context.Context, id int, contentT
 	return c.Client.Do(req)
 }
 
-func (c *Client) SendSensorCommand(ctx context.Context, id int, body SendSensorCommandJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSendSensorCommandRequest(c.Server, id, body)
+func (c *Client) CreateMqttSubscription(ctx context.Context, body CreateMqttSubscriptionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateMqttSubscriptionRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1608,8 +1862,8 @@ func (c *Client) SendSensorCommand(ctx context.Context, id int, body SendSensorC
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteSensorByName(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteSensorByNameRequest(c.Server, name)
+func (c *Client) DeleteMqttSubscription(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteMqttSubscriptionRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
@@ -1620,8 +1874,8 @@ func (c *Client) DeleteSensorByName(ctx context.Context, name string, reqEditors
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetSensorByName(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetSensorByNameRequest(c.Server, name)
+func (c *Client) GetMqttSubscription(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetMqttSubscriptionRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
@@ -1632,8 +1886,8 @@ func (c *Client) GetSensorByName(ctx context.Context, name string, reqEditors ..
 	return c.Client.Do(req)
 }
 
-func (c *Client) SensorExists(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSensorExistsRequest(c.Server, name)
+func (c *Client) UpdateMqttSubscriptionWithBody(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateMqttSubscriptionRequestWithBody(c.Server, id, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1644,8 +1898,8 @@ func (c *Client) SensorExists(ctx context.Context, name string, reqEditors ...Re
 	return c.Client.Do(req)
 }
 
-func (c *Client) ListUsers(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListUsersRequest(c.Server)
+func (c *Client) UpdateMqttSubscription(ctx context.Context, id int, body UpdateMqttSubscriptionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateMqttSubscriptionRequest(c.Server, id, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1656,8 +1910,8 @@ func (c *Client) ListUsers(ctx context.Context, reqEditors ...RequestEditorFn) (
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateUserWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateUserRequestWithBody(c.Server, contentType, body)
+func (c *Client) ListNotifications(ctx context.Context, params *ListNotificationsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListNotificationsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1668,8 +1922,8 @@ func (c *Client) CreateUserWithBody(ctx context.Context, contentType string, bod
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateUser(ctx context.Context, body CreateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateUserRequest(c.Server, body)
+func (c *Client) BulkDismiss(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewBulkDismissRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -1680,8 +1934,8 @@ func (c *Client) CreateUser(ctx context.Context, body CreateUserJSONRequestBody,
 	return c.Client.Do(req)
 }
 
-func (c *Client) ChangePasswordWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewChangePasswordRequestWithBody(c.Server, contentType, body)
+func (c *Client) BulkMarkAsRead(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewBulkMarkAsReadRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -1692,8 +1946,8 @@ func (c *Client) ChangePasswordWithBody(ctx context.Context, contentType string,
 	return c.Client.Do(req)
 }
 
-func (c *Client) ChangePassword(ctx context.Context, body ChangePasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewChangePasswordRequest(c.Server, body)
+func (c *Client) GetChannelPreferences(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetChannelPreferencesRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -1704,8 +1958,8 @@ func (c *Client) ChangePassword(ctx context.Context, body ChangePasswordJSONRequ
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteUser(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteUserRequest(c.Server, id)
+func (c *Client) SetChannelPreferenceWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetChannelPreferenceRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1716,8 +1970,8 @@ func (c *Client) DeleteUser(ctx context.Context, id int, reqEditors ...RequestEd
 	return c.Client.Do(req)
 }
 
-func (c *Client) SetMustChangePasswordWithBody(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSetMustChangePasswordRequestWithBody(c.Server, id, contentType, body)
+func (c *Client) SetChannelPreference(ctx context.Context, body SetChannelPreferenceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetChannelPreferenceRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1728,8 +1982,8 @@ func (c *Client) SetMustChangePasswordWithBody(ctx context.Context, id int, cont
 	return c.Client.Do(req)
 }
 
-func (c *Client) SetMustChangePassword(ctx context.Context, id int, body SetMustChangePasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSetMustChangePasswordRequest(c.Server, id, body)
+func (c *Client) DeletePushSubscription(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeletePushSubscriptionRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -1740,8 +1994,8 @@ func (c *Client) SetMustChangePassword(ctx context.Context, id int, body SetMust
 	return c.Client.Do(req)
 }
 
-func (c *Client) SetUserRolesWithBody(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSetUserRolesRequestWithBody(c.Server, id, contentType, body)
+func (c *Client) GetPushSubscription(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetPushSubscriptionRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -1752,8 +2006,8 @@ func (c *Client) SetUserRolesWithBody(ctx context.Context, id int, contentType s
 	return c.Client.Do(req)
 }
 
-func (c *Client) SetUserRoles(ctx context.Context, id int, body SetUserRolesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSetUserRolesRequest(c.Server, id, body)
+func (c *Client) SetPushSubscriptionWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetPushSubscriptionRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1764,1166 +2018,1132 @@ func (c *Client) SetUserRoles(ctx context.Context, id int, body SetUserRolesJSON
 	return c.Client.Do(req)
 }
 
-// NewGetAllAlertRulesRequest generates requests for GetAllAlertRules
-func NewGetAllAlertRulesRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) SetPushSubscription(ctx context.Context, body SetPushSubscriptionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetPushSubscriptionRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/alerts")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) TestPushSubscription(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTestPushSubscriptionRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewCreateAlertRuleRequest calls the generic CreateAlertRule builder with application/json body
-func NewCreateAlertRuleRequest(server string, body CreateAlertRuleJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) DeleteQuietHours(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteQuietHoursRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCreateAlertRuleRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewCreateAlertRuleRequestWithBody generates requests for CreateAlertRule with any type of body
-func NewCreateAlertRuleRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) GetQuietHours(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetQuietHoursRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/alerts")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) SetQuietHoursWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetQuietHoursRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetAlertRulesBySensorIdRequest generates requests for GetAlertRulesBySensorId
-func NewGetAlertRulesBySensorIdRequest(server string, sensorId int) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "sensorId", sensorId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "integer", Format: ""})
+func (c *Client) SetQuietHours(ctx context.Context, body SetQuietHoursJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetQuietHoursRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) GetUnreadCount(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUnreadCountRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/alerts/sensor/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) ListWebhookTargets(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListWebhookTargetsRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetAlertHistoryRequest generates requests for GetAlertHistory
-func NewGetAlertHistoryRequest(server string, sensorId int, params *GetAlertHistoryParams) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "sensorId", sensorId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "integer", Format: ""})
+func (c *Client) CreateWebhookTargetWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateWebhookTargetRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	operationPath := fmt.Sprintf("/alerts/sensor/%s/history", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) CreateWebhookTarget(ctx context.Context, body CreateWebhookTargetJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateWebhookTargetRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.Limit != nil {
-
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "limit", *params.Limit, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: ""}); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
-	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewDeleteAlertRuleRequest generates requests for DeleteAlertRule
-func NewDeleteAlertRuleRequest(server string, id int) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "id", id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "integer", Format: ""})
+func (c *Client) DeleteWebhookTarget(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteWebhookTargetRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) UpdateWebhookTargetWithBody(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateWebhookTargetRequestWithBody(c.Server, id, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/alerts/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) UpdateWebhookTarget(ctx context.Context, id int, body UpdateWebhookTargetJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateWebhookTargetRequest(c.Server, id, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetAlertRuleByIdRequest generates requests for GetAlertRuleById
-func NewGetAlertRuleByIdRequest(server string, id int) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "id", id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "integer", Format: ""})
+func (c *Client) GetWebhookDeliveries(ctx context.Context, id int, params *GetWebhookDeliveriesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetWebhookDeliveriesRequest(c.Server, id, params)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) TestWebhookTarget(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTestWebhookTargetRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/alerts/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) NotificationsWebSocket(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewNotificationsWebSocketRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewUpdateAlertRuleRequest calls the generic UpdateAlertRule builder with application/json body
-func NewUpdateAlertRuleRequest(server string, id int, body UpdateAlertRuleJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) DismissNotification(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDismissNotificationRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewUpdateAlertRuleRequestWithBody(server, id, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewUpdateAlertRuleRequestWithBody generates requests for UpdateAlertRule with any type of body
-func NewUpdateAlertRuleRequestWithBody(server string, id int, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "id", id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "integer", Format: ""})
+func (c *Client) MarkAsRead(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewMarkAsReadRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) GetOAuthAuthorizeUrl(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetOAuthAuthorizeUrlRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/alerts/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) ReloadOAuth(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReloadOAuthRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewListApiKeysRequest generates requests for ListApiKeys
-func NewListApiKeysRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) GetOAuthStatus(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetOAuthStatusRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api-keys")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) SubmitOAuthCodeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSubmitOAuthCodeRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewCreateApiKeyRequest calls the generic CreateApiKey builder with application/json body
-func NewCreateApiKeyRequest(server string, body CreateApiKeyJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) SubmitOAuthCode(ctx context.Context, body SubmitOAuthCodeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSubmitOAuthCodeRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCreateApiKeyRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewCreateApiKeyRequestWithBody generates requests for CreateApiKey with any type of body
-func NewCreateApiKeyRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) GetOpenApiSpec(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetOpenApiSpecRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api-keys")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetProperties(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetPropertiesRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewDeleteApiKeyRequest generates requests for DeleteApiKey
-func NewDeleteApiKeyRequest(server string, id int) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "id", id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "integer", Format: ""})
+func (c *Client) UpdatePropertiesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdatePropertiesRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	operationPath := fmt.Sprintf("/api-keys/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) UpdateProperties(ctx context.Context, body UpdatePropertiesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdatePropertiesRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewUpdateApiKeyExpiryRequest calls the generic UpdateApiKeyExpiry builder with application/json body
-func NewUpdateApiKeyExpiryRequest(server string, id int, body UpdateApiKeyExpiryJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) PropertiesWebSocket(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPropertiesWebSocketRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewUpdateApiKeyExpiryRequestWithBody(server, id, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewUpdateApiKeyExpiryRequestWithBody generates requests for UpdateApiKeyExpiry with any type of body
-func NewUpdateApiKeyExpiryRequestWithBody(server string, id int, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "id", id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "integer", Format: ""})
+func (c *Client) GetReadingsBetweenDates(ctx context.Context, params *GetReadingsBetweenDatesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetReadingsBetweenDatesRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) SubscribeCurrentReadings(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSubscribeCurrentReadingsRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api-keys/%s/expiry", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) ListRoles(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListRolesRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("PATCH", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewRevokeApiKeyRequest generates requests for RevokeApiKey
-func NewRevokeApiKeyRequest(server string, id int) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "id", id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "integer", Format: ""})
+func (c *Client) ListPermissions(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListPermissionsRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) GetRolePermissions(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetRolePermissionsRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api-keys/%s/revoke", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) AssignPermissionWithBody(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAssignPermissionRequestWithBody(c.Server, id, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewLoginRequest calls the generic Login builder with application/json body
-func NewLoginRequest(server string, body LoginJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) AssignPermission(ctx context.Context, id int, body AssignPermissionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAssignPermissionRequest(c.Server, id, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewLoginRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewLoginRequestWithBody generates requests for Login with any type of body
-func NewLoginRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) RemovePermission(ctx context.Context, id int, pid int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRemovePermissionRequest(c.Server, id, pid)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/auth/login")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetAllScenes(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAllScenesRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewLogoutRequest generates requests for Logout
-func NewLogoutRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) CreateSceneWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateSceneRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/auth/logout")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) CreateScene(ctx context.Context, body CreateSceneJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateSceneRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetCurrentUserRequest generates requests for GetCurrentUser
-func NewGetCurrentUserRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) DeleteScene(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteSceneRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/auth/me")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetSceneById(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSceneByIdRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewListSessionsRequest generates requests for ListSessions
-func NewListSessionsRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) UpdateSceneWithBody(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateSceneRequestWithBody(c.Server, id, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/auth/sessions")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) UpdateScene(ctx context.Context, id int, body UpdateSceneJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateSceneRequest(c.Server, id, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewRevokeSessionRequest generates requests for RevokeSession
-func NewRevokeSessionRequest(server string, id int64) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "id", id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "integer", Format: "int64"})
+func (c *Client) ActivateScene(ctx context.Context, id int, params *ActivateSceneParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewActivateSceneRequest(c.Server, id, params)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) GetAllSchedules(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAllSchedulesRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/auth/sessions/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) CreateScheduleWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateScheduleRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewListDashboardsRequest generates requests for ListDashboards
-func NewListDashboardsRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) CreateSchedule(ctx context.Context, body CreateScheduleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateScheduleRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/dashboards")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetHolidayMode(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetHolidayModeRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewCreateDashboardRequest calls the generic CreateDashboard builder with application/json body
-func NewCreateDashboardRequest(server string, body CreateDashboardJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) SetHolidayModeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetHolidayModeRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCreateDashboardRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewCreateDashboardRequestWithBody generates requests for CreateDashboard with any type of body
-func NewCreateDashboardRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) SetHolidayMode(ctx context.Context, body SetHolidayModeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetHolidayModeRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/dashboards")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) DeleteSchedule(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteScheduleRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewDeleteDashboardRequest generates requests for DeleteDashboard
-func NewDeleteDashboardRequest(server string, id int) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "id", id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "integer", Format: ""})
+func (c *Client) GetScheduleById(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetScheduleByIdRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) UpdateScheduleWithBody(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateScheduleRequestWithBody(c.Server, id, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/dashboards/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) UpdateSchedule(ctx context.Context, id int, body UpdateScheduleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateScheduleRequest(c.Server, id, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetDashboardRequest generates requests for GetDashboard
-func NewGetDashboardRequest(server string, id int) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "id", id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "integer", Format: ""})
+func (c *Client) GetAllSensors(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAllSensorsRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) AddSensorWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddSensorRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/dashboards/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) AddSensor(ctx context.Context, body AddSensorJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddSensorRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewUpdateDashboardRequest calls the generic UpdateDashboard builder with application/json body
-func NewUpdateDashboardRequest(server string, id int, body UpdateDashboardJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) ApproveSensor(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApproveSensorRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewUpdateDashboardRequestWithBody(server, id, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewUpdateDashboardRequestWithBody generates requests for UpdateDashboard with any type of body
-func NewUpdateDashboardRequestWithBody(server string, id int, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "id", id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "integer", Format: ""})
+func (c *Client) GetSensorCapabilities(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSensorCapabilitiesRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) GetSensorCommandHistory(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSensorCommandHistoryRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/dashboards/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetSensorDesiredStates(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSensorDesiredStatesRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewSetDefaultDashboardRequest generates requests for SetDefaultDashboard
-func NewSetDefaultDashboardRequest(server string, id int) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "id", id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "integer", Format: ""})
+func (c *Client) DeleteSensorDesiredState(ctx context.Context, id int, property string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteSensorDesiredStateRequest(c.Server, id, property)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) SetSensorDesiredStateWithBody(ctx context.Context, id int, property string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetSensorDesiredStateRequestWithBody(c.Server, id, property, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/dashboards/%s/default", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) SetSensorDesiredState(ctx context.Context, id int, property string, body SetSensorDesiredStateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetSensorDesiredStateRequest(c.Server, id, property, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("PUT", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewShareDashboardRequest calls the generic ShareDashboard builder with application/json body
-func NewShareDashboardRequest(server string, id int, body ShareDashboardJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) GetSensorMeasurementTypes(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSensorMeasurementTypesRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewShareDashboardRequestWithBody(server, id, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewShareDashboardRequestWithBody generates requests for ShareDashboard with any type of body
-func NewShareDashboardRequestWithBody(server string, id int, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "id", id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "integer", Format: ""})
+func (c *Client) CollectAllSensorReadings(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCollectAllSensorReadingsRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) CollectFromSensor(ctx context.Context, sensorName string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCollectFromSensorRequest(c.Server, sensorName)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/dashboards/%s/share", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) DisableSensor(ctx context.Context, sensorName string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDisableSensorRequest(c.Server, sensorName)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+func (c *Client) DismissSensor(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDismissSensorRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewListDriversRequest generates requests for ListDrivers
-func NewListDriversRequest(server string, params *ListDriversParams) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) GetSensorsByDriver(ctx context.Context, driver string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSensorsByDriverRequest(c.Server, driver)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/drivers")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) EnableSensor(ctx context.Context, sensorName string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEnableSensorRequest(c.Server, sensorName)
 	if err != nil {
 		return nil, err
 	}
-
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.Type != nil {
-
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "type", *params.Type, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
-	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetHealthRequest generates requests for GetHealth
-func NewGetHealthRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) GetSensorHealthHistoryByName(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSensorHealthHistoryByNameRequest(c.Server, name)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/health")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetTotalReadingsPerSensor(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTotalReadingsPerSensorRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetAllMeasurementTypesRequest generates requests for GetAllMeasurementTypes
-func NewGetAllMeasurementTypesRequest(server string, params *GetAllMeasurementTypesParams) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) GetSensorsByStatus(ctx context.Context, status GetSensorsByStatusParamsStatus, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSensorsByStatusRequest(c.Server, status)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/measurement-types")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) SubscribeAllSensors(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSubscribeAllSensorsRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.HasReadings != nil {
-
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "has_readings", *params.HasReadings, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "boolean", Format: ""}); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) SubscribeSensorsByDriver(ctx context.Context, driver string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSubscribeSensorsByDriverRequest(c.Server, driver)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewListMqttBrokersRequest generates requests for ListMqttBrokers
-func NewListMqttBrokersRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) UpdateSensorByIdWithBody(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateSensorByIdRequestWithBody(c.Server, id, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/mqtt/brokers")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) UpdateSensorById(ctx context.Context, id int, body UpdateSensorByIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateSensorByIdRequest(c.Server, id, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewCreateMqttBrokerRequest calls the generic CreateMqttBroker builder with application/json body
-func NewCreateMqttBrokerRequest(server string, body CreateMqttBrokerJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) SendSensorCommandWithBody(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSendSensorCommandRequestWithBody(c.Server, id, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCreateMqttBrokerRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewCreateMqttBrokerRequestWithBody generates requests for CreateMqttBroker with any type of body
-func NewCreateMqttBrokerRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) SendSensorCommand(ctx context.Context, id int, body SendSensorCommandJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSendSensorCommandRequest(c.Server, id, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/mqtt/brokers")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) DeleteSensorByName(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteSensorByNameRequest(c.Server, name)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewDeleteMqttBrokerRequest generates requests for DeleteMqttBroker
-func NewDeleteMqttBrokerRequest(server string, id int) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "id", id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "integer", Format: ""})
+func (c *Client) GetSensorByName(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSensorByNameRequest(c.Server, name)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) SensorExists(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSensorExistsRequest(c.Server, name)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/mqtt/brokers/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) ListUsers(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListUsersRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetMqttBrokerRequest generates requests for GetMqttBroker
-func NewGetMqttBrokerRequest(server string, id int) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "id", id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "integer", Format: ""})
+func (c *Client) CreateUserWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateUserRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) CreateUser(ctx context.Context, body CreateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateUserRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/mqtt/brokers/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) ChangePasswordWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewChangePasswordRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) ChangePassword(ctx context.Context, body ChangePasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewChangePasswordRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	return req, nil
+func (c *Client) DeleteUser(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteUserRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewUpdateMqttBrokerRequest calls the generic UpdateMqttBroker builder with application/json body
-func NewUpdateMqttBrokerRequest(server string, id int, body UpdateMqttBrokerJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) SetMustChangePasswordWithBody(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetMustChangePasswordRequestWithBody(c.Server, id, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewUpdateMqttBrokerRequestWithBody(server, id, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewUpdateMqttBrokerRequestWithBody generates requests for UpdateMqttBroker with any type of body
-func NewUpdateMqttBrokerRequestWithBody(server string, id int, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
+func (c *Client) SetMustChangePassword(ctx context.Context, id int, body SetMustChangePasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetMustChangePasswordRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	var pathParam0 string
+func (c *Client) SetUserRolesWithBody(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetUserRolesRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "id", id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "integer", Format: ""})
+func (c *Client) SetUserRoles(ctx context.Context, id int, body SetUserRolesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetUserRolesRequest(c.Server, id, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewGetAllAlertRulesRequest generates requests for GetAllAlertRules
+func NewGetAllAlertRulesRequest(server string) (*http.Request, error) {
+	var err error
 
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/mqtt/brokers/%s", pathParam0)
+	operationPath := fmt.Sprintf("/alerts")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2933,18 +3153,27 @@ func NewUpdateMqttBrokerRequestWithBody(server string, id int, contentType strin
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewGetMqttStatsRequest generates requests for GetMqttStats
-func NewGetMqttStatsRequest(server string) (*http.Request, error) {
+// NewCreateAlertRuleRequest calls the generic CreateAlertRule builder with application/json body
+func NewCreateAlertRuleRequest(server string, body CreateAlertRuleJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateAlertRuleRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateAlertRuleRequestWithBody generates requests for CreateAlertRule with any type of body
+func NewCreateAlertRuleRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -2952,7 +3181,7 @@ func NewGetMqttStatsRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/mqtt/stats")
+	operationPath := fmt.Sprintf("/alerts")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2962,16 +3191,18 @@ func NewGetMqttStatsRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewListMqttSubscriptionsRequest generates requests for ListMqttSubscriptions
-func NewListMqttSubscriptionsRequest(server string, params *ListMqttSubscriptionsParams) (*http.Request, error) {
+// NewGetAllCompoundAlertRulesRequest generates requests for GetAllCompoundAlertRules
+func NewGetAllCompoundAlertRulesRequest(server string) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -2979,7 +3210,7 @@ func NewListMqttSubscriptionsRequest(server string, params *ListMqttSubscription
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/mqtt/subscriptions")
+	operationPath := fmt.Sprintf("/alerts/compound")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2989,28 +3220,6 @@ func NewListMqttSubscriptionsRequest(server string, params *ListMqttSubscription
 		return nil, err
 	}
 
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.BrokerId != nil {
-
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "broker_id", *params.BrokerId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: ""}); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
-	}
-
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -3019,19 +3228,19 @@ func NewListMqttSubscriptionsRequest(server string, params *ListMqttSubscription
 	return req, nil
 }
 
-// NewCreateMqttSubscriptionRequest calls the generic CreateMqttSubscription builder with application/json body
-func NewCreateMqttSubscriptionRequest(server string, body CreateMqttSubscriptionJSONRequestBody) (*http.Request, error) {
+// NewCreateCompoundAlertRuleRequest calls the generic CreateCompoundAlertRule builder with application/json body
+func NewCreateCompoundAlertRuleRequest(server string, body CreateCompoundAlertRuleJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewCreateMqttSubscriptionRequestWithBody(server, "application/json", bodyReader)
+	return NewCreateCompoundAlertRuleRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewCreateMqttSubscriptionRequestWithBody generates requests for CreateMqttSubscription with any type of body
-func NewCreateMqttSubscriptionRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewCreateCompoundAlertRuleRequestWithBody generates requests for CreateCompoundAlertRule with any type of body
+func NewCreateCompoundAlertRuleRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -3039,7 +3248,7 @@ func NewCreateMqttSubscriptionRequestWithBody(server string, contentType string,
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/mqtt/subscriptions")
+	operationPath := fmt.Sprintf("/alerts/compound")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3059,8 +3268,8 @@ func NewCreateMqttSubscriptionRequestWithBody(server string, contentType string,
 	return req, nil
 }
 
-// NewDeleteMqttSubscriptionRequest generates requests for DeleteMqttSubscription
-func NewDeleteMqttSubscriptionRequest(server string, id int) (*http.Request, error) {
+// NewDeleteCompoundAlertRuleRequest generates requests for DeleteCompoundAlertRule
+func NewDeleteCompoundAlertRuleRequest(server string, id int) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -3075,7 +3284,7 @@ func NewDeleteMqttSubscriptionRequest(server string, id int) (*http.Request, err
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/mqtt/subscriptions/%s", pathParam0)
+	operationPath := fmt.Sprintf("/alerts/compound/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3093,8 +3302,8 @@ func NewDeleteMqttSubscriptionRequest(server string, id int) (*http.Request, err
 	return req, nil
 }
 
-// NewGetMqttSubscriptionRequest generates requests for GetMqttSubscription
-func NewGetMqttSubscriptionRequest(server string, id int) (*http.Request, error) {
+// NewGetCompoundAlertRuleByIdRequest generates requests for GetCompoundAlertRuleById
+func NewGetCompoundAlertRuleByIdRequest(server string, id int) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -3109,7 +3318,7 @@ func NewGetMqttSubscriptionRequest(server string, id int) (*http.Request, error)
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/mqtt/subscriptions/%s", pathParam0)
+	operationPath := fmt.Sprintf("/alerts/compound/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3127,19 +3336,19 @@ func NewGetMqttSubscriptionRequest(server string, id int) (*http.Request, error)
 	return req, nil
 }
 
-// NewUpdateMqttSubscriptionRequest calls the generic UpdateMqttSubscription builder with application/json body
-func NewUpdateMqttSubscriptionRequest(server string, id int, body UpdateMqttSubscriptionJSONRequestBody) (*http.Request, error) {
+// NewUpdateCompoundAlertRuleRequest calls the generic UpdateCompoundAlertRule builder with application/json body
+func NewUpdateCompoundAlertRuleRequest(server string, id int, body UpdateCompoundAlertRuleJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewUpdateMqttSubscriptionRequestWithBody(server, id, "application/json", bodyReader)
+	return NewUpdateCompoundAlertRuleRequestWithBody(server, id, "application/json", bodyReader)
 }
 
-// NewUpdateMqttSubscriptionRequestWithBody generates requests for UpdateMqttSubscription with any type of body
-func NewUpdateMqttSubscriptionRequestWithBody(server string, id int, contentType string, body io.Reader) (*http.Request, error) {
+// NewUpdateCompoundAlertRuleRequestWithBody generates requests for UpdateCompoundAlertRule with any type of body
+func NewUpdateCompoundAlertRuleRequestWithBody(server string, id int, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -3154,7 +3363,7 @@ func NewUpdateMqttSubscriptionRequestWithBody(server string, id int, contentType
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/mqtt/subscriptions/%s", pathParam0)
+	operationPath := fmt.Sprintf("/alerts/compound/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3174,16 +3383,23 @@ func NewUpdateMqttSubscriptionRequestWithBody(server string, id int, contentType
 	return req, nil
 }
 
-// NewListNotificationsRequest generates requests for ListNotifications
-func NewListNotificationsRequest(server string, params *ListNotificationsParams) (*http.Request, error) {
+// NewAcknowledgeCompoundAlertRuleRequest generates requests for AcknowledgeCompoundAlertRule
+func NewAcknowledgeCompoundAlertRuleRequest(server string, id int) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "id", id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "integer", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/notifications")
+	operationPath := fmt.Sprintf("/alerts/compound/%s/acknowledge", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3193,61 +3409,7 @@ func NewListNotificationsRequest(server string, params *ListNotificationsParams)
 		return nil, err
 	}
 
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.Limit != nil {
-
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "limit", *params.Limit, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: ""}); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Offset != nil {
-
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "offset", *params.Offset, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: ""}); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.IncludeDismissed != nil {
-
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "include_dismissed", *params.IncludeDismissed, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
-	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -3255,16 +3417,23 @@ func NewListNotificationsRequest(server string, params *ListNotificationsParams)
 	return req, nil
 }
 
-// NewBulkDismissRequest generates requests for BulkDismiss
-func NewBulkDismissRequest(server string) (*http.Request, error) {
+// NewDeleteCompoundAlertEscalationPolicyRequest generates requests for DeleteCompoundAlertEscalationPolicy
+func NewDeleteCompoundAlertEscalationPolicyRequest(server string, id int) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "id", id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "integer", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/notifications/bulk/dismiss")
+	operationPath := fmt.Sprintf("/alerts/compound/%s/escalation", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3274,7 +3443,7 @@ func NewBulkDismissRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -3282,16 +3451,23 @@ func NewBulkDismissRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewBulkMarkAsReadRequest generates requests for BulkMarkAsRead
-func NewBulkMarkAsReadRequest(server string) (*http.Request, error) {
+// NewGetCompoundAlertEscalationPolicyRequest generates requests for GetCompoundAlertEscalationPolicy
+func NewGetCompoundAlertEscalationPolicyRequest(server string, id int) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "id", id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "integer", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/notifications/bulk/read")
+	operationPath := fmt.Sprintf("/alerts/compound/%s/escalation", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3301,7 +3477,7 @@ func NewBulkMarkAsReadRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -3309,16 +3485,34 @@ func NewBulkMarkAsReadRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewGetChannelPreferencesRequest generates requests for GetChannelPreferences
-func NewGetChannelPreferencesRequest(server string) (*http.Request, error) {
+// NewSetCompoundAlertEscalationPolicyRequest calls the generic SetCompoundAlertEscalationPolicy builder with application/json body
+func NewSetCompoundAlertEscalationPolicyRequest(server string, id int, body SetCompoundAlertEscalationPolicyJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSetCompoundAlertEscalationPolicyRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewSetCompoundAlertEscalationPolicyRequestWithBody generates requests for SetCompoundAlertEscalationPolicy with any type of body
+func NewSetCompoundAlertEscalationPolicyRequestWithBody(server string, id int, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "id", id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "integer", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/notifications/preferences")
+	operationPath := fmt.Sprintf("/alerts/compound/%s/escalation", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3328,35 +3522,33 @@ func NewGetChannelPreferencesRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewSetChannelPreferenceRequest calls the generic SetChannelPreference builder with application/json body
-func NewSetChannelPreferenceRequest(server string, body SetChannelPreferenceJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+// NewDeleteCompoundAlertRecipientsRequest generates requests for DeleteCompoundAlertRecipients
+func NewDeleteCompoundAlertRecipientsRequest(server string, id int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "id", id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "integer", Format: ""})
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewSetChannelPreferenceRequestWithBody(server, "application/json", bodyReader)
-}
-
-// NewSetChannelPreferenceRequestWithBody generates requests for SetChannelPreference with any type of body
-func NewSetChannelPreferenceRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
 
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/notifications/preferences")
+	operationPath := fmt.Sprintf("/alerts/compound/%s/recipients", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3366,26 +3558,31 @@ func NewSetChannelPreferenceRequestWithBody(server string, contentType string, b
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewGetUnreadCountRequest generates requests for GetUnreadCount
-func NewGetUnreadCountRequest(server string) (*http.Request, error) {
+// NewGetCompoundAlertRecipientsRequest generates requests for GetCompoundAlertRecipients
+func NewGetCompoundAlertRecipientsRequest(server string, id int) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "id", id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "integer", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/notifications/unread-count")
+	operationPath := fmt.Sprintf("/alerts/compound/%s/recipients", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3403,16 +3600,34 @@ func NewGetUnreadCountRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewNotificationsWebSocketRequest generates requests for NotificationsWebSocket
-func NewNotificationsWebSocketRequest(server string) (*http.Request, error) {
+// NewSetCompoundAlertRecipientsRequest calls the generic SetCompoundAlertRecipients builder with application/json body
+func NewSetCompoundAlertRecipientsRequest(server string, id int, body SetCompoundAlertRecipientsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSetCompoundAlertRecipientsRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewSetCompoundAlertRecipientsRequestWithBody generates requests for SetCompoundAlertRecipients with any type of body
+func NewSetCompoundAlertRecipientsRequestWithBody(server string, id int, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "id", id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "integer", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/notifications/ws")
+	operationPath := fmt.Sprintf("/alerts/compound/%s/recipients", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3422,16 +3637,18 @@ func NewNotificationsWebSocketRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewDismissNotificationRequest generates requests for DismissNotification
-func NewDismissNotificationRequest(server string, id int) (*http.Request, error) {
+// NewUnsnoozeCompoundAlertRuleRequest generates requests for UnsnoozeCompoundAlertRule
+func NewUnsnoozeCompoundAlertRuleRequest(server string, id int) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -3446,7 +3663,7 @@ func NewDismissNotificationRequest(server string, id int) (*http.Request, error)
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/notifications/%s/dismiss", pathParam0)
+	operationPath := fmt.Sprintf("/alerts/compound/%s/snooze", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3456,7 +3673,7 @@ func NewDismissNotificationRequest(server string, id int) (*http.Request, error)
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -3464,8 +3681,19 @@ func NewDismissNotificationRequest(server string, id int) (*http.Request, error)
 	return req, nil
 }
 
-// NewMarkAsReadRequest generates requests for MarkAsRead
-func NewMarkAsReadRequest(server string, id int) (*http.Request, error) {
+// NewSnoozeCompoundAlertRuleRequest calls the generic SnoozeCompoundAlertRule builder with application/json body
+func NewSnoozeCompoundAlertRuleRequest(server string, id int, body SnoozeCompoundAlertRuleJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSnoozeCompoundAlertRuleRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewSnoozeCompoundAlertRuleRequestWithBody generates requests for SnoozeCompoundAlertRule with any type of body
+func NewSnoozeCompoundAlertRuleRequestWithBody(server string, id int, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -3480,7 +3708,7 @@ func NewMarkAsReadRequest(server string, id int) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/notifications/%s/read", pathParam0)
+	operationPath := fmt.Sprintf("/alerts/compound/%s/snooze", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3490,16 +3718,18 @@ func NewMarkAsReadRequest(server string, id int) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewGetOAuthAuthorizeUrlRequest generates requests for GetOAuthAuthorizeUrl
-func NewGetOAuthAuthorizeUrlRequest(server string) (*http.Request, error) {
+// NewGetAllContactGroupsRequest generates requests for GetAllContactGroups
+func NewGetAllContactGroupsRequest(server string) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -3507,7 +3737,7 @@ func NewGetOAuthAuthorizeUrlRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/oauth/authorize")
+	operationPath := fmt.Sprintf("/alerts/contact-groups")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3525,8 +3755,19 @@ func NewGetOAuthAuthorizeUrlRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewReloadOAuthRequest generates requests for ReloadOAuth
-func NewReloadOAuthRequest(server string) (*http.Request, error) {
+// NewCreateContactGroupRequest calls the generic CreateContactGroup builder with application/json body
+func NewCreateContactGroupRequest(server string, body CreateContactGroupJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateContactGroupRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateContactGroupRequestWithBody generates requests for CreateContactGroup with any type of body
+func NewCreateContactGroupRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -3534,7 +3775,7 @@ func NewReloadOAuthRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/oauth/reload")
+	operationPath := fmt.Sprintf("/alerts/contact-groups")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3544,24 +3785,33 @@ func NewReloadOAuthRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewGetOAuthStatusRequest generates requests for GetOAuthStatus
-func NewGetOAuthStatusRequest(server string) (*http.Request, error) {
+// NewDeleteContactGroupRequest generates requests for DeleteContactGroup
+func NewDeleteContactGroupRequest(server string, id int) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "id", id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "integer", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/oauth/status")
+	operationPath := fmt.Sprintf("/alerts/contact-groups/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3571,7 +3821,7 @@ func NewGetOAuthStatusRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -3579,27 +3829,34 @@ func NewGetOAuthStatusRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewSubmitOAuthCodeRequest calls the generic SubmitOAuthCode builder with application/json body
-func NewSubmitOAuthCodeRequest(server string, body SubmitOAuthCodeJSONRequestBody) (*http.Request, error) {
+// NewUpdateContactGroupRequest calls the generic UpdateContactGroup builder with application/json body
+func NewUpdateContactGroupRequest(server string, id int, body UpdateContactGroupJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewSubmitOAuthCodeRequestWithBody(server, "application/json", bodyReader)
+	return NewUpdateContactGroupRequestWithBody(server, id, "application/json", bodyReader)
 }
 
-// NewSubmitOAuthCodeRequestWithBody generates requests for SubmitOAuthCode with any type of body
-func NewSubmitOAuthCodeRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewUpdateContactGroupRequestWithBody generates requests for UpdateContactGroup with any type of body
+func NewUpdateContactGroupRequestWithBody(server string, id int, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "id", id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "integer", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/oauth/submit-code")
+	operationPath := fmt.Sprintf("/alerts/contact-groups/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3609,7 +3866,7 @@ func NewSubmitOAuthCodeRequestWithBody(server string, contentType string, body i
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -3619,16 +3876,23 @@ func NewSubmitOAuthCodeRequestWithBody(server string, contentType string, body i
 	return req, nil
 }
 
-// NewGetOpenApiSpecRequest generates requests for GetOpenApiSpec
-func NewGetOpenApiSpecRequest(server string) (*http.Request, error) {
+// NewAcknowledgeAlertHistoryEntryRequest generates requests for AcknowledgeAlertHistoryEntry
+func NewAcknowledgeAlertHistoryEntryRequest(server string, id int) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "id", id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "integer", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/openapi.yaml")
+	operationPath := fmt.Sprintf("/alerts/history/%s/acknowledge", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3638,7 +3902,7 @@ func NewGetOpenApiSpecRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -3646,16 +3910,23 @@ func NewGetOpenApiSpecRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewGetPropertiesRequest generates requests for GetProperties
-func NewGetPropertiesRequest(server string) (*http.Request, error) {
+// NewGetAlertRulesBySensorIdRequest generates requests for GetAlertRulesBySensorId
+func NewGetAlertRulesBySensorIdRequest(server string, sensorId int) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "sensorId", sensorId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "integer", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/properties")
+	operationPath := fmt.Sprintf("/alerts/sensor/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3673,27 +3944,23 @@ func NewGetPropertiesRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewUpdatePropertiesRequest calls the generic UpdateProperties builder with application/json body
-func NewUpdatePropertiesRequest(server string, body UpdatePropertiesJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+// NewGetAlertHistoryRequest generates requests for GetAlertHistory
+func NewGetAlertHistoryRequest(server string, sensorId int, params *GetAlertHistoryParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "sensorId", sensorId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "integer", Format: ""})
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewUpdatePropertiesRequestWithBody(server, "application/json", bodyReader)
-}
-
-// NewUpdatePropertiesRequestWithBody generates requests for UpdateProperties with any type of body
-func NewUpdatePropertiesRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
 
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/properties")
+	operationPath := fmt.Sprintf("/alerts/sensor/%s/history", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3703,18 +3970,38 @@ func NewUpdatePropertiesRequestWithBody(server string, contentType string, body
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "limit", *params.Limit, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: ""}); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewPropertiesWebSocketRequest generates requests for PropertiesWebSocket
-func NewPropertiesWebSocketRequest(server string) (*http.Request, error) {
+// NewGetAllSilenceWindowsRequest generates requests for GetAllSilenceWindows
+func NewGetAllSilenceWindowsRequest(server string) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -3722,7 +4009,7 @@ func NewPropertiesWebSocketRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/properties/ws")
+	operationPath := fmt.Sprintf("/alerts/silences")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3740,8 +4027,19 @@ func NewPropertiesWebSocketRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewGetReadingsBetweenDatesRequest generates requests for GetReadingsBetweenDates
-func NewGetReadingsBetweenDatesRequest(server string, params *GetReadingsBetweenDatesParams) (*http.Request, error) {
+// NewCreateSilenceWindowRequest calls the generic CreateSilenceWindow builder with application/json body
+func NewCreateSilenceWindowRequest(server string, body CreateSilenceWindowJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateSilenceWindowRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateSilenceWindowRequestWithBody generates requests for CreateSilenceWindow with any type of body
+func NewCreateSilenceWindowRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -3749,7 +4047,7 @@ func NewGetReadingsBetweenDatesRequest(server string, params *GetReadingsBetween
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/readings/between")
+	operationPath := fmt.Sprintf("/alerts/silences")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3759,118 +4057,33 @@ func NewGetReadingsBetweenDatesRequest(server string, params *GetReadingsBetween
 		return nil, err
 	}
 
-	if params != nil {
-		queryValues := queryURL.Query()
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "start", params.Start, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
+	req.Header.Add("Content-Type", contentType)
 
-		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "end", params.End, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
-		if params.Type != nil {
-
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "type", *params.Type, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Sensor != nil {
-
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "sensor", *params.Sensor, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Aggregation != nil {
-
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "aggregation", *params.Aggregation, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.AggregationFunction != nil {
-
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "aggregation_function", *params.AggregationFunction, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	return req, nil
+}
 
-		}
+// NewDeleteSilenceWindowRequest generates requests for DeleteSilenceWindow
+func NewDeleteSilenceWindowRequest(server string, id int) (*http.Request, error) {
+	var err error
 
-		queryURL.RawQuery = queryValues.Encode()
-	}
+	var pathParam0 string
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "id", id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "integer", Format: ""})
 	if err != nil {
 		return nil, err
 	}
 
-	return req, nil
-}
-
-// NewSubscribeCurrentReadingsRequest generates requests for SubscribeCurrentReadings
-func NewSubscribeCurrentReadingsRequest(server string) (*http.Request, error) {
-	var err error
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/readings/ws/current")
+	operationPath := fmt.Sprintf("/alerts/silences/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3880,7 +4093,7 @@ func NewSubscribeCurrentReadingsRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -3888,16 +4101,34 @@ func NewSubscribeCurrentReadingsRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewListRolesRequest generates requests for ListRoles
-func NewListRolesRequest(server string) (*http.Request, error) {
+// NewUpdateSilenceWindowRequest calls the generic UpdateSilenceWindow builder with application/json body
+func NewUpdateSilenceWindowRequest(server string, id int, body UpdateSilenceWindowJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateSilenceWindowRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewUpdateSilenceWindowRequestWithBody generates requests for UpdateSilenceWindow with any type of body
+func NewUpdateSilenceWindowRequestWithBody(server string, id int, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "id", id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "integer", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/roles")
+	operationPath := fmt.Sprintf("/alerts/silences/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3907,24 +4138,33 @@ func NewListRolesRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewListPermissionsRequest generates requests for ListPermissions
-func NewListPermissionsRequest(server string) (*http.Request, error) {
+// NewDeleteAlertRuleRequest generates requests for DeleteAlertRule
+func NewDeleteAlertRuleRequest(server string, id int) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "id", id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "integer", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/roles/permissions")
+	operationPath := fmt.Sprintf("/alerts/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3934,7 +4174,7 @@ func NewListPermissionsRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -3942,8 +4182,8 @@ func NewListPermissionsRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewGetRolePermissionsRequest generates requests for GetRolePermissions
-func NewGetRolePermissionsRequest(server string, id int) (*http.Request, error) {
+// NewGetAlertRuleByIdRequest generates requests for GetAlertRuleById
+func NewGetAlertRuleByIdRequest(server string, id int) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -3958,7 +4198,7 @@ func NewGetRolePermissionsRequest(server string, id int) (*http.Request, error)
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/roles/%s/permissions", pathParam0)
+	operationPath := fmt.Sprintf("/alerts/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -3976,19 +4216,19 @@ func NewGetRolePermissionsRequest(server string, id int) (*http.Request, error)
 	return req, nil
 }
 
-// NewAssignPermissionRequest calls the generic AssignPermission builder with application/json body
-func NewAssignPermissionRequest(server string, id int, body AssignPermissionJSONRequestBody) (*http.Request, error) {
+// NewUpdateAlertRuleRequest calls the generic UpdateAlertRule builder with application/json body
+func NewUpdateAlertRuleRequest(server string, id int, body UpdateAlertRuleJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewAssignPermissionRequestWithBody(server, id, "application/json", bodyReader)
+	return NewUpdateAlertRuleRequestWithBody(server, id, "application/json", bodyReader)
 }
 
-// NewAssignPermissionRequestWithBody generates requests for AssignPermission with any type of body
-func NewAssignPermissionRequestWithBody(server string, id int, contentType string, body io.Reader) (*http.Request, error) {
+// NewUpdateAlertRuleRequestWithBody generates requests for UpdateAlertRule with any type of body
+func NewUpdateAlertRuleRequestWithBody(server string, id int, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -4003,7 +4243,7 @@ func NewAssignPermissionRequestWithBody(server string, id int, contentType strin
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/roles/%s/permissions", pathParam0)
+	operationPath := fmt.Sprintf("/alerts/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4013,7 +4253,7 @@ func NewAssignPermissionRequestWithBody(server string, id int, contentType strin
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -4023,8 +4263,8 @@ func NewAssignPermissionRequestWithBody(server string, id int, contentType strin
 	return req, nil
 }
 
-// NewRemovePermissionRequest generates requests for RemovePermission
-func NewRemovePermissionRequest(server string, id int, pid int) (*http.Request, error) {
+// NewAcknowledgeAlertRuleRequest generates requests for AcknowledgeAlertRule
+func NewAcknowledgeAlertRuleRequest(server string, id int) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -4034,19 +4274,12 @@ func NewRemovePermissionRequest(server string, id int, pid int) (*http.Request,
 		return nil, err
 	}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "pid", pid, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "integer", Format: ""})
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/roles/%s/permissions/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/alerts/%s/acknowledge", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4056,7 +4289,7 @@ func NewRemovePermissionRequest(server string, id int, pid int) (*http.Request,
 		return nil, err
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -4064,16 +4297,23 @@ func NewRemovePermissionRequest(server string, id int, pid int) (*http.Request,
 	return req, nil
 }
 
-// NewGetAllSensorsRequest generates requests for GetAllSensors
-func NewGetAllSensorsRequest(server string) (*http.Request, error) {
+// NewDeleteAlertEscalationPolicyRequest generates requests for DeleteAlertEscalationPolicy
+func NewDeleteAlertEscalationPolicyRequest(server string, id int) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "id", id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "integer", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/sensors")
+	operationPath := fmt.Sprintf("/alerts/%s/escalation", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4083,7 +4323,7 @@ func NewGetAllSensorsRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -4091,27 +4331,23 @@ func NewGetAllSensorsRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewAddSensorRequest calls the generic AddSensor builder with application/json body
-func NewAddSensorRequest(server string, body AddSensorJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+// NewGetAlertEscalationPolicyRequest generates requests for GetAlertEscalationPolicy
+func NewGetAlertEscalationPolicyRequest(server string, id int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "id", id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "integer", Format: ""})
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewAddSensorRequestWithBody(server, "application/json", bodyReader)
-}
-
-// NewAddSensorRequestWithBody generates requests for AddSensor with any type of body
-func NewAddSensorRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
 
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/sensors")
+	operationPath := fmt.Sprintf("/alerts/%s/escalation", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4121,18 +4357,27 @@ func NewAddSensorRequestWithBody(server string, contentType string, body io.Read
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewApproveSensorRequest generates requests for ApproveSensor
-func NewApproveSensorRequest(server string, id int) (*http.Request, error) {
+// NewSetAlertEscalationPolicyRequest calls the generic SetAlertEscalationPolicy builder with application/json body
+func NewSetAlertEscalationPolicyRequest(server string, id int, body SetAlertEscalationPolicyJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSetAlertEscalationPolicyRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewSetAlertEscalationPolicyRequestWithBody generates requests for SetAlertEscalationPolicy with any type of body
+func NewSetAlertEscalationPolicyRequestWithBody(server string, id int, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -4147,7 +4392,7 @@ func NewApproveSensorRequest(server string, id int) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/sensors/approve/%s", pathParam0)
+	operationPath := fmt.Sprintf("/alerts/%s/escalation", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4157,16 +4402,18 @@ func NewApproveSensorRequest(server string, id int) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewGetSensorCapabilitiesRequest generates requests for GetSensorCapabilities
-func NewGetSensorCapabilitiesRequest(server string, id int) (*http.Request, error) {
+// NewDeleteAlertRecipientsRequest generates requests for DeleteAlertRecipients
+func NewDeleteAlertRecipientsRequest(server string, id int) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -4181,7 +4428,7 @@ func NewGetSensorCapabilitiesRequest(server string, id int) (*http.Request, erro
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/sensors/by-id/%s/capabilities", pathParam0)
+	operationPath := fmt.Sprintf("/alerts/%s/recipients", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4191,7 +4438,7 @@ func NewGetSensorCapabilitiesRequest(server string, id int) (*http.Request, erro
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -4199,8 +4446,8 @@ func NewGetSensorCapabilitiesRequest(server string, id int) (*http.Request, erro
 	return req, nil
 }
 
-// NewGetSensorCommandHistoryRequest generates requests for GetSensorCommandHistory
-func NewGetSensorCommandHistoryRequest(server string, id int) (*http.Request, error) {
+// NewGetAlertRecipientsRequest generates requests for GetAlertRecipients
+func NewGetAlertRecipientsRequest(server string, id int) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -4215,7 +4462,7 @@ func NewGetSensorCommandHistoryRequest(server string, id int) (*http.Request, er
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/sensors/by-id/%s/commands", pathParam0)
+	operationPath := fmt.Sprintf("/alerts/%s/recipients", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4233,8 +4480,19 @@ func NewGetSensorCommandHistoryRequest(server string, id int) (*http.Request, er
 	return req, nil
 }
 
-// NewGetSensorMeasurementTypesRequest generates requests for GetSensorMeasurementTypes
-func NewGetSensorMeasurementTypesRequest(server string, id int) (*http.Request, error) {
+// NewSetAlertRecipientsRequest calls the generic SetAlertRecipients builder with application/json body
+func NewSetAlertRecipientsRequest(server string, id int, body SetAlertRecipientsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSetAlertRecipientsRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewSetAlertRecipientsRequestWithBody generates requests for SetAlertRecipients with any type of body
+func NewSetAlertRecipientsRequestWithBody(server string, id int, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -4249,7 +4507,7 @@ func NewGetSensorMeasurementTypesRequest(server string, id int) (*http.Request,
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/sensors/by-id/%s/measurement-types", pathParam0)
+	operationPath := fmt.Sprintf("/alerts/%s/recipients", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4259,24 +4517,33 @@ func NewGetSensorMeasurementTypesRequest(server string, id int) (*http.Request,
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewCollectAllSensorReadingsRequest generates requests for CollectAllSensorReadings
-func NewCollectAllSensorReadingsRequest(server string) (*http.Request, error) {
+// NewUnsnoozeAlertRuleRequest generates requests for UnsnoozeAlertRule
+func NewUnsnoozeAlertRuleRequest(server string, id int) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "id", id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "integer", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/sensors/collect")
+	operationPath := fmt.Sprintf("/alerts/%s/snooze", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4286,7 +4553,7 @@ func NewCollectAllSensorReadingsRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -4294,13 +4561,24 @@ func NewCollectAllSensorReadingsRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewCollectFromSensorRequest generates requests for CollectFromSensor
-func NewCollectFromSensorRequest(server string, sensorName string) (*http.Request, error) {
+// NewSnoozeAlertRuleRequest calls the generic SnoozeAlertRule builder with application/json body
+func NewSnoozeAlertRuleRequest(server string, id int, body SnoozeAlertRuleJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSnoozeAlertRuleRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewSnoozeAlertRuleRequestWithBody generates requests for SnoozeAlertRule with any type of body
+func NewSnoozeAlertRuleRequestWithBody(server string, id int, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "sensorName", sensorName, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "id", id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "integer", Format: ""})
 	if err != nil {
 		return nil, err
 	}
@@ -4310,7 +4588,7 @@ func NewCollectFromSensorRequest(server string, sensorName string) (*http.Reques
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/sensors/collect/%s", pathParam0)
+	operationPath := fmt.Sprintf("/alerts/%s/snooze", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4320,31 +4598,64 @@ func NewCollectFromSensorRequest(server string, sensorName string) (*http.Reques
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewDisableSensorRequest generates requests for DisableSensor
-func NewDisableSensorRequest(server string, sensorName string) (*http.Request, error) {
+// NewListApiKeysRequest generates requests for ListApiKeys
+func NewListApiKeysRequest(server string) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "sensorName", sensorName, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	operationPath := fmt.Sprintf("/api-keys")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
+	return req, nil
+}
+
+// NewCreateApiKeyRequest calls the generic CreateApiKey builder with application/json body
+func NewCreateApiKeyRequest(server string, body CreateApiKeyJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateApiKeyRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateApiKeyRequestWithBody generates requests for CreateApiKey with any type of body
+func NewCreateApiKeyRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/sensors/disable/%s", pathParam0)
+	operationPath := fmt.Sprintf("/api-keys")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4354,16 +4665,18 @@ func NewDisableSensorRequest(server string, sensorName string) (*http.Request, e
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewDismissSensorRequest generates requests for DismissSensor
-func NewDismissSensorRequest(server string, id int) (*http.Request, error) {
+// NewDeleteApiKeyRequest generates requests for DeleteApiKey
+func NewDeleteApiKeyRequest(server string, id int) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -4378,7 +4691,7 @@ func NewDismissSensorRequest(server string, id int) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/sensors/dismiss/%s", pathParam0)
+	operationPath := fmt.Sprintf("/api-keys/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4388,7 +4701,7 @@ func NewDismissSensorRequest(server string, id int) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -4396,13 +4709,24 @@ func NewDismissSensorRequest(server string, id int) (*http.Request, error) {
 	return req, nil
 }
 
-// NewGetSensorsByDriverRequest generates requests for GetSensorsByDriver
-func NewGetSensorsByDriverRequest(server string, driver string) (*http.Request, error) {
+// NewUpdateApiKeyExpiryRequest calls the generic UpdateApiKeyExpiry builder with application/json body
+func NewUpdateApiKeyExpiryRequest(server string, id int, body UpdateApiKeyExpiryJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateApiKeyExpiryRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewUpdateApiKeyExpiryRequestWithBody generates requests for UpdateApiKeyExpiry with any type of body
+func NewUpdateApiKeyExpiryRequestWithBody(server string, id int, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "driver", driver, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "id", id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "integer", Format: ""})
 	if err != nil {
 		return nil, err
 	}
@@ -4412,7 +4736,7 @@ func NewGetSensorsByDriverRequest(server string, driver string) (*http.Request,
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/sensors/driver/%s", pathParam0)
+	operationPath := fmt.Sprintf("/api-keys/%s/expiry", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4422,21 +4746,23 @@ func NewGetSensorsByDriverRequest(server string, driver string) (*http.Request,
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewEnableSensorRequest generates requests for EnableSensor
-func NewEnableSensorRequest(server string, sensorName string) (*http.Request, error) {
+// NewRevokeApiKeyRequest generates requests for RevokeApiKey
+func NewRevokeApiKeyRequest(server string, id int) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "sensorName", sensorName, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "id", id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "integer", Format: ""})
 	if err != nil {
 		return nil, err
 	}
@@ -4446,7 +4772,7 @@ func NewEnableSensorRequest(server string, sensorName string) (*http.Request, er
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/sensors/enable/%s", pathParam0)
+	operationPath := fmt.Sprintf("/api-keys/%s/revoke", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4464,23 +4790,27 @@ func NewEnableSensorRequest(server string, sensorName string) (*http.Request, er
 	return req, nil
 }
 
-// NewGetSensorHealthHistoryByNameRequest generates requests for GetSensorHealthHistoryByName
-func NewGetSensorHealthHistoryByNameRequest(server string, name string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "name", name, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+// NewLoginRequest calls the generic Login builder with application/json body
+func NewLoginRequest(server string, body LoginJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
+	bodyReader = bytes.NewReader(buf)
+	return NewLoginRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewLoginRequestWithBody generates requests for Login with any type of body
+func NewLoginRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/sensors/health/%s", pathParam0)
+	operationPath := fmt.Sprintf("/auth/login")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4490,16 +4820,18 @@ func NewGetSensorHealthHistoryByNameRequest(server string, name string) (*http.R
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewGetTotalReadingsPerSensorRequest generates requests for GetTotalReadingsPerSensor
-func NewGetTotalReadingsPerSensorRequest(server string) (*http.Request, error) {
+// NewLogoutRequest generates requests for Logout
+func NewLogoutRequest(server string) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -4507,7 +4839,7 @@ func NewGetTotalReadingsPerSensorRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/sensors/stats/total-readings")
+	operationPath := fmt.Sprintf("/auth/logout")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4517,7 +4849,7 @@ func NewGetTotalReadingsPerSensorRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -4525,23 +4857,16 @@ func NewGetTotalReadingsPerSensorRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewGetSensorsByStatusRequest generates requests for GetSensorsByStatus
-func NewGetSensorsByStatusRequest(server string, status GetSensorsByStatusParamsStatus) (*http.Request, error) {
+// NewGetCurrentUserRequest generates requests for GetCurrentUser
+func NewGetCurrentUserRequest(server string) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "status", status, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/sensors/status/%s", pathParam0)
+	operationPath := fmt.Sprintf("/auth/me")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4559,8 +4884,8 @@ func NewGetSensorsByStatusRequest(server string, status GetSensorsByStatusParams
 	return req, nil
 }
 
-// NewSubscribeAllSensorsRequest generates requests for SubscribeAllSensors
-func NewSubscribeAllSensorsRequest(server string) (*http.Request, error) {
+// NewListSessionsRequest generates requests for ListSessions
+func NewListSessionsRequest(server string) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -4568,7 +4893,7 @@ func NewSubscribeAllSensorsRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/sensors/ws")
+	operationPath := fmt.Sprintf("/auth/sessions")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4586,13 +4911,13 @@ func NewSubscribeAllSensorsRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewSubscribeSensorsByDriverRequest generates requests for SubscribeSensorsByDriver
-func NewSubscribeSensorsByDriverRequest(server string, driver string) (*http.Request, error) {
+// NewRevokeSessionRequest generates requests for RevokeSession
+func NewRevokeSessionRequest(server string, id int64) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "driver", driver, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "id", id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "integer", Format: "int64"})
 	if err != nil {
 		return nil, err
 	}
@@ -4602,7 +4927,7 @@ func NewSubscribeSensorsByDriverRequest(server string, driver string) (*http.Req
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/sensors/ws/%s", pathParam0)
+	operationPath := fmt.Sprintf("/auth/sessions/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4612,7 +4937,7 @@ func NewSubscribeSensorsByDriverRequest(server string, driver string) (*http.Req
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -4620,34 +4945,16 @@ func NewSubscribeSensorsByDriverRequest(server string, driver string) (*http.Req
 	return req, nil
 }
 
-// NewUpdateSensorByIdRequest calls the generic UpdateSensorById builder with application/json body
-func NewUpdateSensorByIdRequest(server string, id int, body UpdateSensorByIdJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewUpdateSensorByIdRequestWithBody(server, id, "application/json", bodyReader)
-}
-
-// NewUpdateSensorByIdRequestWithBody generates requests for UpdateSensorById with any type of body
-func NewUpdateSensorByIdRequestWithBody(server string, id int, contentType string, body io.Reader) (*http.Request, error) {
+// NewGetAllAutomationRulesRequest generates requests for GetAllAutomationRules
+func NewGetAllAutomationRulesRequest(server string) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "id", id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "integer", Format: ""})
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/sensors/%s", pathParam0)
+	operationPath := fmt.Sprintf("/automations")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4657,44 +4964,35 @@ func NewUpdateSensorByIdRequestWithBody(server string, id int, contentType strin
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewSendSensorCommandRequest calls the generic SendSensorCommand builder with application/json body
-func NewSendSensorCommandRequest(server string, id int, body SendSensorCommandJSONRequestBody) (*http.Request, error) {
+// NewCreateAutomationRuleRequest calls the generic CreateAutomationRule builder with application/json body
+func NewCreateAutomationRuleRequest(server string, body CreateAutomationRuleJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewSendSensorCommandRequestWithBody(server, id, "application/json", bodyReader)
+	return NewCreateAutomationRuleRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewSendSensorCommandRequestWithBody generates requests for SendSensorCommand with any type of body
-func NewSendSensorCommandRequestWithBody(server string, id int, contentType string, body io.Reader) (*http.Request, error) {
+// NewCreateAutomationRuleRequestWithBody generates requests for CreateAutomationRule with any type of body
+func NewCreateAutomationRuleRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "id", id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "integer", Format: ""})
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/sensors/%s/command", pathParam0)
+	operationPath := fmt.Sprintf("/automations")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4714,13 +5012,13 @@ func NewSendSensorCommandRequestWithBody(server string, id int, contentType stri
 	return req, nil
 }
 
-// NewDeleteSensorByNameRequest generates requests for DeleteSensorByName
-func NewDeleteSensorByNameRequest(server string, name string) (*http.Request, error) {
+// NewDeleteAutomationRuleRequest generates requests for DeleteAutomationRule
+func NewDeleteAutomationRuleRequest(server string, id int) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "name", name, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "id", id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "integer", Format: ""})
 	if err != nil {
 		return nil, err
 	}
@@ -4730,7 +5028,7 @@ func NewDeleteSensorByNameRequest(server string, name string) (*http.Request, er
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/sensors/%s", pathParam0)
+	operationPath := fmt.Sprintf("/automations/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4748,13 +5046,13 @@ func NewDeleteSensorByNameRequest(server string, name string) (*http.Request, er
 	return req, nil
 }
 
-// NewGetSensorByNameRequest generates requests for GetSensorByName
-func NewGetSensorByNameRequest(server string, name string) (*http.Request, error) {
+// NewGetAutomationRuleByIdRequest generates requests for GetAutomationRuleById
+func NewGetAutomationRuleByIdRequest(server string, id int) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "name", name, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "id", id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "integer", Format: ""})
 	if err != nil {
 		return nil, err
 	}
@@ -4764,7 +5062,7 @@ func NewGetSensorByNameRequest(server string, name string) (*http.Request, error
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/sensors/%s", pathParam0)
+	operationPath := fmt.Sprintf("/automations/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4782,13 +5080,24 @@ func NewGetSensorByNameRequest(server string, name string) (*http.Request, error
 	return req, nil
 }
 
-// NewSensorExistsRequest generates requests for SensorExists
-func NewSensorExistsRequest(server string, name string) (*http.Request, error) {
+// NewUpdateAutomationRuleRequest calls the generic UpdateAutomationRule builder with application/json body
+func NewUpdateAutomationRuleRequest(server string, id int, body UpdateAutomationRuleJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateAutomationRuleRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewUpdateAutomationRuleRequestWithBody generates requests for UpdateAutomationRule with any type of body
+func NewUpdateAutomationRuleRequestWithBody(server string, id int, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "name", name, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "id", id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "integer", Format: ""})
 	if err != nil {
 		return nil, err
 	}
@@ -4798,7 +5107,7 @@ func NewSensorExistsRequest(server string, name string) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/sensors/%s", pathParam0)
+	operationPath := fmt.Sprintf("/automations/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4808,16 +5117,18 @@ func NewSensorExistsRequest(server string, name string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("HEAD", queryURL.String(), nil)
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewListUsersRequest generates requests for ListUsers
-func NewListUsersRequest(server string) (*http.Request, error) {
+// NewListDashboardsRequest generates requests for ListDashboards
+func NewListDashboardsRequest(server string) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -4825,7 +5136,7 @@ func NewListUsersRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/users")
+	operationPath := fmt.Sprintf("/dashboards")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4843,19 +5154,19 @@ func NewListUsersRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewCreateUserRequest calls the generic CreateUser builder with application/json body
-func NewCreateUserRequest(server string, body CreateUserJSONRequestBody) (*http.Request, error) {
+// NewCreateDashboardRequest calls the generic CreateDashboard builder with application/json body
+func NewCreateDashboardRequest(server string, body CreateDashboardJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewCreateUserRequestWithBody(server, "application/json", bodyReader)
+	return NewCreateDashboardRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewCreateUserRequestWithBody generates requests for CreateUser with any type of body
-func NewCreateUserRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewCreateDashboardRequestWithBody generates requests for CreateDashboard with any type of body
+func NewCreateDashboardRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -4863,7 +5174,7 @@ func NewCreateUserRequestWithBody(server string, contentType string, body io.Rea
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/users")
+	operationPath := fmt.Sprintf("/dashboards")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4883,27 +5194,23 @@ func NewCreateUserRequestWithBody(server string, contentType string, body io.Rea
 	return req, nil
 }
 
-// NewChangePasswordRequest calls the generic ChangePassword builder with application/json body
-func NewChangePasswordRequest(server string, body ChangePasswordJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+// NewDeleteDashboardRequest generates requests for DeleteDashboard
+func NewDeleteDashboardRequest(server string, id int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "id", id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "integer", Format: ""})
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewChangePasswordRequestWithBody(server, "application/json", bodyReader)
-}
-
-// NewChangePasswordRequestWithBody generates requests for ChangePassword with any type of body
-func NewChangePasswordRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
 
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/users/password")
+	operationPath := fmt.Sprintf("/dashboards/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4913,18 +5220,16 @@ func NewChangePasswordRequestWithBody(server string, contentType string, body io
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewDeleteUserRequest generates requests for DeleteUser
-func NewDeleteUserRequest(server string, id int) (*http.Request, error) {
+// NewGetDashboardRequest generates requests for GetDashboard
+func NewGetDashboardRequest(server string, id int) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -4939,7 +5244,7 @@ func NewDeleteUserRequest(server string, id int) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/users/%s", pathParam0)
+	operationPath := fmt.Sprintf("/dashboards/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4949,7 +5254,7 @@ func NewDeleteUserRequest(server string, id int) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -4957,19 +5262,19 @@ func NewDeleteUserRequest(server string, id int) (*http.Request, error) {
 	return req, nil
 }
 
-// NewSetMustChangePasswordRequest calls the generic SetMustChangePassword builder with application/json body
-func NewSetMustChangePasswordRequest(server string, id int, body SetMustChangePasswordJSONRequestBody) (*http.Request, error) {
+// NewUpdateDashboardRequest calls the generic UpdateDashboard builder with application/json body
+func NewUpdateDashboardRequest(server string, id int, body UpdateDashboardJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewSetMustChangePasswordRequestWithBody(server, id, "application/json", bodyReader)
+	return NewUpdateDashboardRequestWithBody(server, id, "application/json", bodyReader)
 }
 
-// NewSetMustChangePasswordRequestWithBody generates requests for SetMustChangePassword with any type of body
-func NewSetMustChangePasswordRequestWithBody(server string, id int, contentType string, body io.Reader) (*http.Request, error) {
+// NewUpdateDashboardRequestWithBody generates requests for UpdateDashboard with any type of body
+func NewUpdateDashboardRequestWithBody(server string, id int, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -4984,7 +5289,7 @@ func NewSetMustChangePasswordRequestWithBody(server string, id int, contentType
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/users/%s/must_change", pathParam0)
+	operationPath := fmt.Sprintf("/dashboards/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -4994,7 +5299,7 @@ func NewSetMustChangePasswordRequestWithBody(server string, id int, contentType
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -5004,19 +5309,53 @@ func NewSetMustChangePasswordRequestWithBody(server string, id int, contentType
 	return req, nil
 }
 
-// NewSetUserRolesRequest calls the generic SetUserRoles builder with application/json body
-func NewSetUserRolesRequest(server string, id int, body SetUserRolesJSONRequestBody) (*http.Request, error) {
+// NewSetDefaultDashboardRequest generates requests for SetDefaultDashboard
+func NewSetDefaultDashboardRequest(server string, id int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "id", id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "integer", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/dashboards/%s/default", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewShareDashboardRequest calls the generic ShareDashboard builder with application/json body
+func NewShareDashboardRequest(server string, id int, body ShareDashboardJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewSetUserRolesRequestWithBody(server, id, "application/json", bodyReader)
+	return NewShareDashboardRequestWithBody(server, id, "application/json", bodyReader)
 }
 
-// NewSetUserRolesRequestWithBody generates requests for SetUserRoles with any type of body
-func NewSetUserRolesRequestWithBody(server string, id int, contentType string, body io.Reader) (*http.Request, error) {
+// NewShareDashboardRequestWithBody generates requests for ShareDashboard with any type of body
+func NewShareDashboardRequestWithBody(server string, id int, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -5031,7 +5370,7 @@ func NewSetUserRolesRequestWithBody(server string, id int, contentType string, b
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/users/%s/roles", pathParam0)
+	operationPath := fmt.Sprintf("/dashboards/%s/share", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5051,3608 +5390,12500 @@ func NewSetUserRolesRequestWithBody(server string, id int, contentType string, b
 	return req, nil
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
-	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
-	}
-	return nil
-}
-
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
-}
+// NewListDriversRequest generates requests for ListDrivers
+func NewListDriversRequest(server string, params *ListDriversParams) (*http.Request, error) {
+	var err error
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
-}
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
+	operationPath := fmt.Sprintf("/drivers")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-}
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// GetAllAlertRulesWithResponse request
-	GetAllAlertRulesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAllAlertRulesResp, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// CreateAlertRuleWithBodyWithResponse request with any body
-	CreateAlertRuleWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateAlertRuleResp, error)
+	if params != nil {
+		queryValues := queryURL.Query()
 
-	CreateAlertRuleWithResponse(ctx context.Context, body CreateAlertRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateAlertRuleResp, error)
+		if params.Type != nil {
 
-	// GetAlertRulesBySensorIdWithResponse request
-	GetAlertRulesBySensorIdWithResponse(ctx context.Context, sensorId int, reqEditors ...RequestEditorFn) (*GetAlertRulesBySensorIdResp, error)
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "type", *params.Type, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	// GetAlertHistoryWithResponse request
-	GetAlertHistoryWithResponse(ctx context.Context, sensorId int, params *GetAlertHistoryParams, reqEditors ...RequestEditorFn) (*GetAlertHistoryResp, error)
+		}
 
-	// DeleteAlertRuleWithResponse request
-	DeleteAlertRuleWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*DeleteAlertRuleResp, error)
+		queryURL.RawQuery = queryValues.Encode()
+	}
 
-	// GetAlertRuleByIdWithResponse request
-	GetAlertRuleByIdWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*GetAlertRuleByIdResp, error)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// UpdateAlertRuleWithBodyWithResponse request with any body
-	UpdateAlertRuleWithBodyWithResponse(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateAlertRuleResp, error)
+	return req, nil
+}
 
-	UpdateAlertRuleWithResponse(ctx context.Context, id int, body UpdateAlertRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateAlertRuleResp, error)
+// NewGetHealthRequest generates requests for GetHealth
+func NewGetHealthRequest(server string) (*http.Request, error) {
+	var err error
 
-	// ListApiKeysWithResponse request
-	ListApiKeysWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListApiKeysResp, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// CreateApiKeyWithBodyWithResponse request with any body
-	CreateApiKeyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateApiKeyResp, error)
+	operationPath := fmt.Sprintf("/health")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	CreateApiKeyWithResponse(ctx context.Context, body CreateApiKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateApiKeyResp, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// DeleteApiKeyWithResponse request
-	DeleteApiKeyWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*DeleteApiKeyResp, error)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// UpdateApiKeyExpiryWithBodyWithResponse request with any body
-	UpdateApiKeyExpiryWithBodyWithResponse(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateApiKeyExpiryResp, error)
+	return req, nil
+}
 
-	UpdateApiKeyExpiryWithResponse(ctx context.Context, id int, body UpdateApiKeyExpiryJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateApiKeyExpiryResp, error)
+// NewGetAllMeasurementTypesRequest generates requests for GetAllMeasurementTypes
+func NewGetAllMeasurementTypesRequest(server string, params *GetAllMeasurementTypesParams) (*http.Request, error) {
+	var err error
 
-	// RevokeApiKeyWithResponse request
-	RevokeApiKeyWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*RevokeApiKeyResp, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// LoginWithBodyWithResponse request with any body
-	LoginWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LoginResp, error)
+	operationPath := fmt.Sprintf("/measurement-types")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	LoginWithResponse(ctx context.Context, body LoginJSONRequestBody, reqEditors ...RequestEditorFn) (*LoginResp, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// LogoutWithResponse request
-	LogoutWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*LogoutResp, error)
+	if params != nil {
+		queryValues := queryURL.Query()
 
-	// GetCurrentUserWithResponse request
-	GetCurrentUserWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetCurrentUserResp, error)
+		if params.HasReadings != nil {
 
-	// ListSessionsWithResponse request
-	ListSessionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListSessionsResp, error)
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "has_readings", *params.HasReadings, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "boolean", Format: ""}); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	// RevokeSessionWithResponse request
-	RevokeSessionWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*RevokeSessionResp, error)
+		}
 
-	// ListDashboardsWithResponse request
-	ListDashboardsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListDashboardsResp, error)
+		queryURL.RawQuery = queryValues.Encode()
+	}
 
-	// CreateDashboardWithBodyWithResponse request with any body
-	CreateDashboardWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDashboardResp, error)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	CreateDashboardWithResponse(ctx context.Context, body CreateDashboardJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateDashboardResp, error)
+	return req, nil
+}
 
-	// DeleteDashboardWithResponse request
-	DeleteDashboardWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*DeleteDashboardResp, error)
+// NewDeleteMeasurementTypeDownsamplingRequest generates requests for DeleteMeasurementTypeDownsampling
+func NewDeleteMeasurementTypeDownsamplingRequest(server string, name string) (*http.Request, error) {
+	var err error
 
-	// GetDashboardWithResponse request
-	GetDashboardWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*GetDashboardResp, error)
+	var pathParam0 string
 
-	// UpdateDashboardWithBodyWithResponse request with any body
-	UpdateDashboardWithBodyWithResponse(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateDashboardResp, error)
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "name", name, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
 
-	UpdateDashboardWithResponse(ctx context.Context, id int, body UpdateDashboardJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateDashboardResp, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// SetDefaultDashboardWithResponse request
-	SetDefaultDashboardWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*SetDefaultDashboardResp, error)
+	operationPath := fmt.Sprintf("/measurement-types/%s/downsampling", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// ShareDashboardWithBodyWithResponse request with any body
-	ShareDashboardWithBodyWithResponse(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ShareDashboardResp, error)
-
-	ShareDashboardWithResponse(ctx context.Context, id int, body ShareDashboardJSONRequestBody, reqEditors ...RequestEditorFn) (*ShareDashboardResp, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// ListDriversWithResponse request
-	ListDriversWithResponse(ctx context.Context, params *ListDriversParams, reqEditors ...RequestEditorFn) (*ListDriversResp, error)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetHealthWithResponse request
-	GetHealthWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetHealthResp, error)
+	return req, nil
+}
 
-	// GetAllMeasurementTypesWithResponse request
-	GetAllMeasurementTypesWithResponse(ctx context.Context, params *GetAllMeasurementTypesParams, reqEditors ...RequestEditorFn) (*GetAllMeasurementTypesResp, error)
+// NewSetMeasurementTypeDownsamplingRequest calls the generic SetMeasurementTypeDownsampling builder with application/json body
+func NewSetMeasurementTypeDownsamplingRequest(server string, name string, body SetMeasurementTypeDownsamplingJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSetMeasurementTypeDownsamplingRequestWithBody(server, name, "application/json", bodyReader)
+}
 
-	// ListMqttBrokersWithResponse request
-	ListMqttBrokersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListMqttBrokersResp, error)
+// NewSetMeasurementTypeDownsamplingRequestWithBody generates requests for SetMeasurementTypeDownsampling with any type of body
+func NewSetMeasurementTypeDownsamplingRequestWithBody(server string, name string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	// CreateMqttBrokerWithBodyWithResponse request with any body
-	CreateMqttBrokerWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateMqttBrokerResp, error)
+	var pathParam0 string
 
-	CreateMqttBrokerWithResponse(ctx context.Context, body CreateMqttBrokerJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateMqttBrokerResp, error)
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "name", name, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
 
-	// DeleteMqttBrokerWithResponse request
-	DeleteMqttBrokerWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*DeleteMqttBrokerResp, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetMqttBrokerWithResponse request
-	GetMqttBrokerWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*GetMqttBrokerResp, error)
+	operationPath := fmt.Sprintf("/measurement-types/%s/downsampling", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// UpdateMqttBrokerWithBodyWithResponse request with any body
-	UpdateMqttBrokerWithBodyWithResponse(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateMqttBrokerResp, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	UpdateMqttBrokerWithResponse(ctx context.Context, id int, body UpdateMqttBrokerJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateMqttBrokerResp, error)
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetMqttStatsWithResponse request
-	GetMqttStatsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetMqttStatsResp, error)
+	req.Header.Add("Content-Type", contentType)
 
-	// ListMqttSubscriptionsWithResponse request
-	ListMqttSubscriptionsWithResponse(ctx context.Context, params *ListMqttSubscriptionsParams, reqEditors ...RequestEditorFn) (*ListMqttSubscriptionsResp, error)
+	return req, nil
+}
 
-	// CreateMqttSubscriptionWithBodyWithResponse request with any body
-	CreateMqttSubscriptionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateMqttSubscriptionResp, error)
+// NewListMqttBrokersRequest generates requests for ListMqttBrokers
+func NewListMqttBrokersRequest(server string) (*http.Request, error) {
+	var err error
 
-	CreateMqttSubscriptionWithResponse(ctx context.Context, body CreateMqttSubscriptionJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateMqttSubscriptionResp, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// DeleteMqttSubscriptionWithResponse request
-	DeleteMqttSubscriptionWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*DeleteMqttSubscriptionResp, error)
+	operationPath := fmt.Sprintf("/mqtt/brokers")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// GetMqttSubscriptionWithResponse request
-	GetMqttSubscriptionWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*GetMqttSubscriptionResp, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// UpdateMqttSubscriptionWithBodyWithResponse request with any body
-	UpdateMqttSubscriptionWithBodyWithResponse(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateMqttSubscriptionResp, error)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	UpdateMqttSubscriptionWithResponse(ctx context.Context, id int, body UpdateMqttSubscriptionJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateMqttSubscriptionResp, error)
+	return req, nil
+}
 
-	// ListNotificationsWithResponse request
-	ListNotificationsWithResponse(ctx context.Context, params *ListNotificationsParams, reqEditors ...RequestEditorFn) (*ListNotificationsResp, error)
+// NewCreateMqttBrokerRequest calls the generic CreateMqttBroker builder with application/json body
+func NewCreateMqttBrokerRequest(server string, body CreateMqttBrokerJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateMqttBrokerRequestWithBody(server, "application/json", bodyReader)
+}
 
-	// BulkDismissWithResponse request
-	BulkDismissWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*BulkDismissResp, error)
+// NewCreateMqttBrokerRequestWithBody generates requests for CreateMqttBroker with any type of body
+func NewCreateMqttBrokerRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	// BulkMarkAsReadWithResponse request
-	BulkMarkAsReadWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*BulkMarkAsReadResp, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetChannelPreferencesWithResponse request
-	GetChannelPreferencesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetChannelPreferencesResp, error)
+	operationPath := fmt.Sprintf("/mqtt/brokers")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// SetChannelPreferenceWithBodyWithResponse request with any body
-	SetChannelPreferenceWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetChannelPreferenceResp, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	SetChannelPreferenceWithResponse(ctx context.Context, body SetChannelPreferenceJSONRequestBody, reqEditors ...RequestEditorFn) (*SetChannelPreferenceResp, error)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetUnreadCountWithResponse request
-	GetUnreadCountWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetUnreadCountResp, error)
+	req.Header.Add("Content-Type", contentType)
 
-	// NotificationsWebSocketWithResponse request
-	NotificationsWebSocketWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*NotificationsWebSocketResp, error)
+	return req, nil
+}
 
-	// DismissNotificationWithResponse request
-	DismissNotificationWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*DismissNotificationResp, error)
+// NewDeleteMqttBrokerRequest generates requests for DeleteMqttBroker
+func NewDeleteMqttBrokerRequest(server string, id int) (*http.Request, error) {
+	var err error
 
-	// MarkAsReadWithResponse request
-	MarkAsReadWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*MarkAsReadResp, error)
+	var pathParam0 string
 
-	// GetOAuthAuthorizeUrlWithResponse request
-	GetOAuthAuthorizeUrlWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetOAuthAuthorizeUrlResp, error)
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "id", id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "integer", Format: ""})
+	if err != nil {
+		return nil, err
+	}
 
-	// ReloadOAuthWithResponse request
-	ReloadOAuthWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ReloadOAuthResp, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetOAuthStatusWithResponse request
-	GetOAuthStatusWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetOAuthStatusResp, error)
+	operationPath := fmt.Sprintf("/mqtt/brokers/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// SubmitOAuthCodeWithBodyWithResponse request with any body
-	SubmitOAuthCodeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SubmitOAuthCodeResp, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	SubmitOAuthCodeWithResponse(ctx context.Context, body SubmitOAuthCodeJSONRequestBody, reqEditors ...RequestEditorFn) (*SubmitOAuthCodeResp, error)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetOpenApiSpecWithResponse request
-	GetOpenApiSpecWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetOpenApiSpecResp, error)
+	return req, nil
+}
 
-	// GetPropertiesWithResponse request
-	GetPropertiesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetPropertiesResp, error)
+// NewGetMqttBrokerRequest generates requests for GetMqttBroker
+func NewGetMqttBrokerRequest(server string, id int) (*http.Request, error) {
+	var err error
 
-	// UpdatePropertiesWithBodyWithResponse request with any body
-	UpdatePropertiesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdatePropertiesResp, error)
+	var pathParam0 string
 
-	UpdatePropertiesWithResponse(ctx context.Context, body UpdatePropertiesJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdatePropertiesResp, error)
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "id", id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "integer", Format: ""})
+	if err != nil {
+		return nil, err
+	}
 
-	// PropertiesWebSocketWithResponse request
-	PropertiesWebSocketWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PropertiesWebSocketResp, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetReadingsBetweenDatesWithResponse request
-	GetReadingsBetweenDatesWithResponse(ctx context.Context, params *GetReadingsBetweenDatesParams, reqEditors ...RequestEditorFn) (*GetReadingsBetweenDatesResp, error)
+	operationPath := fmt.Sprintf("/mqtt/brokers/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// SubscribeCurrentReadingsWithResponse request
-	SubscribeCurrentReadingsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*SubscribeCurrentReadingsResp, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// ListRolesWithResponse request
-	ListRolesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListRolesResp, error)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// ListPermissionsWithResponse request
-	ListPermissionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListPermissionsResp, error)
+	return req, nil
+}
 
-	// GetRolePermissionsWithResponse request
-	GetRolePermissionsWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*GetRolePermissionsResp, error)
+// NewUpdateMqttBrokerRequest calls the generic UpdateMqttBroker builder with application/json body
+func NewUpdateMqttBrokerRequest(server string, id int, body UpdateMqttBrokerJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateMqttBrokerRequestWithBody(server, id, "application/json", bodyReader)
+}
 
-	// AssignPermissionWithBodyWithResponse request with any body
-	AssignPermissionWithBodyWithResponse(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AssignPermissionResp, error)
+// NewUpdateMqttBrokerRequestWithBody generates requests for UpdateMqttBroker with any type of body
+func NewUpdateMqttBrokerRequestWithBody(server string, id int, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	AssignPermissionWithResponse(ctx context.Context, id int, body AssignPermissionJSONRequestBody, reqEditors ...RequestEditorFn) (*AssignPermissionResp, error)
+	var pathParam0 string
 
-	// RemovePermissionWithResponse request
-	RemovePermissionWithResponse(ctx context.Context, id int, pid int, reqEditors ...RequestEditorFn) (*RemovePermissionResp, error)
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "id", id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "integer", Format: ""})
+	if err != nil {
+		return nil, err
+	}
 
-	// GetAllSensorsWithResponse request
-	GetAllSensorsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAllSensorsResp, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// AddSensorWithBodyWithResponse request with any body
-	AddSensorWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddSensorResp, error)
+	operationPath := fmt.Sprintf("/mqtt/brokers/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	AddSensorWithResponse(ctx context.Context, body AddSensorJSONRequestBody, reqEditors ...RequestEditorFn) (*AddSensorResp, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// ApproveSensorWithResponse request
-	ApproveSensorWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*ApproveSensorResp, error)
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetSensorCapabilitiesWithResponse request
-	GetSensorCapabilitiesWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*GetSensorCapabilitiesResp, error)
+	req.Header.Add("Content-Type", contentType)
 
-	// GetSensorCommandHistoryWithResponse request
-	GetSensorCommandHistoryWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*GetSensorCommandHistoryResp, error)
+	return req, nil
+}
 
-	// GetSensorMeasurementTypesWithResponse request
-	GetSensorMeasurementTypesWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*GetSensorMeasurementTypesResp, error)
+// NewGetMqttStatsRequest generates requests for GetMqttStats
+func NewGetMqttStatsRequest(server string) (*http.Request, error) {
+	var err error
 
-	// CollectAllSensorReadingsWithResponse request
-	CollectAllSensorReadingsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*CollectAllSensorReadingsResp, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// CollectFromSensorWithResponse request
-	CollectFromSensorWithResponse(ctx context.Context, sensorName string, reqEditors ...RequestEditorFn) (*CollectFromSensorResp, error)
+	operationPath := fmt.Sprintf("/mqtt/stats")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// DisableSensorWithResponse request
-	DisableSensorWithResponse(ctx context.Context, sensorName string, reqEditors ...RequestEditorFn) (*DisableSensorResp, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// DismissSensorWithResponse request
-	DismissSensorWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*DismissSensorResp, error)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetSensorsByDriverWithResponse request
-	GetSensorsByDriverWithResponse(ctx context.Context, driver string, reqEditors ...RequestEditorFn) (*GetSensorsByDriverResp, error)
+	return req, nil
+}
 
-	// EnableSensorWithResponse request
-	EnableSensorWithResponse(ctx context.Context, sensorName string, reqEditors ...RequestEditorFn) (*EnableSensorResp, error)
+// NewListMqttSubscriptionsRequest generates requests for ListMqttSubscriptions
+func NewListMqttSubscriptionsRequest(server string, params *ListMqttSubscriptionsParams) (*http.Request, error) {
+	var err error
 
-	// GetSensorHealthHistoryByNameWithResponse request
-	GetSensorHealthHistoryByNameWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*GetSensorHealthHistoryByNameResp, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetTotalReadingsPerSensorWithResponse request
-	GetTotalReadingsPerSensorWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetTotalReadingsPerSensorResp, error)
+	operationPath := fmt.Sprintf("/mqtt/subscriptions")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// GetSensorsByStatusWithResponse request
-	GetSensorsByStatusWithResponse(ctx context.Context, status GetSensorsByStatusParamsStatus, reqEditors ...RequestEditorFn) (*GetSensorsByStatusResp, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// SubscribeAllSensorsWithResponse request
-	SubscribeAllSensorsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*SubscribeAllSensorsResp, error)
+	if params != nil {
+		queryValues := queryURL.Query()
 
-	// SubscribeSensorsByDriverWithResponse request
-	SubscribeSensorsByDriverWithResponse(ctx context.Context, driver string, reqEditors ...RequestEditorFn) (*SubscribeSensorsByDriverResp, error)
+		if params.BrokerId != nil {
 
-	// UpdateSensorByIdWithBodyWithResponse request with any body
-	UpdateSensorByIdWithBodyWithResponse(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateSensorByIdResp, error)
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "broker_id", *params.BrokerId, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: ""}); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	UpdateSensorByIdWithResponse(ctx context.Context, id int, body UpdateSensorByIdJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateSensorByIdResp, error)
+		}
 
-	// SendSensorCommandWithBodyWithResponse request with any body
-	SendSensorCommandWithBodyWithResponse(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SendSensorCommandResp, error)
+		queryURL.RawQuery = queryValues.Encode()
+	}
 
-	SendSensorCommandWithResponse(ctx context.Context, id int, body SendSensorCommandJSONRequestBody, reqEditors ...RequestEditorFn) (*SendSensorCommandResp, error)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// DeleteSensorByNameWithResponse request
-	DeleteSensorByNameWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*DeleteSensorByNameResp, error)
+	return req, nil
+}
 
-	// GetSensorByNameWithResponse request
-	GetSensorByNameWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*GetSensorByNameResp, error)
+// NewCreateMqttSubscriptionRequest calls the generic CreateMqttSubscription builder with application/json body
+func NewCreateMqttSubscriptionRequest(server string, body CreateMqttSubscriptionJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateMqttSubscriptionRequestWithBody(server, "application/json", bodyReader)
+}
 
-	// SensorExistsWithResponse request
-	SensorExistsWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*SensorExistsResp, error)
+// NewCreateMqttSubscriptionRequestWithBody generates requests for CreateMqttSubscription with any type of body
+func NewCreateMqttSubscriptionRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	// ListUsersWithResponse request
-	ListUsersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListUsersResp, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// CreateUserWithBodyWithResponse request with any body
-	CreateUserWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateUserResp, error)
+	operationPath := fmt.Sprintf("/mqtt/subscriptions")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	CreateUserWithResponse(ctx context.Context, body CreateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateUserResp, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// ChangePasswordWithBodyWithResponse request with any body
-	ChangePasswordWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ChangePasswordResp, error)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-	ChangePasswordWithResponse(ctx context.Context, body ChangePasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*ChangePasswordResp, error)
+	req.Header.Add("Content-Type", contentType)
 
-	// DeleteUserWithResponse request
-	DeleteUserWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*DeleteUserResp, error)
+	return req, nil
+}
 
-	// SetMustChangePasswordWithBodyWithResponse request with any body
-	SetMustChangePasswordWithBodyWithResponse(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetMustChangePasswordResp, error)
+// NewDeleteMqttSubscriptionRequest generates requests for DeleteMqttSubscription
+func NewDeleteMqttSubscriptionRequest(server string, id int) (*http.Request, error) {
+	var err error
 
-	SetMustChangePasswordWithResponse(ctx context.Context, id int, body SetMustChangePasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*SetMustChangePasswordResp, error)
+	var pathParam0 string
 
-	// SetUserRolesWithBodyWithResponse request with any body
-	SetUserRolesWithBodyWithResponse(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetUserRolesResp, error)
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "id", id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "integer", Format: ""})
+	if err != nil {
+		return nil, err
+	}
 
-	SetUserRolesWithResponse(ctx context.Context, id int, body SetUserRolesJSONRequestBody, reqEditors ...RequestEditorFn) (*SetUserRolesResp, error)
-}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-type GetAllAlertRulesResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]AlertRule
-	JSON500      *ErrorResponse
-}
+	operationPath := fmt.Sprintf("/mqtt/subscriptions/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r GetAllAlertRulesResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetAllAlertRulesResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type CreateAlertRuleResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *SuccessMessage
-	JSON400      *ErrorResponse
-	JSON500      *ErrorResponse
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r CreateAlertRuleResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+// NewGetMqttSubscriptionRequest generates requests for GetMqttSubscription
+func NewGetMqttSubscriptionRequest(server string, id int) (*http.Request, error) {
+	var err error
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateAlertRuleResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "id", id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "integer", Format: ""})
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetAlertRulesBySensorIdResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]AlertRule
-	JSON400      *ErrorResponse
-	JSON404      *ErrorResponse
-}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r GetAlertRulesBySensorIdResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	operationPath := fmt.Sprintf("/mqtt/subscriptions/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetAlertRulesBySensorIdResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
-
-type GetAlertHistoryResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]AlertHistoryEntry
-	JSON400      *ErrorResponse
-	JSON500      *ErrorResponse
-}
-
-// Status returns HTTPResponse.Status
-func (r GetAlertHistoryResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetAlertHistoryResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type DeleteAlertRuleResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *SuccessMessage
-	JSON400      *ErrorResponse
-	JSON500      *ErrorResponse
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r DeleteAlertRuleResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewUpdateMqttSubscriptionRequest calls the generic UpdateMqttSubscription builder with application/json body
+func NewUpdateMqttSubscriptionRequest(server string, id int, body UpdateMqttSubscriptionJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateMqttSubscriptionRequestWithBody(server, id, "application/json", bodyReader)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteAlertRuleResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+// NewUpdateMqttSubscriptionRequestWithBody generates requests for UpdateMqttSubscription with any type of body
+func NewUpdateMqttSubscriptionRequestWithBody(server string, id int, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-type GetAlertRuleByIdResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *AlertRule
-	JSON400      *ErrorResponse
-	JSON404      *ErrorResponse
-}
+	var pathParam0 string
 
-// Status returns HTTPResponse.Status
-func (r GetAlertRuleByIdResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "id", id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "integer", Format: ""})
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetAlertRuleByIdResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type UpdateAlertRuleResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *SuccessMessage
-	JSON400      *ErrorResponse
-	JSON500      *ErrorResponse
-}
+	operationPath := fmt.Sprintf("/mqtt/subscriptions/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r UpdateAlertRuleResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateAlertRuleResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type ListApiKeysResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]ApiKey
-	JSON500      *ErrorResponse
-}
+	req.Header.Add("Content-Type", contentType)
 
-// Status returns HTTPResponse.Status
-func (r ListApiKeysResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListApiKeysResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+// NewListNotificationsRequest generates requests for ListNotifications
+func NewListNotificationsRequest(server string, params *ListNotificationsParams) (*http.Request, error) {
+	var err error
 
-type CreateApiKeyResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *struct {
-		// Key The full API key. Store it securely — it will not be shown again.
-		Key     *string `json:"key,omitempty"`
-		Message *string `json:"message,omitempty"`
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	JSON500 *ErrorResponse
-}
 
-// Status returns HTTPResponse.Status
-func (r CreateApiKeyResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	operationPath := fmt.Sprintf("/notifications")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateApiKeyResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type DeleteApiKeyResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *SuccessMessage
-	JSON500      *ErrorResponse
-}
+	if params != nil {
+		queryValues := queryURL.Query()
 
-// Status returns HTTPResponse.Status
-func (r DeleteApiKeyResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+		if params.Limit != nil {
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteApiKeyResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "limit", *params.Limit, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: ""}); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-type UpdateApiKeyExpiryResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *SuccessMessage
-	JSON500      *ErrorResponse
-}
+		}
 
-// Status returns HTTPResponse.Status
-func (r UpdateApiKeyExpiryResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+		if params.Offset != nil {
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateApiKeyExpiryResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "offset", *params.Offset, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: ""}); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-type RevokeApiKeyResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *SuccessMessage
-	JSON500      *ErrorResponse
-}
+		}
 
-// Status returns HTTPResponse.Status
-func (r RevokeApiKeyResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+		if params.IncludeDismissed != nil {
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r RevokeApiKeyResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "include_dismissed", *params.IncludeDismissed, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-type LoginResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *LoginResponse
-	JSON401      *ErrorResponse
-	JSON429      *RateLimitResponse
-}
+		}
 
-// Status returns HTTPResponse.Status
-func (r LoginResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+		queryURL.RawQuery = queryValues.Encode()
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r LoginResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type LogoutResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r LogoutResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+// NewBulkDismissRequest generates requests for BulkDismiss
+func NewBulkDismissRequest(server string) (*http.Request, error) {
+	var err error
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r LogoutResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetCurrentUserResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *MeResponse
-}
+	operationPath := fmt.Sprintf("/notifications/bulk/dismiss")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r GetCurrentUserResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetCurrentUserResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type ListSessionsResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]SessionInfo
-	JSON500      *ErrorResponse
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r ListSessionsResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+// NewBulkMarkAsReadRequest generates requests for BulkMarkAsRead
+func NewBulkMarkAsReadRequest(server string) (*http.Request, error) {
+	var err error
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListSessionsResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type RevokeSessionResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *ErrorResponse
-	JSON500      *ErrorResponse
-}
+	operationPath := fmt.Sprintf("/notifications/bulk/read")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r RevokeSessionResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r RevokeSessionResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type ListDashboardsResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]Dashboard
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r ListDashboardsResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+// NewGetChannelPreferencesRequest generates requests for GetChannelPreferences
+func NewGetChannelPreferencesRequest(server string) (*http.Request, error) {
+	var err error
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListDashboardsResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type CreateDashboardResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *struct {
-		Id *int `json:"id,omitempty"`
+	operationPath := fmt.Sprintf("/notifications/preferences")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	JSON500 *ErrorResponse
-}
 
-// Status returns HTTPResponse.Status
-func (r CreateDashboardResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateDashboardResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type DeleteDashboardResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *SuccessMessage
-	JSON500      *ErrorResponse
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r DeleteDashboardResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewSetChannelPreferenceRequest calls the generic SetChannelPreference builder with application/json body
+func NewSetChannelPreferenceRequest(server string, body SetChannelPreferenceJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	bodyReader = bytes.NewReader(buf)
+	return NewSetChannelPreferenceRequestWithBody(server, "application/json", bodyReader)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteDashboardResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewSetChannelPreferenceRequestWithBody generates requests for SetChannelPreference with any type of body
+func NewSetChannelPreferenceRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetDashboardResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Dashboard
-}
+	operationPath := fmt.Sprintf("/notifications/preferences")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r GetDashboardResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetDashboardResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type UpdateDashboardResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *SuccessMessage
-	JSON500      *ErrorResponse
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r UpdateDashboardResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewDeletePushSubscriptionRequest generates requests for DeletePushSubscription
+func NewDeletePushSubscriptionRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateDashboardResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	operationPath := fmt.Sprintf("/notifications/push")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return 0
-}
 
-type SetDefaultDashboardResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *SuccessMessage
-	JSON500      *ErrorResponse
-}
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r SetDefaultDashboardResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r SetDefaultDashboardResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewGetPushSubscriptionRequest generates requests for GetPushSubscription
+func NewGetPushSubscriptionRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type ShareDashboardResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *SuccessMessage
-	JSON500      *ErrorResponse
-}
+	operationPath := fmt.Sprintf("/notifications/push")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r ShareDashboardResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ShareDashboardResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type ListDriversResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]DriverInfo
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r ListDriversResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewSetPushSubscriptionRequest calls the generic SetPushSubscription builder with application/json body
+func NewSetPushSubscriptionRequest(server string, body SetPushSubscriptionJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	bodyReader = bytes.NewReader(buf)
+	return NewSetPushSubscriptionRequestWithBody(server, "application/json", bodyReader)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListDriversResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewSetPushSubscriptionRequestWithBody generates requests for SetPushSubscription with any type of body
+func NewSetPushSubscriptionRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetHealthResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *struct {
-		Status *string `json:"status,omitempty"`
+	operationPath := fmt.Sprintf("/notifications/push")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-}
 
-// Status returns HTTPResponse.Status
-func (r GetHealthResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetHealthResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetAllMeasurementTypesResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]MeasurementType
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetAllMeasurementTypesResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewTestPushSubscriptionRequest generates requests for TestPushSubscription
+func NewTestPushSubscriptionRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetAllMeasurementTypesResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	operationPath := fmt.Sprintf("/notifications/push/test")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return 0
-}
 
-type ListMqttBrokersResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]MQTTBroker
-}
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r ListMqttBrokersResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListMqttBrokersResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewDeleteQuietHoursRequest generates requests for DeleteQuietHours
+func NewDeleteQuietHoursRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type CreateMqttBrokerResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *struct {
-		Id *int `json:"id,omitempty"`
+	operationPath := fmt.Sprintf("/notifications/quiet-hours")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-}
 
-// Status returns HTTPResponse.Status
-func (r CreateMqttBrokerResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateMqttBrokerResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type DeleteMqttBrokerResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r DeleteMqttBrokerResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+// NewGetQuietHoursRequest generates requests for GetQuietHours
+func NewGetQuietHoursRequest(server string) (*http.Request, error) {
+	var err error
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteMqttBrokerResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetMqttBrokerResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *MQTTBroker
-}
+	operationPath := fmt.Sprintf("/notifications/quiet-hours")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r GetMqttBrokerResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetMqttBrokerResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type UpdateMqttBrokerResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *SuccessMessage
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r UpdateMqttBrokerResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewSetQuietHoursRequest calls the generic SetQuietHours builder with application/json body
+func NewSetQuietHoursRequest(server string, body SetQuietHoursJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	bodyReader = bytes.NewReader(buf)
+	return NewSetQuietHoursRequestWithBody(server, "application/json", bodyReader)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateMqttBrokerResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewSetQuietHoursRequestWithBody generates requests for SetQuietHours with any type of body
+func NewSetQuietHoursRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetMqttStatsResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]MQTTBrokerStats
-}
+	operationPath := fmt.Sprintf("/notifications/quiet-hours")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r GetMqttStatsResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetMqttStatsResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type ListMqttSubscriptionsResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]MQTTSubscription
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r ListMqttSubscriptionsResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewGetUnreadCountRequest generates requests for GetUnreadCount
+func NewGetUnreadCountRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListMqttSubscriptionsResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	operationPath := fmt.Sprintf("/notifications/unread-count")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return 0
-}
 
-type CreateMqttSubscriptionResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *struct {
-		Id *int `json:"id,omitempty"`
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-}
 
-// Status returns HTTPResponse.Status
-func (r CreateMqttSubscriptionResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateMqttSubscriptionResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewListWebhookTargetsRequest generates requests for ListWebhookTargets
+func NewListWebhookTargetsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type DeleteMqttSubscriptionResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-}
+	operationPath := fmt.Sprintf("/notifications/webhooks")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r DeleteMqttSubscriptionResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteMqttSubscriptionResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetMqttSubscriptionResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *MQTTSubscription
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetMqttSubscriptionResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewCreateWebhookTargetRequest calls the generic CreateWebhookTarget builder with application/json body
+func NewCreateWebhookTargetRequest(server string, body CreateWebhookTargetJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateWebhookTargetRequestWithBody(server, "application/json", bodyReader)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetMqttSubscriptionResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewCreateWebhookTargetRequestWithBody generates requests for CreateWebhookTarget with any type of body
+func NewCreateWebhookTargetRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type UpdateMqttSubscriptionResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *SuccessMessage
-}
+	operationPath := fmt.Sprintf("/notifications/webhooks")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r UpdateMqttSubscriptionResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateMqttSubscriptionResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type ListNotificationsResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]UserNotification
-	JSON500      *ErrorResponse
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r ListNotificationsResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewDeleteWebhookTargetRequest generates requests for DeleteWebhookTarget
+func NewDeleteWebhookTargetRequest(server string, id int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "id", id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "integer", Format: ""})
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListNotificationsResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type BulkDismissResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *SuccessMessage
-	JSON500      *ErrorResponse
-}
+	operationPath := fmt.Sprintf("/notifications/webhooks/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r BulkDismissResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r BulkDismissResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+
+	return req, nil
 }
 
-type BulkMarkAsReadResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *SuccessMessage
-	JSON500      *ErrorResponse
+// NewUpdateWebhookTargetRequest calls the generic UpdateWebhookTarget builder with application/json body
+func NewUpdateWebhookTargetRequest(server string, id int, body UpdateWebhookTargetJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateWebhookTargetRequestWithBody(server, id, "application/json", bodyReader)
 }
 
-// Status returns HTTPResponse.Status
-func (r BulkMarkAsReadResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewUpdateWebhookTargetRequestWithBody generates requests for UpdateWebhookTarget with any type of body
+func NewUpdateWebhookTargetRequestWithBody(server string, id int, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "id", id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "integer", Format: ""})
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r BulkMarkAsReadResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetChannelPreferencesResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]ChannelPreference
-	JSON500      *ErrorResponse
-}
+	operationPath := fmt.Sprintf("/notifications/webhooks/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r GetChannelPreferencesResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetChannelPreferencesResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type SetChannelPreferenceResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *SuccessMessage
-	JSON400      *ErrorResponse
-	JSON500      *ErrorResponse
-}
+	req.Header.Add("Content-Type", contentType)
 
-// Status returns HTTPResponse.Status
-func (r SetChannelPreferenceResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r SetChannelPreferenceResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewGetWebhookDeliveriesRequest generates requests for GetWebhookDeliveries
+func NewGetWebhookDeliveriesRequest(server string, id int, params *GetWebhookDeliveriesParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "id", id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "integer", Format: ""})
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetUnreadCountResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *struct {
-		Count *int `json:"count,omitempty"`
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	JSON500 *ErrorResponse
-}
 
-// Status returns HTTPResponse.Status
-func (r GetUnreadCountResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	operationPath := fmt.Sprintf("/notifications/webhooks/%s/deliveries", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetUnreadCountResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type NotificationsWebSocketResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-}
+	if params != nil {
+		queryValues := queryURL.Query()
 
-// Status returns HTTPResponse.Status
-func (r NotificationsWebSocketResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+		if params.Limit != nil {
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r NotificationsWebSocketResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "limit", *params.Limit, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: ""}); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-type DismissNotificationResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *SuccessMessage
-	JSON400      *ErrorResponse
-	JSON500      *ErrorResponse
-}
+		}
 
-// Status returns HTTPResponse.Status
-func (r DismissNotificationResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+		queryURL.RawQuery = queryValues.Encode()
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DismissNotificationResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type MarkAsReadResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *SuccessMessage
-	JSON400      *ErrorResponse
-	JSON500      *ErrorResponse
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r MarkAsReadResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewTestWebhookTargetRequest generates requests for TestWebhookTarget
+func NewTestWebhookTargetRequest(server string, id int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "id", id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "integer", Format: ""})
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r MarkAsReadResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetOAuthAuthorizeUrlResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *OAuthAuthorizeResponse
-	JSON500      *ErrorResponse
-	JSON503      *ErrorResponse
-}
+	operationPath := fmt.Sprintf("/notifications/webhooks/%s/test", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r GetOAuthAuthorizeUrlResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetOAuthAuthorizeUrlResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type ReloadOAuthResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *SuccessMessage
-	JSON500      *ErrorResponse
-	JSON503      *ErrorResponse
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r ReloadOAuthResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewNotificationsWebSocketRequest generates requests for NotificationsWebSocket
+func NewNotificationsWebSocketRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ReloadOAuthResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	operationPath := fmt.Sprintf("/notifications/ws")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return 0
-}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDismissNotificationRequest generates requests for DismissNotification
+func NewDismissNotificationRequest(server string, id int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "id", id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "integer", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/notifications/%s/dismiss", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewMarkAsReadRequest generates requests for MarkAsRead
+func NewMarkAsReadRequest(server string, id int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "id", id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "integer", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/notifications/%s/read", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetOAuthAuthorizeUrlRequest generates requests for GetOAuthAuthorizeUrl
+func NewGetOAuthAuthorizeUrlRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/oauth/authorize")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewReloadOAuthRequest generates requests for ReloadOAuth
+func NewReloadOAuthRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/oauth/reload")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetOAuthStatusRequest generates requests for GetOAuthStatus
+func NewGetOAuthStatusRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/oauth/status")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSubmitOAuthCodeRequest calls the generic SubmitOAuthCode builder with application/json body
+func NewSubmitOAuthCodeRequest(server string, body SubmitOAuthCodeJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSubmitOAuthCodeRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewSubmitOAuthCodeRequestWithBody generates requests for SubmitOAuthCode with any type of body
+func NewSubmitOAuthCodeRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/oauth/submit-code")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetOpenApiSpecRequest generates requests for GetOpenApiSpec
+func NewGetOpenApiSpecRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/openapi.yaml")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetPropertiesRequest generates requests for GetProperties
+func NewGetPropertiesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/properties")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdatePropertiesRequest calls the generic UpdateProperties builder with application/json body
+func NewUpdatePropertiesRequest(server string, body UpdatePropertiesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdatePropertiesRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewUpdatePropertiesRequestWithBody generates requests for UpdateProperties with any type of body
+func NewUpdatePropertiesRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/properties")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPropertiesWebSocketRequest generates requests for PropertiesWebSocket
+func NewPropertiesWebSocketRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/properties/ws")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetReadingsBetweenDatesRequest generates requests for GetReadingsBetweenDates
+func NewGetReadingsBetweenDatesRequest(server string, params *GetReadingsBetweenDatesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/readings/between")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "start", params.Start, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if queryFrag, err := runtime.StyleParamWithOptions("form", true, "end", params.End, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.Type != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "type", *params.Type, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Sensor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "sensor", *params.Sensor, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Aggregation != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "aggregation", *params.Aggregation, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.AggregationFunction != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "aggregation_function", *params.AggregationFunction, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Statistics != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", false, "statistics", *params.Statistics, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "array", Format: ""}); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSubscribeCurrentReadingsRequest generates requests for SubscribeCurrentReadings
+func NewSubscribeCurrentReadingsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/readings/ws/current")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListRolesRequest generates requests for ListRoles
+func NewListRolesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/roles")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListPermissionsRequest generates requests for ListPermissions
+func NewListPermissionsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/roles/permissions")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetRolePermissionsRequest generates requests for GetRolePermissions
+func NewGetRolePermissionsRequest(server string, id int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "id", id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "integer", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/roles/%s/permissions", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAssignPermissionRequest calls the generic AssignPermission builder with application/json body
+func NewAssignPermissionRequest(server string, id int, body AssignPermissionJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAssignPermissionRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewAssignPermissionRequestWithBody generates requests for AssignPermission with any type of body
+func NewAssignPermissionRequestWithBody(server string, id int, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "id", id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "integer", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/roles/%s/permissions", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewRemovePermissionRequest generates requests for RemovePermission
+func NewRemovePermissionRequest(server string, id int, pid int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "id", id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "integer", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "pid", pid, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "integer", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/roles/%s/permissions/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetAllScenesRequest generates requests for GetAllScenes
+func NewGetAllScenesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/scenes")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateSceneRequest calls the generic CreateScene builder with application/json body
+func NewCreateSceneRequest(server string, body CreateSceneJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateSceneRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateSceneRequestWithBody generates requests for CreateScene with any type of body
+func NewCreateSceneRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/scenes")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteSceneRequest generates requests for DeleteScene
+func NewDeleteSceneRequest(server string, id int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "id", id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "integer", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/scenes/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetSceneByIdRequest generates requests for GetSceneById
+func NewGetSceneByIdRequest(server string, id int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "id", id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "integer", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/scenes/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateSceneRequest calls the generic UpdateScene builder with application/json body
+func NewUpdateSceneRequest(server string, id int, body UpdateSceneJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateSceneRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewUpdateSceneRequestWithBody generates requests for UpdateScene with any type of body
+func NewUpdateSceneRequestWithBody(server string, id int, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "id", id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "integer", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/scenes/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewActivateSceneRequest generates requests for ActivateScene
+func NewActivateSceneRequest(server string, id int, params *ActivateSceneParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "id", id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "integer", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/scenes/%s/activate", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Wait != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "wait", *params.Wait, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "boolean", Format: ""}); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetAllSchedulesRequest generates requests for GetAllSchedules
+func NewGetAllSchedulesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/schedules")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateScheduleRequest calls the generic CreateSchedule builder with application/json body
+func NewCreateScheduleRequest(server string, body CreateScheduleJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateScheduleRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateScheduleRequestWithBody generates requests for CreateSchedule with any type of body
+func NewCreateScheduleRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/schedules")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetHolidayModeRequest generates requests for GetHolidayMode
+func NewGetHolidayModeRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/schedules/holiday-mode")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSetHolidayModeRequest calls the generic SetHolidayMode builder with application/json body
+func NewSetHolidayModeRequest(server string, body SetHolidayModeJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSetHolidayModeRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewSetHolidayModeRequestWithBody generates requests for SetHolidayMode with any type of body
+func NewSetHolidayModeRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/schedules/holiday-mode")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteScheduleRequest generates requests for DeleteSchedule
+func NewDeleteScheduleRequest(server string, id int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "id", id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "integer", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/schedules/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetScheduleByIdRequest generates requests for GetScheduleById
+func NewGetScheduleByIdRequest(server string, id int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "id", id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "integer", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/schedules/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateScheduleRequest calls the generic UpdateSchedule builder with application/json body
+func NewUpdateScheduleRequest(server string, id int, body UpdateScheduleJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateScheduleRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewUpdateScheduleRequestWithBody generates requests for UpdateSchedule with any type of body
+func NewUpdateScheduleRequestWithBody(server string, id int, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "id", id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "integer", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/schedules/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetAllSensorsRequest generates requests for GetAllSensors
+func NewGetAllSensorsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/sensors")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAddSensorRequest calls the generic AddSensor builder with application/json body
+func NewAddSensorRequest(server string, body AddSensorJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAddSensorRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewAddSensorRequestWithBody generates requests for AddSensor with any type of body
+func NewAddSensorRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/sensors")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewApproveSensorRequest generates requests for ApproveSensor
+func NewApproveSensorRequest(server string, id int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "id", id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "integer", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/sensors/approve/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetSensorCapabilitiesRequest generates requests for GetSensorCapabilities
+func NewGetSensorCapabilitiesRequest(server string, id int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "id", id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "integer", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/sensors/by-id/%s/capabilities", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetSensorCommandHistoryRequest generates requests for GetSensorCommandHistory
+func NewGetSensorCommandHistoryRequest(server string, id int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "id", id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "integer", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/sensors/by-id/%s/commands", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetSensorDesiredStatesRequest generates requests for GetSensorDesiredStates
+func NewGetSensorDesiredStatesRequest(server string, id int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "id", id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "integer", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/sensors/by-id/%s/desired-states", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteSensorDesiredStateRequest generates requests for DeleteSensorDesiredState
+func NewDeleteSensorDesiredStateRequest(server string, id int, property string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "id", id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "integer", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "property", property, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/sensors/by-id/%s/desired-states/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSetSensorDesiredStateRequest calls the generic SetSensorDesiredState builder with application/json body
+func NewSetSensorDesiredStateRequest(server string, id int, property string, body SetSensorDesiredStateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSetSensorDesiredStateRequestWithBody(server, id, property, "application/json", bodyReader)
+}
+
+// NewSetSensorDesiredStateRequestWithBody generates requests for SetSensorDesiredState with any type of body
+func NewSetSensorDesiredStateRequestWithBody(server string, id int, property string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "id", id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "integer", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithOptions("simple", false, "property", property, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/sensors/by-id/%s/desired-states/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetSensorMeasurementTypesRequest generates requests for GetSensorMeasurementTypes
+func NewGetSensorMeasurementTypesRequest(server string, id int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "id", id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "integer", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/sensors/by-id/%s/measurement-types", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCollectAllSensorReadingsRequest generates requests for CollectAllSensorReadings
+func NewCollectAllSensorReadingsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/sensors/collect")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCollectFromSensorRequest generates requests for CollectFromSensor
+func NewCollectFromSensorRequest(server string, sensorName string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "sensorName", sensorName, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/sensors/collect/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDisableSensorRequest generates requests for DisableSensor
+func NewDisableSensorRequest(server string, sensorName string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "sensorName", sensorName, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/sensors/disable/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDismissSensorRequest generates requests for DismissSensor
+func NewDismissSensorRequest(server string, id int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "id", id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "integer", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/sensors/dismiss/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetSensorsByDriverRequest generates requests for GetSensorsByDriver
+func NewGetSensorsByDriverRequest(server string, driver string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "driver", driver, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/sensors/driver/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewEnableSensorRequest generates requests for EnableSensor
+func NewEnableSensorRequest(server string, sensorName string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "sensorName", sensorName, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/sensors/enable/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetSensorHealthHistoryByNameRequest generates requests for GetSensorHealthHistoryByName
+func NewGetSensorHealthHistoryByNameRequest(server string, name string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "name", name, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/sensors/health/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetTotalReadingsPerSensorRequest generates requests for GetTotalReadingsPerSensor
+func NewGetTotalReadingsPerSensorRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/sensors/stats/total-readings")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetSensorsByStatusRequest generates requests for GetSensorsByStatus
+func NewGetSensorsByStatusRequest(server string, status GetSensorsByStatusParamsStatus) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "status", status, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/sensors/status/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSubscribeAllSensorsRequest generates requests for SubscribeAllSensors
+func NewSubscribeAllSensorsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/sensors/ws")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSubscribeSensorsByDriverRequest generates requests for SubscribeSensorsByDriver
+func NewSubscribeSensorsByDriverRequest(server string, driver string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "driver", driver, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/sensors/ws/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateSensorByIdRequest calls the generic UpdateSensorById builder with application/json body
+func NewUpdateSensorByIdRequest(server string, id int, body UpdateSensorByIdJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateSensorByIdRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewUpdateSensorByIdRequestWithBody generates requests for UpdateSensorById with any type of body
+func NewUpdateSensorByIdRequestWithBody(server string, id int, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "id", id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "integer", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/sensors/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewSendSensorCommandRequest calls the generic SendSensorCommand builder with application/json body
+func NewSendSensorCommandRequest(server string, id int, body SendSensorCommandJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSendSensorCommandRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewSendSensorCommandRequestWithBody generates requests for SendSensorCommand with any type of body
+func NewSendSensorCommandRequestWithBody(server string, id int, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "id", id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "integer", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/sensors/%s/command", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteSensorByNameRequest generates requests for DeleteSensorByName
+func NewDeleteSensorByNameRequest(server string, name string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "name", name, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/sensors/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetSensorByNameRequest generates requests for GetSensorByName
+func NewGetSensorByNameRequest(server string, name string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "name", name, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/sensors/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSensorExistsRequest generates requests for SensorExists
+func NewSensorExistsRequest(server string, name string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "name", name, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/sensors/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("HEAD", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListUsersRequest generates requests for ListUsers
+func NewListUsersRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateUserRequest calls the generic CreateUser builder with application/json body
+func NewCreateUserRequest(server string, body CreateUserJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateUserRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateUserRequestWithBody generates requests for CreateUser with any type of body
+func NewCreateUserRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewChangePasswordRequest calls the generic ChangePassword builder with application/json body
+func NewChangePasswordRequest(server string, body ChangePasswordJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewChangePasswordRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewChangePasswordRequestWithBody generates requests for ChangePassword with any type of body
+func NewChangePasswordRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/password")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteUserRequest generates requests for DeleteUser
+func NewDeleteUserRequest(server string, id int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "id", id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "integer", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSetMustChangePasswordRequest calls the generic SetMustChangePassword builder with application/json body
+func NewSetMustChangePasswordRequest(server string, id int, body SetMustChangePasswordJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSetMustChangePasswordRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewSetMustChangePasswordRequestWithBody generates requests for SetMustChangePassword with any type of body
+func NewSetMustChangePasswordRequestWithBody(server string, id int, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "id", id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "integer", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/%s/must_change", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewSetUserRolesRequest calls the generic SetUserRoles builder with application/json body
+func NewSetUserRolesRequest(server string, id int, body SetUserRolesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSetUserRolesRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewSetUserRolesRequestWithBody generates requests for SetUserRoles with any type of body
+func NewSetUserRolesRequestWithBody(server string, id int, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "id", id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "integer", Format: ""})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/%s/roles", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// GetAllAlertRulesWithResponse request
+	GetAllAlertRulesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAllAlertRulesResp, error)
+
+	// CreateAlertRuleWithBodyWithResponse request with any body
+	CreateAlertRuleWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateAlertRuleResp, error)
+
+	CreateAlertRuleWithResponse(ctx context.Context, body CreateAlertRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateAlertRuleResp, error)
+
+	// GetAllCompoundAlertRulesWithResponse request
+	GetAllCompoundAlertRulesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAllCompoundAlertRulesResp, error)
+
+	// CreateCompoundAlertRuleWithBodyWithResponse request with any body
+	CreateCompoundAlertRuleWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateCompoundAlertRuleResp, error)
+
+	CreateCompoundAlertRuleWithResponse(ctx context.Context, body CreateCompoundAlertRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateCompoundAlertRuleResp, error)
+
+	// DeleteCompoundAlertRuleWithResponse request
+	DeleteCompoundAlertRuleWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*DeleteCompoundAlertRuleResp, error)
+
+	// GetCompoundAlertRuleByIdWithResponse request
+	GetCompoundAlertRuleByIdWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*GetCompoundAlertRuleByIdResp, error)
+
+	// UpdateCompoundAlertRuleWithBodyWithResponse request with any body
+	UpdateCompoundAlertRuleWithBodyWithResponse(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateCompoundAlertRuleResp, error)
+
+	UpdateCompoundAlertRuleWithResponse(ctx context.Context, id int, body UpdateCompoundAlertRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateCompoundAlertRuleResp, error)
+
+	// AcknowledgeCompoundAlertRuleWithResponse request
+	AcknowledgeCompoundAlertRuleWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*AcknowledgeCompoundAlertRuleResp, error)
+
+	// DeleteCompoundAlertEscalationPolicyWithResponse request
+	DeleteCompoundAlertEscalationPolicyWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*DeleteCompoundAlertEscalationPolicyResp, error)
+
+	// GetCompoundAlertEscalationPolicyWithResponse request
+	GetCompoundAlertEscalationPolicyWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*GetCompoundAlertEscalationPolicyResp, error)
+
+	// SetCompoundAlertEscalationPolicyWithBodyWithResponse request with any body
+	SetCompoundAlertEscalationPolicyWithBodyWithResponse(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetCompoundAlertEscalationPolicyResp, error)
+
+	SetCompoundAlertEscalationPolicyWithResponse(ctx context.Context, id int, body SetCompoundAlertEscalationPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*SetCompoundAlertEscalationPolicyResp, error)
+
+	// DeleteCompoundAlertRecipientsWithResponse request
+	DeleteCompoundAlertRecipientsWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*DeleteCompoundAlertRecipientsResp, error)
+
+	// GetCompoundAlertRecipientsWithResponse request
+	GetCompoundAlertRecipientsWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*GetCompoundAlertRecipientsResp, error)
+
+	// SetCompoundAlertRecipientsWithBodyWithResponse request with any body
+	SetCompoundAlertRecipientsWithBodyWithResponse(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetCompoundAlertRecipientsResp, error)
+
+	SetCompoundAlertRecipientsWithResponse(ctx context.Context, id int, body SetCompoundAlertRecipientsJSONRequestBody, reqEditors ...RequestEditorFn) (*SetCompoundAlertRecipientsResp, error)
+
+	// UnsnoozeCompoundAlertRuleWithResponse request
+	UnsnoozeCompoundAlertRuleWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*UnsnoozeCompoundAlertRuleResp, error)
+
+	// SnoozeCompoundAlertRuleWithBodyWithResponse request with any body
+	SnoozeCompoundAlertRuleWithBodyWithResponse(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SnoozeCompoundAlertRuleResp, error)
+
+	SnoozeCompoundAlertRuleWithResponse(ctx context.Context, id int, body SnoozeCompoundAlertRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*SnoozeCompoundAlertRuleResp, error)
+
+	// GetAllContactGroupsWithResponse request
+	GetAllContactGroupsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAllContactGroupsResp, error)
+
+	// CreateContactGroupWithBodyWithResponse request with any body
+	CreateContactGroupWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateContactGroupResp, error)
+
+	CreateContactGroupWithResponse(ctx context.Context, body CreateContactGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateContactGroupResp, error)
+
+	// DeleteContactGroupWithResponse request
+	DeleteContactGroupWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*DeleteContactGroupResp, error)
+
+	// UpdateContactGroupWithBodyWithResponse request with any body
+	UpdateContactGroupWithBodyWithResponse(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateContactGroupResp, error)
+
+	UpdateContactGroupWithResponse(ctx context.Context, id int, body UpdateContactGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateContactGroupResp, error)
+
+	// AcknowledgeAlertHistoryEntryWithResponse request
+	AcknowledgeAlertHistoryEntryWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*AcknowledgeAlertHistoryEntryResp, error)
+
+	// GetAlertRulesBySensorIdWithResponse request
+	GetAlertRulesBySensorIdWithResponse(ctx context.Context, sensorId int, reqEditors ...RequestEditorFn) (*GetAlertRulesBySensorIdResp, error)
+
+	// GetAlertHistoryWithResponse request
+	GetAlertHistoryWithResponse(ctx context.Context, sensorId int, params *GetAlertHistoryParams, reqEditors ...RequestEditorFn) (*GetAlertHistoryResp, error)
+
+	// GetAllSilenceWindowsWithResponse request
+	GetAllSilenceWindowsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAllSilenceWindowsResp, error)
+
+	// CreateSilenceWindowWithBodyWithResponse request with any body
+	CreateSilenceWindowWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateSilenceWindowResp, error)
+
+	CreateSilenceWindowWithResponse(ctx context.Context, body CreateSilenceWindowJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateSilenceWindowResp, error)
+
+	// DeleteSilenceWindowWithResponse request
+	DeleteSilenceWindowWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*DeleteSilenceWindowResp, error)
+
+	// UpdateSilenceWindowWithBodyWithResponse request with any body
+	UpdateSilenceWindowWithBodyWithResponse(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateSilenceWindowResp, error)
+
+	UpdateSilenceWindowWithResponse(ctx context.Context, id int, body UpdateSilenceWindowJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateSilenceWindowResp, error)
+
+	// DeleteAlertRuleWithResponse request
+	DeleteAlertRuleWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*DeleteAlertRuleResp, error)
+
+	// GetAlertRuleByIdWithResponse request
+	GetAlertRuleByIdWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*GetAlertRuleByIdResp, error)
+
+	// UpdateAlertRuleWithBodyWithResponse request with any body
+	UpdateAlertRuleWithBodyWithResponse(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateAlertRuleResp, error)
+
+	UpdateAlertRuleWithResponse(ctx context.Context, id int, body UpdateAlertRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateAlertRuleResp, error)
+
+	// AcknowledgeAlertRuleWithResponse request
+	AcknowledgeAlertRuleWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*AcknowledgeAlertRuleResp, error)
+
+	// DeleteAlertEscalationPolicyWithResponse request
+	DeleteAlertEscalationPolicyWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*DeleteAlertEscalationPolicyResp, error)
+
+	// GetAlertEscalationPolicyWithResponse request
+	GetAlertEscalationPolicyWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*GetAlertEscalationPolicyResp, error)
+
+	// SetAlertEscalationPolicyWithBodyWithResponse request with any body
+	SetAlertEscalationPolicyWithBodyWithResponse(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetAlertEscalationPolicyResp, error)
+
+	SetAlertEscalationPolicyWithResponse(ctx context.Context, id int, body SetAlertEscalationPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*SetAlertEscalationPolicyResp, error)
+
+	// DeleteAlertRecipientsWithResponse request
+	DeleteAlertRecipientsWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*DeleteAlertRecipientsResp, error)
+
+	// GetAlertRecipientsWithResponse request
+	GetAlertRecipientsWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*GetAlertRecipientsResp, error)
+
+	// SetAlertRecipientsWithBodyWithResponse request with any body
+	SetAlertRecipientsWithBodyWithResponse(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetAlertRecipientsResp, error)
+
+	SetAlertRecipientsWithResponse(ctx context.Context, id int, body SetAlertRecipientsJSONRequestBody, reqEditors ...RequestEditorFn) (*SetAlertRecipientsResp, error)
+
+	// UnsnoozeAlertRuleWithResponse request
+	UnsnoozeAlertRuleWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*UnsnoozeAlertRuleResp, error)
+
+	// SnoozeAlertRuleWithBodyWithResponse request with any body
+	SnoozeAlertRuleWithBodyWithResponse(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SnoozeAlertRuleResp, error)
+
+	SnoozeAlertRuleWithResponse(ctx context.Context, id int, body SnoozeAlertRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*SnoozeAlertRuleResp, error)
+
+	// ListApiKeysWithResponse request
+	ListApiKeysWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListApiKeysResp, error)
+
+	// CreateApiKeyWithBodyWithResponse request with any body
+	CreateApiKeyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateApiKeyResp, error)
+
+	CreateApiKeyWithResponse(ctx context.Context, body CreateApiKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateApiKeyResp, error)
+
+	// DeleteApiKeyWithResponse request
+	DeleteApiKeyWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*DeleteApiKeyResp, error)
+
+	// UpdateApiKeyExpiryWithBodyWithResponse request with any body
+	UpdateApiKeyExpiryWithBodyWithResponse(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateApiKeyExpiryResp, error)
+
+	UpdateApiKeyExpiryWithResponse(ctx context.Context, id int, body UpdateApiKeyExpiryJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateApiKeyExpiryResp, error)
+
+	// RevokeApiKeyWithResponse request
+	RevokeApiKeyWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*RevokeApiKeyResp, error)
+
+	// LoginWithBodyWithResponse request with any body
+	LoginWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LoginResp, error)
+
+	LoginWithResponse(ctx context.Context, body LoginJSONRequestBody, reqEditors ...RequestEditorFn) (*LoginResp, error)
+
+	// LogoutWithResponse request
+	LogoutWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*LogoutResp, error)
+
+	// GetCurrentUserWithResponse request
+	GetCurrentUserWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetCurrentUserResp, error)
+
+	// ListSessionsWithResponse request
+	ListSessionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListSessionsResp, error)
+
+	// RevokeSessionWithResponse request
+	RevokeSessionWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*RevokeSessionResp, error)
+
+	// GetAllAutomationRulesWithResponse request
+	GetAllAutomationRulesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAllAutomationRulesResp, error)
+
+	// CreateAutomationRuleWithBodyWithResponse request with any body
+	CreateAutomationRuleWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateAutomationRuleResp, error)
+
+	CreateAutomationRuleWithResponse(ctx context.Context, body CreateAutomationRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateAutomationRuleResp, error)
+
+	// DeleteAutomationRuleWithResponse request
+	DeleteAutomationRuleWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*DeleteAutomationRuleResp, error)
+
+	// GetAutomationRuleByIdWithResponse request
+	GetAutomationRuleByIdWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*GetAutomationRuleByIdResp, error)
+
+	// UpdateAutomationRuleWithBodyWithResponse request with any body
+	UpdateAutomationRuleWithBodyWithResponse(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateAutomationRuleResp, error)
+
+	UpdateAutomationRuleWithResponse(ctx context.Context, id int, body UpdateAutomationRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateAutomationRuleResp, error)
+
+	// ListDashboardsWithResponse request
+	ListDashboardsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListDashboardsResp, error)
+
+	// CreateDashboardWithBodyWithResponse request with any body
+	CreateDashboardWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDashboardResp, error)
+
+	CreateDashboardWithResponse(ctx context.Context, body CreateDashboardJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateDashboardResp, error)
+
+	// DeleteDashboardWithResponse request
+	DeleteDashboardWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*DeleteDashboardResp, error)
+
+	// GetDashboardWithResponse request
+	GetDashboardWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*GetDashboardResp, error)
+
+	// UpdateDashboardWithBodyWithResponse request with any body
+	UpdateDashboardWithBodyWithResponse(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateDashboardResp, error)
+
+	UpdateDashboardWithResponse(ctx context.Context, id int, body UpdateDashboardJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateDashboardResp, error)
+
+	// SetDefaultDashboardWithResponse request
+	SetDefaultDashboardWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*SetDefaultDashboardResp, error)
+
+	// ShareDashboardWithBodyWithResponse request with any body
+	ShareDashboardWithBodyWithResponse(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ShareDashboardResp, error)
+
+	ShareDashboardWithResponse(ctx context.Context, id int, body ShareDashboardJSONRequestBody, reqEditors ...RequestEditorFn) (*ShareDashboardResp, error)
+
+	// ListDriversWithResponse request
+	ListDriversWithResponse(ctx context.Context, params *ListDriversParams, reqEditors ...RequestEditorFn) (*ListDriversResp, error)
+
+	// GetHealthWithResponse request
+	GetHealthWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetHealthResp, error)
+
+	// GetAllMeasurementTypesWithResponse request
+	GetAllMeasurementTypesWithResponse(ctx context.Context, params *GetAllMeasurementTypesParams, reqEditors ...RequestEditorFn) (*GetAllMeasurementTypesResp, error)
+
+	// DeleteMeasurementTypeDownsamplingWithResponse request
+	DeleteMeasurementTypeDownsamplingWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*DeleteMeasurementTypeDownsamplingResp, error)
+
+	// SetMeasurementTypeDownsamplingWithBodyWithResponse request with any body
+	SetMeasurementTypeDownsamplingWithBodyWithResponse(ctx context.Context, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetMeasurementTypeDownsamplingResp, error)
+
+	SetMeasurementTypeDownsamplingWithResponse(ctx context.Context, name string, body SetMeasurementTypeDownsamplingJSONRequestBody, reqEditors ...RequestEditorFn) (*SetMeasurementTypeDownsamplingResp, error)
+
+	// ListMqttBrokersWithResponse request
+	ListMqttBrokersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListMqttBrokersResp, error)
+
+	// CreateMqttBrokerWithBodyWithResponse request with any body
+	CreateMqttBrokerWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateMqttBrokerResp, error)
+
+	CreateMqttBrokerWithResponse(ctx context.Context, body CreateMqttBrokerJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateMqttBrokerResp, error)
+
+	// DeleteMqttBrokerWithResponse request
+	DeleteMqttBrokerWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*DeleteMqttBrokerResp, error)
+
+	// GetMqttBrokerWithResponse request
+	GetMqttBrokerWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*GetMqttBrokerResp, error)
+
+	// UpdateMqttBrokerWithBodyWithResponse request with any body
+	UpdateMqttBrokerWithBodyWithResponse(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateMqttBrokerResp, error)
+
+	UpdateMqttBrokerWithResponse(ctx context.Context, id int, body UpdateMqttBrokerJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateMqttBrokerResp, error)
+
+	// GetMqttStatsWithResponse request
+	GetMqttStatsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetMqttStatsResp, error)
+
+	// ListMqttSubscriptionsWithResponse request
+	ListMqttSubscriptionsWithResponse(ctx context.Context, params *ListMqttSubscriptionsParams, reqEditors ...RequestEditorFn) (*ListMqttSubscriptionsResp, error)
+
+	// CreateMqttSubscriptionWithBodyWithResponse request with any body
+	CreateMqttSubscriptionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateMqttSubscriptionResp, error)
+
+	CreateMqttSubscriptionWithResponse(ctx context.Context, body CreateMqttSubscriptionJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateMqttSubscriptionResp, error)
+
+	// DeleteMqttSubscriptionWithResponse request
+	DeleteMqttSubscriptionWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*DeleteMqttSubscriptionResp, error)
+
+	// GetMqttSubscriptionWithResponse request
+	GetMqttSubscriptionWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*GetMqttSubscriptionResp, error)
+
+	// UpdateMqttSubscriptionWithBodyWithResponse request with any body
+	UpdateMqttSubscriptionWithBodyWithResponse(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateMqttSubscriptionResp, error)
+
+	UpdateMqttSubscriptionWithResponse(ctx context.Context, id int, body UpdateMqttSubscriptionJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateMqttSubscriptionResp, error)
+
+	// ListNotificationsWithResponse request
+	ListNotificationsWithResponse(ctx context.Context, params *ListNotificationsParams, reqEditors ...RequestEditorFn) (*ListNotificationsResp, error)
+
+	// BulkDismissWithResponse request
+	BulkDismissWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*BulkDismissResp, error)
+
+	// BulkMarkAsReadWithResponse request
+	BulkMarkAsReadWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*BulkMarkAsReadResp, error)
+
+	// GetChannelPreferencesWithResponse request
+	GetChannelPreferencesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetChannelPreferencesResp, error)
+
+	// SetChannelPreferenceWithBodyWithResponse request with any body
+	SetChannelPreferenceWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetChannelPreferenceResp, error)
+
+	SetChannelPreferenceWithResponse(ctx context.Context, body SetChannelPreferenceJSONRequestBody, reqEditors ...RequestEditorFn) (*SetChannelPreferenceResp, error)
+
+	// DeletePushSubscriptionWithResponse request
+	DeletePushSubscriptionWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DeletePushSubscriptionResp, error)
+
+	// GetPushSubscriptionWithResponse request
+	GetPushSubscriptionWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetPushSubscriptionResp, error)
+
+	// SetPushSubscriptionWithBodyWithResponse request with any body
+	SetPushSubscriptionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetPushSubscriptionResp, error)
+
+	SetPushSubscriptionWithResponse(ctx context.Context, body SetPushSubscriptionJSONRequestBody, reqEditors ...RequestEditorFn) (*SetPushSubscriptionResp, error)
+
+	// TestPushSubscriptionWithResponse request
+	TestPushSubscriptionWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*TestPushSubscriptionResp, error)
+
+	// DeleteQuietHoursWithResponse request
+	DeleteQuietHoursWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DeleteQuietHoursResp, error)
+
+	// GetQuietHoursWithResponse request
+	GetQuietHoursWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetQuietHoursResp, error)
+
+	// SetQuietHoursWithBodyWithResponse request with any body
+	SetQuietHoursWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetQuietHoursResp, error)
+
+	SetQuietHoursWithResponse(ctx context.Context, body SetQuietHoursJSONRequestBody, reqEditors ...RequestEditorFn) (*SetQuietHoursResp, error)
+
+	// GetUnreadCountWithResponse request
+	GetUnreadCountWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetUnreadCountResp, error)
+
+	// ListWebhookTargetsWithResponse request
+	ListWebhookTargetsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListWebhookTargetsResp, error)
+
+	// CreateWebhookTargetWithBodyWithResponse request with any body
+	CreateWebhookTargetWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateWebhookTargetResp, error)
+
+	CreateWebhookTargetWithResponse(ctx context.Context, body CreateWebhookTargetJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateWebhookTargetResp, error)
+
+	// DeleteWebhookTargetWithResponse request
+	DeleteWebhookTargetWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*DeleteWebhookTargetResp, error)
+
+	// UpdateWebhookTargetWithBodyWithResponse request with any body
+	UpdateWebhookTargetWithBodyWithResponse(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateWebhookTargetResp, error)
+
+	UpdateWebhookTargetWithResponse(ctx context.Context, id int, body UpdateWebhookTargetJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateWebhookTargetResp, error)
+
+	// GetWebhookDeliveriesWithResponse request
+	GetWebhookDeliveriesWithResponse(ctx context.Context, id int, params *GetWebhookDeliveriesParams, reqEditors ...RequestEditorFn) (*GetWebhookDeliveriesResp, error)
+
+	// TestWebhookTargetWithResponse request
+	TestWebhookTargetWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*TestWebhookTargetResp, error)
+
+	// NotificationsWebSocketWithResponse request
+	NotificationsWebSocketWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*NotificationsWebSocketResp, error)
+
+	// DismissNotificationWithResponse request
+	DismissNotificationWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*DismissNotificationResp, error)
+
+	// MarkAsReadWithResponse request
+	MarkAsReadWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*MarkAsReadResp, error)
+
+	// GetOAuthAuthorizeUrlWithResponse request
+	GetOAuthAuthorizeUrlWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetOAuthAuthorizeUrlResp, error)
+
+	// ReloadOAuthWithResponse request
+	ReloadOAuthWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ReloadOAuthResp, error)
+
+	// GetOAuthStatusWithResponse request
+	GetOAuthStatusWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetOAuthStatusResp, error)
+
+	// SubmitOAuthCodeWithBodyWithResponse request with any body
+	SubmitOAuthCodeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SubmitOAuthCodeResp, error)
+
+	SubmitOAuthCodeWithResponse(ctx context.Context, body SubmitOAuthCodeJSONRequestBody, reqEditors ...RequestEditorFn) (*SubmitOAuthCodeResp, error)
+
+	// GetOpenApiSpecWithResponse request
+	GetOpenApiSpecWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetOpenApiSpecResp, error)
+
+	// GetPropertiesWithResponse request
+	GetPropertiesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetPropertiesResp, error)
+
+	// UpdatePropertiesWithBodyWithResponse request with any body
+	UpdatePropertiesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdatePropertiesResp, error)
+
+	UpdatePropertiesWithResponse(ctx context.Context, body UpdatePropertiesJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdatePropertiesResp, error)
+
+	// PropertiesWebSocketWithResponse request
+	PropertiesWebSocketWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PropertiesWebSocketResp, error)
+
+	// GetReadingsBetweenDatesWithResponse request
+	GetReadingsBetweenDatesWithResponse(ctx context.Context, params *GetReadingsBetweenDatesParams, reqEditors ...RequestEditorFn) (*GetReadingsBetweenDatesResp, error)
+
+	// SubscribeCurrentReadingsWithResponse request
+	SubscribeCurrentReadingsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*SubscribeCurrentReadingsResp, error)
+
+	// ListRolesWithResponse request
+	ListRolesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListRolesResp, error)
+
+	// ListPermissionsWithResponse request
+	ListPermissionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListPermissionsResp, error)
+
+	// GetRolePermissionsWithResponse request
+	GetRolePermissionsWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*GetRolePermissionsResp, error)
+
+	// AssignPermissionWithBodyWithResponse request with any body
+	AssignPermissionWithBodyWithResponse(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AssignPermissionResp, error)
+
+	AssignPermissionWithResponse(ctx context.Context, id int, body AssignPermissionJSONRequestBody, reqEditors ...RequestEditorFn) (*AssignPermissionResp, error)
+
+	// RemovePermissionWithResponse request
+	RemovePermissionWithResponse(ctx context.Context, id int, pid int, reqEditors ...RequestEditorFn) (*RemovePermissionResp, error)
+
+	// GetAllScenesWithResponse request
+	GetAllScenesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAllScenesResp, error)
+
+	// CreateSceneWithBodyWithResponse request with any body
+	CreateSceneWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateSceneResp, error)
+
+	CreateSceneWithResponse(ctx context.Context, body CreateSceneJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateSceneResp, error)
+
+	// DeleteSceneWithResponse request
+	DeleteSceneWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*DeleteSceneResp, error)
+
+	// GetSceneByIdWithResponse request
+	GetSceneByIdWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*GetSceneByIdResp, error)
+
+	// UpdateSceneWithBodyWithResponse request with any body
+	UpdateSceneWithBodyWithResponse(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateSceneResp, error)
+
+	UpdateSceneWithResponse(ctx context.Context, id int, body UpdateSceneJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateSceneResp, error)
+
+	// ActivateSceneWithResponse request
+	ActivateSceneWithResponse(ctx context.Context, id int, params *ActivateSceneParams, reqEditors ...RequestEditorFn) (*ActivateSceneResp, error)
+
+	// GetAllSchedulesWithResponse request
+	GetAllSchedulesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAllSchedulesResp, error)
+
+	// CreateScheduleWithBodyWithResponse request with any body
+	CreateScheduleWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateScheduleResp, error)
+
+	CreateScheduleWithResponse(ctx context.Context, body CreateScheduleJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateScheduleResp, error)
+
+	// GetHolidayModeWithResponse request
+	GetHolidayModeWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetHolidayModeResp, error)
+
+	// SetHolidayModeWithBodyWithResponse request with any body
+	SetHolidayModeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetHolidayModeResp, error)
+
+	SetHolidayModeWithResponse(ctx context.Context, body SetHolidayModeJSONRequestBody, reqEditors ...RequestEditorFn) (*SetHolidayModeResp, error)
+
+	// DeleteScheduleWithResponse request
+	DeleteScheduleWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*DeleteScheduleResp, error)
+
+	// GetScheduleByIdWithResponse request
+	GetScheduleByIdWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*GetScheduleByIdResp, error)
+
+	// UpdateScheduleWithBodyWithResponse request with any body
+	UpdateScheduleWithBodyWithResponse(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateScheduleResp, error)
+
+	UpdateScheduleWithResponse(ctx context.Context, id int, body UpdateScheduleJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateScheduleResp, error)
+
+	// GetAllSensorsWithResponse request
+	GetAllSensorsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAllSensorsResp, error)
+
+	// AddSensorWithBodyWithResponse request with any body
+	AddSensorWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddSensorResp, error)
+
+	AddSensorWithResponse(ctx context.Context, body AddSensorJSONRequestBody, reqEditors ...RequestEditorFn) (*AddSensorResp, error)
+
+	// ApproveSensorWithResponse request
+	ApproveSensorWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*ApproveSensorResp, error)
+
+	// GetSensorCapabilitiesWithResponse request
+	GetSensorCapabilitiesWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*GetSensorCapabilitiesResp, error)
+
+	// GetSensorCommandHistoryWithResponse request
+	GetSensorCommandHistoryWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*GetSensorCommandHistoryResp, error)
+
+	// GetSensorDesiredStatesWithResponse request
+	GetSensorDesiredStatesWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*GetSensorDesiredStatesResp, error)
+
+	// DeleteSensorDesiredStateWithResponse request
+	DeleteSensorDesiredStateWithResponse(ctx context.Context, id int, property string, reqEditors ...RequestEditorFn) (*DeleteSensorDesiredStateResp, error)
+
+	// SetSensorDesiredStateWithBodyWithResponse request with any body
+	SetSensorDesiredStateWithBodyWithResponse(ctx context.Context, id int, property string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetSensorDesiredStateResp, error)
+
+	SetSensorDesiredStateWithResponse(ctx context.Context, id int, property string, body SetSensorDesiredStateJSONRequestBody, reqEditors ...RequestEditorFn) (*SetSensorDesiredStateResp, error)
+
+	// GetSensorMeasurementTypesWithResponse request
+	GetSensorMeasurementTypesWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*GetSensorMeasurementTypesResp, error)
+
+	// CollectAllSensorReadingsWithResponse request
+	CollectAllSensorReadingsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*CollectAllSensorReadingsResp, error)
+
+	// CollectFromSensorWithResponse request
+	CollectFromSensorWithResponse(ctx context.Context, sensorName string, reqEditors ...RequestEditorFn) (*CollectFromSensorResp, error)
+
+	// DisableSensorWithResponse request
+	DisableSensorWithResponse(ctx context.Context, sensorName string, reqEditors ...RequestEditorFn) (*DisableSensorResp, error)
+
+	// DismissSensorWithResponse request
+	DismissSensorWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*DismissSensorResp, error)
+
+	// GetSensorsByDriverWithResponse request
+	GetSensorsByDriverWithResponse(ctx context.Context, driver string, reqEditors ...RequestEditorFn) (*GetSensorsByDriverResp, error)
+
+	// EnableSensorWithResponse request
+	EnableSensorWithResponse(ctx context.Context, sensorName string, reqEditors ...RequestEditorFn) (*EnableSensorResp, error)
+
+	// GetSensorHealthHistoryByNameWithResponse request
+	GetSensorHealthHistoryByNameWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*GetSensorHealthHistoryByNameResp, error)
+
+	// GetTotalReadingsPerSensorWithResponse request
+	GetTotalReadingsPerSensorWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetTotalReadingsPerSensorResp, error)
+
+	// GetSensorsByStatusWithResponse request
+	GetSensorsByStatusWithResponse(ctx context.Context, status GetSensorsByStatusParamsStatus, reqEditors ...RequestEditorFn) (*GetSensorsByStatusResp, error)
+
+	// SubscribeAllSensorsWithResponse request
+	SubscribeAllSensorsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*SubscribeAllSensorsResp, error)
+
+	// SubscribeSensorsByDriverWithResponse request
+	SubscribeSensorsByDriverWithResponse(ctx context.Context, driver string, reqEditors ...RequestEditorFn) (*SubscribeSensorsByDriverResp, error)
+
+	// UpdateSensorByIdWithBodyWithResponse request with any body
+	UpdateSensorByIdWithBodyWithResponse(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateSensorByIdResp, error)
+
+	UpdateSensorByIdWithResponse(ctx context.Context, id int, body UpdateSensorByIdJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateSensorByIdResp, error)
+
+	// SendSensorCommandWithBodyWithResponse request with any body
+	SendSensorCommandWithBodyWithResponse(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SendSensorCommandResp, error)
+
+	SendSensorCommandWithResponse(ctx context.Context, id int, body SendSensorCommandJSONRequestBody, reqEditors ...RequestEditorFn) (*SendSensorCommandResp, error)
+
+	// DeleteSensorByNameWithResponse request
+	DeleteSensorByNameWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*DeleteSensorByNameResp, error)
+
+	// GetSensorByNameWithResponse request
+	GetSensorByNameWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*GetSensorByNameResp, error)
+
+	// SensorExistsWithResponse request
+	SensorExistsWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*SensorExistsResp, error)
+
+	// ListUsersWithResponse request
+	ListUsersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListUsersResp, error)
+
+	// CreateUserWithBodyWithResponse request with any body
+	CreateUserWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateUserResp, error)
+
+	CreateUserWithResponse(ctx context.Context, body CreateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateUserResp, error)
+
+	// ChangePasswordWithBodyWithResponse request with any body
+	ChangePasswordWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ChangePasswordResp, error)
+
+	ChangePasswordWithResponse(ctx context.Context, body ChangePasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*ChangePasswordResp, error)
+
+	// DeleteUserWithResponse request
+	DeleteUserWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*DeleteUserResp, error)
+
+	// SetMustChangePasswordWithBodyWithResponse request with any body
+	SetMustChangePasswordWithBodyWithResponse(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetMustChangePasswordResp, error)
+
+	SetMustChangePasswordWithResponse(ctx context.Context, id int, body SetMustChangePasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*SetMustChangePasswordResp, error)
+
+	// SetUserRolesWithBodyWithResponse request with any body
+	SetUserRolesWithBodyWithResponse(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetUserRolesResp, error)
+
+	SetUserRolesWithResponse(ctx context.Context, id int, body SetUserRolesJSONRequestBody, reqEditors ...RequestEditorFn) (*SetUserRolesResp, error)
+}
+
+type GetAllAlertRulesResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]AlertRule
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAllAlertRulesResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAllAlertRulesResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateAlertRuleResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *SuccessMessage
+	JSON400      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateAlertRuleResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateAlertRuleResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAllCompoundAlertRulesResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]CompoundAlertRule
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAllCompoundAlertRulesResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAllCompoundAlertRulesResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateCompoundAlertRuleResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *CompoundAlertRule
+	JSON400      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateCompoundAlertRuleResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateCompoundAlertRuleResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteCompoundAlertRuleResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SuccessMessage
+	JSON400      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteCompoundAlertRuleResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteCompoundAlertRuleResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetCompoundAlertRuleByIdResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CompoundAlertRule
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetCompoundAlertRuleByIdResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetCompoundAlertRuleByIdResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateCompoundAlertRuleResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SuccessMessage
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateCompoundAlertRuleResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateCompoundAlertRuleResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AcknowledgeCompoundAlertRuleResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SuccessMessage
+	JSON404      *ErrorResponse
+	JSON409      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r AcknowledgeCompoundAlertRuleResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AcknowledgeCompoundAlertRuleResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteCompoundAlertEscalationPolicyResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SuccessMessage
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteCompoundAlertEscalationPolicyResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteCompoundAlertEscalationPolicyResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetCompoundAlertEscalationPolicyResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *EscalationPolicy
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetCompoundAlertEscalationPolicyResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetCompoundAlertEscalationPolicyResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SetCompoundAlertEscalationPolicyResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SuccessMessage
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r SetCompoundAlertEscalationPolicyResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SetCompoundAlertEscalationPolicyResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteCompoundAlertRecipientsResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SuccessMessage
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteCompoundAlertRecipientsResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteCompoundAlertRecipientsResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetCompoundAlertRecipientsResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AlertRecipients
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetCompoundAlertRecipientsResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetCompoundAlertRecipientsResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SetCompoundAlertRecipientsResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SuccessMessage
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r SetCompoundAlertRecipientsResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SetCompoundAlertRecipientsResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UnsnoozeCompoundAlertRuleResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SuccessMessage
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UnsnoozeCompoundAlertRuleResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UnsnoozeCompoundAlertRuleResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SnoozeCompoundAlertRuleResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SuccessMessage
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r SnoozeCompoundAlertRuleResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SnoozeCompoundAlertRuleResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAllContactGroupsResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]ContactGroup
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAllContactGroupsResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAllContactGroupsResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateContactGroupResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *ContactGroup
+	JSON400      *ErrorResponse
+	JSON409      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateContactGroupResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateContactGroupResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteContactGroupResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SuccessMessage
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteContactGroupResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteContactGroupResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateContactGroupResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SuccessMessage
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON409      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateContactGroupResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateContactGroupResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AcknowledgeAlertHistoryEntryResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SuccessMessage
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r AcknowledgeAlertHistoryEntryResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AcknowledgeAlertHistoryEntryResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAlertRulesBySensorIdResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]AlertRule
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAlertRulesBySensorIdResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAlertRulesBySensorIdResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAlertHistoryResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]AlertHistoryEntry
+	JSON400      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAlertHistoryResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAlertHistoryResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAllSilenceWindowsResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]SilenceWindow
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAllSilenceWindowsResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAllSilenceWindowsResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateSilenceWindowResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *SilenceWindow
+	JSON400      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateSilenceWindowResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateSilenceWindowResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteSilenceWindowResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SuccessMessage
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteSilenceWindowResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteSilenceWindowResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateSilenceWindowResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SuccessMessage
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateSilenceWindowResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateSilenceWindowResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteAlertRuleResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SuccessMessage
+	JSON400      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteAlertRuleResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteAlertRuleResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAlertRuleByIdResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AlertRule
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAlertRuleByIdResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAlertRuleByIdResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateAlertRuleResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SuccessMessage
+	JSON400      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateAlertRuleResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateAlertRuleResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AcknowledgeAlertRuleResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SuccessMessage
+	JSON404      *ErrorResponse
+	JSON409      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r AcknowledgeAlertRuleResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AcknowledgeAlertRuleResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteAlertEscalationPolicyResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SuccessMessage
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteAlertEscalationPolicyResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteAlertEscalationPolicyResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAlertEscalationPolicyResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *EscalationPolicy
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAlertEscalationPolicyResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAlertEscalationPolicyResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SetAlertEscalationPolicyResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SuccessMessage
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r SetAlertEscalationPolicyResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SetAlertEscalationPolicyResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteAlertRecipientsResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SuccessMessage
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteAlertRecipientsResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteAlertRecipientsResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAlertRecipientsResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AlertRecipients
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAlertRecipientsResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAlertRecipientsResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SetAlertRecipientsResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SuccessMessage
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r SetAlertRecipientsResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SetAlertRecipientsResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UnsnoozeAlertRuleResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SuccessMessage
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UnsnoozeAlertRuleResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UnsnoozeAlertRuleResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SnoozeAlertRuleResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SuccessMessage
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r SnoozeAlertRuleResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SnoozeAlertRuleResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListApiKeysResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]ApiKey
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListApiKeysResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListApiKeysResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateApiKeyResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *struct {
+		// Key The full API key. Store it securely — it will not be shown again.
+		Key     *string `json:"key,omitempty"`
+		Message *string `json:"message,omitempty"`
+	}
+	JSON500 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateApiKeyResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateApiKeyResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteApiKeyResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SuccessMessage
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteApiKeyResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteApiKeyResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateApiKeyExpiryResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SuccessMessage
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateApiKeyExpiryResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateApiKeyExpiryResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RevokeApiKeyResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SuccessMessage
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r RevokeApiKeyResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RevokeApiKeyResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LoginResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *LoginResponse
+	JSON401      *ErrorResponse
+	JSON429      *RateLimitResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r LoginResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LoginResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LogoutResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r LogoutResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LogoutResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetCurrentUserResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *MeResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetCurrentUserResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetCurrentUserResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListSessionsResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]SessionInfo
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListSessionsResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListSessionsResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RevokeSessionResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r RevokeSessionResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RevokeSessionResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAllAutomationRulesResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]AutomationRule
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAllAutomationRulesResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAllAutomationRulesResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateAutomationRuleResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *AutomationRule
+	JSON400      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateAutomationRuleResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateAutomationRuleResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteAutomationRuleResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SuccessMessage
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteAutomationRuleResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteAutomationRuleResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAutomationRuleByIdResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AutomationRule
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAutomationRuleByIdResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAutomationRuleByIdResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateAutomationRuleResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SuccessMessage
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateAutomationRuleResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateAutomationRuleResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListDashboardsResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Dashboard
+}
+
+// Status returns HTTPResponse.Status
+func (r ListDashboardsResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListDashboardsResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateDashboardResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *struct {
+		Id *int `json:"id,omitempty"`
+	}
+	JSON500 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateDashboardResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateDashboardResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteDashboardResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SuccessMessage
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteDashboardResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteDashboardResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDashboardResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Dashboard
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDashboardResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDashboardResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateDashboardResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SuccessMessage
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateDashboardResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateDashboardResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SetDefaultDashboardResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SuccessMessage
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r SetDefaultDashboardResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SetDefaultDashboardResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ShareDashboardResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SuccessMessage
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ShareDashboardResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ShareDashboardResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListDriversResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]DriverInfo
+}
+
+// Status returns HTTPResponse.Status
+func (r ListDriversResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListDriversResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetHealthResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Status *string `json:"status,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r GetHealthResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetHealthResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAllMeasurementTypesResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]MeasurementType
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAllMeasurementTypesResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAllMeasurementTypesResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteMeasurementTypeDownsamplingResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *MeasurementType
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteMeasurementTypeDownsamplingResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteMeasurementTypeDownsamplingResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SetMeasurementTypeDownsamplingResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *MeasurementType
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r SetMeasurementTypeDownsamplingResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SetMeasurementTypeDownsamplingResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListMqttBrokersResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]MQTTBroker
+}
+
+// Status returns HTTPResponse.Status
+func (r ListMqttBrokersResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListMqttBrokersResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateMqttBrokerResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *struct {
+		Id *int `json:"id,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateMqttBrokerResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateMqttBrokerResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteMqttBrokerResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteMqttBrokerResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteMqttBrokerResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetMqttBrokerResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *MQTTBroker
+}
+
+// Status returns HTTPResponse.Status
+func (r GetMqttBrokerResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetMqttBrokerResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateMqttBrokerResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SuccessMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateMqttBrokerResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateMqttBrokerResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetMqttStatsResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]MQTTBrokerStats
+}
+
+// Status returns HTTPResponse.Status
+func (r GetMqttStatsResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetMqttStatsResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListMqttSubscriptionsResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]MQTTSubscription
+}
+
+// Status returns HTTPResponse.Status
+func (r ListMqttSubscriptionsResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListMqttSubscriptionsResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateMqttSubscriptionResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *struct {
+		Id *int `json:"id,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateMqttSubscriptionResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateMqttSubscriptionResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteMqttSubscriptionResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteMqttSubscriptionResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteMqttSubscriptionResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetMqttSubscriptionResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *MQTTSubscription
+}
+
+// Status returns HTTPResponse.Status
+func (r GetMqttSubscriptionResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetMqttSubscriptionResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateMqttSubscriptionResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SuccessMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateMqttSubscriptionResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateMqttSubscriptionResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListNotificationsResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]UserNotification
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListNotificationsResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListNotificationsResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type BulkDismissResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SuccessMessage
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r BulkDismissResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r BulkDismissResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type BulkMarkAsReadResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SuccessMessage
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r BulkMarkAsReadResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r BulkMarkAsReadResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetChannelPreferencesResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]ChannelPreference
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetChannelPreferencesResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetChannelPreferencesResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SetChannelPreferenceResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SuccessMessage
+	JSON400      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r SetChannelPreferenceResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SetChannelPreferenceResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeletePushSubscriptionResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SuccessMessage
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeletePushSubscriptionResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeletePushSubscriptionResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetPushSubscriptionResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PushSubscription
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetPushSubscriptionResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetPushSubscriptionResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SetPushSubscriptionResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PushSubscription
+	JSON400      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r SetPushSubscriptionResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SetPushSubscriptionResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TestPushSubscriptionResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SuccessMessage
+	JSON404      *ErrorResponse
+	JSON502      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r TestPushSubscriptionResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TestPushSubscriptionResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteQuietHoursResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SuccessMessage
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteQuietHoursResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteQuietHoursResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetQuietHoursResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *QuietHours
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetQuietHoursResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetQuietHoursResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SetQuietHoursResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *QuietHours
+	JSON400      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r SetQuietHoursResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SetQuietHoursResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetUnreadCountResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Count *int `json:"count,omitempty"`
+	}
+	JSON500 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetUnreadCountResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUnreadCountResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListWebhookTargetsResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]WebhookTarget
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListWebhookTargetsResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListWebhookTargetsResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateWebhookTargetResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *WebhookTarget
+	JSON400      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateWebhookTargetResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateWebhookTargetResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteWebhookTargetResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SuccessMessage
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteWebhookTargetResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteWebhookTargetResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateWebhookTargetResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SuccessMessage
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateWebhookTargetResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateWebhookTargetResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetWebhookDeliveriesResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]WebhookDelivery
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetWebhookDeliveriesResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetWebhookDeliveriesResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TestWebhookTargetResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *WebhookDelivery
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r TestWebhookTargetResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TestWebhookTargetResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type NotificationsWebSocketResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r NotificationsWebSocketResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r NotificationsWebSocketResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DismissNotificationResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SuccessMessage
+	JSON400      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DismissNotificationResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DismissNotificationResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type MarkAsReadResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SuccessMessage
+	JSON400      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r MarkAsReadResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r MarkAsReadResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetOAuthAuthorizeUrlResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *OAuthAuthorizeResponse
+	JSON500      *ErrorResponse
+	JSON503      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetOAuthAuthorizeUrlResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetOAuthAuthorizeUrlResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ReloadOAuthResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SuccessMessage
+	JSON500      *ErrorResponse
+	JSON503      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ReloadOAuthResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ReloadOAuthResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
 type GetOAuthStatusResp struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *OAuthStatus
-	JSON503      *ErrorResponse
+	JSON200      *OAuthStatus
+	JSON503      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetOAuthStatusResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetOAuthStatusResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SubmitOAuthCodeResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SuccessMessage
+	JSON400      *ErrorResponse
+	JSON500      *ErrorResponse
+	JSON503      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r SubmitOAuthCodeResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SubmitOAuthCodeResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetOpenApiSpecResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	YAML200      *string
+}
+
+// Status returns HTTPResponse.Status
+func (r GetOpenApiSpecResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetOpenApiSpecResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetPropertiesResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PropertiesMap
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetPropertiesResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetPropertiesResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdatePropertiesResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON202      *OperationAccepted
+	JSON400      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdatePropertiesResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdatePropertiesResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PropertiesWebSocketResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PropertiesWebSocketResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PropertiesWebSocketResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetReadingsBetweenDatesResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AggregatedReadingsResponse
+	JSON400      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetReadingsBetweenDatesResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetReadingsBetweenDatesResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SubscribeCurrentReadingsResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r SubscribeCurrentReadingsResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SubscribeCurrentReadingsResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListRolesResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]RoleInfo
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListRolesResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListRolesResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListPermissionsResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]PermissionInfo
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListPermissionsResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListPermissionsResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetRolePermissionsResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]PermissionInfo
+	JSON400      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetRolePermissionsResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetRolePermissionsResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AssignPermissionResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r AssignPermissionResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AssignPermissionResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RemovePermissionResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r RemovePermissionResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RemovePermissionResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAllScenesResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Scene
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAllScenesResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAllScenesResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateSceneResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Scene
+	JSON400      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateSceneResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateSceneResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteSceneResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SuccessMessage
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteSceneResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteSceneResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetSceneByIdResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Scene
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetSceneByIdResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSceneByIdResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateSceneResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SuccessMessage
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateSceneResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateSceneResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ActivateSceneResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SceneActivation
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ActivateSceneResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ActivateSceneResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAllSchedulesResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]CommandSchedule
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAllSchedulesResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAllSchedulesResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateScheduleResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *CommandSchedule
+	JSON400      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateScheduleResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateScheduleResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetHolidayModeResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HolidayMode
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetHolidayModeResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetHolidayModeResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SetHolidayModeResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HolidayMode
+	JSON400      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r SetHolidayModeResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SetHolidayModeResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteScheduleResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SuccessMessage
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteScheduleResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteScheduleResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetScheduleByIdResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CommandSchedule
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetScheduleByIdResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetScheduleByIdResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateScheduleResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SuccessMessage
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateScheduleResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateScheduleResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAllSensorsResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Sensor
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAllSensorsResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAllSensorsResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AddSensorResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Sensor
+	JSON400      *ErrorResponse
+	JSON409      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r AddSensorResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AddSensorResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ApproveSensorResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SuccessMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r ApproveSensorResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ApproveSensorResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetSensorCapabilitiesResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Capability
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetSensorCapabilitiesResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSensorCapabilitiesResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetSensorCommandHistoryResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]CommandHistoryEntry
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetSensorCommandHistoryResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSensorCommandHistoryResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetSensorDesiredStatesResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]DesiredState
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetSensorDesiredStatesResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSensorDesiredStatesResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteSensorDesiredStateResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SuccessMessage
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteSensorDesiredStateResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteSensorDesiredStateResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SetSensorDesiredStateResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DesiredState
+	JSON400      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r SetSensorDesiredStateResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SetSensorDesiredStateResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetSensorMeasurementTypesResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]MeasurementType
+}
+
+// Status returns HTTPResponse.Status
+func (r GetSensorMeasurementTypesResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSensorMeasurementTypesResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CollectAllSensorReadingsResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SuccessMessage
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CollectAllSensorReadingsResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CollectAllSensorReadingsResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CollectFromSensorResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SuccessMessage
+	JSON400      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CollectFromSensorResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CollectFromSensorResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DisableSensorResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SuccessMessage
+	JSON400      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DisableSensorResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DisableSensorResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DismissSensorResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SuccessMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r DismissSensorResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DismissSensorResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetSensorsByDriverResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Sensor
+}
+
+// Status returns HTTPResponse.Status
+func (r GetSensorsByDriverResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSensorsByDriverResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type EnableSensorResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SuccessMessage
+	JSON400      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r EnableSensorResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r EnableSensorResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetSensorHealthHistoryByNameResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]SensorHealthHistory
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetSensorHealthHistoryByNameResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSensorHealthHistoryByNameResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTotalReadingsPerSensorResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *map[string]int
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTotalReadingsPerSensorResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTotalReadingsPerSensorResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetSensorsByStatusResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Sensor
+}
+
+// Status returns HTTPResponse.Status
+func (r GetSensorsByStatusResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSensorsByStatusResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SubscribeAllSensorsResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r SubscribeAllSensorsResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SubscribeAllSensorsResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SubscribeSensorsByDriverResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r SubscribeSensorsByDriverResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SubscribeSensorsByDriverResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateSensorByIdResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Sensor
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateSensorByIdResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateSensorByIdResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SendSensorCommandResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON202      *SensorCommandAccepted
+	JSON400      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON409      *ErrorResponse
+	JSON429      *ErrorResponse
+	JSON503      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r SendSensorCommandResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SendSensorCommandResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteSensorByNameResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SuccessMessage
+	JSON400      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteSensorByNameResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteSensorByNameResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetSensorByNameResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Sensor
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetSensorByNameResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSensorByNameResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SensorExistsResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r SensorExistsResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SensorExistsResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListUsersResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]User
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListUsersResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListUsersResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateUserResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *struct {
+		// Id ID of the created user
+		Id *int `json:"id,omitempty"`
+	}
+	JSON400 *ErrorResponse
+	JSON500 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateUserResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateUserResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ChangePasswordResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ChangePasswordResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ChangePasswordResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteUserResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteUserResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteUserResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SetMustChangePasswordResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r SetMustChangePasswordResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SetMustChangePasswordResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SetUserRolesResp struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r SetUserRolesResp) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SetUserRolesResp) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// GetAllAlertRulesWithResponse request returning *GetAllAlertRulesResp
+func (c *ClientWithResponses) GetAllAlertRulesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAllAlertRulesResp, error) {
+	rsp, err := c.GetAllAlertRules(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAllAlertRulesResp(rsp)
+}
+
+// CreateAlertRuleWithBodyWithResponse request with arbitrary body returning *CreateAlertRuleResp
+func (c *ClientWithResponses) CreateAlertRuleWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateAlertRuleResp, error) {
+	rsp, err := c.CreateAlertRuleWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateAlertRuleResp(rsp)
+}
+
+func (c *ClientWithResponses) CreateAlertRuleWithResponse(ctx context.Context, body CreateAlertRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateAlertRuleResp, error) {
+	rsp, err := c.CreateAlertRule(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateAlertRuleResp(rsp)
+}
+
+// GetAllCompoundAlertRulesWithResponse request returning *GetAllCompoundAlertRulesResp
+func (c *ClientWithResponses) GetAllCompoundAlertRulesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAllCompoundAlertRulesResp, error) {
+	rsp, err := c.GetAllCompoundAlertRules(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAllCompoundAlertRulesResp(rsp)
+}
+
+// CreateCompoundAlertRuleWithBodyWithResponse request with arbitrary body returning *CreateCompoundAlertRuleResp
+func (c *ClientWithResponses) CreateCompoundAlertRuleWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateCompoundAlertRuleResp, error) {
+	rsp, err := c.CreateCompoundAlertRuleWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateCompoundAlertRuleResp(rsp)
+}
+
+func (c *ClientWithResponses) CreateCompoundAlertRuleWithResponse(ctx context.Context, body CreateCompoundAlertRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateCompoundAlertRuleResp, error) {
+	rsp, err := c.CreateCompoundAlertRule(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateCompoundAlertRuleResp(rsp)
+}
+
+// DeleteCompoundAlertRuleWithResponse request returning *DeleteCompoundAlertRuleResp
+func (c *ClientWithResponses) DeleteCompoundAlertRuleWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*DeleteCompoundAlertRuleResp, error) {
+	rsp, err := c.DeleteCompoundAlertRule(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteCompoundAlertRuleResp(rsp)
+}
+
+// GetCompoundAlertRuleByIdWithResponse request returning *GetCompoundAlertRuleByIdResp
+func (c *ClientWithResponses) GetCompoundAlertRuleByIdWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*GetCompoundAlertRuleByIdResp, error) {
+	rsp, err := c.GetCompoundAlertRuleById(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetCompoundAlertRuleByIdResp(rsp)
+}
+
+// UpdateCompoundAlertRuleWithBodyWithResponse request with arbitrary body returning *UpdateCompoundAlertRuleResp
+func (c *ClientWithResponses) UpdateCompoundAlertRuleWithBodyWithResponse(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateCompoundAlertRuleResp, error) {
+	rsp, err := c.UpdateCompoundAlertRuleWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateCompoundAlertRuleResp(rsp)
+}
+
+func (c *ClientWithResponses) UpdateCompoundAlertRuleWithResponse(ctx context.Context, id int, body UpdateCompoundAlertRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateCompoundAlertRuleResp, error) {
+	rsp, err := c.UpdateCompoundAlertRule(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateCompoundAlertRuleResp(rsp)
+}
+
+// AcknowledgeCompoundAlertRuleWithResponse request returning *AcknowledgeCompoundAlertRuleResp
+func (c *ClientWithResponses) AcknowledgeCompoundAlertRuleWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*AcknowledgeCompoundAlertRuleResp, error) {
+	rsp, err := c.AcknowledgeCompoundAlertRule(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAcknowledgeCompoundAlertRuleResp(rsp)
+}
+
+// DeleteCompoundAlertEscalationPolicyWithResponse request returning *DeleteCompoundAlertEscalationPolicyResp
+func (c *ClientWithResponses) DeleteCompoundAlertEscalationPolicyWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*DeleteCompoundAlertEscalationPolicyResp, error) {
+	rsp, err := c.DeleteCompoundAlertEscalationPolicy(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteCompoundAlertEscalationPolicyResp(rsp)
+}
+
+// GetCompoundAlertEscalationPolicyWithResponse request returning *GetCompoundAlertEscalationPolicyResp
+func (c *ClientWithResponses) GetCompoundAlertEscalationPolicyWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*GetCompoundAlertEscalationPolicyResp, error) {
+	rsp, err := c.GetCompoundAlertEscalationPolicy(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetCompoundAlertEscalationPolicyResp(rsp)
+}
+
+// SetCompoundAlertEscalationPolicyWithBodyWithResponse request with arbitrary body returning *SetCompoundAlertEscalationPolicyResp
+func (c *ClientWithResponses) SetCompoundAlertEscalationPolicyWithBodyWithResponse(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetCompoundAlertEscalationPolicyResp, error) {
+	rsp, err := c.SetCompoundAlertEscalationPolicyWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetCompoundAlertEscalationPolicyResp(rsp)
+}
+
+func (c *ClientWithResponses) SetCompoundAlertEscalationPolicyWithResponse(ctx context.Context, id int, body SetCompoundAlertEscalationPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*SetCompoundAlertEscalationPolicyResp, error) {
+	rsp, err := c.SetCompoundAlertEscalationPolicy(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetCompoundAlertEscalationPolicyResp(rsp)
+}
+
+// DeleteCompoundAlertRecipientsWithResponse request returning *DeleteCompoundAlertRecipientsResp
+func (c *ClientWithResponses) DeleteCompoundAlertRecipientsWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*DeleteCompoundAlertRecipientsResp, error) {
+	rsp, err := c.DeleteCompoundAlertRecipients(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteCompoundAlertRecipientsResp(rsp)
+}
+
+// GetCompoundAlertRecipientsWithResponse request returning *GetCompoundAlertRecipientsResp
+func (c *ClientWithResponses) GetCompoundAlertRecipientsWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*GetCompoundAlertRecipientsResp, error) {
+	rsp, err := c.GetCompoundAlertRecipients(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetCompoundAlertRecipientsResp(rsp)
+}
+
+// SetCompoundAlertRecipientsWithBodyWithResponse request with arbitrary body returning *SetCompoundAlertRecipientsResp
+func (c *ClientWithResponses) SetCompoundAlertRecipientsWithBodyWithResponse(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetCompoundAlertRecipientsResp, error) {
+	rsp, err := c.SetCompoundAlertRecipientsWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetCompoundAlertRecipientsResp(rsp)
+}
+
+func (c *ClientWithResponses) SetCompoundAlertRecipientsWithResponse(ctx context.Context, id int, body SetCompoundAlertRecipientsJSONRequestBody, reqEditors ...RequestEditorFn) (*SetCompoundAlertRecipientsResp, error) {
+	rsp, err := c.SetCompoundAlertRecipients(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetCompoundAlertRecipientsResp(rsp)
+}
+
+// UnsnoozeCompoundAlertRuleWithResponse request returning *UnsnoozeCompoundAlertRuleResp
+func (c *ClientWithResponses) UnsnoozeCompoundAlertRuleWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*UnsnoozeCompoundAlertRuleResp, error) {
+	rsp, err := c.UnsnoozeCompoundAlertRule(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUnsnoozeCompoundAlertRuleResp(rsp)
+}
+
+// SnoozeCompoundAlertRuleWithBodyWithResponse request with arbitrary body returning *SnoozeCompoundAlertRuleResp
+func (c *ClientWithResponses) SnoozeCompoundAlertRuleWithBodyWithResponse(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SnoozeCompoundAlertRuleResp, error) {
+	rsp, err := c.SnoozeCompoundAlertRuleWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSnoozeCompoundAlertRuleResp(rsp)
+}
+
+func (c *ClientWithResponses) SnoozeCompoundAlertRuleWithResponse(ctx context.Context, id int, body SnoozeCompoundAlertRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*SnoozeCompoundAlertRuleResp, error) {
+	rsp, err := c.SnoozeCompoundAlertRule(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSnoozeCompoundAlertRuleResp(rsp)
+}
+
+// GetAllContactGroupsWithResponse request returning *GetAllContactGroupsResp
+func (c *ClientWithResponses) GetAllContactGroupsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAllContactGroupsResp, error) {
+	rsp, err := c.GetAllContactGroups(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAllContactGroupsResp(rsp)
+}
+
+// CreateContactGroupWithBodyWithResponse request with arbitrary body returning *CreateContactGroupResp
+func (c *ClientWithResponses) CreateContactGroupWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateContactGroupResp, error) {
+	rsp, err := c.CreateContactGroupWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateContactGroupResp(rsp)
+}
+
+func (c *ClientWithResponses) CreateContactGroupWithResponse(ctx context.Context, body CreateContactGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateContactGroupResp, error) {
+	rsp, err := c.CreateContactGroup(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateContactGroupResp(rsp)
+}
+
+// DeleteContactGroupWithResponse request returning *DeleteContactGroupResp
+func (c *ClientWithResponses) DeleteContactGroupWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*DeleteContactGroupResp, error) {
+	rsp, err := c.DeleteContactGroup(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteContactGroupResp(rsp)
+}
+
+// UpdateContactGroupWithBodyWithResponse request with arbitrary body returning *UpdateContactGroupResp
+func (c *ClientWithResponses) UpdateContactGroupWithBodyWithResponse(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateContactGroupResp, error) {
+	rsp, err := c.UpdateContactGroupWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateContactGroupResp(rsp)
+}
+
+func (c *ClientWithResponses) UpdateContactGroupWithResponse(ctx context.Context, id int, body UpdateContactGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateContactGroupResp, error) {
+	rsp, err := c.UpdateContactGroup(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateContactGroupResp(rsp)
+}
+
+// AcknowledgeAlertHistoryEntryWithResponse request returning *AcknowledgeAlertHistoryEntryResp
+func (c *ClientWithResponses) AcknowledgeAlertHistoryEntryWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*AcknowledgeAlertHistoryEntryResp, error) {
+	rsp, err := c.AcknowledgeAlertHistoryEntry(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAcknowledgeAlertHistoryEntryResp(rsp)
+}
+
+// GetAlertRulesBySensorIdWithResponse request returning *GetAlertRulesBySensorIdResp
+func (c *ClientWithResponses) GetAlertRulesBySensorIdWithResponse(ctx context.Context, sensorId int, reqEditors ...RequestEditorFn) (*GetAlertRulesBySensorIdResp, error) {
+	rsp, err := c.GetAlertRulesBySensorId(ctx, sensorId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAlertRulesBySensorIdResp(rsp)
+}
+
+// GetAlertHistoryWithResponse request returning *GetAlertHistoryResp
+func (c *ClientWithResponses) GetAlertHistoryWithResponse(ctx context.Context, sensorId int, params *GetAlertHistoryParams, reqEditors ...RequestEditorFn) (*GetAlertHistoryResp, error) {
+	rsp, err := c.GetAlertHistory(ctx, sensorId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAlertHistoryResp(rsp)
+}
+
+// GetAllSilenceWindowsWithResponse request returning *GetAllSilenceWindowsResp
+func (c *ClientWithResponses) GetAllSilenceWindowsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAllSilenceWindowsResp, error) {
+	rsp, err := c.GetAllSilenceWindows(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAllSilenceWindowsResp(rsp)
+}
+
+// CreateSilenceWindowWithBodyWithResponse request with arbitrary body returning *CreateSilenceWindowResp
+func (c *ClientWithResponses) CreateSilenceWindowWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateSilenceWindowResp, error) {
+	rsp, err := c.CreateSilenceWindowWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateSilenceWindowResp(rsp)
+}
+
+func (c *ClientWithResponses) CreateSilenceWindowWithResponse(ctx context.Context, body CreateSilenceWindowJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateSilenceWindowResp, error) {
+	rsp, err := c.CreateSilenceWindow(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateSilenceWindowResp(rsp)
+}
+
+// DeleteSilenceWindowWithResponse request returning *DeleteSilenceWindowResp
+func (c *ClientWithResponses) DeleteSilenceWindowWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*DeleteSilenceWindowResp, error) {
+	rsp, err := c.DeleteSilenceWindow(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteSilenceWindowResp(rsp)
+}
+
+// UpdateSilenceWindowWithBodyWithResponse request with arbitrary body returning *UpdateSilenceWindowResp
+func (c *ClientWithResponses) UpdateSilenceWindowWithBodyWithResponse(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateSilenceWindowResp, error) {
+	rsp, err := c.UpdateSilenceWindowWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateSilenceWindowResp(rsp)
+}
+
+func (c *ClientWithResponses) UpdateSilenceWindowWithResponse(ctx context.Context, id int, body UpdateSilenceWindowJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateSilenceWindowResp, error) {
+	rsp, err := c.UpdateSilenceWindow(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateSilenceWindowResp(rsp)
+}
+
+// DeleteAlertRuleWithResponse request returning *DeleteAlertRuleResp
+func (c *ClientWithResponses) DeleteAlertRuleWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*DeleteAlertRuleResp, error) {
+	rsp, err := c.DeleteAlertRule(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteAlertRuleResp(rsp)
+}
+
+// GetAlertRuleByIdWithResponse request returning *GetAlertRuleByIdResp
+func (c *ClientWithResponses) GetAlertRuleByIdWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*GetAlertRuleByIdResp, error) {
+	rsp, err := c.GetAlertRuleById(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAlertRuleByIdResp(rsp)
+}
+
+// UpdateAlertRuleWithBodyWithResponse request with arbitrary body returning *UpdateAlertRuleResp
+func (c *ClientWithResponses) UpdateAlertRuleWithBodyWithResponse(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateAlertRuleResp, error) {
+	rsp, err := c.UpdateAlertRuleWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateAlertRuleResp(rsp)
+}
+
+func (c *ClientWithResponses) UpdateAlertRuleWithResponse(ctx context.Context, id int, body UpdateAlertRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateAlertRuleResp, error) {
+	rsp, err := c.UpdateAlertRule(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateAlertRuleResp(rsp)
+}
+
+// AcknowledgeAlertRuleWithResponse request returning *AcknowledgeAlertRuleResp
+func (c *ClientWithResponses) AcknowledgeAlertRuleWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*AcknowledgeAlertRuleResp, error) {
+	rsp, err := c.AcknowledgeAlertRule(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAcknowledgeAlertRuleResp(rsp)
+}
+
+// DeleteAlertEscalationPolicyWithResponse request returning *DeleteAlertEscalationPolicyResp
+func (c *ClientWithResponses) DeleteAlertEscalationPolicyWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*DeleteAlertEscalationPolicyResp, error) {
+	rsp, err := c.DeleteAlertEscalationPolicy(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteAlertEscalationPolicyResp(rsp)
+}
+
+// GetAlertEscalationPolicyWithResponse request returning *GetAlertEscalationPolicyResp
+func (c *ClientWithResponses) GetAlertEscalationPolicyWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*GetAlertEscalationPolicyResp, error) {
+	rsp, err := c.GetAlertEscalationPolicy(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAlertEscalationPolicyResp(rsp)
+}
+
+// SetAlertEscalationPolicyWithBodyWithResponse request with arbitrary body returning *SetAlertEscalationPolicyResp
+func (c *ClientWithResponses) SetAlertEscalationPolicyWithBodyWithResponse(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetAlertEscalationPolicyResp, error) {
+	rsp, err := c.SetAlertEscalationPolicyWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetAlertEscalationPolicyResp(rsp)
+}
+
+func (c *ClientWithResponses) SetAlertEscalationPolicyWithResponse(ctx context.Context, id int, body SetAlertEscalationPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*SetAlertEscalationPolicyResp, error) {
+	rsp, err := c.SetAlertEscalationPolicy(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetAlertEscalationPolicyResp(rsp)
+}
+
+// DeleteAlertRecipientsWithResponse request returning *DeleteAlertRecipientsResp
+func (c *ClientWithResponses) DeleteAlertRecipientsWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*DeleteAlertRecipientsResp, error) {
+	rsp, err := c.DeleteAlertRecipients(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteAlertRecipientsResp(rsp)
+}
+
+// GetAlertRecipientsWithResponse request returning *GetAlertRecipientsResp
+func (c *ClientWithResponses) GetAlertRecipientsWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*GetAlertRecipientsResp, error) {
+	rsp, err := c.GetAlertRecipients(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAlertRecipientsResp(rsp)
+}
+
+// SetAlertRecipientsWithBodyWithResponse request with arbitrary body returning *SetAlertRecipientsResp
+func (c *ClientWithResponses) SetAlertRecipientsWithBodyWithResponse(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetAlertRecipientsResp, error) {
+	rsp, err := c.SetAlertRecipientsWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetAlertRecipientsResp(rsp)
+}
+
+func (c *ClientWithResponses) SetAlertRecipientsWithResponse(ctx context.Context, id int, body SetAlertRecipientsJSONRequestBody, reqEditors ...RequestEditorFn) (*SetAlertRecipientsResp, error) {
+	rsp, err := c.SetAlertRecipients(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetAlertRecipientsResp(rsp)
+}
+
+// UnsnoozeAlertRuleWithResponse request returning *UnsnoozeAlertRuleResp
+func (c *ClientWithResponses) UnsnoozeAlertRuleWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*UnsnoozeAlertRuleResp, error) {
+	rsp, err := c.UnsnoozeAlertRule(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUnsnoozeAlertRuleResp(rsp)
+}
+
+// SnoozeAlertRuleWithBodyWithResponse request with arbitrary body returning *SnoozeAlertRuleResp
+func (c *ClientWithResponses) SnoozeAlertRuleWithBodyWithResponse(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SnoozeAlertRuleResp, error) {
+	rsp, err := c.SnoozeAlertRuleWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSnoozeAlertRuleResp(rsp)
+}
+
+func (c *ClientWithResponses) SnoozeAlertRuleWithResponse(ctx context.Context, id int, body SnoozeAlertRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*SnoozeAlertRuleResp, error) {
+	rsp, err := c.SnoozeAlertRule(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSnoozeAlertRuleResp(rsp)
+}
+
+// ListApiKeysWithResponse request returning *ListApiKeysResp
+func (c *ClientWithResponses) ListApiKeysWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListApiKeysResp, error) {
+	rsp, err := c.ListApiKeys(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListApiKeysResp(rsp)
+}
+
+// CreateApiKeyWithBodyWithResponse request with arbitrary body returning *CreateApiKeyResp
+func (c *ClientWithResponses) CreateApiKeyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateApiKeyResp, error) {
+	rsp, err := c.CreateApiKeyWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateApiKeyResp(rsp)
+}
+
+func (c *ClientWithResponses) CreateApiKeyWithResponse(ctx context.Context, body CreateApiKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateApiKeyResp, error) {
+	rsp, err := c.CreateApiKey(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateApiKeyResp(rsp)
+}
+
+// DeleteApiKeyWithResponse request returning *DeleteApiKeyResp
+func (c *ClientWithResponses) DeleteApiKeyWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*DeleteApiKeyResp, error) {
+	rsp, err := c.DeleteApiKey(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteApiKeyResp(rsp)
+}
+
+// UpdateApiKeyExpiryWithBodyWithResponse request with arbitrary body returning *UpdateApiKeyExpiryResp
+func (c *ClientWithResponses) UpdateApiKeyExpiryWithBodyWithResponse(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateApiKeyExpiryResp, error) {
+	rsp, err := c.UpdateApiKeyExpiryWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateApiKeyExpiryResp(rsp)
+}
+
+func (c *ClientWithResponses) UpdateApiKeyExpiryWithResponse(ctx context.Context, id int, body UpdateApiKeyExpiryJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateApiKeyExpiryResp, error) {
+	rsp, err := c.UpdateApiKeyExpiry(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateApiKeyExpiryResp(rsp)
+}
+
+// RevokeApiKeyWithResponse request returning *RevokeApiKeyResp
+func (c *ClientWithResponses) RevokeApiKeyWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*RevokeApiKeyResp, error) {
+	rsp, err := c.RevokeApiKey(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRevokeApiKeyResp(rsp)
+}
+
+// LoginWithBodyWithResponse request with arbitrary body returning *LoginResp
+func (c *ClientWithResponses) LoginWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LoginResp, error) {
+	rsp, err := c.LoginWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLoginResp(rsp)
+}
+
+func (c *ClientWithResponses) LoginWithResponse(ctx context.Context, body LoginJSONRequestBody, reqEditors ...RequestEditorFn) (*LoginResp, error) {
+	rsp, err := c.Login(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLoginResp(rsp)
+}
+
+// LogoutWithResponse request returning *LogoutResp
+func (c *ClientWithResponses) LogoutWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*LogoutResp, error) {
+	rsp, err := c.Logout(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLogoutResp(rsp)
+}
+
+// GetCurrentUserWithResponse request returning *GetCurrentUserResp
+func (c *ClientWithResponses) GetCurrentUserWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetCurrentUserResp, error) {
+	rsp, err := c.GetCurrentUser(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetCurrentUserResp(rsp)
+}
+
+// ListSessionsWithResponse request returning *ListSessionsResp
+func (c *ClientWithResponses) ListSessionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListSessionsResp, error) {
+	rsp, err := c.ListSessions(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListSessionsResp(rsp)
+}
+
+// RevokeSessionWithResponse request returning *RevokeSessionResp
+func (c *ClientWithResponses) RevokeSessionWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*RevokeSessionResp, error) {
+	rsp, err := c.RevokeSession(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRevokeSessionResp(rsp)
+}
+
+// GetAllAutomationRulesWithResponse request returning *GetAllAutomationRulesResp
+func (c *ClientWithResponses) GetAllAutomationRulesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAllAutomationRulesResp, error) {
+	rsp, err := c.GetAllAutomationRules(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAllAutomationRulesResp(rsp)
+}
+
+// CreateAutomationRuleWithBodyWithResponse request with arbitrary body returning *CreateAutomationRuleResp
+func (c *ClientWithResponses) CreateAutomationRuleWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateAutomationRuleResp, error) {
+	rsp, err := c.CreateAutomationRuleWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateAutomationRuleResp(rsp)
+}
+
+func (c *ClientWithResponses) CreateAutomationRuleWithResponse(ctx context.Context, body CreateAutomationRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateAutomationRuleResp, error) {
+	rsp, err := c.CreateAutomationRule(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateAutomationRuleResp(rsp)
+}
+
+// DeleteAutomationRuleWithResponse request returning *DeleteAutomationRuleResp
+func (c *ClientWithResponses) DeleteAutomationRuleWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*DeleteAutomationRuleResp, error) {
+	rsp, err := c.DeleteAutomationRule(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteAutomationRuleResp(rsp)
+}
+
+// GetAutomationRuleByIdWithResponse request returning *GetAutomationRuleByIdResp
+func (c *ClientWithResponses) GetAutomationRuleByIdWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*GetAutomationRuleByIdResp, error) {
+	rsp, err := c.GetAutomationRuleById(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAutomationRuleByIdResp(rsp)
+}
+
+// UpdateAutomationRuleWithBodyWithResponse request with arbitrary body returning *UpdateAutomationRuleResp
+func (c *ClientWithResponses) UpdateAutomationRuleWithBodyWithResponse(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateAutomationRuleResp, error) {
+	rsp, err := c.UpdateAutomationRuleWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateAutomationRuleResp(rsp)
+}
+
+func (c *ClientWithResponses) UpdateAutomationRuleWithResponse(ctx context.Context, id int, body UpdateAutomationRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateAutomationRuleResp, error) {
+	rsp, err := c.UpdateAutomationRule(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateAutomationRuleResp(rsp)
+}
+
+// ListDashboardsWithResponse request returning *ListDashboardsResp
+func (c *ClientWithResponses) ListDashboardsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListDashboardsResp, error) {
+	rsp, err := c.ListDashboards(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListDashboardsResp(rsp)
+}
+
+// CreateDashboardWithBodyWithResponse request with arbitrary body returning *CreateDashboardResp
+func (c *ClientWithResponses) CreateDashboardWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDashboardResp, error) {
+	rsp, err := c.CreateDashboardWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateDashboardResp(rsp)
+}
+
+func (c *ClientWithResponses) CreateDashboardWithResponse(ctx context.Context, body CreateDashboardJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateDashboardResp, error) {
+	rsp, err := c.CreateDashboard(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateDashboardResp(rsp)
+}
+
+// DeleteDashboardWithResponse request returning *DeleteDashboardResp
+func (c *ClientWithResponses) DeleteDashboardWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*DeleteDashboardResp, error) {
+	rsp, err := c.DeleteDashboard(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteDashboardResp(rsp)
+}
+
+// GetDashboardWithResponse request returning *GetDashboardResp
+func (c *ClientWithResponses) GetDashboardWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*GetDashboardResp, error) {
+	rsp, err := c.GetDashboard(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDashboardResp(rsp)
+}
+
+// UpdateDashboardWithBodyWithResponse request with arbitrary body returning *UpdateDashboardResp
+func (c *ClientWithResponses) UpdateDashboardWithBodyWithResponse(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateDashboardResp, error) {
+	rsp, err := c.UpdateDashboardWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateDashboardResp(rsp)
+}
+
+func (c *ClientWithResponses) UpdateDashboardWithResponse(ctx context.Context, id int, body UpdateDashboardJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateDashboardResp, error) {
+	rsp, err := c.UpdateDashboard(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateDashboardResp(rsp)
+}
+
+// SetDefaultDashboardWithResponse request returning *SetDefaultDashboardResp
+func (c *ClientWithResponses) SetDefaultDashboardWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*SetDefaultDashboardResp, error) {
+	rsp, err := c.SetDefaultDashboard(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetDefaultDashboardResp(rsp)
+}
+
+// ShareDashboardWithBodyWithResponse request with arbitrary body returning *ShareDashboardResp
+func (c *ClientWithResponses) ShareDashboardWithBodyWithResponse(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ShareDashboardResp, error) {
+	rsp, err := c.ShareDashboardWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseShareDashboardResp(rsp)
+}
+
+func (c *ClientWithResponses) ShareDashboardWithResponse(ctx context.Context, id int, body ShareDashboardJSONRequestBody, reqEditors ...RequestEditorFn) (*ShareDashboardResp, error) {
+	rsp, err := c.ShareDashboard(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseShareDashboardResp(rsp)
+}
+
+// ListDriversWithResponse request returning *ListDriversResp
+func (c *ClientWithResponses) ListDriversWithResponse(ctx context.Context, params *ListDriversParams, reqEditors ...RequestEditorFn) (*ListDriversResp, error) {
+	rsp, err := c.ListDrivers(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListDriversResp(rsp)
+}
+
+// GetHealthWithResponse request returning *GetHealthResp
+func (c *ClientWithResponses) GetHealthWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetHealthResp, error) {
+	rsp, err := c.GetHealth(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetHealthResp(rsp)
+}
+
+// GetAllMeasurementTypesWithResponse request returning *GetAllMeasurementTypesResp
+func (c *ClientWithResponses) GetAllMeasurementTypesWithResponse(ctx context.Context, params *GetAllMeasurementTypesParams, reqEditors ...RequestEditorFn) (*GetAllMeasurementTypesResp, error) {
+	rsp, err := c.GetAllMeasurementTypes(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAllMeasurementTypesResp(rsp)
+}
+
+// DeleteMeasurementTypeDownsamplingWithResponse request returning *DeleteMeasurementTypeDownsamplingResp
+func (c *ClientWithResponses) DeleteMeasurementTypeDownsamplingWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*DeleteMeasurementTypeDownsamplingResp, error) {
+	rsp, err := c.DeleteMeasurementTypeDownsampling(ctx, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteMeasurementTypeDownsamplingResp(rsp)
+}
+
+// SetMeasurementTypeDownsamplingWithBodyWithResponse request with arbitrary body returning *SetMeasurementTypeDownsamplingResp
+func (c *ClientWithResponses) SetMeasurementTypeDownsamplingWithBodyWithResponse(ctx context.Context, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetMeasurementTypeDownsamplingResp, error) {
+	rsp, err := c.SetMeasurementTypeDownsamplingWithBody(ctx, name, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetMeasurementTypeDownsamplingResp(rsp)
+}
+
+func (c *ClientWithResponses) SetMeasurementTypeDownsamplingWithResponse(ctx context.Context, name string, body SetMeasurementTypeDownsamplingJSONRequestBody, reqEditors ...RequestEditorFn) (*SetMeasurementTypeDownsamplingResp, error) {
+	rsp, err := c.SetMeasurementTypeDownsampling(ctx, name, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetMeasurementTypeDownsamplingResp(rsp)
+}
+
+// ListMqttBrokersWithResponse request returning *ListMqttBrokersResp
+func (c *ClientWithResponses) ListMqttBrokersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListMqttBrokersResp, error) {
+	rsp, err := c.ListMqttBrokers(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListMqttBrokersResp(rsp)
+}
+
+// CreateMqttBrokerWithBodyWithResponse request with arbitrary body returning *CreateMqttBrokerResp
+func (c *ClientWithResponses) CreateMqttBrokerWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateMqttBrokerResp, error) {
+	rsp, err := c.CreateMqttBrokerWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateMqttBrokerResp(rsp)
+}
+
+func (c *ClientWithResponses) CreateMqttBrokerWithResponse(ctx context.Context, body CreateMqttBrokerJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateMqttBrokerResp, error) {
+	rsp, err := c.CreateMqttBroker(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateMqttBrokerResp(rsp)
+}
+
+// DeleteMqttBrokerWithResponse request returning *DeleteMqttBrokerResp
+func (c *ClientWithResponses) DeleteMqttBrokerWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*DeleteMqttBrokerResp, error) {
+	rsp, err := c.DeleteMqttBroker(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteMqttBrokerResp(rsp)
+}
+
+// GetMqttBrokerWithResponse request returning *GetMqttBrokerResp
+func (c *ClientWithResponses) GetMqttBrokerWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*GetMqttBrokerResp, error) {
+	rsp, err := c.GetMqttBroker(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetMqttBrokerResp(rsp)
+}
+
+// UpdateMqttBrokerWithBodyWithResponse request with arbitrary body returning *UpdateMqttBrokerResp
+func (c *ClientWithResponses) UpdateMqttBrokerWithBodyWithResponse(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateMqttBrokerResp, error) {
+	rsp, err := c.UpdateMqttBrokerWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateMqttBrokerResp(rsp)
+}
+
+func (c *ClientWithResponses) UpdateMqttBrokerWithResponse(ctx context.Context, id int, body UpdateMqttBrokerJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateMqttBrokerResp, error) {
+	rsp, err := c.UpdateMqttBroker(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateMqttBrokerResp(rsp)
+}
+
+// GetMqttStatsWithResponse request returning *GetMqttStatsResp
+func (c *ClientWithResponses) GetMqttStatsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetMqttStatsResp, error) {
+	rsp, err := c.GetMqttStats(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetMqttStatsResp(rsp)
+}
+
+// ListMqttSubscriptionsWithResponse request returning *ListMqttSubscriptionsResp
+func (c *ClientWithResponses) ListMqttSubscriptionsWithResponse(ctx context.Context, params *ListMqttSubscriptionsParams, reqEditors ...RequestEditorFn) (*ListMqttSubscriptionsResp, error) {
+	rsp, err := c.ListMqttSubscriptions(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListMqttSubscriptionsResp(rsp)
+}
+
+// CreateMqttSubscriptionWithBodyWithResponse request with arbitrary body returning *CreateMqttSubscriptionResp
+func (c *ClientWithResponses) CreateMqttSubscriptionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateMqttSubscriptionResp, error) {
+	rsp, err := c.CreateMqttSubscriptionWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateMqttSubscriptionResp(rsp)
+}
+
+func (c *ClientWithResponses) CreateMqttSubscriptionWithResponse(ctx context.Context, body CreateMqttSubscriptionJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateMqttSubscriptionResp, error) {
+	rsp, err := c.CreateMqttSubscription(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateMqttSubscriptionResp(rsp)
+}
+
+// DeleteMqttSubscriptionWithResponse request returning *DeleteMqttSubscriptionResp
+func (c *ClientWithResponses) DeleteMqttSubscriptionWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*DeleteMqttSubscriptionResp, error) {
+	rsp, err := c.DeleteMqttSubscription(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteMqttSubscriptionResp(rsp)
+}
+
+// GetMqttSubscriptionWithResponse request returning *GetMqttSubscriptionResp
+func (c *ClientWithResponses) GetMqttSubscriptionWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*GetMqttSubscriptionResp, error) {
+	rsp, err := c.GetMqttSubscription(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetMqttSubscriptionResp(rsp)
+}
+
+// UpdateMqttSubscriptionWithBodyWithResponse request with arbitrary body returning *UpdateMqttSubscriptionResp
+func (c *ClientWithResponses) UpdateMqttSubscriptionWithBodyWithResponse(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateMqttSubscriptionResp, error) {
+	rsp, err := c.UpdateMqttSubscriptionWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateMqttSubscriptionResp(rsp)
+}
+
+func (c *ClientWithResponses) UpdateMqttSubscriptionWithResponse(ctx context.Context, id int, body UpdateMqttSubscriptionJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateMqttSubscriptionResp, error) {
+	rsp, err := c.UpdateMqttSubscription(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateMqttSubscriptionResp(rsp)
+}
+
+// ListNotificationsWithResponse request returning *ListNotificationsResp
+func (c *ClientWithResponses) ListNotificationsWithResponse(ctx context.Context, params *ListNotificationsParams, reqEditors ...RequestEditorFn) (*ListNotificationsResp, error) {
+	rsp, err := c.ListNotifications(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListNotificationsResp(rsp)
+}
+
+// BulkDismissWithResponse request returning *BulkDismissResp
+func (c *ClientWithResponses) BulkDismissWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*BulkDismissResp, error) {
+	rsp, err := c.BulkDismiss(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseBulkDismissResp(rsp)
+}
+
+// BulkMarkAsReadWithResponse request returning *BulkMarkAsReadResp
+func (c *ClientWithResponses) BulkMarkAsReadWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*BulkMarkAsReadResp, error) {
+	rsp, err := c.BulkMarkAsRead(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseBulkMarkAsReadResp(rsp)
+}
+
+// GetChannelPreferencesWithResponse request returning *GetChannelPreferencesResp
+func (c *ClientWithResponses) GetChannelPreferencesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetChannelPreferencesResp, error) {
+	rsp, err := c.GetChannelPreferences(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetChannelPreferencesResp(rsp)
+}
+
+// SetChannelPreferenceWithBodyWithResponse request with arbitrary body returning *SetChannelPreferenceResp
+func (c *ClientWithResponses) SetChannelPreferenceWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetChannelPreferenceResp, error) {
+	rsp, err := c.SetChannelPreferenceWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetChannelPreferenceResp(rsp)
+}
+
+func (c *ClientWithResponses) SetChannelPreferenceWithResponse(ctx context.Context, body SetChannelPreferenceJSONRequestBody, reqEditors ...RequestEditorFn) (*SetChannelPreferenceResp, error) {
+	rsp, err := c.SetChannelPreference(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetChannelPreferenceResp(rsp)
+}
+
+// DeletePushSubscriptionWithResponse request returning *DeletePushSubscriptionResp
+func (c *ClientWithResponses) DeletePushSubscriptionWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DeletePushSubscriptionResp, error) {
+	rsp, err := c.DeletePushSubscription(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeletePushSubscriptionResp(rsp)
+}
+
+// GetPushSubscriptionWithResponse request returning *GetPushSubscriptionResp
+func (c *ClientWithResponses) GetPushSubscriptionWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetPushSubscriptionResp, error) {
+	rsp, err := c.GetPushSubscription(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetPushSubscriptionResp(rsp)
+}
+
+// SetPushSubscriptionWithBodyWithResponse request with arbitrary body returning *SetPushSubscriptionResp
+func (c *ClientWithResponses) SetPushSubscriptionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetPushSubscriptionResp, error) {
+	rsp, err := c.SetPushSubscriptionWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetPushSubscriptionResp(rsp)
+}
+
+func (c *ClientWithResponses) SetPushSubscriptionWithResponse(ctx context.Context, body SetPushSubscriptionJSONRequestBody, reqEditors ...RequestEditorFn) (*SetPushSubscriptionResp, error) {
+	rsp, err := c.SetPushSubscription(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetPushSubscriptionResp(rsp)
+}
+
+// TestPushSubscriptionWithResponse request returning *TestPushSubscriptionResp
+func (c *ClientWithResponses) TestPushSubscriptionWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*TestPushSubscriptionResp, error) {
+	rsp, err := c.TestPushSubscription(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTestPushSubscriptionResp(rsp)
+}
+
+// DeleteQuietHoursWithResponse request returning *DeleteQuietHoursResp
+func (c *ClientWithResponses) DeleteQuietHoursWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DeleteQuietHoursResp, error) {
+	rsp, err := c.DeleteQuietHours(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteQuietHoursResp(rsp)
+}
+
+// GetQuietHoursWithResponse request returning *GetQuietHoursResp
+func (c *ClientWithResponses) GetQuietHoursWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetQuietHoursResp, error) {
+	rsp, err := c.GetQuietHours(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetQuietHoursResp(rsp)
+}
+
+// SetQuietHoursWithBodyWithResponse request with arbitrary body returning *SetQuietHoursResp
+func (c *ClientWithResponses) SetQuietHoursWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetQuietHoursResp, error) {
+	rsp, err := c.SetQuietHoursWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetQuietHoursResp(rsp)
+}
+
+func (c *ClientWithResponses) SetQuietHoursWithResponse(ctx context.Context, body SetQuietHoursJSONRequestBody, reqEditors ...RequestEditorFn) (*SetQuietHoursResp, error) {
+	rsp, err := c.SetQuietHours(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetQuietHoursResp(rsp)
+}
+
+// GetUnreadCountWithResponse request returning *GetUnreadCountResp
+func (c *ClientWithResponses) GetUnreadCountWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetUnreadCountResp, error) {
+	rsp, err := c.GetUnreadCount(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetUnreadCountResp(rsp)
+}
+
+// ListWebhookTargetsWithResponse request returning *ListWebhookTargetsResp
+func (c *ClientWithResponses) ListWebhookTargetsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListWebhookTargetsResp, error) {
+	rsp, err := c.ListWebhookTargets(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListWebhookTargetsResp(rsp)
+}
+
+// CreateWebhookTargetWithBodyWithResponse request with arbitrary body returning *CreateWebhookTargetResp
+func (c *ClientWithResponses) CreateWebhookTargetWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateWebhookTargetResp, error) {
+	rsp, err := c.CreateWebhookTargetWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateWebhookTargetResp(rsp)
+}
+
+func (c *ClientWithResponses) CreateWebhookTargetWithResponse(ctx context.Context, body CreateWebhookTargetJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateWebhookTargetResp, error) {
+	rsp, err := c.CreateWebhookTarget(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateWebhookTargetResp(rsp)
+}
+
+// DeleteWebhookTargetWithResponse request returning *DeleteWebhookTargetResp
+func (c *ClientWithResponses) DeleteWebhookTargetWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*DeleteWebhookTargetResp, error) {
+	rsp, err := c.DeleteWebhookTarget(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteWebhookTargetResp(rsp)
+}
+
+// UpdateWebhookTargetWithBodyWithResponse request with arbitrary body returning *UpdateWebhookTargetResp
+func (c *ClientWithResponses) UpdateWebhookTargetWithBodyWithResponse(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateWebhookTargetResp, error) {
+	rsp, err := c.UpdateWebhookTargetWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateWebhookTargetResp(rsp)
+}
+
+func (c *ClientWithResponses) UpdateWebhookTargetWithResponse(ctx context.Context, id int, body UpdateWebhookTargetJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateWebhookTargetResp, error) {
+	rsp, err := c.UpdateWebhookTarget(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateWebhookTargetResp(rsp)
+}
+
+// GetWebhookDeliveriesWithResponse request returning *GetWebhookDeliveriesResp
+func (c *ClientWithResponses) GetWebhookDeliveriesWithResponse(ctx context.Context, id int, params *GetWebhookDeliveriesParams, reqEditors ...RequestEditorFn) (*GetWebhookDeliveriesResp, error) {
+	rsp, err := c.GetWebhookDeliveries(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetWebhookDeliveriesResp(rsp)
+}
+
+// TestWebhookTargetWithResponse request returning *TestWebhookTargetResp
+func (c *ClientWithResponses) TestWebhookTargetWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*TestWebhookTargetResp, error) {
+	rsp, err := c.TestWebhookTarget(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTestWebhookTargetResp(rsp)
+}
+
+// NotificationsWebSocketWithResponse request returning *NotificationsWebSocketResp
+func (c *ClientWithResponses) NotificationsWebSocketWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*NotificationsWebSocketResp, error) {
+	rsp, err := c.NotificationsWebSocket(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseNotificationsWebSocketResp(rsp)
+}
+
+// DismissNotificationWithResponse request returning *DismissNotificationResp
+func (c *ClientWithResponses) DismissNotificationWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*DismissNotificationResp, error) {
+	rsp, err := c.DismissNotification(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDismissNotificationResp(rsp)
+}
+
+// MarkAsReadWithResponse request returning *MarkAsReadResp
+func (c *ClientWithResponses) MarkAsReadWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*MarkAsReadResp, error) {
+	rsp, err := c.MarkAsRead(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseMarkAsReadResp(rsp)
+}
+
+// GetOAuthAuthorizeUrlWithResponse request returning *GetOAuthAuthorizeUrlResp
+func (c *ClientWithResponses) GetOAuthAuthorizeUrlWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetOAuthAuthorizeUrlResp, error) {
+	rsp, err := c.GetOAuthAuthorizeUrl(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetOAuthAuthorizeUrlResp(rsp)
+}
+
+// ReloadOAuthWithResponse request returning *ReloadOAuthResp
+func (c *ClientWithResponses) ReloadOAuthWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ReloadOAuthResp, error) {
+	rsp, err := c.ReloadOAuth(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReloadOAuthResp(rsp)
+}
+
+// GetOAuthStatusWithResponse request returning *GetOAuthStatusResp
+func (c *ClientWithResponses) GetOAuthStatusWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetOAuthStatusResp, error) {
+	rsp, err := c.GetOAuthStatus(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetOAuthStatusResp(rsp)
+}
+
+// SubmitOAuthCodeWithBodyWithResponse request with arbitrary body returning *SubmitOAuthCodeResp
+func (c *ClientWithResponses) SubmitOAuthCodeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SubmitOAuthCodeResp, error) {
+	rsp, err := c.SubmitOAuthCodeWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSubmitOAuthCodeResp(rsp)
+}
+
+func (c *ClientWithResponses) SubmitOAuthCodeWithResponse(ctx context.Context, body SubmitOAuthCodeJSONRequestBody, reqEditors ...RequestEditorFn) (*SubmitOAuthCodeResp, error) {
+	rsp, err := c.SubmitOAuthCode(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSubmitOAuthCodeResp(rsp)
+}
+
+// GetOpenApiSpecWithResponse request returning *GetOpenApiSpecResp
+func (c *ClientWithResponses) GetOpenApiSpecWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetOpenApiSpecResp, error) {
+	rsp, err := c.GetOpenApiSpec(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetOpenApiSpecResp(rsp)
+}
+
+// GetPropertiesWithResponse request returning *GetPropertiesResp
+func (c *ClientWithResponses) GetPropertiesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetPropertiesResp, error) {
+	rsp, err := c.GetProperties(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetPropertiesResp(rsp)
+}
+
+// UpdatePropertiesWithBodyWithResponse request with arbitrary body returning *UpdatePropertiesResp
+func (c *ClientWithResponses) UpdatePropertiesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdatePropertiesResp, error) {
+	rsp, err := c.UpdatePropertiesWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdatePropertiesResp(rsp)
+}
+
+func (c *ClientWithResponses) UpdatePropertiesWithResponse(ctx context.Context, body UpdatePropertiesJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdatePropertiesResp, error) {
+	rsp, err := c.UpdateProperties(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdatePropertiesResp(rsp)
+}
+
+// PropertiesWebSocketWithResponse request returning *PropertiesWebSocketResp
+func (c *ClientWithResponses) PropertiesWebSocketWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PropertiesWebSocketResp, error) {
+	rsp, err := c.PropertiesWebSocket(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePropertiesWebSocketResp(rsp)
+}
+
+// GetReadingsBetweenDatesWithResponse request returning *GetReadingsBetweenDatesResp
+func (c *ClientWithResponses) GetReadingsBetweenDatesWithResponse(ctx context.Context, params *GetReadingsBetweenDatesParams, reqEditors ...RequestEditorFn) (*GetReadingsBetweenDatesResp, error) {
+	rsp, err := c.GetReadingsBetweenDates(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetReadingsBetweenDatesResp(rsp)
+}
+
+// SubscribeCurrentReadingsWithResponse request returning *SubscribeCurrentReadingsResp
+func (c *ClientWithResponses) SubscribeCurrentReadingsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*SubscribeCurrentReadingsResp, error) {
+	rsp, err := c.SubscribeCurrentReadings(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSubscribeCurrentReadingsResp(rsp)
+}
+
+// ListRolesWithResponse request returning *ListRolesResp
+func (c *ClientWithResponses) ListRolesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListRolesResp, error) {
+	rsp, err := c.ListRoles(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListRolesResp(rsp)
+}
+
+// ListPermissionsWithResponse request returning *ListPermissionsResp
+func (c *ClientWithResponses) ListPermissionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListPermissionsResp, error) {
+	rsp, err := c.ListPermissions(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListPermissionsResp(rsp)
+}
+
+// GetRolePermissionsWithResponse request returning *GetRolePermissionsResp
+func (c *ClientWithResponses) GetRolePermissionsWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*GetRolePermissionsResp, error) {
+	rsp, err := c.GetRolePermissions(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetRolePermissionsResp(rsp)
+}
+
+// AssignPermissionWithBodyWithResponse request with arbitrary body returning *AssignPermissionResp
+func (c *ClientWithResponses) AssignPermissionWithBodyWithResponse(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AssignPermissionResp, error) {
+	rsp, err := c.AssignPermissionWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAssignPermissionResp(rsp)
+}
+
+func (c *ClientWithResponses) AssignPermissionWithResponse(ctx context.Context, id int, body AssignPermissionJSONRequestBody, reqEditors ...RequestEditorFn) (*AssignPermissionResp, error) {
+	rsp, err := c.AssignPermission(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAssignPermissionResp(rsp)
+}
+
+// RemovePermissionWithResponse request returning *RemovePermissionResp
+func (c *ClientWithResponses) RemovePermissionWithResponse(ctx context.Context, id int, pid int, reqEditors ...RequestEditorFn) (*RemovePermissionResp, error) {
+	rsp, err := c.RemovePermission(ctx, id, pid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRemovePermissionResp(rsp)
+}
+
+// GetAllScenesWithResponse request returning *GetAllScenesResp
+func (c *ClientWithResponses) GetAllScenesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAllScenesResp, error) {
+	rsp, err := c.GetAllScenes(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAllScenesResp(rsp)
+}
+
+// CreateSceneWithBodyWithResponse request with arbitrary body returning *CreateSceneResp
+func (c *ClientWithResponses) CreateSceneWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateSceneResp, error) {
+	rsp, err := c.CreateSceneWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateSceneResp(rsp)
+}
+
+func (c *ClientWithResponses) CreateSceneWithResponse(ctx context.Context, body CreateSceneJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateSceneResp, error) {
+	rsp, err := c.CreateScene(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateSceneResp(rsp)
+}
+
+// DeleteSceneWithResponse request returning *DeleteSceneResp
+func (c *ClientWithResponses) DeleteSceneWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*DeleteSceneResp, error) {
+	rsp, err := c.DeleteScene(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteSceneResp(rsp)
+}
+
+// GetSceneByIdWithResponse request returning *GetSceneByIdResp
+func (c *ClientWithResponses) GetSceneByIdWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*GetSceneByIdResp, error) {
+	rsp, err := c.GetSceneById(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSceneByIdResp(rsp)
+}
+
+// UpdateSceneWithBodyWithResponse request with arbitrary body returning *UpdateSceneResp
+func (c *ClientWithResponses) UpdateSceneWithBodyWithResponse(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateSceneResp, error) {
+	rsp, err := c.UpdateSceneWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateSceneResp(rsp)
+}
+
+func (c *ClientWithResponses) UpdateSceneWithResponse(ctx context.Context, id int, body UpdateSceneJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateSceneResp, error) {
+	rsp, err := c.UpdateScene(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateSceneResp(rsp)
+}
+
+// ActivateSceneWithResponse request returning *ActivateSceneResp
+func (c *ClientWithResponses) ActivateSceneWithResponse(ctx context.Context, id int, params *ActivateSceneParams, reqEditors ...RequestEditorFn) (*ActivateSceneResp, error) {
+	rsp, err := c.ActivateScene(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseActivateSceneResp(rsp)
+}
+
+// GetAllSchedulesWithResponse request returning *GetAllSchedulesResp
+func (c *ClientWithResponses) GetAllSchedulesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAllSchedulesResp, error) {
+	rsp, err := c.GetAllSchedules(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAllSchedulesResp(rsp)
+}
+
+// CreateScheduleWithBodyWithResponse request with arbitrary body returning *CreateScheduleResp
+func (c *ClientWithResponses) CreateScheduleWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateScheduleResp, error) {
+	rsp, err := c.CreateScheduleWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateScheduleResp(rsp)
+}
+
+func (c *ClientWithResponses) CreateScheduleWithResponse(ctx context.Context, body CreateScheduleJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateScheduleResp, error) {
+	rsp, err := c.CreateSchedule(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateScheduleResp(rsp)
+}
+
+// GetHolidayModeWithResponse request returning *GetHolidayModeResp
+func (c *ClientWithResponses) GetHolidayModeWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetHolidayModeResp, error) {
+	rsp, err := c.GetHolidayMode(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetHolidayModeResp(rsp)
+}
+
+// SetHolidayModeWithBodyWithResponse request with arbitrary body returning *SetHolidayModeResp
+func (c *ClientWithResponses) SetHolidayModeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetHolidayModeResp, error) {
+	rsp, err := c.SetHolidayModeWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetHolidayModeResp(rsp)
+}
+
+func (c *ClientWithResponses) SetHolidayModeWithResponse(ctx context.Context, body SetHolidayModeJSONRequestBody, reqEditors ...RequestEditorFn) (*SetHolidayModeResp, error) {
+	rsp, err := c.SetHolidayMode(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetHolidayModeResp(rsp)
+}
+
+// DeleteScheduleWithResponse request returning *DeleteScheduleResp
+func (c *ClientWithResponses) DeleteScheduleWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*DeleteScheduleResp, error) {
+	rsp, err := c.DeleteSchedule(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteScheduleResp(rsp)
+}
+
+// GetScheduleByIdWithResponse request returning *GetScheduleByIdResp
+func (c *ClientWithResponses) GetScheduleByIdWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*GetScheduleByIdResp, error) {
+	rsp, err := c.GetScheduleById(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetScheduleByIdResp(rsp)
+}
+
+// UpdateScheduleWithBodyWithResponse request with arbitrary body returning *UpdateScheduleResp
+func (c *ClientWithResponses) UpdateScheduleWithBodyWithResponse(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateScheduleResp, error) {
+	rsp, err := c.UpdateScheduleWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateScheduleResp(rsp)
+}
+
+func (c *ClientWithResponses) UpdateScheduleWithResponse(ctx context.Context, id int, body UpdateScheduleJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateScheduleResp, error) {
+	rsp, err := c.UpdateSchedule(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateScheduleResp(rsp)
+}
+
+// GetAllSensorsWithResponse request returning *GetAllSensorsResp
+func (c *ClientWithResponses) GetAllSensorsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAllSensorsResp, error) {
+	rsp, err := c.GetAllSensors(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAllSensorsResp(rsp)
+}
+
+// AddSensorWithBodyWithResponse request with arbitrary body returning *AddSensorResp
+func (c *ClientWithResponses) AddSensorWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddSensorResp, error) {
+	rsp, err := c.AddSensorWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddSensorResp(rsp)
+}
+
+func (c *ClientWithResponses) AddSensorWithResponse(ctx context.Context, body AddSensorJSONRequestBody, reqEditors ...RequestEditorFn) (*AddSensorResp, error) {
+	rsp, err := c.AddSensor(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddSensorResp(rsp)
+}
+
+// ApproveSensorWithResponse request returning *ApproveSensorResp
+func (c *ClientWithResponses) ApproveSensorWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*ApproveSensorResp, error) {
+	rsp, err := c.ApproveSensor(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApproveSensorResp(rsp)
+}
+
+// GetSensorCapabilitiesWithResponse request returning *GetSensorCapabilitiesResp
+func (c *ClientWithResponses) GetSensorCapabilitiesWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*GetSensorCapabilitiesResp, error) {
+	rsp, err := c.GetSensorCapabilities(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSensorCapabilitiesResp(rsp)
+}
+
+// GetSensorCommandHistoryWithResponse request returning *GetSensorCommandHistoryResp
+func (c *ClientWithResponses) GetSensorCommandHistoryWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*GetSensorCommandHistoryResp, error) {
+	rsp, err := c.GetSensorCommandHistory(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSensorCommandHistoryResp(rsp)
+}
+
+// GetSensorDesiredStatesWithResponse request returning *GetSensorDesiredStatesResp
+func (c *ClientWithResponses) GetSensorDesiredStatesWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*GetSensorDesiredStatesResp, error) {
+	rsp, err := c.GetSensorDesiredStates(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSensorDesiredStatesResp(rsp)
+}
+
+// DeleteSensorDesiredStateWithResponse request returning *DeleteSensorDesiredStateResp
+func (c *ClientWithResponses) DeleteSensorDesiredStateWithResponse(ctx context.Context, id int, property string, reqEditors ...RequestEditorFn) (*DeleteSensorDesiredStateResp, error) {
+	rsp, err := c.DeleteSensorDesiredState(ctx, id, property, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteSensorDesiredStateResp(rsp)
+}
+
+// SetSensorDesiredStateWithBodyWithResponse request with arbitrary body returning *SetSensorDesiredStateResp
+func (c *ClientWithResponses) SetSensorDesiredStateWithBodyWithResponse(ctx context.Context, id int, property string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetSensorDesiredStateResp, error) {
+	rsp, err := c.SetSensorDesiredStateWithBody(ctx, id, property, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetSensorDesiredStateResp(rsp)
+}
+
+func (c *ClientWithResponses) SetSensorDesiredStateWithResponse(ctx context.Context, id int, property string, body SetSensorDesiredStateJSONRequestBody, reqEditors ...RequestEditorFn) (*SetSensorDesiredStateResp, error) {
+	rsp, err := c.SetSensorDesiredState(ctx, id, property, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetSensorDesiredStateResp(rsp)
+}
+
+// GetSensorMeasurementTypesWithResponse request returning *GetSensorMeasurementTypesResp
+func (c *ClientWithResponses) GetSensorMeasurementTypesWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*GetSensorMeasurementTypesResp, error) {
+	rsp, err := c.GetSensorMeasurementTypes(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSensorMeasurementTypesResp(rsp)
+}
+
+// CollectAllSensorReadingsWithResponse request returning *CollectAllSensorReadingsResp
+func (c *ClientWithResponses) CollectAllSensorReadingsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*CollectAllSensorReadingsResp, error) {
+	rsp, err := c.CollectAllSensorReadings(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCollectAllSensorReadingsResp(rsp)
+}
+
+// CollectFromSensorWithResponse request returning *CollectFromSensorResp
+func (c *ClientWithResponses) CollectFromSensorWithResponse(ctx context.Context, sensorName string, reqEditors ...RequestEditorFn) (*CollectFromSensorResp, error) {
+	rsp, err := c.CollectFromSensor(ctx, sensorName, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCollectFromSensorResp(rsp)
+}
+
+// DisableSensorWithResponse request returning *DisableSensorResp
+func (c *ClientWithResponses) DisableSensorWithResponse(ctx context.Context, sensorName string, reqEditors ...RequestEditorFn) (*DisableSensorResp, error) {
+	rsp, err := c.DisableSensor(ctx, sensorName, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDisableSensorResp(rsp)
+}
+
+// DismissSensorWithResponse request returning *DismissSensorResp
+func (c *ClientWithResponses) DismissSensorWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*DismissSensorResp, error) {
+	rsp, err := c.DismissSensor(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDismissSensorResp(rsp)
+}
+
+// GetSensorsByDriverWithResponse request returning *GetSensorsByDriverResp
+func (c *ClientWithResponses) GetSensorsByDriverWithResponse(ctx context.Context, driver string, reqEditors ...RequestEditorFn) (*GetSensorsByDriverResp, error) {
+	rsp, err := c.GetSensorsByDriver(ctx, driver, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSensorsByDriverResp(rsp)
+}
+
+// EnableSensorWithResponse request returning *EnableSensorResp
+func (c *ClientWithResponses) EnableSensorWithResponse(ctx context.Context, sensorName string, reqEditors ...RequestEditorFn) (*EnableSensorResp, error) {
+	rsp, err := c.EnableSensor(ctx, sensorName, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseEnableSensorResp(rsp)
+}
+
+// GetSensorHealthHistoryByNameWithResponse request returning *GetSensorHealthHistoryByNameResp
+func (c *ClientWithResponses) GetSensorHealthHistoryByNameWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*GetSensorHealthHistoryByNameResp, error) {
+	rsp, err := c.GetSensorHealthHistoryByName(ctx, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSensorHealthHistoryByNameResp(rsp)
+}
+
+// GetTotalReadingsPerSensorWithResponse request returning *GetTotalReadingsPerSensorResp
+func (c *ClientWithResponses) GetTotalReadingsPerSensorWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetTotalReadingsPerSensorResp, error) {
+	rsp, err := c.GetTotalReadingsPerSensor(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTotalReadingsPerSensorResp(rsp)
+}
+
+// GetSensorsByStatusWithResponse request returning *GetSensorsByStatusResp
+func (c *ClientWithResponses) GetSensorsByStatusWithResponse(ctx context.Context, status GetSensorsByStatusParamsStatus, reqEditors ...RequestEditorFn) (*GetSensorsByStatusResp, error) {
+	rsp, err := c.GetSensorsByStatus(ctx, status, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSensorsByStatusResp(rsp)
+}
+
+// SubscribeAllSensorsWithResponse request returning *SubscribeAllSensorsResp
+func (c *ClientWithResponses) SubscribeAllSensorsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*SubscribeAllSensorsResp, error) {
+	rsp, err := c.SubscribeAllSensors(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSubscribeAllSensorsResp(rsp)
+}
+
+// SubscribeSensorsByDriverWithResponse request returning *SubscribeSensorsByDriverResp
+func (c *ClientWithResponses) SubscribeSensorsByDriverWithResponse(ctx context.Context, driver string, reqEditors ...RequestEditorFn) (*SubscribeSensorsByDriverResp, error) {
+	rsp, err := c.SubscribeSensorsByDriver(ctx, driver, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSubscribeSensorsByDriverResp(rsp)
+}
+
+// UpdateSensorByIdWithBodyWithResponse request with arbitrary body returning *UpdateSensorByIdResp
+func (c *ClientWithResponses) UpdateSensorByIdWithBodyWithResponse(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateSensorByIdResp, error) {
+	rsp, err := c.UpdateSensorByIdWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateSensorByIdResp(rsp)
+}
+
+func (c *ClientWithResponses) UpdateSensorByIdWithResponse(ctx context.Context, id int, body UpdateSensorByIdJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateSensorByIdResp, error) {
+	rsp, err := c.UpdateSensorById(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateSensorByIdResp(rsp)
+}
+
+// SendSensorCommandWithBodyWithResponse request with arbitrary body returning *SendSensorCommandResp
+func (c *ClientWithResponses) SendSensorCommandWithBodyWithResponse(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SendSensorCommandResp, error) {
+	rsp, err := c.SendSensorCommandWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSendSensorCommandResp(rsp)
+}
+
+func (c *ClientWithResponses) SendSensorCommandWithResponse(ctx context.Context, id int, body SendSensorCommandJSONRequestBody, reqEditors ...RequestEditorFn) (*SendSensorCommandResp, error) {
+	rsp, err := c.SendSensorCommand(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSendSensorCommandResp(rsp)
+}
+
+// DeleteSensorByNameWithResponse request returning *DeleteSensorByNameResp
+func (c *ClientWithResponses) DeleteSensorByNameWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*DeleteSensorByNameResp, error) {
+	rsp, err := c.DeleteSensorByName(ctx, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteSensorByNameResp(rsp)
+}
+
+// GetSensorByNameWithResponse request returning *GetSensorByNameResp
+func (c *ClientWithResponses) GetSensorByNameWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*GetSensorByNameResp, error) {
+	rsp, err := c.GetSensorByName(ctx, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSensorByNameResp(rsp)
+}
+
+// SensorExistsWithResponse request returning *SensorExistsResp
+func (c *ClientWithResponses) SensorExistsWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*SensorExistsResp, error) {
+	rsp, err := c.SensorExists(ctx, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSensorExistsResp(rsp)
+}
+
+// ListUsersWithResponse request returning *ListUsersResp
+func (c *ClientWithResponses) ListUsersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListUsersResp, error) {
+	rsp, err := c.ListUsers(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListUsersResp(rsp)
+}
+
+// CreateUserWithBodyWithResponse request with arbitrary body returning *CreateUserResp
+func (c *ClientWithResponses) CreateUserWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateUserResp, error) {
+	rsp, err := c.CreateUserWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateUserResp(rsp)
+}
+
+func (c *ClientWithResponses) CreateUserWithResponse(ctx context.Context, body CreateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateUserResp, error) {
+	rsp, err := c.CreateUser(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateUserResp(rsp)
+}
+
+// ChangePasswordWithBodyWithResponse request with arbitrary body returning *ChangePasswordResp
+func (c *ClientWithResponses) ChangePasswordWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ChangePasswordResp, error) {
+	rsp, err := c.ChangePasswordWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseChangePasswordResp(rsp)
+}
+
+func (c *ClientWithResponses) ChangePasswordWithResponse(ctx context.Context, body ChangePasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*ChangePasswordResp, error) {
+	rsp, err := c.ChangePassword(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseChangePasswordResp(rsp)
+}
+
+// DeleteUserWithResponse request returning *DeleteUserResp
+func (c *ClientWithResponses) DeleteUserWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*DeleteUserResp, error) {
+	rsp, err := c.DeleteUser(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteUserResp(rsp)
+}
+
+// SetMustChangePasswordWithBodyWithResponse request with arbitrary body returning *SetMustChangePasswordResp
+func (c *ClientWithResponses) SetMustChangePasswordWithBodyWithResponse(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetMustChangePasswordResp, error) {
+	rsp, err := c.SetMustChangePasswordWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetMustChangePasswordResp(rsp)
+}
+
+func (c *ClientWithResponses) SetMustChangePasswordWithResponse(ctx context.Context, id int, body SetMustChangePasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*SetMustChangePasswordResp, error) {
+	rsp, err := c.SetMustChangePassword(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetMustChangePasswordResp(rsp)
+}
+
+// SetUserRolesWithBodyWithResponse request with arbitrary body returning *SetUserRolesResp
+func (c *ClientWithResponses) SetUserRolesWithBodyWithResponse(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetUserRolesResp, error) {
+	rsp, err := c.SetUserRolesWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetUserRolesResp(rsp)
+}
+
+func (c *ClientWithResponses) SetUserRolesWithResponse(ctx context.Context, id int, body SetUserRolesJSONRequestBody, reqEditors ...RequestEditorFn) (*SetUserRolesResp, error) {
+	rsp, err := c.SetUserRoles(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetUserRolesResp(rsp)
+}
+
+// ParseGetAllAlertRulesResp parses an HTTP response from a GetAllAlertRulesWithResponse call
+func ParseGetAllAlertRulesResp(rsp *http.Response) (*GetAllAlertRulesResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAllAlertRulesResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []AlertRule
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateAlertRuleResp parses an HTTP response from a CreateAlertRuleWithResponse call
+func ParseCreateAlertRuleResp(rsp *http.Response) (*CreateAlertRuleResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateAlertRuleResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest SuccessMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAllCompoundAlertRulesResp parses an HTTP response from a GetAllCompoundAlertRulesWithResponse call
+func ParseGetAllCompoundAlertRulesResp(rsp *http.Response) (*GetAllCompoundAlertRulesResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAllCompoundAlertRulesResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []CompoundAlertRule
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateCompoundAlertRuleResp parses an HTTP response from a CreateCompoundAlertRuleWithResponse call
+func ParseCreateCompoundAlertRuleResp(rsp *http.Response) (*CreateCompoundAlertRuleResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateCompoundAlertRuleResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest CompoundAlertRule
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteCompoundAlertRuleResp parses an HTTP response from a DeleteCompoundAlertRuleWithResponse call
+func ParseDeleteCompoundAlertRuleResp(rsp *http.Response) (*DeleteCompoundAlertRuleResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteCompoundAlertRuleResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SuccessMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetCompoundAlertRuleByIdResp parses an HTTP response from a GetCompoundAlertRuleByIdWithResponse call
+func ParseGetCompoundAlertRuleByIdResp(rsp *http.Response) (*GetCompoundAlertRuleByIdResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetCompoundAlertRuleByIdResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CompoundAlertRule
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateCompoundAlertRuleResp parses an HTTP response from a UpdateCompoundAlertRuleWithResponse call
+func ParseUpdateCompoundAlertRuleResp(rsp *http.Response) (*UpdateCompoundAlertRuleResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateCompoundAlertRuleResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SuccessMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAcknowledgeCompoundAlertRuleResp parses an HTTP response from a AcknowledgeCompoundAlertRuleWithResponse call
+func ParseAcknowledgeCompoundAlertRuleResp(rsp *http.Response) (*AcknowledgeCompoundAlertRuleResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AcknowledgeCompoundAlertRuleResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SuccessMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteCompoundAlertEscalationPolicyResp parses an HTTP response from a DeleteCompoundAlertEscalationPolicyWithResponse call
+func ParseDeleteCompoundAlertEscalationPolicyResp(rsp *http.Response) (*DeleteCompoundAlertEscalationPolicyResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteCompoundAlertEscalationPolicyResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SuccessMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetCompoundAlertEscalationPolicyResp parses an HTTP response from a GetCompoundAlertEscalationPolicyWithResponse call
+func ParseGetCompoundAlertEscalationPolicyResp(rsp *http.Response) (*GetCompoundAlertEscalationPolicyResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetCompoundAlertEscalationPolicyResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest EscalationPolicy
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetOAuthStatusResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseSetCompoundAlertEscalationPolicyResp parses an HTTP response from a SetCompoundAlertEscalationPolicyWithResponse call
+func ParseSetCompoundAlertEscalationPolicyResp(rsp *http.Response) (*SetCompoundAlertEscalationPolicyResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+
+	response := &SetCompoundAlertEscalationPolicyResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SuccessMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetOAuthStatusResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ParseDeleteCompoundAlertRecipientsResp parses an HTTP response from a DeleteCompoundAlertRecipientsWithResponse call
+func ParseDeleteCompoundAlertRecipientsResp(rsp *http.Response) (*DeleteCompoundAlertRecipientsResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return 0
+
+	response := &DeleteCompoundAlertRecipientsResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SuccessMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
 }
 
-type SubmitOAuthCodeResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *SuccessMessage
-	JSON400      *ErrorResponse
-	JSON500      *ErrorResponse
-	JSON503      *ErrorResponse
+// ParseGetCompoundAlertRecipientsResp parses an HTTP response from a GetCompoundAlertRecipientsWithResponse call
+func ParseGetCompoundAlertRecipientsResp(rsp *http.Response) (*GetCompoundAlertRecipientsResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetCompoundAlertRecipientsResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AlertRecipients
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r SubmitOAuthCodeResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseSetCompoundAlertRecipientsResp parses an HTTP response from a SetCompoundAlertRecipientsWithResponse call
+func ParseSetCompoundAlertRecipientsResp(rsp *http.Response) (*SetCompoundAlertRecipientsResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+
+	response := &SetCompoundAlertRecipientsResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SuccessMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUnsnoozeCompoundAlertRuleResp parses an HTTP response from a UnsnoozeCompoundAlertRuleWithResponse call
+func ParseUnsnoozeCompoundAlertRuleResp(rsp *http.Response) (*UnsnoozeCompoundAlertRuleResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UnsnoozeCompoundAlertRuleResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SuccessMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r SubmitOAuthCodeResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ParseSnoozeCompoundAlertRuleResp parses an HTTP response from a SnoozeCompoundAlertRuleWithResponse call
+func ParseSnoozeCompoundAlertRuleResp(rsp *http.Response) (*SnoozeCompoundAlertRuleResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SnoozeCompoundAlertRuleResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SuccessMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
-	return 0
-}
 
-type GetOpenApiSpecResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	YAML200      *string
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetOpenApiSpecResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseGetAllContactGroupsResp parses an HTTP response from a GetAllContactGroupsWithResponse call
+func ParseGetAllContactGroupsResp(rsp *http.Response) (*GetAllContactGroupsResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetOpenApiSpecResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &GetAllContactGroupsResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type GetPropertiesResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *PropertiesMap
-	JSON500      *ErrorResponse
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []ContactGroup
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r GetPropertiesResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetPropertiesResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
-}
 
-type UpdatePropertiesResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON202      *OperationAccepted
-	JSON400      *ErrorResponse
-	JSON500      *ErrorResponse
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r UpdatePropertiesResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseCreateContactGroupResp parses an HTTP response from a CreateContactGroupWithResponse call
+func ParseCreateContactGroupResp(rsp *http.Response) (*CreateContactGroupResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdatePropertiesResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &CreateContactGroupResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type PropertiesWebSocketResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ErrorResponse
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest ContactGroup
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
 
-// Status returns HTTPResponse.Status
-func (r PropertiesWebSocketResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PropertiesWebSocketResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
-}
 
-type GetReadingsBetweenDatesResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *AggregatedReadingsResponse
-	JSON400      *ErrorResponse
-	JSON500      *ErrorResponse
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetReadingsBetweenDatesResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseDeleteContactGroupResp parses an HTTP response from a DeleteContactGroupWithResponse call
+func ParseDeleteContactGroupResp(rsp *http.Response) (*DeleteContactGroupResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetReadingsBetweenDatesResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &DeleteContactGroupResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type SubscribeCurrentReadingsResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ErrorResponse
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SuccessMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r SubscribeCurrentReadingsResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r SubscribeCurrentReadingsResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
-}
 
-type ListRolesResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]RoleInfo
-	JSON500      *ErrorResponse
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r ListRolesResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseUpdateContactGroupResp parses an HTTP response from a UpdateContactGroupWithResponse call
+func ParseUpdateContactGroupResp(rsp *http.Response) (*UpdateContactGroupResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListRolesResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &UpdateContactGroupResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type ListPermissionsResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]PermissionInfo
-	JSON500      *ErrorResponse
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SuccessMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r ListPermissionsResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListPermissionsResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
-}
 
-type GetRolePermissionsResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]PermissionInfo
-	JSON400      *ErrorResponse
-	JSON500      *ErrorResponse
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetRolePermissionsResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseAcknowledgeAlertHistoryEntryResp parses an HTTP response from a AcknowledgeAlertHistoryEntryWithResponse call
+func ParseAcknowledgeAlertHistoryEntryResp(rsp *http.Response) (*AcknowledgeAlertHistoryEntryResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetRolePermissionsResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &AcknowledgeAlertHistoryEntryResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type AssignPermissionResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *ErrorResponse
-	JSON500      *ErrorResponse
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SuccessMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r AssignPermissionResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r AssignPermissionResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
-}
 
-type RemovePermissionResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *ErrorResponse
-	JSON500      *ErrorResponse
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r RemovePermissionResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseGetAlertRulesBySensorIdResp parses an HTTP response from a GetAlertRulesBySensorIdWithResponse call
+func ParseGetAlertRulesBySensorIdResp(rsp *http.Response) (*GetAlertRulesBySensorIdResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r RemovePermissionResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &GetAlertRulesBySensorIdResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type GetAllSensorsResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]Sensor
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []AlertRule
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r GetAllSensorsResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetAllSensorsResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
-}
 
-type AddSensorResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *Sensor
-	JSON400      *ErrorResponse
-	JSON409      *ErrorResponse
-	JSON500      *ErrorResponse
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r AddSensorResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseGetAlertHistoryResp parses an HTTP response from a GetAlertHistoryWithResponse call
+func ParseGetAlertHistoryResp(rsp *http.Response) (*GetAlertHistoryResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r AddSensorResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &GetAlertHistoryResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type ApproveSensorResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *SuccessMessage
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []AlertHistoryEntry
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r ApproveSensorResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ApproveSensorResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
-}
 
-type GetSensorCapabilitiesResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]Capability
-	JSON404      *ErrorResponse
-	JSON500      *ErrorResponse
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetSensorCapabilitiesResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseGetAllSilenceWindowsResp parses an HTTP response from a GetAllSilenceWindowsWithResponse call
+func ParseGetAllSilenceWindowsResp(rsp *http.Response) (*GetAllSilenceWindowsResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetSensorCapabilitiesResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &GetAllSilenceWindowsResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
-
-type GetSensorCommandHistoryResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]CommandHistoryEntry
-	JSON404      *ErrorResponse
-	JSON500      *ErrorResponse
-}
 
-// Status returns HTTPResponse.Status
-func (r GetSensorCommandHistoryResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []SilenceWindow
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetSensorCommandHistoryResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
-}
 
-type GetSensorMeasurementTypesResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]MeasurementType
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetSensorMeasurementTypesResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseCreateSilenceWindowResp parses an HTTP response from a CreateSilenceWindowWithResponse call
+func ParseCreateSilenceWindowResp(rsp *http.Response) (*CreateSilenceWindowResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetSensorMeasurementTypesResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &CreateSilenceWindowResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type CollectAllSensorReadingsResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *SuccessMessage
-	JSON500      *ErrorResponse
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest SilenceWindow
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
 
-// Status returns HTTPResponse.Status
-func (r CollectAllSensorReadingsResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CollectAllSensorReadingsResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
-}
 
-type CollectFromSensorResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *SuccessMessage
-	JSON400      *ErrorResponse
-	JSON500      *ErrorResponse
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r CollectFromSensorResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseDeleteSilenceWindowResp parses an HTTP response from a DeleteSilenceWindowWithResponse call
+func ParseDeleteSilenceWindowResp(rsp *http.Response) (*DeleteSilenceWindowResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CollectFromSensorResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &DeleteSilenceWindowResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type DisableSensorResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *SuccessMessage
-	JSON400      *ErrorResponse
-	JSON500      *ErrorResponse
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SuccessMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r DisableSensorResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DisableSensorResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
-}
 
-type DismissSensorResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *SuccessMessage
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r DismissSensorResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseUpdateSilenceWindowResp parses an HTTP response from a UpdateSilenceWindowWithResponse call
+func ParseUpdateSilenceWindowResp(rsp *http.Response) (*UpdateSilenceWindowResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DismissSensorResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &UpdateSilenceWindowResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type GetSensorsByDriverResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]Sensor
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SuccessMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r GetSensorsByDriverResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetSensorsByDriverResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
-}
 
-type EnableSensorResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *SuccessMessage
-	JSON400      *ErrorResponse
-	JSON500      *ErrorResponse
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r EnableSensorResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseDeleteAlertRuleResp parses an HTTP response from a DeleteAlertRuleWithResponse call
+func ParseDeleteAlertRuleResp(rsp *http.Response) (*DeleteAlertRuleResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r EnableSensorResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &DeleteAlertRuleResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type GetSensorHealthHistoryByNameResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]SensorHealthHistory
-	JSON400      *ErrorResponse
-	JSON404      *ErrorResponse
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SuccessMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r GetSensorHealthHistoryByNameResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetSensorHealthHistoryByNameResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
-}
 
-type GetTotalReadingsPerSensorResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *map[string]int
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetTotalReadingsPerSensorResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseGetAlertRuleByIdResp parses an HTTP response from a GetAlertRuleByIdWithResponse call
+func ParseGetAlertRuleByIdResp(rsp *http.Response) (*GetAlertRuleByIdResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetTotalReadingsPerSensorResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &GetAlertRuleByIdResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type GetSensorsByStatusResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]Sensor
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AlertRule
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r GetSensorsByStatusResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetSensorsByStatusResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
-}
 
-type SubscribeAllSensorsResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ErrorResponse
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r SubscribeAllSensorsResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseUpdateAlertRuleResp parses an HTTP response from a UpdateAlertRuleWithResponse call
+func ParseUpdateAlertRuleResp(rsp *http.Response) (*UpdateAlertRuleResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r SubscribeAllSensorsResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &UpdateAlertRuleResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type SubscribeSensorsByDriverResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ErrorResponse
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SuccessMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r SubscribeSensorsByDriverResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r SubscribeSensorsByDriverResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
-}
 
-type UpdateSensorByIdResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Sensor
-	JSON400      *ErrorResponse
-	JSON404      *ErrorResponse
-	JSON500      *ErrorResponse
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r UpdateSensorByIdResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseAcknowledgeAlertRuleResp parses an HTTP response from a AcknowledgeAlertRuleWithResponse call
+func ParseAcknowledgeAlertRuleResp(rsp *http.Response) (*AcknowledgeAlertRuleResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateSensorByIdResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &AcknowledgeAlertRuleResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type SendSensorCommandResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON202      *SensorCommandAccepted
-	JSON400      *ErrorResponse
-	JSON403      *ErrorResponse
-	JSON404      *ErrorResponse
-	JSON409      *ErrorResponse
-	JSON429      *ErrorResponse
-	JSON503      *ErrorResponse
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SuccessMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r SendSensorCommandResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r SendSensorCommandResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
-}
 
-type DeleteSensorByNameResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *SuccessMessage
-	JSON400      *ErrorResponse
-	JSON500      *ErrorResponse
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r DeleteSensorByNameResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseDeleteAlertEscalationPolicyResp parses an HTTP response from a DeleteAlertEscalationPolicyWithResponse call
+func ParseDeleteAlertEscalationPolicyResp(rsp *http.Response) (*DeleteAlertEscalationPolicyResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteSensorByNameResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &DeleteAlertEscalationPolicyResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type GetSensorByNameResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Sensor
-	JSON400      *ErrorResponse
-	JSON404      *ErrorResponse
-	JSON500      *ErrorResponse
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SuccessMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
 
-// Status returns HTTPResponse.Status
-func (r GetSensorByNameResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetSensorByNameResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
-}
 
-type SensorExistsResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r SensorExistsResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseGetAlertEscalationPolicyResp parses an HTTP response from a GetAlertEscalationPolicyWithResponse call
+func ParseGetAlertEscalationPolicyResp(rsp *http.Response) (*GetAlertEscalationPolicyResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r SensorExistsResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &GetAlertEscalationPolicyResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type ListUsersResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]User
-	JSON500      *ErrorResponse
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest EscalationPolicy
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r ListUsersResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListUsersResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
 
-type CreateUserResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *struct {
-		// Id ID of the created user
-		Id *int `json:"id,omitempty"`
 	}
-	JSON400 *ErrorResponse
-	JSON500 *ErrorResponse
+
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r CreateUserResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseSetAlertEscalationPolicyResp parses an HTTP response from a SetAlertEscalationPolicyWithResponse call
+func ParseSetAlertEscalationPolicyResp(rsp *http.Response) (*SetAlertEscalationPolicyResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateUserResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &SetAlertEscalationPolicyResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type ChangePasswordResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *ErrorResponse
-	JSON500      *ErrorResponse
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SuccessMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r ChangePasswordResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ChangePasswordResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
-}
 
-type DeleteUserResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *ErrorResponse
-	JSON500      *ErrorResponse
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r DeleteUserResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseDeleteAlertRecipientsResp parses an HTTP response from a DeleteAlertRecipientsWithResponse call
+func ParseDeleteAlertRecipientsResp(rsp *http.Response) (*DeleteAlertRecipientsResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteUserResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &DeleteAlertRecipientsResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type SetMustChangePasswordResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *ErrorResponse
-	JSON500      *ErrorResponse
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SuccessMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r SetMustChangePasswordResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r SetMustChangePasswordResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
-}
 
-type SetUserRolesResp struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *ErrorResponse
-	JSON500      *ErrorResponse
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r SetUserRolesResp) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseGetAlertRecipientsResp parses an HTTP response from a GetAlertRecipientsWithResponse call
+func ParseGetAlertRecipientsResp(rsp *http.Response) (*GetAlertRecipientsResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r SetUserRolesResp) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &GetAlertRecipientsResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-// GetAllAlertRulesWithResponse request returning *GetAllAlertRulesResp
-func (c *ClientWithResponses) GetAllAlertRulesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAllAlertRulesResp, error) {
-	rsp, err := c.GetAllAlertRules(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AlertRecipients
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
-	return ParseGetAllAlertRulesResp(rsp)
-}
 
-// CreateAlertRuleWithBodyWithResponse request with arbitrary body returning *CreateAlertRuleResp
-func (c *ClientWithResponses) CreateAlertRuleWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateAlertRuleResp, error) {
-	rsp, err := c.CreateAlertRuleWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseCreateAlertRuleResp(rsp)
+	return response, nil
 }
 
-func (c *ClientWithResponses) CreateAlertRuleWithResponse(ctx context.Context, body CreateAlertRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateAlertRuleResp, error) {
-	rsp, err := c.CreateAlertRule(ctx, body, reqEditors...)
+// ParseSetAlertRecipientsResp parses an HTTP response from a SetAlertRecipientsWithResponse call
+func ParseSetAlertRecipientsResp(rsp *http.Response) (*SetAlertRecipientsResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateAlertRuleResp(rsp)
-}
 
-// GetAlertRulesBySensorIdWithResponse request returning *GetAlertRulesBySensorIdResp
-func (c *ClientWithResponses) GetAlertRulesBySensorIdWithResponse(ctx context.Context, sensorId int, reqEditors ...RequestEditorFn) (*GetAlertRulesBySensorIdResp, error) {
-	rsp, err := c.GetAlertRulesBySensorId(ctx, sensorId, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &SetAlertRecipientsResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseGetAlertRulesBySensorIdResp(rsp)
-}
 
-// GetAlertHistoryWithResponse request returning *GetAlertHistoryResp
-func (c *ClientWithResponses) GetAlertHistoryWithResponse(ctx context.Context, sensorId int, params *GetAlertHistoryParams, reqEditors ...RequestEditorFn) (*GetAlertHistoryResp, error) {
-	rsp, err := c.GetAlertHistory(ctx, sensorId, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SuccessMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
-	return ParseGetAlertHistoryResp(rsp)
+
+	return response, nil
 }
 
-// DeleteAlertRuleWithResponse request returning *DeleteAlertRuleResp
-func (c *ClientWithResponses) DeleteAlertRuleWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*DeleteAlertRuleResp, error) {
-	rsp, err := c.DeleteAlertRule(ctx, id, reqEditors...)
+// ParseUnsnoozeAlertRuleResp parses an HTTP response from a UnsnoozeAlertRuleWithResponse call
+func ParseUnsnoozeAlertRuleResp(rsp *http.Response) (*UnsnoozeAlertRuleResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteAlertRuleResp(rsp)
-}
 
-// GetAlertRuleByIdWithResponse request returning *GetAlertRuleByIdResp
-func (c *ClientWithResponses) GetAlertRuleByIdWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*GetAlertRuleByIdResp, error) {
-	rsp, err := c.GetAlertRuleById(ctx, id, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &UnsnoozeAlertRuleResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseGetAlertRuleByIdResp(rsp)
-}
 
-// UpdateAlertRuleWithBodyWithResponse request with arbitrary body returning *UpdateAlertRuleResp
-func (c *ClientWithResponses) UpdateAlertRuleWithBodyWithResponse(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateAlertRuleResp, error) {
-	rsp, err := c.UpdateAlertRuleWithBody(ctx, id, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SuccessMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
-	return ParseUpdateAlertRuleResp(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) UpdateAlertRuleWithResponse(ctx context.Context, id int, body UpdateAlertRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateAlertRuleResp, error) {
-	rsp, err := c.UpdateAlertRule(ctx, id, body, reqEditors...)
+// ParseSnoozeAlertRuleResp parses an HTTP response from a SnoozeAlertRuleWithResponse call
+func ParseSnoozeAlertRuleResp(rsp *http.Response) (*SnoozeAlertRuleResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateAlertRuleResp(rsp)
-}
 
-// ListApiKeysWithResponse request returning *ListApiKeysResp
-func (c *ClientWithResponses) ListApiKeysWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListApiKeysResp, error) {
-	rsp, err := c.ListApiKeys(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &SnoozeAlertRuleResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseListApiKeysResp(rsp)
-}
 
-// CreateApiKeyWithBodyWithResponse request with arbitrary body returning *CreateApiKeyResp
-func (c *ClientWithResponses) CreateApiKeyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateApiKeyResp, error) {
-	rsp, err := c.CreateApiKeyWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SuccessMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
-	return ParseCreateApiKeyResp(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) CreateApiKeyWithResponse(ctx context.Context, body CreateApiKeyJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateApiKeyResp, error) {
-	rsp, err := c.CreateApiKey(ctx, body, reqEditors...)
+// ParseListApiKeysResp parses an HTTP response from a ListApiKeysWithResponse call
+func ParseListApiKeysResp(rsp *http.Response) (*ListApiKeysResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateApiKeyResp(rsp)
-}
 
-// DeleteApiKeyWithResponse request returning *DeleteApiKeyResp
-func (c *ClientWithResponses) DeleteApiKeyWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*DeleteApiKeyResp, error) {
-	rsp, err := c.DeleteApiKey(ctx, id, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &ListApiKeysResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseDeleteApiKeyResp(rsp)
-}
 
-// UpdateApiKeyExpiryWithBodyWithResponse request with arbitrary body returning *UpdateApiKeyExpiryResp
-func (c *ClientWithResponses) UpdateApiKeyExpiryWithBodyWithResponse(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateApiKeyExpiryResp, error) {
-	rsp, err := c.UpdateApiKeyExpiryWithBody(ctx, id, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []ApiKey
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
-	return ParseUpdateApiKeyExpiryResp(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) UpdateApiKeyExpiryWithResponse(ctx context.Context, id int, body UpdateApiKeyExpiryJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateApiKeyExpiryResp, error) {
-	rsp, err := c.UpdateApiKeyExpiry(ctx, id, body, reqEditors...)
+// ParseCreateApiKeyResp parses an HTTP response from a CreateApiKeyWithResponse call
+func ParseCreateApiKeyResp(rsp *http.Response) (*CreateApiKeyResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateApiKeyExpiryResp(rsp)
-}
 
-// RevokeApiKeyWithResponse request returning *RevokeApiKeyResp
-func (c *ClientWithResponses) RevokeApiKeyWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*RevokeApiKeyResp, error) {
-	rsp, err := c.RevokeApiKey(ctx, id, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &CreateApiKeyResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseRevokeApiKeyResp(rsp)
-}
 
-// LoginWithBodyWithResponse request with arbitrary body returning *LoginResp
-func (c *ClientWithResponses) LoginWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LoginResp, error) {
-	rsp, err := c.LoginWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest struct {
+			// Key The full API key. Store it securely — it will not be shown again.
+			Key     *string `json:"key,omitempty"`
+			Message *string `json:"message,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
-	return ParseLoginResp(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) LoginWithResponse(ctx context.Context, body LoginJSONRequestBody, reqEditors ...RequestEditorFn) (*LoginResp, error) {
-	rsp, err := c.Login(ctx, body, reqEditors...)
+// ParseDeleteApiKeyResp parses an HTTP response from a DeleteApiKeyWithResponse call
+func ParseDeleteApiKeyResp(rsp *http.Response) (*DeleteApiKeyResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseLoginResp(rsp)
-}
 
-// LogoutWithResponse request returning *LogoutResp
-func (c *ClientWithResponses) LogoutWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*LogoutResp, error) {
-	rsp, err := c.Logout(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &DeleteApiKeyResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseLogoutResp(rsp)
-}
 
-// GetCurrentUserWithResponse request returning *GetCurrentUserResp
-func (c *ClientWithResponses) GetCurrentUserWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetCurrentUserResp, error) {
-	rsp, err := c.GetCurrentUser(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SuccessMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
-	return ParseGetCurrentUserResp(rsp)
+
+	return response, nil
 }
 
-// ListSessionsWithResponse request returning *ListSessionsResp
-func (c *ClientWithResponses) ListSessionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListSessionsResp, error) {
-	rsp, err := c.ListSessions(ctx, reqEditors...)
+// ParseUpdateApiKeyExpiryResp parses an HTTP response from a UpdateApiKeyExpiryWithResponse call
+func ParseUpdateApiKeyExpiryResp(rsp *http.Response) (*UpdateApiKeyExpiryResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseListSessionsResp(rsp)
-}
 
-// RevokeSessionWithResponse request returning *RevokeSessionResp
-func (c *ClientWithResponses) RevokeSessionWithResponse(ctx context.Context, id int64, reqEditors ...RequestEditorFn) (*RevokeSessionResp, error) {
-	rsp, err := c.RevokeSession(ctx, id, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &UpdateApiKeyExpiryResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseRevokeSessionResp(rsp)
-}
 
-// ListDashboardsWithResponse request returning *ListDashboardsResp
-func (c *ClientWithResponses) ListDashboardsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListDashboardsResp, error) {
-	rsp, err := c.ListDashboards(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SuccessMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
-	return ParseListDashboardsResp(rsp)
+
+	return response, nil
 }
 
-// CreateDashboardWithBodyWithResponse request with arbitrary body returning *CreateDashboardResp
-func (c *ClientWithResponses) CreateDashboardWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDashboardResp, error) {
-	rsp, err := c.CreateDashboardWithBody(ctx, contentType, body, reqEditors...)
+// ParseRevokeApiKeyResp parses an HTTP response from a RevokeApiKeyWithResponse call
+func ParseRevokeApiKeyResp(rsp *http.Response) (*RevokeApiKeyResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateDashboardResp(rsp)
-}
 
-func (c *ClientWithResponses) CreateDashboardWithResponse(ctx context.Context, body CreateDashboardJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateDashboardResp, error) {
-	rsp, err := c.CreateDashboard(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &RevokeApiKeyResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseCreateDashboardResp(rsp)
-}
 
-// DeleteDashboardWithResponse request returning *DeleteDashboardResp
-func (c *ClientWithResponses) DeleteDashboardWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*DeleteDashboardResp, error) {
-	rsp, err := c.DeleteDashboard(ctx, id, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SuccessMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
-	return ParseDeleteDashboardResp(rsp)
+
+	return response, nil
 }
 
-// GetDashboardWithResponse request returning *GetDashboardResp
-func (c *ClientWithResponses) GetDashboardWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*GetDashboardResp, error) {
-	rsp, err := c.GetDashboard(ctx, id, reqEditors...)
+// ParseLoginResp parses an HTTP response from a LoginWithResponse call
+func ParseLoginResp(rsp *http.Response) (*LoginResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetDashboardResp(rsp)
-}
 
-// UpdateDashboardWithBodyWithResponse request with arbitrary body returning *UpdateDashboardResp
-func (c *ClientWithResponses) UpdateDashboardWithBodyWithResponse(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateDashboardResp, error) {
-	rsp, err := c.UpdateDashboardWithBody(ctx, id, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &LoginResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseUpdateDashboardResp(rsp)
-}
 
-func (c *ClientWithResponses) UpdateDashboardWithResponse(ctx context.Context, id int, body UpdateDashboardJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateDashboardResp, error) {
-	rsp, err := c.UpdateDashboard(ctx, id, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest LoginResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest RateLimitResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
 	}
-	return ParseUpdateDashboardResp(rsp)
+
+	return response, nil
 }
 
-// SetDefaultDashboardWithResponse request returning *SetDefaultDashboardResp
-func (c *ClientWithResponses) SetDefaultDashboardWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*SetDefaultDashboardResp, error) {
-	rsp, err := c.SetDefaultDashboard(ctx, id, reqEditors...)
+// ParseLogoutResp parses an HTTP response from a LogoutWithResponse call
+func ParseLogoutResp(rsp *http.Response) (*LogoutResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseSetDefaultDashboardResp(rsp)
-}
 
-// ShareDashboardWithBodyWithResponse request with arbitrary body returning *ShareDashboardResp
-func (c *ClientWithResponses) ShareDashboardWithBodyWithResponse(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ShareDashboardResp, error) {
-	rsp, err := c.ShareDashboardWithBody(ctx, id, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &LogoutResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseShareDashboardResp(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) ShareDashboardWithResponse(ctx context.Context, id int, body ShareDashboardJSONRequestBody, reqEditors ...RequestEditorFn) (*ShareDashboardResp, error) {
-	rsp, err := c.ShareDashboard(ctx, id, body, reqEditors...)
+// ParseGetCurrentUserResp parses an HTTP response from a GetCurrentUserWithResponse call
+func ParseGetCurrentUserResp(rsp *http.Response) (*GetCurrentUserResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseShareDashboardResp(rsp)
-}
 
-// ListDriversWithResponse request returning *ListDriversResp
-func (c *ClientWithResponses) ListDriversWithResponse(ctx context.Context, params *ListDriversParams, reqEditors ...RequestEditorFn) (*ListDriversResp, error) {
-	rsp, err := c.ListDrivers(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetCurrentUserResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseListDriversResp(rsp)
-}
 
-// GetHealthWithResponse request returning *GetHealthResp
-func (c *ClientWithResponses) GetHealthWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetHealthResp, error) {
-	rsp, err := c.GetHealth(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest MeResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParseGetHealthResp(rsp)
+
+	return response, nil
 }
 
-// GetAllMeasurementTypesWithResponse request returning *GetAllMeasurementTypesResp
-func (c *ClientWithResponses) GetAllMeasurementTypesWithResponse(ctx context.Context, params *GetAllMeasurementTypesParams, reqEditors ...RequestEditorFn) (*GetAllMeasurementTypesResp, error) {
-	rsp, err := c.GetAllMeasurementTypes(ctx, params, reqEditors...)
+// ParseListSessionsResp parses an HTTP response from a ListSessionsWithResponse call
+func ParseListSessionsResp(rsp *http.Response) (*ListSessionsResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetAllMeasurementTypesResp(rsp)
+
+	response := &ListSessionsResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []SessionInfo
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
 }
 
-// ListMqttBrokersWithResponse request returning *ListMqttBrokersResp
-func (c *ClientWithResponses) ListMqttBrokersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListMqttBrokersResp, error) {
-	rsp, err := c.ListMqttBrokers(ctx, reqEditors...)
+// ParseRevokeSessionResp parses an HTTP response from a RevokeSessionWithResponse call
+func ParseRevokeSessionResp(rsp *http.Response) (*RevokeSessionResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseListMqttBrokersResp(rsp)
-}
 
-// CreateMqttBrokerWithBodyWithResponse request with arbitrary body returning *CreateMqttBrokerResp
-func (c *ClientWithResponses) CreateMqttBrokerWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateMqttBrokerResp, error) {
-	rsp, err := c.CreateMqttBrokerWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &RevokeSessionResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseCreateMqttBrokerResp(rsp)
-}
 
-func (c *ClientWithResponses) CreateMqttBrokerWithResponse(ctx context.Context, body CreateMqttBrokerJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateMqttBrokerResp, error) {
-	rsp, err := c.CreateMqttBroker(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
-	return ParseCreateMqttBrokerResp(rsp)
+
+	return response, nil
 }
 
-// DeleteMqttBrokerWithResponse request returning *DeleteMqttBrokerResp
-func (c *ClientWithResponses) DeleteMqttBrokerWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*DeleteMqttBrokerResp, error) {
-	rsp, err := c.DeleteMqttBroker(ctx, id, reqEditors...)
+// ParseGetAllAutomationRulesResp parses an HTTP response from a GetAllAutomationRulesWithResponse call
+func ParseGetAllAutomationRulesResp(rsp *http.Response) (*GetAllAutomationRulesResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteMqttBrokerResp(rsp)
-}
 
-// GetMqttBrokerWithResponse request returning *GetMqttBrokerResp
-func (c *ClientWithResponses) GetMqttBrokerWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*GetMqttBrokerResp, error) {
-	rsp, err := c.GetMqttBroker(ctx, id, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetAllAutomationRulesResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseGetMqttBrokerResp(rsp)
-}
 
-// UpdateMqttBrokerWithBodyWithResponse request with arbitrary body returning *UpdateMqttBrokerResp
-func (c *ClientWithResponses) UpdateMqttBrokerWithBodyWithResponse(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateMqttBrokerResp, error) {
-	rsp, err := c.UpdateMqttBrokerWithBody(ctx, id, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []AutomationRule
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
-	return ParseUpdateMqttBrokerResp(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) UpdateMqttBrokerWithResponse(ctx context.Context, id int, body UpdateMqttBrokerJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateMqttBrokerResp, error) {
-	rsp, err := c.UpdateMqttBroker(ctx, id, body, reqEditors...)
+// ParseCreateAutomationRuleResp parses an HTTP response from a CreateAutomationRuleWithResponse call
+func ParseCreateAutomationRuleResp(rsp *http.Response) (*CreateAutomationRuleResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateMqttBrokerResp(rsp)
-}
 
-// GetMqttStatsWithResponse request returning *GetMqttStatsResp
-func (c *ClientWithResponses) GetMqttStatsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetMqttStatsResp, error) {
-	rsp, err := c.GetMqttStats(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &CreateAutomationRuleResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseGetMqttStatsResp(rsp)
-}
 
-// ListMqttSubscriptionsWithResponse request returning *ListMqttSubscriptionsResp
-func (c *ClientWithResponses) ListMqttSubscriptionsWithResponse(ctx context.Context, params *ListMqttSubscriptionsParams, reqEditors ...RequestEditorFn) (*ListMqttSubscriptionsResp, error) {
-	rsp, err := c.ListMqttSubscriptions(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest AutomationRule
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
-	return ParseListMqttSubscriptionsResp(rsp)
+
+	return response, nil
 }
 
-// CreateMqttSubscriptionWithBodyWithResponse request with arbitrary body returning *CreateMqttSubscriptionResp
-func (c *ClientWithResponses) CreateMqttSubscriptionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateMqttSubscriptionResp, error) {
-	rsp, err := c.CreateMqttSubscriptionWithBody(ctx, contentType, body, reqEditors...)
+// ParseDeleteAutomationRuleResp parses an HTTP response from a DeleteAutomationRuleWithResponse call
+func ParseDeleteAutomationRuleResp(rsp *http.Response) (*DeleteAutomationRuleResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateMqttSubscriptionResp(rsp)
-}
 
-func (c *ClientWithResponses) CreateMqttSubscriptionWithResponse(ctx context.Context, body CreateMqttSubscriptionJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateMqttSubscriptionResp, error) {
-	rsp, err := c.CreateMqttSubscription(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &DeleteAutomationRuleResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseCreateMqttSubscriptionResp(rsp)
-}
 
-// DeleteMqttSubscriptionWithResponse request returning *DeleteMqttSubscriptionResp
-func (c *ClientWithResponses) DeleteMqttSubscriptionWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*DeleteMqttSubscriptionResp, error) {
-	rsp, err := c.DeleteMqttSubscription(ctx, id, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SuccessMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
-	return ParseDeleteMqttSubscriptionResp(rsp)
+
+	return response, nil
 }
 
-// GetMqttSubscriptionWithResponse request returning *GetMqttSubscriptionResp
-func (c *ClientWithResponses) GetMqttSubscriptionWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*GetMqttSubscriptionResp, error) {
-	rsp, err := c.GetMqttSubscription(ctx, id, reqEditors...)
+// ParseGetAutomationRuleByIdResp parses an HTTP response from a GetAutomationRuleByIdWithResponse call
+func ParseGetAutomationRuleByIdResp(rsp *http.Response) (*GetAutomationRuleByIdResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetMqttSubscriptionResp(rsp)
-}
 
-// UpdateMqttSubscriptionWithBodyWithResponse request with arbitrary body returning *UpdateMqttSubscriptionResp
-func (c *ClientWithResponses) UpdateMqttSubscriptionWithBodyWithResponse(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateMqttSubscriptionResp, error) {
-	rsp, err := c.UpdateMqttSubscriptionWithBody(ctx, id, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetAutomationRuleByIdResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseUpdateMqttSubscriptionResp(rsp)
-}
 
-func (c *ClientWithResponses) UpdateMqttSubscriptionWithResponse(ctx context.Context, id int, body UpdateMqttSubscriptionJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateMqttSubscriptionResp, error) {
-	rsp, err := c.UpdateMqttSubscription(ctx, id, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AutomationRule
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
-	return ParseUpdateMqttSubscriptionResp(rsp)
+
+	return response, nil
 }
 
-// ListNotificationsWithResponse request returning *ListNotificationsResp
-func (c *ClientWithResponses) ListNotificationsWithResponse(ctx context.Context, params *ListNotificationsParams, reqEditors ...RequestEditorFn) (*ListNotificationsResp, error) {
-	rsp, err := c.ListNotifications(ctx, params, reqEditors...)
+// ParseUpdateAutomationRuleResp parses an HTTP response from a UpdateAutomationRuleWithResponse call
+func ParseUpdateAutomationRuleResp(rsp *http.Response) (*UpdateAutomationRuleResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseListNotificationsResp(rsp)
-}
 
-// BulkDismissWithResponse request returning *BulkDismissResp
-func (c *ClientWithResponses) BulkDismissWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*BulkDismissResp, error) {
-	rsp, err := c.BulkDismiss(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &UpdateAutomationRuleResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseBulkDismissResp(rsp)
-}
 
-// BulkMarkAsReadWithResponse request returning *BulkMarkAsReadResp
-func (c *ClientWithResponses) BulkMarkAsReadWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*BulkMarkAsReadResp, error) {
-	rsp, err := c.BulkMarkAsRead(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SuccessMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
-	return ParseBulkMarkAsReadResp(rsp)
-}
 
-// GetChannelPreferencesWithResponse request returning *GetChannelPreferencesResp
-func (c *ClientWithResponses) GetChannelPreferencesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetChannelPreferencesResp, error) {
-	rsp, err := c.GetChannelPreferences(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetChannelPreferencesResp(rsp)
+	return response, nil
 }
 
-// SetChannelPreferenceWithBodyWithResponse request with arbitrary body returning *SetChannelPreferenceResp
-func (c *ClientWithResponses) SetChannelPreferenceWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetChannelPreferenceResp, error) {
-	rsp, err := c.SetChannelPreferenceWithBody(ctx, contentType, body, reqEditors...)
+// ParseListDashboardsResp parses an HTTP response from a ListDashboardsWithResponse call
+func ParseListDashboardsResp(rsp *http.Response) (*ListDashboardsResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseSetChannelPreferenceResp(rsp)
-}
 
-func (c *ClientWithResponses) SetChannelPreferenceWithResponse(ctx context.Context, body SetChannelPreferenceJSONRequestBody, reqEditors ...RequestEditorFn) (*SetChannelPreferenceResp, error) {
-	rsp, err := c.SetChannelPreference(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &ListDashboardsResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseSetChannelPreferenceResp(rsp)
-}
 
-// GetUnreadCountWithResponse request returning *GetUnreadCountResp
-func (c *ClientWithResponses) GetUnreadCountWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetUnreadCountResp, error) {
-	rsp, err := c.GetUnreadCount(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetUnreadCountResp(rsp)
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Dashboard
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// NotificationsWebSocketWithResponse request returning *NotificationsWebSocketResp
-func (c *ClientWithResponses) NotificationsWebSocketWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*NotificationsWebSocketResp, error) {
-	rsp, err := c.NotificationsWebSocket(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
 	}
-	return ParseNotificationsWebSocketResp(rsp)
-}
 
-// DismissNotificationWithResponse request returning *DismissNotificationResp
-func (c *ClientWithResponses) DismissNotificationWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*DismissNotificationResp, error) {
-	rsp, err := c.DismissNotification(ctx, id, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseDismissNotificationResp(rsp)
+	return response, nil
 }
 
-// MarkAsReadWithResponse request returning *MarkAsReadResp
-func (c *ClientWithResponses) MarkAsReadWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*MarkAsReadResp, error) {
-	rsp, err := c.MarkAsRead(ctx, id, reqEditors...)
+// ParseCreateDashboardResp parses an HTTP response from a CreateDashboardWithResponse call
+func ParseCreateDashboardResp(rsp *http.Response) (*CreateDashboardResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseMarkAsReadResp(rsp)
-}
 
-// GetOAuthAuthorizeUrlWithResponse request returning *GetOAuthAuthorizeUrlResp
-func (c *ClientWithResponses) GetOAuthAuthorizeUrlWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetOAuthAuthorizeUrlResp, error) {
-	rsp, err := c.GetOAuthAuthorizeUrl(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &CreateDashboardResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseGetOAuthAuthorizeUrlResp(rsp)
-}
 
-// ReloadOAuthWithResponse request returning *ReloadOAuthResp
-func (c *ClientWithResponses) ReloadOAuthWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ReloadOAuthResp, error) {
-	rsp, err := c.ReloadOAuth(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest struct {
+			Id *int `json:"id,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
-	return ParseReloadOAuthResp(rsp)
+
+	return response, nil
 }
 
-// GetOAuthStatusWithResponse request returning *GetOAuthStatusResp
-func (c *ClientWithResponses) GetOAuthStatusWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetOAuthStatusResp, error) {
-	rsp, err := c.GetOAuthStatus(ctx, reqEditors...)
+// ParseDeleteDashboardResp parses an HTTP response from a DeleteDashboardWithResponse call
+func ParseDeleteDashboardResp(rsp *http.Response) (*DeleteDashboardResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetOAuthStatusResp(rsp)
-}
 
-// SubmitOAuthCodeWithBodyWithResponse request with arbitrary body returning *SubmitOAuthCodeResp
-func (c *ClientWithResponses) SubmitOAuthCodeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SubmitOAuthCodeResp, error) {
-	rsp, err := c.SubmitOAuthCodeWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &DeleteDashboardResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseSubmitOAuthCodeResp(rsp)
-}
 
-func (c *ClientWithResponses) SubmitOAuthCodeWithResponse(ctx context.Context, body SubmitOAuthCodeJSONRequestBody, reqEditors ...RequestEditorFn) (*SubmitOAuthCodeResp, error) {
-	rsp, err := c.SubmitOAuthCode(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SuccessMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
-	return ParseSubmitOAuthCodeResp(rsp)
+
+	return response, nil
 }
 
-// GetOpenApiSpecWithResponse request returning *GetOpenApiSpecResp
-func (c *ClientWithResponses) GetOpenApiSpecWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetOpenApiSpecResp, error) {
-	rsp, err := c.GetOpenApiSpec(ctx, reqEditors...)
+// ParseGetDashboardResp parses an HTTP response from a GetDashboardWithResponse call
+func ParseGetDashboardResp(rsp *http.Response) (*GetDashboardResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetOpenApiSpecResp(rsp)
-}
 
-// GetPropertiesWithResponse request returning *GetPropertiesResp
-func (c *ClientWithResponses) GetPropertiesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetPropertiesResp, error) {
-	rsp, err := c.GetProperties(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetDashboardResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseGetPropertiesResp(rsp)
-}
 
-// UpdatePropertiesWithBodyWithResponse request with arbitrary body returning *UpdatePropertiesResp
-func (c *ClientWithResponses) UpdatePropertiesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdatePropertiesResp, error) {
-	rsp, err := c.UpdatePropertiesWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Dashboard
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParseUpdatePropertiesResp(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) UpdatePropertiesWithResponse(ctx context.Context, body UpdatePropertiesJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdatePropertiesResp, error) {
-	rsp, err := c.UpdateProperties(ctx, body, reqEditors...)
+// ParseUpdateDashboardResp parses an HTTP response from a UpdateDashboardWithResponse call
+func ParseUpdateDashboardResp(rsp *http.Response) (*UpdateDashboardResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdatePropertiesResp(rsp)
-}
 
-// PropertiesWebSocketWithResponse request returning *PropertiesWebSocketResp
-func (c *ClientWithResponses) PropertiesWebSocketWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PropertiesWebSocketResp, error) {
-	rsp, err := c.PropertiesWebSocket(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &UpdateDashboardResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParsePropertiesWebSocketResp(rsp)
-}
 
-// GetReadingsBetweenDatesWithResponse request returning *GetReadingsBetweenDatesResp
-func (c *ClientWithResponses) GetReadingsBetweenDatesWithResponse(ctx context.Context, params *GetReadingsBetweenDatesParams, reqEditors ...RequestEditorFn) (*GetReadingsBetweenDatesResp, error) {
-	rsp, err := c.GetReadingsBetweenDates(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SuccessMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
-	return ParseGetReadingsBetweenDatesResp(rsp)
+
+	return response, nil
 }
 
-// SubscribeCurrentReadingsWithResponse request returning *SubscribeCurrentReadingsResp
-func (c *ClientWithResponses) SubscribeCurrentReadingsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*SubscribeCurrentReadingsResp, error) {
-	rsp, err := c.SubscribeCurrentReadings(ctx, reqEditors...)
+// ParseSetDefaultDashboardResp parses an HTTP response from a SetDefaultDashboardWithResponse call
+func ParseSetDefaultDashboardResp(rsp *http.Response) (*SetDefaultDashboardResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseSubscribeCurrentReadingsResp(rsp)
-}
 
-// ListRolesWithResponse request returning *ListRolesResp
-func (c *ClientWithResponses) ListRolesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListRolesResp, error) {
-	rsp, err := c.ListRoles(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &SetDefaultDashboardResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseListRolesResp(rsp)
-}
 
-// ListPermissionsWithResponse request returning *ListPermissionsResp
-func (c *ClientWithResponses) ListPermissionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListPermissionsResp, error) {
-	rsp, err := c.ListPermissions(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SuccessMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
-	return ParseListPermissionsResp(rsp)
-}
 
-// GetRolePermissionsWithResponse request returning *GetRolePermissionsResp
-func (c *ClientWithResponses) GetRolePermissionsWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*GetRolePermissionsResp, error) {
-	rsp, err := c.GetRolePermissions(ctx, id, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetRolePermissionsResp(rsp)
+	return response, nil
 }
 
-// AssignPermissionWithBodyWithResponse request with arbitrary body returning *AssignPermissionResp
-func (c *ClientWithResponses) AssignPermissionWithBodyWithResponse(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AssignPermissionResp, error) {
-	rsp, err := c.AssignPermissionWithBody(ctx, id, contentType, body, reqEditors...)
+// ParseShareDashboardResp parses an HTTP response from a ShareDashboardWithResponse call
+func ParseShareDashboardResp(rsp *http.Response) (*ShareDashboardResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseAssignPermissionResp(rsp)
-}
 
-func (c *ClientWithResponses) AssignPermissionWithResponse(ctx context.Context, id int, body AssignPermissionJSONRequestBody, reqEditors ...RequestEditorFn) (*AssignPermissionResp, error) {
-	rsp, err := c.AssignPermission(ctx, id, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &ShareDashboardResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseAssignPermissionResp(rsp)
-}
 
-// RemovePermissionWithResponse request returning *RemovePermissionResp
-func (c *ClientWithResponses) RemovePermissionWithResponse(ctx context.Context, id int, pid int, reqEditors ...RequestEditorFn) (*RemovePermissionResp, error) {
-	rsp, err := c.RemovePermission(ctx, id, pid, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SuccessMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
-	return ParseRemovePermissionResp(rsp)
+
+	return response, nil
 }
 
-// GetAllSensorsWithResponse request returning *GetAllSensorsResp
-func (c *ClientWithResponses) GetAllSensorsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAllSensorsResp, error) {
-	rsp, err := c.GetAllSensors(ctx, reqEditors...)
+// ParseListDriversResp parses an HTTP response from a ListDriversWithResponse call
+func ParseListDriversResp(rsp *http.Response) (*ListDriversResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetAllSensorsResp(rsp)
-}
 
-// AddSensorWithBodyWithResponse request with arbitrary body returning *AddSensorResp
-func (c *ClientWithResponses) AddSensorWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddSensorResp, error) {
-	rsp, err := c.AddSensorWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &ListDriversResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseAddSensorResp(rsp)
-}
 
-func (c *ClientWithResponses) AddSensorWithResponse(ctx context.Context, body AddSensorJSONRequestBody, reqEditors ...RequestEditorFn) (*AddSensorResp, error) {
-	rsp, err := c.AddSensor(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []DriverInfo
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParseAddSensorResp(rsp)
+
+	return response, nil
 }
 
-// ApproveSensorWithResponse request returning *ApproveSensorResp
-func (c *ClientWithResponses) ApproveSensorWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*ApproveSensorResp, error) {
-	rsp, err := c.ApproveSensor(ctx, id, reqEditors...)
+// ParseGetHealthResp parses an HTTP response from a GetHealthWithResponse call
+func ParseGetHealthResp(rsp *http.Response) (*GetHealthResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseApproveSensorResp(rsp)
-}
 
-// GetSensorCapabilitiesWithResponse request returning *GetSensorCapabilitiesResp
-func (c *ClientWithResponses) GetSensorCapabilitiesWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*GetSensorCapabilitiesResp, error) {
-	rsp, err := c.GetSensorCapabilities(ctx, id, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetHealthResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseGetSensorCapabilitiesResp(rsp)
-}
 
-// GetSensorCommandHistoryWithResponse request returning *GetSensorCommandHistoryResp
-func (c *ClientWithResponses) GetSensorCommandHistoryWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*GetSensorCommandHistoryResp, error) {
-	rsp, err := c.GetSensorCommandHistory(ctx, id, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Status *string `json:"status,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParseGetSensorCommandHistoryResp(rsp)
+
+	return response, nil
 }
 
-// GetSensorMeasurementTypesWithResponse request returning *GetSensorMeasurementTypesResp
-func (c *ClientWithResponses) GetSensorMeasurementTypesWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*GetSensorMeasurementTypesResp, error) {
-	rsp, err := c.GetSensorMeasurementTypes(ctx, id, reqEditors...)
+// ParseGetAllMeasurementTypesResp parses an HTTP response from a GetAllMeasurementTypesWithResponse call
+func ParseGetAllMeasurementTypesResp(rsp *http.Response) (*GetAllMeasurementTypesResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetSensorMeasurementTypesResp(rsp)
-}
 
-// CollectAllSensorReadingsWithResponse request returning *CollectAllSensorReadingsResp
-func (c *ClientWithResponses) CollectAllSensorReadingsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*CollectAllSensorReadingsResp, error) {
-	rsp, err := c.CollectAllSensorReadings(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetAllMeasurementTypesResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseCollectAllSensorReadingsResp(rsp)
-}
 
-// CollectFromSensorWithResponse request returning *CollectFromSensorResp
-func (c *ClientWithResponses) CollectFromSensorWithResponse(ctx context.Context, sensorName string, reqEditors ...RequestEditorFn) (*CollectFromSensorResp, error) {
-	rsp, err := c.CollectFromSensor(ctx, sensorName, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []MeasurementType
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParseCollectFromSensorResp(rsp)
+
+	return response, nil
 }
 
-// DisableSensorWithResponse request returning *DisableSensorResp
-func (c *ClientWithResponses) DisableSensorWithResponse(ctx context.Context, sensorName string, reqEditors ...RequestEditorFn) (*DisableSensorResp, error) {
-	rsp, err := c.DisableSensor(ctx, sensorName, reqEditors...)
+// ParseDeleteMeasurementTypeDownsamplingResp parses an HTTP response from a DeleteMeasurementTypeDownsamplingWithResponse call
+func ParseDeleteMeasurementTypeDownsamplingResp(rsp *http.Response) (*DeleteMeasurementTypeDownsamplingResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseDisableSensorResp(rsp)
-}
 
-// DismissSensorWithResponse request returning *DismissSensorResp
-func (c *ClientWithResponses) DismissSensorWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*DismissSensorResp, error) {
-	rsp, err := c.DismissSensor(ctx, id, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &DeleteMeasurementTypeDownsamplingResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseDismissSensorResp(rsp)
-}
 
-// GetSensorsByDriverWithResponse request returning *GetSensorsByDriverResp
-func (c *ClientWithResponses) GetSensorsByDriverWithResponse(ctx context.Context, driver string, reqEditors ...RequestEditorFn) (*GetSensorsByDriverResp, error) {
-	rsp, err := c.GetSensorsByDriver(ctx, driver, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest MeasurementType
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
-	return ParseGetSensorsByDriverResp(rsp)
+
+	return response, nil
 }
 
-// EnableSensorWithResponse request returning *EnableSensorResp
-func (c *ClientWithResponses) EnableSensorWithResponse(ctx context.Context, sensorName string, reqEditors ...RequestEditorFn) (*EnableSensorResp, error) {
-	rsp, err := c.EnableSensor(ctx, sensorName, reqEditors...)
+// ParseSetMeasurementTypeDownsamplingResp parses an HTTP response from a SetMeasurementTypeDownsamplingWithResponse call
+func ParseSetMeasurementTypeDownsamplingResp(rsp *http.Response) (*SetMeasurementTypeDownsamplingResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseEnableSensorResp(rsp)
-}
 
-// GetSensorHealthHistoryByNameWithResponse request returning *GetSensorHealthHistoryByNameResp
-func (c *ClientWithResponses) GetSensorHealthHistoryByNameWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*GetSensorHealthHistoryByNameResp, error) {
-	rsp, err := c.GetSensorHealthHistoryByName(ctx, name, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &SetMeasurementTypeDownsamplingResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseGetSensorHealthHistoryByNameResp(rsp)
-}
 
-// GetTotalReadingsPerSensorWithResponse request returning *GetTotalReadingsPerSensorResp
-func (c *ClientWithResponses) GetTotalReadingsPerSensorWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetTotalReadingsPerSensorResp, error) {
-	rsp, err := c.GetTotalReadingsPerSensor(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest MeasurementType
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
-	return ParseGetTotalReadingsPerSensorResp(rsp)
-}
 
-// GetSensorsByStatusWithResponse request returning *GetSensorsByStatusResp
-func (c *ClientWithResponses) GetSensorsByStatusWithResponse(ctx context.Context, status GetSensorsByStatusParamsStatus, reqEditors ...RequestEditorFn) (*GetSensorsByStatusResp, error) {
-	rsp, err := c.GetSensorsByStatus(ctx, status, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetSensorsByStatusResp(rsp)
+	return response, nil
 }
 
-// SubscribeAllSensorsWithResponse request returning *SubscribeAllSensorsResp
-func (c *ClientWithResponses) SubscribeAllSensorsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*SubscribeAllSensorsResp, error) {
-	rsp, err := c.SubscribeAllSensors(ctx, reqEditors...)
+// ParseListMqttBrokersResp parses an HTTP response from a ListMqttBrokersWithResponse call
+func ParseListMqttBrokersResp(rsp *http.Response) (*ListMqttBrokersResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseSubscribeAllSensorsResp(rsp)
-}
 
-// SubscribeSensorsByDriverWithResponse request returning *SubscribeSensorsByDriverResp
-func (c *ClientWithResponses) SubscribeSensorsByDriverWithResponse(ctx context.Context, driver string, reqEditors ...RequestEditorFn) (*SubscribeSensorsByDriverResp, error) {
-	rsp, err := c.SubscribeSensorsByDriver(ctx, driver, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &ListMqttBrokersResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseSubscribeSensorsByDriverResp(rsp)
-}
 
-// UpdateSensorByIdWithBodyWithResponse request with arbitrary body returning *UpdateSensorByIdResp
-func (c *ClientWithResponses) UpdateSensorByIdWithBodyWithResponse(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateSensorByIdResp, error) {
-	rsp, err := c.UpdateSensorByIdWithBody(ctx, id, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseUpdateSensorByIdResp(rsp)
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []MQTTBroker
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-func (c *ClientWithResponses) UpdateSensorByIdWithResponse(ctx context.Context, id int, body UpdateSensorByIdJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateSensorByIdResp, error) {
-	rsp, err := c.UpdateSensorById(ctx, id, body, reqEditors...)
-	if err != nil {
-		return nil, err
 	}
-	return ParseUpdateSensorByIdResp(rsp)
-}
 
-// SendSensorCommandWithBodyWithResponse request with arbitrary body returning *SendSensorCommandResp
-func (c *ClientWithResponses) SendSensorCommandWithBodyWithResponse(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SendSensorCommandResp, error) {
-	rsp, err := c.SendSensorCommandWithBody(ctx, id, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseSendSensorCommandResp(rsp)
+	return response, nil
 }
 
-func (c *ClientWithResponses) SendSensorCommandWithResponse(ctx context.Context, id int, body SendSensorCommandJSONRequestBody, reqEditors ...RequestEditorFn) (*SendSensorCommandResp, error) {
-	rsp, err := c.SendSensorCommand(ctx, id, body, reqEditors...)
+// ParseCreateMqttBrokerResp parses an HTTP response from a CreateMqttBrokerWithResponse call
+func ParseCreateMqttBrokerResp(rsp *http.Response) (*CreateMqttBrokerResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseSendSensorCommandResp(rsp)
-}
 
-// DeleteSensorByNameWithResponse request returning *DeleteSensorByNameResp
-func (c *ClientWithResponses) DeleteSensorByNameWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*DeleteSensorByNameResp, error) {
-	rsp, err := c.DeleteSensorByName(ctx, name, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &CreateMqttBrokerResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseDeleteSensorByNameResp(rsp)
-}
 
-// GetSensorByNameWithResponse request returning *GetSensorByNameResp
-func (c *ClientWithResponses) GetSensorByNameWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*GetSensorByNameResp, error) {
-	rsp, err := c.GetSensorByName(ctx, name, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest struct {
+			Id *int `json:"id,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
 	}
-	return ParseGetSensorByNameResp(rsp)
+
+	return response, nil
 }
 
-// SensorExistsWithResponse request returning *SensorExistsResp
-func (c *ClientWithResponses) SensorExistsWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*SensorExistsResp, error) {
-	rsp, err := c.SensorExists(ctx, name, reqEditors...)
+// ParseDeleteMqttBrokerResp parses an HTTP response from a DeleteMqttBrokerWithResponse call
+func ParseDeleteMqttBrokerResp(rsp *http.Response) (*DeleteMqttBrokerResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseSensorExistsResp(rsp)
-}
 
-// ListUsersWithResponse request returning *ListUsersResp
-func (c *ClientWithResponses) ListUsersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListUsersResp, error) {
-	rsp, err := c.ListUsers(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &DeleteMqttBrokerResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseListUsersResp(rsp)
+
+	return response, nil
 }
 
-// CreateUserWithBodyWithResponse request with arbitrary body returning *CreateUserResp
-func (c *ClientWithResponses) CreateUserWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateUserResp, error) {
-	rsp, err := c.CreateUserWithBody(ctx, contentType, body, reqEditors...)
+// ParseGetMqttBrokerResp parses an HTTP response from a GetMqttBrokerWithResponse call
+func ParseGetMqttBrokerResp(rsp *http.Response) (*GetMqttBrokerResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateUserResp(rsp)
-}
 
-func (c *ClientWithResponses) CreateUserWithResponse(ctx context.Context, body CreateUserJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateUserResp, error) {
-	rsp, err := c.CreateUser(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetMqttBrokerResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseCreateUserResp(rsp)
-}
 
-// ChangePasswordWithBodyWithResponse request with arbitrary body returning *ChangePasswordResp
-func (c *ClientWithResponses) ChangePasswordWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ChangePasswordResp, error) {
-	rsp, err := c.ChangePasswordWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest MQTTBroker
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParseChangePasswordResp(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) ChangePasswordWithResponse(ctx context.Context, body ChangePasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*ChangePasswordResp, error) {
-	rsp, err := c.ChangePassword(ctx, body, reqEditors...)
+// ParseUpdateMqttBrokerResp parses an HTTP response from a UpdateMqttBrokerWithResponse call
+func ParseUpdateMqttBrokerResp(rsp *http.Response) (*UpdateMqttBrokerResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseChangePasswordResp(rsp)
-}
 
-// DeleteUserWithResponse request returning *DeleteUserResp
-func (c *ClientWithResponses) DeleteUserWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*DeleteUserResp, error) {
-	rsp, err := c.DeleteUser(ctx, id, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &UpdateMqttBrokerResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseDeleteUserResp(rsp)
-}
 
-// SetMustChangePasswordWithBodyWithResponse request with arbitrary body returning *SetMustChangePasswordResp
-func (c *ClientWithResponses) SetMustChangePasswordWithBodyWithResponse(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetMustChangePasswordResp, error) {
-	rsp, err := c.SetMustChangePasswordWithBody(ctx, id, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SuccessMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParseSetMustChangePasswordResp(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) SetMustChangePasswordWithResponse(ctx context.Context, id int, body SetMustChangePasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*SetMustChangePasswordResp, error) {
-	rsp, err := c.SetMustChangePassword(ctx, id, body, reqEditors...)
+// ParseGetMqttStatsResp parses an HTTP response from a GetMqttStatsWithResponse call
+func ParseGetMqttStatsResp(rsp *http.Response) (*GetMqttStatsResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseSetMustChangePasswordResp(rsp)
-}
 
-// SetUserRolesWithBodyWithResponse request with arbitrary body returning *SetUserRolesResp
-func (c *ClientWithResponses) SetUserRolesWithBodyWithResponse(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetUserRolesResp, error) {
-	rsp, err := c.SetUserRolesWithBody(ctx, id, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetMqttStatsResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseSetUserRolesResp(rsp)
-}
 
-func (c *ClientWithResponses) SetUserRolesWithResponse(ctx context.Context, id int, body SetUserRolesJSONRequestBody, reqEditors ...RequestEditorFn) (*SetUserRolesResp, error) {
-	rsp, err := c.SetUserRoles(ctx, id, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []MQTTBrokerStats
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParseSetUserRolesResp(rsp)
+
+	return response, nil
 }
 
-// ParseGetAllAlertRulesResp parses an HTTP response from a GetAllAlertRulesWithResponse call
-func ParseGetAllAlertRulesResp(rsp *http.Response) (*GetAllAlertRulesResp, error) {
+// ParseListMqttSubscriptionsResp parses an HTTP response from a ListMqttSubscriptionsWithResponse call
+func ParseListMqttSubscriptionsResp(rsp *http.Response) (*ListMqttSubscriptionsResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetAllAlertRulesResp{
+	response := &ListMqttSubscriptionsResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []AlertRule
+		var dest []MQTTSubscription
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
-
 	}
 
 	return response, nil
 }
 
-// ParseCreateAlertRuleResp parses an HTTP response from a CreateAlertRuleWithResponse call
-func ParseCreateAlertRuleResp(rsp *http.Response) (*CreateAlertRuleResp, error) {
+// ParseCreateMqttSubscriptionResp parses an HTTP response from a CreateMqttSubscriptionWithResponse call
+func ParseCreateMqttSubscriptionResp(rsp *http.Response) (*CreateMqttSubscriptionResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateAlertRuleResp{
+	response := &CreateMqttSubscriptionResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest SuccessMessage
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest ErrorResponse
+		var dest struct {
+			Id *int `json:"id,omitempty"`
+		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON500 = &dest
+		response.JSON201 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseGetAlertRulesBySensorIdResp parses an HTTP response from a GetAlertRulesBySensorIdWithResponse call
-func ParseGetAlertRulesBySensorIdResp(rsp *http.Response) (*GetAlertRulesBySensorIdResp, error) {
+// ParseDeleteMqttSubscriptionResp parses an HTTP response from a DeleteMqttSubscriptionWithResponse call
+func ParseDeleteMqttSubscriptionResp(rsp *http.Response) (*DeleteMqttSubscriptionResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetAlertRulesBySensorIdResp{
+	response := &DeleteMqttSubscriptionResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []AlertRule
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
-
-	}
-
 	return response, nil
 }
 
-// ParseGetAlertHistoryResp parses an HTTP response from a GetAlertHistoryWithResponse call
-func ParseGetAlertHistoryResp(rsp *http.Response) (*GetAlertHistoryResp, error) {
+// ParseGetMqttSubscriptionResp parses an HTTP response from a GetMqttSubscriptionWithResponse call
+func ParseGetMqttSubscriptionResp(rsp *http.Response) (*GetMqttSubscriptionResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetAlertHistoryResp{
+	response := &GetMqttSubscriptionResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []AlertHistoryEntry
+		var dest MQTTSubscription
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
-
 	}
 
 	return response, nil
 }
 
-// ParseDeleteAlertRuleResp parses an HTTP response from a DeleteAlertRuleWithResponse call
-func ParseDeleteAlertRuleResp(rsp *http.Response) (*DeleteAlertRuleResp, error) {
+// ParseUpdateMqttSubscriptionResp parses an HTTP response from a UpdateMqttSubscriptionWithResponse call
+func ParseUpdateMqttSubscriptionResp(rsp *http.Response) (*UpdateMqttSubscriptionResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteAlertRuleResp{
+	response := &UpdateMqttSubscriptionResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -8665,74 +17896,53 @@ func ParseDeleteAlertRuleResp(rsp *http.Response) (*DeleteAlertRuleResp, error)
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
-
 	}
 
 	return response, nil
 }
 
-// ParseGetAlertRuleByIdResp parses an HTTP response from a GetAlertRuleByIdWithResponse call
-func ParseGetAlertRuleByIdResp(rsp *http.Response) (*GetAlertRuleByIdResp, error) {
+// ParseListNotificationsResp parses an HTTP response from a ListNotificationsWithResponse call
+func ParseListNotificationsResp(rsp *http.Response) (*ListNotificationsResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetAlertRuleByIdResp{
+	response := &ListNotificationsResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest AlertRule
+		var dest []UserNotification
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON404 = &dest
+		response.JSON500 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseUpdateAlertRuleResp parses an HTTP response from a UpdateAlertRuleWithResponse call
-func ParseUpdateAlertRuleResp(rsp *http.Response) (*UpdateAlertRuleResp, error) {
+// ParseBulkDismissResp parses an HTTP response from a BulkDismissWithResponse call
+func ParseBulkDismissResp(rsp *http.Response) (*BulkDismissResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateAlertRuleResp{
+	response := &BulkDismissResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -8745,13 +17955,6 @@ func ParseUpdateAlertRuleResp(rsp *http.Response) (*UpdateAlertRuleResp, error)
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -8764,22 +17967,22 @@ func ParseUpdateAlertRuleResp(rsp *http.Response) (*UpdateAlertRuleResp, error)
 	return response, nil
 }
 
-// ParseListApiKeysResp parses an HTTP response from a ListApiKeysWithResponse call
-func ParseListApiKeysResp(rsp *http.Response) (*ListApiKeysResp, error) {
+// ParseBulkMarkAsReadResp parses an HTTP response from a BulkMarkAsReadWithResponse call
+func ParseBulkMarkAsReadResp(rsp *http.Response) (*BulkMarkAsReadResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListApiKeysResp{
+	response := &BulkMarkAsReadResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []ApiKey
+		var dest SuccessMessage
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -8797,30 +18000,26 @@ func ParseListApiKeysResp(rsp *http.Response) (*ListApiKeysResp, error) {
 	return response, nil
 }
 
-// ParseCreateApiKeyResp parses an HTTP response from a CreateApiKeyWithResponse call
-func ParseCreateApiKeyResp(rsp *http.Response) (*CreateApiKeyResp, error) {
+// ParseGetChannelPreferencesResp parses an HTTP response from a GetChannelPreferencesWithResponse call
+func ParseGetChannelPreferencesResp(rsp *http.Response) (*GetChannelPreferencesResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateApiKeyResp{
+	response := &GetChannelPreferencesResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest struct {
-			// Key The full API key. Store it securely — it will not be shown again.
-			Key     *string `json:"key,omitempty"`
-			Message *string `json:"message,omitempty"`
-		}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []ChannelPreference
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON201 = &dest
+		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest ErrorResponse
@@ -8834,15 +18033,15 @@ func ParseCreateApiKeyResp(rsp *http.Response) (*CreateApiKeyResp, error) {
 	return response, nil
 }
 
-// ParseDeleteApiKeyResp parses an HTTP response from a DeleteApiKeyWithResponse call
-func ParseDeleteApiKeyResp(rsp *http.Response) (*DeleteApiKeyResp, error) {
+// ParseSetChannelPreferenceResp parses an HTTP response from a SetChannelPreferenceWithResponse call
+func ParseSetChannelPreferenceResp(rsp *http.Response) (*SetChannelPreferenceResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteApiKeyResp{
+	response := &SetChannelPreferenceResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -8855,6 +18054,13 @@ func ParseDeleteApiKeyResp(rsp *http.Response) (*DeleteApiKeyResp, error) {
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -8867,15 +18073,15 @@ func ParseDeleteApiKeyResp(rsp *http.Response) (*DeleteApiKeyResp, error) {
 	return response, nil
 }
 
-// ParseUpdateApiKeyExpiryResp parses an HTTP response from a UpdateApiKeyExpiryWithResponse call
-func ParseUpdateApiKeyExpiryResp(rsp *http.Response) (*UpdateApiKeyExpiryResp, error) {
+// ParseDeletePushSubscriptionResp parses an HTTP response from a DeletePushSubscriptionWithResponse call
+func ParseDeletePushSubscriptionResp(rsp *http.Response) (*DeletePushSubscriptionResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateApiKeyExpiryResp{
+	response := &DeletePushSubscriptionResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -8888,6 +18094,13 @@ func ParseUpdateApiKeyExpiryResp(rsp *http.Response) (*UpdateApiKeyExpiryResp, e
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -8900,27 +18113,34 @@ func ParseUpdateApiKeyExpiryResp(rsp *http.Response) (*UpdateApiKeyExpiryResp, e
 	return response, nil
 }
 
-// ParseRevokeApiKeyResp parses an HTTP response from a RevokeApiKeyWithResponse call
-func ParseRevokeApiKeyResp(rsp *http.Response) (*RevokeApiKeyResp, error) {
+// ParseGetPushSubscriptionResp parses an HTTP response from a GetPushSubscriptionWithResponse call
+func ParseGetPushSubscriptionResp(rsp *http.Response) (*GetPushSubscriptionResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &RevokeApiKeyResp{
+	response := &GetPushSubscriptionResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest SuccessMessage
+		var dest PushSubscription
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -8933,109 +18153,114 @@ func ParseRevokeApiKeyResp(rsp *http.Response) (*RevokeApiKeyResp, error) {
 	return response, nil
 }
 
-// ParseLoginResp parses an HTTP response from a LoginWithResponse call
-func ParseLoginResp(rsp *http.Response) (*LoginResp, error) {
+// ParseSetPushSubscriptionResp parses an HTTP response from a SetPushSubscriptionWithResponse call
+func ParseSetPushSubscriptionResp(rsp *http.Response) (*SetPushSubscriptionResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &LoginResp{
+	response := &SetPushSubscriptionResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest LoginResponse
+		var dest PushSubscription
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON401 = &dest
+		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
-		var dest RateLimitResponse
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON429 = &dest
-
-	}
-
-	return response, nil
-}
-
-// ParseLogoutResp parses an HTTP response from a LogoutWithResponse call
-func ParseLogoutResp(rsp *http.Response) (*LogoutResp, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+		response.JSON500 = &dest
 
-	response := &LogoutResp{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
 	}
 
 	return response, nil
 }
 
-// ParseGetCurrentUserResp parses an HTTP response from a GetCurrentUserWithResponse call
-func ParseGetCurrentUserResp(rsp *http.Response) (*GetCurrentUserResp, error) {
+// ParseTestPushSubscriptionResp parses an HTTP response from a TestPushSubscriptionWithResponse call
+func ParseTestPushSubscriptionResp(rsp *http.Response) (*TestPushSubscriptionResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetCurrentUserResp{
+	response := &TestPushSubscriptionResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest MeResponse
+		var dest SuccessMessage
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 502:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON502 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseListSessionsResp parses an HTTP response from a ListSessionsWithResponse call
-func ParseListSessionsResp(rsp *http.Response) (*ListSessionsResp, error) {
+// ParseDeleteQuietHoursResp parses an HTTP response from a DeleteQuietHoursWithResponse call
+func ParseDeleteQuietHoursResp(rsp *http.Response) (*DeleteQuietHoursResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListSessionsResp{
+	response := &DeleteQuietHoursResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []SessionInfo
+		var dest SuccessMessage
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -9048,87 +18273,73 @@ func ParseListSessionsResp(rsp *http.Response) (*ListSessionsResp, error) {
 	return response, nil
 }
 
-// ParseRevokeSessionResp parses an HTTP response from a RevokeSessionWithResponse call
-func ParseRevokeSessionResp(rsp *http.Response) (*RevokeSessionResp, error) {
+// ParseGetQuietHoursResp parses an HTTP response from a GetQuietHoursWithResponse call
+func ParseGetQuietHoursResp(rsp *http.Response) (*GetQuietHoursResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &RevokeSessionResp{
+	response := &GetQuietHoursResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ErrorResponse
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest QuietHours
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON500 = &dest
-
-	}
-
-	return response, nil
-}
-
-// ParseListDashboardsResp parses an HTTP response from a ListDashboardsWithResponse call
-func ParseListDashboardsResp(rsp *http.Response) (*ListDashboardsResp, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
-	response := &ListDashboardsResp{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+		response.JSON404 = &dest
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []Dashboard
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON500 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseCreateDashboardResp parses an HTTP response from a CreateDashboardWithResponse call
-func ParseCreateDashboardResp(rsp *http.Response) (*CreateDashboardResp, error) {
+// ParseSetQuietHoursResp parses an HTTP response from a SetQuietHoursWithResponse call
+func ParseSetQuietHoursResp(rsp *http.Response) (*SetQuietHoursResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateDashboardResp{
+	response := &SetQuietHoursResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest struct {
-			Id *int `json:"id,omitempty"`
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest QuietHours
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
 		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON201 = &dest
+		response.JSON400 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest ErrorResponse
@@ -9142,22 +18353,24 @@ func ParseCreateDashboardResp(rsp *http.Response) (*CreateDashboardResp, error)
 	return response, nil
 }
 
-// ParseDeleteDashboardResp parses an HTTP response from a DeleteDashboardWithResponse call
-func ParseDeleteDashboardResp(rsp *http.Response) (*DeleteDashboardResp, error) {
+// ParseGetUnreadCountResp parses an HTTP response from a GetUnreadCountWithResponse call
+func ParseGetUnreadCountResp(rsp *http.Response) (*GetUnreadCountResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteDashboardResp{
+	response := &GetUnreadCountResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest SuccessMessage
+		var dest struct {
+			Count *int `json:"count,omitempty"`
+		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -9175,52 +18388,66 @@ func ParseDeleteDashboardResp(rsp *http.Response) (*DeleteDashboardResp, error)
 	return response, nil
 }
 
-// ParseGetDashboardResp parses an HTTP response from a GetDashboardWithResponse call
-func ParseGetDashboardResp(rsp *http.Response) (*GetDashboardResp, error) {
+// ParseListWebhookTargetsResp parses an HTTP response from a ListWebhookTargetsWithResponse call
+func ParseListWebhookTargetsResp(rsp *http.Response) (*ListWebhookTargetsResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetDashboardResp{
+	response := &ListWebhookTargetsResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Dashboard
+		var dest []WebhookTarget
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseUpdateDashboardResp parses an HTTP response from a UpdateDashboardWithResponse call
-func ParseUpdateDashboardResp(rsp *http.Response) (*UpdateDashboardResp, error) {
+// ParseCreateWebhookTargetResp parses an HTTP response from a CreateWebhookTargetWithResponse call
+func ParseCreateWebhookTargetResp(rsp *http.Response) (*CreateWebhookTargetResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateDashboardResp{
+	response := &CreateWebhookTargetResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest SuccessMessage
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest WebhookTarget
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest ErrorResponse
@@ -9234,15 +18461,15 @@ func ParseUpdateDashboardResp(rsp *http.Response) (*UpdateDashboardResp, error)
 	return response, nil
 }
 
-// ParseSetDefaultDashboardResp parses an HTTP response from a SetDefaultDashboardWithResponse call
-func ParseSetDefaultDashboardResp(rsp *http.Response) (*SetDefaultDashboardResp, error) {
+// ParseDeleteWebhookTargetResp parses an HTTP response from a DeleteWebhookTargetWithResponse call
+func ParseDeleteWebhookTargetResp(rsp *http.Response) (*DeleteWebhookTargetResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &SetDefaultDashboardResp{
+	response := &DeleteWebhookTargetResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -9255,6 +18482,13 @@ func ParseSetDefaultDashboardResp(rsp *http.Response) (*SetDefaultDashboardResp,
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -9267,15 +18501,15 @@ func ParseSetDefaultDashboardResp(rsp *http.Response) (*SetDefaultDashboardResp,
 	return response, nil
 }
 
-// ParseShareDashboardResp parses an HTTP response from a ShareDashboardWithResponse call
-func ParseShareDashboardResp(rsp *http.Response) (*ShareDashboardResp, error) {
+// ParseUpdateWebhookTargetResp parses an HTTP response from a UpdateWebhookTargetWithResponse call
+func ParseUpdateWebhookTargetResp(rsp *http.Response) (*UpdateWebhookTargetResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ShareDashboardResp{
+	response := &UpdateWebhookTargetResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -9288,6 +18522,20 @@ func ParseShareDashboardResp(rsp *http.Response) (*ShareDashboardResp, error) {
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -9300,191 +18548,231 @@ func ParseShareDashboardResp(rsp *http.Response) (*ShareDashboardResp, error) {
 	return response, nil
 }
 
-// ParseListDriversResp parses an HTTP response from a ListDriversWithResponse call
-func ParseListDriversResp(rsp *http.Response) (*ListDriversResp, error) {
+// ParseGetWebhookDeliveriesResp parses an HTTP response from a GetWebhookDeliveriesWithResponse call
+func ParseGetWebhookDeliveriesResp(rsp *http.Response) (*GetWebhookDeliveriesResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListDriversResp{
+	response := &GetWebhookDeliveriesResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []DriverInfo
+		var dest []WebhookDelivery
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseGetHealthResp parses an HTTP response from a GetHealthWithResponse call
-func ParseGetHealthResp(rsp *http.Response) (*GetHealthResp, error) {
+// ParseTestWebhookTargetResp parses an HTTP response from a TestWebhookTargetWithResponse call
+func ParseTestWebhookTargetResp(rsp *http.Response) (*TestWebhookTargetResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetHealthResp{
+	response := &TestWebhookTargetResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest struct {
-			Status *string `json:"status,omitempty"`
-		}
+		var dest WebhookDelivery
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseGetAllMeasurementTypesResp parses an HTTP response from a GetAllMeasurementTypesWithResponse call
-func ParseGetAllMeasurementTypesResp(rsp *http.Response) (*GetAllMeasurementTypesResp, error) {
+// ParseNotificationsWebSocketResp parses an HTTP response from a NotificationsWebSocketWithResponse call
+func ParseNotificationsWebSocketResp(rsp *http.Response) (*NotificationsWebSocketResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetAllMeasurementTypesResp{
+	response := &NotificationsWebSocketResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []MeasurementType
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
-	}
-
 	return response, nil
 }
 
-// ParseListMqttBrokersResp parses an HTTP response from a ListMqttBrokersWithResponse call
-func ParseListMqttBrokersResp(rsp *http.Response) (*ListMqttBrokersResp, error) {
+// ParseDismissNotificationResp parses an HTTP response from a DismissNotificationWithResponse call
+func ParseDismissNotificationResp(rsp *http.Response) (*DismissNotificationResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListMqttBrokersResp{
+	response := &DismissNotificationResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []MQTTBroker
+		var dest SuccessMessage
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseCreateMqttBrokerResp parses an HTTP response from a CreateMqttBrokerWithResponse call
-func ParseCreateMqttBrokerResp(rsp *http.Response) (*CreateMqttBrokerResp, error) {
+// ParseMarkAsReadResp parses an HTTP response from a MarkAsReadWithResponse call
+func ParseMarkAsReadResp(rsp *http.Response) (*MarkAsReadResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateMqttBrokerResp{
+	response := &MarkAsReadResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest struct {
-			Id *int `json:"id,omitempty"`
-		}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SuccessMessage
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON201 = &dest
-
-	}
+		response.JSON200 = &dest
 
-	return response, nil
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
 
-// ParseDeleteMqttBrokerResp parses an HTTP response from a DeleteMqttBrokerWithResponse call
-func ParseDeleteMqttBrokerResp(rsp *http.Response) (*DeleteMqttBrokerResp, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
 
-	response := &DeleteMqttBrokerResp{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
 	}
 
 	return response, nil
 }
 
-// ParseGetMqttBrokerResp parses an HTTP response from a GetMqttBrokerWithResponse call
-func ParseGetMqttBrokerResp(rsp *http.Response) (*GetMqttBrokerResp, error) {
+// ParseGetOAuthAuthorizeUrlResp parses an HTTP response from a GetOAuthAuthorizeUrlWithResponse call
+func ParseGetOAuthAuthorizeUrlResp(rsp *http.Response) (*GetOAuthAuthorizeUrlResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetMqttBrokerResp{
+	response := &GetOAuthAuthorizeUrlResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest MQTTBroker
+		var dest OAuthAuthorizeResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseUpdateMqttBrokerResp parses an HTTP response from a UpdateMqttBrokerWithResponse call
-func ParseUpdateMqttBrokerResp(rsp *http.Response) (*UpdateMqttBrokerResp, error) {
+// ParseReloadOAuthResp parses an HTTP response from a ReloadOAuthWithResponse call
+func ParseReloadOAuthResp(rsp *http.Response) (*ReloadOAuthResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateMqttBrokerResp{
+	response := &ReloadOAuthResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -9497,149 +18785,220 @@ func ParseUpdateMqttBrokerResp(rsp *http.Response) (*UpdateMqttBrokerResp, error
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseGetMqttStatsResp parses an HTTP response from a GetMqttStatsWithResponse call
-func ParseGetMqttStatsResp(rsp *http.Response) (*GetMqttStatsResp, error) {
+// ParseGetOAuthStatusResp parses an HTTP response from a GetOAuthStatusWithResponse call
+func ParseGetOAuthStatusResp(rsp *http.Response) (*GetOAuthStatusResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetMqttStatsResp{
+	response := &GetOAuthStatusResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []MQTTBrokerStats
+		var dest OAuthStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON503 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseListMqttSubscriptionsResp parses an HTTP response from a ListMqttSubscriptionsWithResponse call
-func ParseListMqttSubscriptionsResp(rsp *http.Response) (*ListMqttSubscriptionsResp, error) {
+// ParseSubmitOAuthCodeResp parses an HTTP response from a SubmitOAuthCodeWithResponse call
+func ParseSubmitOAuthCodeResp(rsp *http.Response) (*SubmitOAuthCodeResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListMqttSubscriptionsResp{
+	response := &SubmitOAuthCodeResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []MQTTSubscription
+		var dest SuccessMessage
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseCreateMqttSubscriptionResp parses an HTTP response from a CreateMqttSubscriptionWithResponse call
-func ParseCreateMqttSubscriptionResp(rsp *http.Response) (*CreateMqttSubscriptionResp, error) {
+// ParseGetOpenApiSpecResp parses an HTTP response from a GetOpenApiSpecWithResponse call
+func ParseGetOpenApiSpecResp(rsp *http.Response) (*GetOpenApiSpecResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateMqttSubscriptionResp{
+	response := &GetOpenApiSpecResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest struct {
-			Id *int `json:"id,omitempty"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "yaml") && rsp.StatusCode == 200:
+		var dest string
+		if err := yaml.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON201 = &dest
+		response.YAML200 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseDeleteMqttSubscriptionResp parses an HTTP response from a DeleteMqttSubscriptionWithResponse call
-func ParseDeleteMqttSubscriptionResp(rsp *http.Response) (*DeleteMqttSubscriptionResp, error) {
+// ParseGetPropertiesResp parses an HTTP response from a GetPropertiesWithResponse call
+func ParseGetPropertiesResp(rsp *http.Response) (*GetPropertiesResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteMqttSubscriptionResp{
+	response := &GetPropertiesResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PropertiesMap
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
 	return response, nil
 }
 
-// ParseGetMqttSubscriptionResp parses an HTTP response from a GetMqttSubscriptionWithResponse call
-func ParseGetMqttSubscriptionResp(rsp *http.Response) (*GetMqttSubscriptionResp, error) {
+// ParseUpdatePropertiesResp parses an HTTP response from a UpdatePropertiesWithResponse call
+func ParseUpdatePropertiesResp(rsp *http.Response) (*UpdatePropertiesResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetMqttSubscriptionResp{
+	response := &UpdatePropertiesResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest MQTTSubscription
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest OperationAccepted
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON202 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseUpdateMqttSubscriptionResp parses an HTTP response from a UpdateMqttSubscriptionWithResponse call
-func ParseUpdateMqttSubscriptionResp(rsp *http.Response) (*UpdateMqttSubscriptionResp, error) {
+// ParsePropertiesWebSocketResp parses an HTTP response from a PropertiesWebSocketWithResponse call
+func ParsePropertiesWebSocketResp(rsp *http.Response) (*PropertiesWebSocketResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateMqttSubscriptionResp{
+	response := &PropertiesWebSocketResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest SuccessMessage
+		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -9650,27 +19009,34 @@ func ParseUpdateMqttSubscriptionResp(rsp *http.Response) (*UpdateMqttSubscriptio
 	return response, nil
 }
 
-// ParseListNotificationsResp parses an HTTP response from a ListNotificationsWithResponse call
-func ParseListNotificationsResp(rsp *http.Response) (*ListNotificationsResp, error) {
+// ParseGetReadingsBetweenDatesResp parses an HTTP response from a GetReadingsBetweenDatesWithResponse call
+func ParseGetReadingsBetweenDatesResp(rsp *http.Response) (*GetReadingsBetweenDatesResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListNotificationsResp{
+	response := &GetReadingsBetweenDatesResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []UserNotification
+		var dest AggregatedReadingsResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -9683,55 +19049,48 @@ func ParseListNotificationsResp(rsp *http.Response) (*ListNotificationsResp, err
 	return response, nil
 }
 
-// ParseBulkDismissResp parses an HTTP response from a BulkDismissWithResponse call
-func ParseBulkDismissResp(rsp *http.Response) (*BulkDismissResp, error) {
+// ParseSubscribeCurrentReadingsResp parses an HTTP response from a SubscribeCurrentReadingsWithResponse call
+func ParseSubscribeCurrentReadingsResp(rsp *http.Response) (*SubscribeCurrentReadingsResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &BulkDismissResp{
+	response := &SubscribeCurrentReadingsResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest SuccessMessage
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON500 = &dest
+		response.JSON200 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseBulkMarkAsReadResp parses an HTTP response from a BulkMarkAsReadWithResponse call
-func ParseBulkMarkAsReadResp(rsp *http.Response) (*BulkMarkAsReadResp, error) {
+// ParseListRolesResp parses an HTTP response from a ListRolesWithResponse call
+func ParseListRolesResp(rsp *http.Response) (*ListRolesResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &BulkMarkAsReadResp{
+	response := &ListRolesResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest SuccessMessage
+		var dest []RoleInfo
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -9749,22 +19108,22 @@ func ParseBulkMarkAsReadResp(rsp *http.Response) (*BulkMarkAsReadResp, error) {
 	return response, nil
 }
 
-// ParseGetChannelPreferencesResp parses an HTTP response from a GetChannelPreferencesWithResponse call
-func ParseGetChannelPreferencesResp(rsp *http.Response) (*GetChannelPreferencesResp, error) {
+// ParseListPermissionsResp parses an HTTP response from a ListPermissionsWithResponse call
+func ParseListPermissionsResp(rsp *http.Response) (*ListPermissionsResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetChannelPreferencesResp{
+	response := &ListPermissionsResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []ChannelPreference
+		var dest []PermissionInfo
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -9782,22 +19141,22 @@ func ParseGetChannelPreferencesResp(rsp *http.Response) (*GetChannelPreferencesR
 	return response, nil
 }
 
-// ParseSetChannelPreferenceResp parses an HTTP response from a SetChannelPreferenceWithResponse call
-func ParseSetChannelPreferenceResp(rsp *http.Response) (*SetChannelPreferenceResp, error) {
+// ParseGetRolePermissionsResp parses an HTTP response from a GetRolePermissionsWithResponse call
+func ParseGetRolePermissionsResp(rsp *http.Response) (*GetRolePermissionsResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &SetChannelPreferenceResp{
+	response := &GetRolePermissionsResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest SuccessMessage
+		var dest []PermissionInfo
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -9822,28 +19181,26 @@ func ParseSetChannelPreferenceResp(rsp *http.Response) (*SetChannelPreferenceRes
 	return response, nil
 }
 
-// ParseGetUnreadCountResp parses an HTTP response from a GetUnreadCountWithResponse call
-func ParseGetUnreadCountResp(rsp *http.Response) (*GetUnreadCountResp, error) {
+// ParseAssignPermissionResp parses an HTTP response from a AssignPermissionWithResponse call
+func ParseAssignPermissionResp(rsp *http.Response) (*AssignPermissionResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetUnreadCountResp{
+	response := &AssignPermissionResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest struct {
-			Count *int `json:"count,omitempty"`
-		}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON400 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest ErrorResponse
@@ -9857,43 +19214,20 @@ func ParseGetUnreadCountResp(rsp *http.Response) (*GetUnreadCountResp, error) {
 	return response, nil
 }
 
-// ParseNotificationsWebSocketResp parses an HTTP response from a NotificationsWebSocketWithResponse call
-func ParseNotificationsWebSocketResp(rsp *http.Response) (*NotificationsWebSocketResp, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
-	response := &NotificationsWebSocketResp{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
-
-	return response, nil
-}
-
-// ParseDismissNotificationResp parses an HTTP response from a DismissNotificationWithResponse call
-func ParseDismissNotificationResp(rsp *http.Response) (*DismissNotificationResp, error) {
+// ParseRemovePermissionResp parses an HTTP response from a RemovePermissionWithResponse call
+func ParseRemovePermissionResp(rsp *http.Response) (*RemovePermissionResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DismissNotificationResp{
+	response := &RemovePermissionResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest SuccessMessage
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -9913,34 +19247,27 @@ func ParseDismissNotificationResp(rsp *http.Response) (*DismissNotificationResp,
 	return response, nil
 }
 
-// ParseMarkAsReadResp parses an HTTP response from a MarkAsReadWithResponse call
-func ParseMarkAsReadResp(rsp *http.Response) (*MarkAsReadResp, error) {
+// ParseGetAllScenesResp parses an HTTP response from a GetAllScenesWithResponse call
+func ParseGetAllScenesResp(rsp *http.Response) (*GetAllScenesResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &MarkAsReadResp{
+	response := &GetAllScenesResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest SuccessMessage
+		var dest []Scene
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -9953,55 +19280,55 @@ func ParseMarkAsReadResp(rsp *http.Response) (*MarkAsReadResp, error) {
 	return response, nil
 }
 
-// ParseGetOAuthAuthorizeUrlResp parses an HTTP response from a GetOAuthAuthorizeUrlWithResponse call
-func ParseGetOAuthAuthorizeUrlResp(rsp *http.Response) (*GetOAuthAuthorizeUrlResp, error) {
+// ParseCreateSceneResp parses an HTTP response from a CreateSceneWithResponse call
+func ParseCreateSceneResp(rsp *http.Response) (*CreateSceneResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetOAuthAuthorizeUrlResp{
+	response := &CreateSceneResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest OAuthAuthorizeResponse
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Scene
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON201 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON500 = &dest
+		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON503 = &dest
+		response.JSON500 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseReloadOAuthResp parses an HTTP response from a ReloadOAuthWithResponse call
-func ParseReloadOAuthResp(rsp *http.Response) (*ReloadOAuthResp, error) {
+// ParseDeleteSceneResp parses an HTTP response from a DeleteSceneWithResponse call
+func ParseDeleteSceneResp(rsp *http.Response) (*DeleteSceneResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ReloadOAuthResp{
+	response := &DeleteSceneResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -10014,67 +19341,74 @@ func ParseReloadOAuthResp(rsp *http.Response) (*ReloadOAuthResp, error) {
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON500 = &dest
+		response.JSON404 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON503 = &dest
+		response.JSON500 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseGetOAuthStatusResp parses an HTTP response from a GetOAuthStatusWithResponse call
-func ParseGetOAuthStatusResp(rsp *http.Response) (*GetOAuthStatusResp, error) {
+// ParseGetSceneByIdResp parses an HTTP response from a GetSceneByIdWithResponse call
+func ParseGetSceneByIdResp(rsp *http.Response) (*GetSceneByIdResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetOAuthStatusResp{
+	response := &GetSceneByIdResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest OAuthStatus
+		var dest Scene
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON503 = &dest
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseSubmitOAuthCodeResp parses an HTTP response from a SubmitOAuthCodeWithResponse call
-func ParseSubmitOAuthCodeResp(rsp *http.Response) (*SubmitOAuthCodeResp, error) {
+// ParseUpdateSceneResp parses an HTTP response from a UpdateSceneWithResponse call
+func ParseUpdateSceneResp(rsp *http.Response) (*UpdateSceneResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &SubmitOAuthCodeResp{
+	response := &UpdateSceneResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -10094,67 +19428,81 @@ func ParseSubmitOAuthCodeResp(rsp *http.Response) (*SubmitOAuthCodeResp, error)
 		}
 		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON500 = &dest
+		response.JSON404 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON503 = &dest
+		response.JSON500 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseGetOpenApiSpecResp parses an HTTP response from a GetOpenApiSpecWithResponse call
-func ParseGetOpenApiSpecResp(rsp *http.Response) (*GetOpenApiSpecResp, error) {
+// ParseActivateSceneResp parses an HTTP response from a ActivateSceneWithResponse call
+func ParseActivateSceneResp(rsp *http.Response) (*ActivateSceneResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetOpenApiSpecResp{
+	response := &ActivateSceneResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "yaml") && rsp.StatusCode == 200:
-		var dest string
-		if err := yaml.Unmarshal(bodyBytes, &dest); err != nil {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SceneActivation
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.YAML200 = &dest
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseGetPropertiesResp parses an HTTP response from a GetPropertiesWithResponse call
-func ParseGetPropertiesResp(rsp *http.Response) (*GetPropertiesResp, error) {
+// ParseGetAllSchedulesResp parses an HTTP response from a GetAllSchedulesWithResponse call
+func ParseGetAllSchedulesResp(rsp *http.Response) (*GetAllSchedulesResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetPropertiesResp{
+	response := &GetAllSchedulesResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest PropertiesMap
+		var dest []CommandSchedule
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -10172,26 +19520,26 @@ func ParseGetPropertiesResp(rsp *http.Response) (*GetPropertiesResp, error) {
 	return response, nil
 }
 
-// ParseUpdatePropertiesResp parses an HTTP response from a UpdatePropertiesWithResponse call
-func ParseUpdatePropertiesResp(rsp *http.Response) (*UpdatePropertiesResp, error) {
+// ParseCreateScheduleResp parses an HTTP response from a CreateScheduleWithResponse call
+func ParseCreateScheduleResp(rsp *http.Response) (*CreateScheduleResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdatePropertiesResp{
+	response := &CreateScheduleResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
-		var dest OperationAccepted
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest CommandSchedule
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON202 = &dest
+		response.JSON201 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest ErrorResponse
@@ -10212,48 +19560,55 @@ func ParseUpdatePropertiesResp(rsp *http.Response) (*UpdatePropertiesResp, error
 	return response, nil
 }
 
-// ParsePropertiesWebSocketResp parses an HTTP response from a PropertiesWebSocketWithResponse call
-func ParsePropertiesWebSocketResp(rsp *http.Response) (*PropertiesWebSocketResp, error) {
+// ParseGetHolidayModeResp parses an HTTP response from a GetHolidayModeWithResponse call
+func ParseGetHolidayModeResp(rsp *http.Response) (*GetHolidayModeResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PropertiesWebSocketResp{
+	response := &GetHolidayModeResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ErrorResponse
+		var dest HolidayMode
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseGetReadingsBetweenDatesResp parses an HTTP response from a GetReadingsBetweenDatesWithResponse call
-func ParseGetReadingsBetweenDatesResp(rsp *http.Response) (*GetReadingsBetweenDatesResp, error) {
+// ParseSetHolidayModeResp parses an HTTP response from a SetHolidayModeWithResponse call
+func ParseSetHolidayModeResp(rsp *http.Response) (*SetHolidayModeResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetReadingsBetweenDatesResp{
+	response := &SetHolidayModeResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest AggregatedReadingsResponse
+		var dest HolidayMode
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -10278,52 +19633,33 @@ func ParseGetReadingsBetweenDatesResp(rsp *http.Response) (*GetReadingsBetweenDa
 	return response, nil
 }
 
-// ParseSubscribeCurrentReadingsResp parses an HTTP response from a SubscribeCurrentReadingsWithResponse call
-func ParseSubscribeCurrentReadingsResp(rsp *http.Response) (*SubscribeCurrentReadingsResp, error) {
+// ParseDeleteScheduleResp parses an HTTP response from a DeleteScheduleWithResponse call
+func ParseDeleteScheduleResp(rsp *http.Response) (*DeleteScheduleResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &SubscribeCurrentReadingsResp{
+	response := &DeleteScheduleResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ErrorResponse
+		var dest SuccessMessage
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	}
-
-	return response, nil
-}
-
-// ParseListRolesResp parses an HTTP response from a ListRolesWithResponse call
-func ParseListRolesResp(rsp *http.Response) (*ListRolesResp, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
-	response := &ListRolesResp{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
-
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []RoleInfo
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON404 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest ErrorResponse
@@ -10337,27 +19673,34 @@ func ParseListRolesResp(rsp *http.Response) (*ListRolesResp, error) {
 	return response, nil
 }
 
-// ParseListPermissionsResp parses an HTTP response from a ListPermissionsWithResponse call
-func ParseListPermissionsResp(rsp *http.Response) (*ListPermissionsResp, error) {
+// ParseGetScheduleByIdResp parses an HTTP response from a GetScheduleByIdWithResponse call
+func ParseGetScheduleByIdResp(rsp *http.Response) (*GetScheduleByIdResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListPermissionsResp{
+	response := &GetScheduleByIdResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []PermissionInfo
+		var dest CommandSchedule
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -10370,22 +19713,22 @@ func ParseListPermissionsResp(rsp *http.Response) (*ListPermissionsResp, error)
 	return response, nil
 }
 
-// ParseGetRolePermissionsResp parses an HTTP response from a GetRolePermissionsWithResponse call
-func ParseGetRolePermissionsResp(rsp *http.Response) (*GetRolePermissionsResp, error) {
+// ParseUpdateScheduleResp parses an HTTP response from a UpdateScheduleWithResponse call
+func ParseUpdateScheduleResp(rsp *http.Response) (*UpdateScheduleResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetRolePermissionsResp{
+	response := &UpdateScheduleResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []PermissionInfo
+		var dest SuccessMessage
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -10398,6 +19741,13 @@ func ParseGetRolePermissionsResp(rsp *http.Response) (*GetRolePermissionsResp, e
 		}
 		response.JSON400 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -10410,53 +19760,53 @@ func ParseGetRolePermissionsResp(rsp *http.Response) (*GetRolePermissionsResp, e
 	return response, nil
 }
 
-// ParseAssignPermissionResp parses an HTTP response from a AssignPermissionWithResponse call
-func ParseAssignPermissionResp(rsp *http.Response) (*AssignPermissionResp, error) {
+// ParseGetAllSensorsResp parses an HTTP response from a GetAllSensorsWithResponse call
+func ParseGetAllSensorsResp(rsp *http.Response) (*GetAllSensorsResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AssignPermissionResp{
+	response := &GetAllSensorsResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest ErrorResponse
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Sensor
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON500 = &dest
+		response.JSON200 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseRemovePermissionResp parses an HTTP response from a RemovePermissionWithResponse call
-func ParseRemovePermissionResp(rsp *http.Response) (*RemovePermissionResp, error) {
+// ParseAddSensorResp parses an HTTP response from a AddSensorWithResponse call
+func ParseAddSensorResp(rsp *http.Response) (*AddSensorResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &RemovePermissionResp{
+	response := &AddSensorResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Sensor
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -10464,6 +19814,13 @@ func ParseRemovePermissionResp(rsp *http.Response) (*RemovePermissionResp, error
 		}
 		response.JSON400 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -10476,22 +19833,22 @@ func ParseRemovePermissionResp(rsp *http.Response) (*RemovePermissionResp, error
 	return response, nil
 }
 
-// ParseGetAllSensorsResp parses an HTTP response from a GetAllSensorsWithResponse call
-func ParseGetAllSensorsResp(rsp *http.Response) (*GetAllSensorsResp, error) {
+// ParseApproveSensorResp parses an HTTP response from a ApproveSensorWithResponse call
+func ParseApproveSensorResp(rsp *http.Response) (*ApproveSensorResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetAllSensorsResp{
+	response := &ApproveSensorResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []Sensor
+		var dest SuccessMessage
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -10502,40 +19859,73 @@ func ParseGetAllSensorsResp(rsp *http.Response) (*GetAllSensorsResp, error) {
 	return response, nil
 }
 
-// ParseAddSensorResp parses an HTTP response from a AddSensorWithResponse call
-func ParseAddSensorResp(rsp *http.Response) (*AddSensorResp, error) {
+// ParseGetSensorCapabilitiesResp parses an HTTP response from a GetSensorCapabilitiesWithResponse call
+func ParseGetSensorCapabilitiesResp(rsp *http.Response) (*GetSensorCapabilitiesResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AddSensorResp{
+	response := &GetSensorCapabilitiesResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest Sensor
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Capability
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON201 = &dest
+		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.JSON404 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON409 = &dest
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetSensorCommandHistoryResp parses an HTTP response from a GetSensorCommandHistoryWithResponse call
+func ParseGetSensorCommandHistoryResp(rsp *http.Response) (*GetSensorCommandHistoryResp, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetSensorCommandHistoryResp{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []CommandHistoryEntry
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest ErrorResponse
@@ -10549,48 +19939,62 @@ func ParseAddSensorResp(rsp *http.Response) (*AddSensorResp, error) {
 	return response, nil
 }
 
-// ParseApproveSensorResp parses an HTTP response from a ApproveSensorWithResponse call
-func ParseApproveSensorResp(rsp *http.Response) (*ApproveSensorResp, error) {
+// ParseGetSensorDesiredStatesResp parses an HTTP response from a GetSensorDesiredStatesWithResponse call
+func ParseGetSensorDesiredStatesResp(rsp *http.Response) (*GetSensorDesiredStatesResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ApproveSensorResp{
+	response := &GetSensorDesiredStatesResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest SuccessMessage
+		var dest []DesiredState
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseGetSensorCapabilitiesResp parses an HTTP response from a GetSensorCapabilitiesWithResponse call
-func ParseGetSensorCapabilitiesResp(rsp *http.Response) (*GetSensorCapabilitiesResp, error) {
+// ParseDeleteSensorDesiredStateResp parses an HTTP response from a DeleteSensorDesiredStateWithResponse call
+func ParseDeleteSensorDesiredStateResp(rsp *http.Response) (*DeleteSensorDesiredStateResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetSensorCapabilitiesResp{
+	response := &DeleteSensorDesiredStateResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []Capability
+		var dest SuccessMessage
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -10615,27 +20019,41 @@ func ParseGetSensorCapabilitiesResp(rsp *http.Response) (*GetSensorCapabilitiesR
 	return response, nil
 }
 
-// ParseGetSensorCommandHistoryResp parses an HTTP response from a GetSensorCommandHistoryWithResponse call
-func ParseGetSensorCommandHistoryResp(rsp *http.Response) (*GetSensorCommandHistoryResp, error) {
+// ParseSetSensorDesiredStateResp parses an HTTP response from a SetSensorDesiredStateWithResponse call
+func ParseSetSensorDesiredStateResp(rsp *http.Response) (*SetSensorDesiredStateResp, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetSensorCommandHistoryResp{
+	response := &SetSensorDesiredStateResp{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []CommandHistoryEntry
+		var dest DesiredState
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
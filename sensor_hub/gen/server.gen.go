@@ -19,12 +19,81 @@ type ServerInterface interface {
 	// Create an alert rule
 	// (POST /alerts)
 	CreateAlertRule(c *gin.Context)
+	// List compound alert rules
+	// (GET /alerts/compound)
+	GetAllCompoundAlertRules(c *gin.Context)
+	// Create a compound alert rule
+	// (POST /alerts/compound)
+	CreateCompoundAlertRule(c *gin.Context)
+	// Delete compound alert rule
+	// (DELETE /alerts/compound/{id})
+	DeleteCompoundAlertRule(c *gin.Context, id int)
+	// Get compound alert rule by ID
+	// (GET /alerts/compound/{id})
+	GetCompoundAlertRuleById(c *gin.Context, id int)
+	// Update compound alert rule
+	// (PUT /alerts/compound/{id})
+	UpdateCompoundAlertRule(c *gin.Context, id int)
+	// Acknowledge a firing compound alert rule
+	// (POST /alerts/compound/{id}/acknowledge)
+	AcknowledgeCompoundAlertRule(c *gin.Context, id int)
+	// Delete a compound alert rule's escalation policy
+	// (DELETE /alerts/compound/{id}/escalation)
+	DeleteCompoundAlertEscalationPolicy(c *gin.Context, id int)
+	// Get a compound alert rule's escalation policy
+	// (GET /alerts/compound/{id}/escalation)
+	GetCompoundAlertEscalationPolicy(c *gin.Context, id int)
+	// Set a compound alert rule's escalation policy
+	// (PUT /alerts/compound/{id}/escalation)
+	SetCompoundAlertEscalationPolicy(c *gin.Context, id int)
+	// Delete a compound alert rule's recipients
+	// (DELETE /alerts/compound/{id}/recipients)
+	DeleteCompoundAlertRecipients(c *gin.Context, id int)
+	// Get a compound alert rule's recipients
+	// (GET /alerts/compound/{id}/recipients)
+	GetCompoundAlertRecipients(c *gin.Context, id int)
+	// Set a compound alert rule's recipients
+	// (PUT /alerts/compound/{id}/recipients)
+	SetCompoundAlertRecipients(c *gin.Context, id int)
+	// Cancel a compound alert rule snooze
+	// (DELETE /alerts/compound/{id}/snooze)
+	UnsnoozeCompoundAlertRule(c *gin.Context, id int)
+	// Snooze a compound alert rule
+	// (POST /alerts/compound/{id}/snooze)
+	SnoozeCompoundAlertRule(c *gin.Context, id int)
+	// List contact groups
+	// (GET /alerts/contact-groups)
+	GetAllContactGroups(c *gin.Context)
+	// Create a contact group
+	// (POST /alerts/contact-groups)
+	CreateContactGroup(c *gin.Context)
+	// Delete a contact group
+	// (DELETE /alerts/contact-groups/{id})
+	DeleteContactGroup(c *gin.Context, id int)
+	// Update a contact group
+	// (PUT /alerts/contact-groups/{id})
+	UpdateContactGroup(c *gin.Context, id int)
+	// Acknowledge an alert history entry
+	// (POST /alerts/history/{id}/acknowledge)
+	AcknowledgeAlertHistoryEntry(c *gin.Context, id int)
 	// Get alert rules for a sensor
 	// (GET /alerts/sensor/{sensorId})
 	GetAlertRulesBySensorId(c *gin.Context, sensorId int)
 	// Get alert history
 	// (GET /alerts/sensor/{sensorId}/history)
 	GetAlertHistory(c *gin.Context, sensorId int, params GetAlertHistoryParams)
+	// List alert silence windows
+	// (GET /alerts/silences)
+	GetAllSilenceWindows(c *gin.Context)
+	// Create an alert silence window
+	// (POST /alerts/silences)
+	CreateSilenceWindow(c *gin.Context)
+	// Delete an alert silence window
+	// (DELETE /alerts/silences/{id})
+	DeleteSilenceWindow(c *gin.Context, id int)
+	// Update an alert silence window
+	// (PUT /alerts/silences/{id})
+	UpdateSilenceWindow(c *gin.Context, id int)
 	// Delete alert rule
 	// (DELETE /alerts/{id})
 	DeleteAlertRule(c *gin.Context, id int)
@@ -34,6 +103,33 @@ type ServerInterface interface {
 	// Update alert rule
 	// (PUT /alerts/{id})
 	UpdateAlertRule(c *gin.Context, id int)
+	// Acknowledge a firing alert rule
+	// (POST /alerts/{id}/acknowledge)
+	AcknowledgeAlertRule(c *gin.Context, id int)
+	// Delete an alert rule's escalation policy
+	// (DELETE /alerts/{id}/escalation)
+	DeleteAlertEscalationPolicy(c *gin.Context, id int)
+	// Get an alert rule's escalation policy
+	// (GET /alerts/{id}/escalation)
+	GetAlertEscalationPolicy(c *gin.Context, id int)
+	// Set an alert rule's escalation policy
+	// (PUT /alerts/{id}/escalation)
+	SetAlertEscalationPolicy(c *gin.Context, id int)
+	// Delete an alert rule's recipients
+	// (DELETE /alerts/{id}/recipients)
+	DeleteAlertRecipients(c *gin.Context, id int)
+	// Get an alert rule's recipients
+	// (GET /alerts/{id}/recipients)
+	GetAlertRecipients(c *gin.Context, id int)
+	// Set an alert rule's recipients
+	// (PUT /alerts/{id}/recipients)
+	SetAlertRecipients(c *gin.Context, id int)
+	// Cancel an alert rule snooze
+	// (DELETE /alerts/{id}/snooze)
+	UnsnoozeAlertRule(c *gin.Context, id int)
+	// Snooze an alert rule
+	// (POST /alerts/{id}/snooze)
+	SnoozeAlertRule(c *gin.Context, id int)
 	// List API keys
 	// (GET /api-keys)
 	ListApiKeys(c *gin.Context)
@@ -64,6 +160,21 @@ type ServerInterface interface {
 	// Revoke a session
 	// (DELETE /auth/sessions/{id})
 	RevokeSession(c *gin.Context, id int64)
+	// List automation rules
+	// (GET /automations)
+	GetAllAutomationRules(c *gin.Context)
+	// Create an automation rule
+	// (POST /automations)
+	CreateAutomationRule(c *gin.Context)
+	// Delete an automation rule
+	// (DELETE /automations/{id})
+	DeleteAutomationRule(c *gin.Context, id int)
+	// Get automation rule by ID
+	// (GET /automations/{id})
+	GetAutomationRuleById(c *gin.Context, id int)
+	// Update an automation rule
+	// (PUT /automations/{id})
+	UpdateAutomationRule(c *gin.Context, id int)
 	// List all dashboards
 	// (GET /dashboards)
 	ListDashboards(c *gin.Context)
@@ -94,6 +205,12 @@ type ServerInterface interface {
 	// Get all measurement types
 	// (GET /measurement-types)
 	GetAllMeasurementTypes(c *gin.Context, params GetAllMeasurementTypesParams)
+	// Stop downsampling a measurement type's readings on retention
+	// (DELETE /measurement-types/{name}/downsampling)
+	DeleteMeasurementTypeDownsampling(c *gin.Context, name string)
+	// Downsample a measurement type's readings on retention
+	// (PUT /measurement-types/{name}/downsampling)
+	SetMeasurementTypeDownsampling(c *gin.Context, name string)
 	// List all MQTT brokers
 	// (GET /mqtt/brokers)
 	ListMqttBrokers(c *gin.Context)
@@ -142,9 +259,48 @@ type ServerInterface interface {
 	// Set notification preference
 	// (POST /notifications/preferences)
 	SetChannelPreference(c *gin.Context)
+	// Delete the current user's push subscription
+	// (DELETE /notifications/push)
+	DeletePushSubscription(c *gin.Context)
+	// Get the current user's push subscription
+	// (GET /notifications/push)
+	GetPushSubscription(c *gin.Context)
+	// Set the current user's push subscription
+	// (PUT /notifications/push)
+	SetPushSubscription(c *gin.Context)
+	// Send a test push notification
+	// (POST /notifications/push/test)
+	TestPushSubscription(c *gin.Context)
+	// Delete the current user's quiet hours
+	// (DELETE /notifications/quiet-hours)
+	DeleteQuietHours(c *gin.Context)
+	// Get the current user's quiet hours
+	// (GET /notifications/quiet-hours)
+	GetQuietHours(c *gin.Context)
+	// Set the current user's quiet hours
+	// (PUT /notifications/quiet-hours)
+	SetQuietHours(c *gin.Context)
 	// Get unread notification count
 	// (GET /notifications/unread-count)
 	GetUnreadCount(c *gin.Context)
+	// List webhook targets
+	// (GET /notifications/webhooks)
+	ListWebhookTargets(c *gin.Context)
+	// Create a webhook target
+	// (POST /notifications/webhooks)
+	CreateWebhookTarget(c *gin.Context)
+	// Delete a webhook target
+	// (DELETE /notifications/webhooks/{id})
+	DeleteWebhookTarget(c *gin.Context, id int)
+	// Update a webhook target
+	// (PUT /notifications/webhooks/{id})
+	UpdateWebhookTarget(c *gin.Context, id int)
+	// Get a webhook target's delivery log
+	// (GET /notifications/webhooks/{id}/deliveries)
+	GetWebhookDeliveries(c *gin.Context, id int, params GetWebhookDeliveriesParams)
+	// Send a test notification to a webhook target
+	// (POST /notifications/webhooks/{id}/test)
+	TestWebhookTarget(c *gin.Context, id int)
 	// WebSocket endpoint — subscribe to notifications
 	// (GET /notifications/ws)
 	NotificationsWebSocket(c *gin.Context)
@@ -199,6 +355,45 @@ type ServerInterface interface {
 	// Remove permission from role
 	// (DELETE /roles/{id}/permissions/{pid})
 	RemovePermission(c *gin.Context, id int, pid int)
+	// List scenes
+	// (GET /scenes)
+	GetAllScenes(c *gin.Context)
+	// Create a scene
+	// (POST /scenes)
+	CreateScene(c *gin.Context)
+	// Delete a scene
+	// (DELETE /scenes/{id})
+	DeleteScene(c *gin.Context, id int)
+	// Get scene by ID
+	// (GET /scenes/{id})
+	GetSceneById(c *gin.Context, id int)
+	// Update a scene
+	// (PUT /scenes/{id})
+	UpdateScene(c *gin.Context, id int)
+	// Activate a scene
+	// (POST /scenes/{id}/activate)
+	ActivateScene(c *gin.Context, id int, params ActivateSceneParams)
+	// List command schedules
+	// (GET /schedules)
+	GetAllSchedules(c *gin.Context)
+	// Create a command schedule
+	// (POST /schedules)
+	CreateSchedule(c *gin.Context)
+	// Get holiday mode
+	// (GET /schedules/holiday-mode)
+	GetHolidayMode(c *gin.Context)
+	// Set holiday mode
+	// (PUT /schedules/holiday-mode)
+	SetHolidayMode(c *gin.Context)
+	// Delete a command schedule
+	// (DELETE /schedules/{id})
+	DeleteSchedule(c *gin.Context, id int)
+	// Get command schedule by ID
+	// (GET /schedules/{id})
+	GetScheduleById(c *gin.Context, id int)
+	// Update a command schedule
+	// (PUT /schedules/{id})
+	UpdateSchedule(c *gin.Context, id int)
 	// List all sensors
 	// (GET /sensors)
 	GetAllSensors(c *gin.Context)
@@ -214,6 +409,15 @@ type ServerInterface interface {
 	// Get sensor command history by id
 	// (GET /sensors/by-id/{id}/commands)
 	GetSensorCommandHistory(c *gin.Context, id int)
+	// Get sensor desired states by id
+	// (GET /sensors/by-id/{id}/desired-states)
+	GetSensorDesiredStates(c *gin.Context, id int)
+	// Clear the desired value of a sensor capability
+	// (DELETE /sensors/by-id/{id}/desired-states/{property})
+	DeleteSensorDesiredState(c *gin.Context, id int, property string)
+	// Set the desired value of a sensor capability
+	// (PUT /sensors/by-id/{id}/desired-states/{property})
+	SetSensorDesiredState(c *gin.Context, id int, property string)
 	// Get measurement types for a sensor
 	// (GET /sensors/by-id/{id}/measurement-types)
 	GetSensorMeasurementTypes(c *gin.Context, id int)
@@ -332,19 +536,8 @@ func (siw *ServerInterfaceWrapper) CreateAlertRule(c *gin.Context) {
 	siw.Handler.CreateAlertRule(c)
 }
 
-// GetAlertRulesBySensorId operation middleware
-func (siw *ServerInterfaceWrapper) GetAlertRulesBySensorId(c *gin.Context) {
-
-	var err error
-
-	// ------------- Path parameter "sensorId" -------------
-	var sensorId int
-
-	err = runtime.BindStyledParameterWithOptions("simple", "sensorId", c.Param("sensorId"), &sensorId, runtime.BindStyledParameterOptions{Explode: false, Required: true, Type: "integer", Format: ""})
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sensorId: %w", err), http.StatusBadRequest)
-		return
-	}
+// GetAllCompoundAlertRules operation middleware
+func (siw *ServerInterfaceWrapper) GetAllCompoundAlertRules(c *gin.Context) {
 
 	c.Set(CookieAuthScopes, []string{})
 
@@ -359,22 +552,11 @@ func (siw *ServerInterfaceWrapper) GetAlertRulesBySensorId(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetAlertRulesBySensorId(c, sensorId)
+	siw.Handler.GetAllCompoundAlertRules(c)
 }
 
-// GetAlertHistory operation middleware
-func (siw *ServerInterfaceWrapper) GetAlertHistory(c *gin.Context) {
-
-	var err error
-
-	// ------------- Path parameter "sensorId" -------------
-	var sensorId int
-
-	err = runtime.BindStyledParameterWithOptions("simple", "sensorId", c.Param("sensorId"), &sensorId, runtime.BindStyledParameterOptions{Explode: false, Required: true, Type: "integer", Format: ""})
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sensorId: %w", err), http.StatusBadRequest)
-		return
-	}
+// CreateCompoundAlertRule operation middleware
+func (siw *ServerInterfaceWrapper) CreateCompoundAlertRule(c *gin.Context) {
 
 	c.Set(CookieAuthScopes, []string{})
 
@@ -382,17 +564,6 @@ func (siw *ServerInterfaceWrapper) GetAlertHistory(c *gin.Context) {
 
 	c.Set(ApiKeyAuthScopes, []string{})
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetAlertHistoryParams
-
-	// ------------- Optional query parameter "limit" -------------
-
-	err = runtime.BindQueryParameterWithOptions("form", true, false, "limit", c.Request.URL.Query(), &params.Limit, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
-		return
-	}
-
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -400,11 +571,11 @@ func (siw *ServerInterfaceWrapper) GetAlertHistory(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetAlertHistory(c, sensorId, params)
+	siw.Handler.CreateCompoundAlertRule(c)
 }
 
-// DeleteAlertRule operation middleware
-func (siw *ServerInterfaceWrapper) DeleteAlertRule(c *gin.Context) {
+// DeleteCompoundAlertRule operation middleware
+func (siw *ServerInterfaceWrapper) DeleteCompoundAlertRule(c *gin.Context) {
 
 	var err error
 
@@ -430,11 +601,11 @@ func (siw *ServerInterfaceWrapper) DeleteAlertRule(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.DeleteAlertRule(c, id)
+	siw.Handler.DeleteCompoundAlertRule(c, id)
 }
 
-// GetAlertRuleById operation middleware
-func (siw *ServerInterfaceWrapper) GetAlertRuleById(c *gin.Context) {
+// GetCompoundAlertRuleById operation middleware
+func (siw *ServerInterfaceWrapper) GetCompoundAlertRuleById(c *gin.Context) {
 
 	var err error
 
@@ -460,11 +631,11 @@ func (siw *ServerInterfaceWrapper) GetAlertRuleById(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetAlertRuleById(c, id)
+	siw.Handler.GetCompoundAlertRuleById(c, id)
 }
 
-// UpdateAlertRule operation middleware
-func (siw *ServerInterfaceWrapper) UpdateAlertRule(c *gin.Context) {
+// UpdateCompoundAlertRule operation middleware
+func (siw *ServerInterfaceWrapper) UpdateCompoundAlertRule(c *gin.Context) {
 
 	var err error
 
@@ -490,31 +661,23 @@ func (siw *ServerInterfaceWrapper) UpdateAlertRule(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.UpdateAlertRule(c, id)
+	siw.Handler.UpdateCompoundAlertRule(c, id)
 }
 
-// ListApiKeys operation middleware
-func (siw *ServerInterfaceWrapper) ListApiKeys(c *gin.Context) {
-
-	c.Set(CookieAuthScopes, []string{})
+// AcknowledgeCompoundAlertRule operation middleware
+func (siw *ServerInterfaceWrapper) AcknowledgeCompoundAlertRule(c *gin.Context) {
 
-	c.Set(CsrfTokenScopes, []string{})
+	var err error
 
-	c.Set(ApiKeyAuthScopes, []string{})
+	// ------------- Path parameter "id" -------------
+	var id int
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		middleware(c)
-		if c.IsAborted() {
-			return
-		}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true, Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
 	}
 
-	siw.Handler.ListApiKeys(c)
-}
-
-// CreateApiKey operation middleware
-func (siw *ServerInterfaceWrapper) CreateApiKey(c *gin.Context) {
-
 	c.Set(CookieAuthScopes, []string{})
 
 	c.Set(CsrfTokenScopes, []string{})
@@ -528,11 +691,11 @@ func (siw *ServerInterfaceWrapper) CreateApiKey(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.CreateApiKey(c)
+	siw.Handler.AcknowledgeCompoundAlertRule(c, id)
 }
 
-// DeleteApiKey operation middleware
-func (siw *ServerInterfaceWrapper) DeleteApiKey(c *gin.Context) {
+// DeleteCompoundAlertEscalationPolicy operation middleware
+func (siw *ServerInterfaceWrapper) DeleteCompoundAlertEscalationPolicy(c *gin.Context) {
 
 	var err error
 
@@ -558,11 +721,11 @@ func (siw *ServerInterfaceWrapper) DeleteApiKey(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.DeleteApiKey(c, id)
+	siw.Handler.DeleteCompoundAlertEscalationPolicy(c, id)
 }
 
-// UpdateApiKeyExpiry operation middleware
-func (siw *ServerInterfaceWrapper) UpdateApiKeyExpiry(c *gin.Context) {
+// GetCompoundAlertEscalationPolicy operation middleware
+func (siw *ServerInterfaceWrapper) GetCompoundAlertEscalationPolicy(c *gin.Context) {
 
 	var err error
 
@@ -588,11 +751,11 @@ func (siw *ServerInterfaceWrapper) UpdateApiKeyExpiry(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.UpdateApiKeyExpiry(c, id)
+	siw.Handler.GetCompoundAlertEscalationPolicy(c, id)
 }
 
-// RevokeApiKey operation middleware
-func (siw *ServerInterfaceWrapper) RevokeApiKey(c *gin.Context) {
+// SetCompoundAlertEscalationPolicy operation middleware
+func (siw *ServerInterfaceWrapper) SetCompoundAlertEscalationPolicy(c *gin.Context) {
 
 	var err error
 
@@ -618,24 +781,22 @@ func (siw *ServerInterfaceWrapper) RevokeApiKey(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.RevokeApiKey(c, id)
+	siw.Handler.SetCompoundAlertEscalationPolicy(c, id)
 }
 
-// Login operation middleware
-func (siw *ServerInterfaceWrapper) Login(c *gin.Context) {
+// DeleteCompoundAlertRecipients operation middleware
+func (siw *ServerInterfaceWrapper) DeleteCompoundAlertRecipients(c *gin.Context) {
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		middleware(c)
-		if c.IsAborted() {
-			return
-		}
-	}
+	var err error
 
-	siw.Handler.Login(c)
-}
+	// ------------- Path parameter "id" -------------
+	var id int
 
-// Logout operation middleware
-func (siw *ServerInterfaceWrapper) Logout(c *gin.Context) {
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true, Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	c.Set(CookieAuthScopes, []string{})
 
@@ -650,11 +811,22 @@ func (siw *ServerInterfaceWrapper) Logout(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.Logout(c)
+	siw.Handler.DeleteCompoundAlertRecipients(c, id)
 }
 
-// GetCurrentUser operation middleware
-func (siw *ServerInterfaceWrapper) GetCurrentUser(c *gin.Context) {
+// GetCompoundAlertRecipients operation middleware
+func (siw *ServerInterfaceWrapper) GetCompoundAlertRecipients(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true, Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	c.Set(CookieAuthScopes, []string{})
 
@@ -669,11 +841,22 @@ func (siw *ServerInterfaceWrapper) GetCurrentUser(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetCurrentUser(c)
+	siw.Handler.GetCompoundAlertRecipients(c, id)
 }
 
-// ListSessions operation middleware
-func (siw *ServerInterfaceWrapper) ListSessions(c *gin.Context) {
+// SetCompoundAlertRecipients operation middleware
+func (siw *ServerInterfaceWrapper) SetCompoundAlertRecipients(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true, Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	c.Set(CookieAuthScopes, []string{})
 
@@ -688,18 +871,18 @@ func (siw *ServerInterfaceWrapper) ListSessions(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.ListSessions(c)
+	siw.Handler.SetCompoundAlertRecipients(c, id)
 }
 
-// RevokeSession operation middleware
-func (siw *ServerInterfaceWrapper) RevokeSession(c *gin.Context) {
+// UnsnoozeCompoundAlertRule operation middleware
+func (siw *ServerInterfaceWrapper) UnsnoozeCompoundAlertRule(c *gin.Context) {
 
 	var err error
 
 	// ------------- Path parameter "id" -------------
-	var id int64
+	var id int
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true, Type: "integer", Format: "int64"})
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true, Type: "integer", Format: ""})
 	if err != nil {
 		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
 		return
@@ -718,11 +901,22 @@ func (siw *ServerInterfaceWrapper) RevokeSession(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.RevokeSession(c, id)
+	siw.Handler.UnsnoozeCompoundAlertRule(c, id)
 }
 
-// ListDashboards operation middleware
-func (siw *ServerInterfaceWrapper) ListDashboards(c *gin.Context) {
+// SnoozeCompoundAlertRule operation middleware
+func (siw *ServerInterfaceWrapper) SnoozeCompoundAlertRule(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true, Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	c.Set(CookieAuthScopes, []string{})
 
@@ -737,11 +931,11 @@ func (siw *ServerInterfaceWrapper) ListDashboards(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.ListDashboards(c)
+	siw.Handler.SnoozeCompoundAlertRule(c, id)
 }
 
-// CreateDashboard operation middleware
-func (siw *ServerInterfaceWrapper) CreateDashboard(c *gin.Context) {
+// GetAllContactGroups operation middleware
+func (siw *ServerInterfaceWrapper) GetAllContactGroups(c *gin.Context) {
 
 	c.Set(CookieAuthScopes, []string{})
 
@@ -756,22 +950,11 @@ func (siw *ServerInterfaceWrapper) CreateDashboard(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.CreateDashboard(c)
+	siw.Handler.GetAllContactGroups(c)
 }
 
-// DeleteDashboard operation middleware
-func (siw *ServerInterfaceWrapper) DeleteDashboard(c *gin.Context) {
-
-	var err error
-
-	// ------------- Path parameter "id" -------------
-	var id int
-
-	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true, Type: "integer", Format: ""})
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
-		return
-	}
+// CreateContactGroup operation middleware
+func (siw *ServerInterfaceWrapper) CreateContactGroup(c *gin.Context) {
 
 	c.Set(CookieAuthScopes, []string{})
 
@@ -786,11 +969,11 @@ func (siw *ServerInterfaceWrapper) DeleteDashboard(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.DeleteDashboard(c, id)
+	siw.Handler.CreateContactGroup(c)
 }
 
-// GetDashboard operation middleware
-func (siw *ServerInterfaceWrapper) GetDashboard(c *gin.Context) {
+// DeleteContactGroup operation middleware
+func (siw *ServerInterfaceWrapper) DeleteContactGroup(c *gin.Context) {
 
 	var err error
 
@@ -816,11 +999,11 @@ func (siw *ServerInterfaceWrapper) GetDashboard(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetDashboard(c, id)
+	siw.Handler.DeleteContactGroup(c, id)
 }
 
-// UpdateDashboard operation middleware
-func (siw *ServerInterfaceWrapper) UpdateDashboard(c *gin.Context) {
+// UpdateContactGroup operation middleware
+func (siw *ServerInterfaceWrapper) UpdateContactGroup(c *gin.Context) {
 
 	var err error
 
@@ -846,11 +1029,11 @@ func (siw *ServerInterfaceWrapper) UpdateDashboard(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.UpdateDashboard(c, id)
+	siw.Handler.UpdateContactGroup(c, id)
 }
 
-// SetDefaultDashboard operation middleware
-func (siw *ServerInterfaceWrapper) SetDefaultDashboard(c *gin.Context) {
+// AcknowledgeAlertHistoryEntry operation middleware
+func (siw *ServerInterfaceWrapper) AcknowledgeAlertHistoryEntry(c *gin.Context) {
 
 	var err error
 
@@ -876,20 +1059,20 @@ func (siw *ServerInterfaceWrapper) SetDefaultDashboard(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.SetDefaultDashboard(c, id)
+	siw.Handler.AcknowledgeAlertHistoryEntry(c, id)
 }
 
-// ShareDashboard operation middleware
-func (siw *ServerInterfaceWrapper) ShareDashboard(c *gin.Context) {
+// GetAlertRulesBySensorId operation middleware
+func (siw *ServerInterfaceWrapper) GetAlertRulesBySensorId(c *gin.Context) {
 
 	var err error
 
-	// ------------- Path parameter "id" -------------
-	var id int
+	// ------------- Path parameter "sensorId" -------------
+	var sensorId int
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true, Type: "integer", Format: ""})
+	err = runtime.BindStyledParameterWithOptions("simple", "sensorId", c.Param("sensorId"), &sensorId, runtime.BindStyledParameterOptions{Explode: false, Required: true, Type: "integer", Format: ""})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sensorId: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -906,53 +1089,23 @@ func (siw *ServerInterfaceWrapper) ShareDashboard(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.ShareDashboard(c, id)
+	siw.Handler.GetAlertRulesBySensorId(c, sensorId)
 }
 
-// ListDrivers operation middleware
-func (siw *ServerInterfaceWrapper) ListDrivers(c *gin.Context) {
+// GetAlertHistory operation middleware
+func (siw *ServerInterfaceWrapper) GetAlertHistory(c *gin.Context) {
 
 	var err error
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ListDriversParams
-
-	// ------------- Optional query parameter "type" -------------
+	// ------------- Path parameter "sensorId" -------------
+	var sensorId int
 
-	err = runtime.BindQueryParameterWithOptions("form", true, false, "type", c.Request.URL.Query(), &params.Type, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	err = runtime.BindStyledParameterWithOptions("simple", "sensorId", c.Param("sensorId"), &sensorId, runtime.BindStyledParameterOptions{Explode: false, Required: true, Type: "integer", Format: ""})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter type: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sensorId: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		middleware(c)
-		if c.IsAborted() {
-			return
-		}
-	}
-
-	siw.Handler.ListDrivers(c, params)
-}
-
-// GetHealth operation middleware
-func (siw *ServerInterfaceWrapper) GetHealth(c *gin.Context) {
-
-	for _, middleware := range siw.HandlerMiddlewares {
-		middleware(c)
-		if c.IsAborted() {
-			return
-		}
-	}
-
-	siw.Handler.GetHealth(c)
-}
-
-// GetAllMeasurementTypes operation middleware
-func (siw *ServerInterfaceWrapper) GetAllMeasurementTypes(c *gin.Context) {
-
-	var err error
-
 	c.Set(CookieAuthScopes, []string{})
 
 	c.Set(CsrfTokenScopes, []string{})
@@ -960,13 +1113,13 @@ func (siw *ServerInterfaceWrapper) GetAllMeasurementTypes(c *gin.Context) {
 	c.Set(ApiKeyAuthScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetAllMeasurementTypesParams
+	var params GetAlertHistoryParams
 
-	// ------------- Optional query parameter "has_readings" -------------
+	// ------------- Optional query parameter "limit" -------------
 
-	err = runtime.BindQueryParameterWithOptions("form", true, false, "has_readings", c.Request.URL.Query(), &params.HasReadings, runtime.BindQueryParameterOptions{Type: "boolean", Format: ""})
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "limit", c.Request.URL.Query(), &params.Limit, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter has_readings: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -977,11 +1130,11 @@ func (siw *ServerInterfaceWrapper) GetAllMeasurementTypes(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetAllMeasurementTypes(c, params)
+	siw.Handler.GetAlertHistory(c, sensorId, params)
 }
 
-// ListMqttBrokers operation middleware
-func (siw *ServerInterfaceWrapper) ListMqttBrokers(c *gin.Context) {
+// GetAllSilenceWindows operation middleware
+func (siw *ServerInterfaceWrapper) GetAllSilenceWindows(c *gin.Context) {
 
 	c.Set(CookieAuthScopes, []string{})
 
@@ -996,11 +1149,11 @@ func (siw *ServerInterfaceWrapper) ListMqttBrokers(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.ListMqttBrokers(c)
+	siw.Handler.GetAllSilenceWindows(c)
 }
 
-// CreateMqttBroker operation middleware
-func (siw *ServerInterfaceWrapper) CreateMqttBroker(c *gin.Context) {
+// CreateSilenceWindow operation middleware
+func (siw *ServerInterfaceWrapper) CreateSilenceWindow(c *gin.Context) {
 
 	c.Set(CookieAuthScopes, []string{})
 
@@ -1015,11 +1168,11 @@ func (siw *ServerInterfaceWrapper) CreateMqttBroker(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.CreateMqttBroker(c)
+	siw.Handler.CreateSilenceWindow(c)
 }
 
-// DeleteMqttBroker operation middleware
-func (siw *ServerInterfaceWrapper) DeleteMqttBroker(c *gin.Context) {
+// DeleteSilenceWindow operation middleware
+func (siw *ServerInterfaceWrapper) DeleteSilenceWindow(c *gin.Context) {
 
 	var err error
 
@@ -1045,11 +1198,11 @@ func (siw *ServerInterfaceWrapper) DeleteMqttBroker(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.DeleteMqttBroker(c, id)
+	siw.Handler.DeleteSilenceWindow(c, id)
 }
 
-// GetMqttBroker operation middleware
-func (siw *ServerInterfaceWrapper) GetMqttBroker(c *gin.Context) {
+// UpdateSilenceWindow operation middleware
+func (siw *ServerInterfaceWrapper) UpdateSilenceWindow(c *gin.Context) {
 
 	var err error
 
@@ -1075,11 +1228,11 @@ func (siw *ServerInterfaceWrapper) GetMqttBroker(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetMqttBroker(c, id)
+	siw.Handler.UpdateSilenceWindow(c, id)
 }
 
-// UpdateMqttBroker operation middleware
-func (siw *ServerInterfaceWrapper) UpdateMqttBroker(c *gin.Context) {
+// DeleteAlertRule operation middleware
+func (siw *ServerInterfaceWrapper) DeleteAlertRule(c *gin.Context) {
 
 	var err error
 
@@ -1105,11 +1258,22 @@ func (siw *ServerInterfaceWrapper) UpdateMqttBroker(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.UpdateMqttBroker(c, id)
+	siw.Handler.DeleteAlertRule(c, id)
 }
 
-// GetMqttStats operation middleware
-func (siw *ServerInterfaceWrapper) GetMqttStats(c *gin.Context) {
+// GetAlertRuleById operation middleware
+func (siw *ServerInterfaceWrapper) GetAlertRuleById(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true, Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	c.Set(CookieAuthScopes, []string{})
 
@@ -1124,31 +1288,29 @@ func (siw *ServerInterfaceWrapper) GetMqttStats(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetMqttStats(c)
+	siw.Handler.GetAlertRuleById(c, id)
 }
 
-// ListMqttSubscriptions operation middleware
-func (siw *ServerInterfaceWrapper) ListMqttSubscriptions(c *gin.Context) {
+// UpdateAlertRule operation middleware
+func (siw *ServerInterfaceWrapper) UpdateAlertRule(c *gin.Context) {
 
 	var err error
 
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true, Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	c.Set(CookieAuthScopes, []string{})
 
 	c.Set(CsrfTokenScopes, []string{})
 
 	c.Set(ApiKeyAuthScopes, []string{})
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ListMqttSubscriptionsParams
-
-	// ------------- Optional query parameter "broker_id" -------------
-
-	err = runtime.BindQueryParameterWithOptions("form", true, false, "broker_id", c.Request.URL.Query(), &params.BrokerId, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter broker_id: %w", err), http.StatusBadRequest)
-		return
-	}
-
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -1156,11 +1318,22 @@ func (siw *ServerInterfaceWrapper) ListMqttSubscriptions(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.ListMqttSubscriptions(c, params)
+	siw.Handler.UpdateAlertRule(c, id)
 }
 
-// CreateMqttSubscription operation middleware
-func (siw *ServerInterfaceWrapper) CreateMqttSubscription(c *gin.Context) {
+// AcknowledgeAlertRule operation middleware
+func (siw *ServerInterfaceWrapper) AcknowledgeAlertRule(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true, Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	c.Set(CookieAuthScopes, []string{})
 
@@ -1175,11 +1348,11 @@ func (siw *ServerInterfaceWrapper) CreateMqttSubscription(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.CreateMqttSubscription(c)
+	siw.Handler.AcknowledgeAlertRule(c, id)
 }
 
-// DeleteMqttSubscription operation middleware
-func (siw *ServerInterfaceWrapper) DeleteMqttSubscription(c *gin.Context) {
+// DeleteAlertEscalationPolicy operation middleware
+func (siw *ServerInterfaceWrapper) DeleteAlertEscalationPolicy(c *gin.Context) {
 
 	var err error
 
@@ -1205,11 +1378,11 @@ func (siw *ServerInterfaceWrapper) DeleteMqttSubscription(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.DeleteMqttSubscription(c, id)
+	siw.Handler.DeleteAlertEscalationPolicy(c, id)
 }
 
-// GetMqttSubscription operation middleware
-func (siw *ServerInterfaceWrapper) GetMqttSubscription(c *gin.Context) {
+// GetAlertEscalationPolicy operation middleware
+func (siw *ServerInterfaceWrapper) GetAlertEscalationPolicy(c *gin.Context) {
 
 	var err error
 
@@ -1235,11 +1408,11 @@ func (siw *ServerInterfaceWrapper) GetMqttSubscription(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetMqttSubscription(c, id)
+	siw.Handler.GetAlertEscalationPolicy(c, id)
 }
 
-// UpdateMqttSubscription operation middleware
-func (siw *ServerInterfaceWrapper) UpdateMqttSubscription(c *gin.Context) {
+// SetAlertEscalationPolicy operation middleware
+func (siw *ServerInterfaceWrapper) SetAlertEscalationPolicy(c *gin.Context) {
 
 	var err error
 
@@ -1265,46 +1438,58 @@ func (siw *ServerInterfaceWrapper) UpdateMqttSubscription(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.UpdateMqttSubscription(c, id)
+	siw.Handler.SetAlertEscalationPolicy(c, id)
 }
 
-// ListNotifications operation middleware
-func (siw *ServerInterfaceWrapper) ListNotifications(c *gin.Context) {
+// DeleteAlertRecipients operation middleware
+func (siw *ServerInterfaceWrapper) DeleteAlertRecipients(c *gin.Context) {
 
 	var err error
 
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true, Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	c.Set(CookieAuthScopes, []string{})
 
 	c.Set(CsrfTokenScopes, []string{})
 
 	c.Set(ApiKeyAuthScopes, []string{})
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ListNotificationsParams
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	// ------------- Optional query parameter "limit" -------------
+	siw.Handler.DeleteAlertRecipients(c, id)
+}
 
-	err = runtime.BindQueryParameterWithOptions("form", true, false, "limit", c.Request.URL.Query(), &params.Limit, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
-		return
-	}
+// GetAlertRecipients operation middleware
+func (siw *ServerInterfaceWrapper) GetAlertRecipients(c *gin.Context) {
 
-	// ------------- Optional query parameter "offset" -------------
+	var err error
 
-	err = runtime.BindQueryParameterWithOptions("form", true, false, "offset", c.Request.URL.Query(), &params.Offset, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true, Type: "integer", Format: ""})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter offset: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	// ------------- Optional query parameter "include_dismissed" -------------
+	c.Set(CookieAuthScopes, []string{})
 
-	err = runtime.BindQueryParameterWithOptions("form", true, false, "include_dismissed", c.Request.URL.Query(), &params.IncludeDismissed, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter include_dismissed: %w", err), http.StatusBadRequest)
-		return
-	}
+	c.Set(CsrfTokenScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -1313,11 +1498,22 @@ func (siw *ServerInterfaceWrapper) ListNotifications(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.ListNotifications(c, params)
+	siw.Handler.GetAlertRecipients(c, id)
 }
 
-// BulkDismiss operation middleware
-func (siw *ServerInterfaceWrapper) BulkDismiss(c *gin.Context) {
+// SetAlertRecipients operation middleware
+func (siw *ServerInterfaceWrapper) SetAlertRecipients(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true, Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	c.Set(CookieAuthScopes, []string{})
 
@@ -1332,11 +1528,22 @@ func (siw *ServerInterfaceWrapper) BulkDismiss(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.BulkDismiss(c)
+	siw.Handler.SetAlertRecipients(c, id)
 }
 
-// BulkMarkAsRead operation middleware
-func (siw *ServerInterfaceWrapper) BulkMarkAsRead(c *gin.Context) {
+// UnsnoozeAlertRule operation middleware
+func (siw *ServerInterfaceWrapper) UnsnoozeAlertRule(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true, Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	c.Set(CookieAuthScopes, []string{})
 
@@ -1351,11 +1558,22 @@ func (siw *ServerInterfaceWrapper) BulkMarkAsRead(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.BulkMarkAsRead(c)
+	siw.Handler.UnsnoozeAlertRule(c, id)
 }
 
-// GetChannelPreferences operation middleware
-func (siw *ServerInterfaceWrapper) GetChannelPreferences(c *gin.Context) {
+// SnoozeAlertRule operation middleware
+func (siw *ServerInterfaceWrapper) SnoozeAlertRule(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true, Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	c.Set(CookieAuthScopes, []string{})
 
@@ -1370,11 +1588,11 @@ func (siw *ServerInterfaceWrapper) GetChannelPreferences(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetChannelPreferences(c)
+	siw.Handler.SnoozeAlertRule(c, id)
 }
 
-// SetChannelPreference operation middleware
-func (siw *ServerInterfaceWrapper) SetChannelPreference(c *gin.Context) {
+// ListApiKeys operation middleware
+func (siw *ServerInterfaceWrapper) ListApiKeys(c *gin.Context) {
 
 	c.Set(CookieAuthScopes, []string{})
 
@@ -1389,11 +1607,11 @@ func (siw *ServerInterfaceWrapper) SetChannelPreference(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.SetChannelPreference(c)
+	siw.Handler.ListApiKeys(c)
 }
 
-// GetUnreadCount operation middleware
-func (siw *ServerInterfaceWrapper) GetUnreadCount(c *gin.Context) {
+// CreateApiKey operation middleware
+func (siw *ServerInterfaceWrapper) CreateApiKey(c *gin.Context) {
 
 	c.Set(CookieAuthScopes, []string{})
 
@@ -1408,11 +1626,22 @@ func (siw *ServerInterfaceWrapper) GetUnreadCount(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetUnreadCount(c)
+	siw.Handler.CreateApiKey(c)
 }
 
-// NotificationsWebSocket operation middleware
-func (siw *ServerInterfaceWrapper) NotificationsWebSocket(c *gin.Context) {
+// DeleteApiKey operation middleware
+func (siw *ServerInterfaceWrapper) DeleteApiKey(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true, Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	c.Set(CookieAuthScopes, []string{})
 
@@ -1427,11 +1656,11 @@ func (siw *ServerInterfaceWrapper) NotificationsWebSocket(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.NotificationsWebSocket(c)
+	siw.Handler.DeleteApiKey(c, id)
 }
 
-// DismissNotification operation middleware
-func (siw *ServerInterfaceWrapper) DismissNotification(c *gin.Context) {
+// UpdateApiKeyExpiry operation middleware
+func (siw *ServerInterfaceWrapper) UpdateApiKeyExpiry(c *gin.Context) {
 
 	var err error
 
@@ -1457,11 +1686,11 @@ func (siw *ServerInterfaceWrapper) DismissNotification(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.DismissNotification(c, id)
+	siw.Handler.UpdateApiKeyExpiry(c, id)
 }
 
-// MarkAsRead operation middleware
-func (siw *ServerInterfaceWrapper) MarkAsRead(c *gin.Context) {
+// RevokeApiKey operation middleware
+func (siw *ServerInterfaceWrapper) RevokeApiKey(c *gin.Context) {
 
 	var err error
 
@@ -1487,17 +1716,11 @@ func (siw *ServerInterfaceWrapper) MarkAsRead(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.MarkAsRead(c, id)
+	siw.Handler.RevokeApiKey(c, id)
 }
 
-// GetOAuthAuthorizeUrl operation middleware
-func (siw *ServerInterfaceWrapper) GetOAuthAuthorizeUrl(c *gin.Context) {
-
-	c.Set(CookieAuthScopes, []string{})
-
-	c.Set(CsrfTokenScopes, []string{})
-
-	c.Set(ApiKeyAuthScopes, []string{})
+// Login operation middleware
+func (siw *ServerInterfaceWrapper) Login(c *gin.Context) {
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -1506,11 +1729,11 @@ func (siw *ServerInterfaceWrapper) GetOAuthAuthorizeUrl(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetOAuthAuthorizeUrl(c)
+	siw.Handler.Login(c)
 }
 
-// ReloadOAuth operation middleware
-func (siw *ServerInterfaceWrapper) ReloadOAuth(c *gin.Context) {
+// Logout operation middleware
+func (siw *ServerInterfaceWrapper) Logout(c *gin.Context) {
 
 	c.Set(CookieAuthScopes, []string{})
 
@@ -1525,11 +1748,11 @@ func (siw *ServerInterfaceWrapper) ReloadOAuth(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.ReloadOAuth(c)
+	siw.Handler.Logout(c)
 }
 
-// GetOAuthStatus operation middleware
-func (siw *ServerInterfaceWrapper) GetOAuthStatus(c *gin.Context) {
+// GetCurrentUser operation middleware
+func (siw *ServerInterfaceWrapper) GetCurrentUser(c *gin.Context) {
 
 	c.Set(CookieAuthScopes, []string{})
 
@@ -1544,11 +1767,11 @@ func (siw *ServerInterfaceWrapper) GetOAuthStatus(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetOAuthStatus(c)
+	siw.Handler.GetCurrentUser(c)
 }
 
-// SubmitOAuthCode operation middleware
-func (siw *ServerInterfaceWrapper) SubmitOAuthCode(c *gin.Context) {
+// ListSessions operation middleware
+func (siw *ServerInterfaceWrapper) ListSessions(c *gin.Context) {
 
 	c.Set(CookieAuthScopes, []string{})
 
@@ -1563,11 +1786,28 @@ func (siw *ServerInterfaceWrapper) SubmitOAuthCode(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.SubmitOAuthCode(c)
+	siw.Handler.ListSessions(c)
 }
 
-// GetOpenApiSpec operation middleware
-func (siw *ServerInterfaceWrapper) GetOpenApiSpec(c *gin.Context) {
+// RevokeSession operation middleware
+func (siw *ServerInterfaceWrapper) RevokeSession(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true, Type: "integer", Format: "int64"})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(CookieAuthScopes, []string{})
+
+	c.Set(CsrfTokenScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -1576,11 +1816,1359 @@ func (siw *ServerInterfaceWrapper) GetOpenApiSpec(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetOpenApiSpec(c)
+	siw.Handler.RevokeSession(c, id)
 }
 
-// GetProperties operation middleware
-func (siw *ServerInterfaceWrapper) GetProperties(c *gin.Context) {
+// GetAllAutomationRules operation middleware
+func (siw *ServerInterfaceWrapper) GetAllAutomationRules(c *gin.Context) {
+
+	c.Set(CookieAuthScopes, []string{})
+
+	c.Set(CsrfTokenScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetAllAutomationRules(c)
+}
+
+// CreateAutomationRule operation middleware
+func (siw *ServerInterfaceWrapper) CreateAutomationRule(c *gin.Context) {
+
+	c.Set(CookieAuthScopes, []string{})
+
+	c.Set(CsrfTokenScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.CreateAutomationRule(c)
+}
+
+// DeleteAutomationRule operation middleware
+func (siw *ServerInterfaceWrapper) DeleteAutomationRule(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true, Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(CookieAuthScopes, []string{})
+
+	c.Set(CsrfTokenScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteAutomationRule(c, id)
+}
+
+// GetAutomationRuleById operation middleware
+func (siw *ServerInterfaceWrapper) GetAutomationRuleById(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true, Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(CookieAuthScopes, []string{})
+
+	c.Set(CsrfTokenScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetAutomationRuleById(c, id)
+}
+
+// UpdateAutomationRule operation middleware
+func (siw *ServerInterfaceWrapper) UpdateAutomationRule(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true, Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(CookieAuthScopes, []string{})
+
+	c.Set(CsrfTokenScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.UpdateAutomationRule(c, id)
+}
+
+// ListDashboards operation middleware
+func (siw *ServerInterfaceWrapper) ListDashboards(c *gin.Context) {
+
+	c.Set(CookieAuthScopes, []string{})
+
+	c.Set(CsrfTokenScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ListDashboards(c)
+}
+
+// CreateDashboard operation middleware
+func (siw *ServerInterfaceWrapper) CreateDashboard(c *gin.Context) {
+
+	c.Set(CookieAuthScopes, []string{})
+
+	c.Set(CsrfTokenScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.CreateDashboard(c)
+}
+
+// DeleteDashboard operation middleware
+func (siw *ServerInterfaceWrapper) DeleteDashboard(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true, Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(CookieAuthScopes, []string{})
+
+	c.Set(CsrfTokenScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteDashboard(c, id)
+}
+
+// GetDashboard operation middleware
+func (siw *ServerInterfaceWrapper) GetDashboard(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true, Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(CookieAuthScopes, []string{})
+
+	c.Set(CsrfTokenScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetDashboard(c, id)
+}
+
+// UpdateDashboard operation middleware
+func (siw *ServerInterfaceWrapper) UpdateDashboard(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true, Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(CookieAuthScopes, []string{})
+
+	c.Set(CsrfTokenScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.UpdateDashboard(c, id)
+}
+
+// SetDefaultDashboard operation middleware
+func (siw *ServerInterfaceWrapper) SetDefaultDashboard(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true, Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(CookieAuthScopes, []string{})
+
+	c.Set(CsrfTokenScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.SetDefaultDashboard(c, id)
+}
+
+// ShareDashboard operation middleware
+func (siw *ServerInterfaceWrapper) ShareDashboard(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true, Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(CookieAuthScopes, []string{})
+
+	c.Set(CsrfTokenScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ShareDashboard(c, id)
+}
+
+// ListDrivers operation middleware
+func (siw *ServerInterfaceWrapper) ListDrivers(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListDriversParams
+
+	// ------------- Optional query parameter "type" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "type", c.Request.URL.Query(), &params.Type, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter type: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ListDrivers(c, params)
+}
+
+// GetHealth operation middleware
+func (siw *ServerInterfaceWrapper) GetHealth(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetHealth(c)
+}
+
+// GetAllMeasurementTypes operation middleware
+func (siw *ServerInterfaceWrapper) GetAllMeasurementTypes(c *gin.Context) {
+
+	var err error
+
+	c.Set(CookieAuthScopes, []string{})
+
+	c.Set(CsrfTokenScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAllMeasurementTypesParams
+
+	// ------------- Optional query parameter "has_readings" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "has_readings", c.Request.URL.Query(), &params.HasReadings, runtime.BindQueryParameterOptions{Type: "boolean", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter has_readings: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetAllMeasurementTypes(c, params)
+}
+
+// DeleteMeasurementTypeDownsampling operation middleware
+func (siw *ServerInterfaceWrapper) DeleteMeasurementTypeDownsampling(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", c.Param("name"), &name, runtime.BindStyledParameterOptions{Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter name: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(CookieAuthScopes, []string{})
+
+	c.Set(CsrfTokenScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteMeasurementTypeDownsampling(c, name)
+}
+
+// SetMeasurementTypeDownsampling operation middleware
+func (siw *ServerInterfaceWrapper) SetMeasurementTypeDownsampling(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", c.Param("name"), &name, runtime.BindStyledParameterOptions{Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter name: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(CookieAuthScopes, []string{})
+
+	c.Set(CsrfTokenScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.SetMeasurementTypeDownsampling(c, name)
+}
+
+// ListMqttBrokers operation middleware
+func (siw *ServerInterfaceWrapper) ListMqttBrokers(c *gin.Context) {
+
+	c.Set(CookieAuthScopes, []string{})
+
+	c.Set(CsrfTokenScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ListMqttBrokers(c)
+}
+
+// CreateMqttBroker operation middleware
+func (siw *ServerInterfaceWrapper) CreateMqttBroker(c *gin.Context) {
+
+	c.Set(CookieAuthScopes, []string{})
+
+	c.Set(CsrfTokenScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.CreateMqttBroker(c)
+}
+
+// DeleteMqttBroker operation middleware
+func (siw *ServerInterfaceWrapper) DeleteMqttBroker(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true, Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(CookieAuthScopes, []string{})
+
+	c.Set(CsrfTokenScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteMqttBroker(c, id)
+}
+
+// GetMqttBroker operation middleware
+func (siw *ServerInterfaceWrapper) GetMqttBroker(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true, Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(CookieAuthScopes, []string{})
+
+	c.Set(CsrfTokenScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetMqttBroker(c, id)
+}
+
+// UpdateMqttBroker operation middleware
+func (siw *ServerInterfaceWrapper) UpdateMqttBroker(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true, Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(CookieAuthScopes, []string{})
+
+	c.Set(CsrfTokenScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.UpdateMqttBroker(c, id)
+}
+
+// GetMqttStats operation middleware
+func (siw *ServerInterfaceWrapper) GetMqttStats(c *gin.Context) {
+
+	c.Set(CookieAuthScopes, []string{})
+
+	c.Set(CsrfTokenScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetMqttStats(c)
+}
+
+// ListMqttSubscriptions operation middleware
+func (siw *ServerInterfaceWrapper) ListMqttSubscriptions(c *gin.Context) {
+
+	var err error
+
+	c.Set(CookieAuthScopes, []string{})
+
+	c.Set(CsrfTokenScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListMqttSubscriptionsParams
+
+	// ------------- Optional query parameter "broker_id" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "broker_id", c.Request.URL.Query(), &params.BrokerId, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter broker_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ListMqttSubscriptions(c, params)
+}
+
+// CreateMqttSubscription operation middleware
+func (siw *ServerInterfaceWrapper) CreateMqttSubscription(c *gin.Context) {
+
+	c.Set(CookieAuthScopes, []string{})
+
+	c.Set(CsrfTokenScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.CreateMqttSubscription(c)
+}
+
+// DeleteMqttSubscription operation middleware
+func (siw *ServerInterfaceWrapper) DeleteMqttSubscription(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true, Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(CookieAuthScopes, []string{})
+
+	c.Set(CsrfTokenScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteMqttSubscription(c, id)
+}
+
+// GetMqttSubscription operation middleware
+func (siw *ServerInterfaceWrapper) GetMqttSubscription(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true, Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(CookieAuthScopes, []string{})
+
+	c.Set(CsrfTokenScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetMqttSubscription(c, id)
+}
+
+// UpdateMqttSubscription operation middleware
+func (siw *ServerInterfaceWrapper) UpdateMqttSubscription(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true, Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(CookieAuthScopes, []string{})
+
+	c.Set(CsrfTokenScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.UpdateMqttSubscription(c, id)
+}
+
+// ListNotifications operation middleware
+func (siw *ServerInterfaceWrapper) ListNotifications(c *gin.Context) {
+
+	var err error
+
+	c.Set(CookieAuthScopes, []string{})
+
+	c.Set(CsrfTokenScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListNotificationsParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "limit", c.Request.URL.Query(), &params.Limit, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "offset", c.Request.URL.Query(), &params.Offset, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter offset: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "include_dismissed" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "include_dismissed", c.Request.URL.Query(), &params.IncludeDismissed, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter include_dismissed: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ListNotifications(c, params)
+}
+
+// BulkDismiss operation middleware
+func (siw *ServerInterfaceWrapper) BulkDismiss(c *gin.Context) {
+
+	c.Set(CookieAuthScopes, []string{})
+
+	c.Set(CsrfTokenScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.BulkDismiss(c)
+}
+
+// BulkMarkAsRead operation middleware
+func (siw *ServerInterfaceWrapper) BulkMarkAsRead(c *gin.Context) {
+
+	c.Set(CookieAuthScopes, []string{})
+
+	c.Set(CsrfTokenScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.BulkMarkAsRead(c)
+}
+
+// GetChannelPreferences operation middleware
+func (siw *ServerInterfaceWrapper) GetChannelPreferences(c *gin.Context) {
+
+	c.Set(CookieAuthScopes, []string{})
+
+	c.Set(CsrfTokenScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetChannelPreferences(c)
+}
+
+// SetChannelPreference operation middleware
+func (siw *ServerInterfaceWrapper) SetChannelPreference(c *gin.Context) {
+
+	c.Set(CookieAuthScopes, []string{})
+
+	c.Set(CsrfTokenScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.SetChannelPreference(c)
+}
+
+// DeletePushSubscription operation middleware
+func (siw *ServerInterfaceWrapper) DeletePushSubscription(c *gin.Context) {
+
+	c.Set(CookieAuthScopes, []string{})
+
+	c.Set(CsrfTokenScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeletePushSubscription(c)
+}
+
+// GetPushSubscription operation middleware
+func (siw *ServerInterfaceWrapper) GetPushSubscription(c *gin.Context) {
+
+	c.Set(CookieAuthScopes, []string{})
+
+	c.Set(CsrfTokenScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetPushSubscription(c)
+}
+
+// SetPushSubscription operation middleware
+func (siw *ServerInterfaceWrapper) SetPushSubscription(c *gin.Context) {
+
+	c.Set(CookieAuthScopes, []string{})
+
+	c.Set(CsrfTokenScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.SetPushSubscription(c)
+}
+
+// TestPushSubscription operation middleware
+func (siw *ServerInterfaceWrapper) TestPushSubscription(c *gin.Context) {
+
+	c.Set(CookieAuthScopes, []string{})
+
+	c.Set(CsrfTokenScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.TestPushSubscription(c)
+}
+
+// DeleteQuietHours operation middleware
+func (siw *ServerInterfaceWrapper) DeleteQuietHours(c *gin.Context) {
+
+	c.Set(CookieAuthScopes, []string{})
+
+	c.Set(CsrfTokenScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteQuietHours(c)
+}
+
+// GetQuietHours operation middleware
+func (siw *ServerInterfaceWrapper) GetQuietHours(c *gin.Context) {
+
+	c.Set(CookieAuthScopes, []string{})
+
+	c.Set(CsrfTokenScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetQuietHours(c)
+}
+
+// SetQuietHours operation middleware
+func (siw *ServerInterfaceWrapper) SetQuietHours(c *gin.Context) {
+
+	c.Set(CookieAuthScopes, []string{})
+
+	c.Set(CsrfTokenScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.SetQuietHours(c)
+}
+
+// GetUnreadCount operation middleware
+func (siw *ServerInterfaceWrapper) GetUnreadCount(c *gin.Context) {
+
+	c.Set(CookieAuthScopes, []string{})
+
+	c.Set(CsrfTokenScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetUnreadCount(c)
+}
+
+// ListWebhookTargets operation middleware
+func (siw *ServerInterfaceWrapper) ListWebhookTargets(c *gin.Context) {
+
+	c.Set(CookieAuthScopes, []string{})
+
+	c.Set(CsrfTokenScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ListWebhookTargets(c)
+}
+
+// CreateWebhookTarget operation middleware
+func (siw *ServerInterfaceWrapper) CreateWebhookTarget(c *gin.Context) {
+
+	c.Set(CookieAuthScopes, []string{})
+
+	c.Set(CsrfTokenScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.CreateWebhookTarget(c)
+}
+
+// DeleteWebhookTarget operation middleware
+func (siw *ServerInterfaceWrapper) DeleteWebhookTarget(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true, Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(CookieAuthScopes, []string{})
+
+	c.Set(CsrfTokenScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteWebhookTarget(c, id)
+}
+
+// UpdateWebhookTarget operation middleware
+func (siw *ServerInterfaceWrapper) UpdateWebhookTarget(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true, Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(CookieAuthScopes, []string{})
+
+	c.Set(CsrfTokenScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.UpdateWebhookTarget(c, id)
+}
+
+// GetWebhookDeliveries operation middleware
+func (siw *ServerInterfaceWrapper) GetWebhookDeliveries(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true, Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(CookieAuthScopes, []string{})
+
+	c.Set(CsrfTokenScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetWebhookDeliveriesParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "limit", c.Request.URL.Query(), &params.Limit, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetWebhookDeliveries(c, id, params)
+}
+
+// TestWebhookTarget operation middleware
+func (siw *ServerInterfaceWrapper) TestWebhookTarget(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true, Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(CookieAuthScopes, []string{})
+
+	c.Set(CsrfTokenScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.TestWebhookTarget(c, id)
+}
+
+// NotificationsWebSocket operation middleware
+func (siw *ServerInterfaceWrapper) NotificationsWebSocket(c *gin.Context) {
+
+	c.Set(CookieAuthScopes, []string{})
+
+	c.Set(CsrfTokenScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.NotificationsWebSocket(c)
+}
+
+// DismissNotification operation middleware
+func (siw *ServerInterfaceWrapper) DismissNotification(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true, Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(CookieAuthScopes, []string{})
+
+	c.Set(CsrfTokenScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DismissNotification(c, id)
+}
+
+// MarkAsRead operation middleware
+func (siw *ServerInterfaceWrapper) MarkAsRead(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true, Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(CookieAuthScopes, []string{})
+
+	c.Set(CsrfTokenScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.MarkAsRead(c, id)
+}
+
+// GetOAuthAuthorizeUrl operation middleware
+func (siw *ServerInterfaceWrapper) GetOAuthAuthorizeUrl(c *gin.Context) {
+
+	c.Set(CookieAuthScopes, []string{})
+
+	c.Set(CsrfTokenScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetOAuthAuthorizeUrl(c)
+}
+
+// ReloadOAuth operation middleware
+func (siw *ServerInterfaceWrapper) ReloadOAuth(c *gin.Context) {
+
+	c.Set(CookieAuthScopes, []string{})
+
+	c.Set(CsrfTokenScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ReloadOAuth(c)
+}
+
+// GetOAuthStatus operation middleware
+func (siw *ServerInterfaceWrapper) GetOAuthStatus(c *gin.Context) {
+
+	c.Set(CookieAuthScopes, []string{})
+
+	c.Set(CsrfTokenScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetOAuthStatus(c)
+}
+
+// SubmitOAuthCode operation middleware
+func (siw *ServerInterfaceWrapper) SubmitOAuthCode(c *gin.Context) {
+
+	c.Set(CookieAuthScopes, []string{})
+
+	c.Set(CsrfTokenScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.SubmitOAuthCode(c)
+}
+
+// GetOpenApiSpec operation middleware
+func (siw *ServerInterfaceWrapper) GetOpenApiSpec(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetOpenApiSpec(c)
+}
+
+// GetProperties operation middleware
+func (siw *ServerInterfaceWrapper) GetProperties(c *gin.Context) {
 
 	c.Set(CookieAuthScopes, []string{})
 
@@ -1598,8 +3186,296 @@ func (siw *ServerInterfaceWrapper) GetProperties(c *gin.Context) {
 	siw.Handler.GetProperties(c)
 }
 
-// UpdateProperties operation middleware
-func (siw *ServerInterfaceWrapper) UpdateProperties(c *gin.Context) {
+// UpdateProperties operation middleware
+func (siw *ServerInterfaceWrapper) UpdateProperties(c *gin.Context) {
+
+	c.Set(CookieAuthScopes, []string{})
+
+	c.Set(CsrfTokenScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.UpdateProperties(c)
+}
+
+// PropertiesWebSocket operation middleware
+func (siw *ServerInterfaceWrapper) PropertiesWebSocket(c *gin.Context) {
+
+	c.Set(CookieAuthScopes, []string{})
+
+	c.Set(CsrfTokenScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PropertiesWebSocket(c)
+}
+
+// GetReadingsBetweenDates operation middleware
+func (siw *ServerInterfaceWrapper) GetReadingsBetweenDates(c *gin.Context) {
+
+	var err error
+
+	c.Set(CookieAuthScopes, []string{})
+
+	c.Set(CsrfTokenScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetReadingsBetweenDatesParams
+
+	// ------------- Required query parameter "start" -------------
+
+	if paramValue := c.Query("start"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument start is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameterWithOptions("form", true, true, "start", c.Request.URL.Query(), &params.Start, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter start: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Required query parameter "end" -------------
+
+	if paramValue := c.Query("end"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument end is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameterWithOptions("form", true, true, "end", c.Request.URL.Query(), &params.End, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter end: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "type" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "type", c.Request.URL.Query(), &params.Type, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter type: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "sensor" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "sensor", c.Request.URL.Query(), &params.Sensor, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sensor: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "aggregation" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "aggregation", c.Request.URL.Query(), &params.Aggregation, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter aggregation: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "aggregation_function" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "aggregation_function", c.Request.URL.Query(), &params.AggregationFunction, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter aggregation_function: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "statistics" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", false, false, "statistics", c.Request.URL.Query(), &params.Statistics, runtime.BindQueryParameterOptions{Type: "array", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter statistics: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetReadingsBetweenDates(c, params)
+}
+
+// SubscribeCurrentReadings operation middleware
+func (siw *ServerInterfaceWrapper) SubscribeCurrentReadings(c *gin.Context) {
+
+	c.Set(CookieAuthScopes, []string{})
+
+	c.Set(CsrfTokenScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.SubscribeCurrentReadings(c)
+}
+
+// ListRoles operation middleware
+func (siw *ServerInterfaceWrapper) ListRoles(c *gin.Context) {
+
+	c.Set(CookieAuthScopes, []string{})
+
+	c.Set(CsrfTokenScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ListRoles(c)
+}
+
+// ListPermissions operation middleware
+func (siw *ServerInterfaceWrapper) ListPermissions(c *gin.Context) {
+
+	c.Set(CookieAuthScopes, []string{})
+
+	c.Set(CsrfTokenScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ListPermissions(c)
+}
+
+// GetRolePermissions operation middleware
+func (siw *ServerInterfaceWrapper) GetRolePermissions(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true, Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(CookieAuthScopes, []string{})
+
+	c.Set(CsrfTokenScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetRolePermissions(c, id)
+}
+
+// AssignPermission operation middleware
+func (siw *ServerInterfaceWrapper) AssignPermission(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true, Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(CookieAuthScopes, []string{})
+
+	c.Set(CsrfTokenScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.AssignPermission(c, id)
+}
+
+// RemovePermission operation middleware
+func (siw *ServerInterfaceWrapper) RemovePermission(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true, Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "pid" -------------
+	var pid int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "pid", c.Param("pid"), &pid, runtime.BindStyledParameterOptions{Explode: false, Required: true, Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter pid: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(CookieAuthScopes, []string{})
+
+	c.Set(CsrfTokenScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.RemovePermission(c, id, pid)
+}
+
+// GetAllScenes operation middleware
+func (siw *ServerInterfaceWrapper) GetAllScenes(c *gin.Context) {
 
 	c.Set(CookieAuthScopes, []string{})
 
@@ -1614,11 +3490,11 @@ func (siw *ServerInterfaceWrapper) UpdateProperties(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.UpdateProperties(c)
+	siw.Handler.GetAllScenes(c)
 }
 
-// PropertiesWebSocket operation middleware
-func (siw *ServerInterfaceWrapper) PropertiesWebSocket(c *gin.Context) {
+// CreateScene operation middleware
+func (siw *ServerInterfaceWrapper) CreateScene(c *gin.Context) {
 
 	c.Set(CookieAuthScopes, []string{})
 
@@ -1633,82 +3509,127 @@ func (siw *ServerInterfaceWrapper) PropertiesWebSocket(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PropertiesWebSocket(c)
+	siw.Handler.CreateScene(c)
 }
 
-// GetReadingsBetweenDates operation middleware
-func (siw *ServerInterfaceWrapper) GetReadingsBetweenDates(c *gin.Context) {
+// DeleteScene operation middleware
+func (siw *ServerInterfaceWrapper) DeleteScene(c *gin.Context) {
 
 	var err error
 
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true, Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	c.Set(CookieAuthScopes, []string{})
 
 	c.Set(CsrfTokenScopes, []string{})
 
 	c.Set(ApiKeyAuthScopes, []string{})
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetReadingsBetweenDatesParams
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	// ------------- Required query parameter "start" -------------
+	siw.Handler.DeleteScene(c, id)
+}
 
-	if paramValue := c.Query("start"); paramValue != "" {
+// GetSceneById operation middleware
+func (siw *ServerInterfaceWrapper) GetSceneById(c *gin.Context) {
 
-	} else {
-		siw.ErrorHandler(c, fmt.Errorf("Query argument start is required, but not found"), http.StatusBadRequest)
-		return
-	}
+	var err error
 
-	err = runtime.BindQueryParameterWithOptions("form", true, true, "start", c.Request.URL.Query(), &params.Start, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true, Type: "integer", Format: ""})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter start: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	// ------------- Required query parameter "end" -------------
+	c.Set(CookieAuthScopes, []string{})
 
-	if paramValue := c.Query("end"); paramValue != "" {
+	c.Set(CsrfTokenScopes, []string{})
 
-	} else {
-		siw.ErrorHandler(c, fmt.Errorf("Query argument end is required, but not found"), http.StatusBadRequest)
-		return
-	}
+	c.Set(ApiKeyAuthScopes, []string{})
 
-	err = runtime.BindQueryParameterWithOptions("form", true, true, "end", c.Request.URL.Query(), &params.End, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter end: %w", err), http.StatusBadRequest)
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
 
-	// ------------- Optional query parameter "type" -------------
+	siw.Handler.GetSceneById(c, id)
+}
 
-	err = runtime.BindQueryParameterWithOptions("form", true, false, "type", c.Request.URL.Query(), &params.Type, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+// UpdateScene operation middleware
+func (siw *ServerInterfaceWrapper) UpdateScene(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true, Type: "integer", Format: ""})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter type: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	// ------------- Optional query parameter "sensor" -------------
+	c.Set(CookieAuthScopes, []string{})
 
-	err = runtime.BindQueryParameterWithOptions("form", true, false, "sensor", c.Request.URL.Query(), &params.Sensor, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sensor: %w", err), http.StatusBadRequest)
-		return
+	c.Set(CsrfTokenScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
 
-	// ------------- Optional query parameter "aggregation" -------------
+	siw.Handler.UpdateScene(c, id)
+}
 
-	err = runtime.BindQueryParameterWithOptions("form", true, false, "aggregation", c.Request.URL.Query(), &params.Aggregation, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+// ActivateScene operation middleware
+func (siw *ServerInterfaceWrapper) ActivateScene(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true, Type: "integer", Format: ""})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter aggregation: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	// ------------- Optional query parameter "aggregation_function" -------------
+	c.Set(CookieAuthScopes, []string{})
 
-	err = runtime.BindQueryParameterWithOptions("form", true, false, "aggregation_function", c.Request.URL.Query(), &params.AggregationFunction, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	c.Set(CsrfTokenScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ActivateSceneParams
+
+	// ------------- Optional query parameter "wait" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "wait", c.Request.URL.Query(), &params.Wait, runtime.BindQueryParameterOptions{Type: "boolean", Format: ""})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter aggregation_function: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter wait: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -1719,11 +3640,11 @@ func (siw *ServerInterfaceWrapper) GetReadingsBetweenDates(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetReadingsBetweenDates(c, params)
+	siw.Handler.ActivateScene(c, id, params)
 }
 
-// SubscribeCurrentReadings operation middleware
-func (siw *ServerInterfaceWrapper) SubscribeCurrentReadings(c *gin.Context) {
+// GetAllSchedules operation middleware
+func (siw *ServerInterfaceWrapper) GetAllSchedules(c *gin.Context) {
 
 	c.Set(CookieAuthScopes, []string{})
 
@@ -1738,11 +3659,11 @@ func (siw *ServerInterfaceWrapper) SubscribeCurrentReadings(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.SubscribeCurrentReadings(c)
+	siw.Handler.GetAllSchedules(c)
 }
 
-// ListRoles operation middleware
-func (siw *ServerInterfaceWrapper) ListRoles(c *gin.Context) {
+// CreateSchedule operation middleware
+func (siw *ServerInterfaceWrapper) CreateSchedule(c *gin.Context) {
 
 	c.Set(CookieAuthScopes, []string{})
 
@@ -1757,11 +3678,11 @@ func (siw *ServerInterfaceWrapper) ListRoles(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.ListRoles(c)
+	siw.Handler.CreateSchedule(c)
 }
 
-// ListPermissions operation middleware
-func (siw *ServerInterfaceWrapper) ListPermissions(c *gin.Context) {
+// GetHolidayMode operation middleware
+func (siw *ServerInterfaceWrapper) GetHolidayMode(c *gin.Context) {
 
 	c.Set(CookieAuthScopes, []string{})
 
@@ -1776,11 +3697,30 @@ func (siw *ServerInterfaceWrapper) ListPermissions(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.ListPermissions(c)
+	siw.Handler.GetHolidayMode(c)
 }
 
-// GetRolePermissions operation middleware
-func (siw *ServerInterfaceWrapper) GetRolePermissions(c *gin.Context) {
+// SetHolidayMode operation middleware
+func (siw *ServerInterfaceWrapper) SetHolidayMode(c *gin.Context) {
+
+	c.Set(CookieAuthScopes, []string{})
+
+	c.Set(CsrfTokenScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.SetHolidayMode(c)
+}
+
+// DeleteSchedule operation middleware
+func (siw *ServerInterfaceWrapper) DeleteSchedule(c *gin.Context) {
 
 	var err error
 
@@ -1806,11 +3746,11 @@ func (siw *ServerInterfaceWrapper) GetRolePermissions(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetRolePermissions(c, id)
+	siw.Handler.DeleteSchedule(c, id)
 }
 
-// AssignPermission operation middleware
-func (siw *ServerInterfaceWrapper) AssignPermission(c *gin.Context) {
+// GetScheduleById operation middleware
+func (siw *ServerInterfaceWrapper) GetScheduleById(c *gin.Context) {
 
 	var err error
 
@@ -1836,11 +3776,11 @@ func (siw *ServerInterfaceWrapper) AssignPermission(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.AssignPermission(c, id)
+	siw.Handler.GetScheduleById(c, id)
 }
 
-// RemovePermission operation middleware
-func (siw *ServerInterfaceWrapper) RemovePermission(c *gin.Context) {
+// UpdateSchedule operation middleware
+func (siw *ServerInterfaceWrapper) UpdateSchedule(c *gin.Context) {
 
 	var err error
 
@@ -1853,15 +3793,6 @@ func (siw *ServerInterfaceWrapper) RemovePermission(c *gin.Context) {
 		return
 	}
 
-	// ------------- Path parameter "pid" -------------
-	var pid int
-
-	err = runtime.BindStyledParameterWithOptions("simple", "pid", c.Param("pid"), &pid, runtime.BindStyledParameterOptions{Explode: false, Required: true, Type: "integer", Format: ""})
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter pid: %w", err), http.StatusBadRequest)
-		return
-	}
-
 	c.Set(CookieAuthScopes, []string{})
 
 	c.Set(CsrfTokenScopes, []string{})
@@ -1875,7 +3806,7 @@ func (siw *ServerInterfaceWrapper) RemovePermission(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.RemovePermission(c, id, pid)
+	siw.Handler.UpdateSchedule(c, id)
 }
 
 // GetAllSensors operation middleware
@@ -2006,6 +3937,114 @@ func (siw *ServerInterfaceWrapper) GetSensorCommandHistory(c *gin.Context) {
 	siw.Handler.GetSensorCommandHistory(c, id)
 }
 
+// GetSensorDesiredStates operation middleware
+func (siw *ServerInterfaceWrapper) GetSensorDesiredStates(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true, Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(CookieAuthScopes, []string{})
+
+	c.Set(CsrfTokenScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetSensorDesiredStates(c, id)
+}
+
+// DeleteSensorDesiredState operation middleware
+func (siw *ServerInterfaceWrapper) DeleteSensorDesiredState(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true, Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "property" -------------
+	var property string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "property", c.Param("property"), &property, runtime.BindStyledParameterOptions{Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter property: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(CookieAuthScopes, []string{})
+
+	c.Set(CsrfTokenScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteSensorDesiredState(c, id, property)
+}
+
+// SetSensorDesiredState operation middleware
+func (siw *ServerInterfaceWrapper) SetSensorDesiredState(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true, Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "property" -------------
+	var property string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "property", c.Param("property"), &property, runtime.BindStyledParameterOptions{Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter property: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(CookieAuthScopes, []string{})
+
+	c.Set(CsrfTokenScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.SetSensorDesiredState(c, id, property)
+}
+
 // GetSensorMeasurementTypes operation middleware
 func (siw *ServerInterfaceWrapper) GetSensorMeasurementTypes(c *gin.Context) {
 
@@ -2659,11 +4698,43 @@ func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options
 
 	router.GET(options.BaseURL+"/alerts", wrapper.GetAllAlertRules)
 	router.POST(options.BaseURL+"/alerts", wrapper.CreateAlertRule)
+	router.GET(options.BaseURL+"/alerts/compound", wrapper.GetAllCompoundAlertRules)
+	router.POST(options.BaseURL+"/alerts/compound", wrapper.CreateCompoundAlertRule)
+	router.DELETE(options.BaseURL+"/alerts/compound/:id", wrapper.DeleteCompoundAlertRule)
+	router.GET(options.BaseURL+"/alerts/compound/:id", wrapper.GetCompoundAlertRuleById)
+	router.PUT(options.BaseURL+"/alerts/compound/:id", wrapper.UpdateCompoundAlertRule)
+	router.POST(options.BaseURL+"/alerts/compound/:id/acknowledge", wrapper.AcknowledgeCompoundAlertRule)
+	router.DELETE(options.BaseURL+"/alerts/compound/:id/escalation", wrapper.DeleteCompoundAlertEscalationPolicy)
+	router.GET(options.BaseURL+"/alerts/compound/:id/escalation", wrapper.GetCompoundAlertEscalationPolicy)
+	router.PUT(options.BaseURL+"/alerts/compound/:id/escalation", wrapper.SetCompoundAlertEscalationPolicy)
+	router.DELETE(options.BaseURL+"/alerts/compound/:id/recipients", wrapper.DeleteCompoundAlertRecipients)
+	router.GET(options.BaseURL+"/alerts/compound/:id/recipients", wrapper.GetCompoundAlertRecipients)
+	router.PUT(options.BaseURL+"/alerts/compound/:id/recipients", wrapper.SetCompoundAlertRecipients)
+	router.DELETE(options.BaseURL+"/alerts/compound/:id/snooze", wrapper.UnsnoozeCompoundAlertRule)
+	router.POST(options.BaseURL+"/alerts/compound/:id/snooze", wrapper.SnoozeCompoundAlertRule)
+	router.GET(options.BaseURL+"/alerts/contact-groups", wrapper.GetAllContactGroups)
+	router.POST(options.BaseURL+"/alerts/contact-groups", wrapper.CreateContactGroup)
+	router.DELETE(options.BaseURL+"/alerts/contact-groups/:id", wrapper.DeleteContactGroup)
+	router.PUT(options.BaseURL+"/alerts/contact-groups/:id", wrapper.UpdateContactGroup)
+	router.POST(options.BaseURL+"/alerts/history/:id/acknowledge", wrapper.AcknowledgeAlertHistoryEntry)
 	router.GET(options.BaseURL+"/alerts/sensor/:sensorId", wrapper.GetAlertRulesBySensorId)
 	router.GET(options.BaseURL+"/alerts/sensor/:sensorId/history", wrapper.GetAlertHistory)
+	router.GET(options.BaseURL+"/alerts/silences", wrapper.GetAllSilenceWindows)
+	router.POST(options.BaseURL+"/alerts/silences", wrapper.CreateSilenceWindow)
+	router.DELETE(options.BaseURL+"/alerts/silences/:id", wrapper.DeleteSilenceWindow)
+	router.PUT(options.BaseURL+"/alerts/silences/:id", wrapper.UpdateSilenceWindow)
 	router.DELETE(options.BaseURL+"/alerts/:id", wrapper.DeleteAlertRule)
 	router.GET(options.BaseURL+"/alerts/:id", wrapper.GetAlertRuleById)
 	router.PUT(options.BaseURL+"/alerts/:id", wrapper.UpdateAlertRule)
+	router.POST(options.BaseURL+"/alerts/:id/acknowledge", wrapper.AcknowledgeAlertRule)
+	router.DELETE(options.BaseURL+"/alerts/:id/escalation", wrapper.DeleteAlertEscalationPolicy)
+	router.GET(options.BaseURL+"/alerts/:id/escalation", wrapper.GetAlertEscalationPolicy)
+	router.PUT(options.BaseURL+"/alerts/:id/escalation", wrapper.SetAlertEscalationPolicy)
+	router.DELETE(options.BaseURL+"/alerts/:id/recipients", wrapper.DeleteAlertRecipients)
+	router.GET(options.BaseURL+"/alerts/:id/recipients", wrapper.GetAlertRecipients)
+	router.PUT(options.BaseURL+"/alerts/:id/recipients", wrapper.SetAlertRecipients)
+	router.DELETE(options.BaseURL+"/alerts/:id/snooze", wrapper.UnsnoozeAlertRule)
+	router.POST(options.BaseURL+"/alerts/:id/snooze", wrapper.SnoozeAlertRule)
 	router.GET(options.BaseURL+"/api-keys", wrapper.ListApiKeys)
 	router.POST(options.BaseURL+"/api-keys", wrapper.CreateApiKey)
 	router.DELETE(options.BaseURL+"/api-keys/:id", wrapper.DeleteApiKey)
@@ -2674,6 +4745,11 @@ func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options
 	router.GET(options.BaseURL+"/auth/me", wrapper.GetCurrentUser)
 	router.GET(options.BaseURL+"/auth/sessions", wrapper.ListSessions)
 	router.DELETE(options.BaseURL+"/auth/sessions/:id", wrapper.RevokeSession)
+	router.GET(options.BaseURL+"/automations", wrapper.GetAllAutomationRules)
+	router.POST(options.BaseURL+"/automations", wrapper.CreateAutomationRule)
+	router.DELETE(options.BaseURL+"/automations/:id", wrapper.DeleteAutomationRule)
+	router.GET(options.BaseURL+"/automations/:id", wrapper.GetAutomationRuleById)
+	router.PUT(options.BaseURL+"/automations/:id", wrapper.UpdateAutomationRule)
 	router.GET(options.BaseURL+"/dashboards", wrapper.ListDashboards)
 	router.POST(options.BaseURL+"/dashboards", wrapper.CreateDashboard)
 	router.DELETE(options.BaseURL+"/dashboards/:id", wrapper.DeleteDashboard)
@@ -2684,6 +4760,8 @@ func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options
 	router.GET(options.BaseURL+"/drivers", wrapper.ListDrivers)
 	router.GET(options.BaseURL+"/health", wrapper.GetHealth)
 	router.GET(options.BaseURL+"/measurement-types", wrapper.GetAllMeasurementTypes)
+	router.DELETE(options.BaseURL+"/measurement-types/:name/downsampling", wrapper.DeleteMeasurementTypeDownsampling)
+	router.PUT(options.BaseURL+"/measurement-types/:name/downsampling", wrapper.SetMeasurementTypeDownsampling)
 	router.GET(options.BaseURL+"/mqtt/brokers", wrapper.ListMqttBrokers)
 	router.POST(options.BaseURL+"/mqtt/brokers", wrapper.CreateMqttBroker)
 	router.DELETE(options.BaseURL+"/mqtt/brokers/:id", wrapper.DeleteMqttBroker)
@@ -2700,7 +4778,20 @@ func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options
 	router.POST(options.BaseURL+"/notifications/bulk/read", wrapper.BulkMarkAsRead)
 	router.GET(options.BaseURL+"/notifications/preferences", wrapper.GetChannelPreferences)
 	router.POST(options.BaseURL+"/notifications/preferences", wrapper.SetChannelPreference)
+	router.DELETE(options.BaseURL+"/notifications/push", wrapper.DeletePushSubscription)
+	router.GET(options.BaseURL+"/notifications/push", wrapper.GetPushSubscription)
+	router.PUT(options.BaseURL+"/notifications/push", wrapper.SetPushSubscription)
+	router.POST(options.BaseURL+"/notifications/push/test", wrapper.TestPushSubscription)
+	router.DELETE(options.BaseURL+"/notifications/quiet-hours", wrapper.DeleteQuietHours)
+	router.GET(options.BaseURL+"/notifications/quiet-hours", wrapper.GetQuietHours)
+	router.PUT(options.BaseURL+"/notifications/quiet-hours", wrapper.SetQuietHours)
 	router.GET(options.BaseURL+"/notifications/unread-count", wrapper.GetUnreadCount)
+	router.GET(options.BaseURL+"/notifications/webhooks", wrapper.ListWebhookTargets)
+	router.POST(options.BaseURL+"/notifications/webhooks", wrapper.CreateWebhookTarget)
+	router.DELETE(options.BaseURL+"/notifications/webhooks/:id", wrapper.DeleteWebhookTarget)
+	router.PUT(options.BaseURL+"/notifications/webhooks/:id", wrapper.UpdateWebhookTarget)
+	router.GET(options.BaseURL+"/notifications/webhooks/:id/deliveries", wrapper.GetWebhookDeliveries)
+	router.POST(options.BaseURL+"/notifications/webhooks/:id/test", wrapper.TestWebhookTarget)
 	router.GET(options.BaseURL+"/notifications/ws", wrapper.NotificationsWebSocket)
 	router.POST(options.BaseURL+"/notifications/:id/dismiss", wrapper.DismissNotification)
 	router.POST(options.BaseURL+"/notifications/:id/read", wrapper.MarkAsRead)
@@ -2719,11 +4810,27 @@ func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options
 	router.GET(options.BaseURL+"/roles/:id/permissions", wrapper.GetRolePermissions)
 	router.POST(options.BaseURL+"/roles/:id/permissions", wrapper.AssignPermission)
 	router.DELETE(options.BaseURL+"/roles/:id/permissions/:pid", wrapper.RemovePermission)
+	router.GET(options.BaseURL+"/scenes", wrapper.GetAllScenes)
+	router.POST(options.BaseURL+"/scenes", wrapper.CreateScene)
+	router.DELETE(options.BaseURL+"/scenes/:id", wrapper.DeleteScene)
+	router.GET(options.BaseURL+"/scenes/:id", wrapper.GetSceneById)
+	router.PUT(options.BaseURL+"/scenes/:id", wrapper.UpdateScene)
+	router.POST(options.BaseURL+"/scenes/:id/activate", wrapper.ActivateScene)
+	router.GET(options.BaseURL+"/schedules", wrapper.GetAllSchedules)
+	router.POST(options.BaseURL+"/schedules", wrapper.CreateSchedule)
+	router.GET(options.BaseURL+"/schedules/holiday-mode", wrapper.GetHolidayMode)
+	router.PUT(options.BaseURL+"/schedules/holiday-mode", wrapper.SetHolidayMode)
+	router.DELETE(options.BaseURL+"/schedules/:id", wrapper.DeleteSchedule)
+	router.GET(options.BaseURL+"/schedules/:id", wrapper.GetScheduleById)
+	router.PUT(options.BaseURL+"/schedules/:id", wrapper.UpdateSchedule)
 	router.GET(options.BaseURL+"/sensors", wrapper.GetAllSensors)
 	router.POST(options.BaseURL+"/sensors", wrapper.AddSensor)
 	router.POST(options.BaseURL+"/sensors/approve/:id", wrapper.ApproveSensor)
 	router.GET(options.BaseURL+"/sensors/by-id/:id/capabilities", wrapper.GetSensorCapabilities)
 	router.GET(options.BaseURL+"/sensors/by-id/:id/commands", wrapper.GetSensorCommandHistory)
+	router.GET(options.BaseURL+"/sensors/by-id/:id/desired-states", wrapper.GetSensorDesiredStates)
+	router.DELETE(options.BaseURL+"/sensors/by-id/:id/desired-states/:property", wrapper.DeleteSensorDesiredState)
+	router.PUT(options.BaseURL+"/sensors/by-id/:id/desired-states/:property", wrapper.SetSensorDesiredState)
 	router.GET(options.BaseURL+"/sensors/by-id/:id/measurement-types", wrapper.GetSensorMeasurementTypes)
 	router.POST(options.BaseURL+"/sensors/collect", wrapper.CollectAllSensorReadings)
 	router.POST(options.BaseURL+"/sensors/collect/:sensorName", wrapper.CollectFromSensor)
@@ -18,7 +18,12 @@ const (
 	AggregatedReadingsResponseAggregationFunctionAvg   AggregatedReadingsResponseAggregationFunction = "avg"
 	AggregatedReadingsResponseAggregationFunctionCount AggregatedReadingsResponseAggregationFunction = "count"
 	AggregatedReadingsResponseAggregationFunctionLast  AggregatedReadingsResponseAggregationFunction = "last"
+	AggregatedReadingsResponseAggregationFunctionMax   AggregatedReadingsResponseAggregationFunction = "max"
+	AggregatedReadingsResponseAggregationFunctionMin   AggregatedReadingsResponseAggregationFunction = "min"
 	AggregatedReadingsResponseAggregationFunctionNone  AggregatedReadingsResponseAggregationFunction = "none"
+	AggregatedReadingsResponseAggregationFunctionP50   AggregatedReadingsResponseAggregationFunction = "p50"
+	AggregatedReadingsResponseAggregationFunctionP95   AggregatedReadingsResponseAggregationFunction = "p95"
+	AggregatedReadingsResponseAggregationFunctionSum   AggregatedReadingsResponseAggregationFunction = "sum"
 )
 
 // Valid indicates whether the value is a known member of the AggregatedReadingsResponseAggregationFunction enum.
@@ -30,8 +35,18 @@ func (e AggregatedReadingsResponseAggregationFunction) Valid() bool {
 		return true
 	case AggregatedReadingsResponseAggregationFunctionLast:
 		return true
+	case AggregatedReadingsResponseAggregationFunctionMax:
+		return true
+	case AggregatedReadingsResponseAggregationFunctionMin:
+		return true
 	case AggregatedReadingsResponseAggregationFunctionNone:
 		return true
+	case AggregatedReadingsResponseAggregationFunctionP50:
+		return true
+	case AggregatedReadingsResponseAggregationFunctionP95:
+		return true
+	case AggregatedReadingsResponseAggregationFunctionSum:
+		return true
 	default:
 		return false
 	}
@@ -70,10 +85,42 @@ func (e AggregatedReadingsResponseAggregationInterval) Valid() bool {
 	}
 }
 
+// Defines values for AlertConditionComparison.
+const (
+	ComparisonEqual              AlertConditionComparison = "=="
+	ComparisonGreaterThan        AlertConditionComparison = ">"
+	ComparisonGreaterThanOrEqual AlertConditionComparison = ">="
+	ComparisonLessThan           AlertConditionComparison = "<"
+	ComparisonLessThanOrEqual    AlertConditionComparison = "<="
+	ComparisonNotEqual           AlertConditionComparison = "!="
+)
+
+// Valid indicates whether the value is a known member of the AlertConditionComparison enum.
+func (e AlertConditionComparison) Valid() bool {
+	switch e {
+	case ComparisonEqual:
+		return true
+	case ComparisonGreaterThan:
+		return true
+	case ComparisonGreaterThanOrEqual:
+		return true
+	case ComparisonLessThan:
+		return true
+	case ComparisonLessThanOrEqual:
+		return true
+	case ComparisonNotEqual:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for AlertRuleAlertType.
 const (
-	NumericRange AlertRuleAlertType = "numeric_range"
-	StatusBased  AlertRuleAlertType = "status_based"
+	NumericRange   AlertRuleAlertType = "numeric_range"
+	RateOfChange   AlertRuleAlertType = "rate_of_change"
+	StatusBased    AlertRuleAlertType = "status_based"
+	SustainedRange AlertRuleAlertType = "sustained_range"
 )
 
 // Valid indicates whether the value is a known member of the AlertRuleAlertType enum.
@@ -81,8 +128,138 @@ func (e AlertRuleAlertType) Valid() bool {
 	switch e {
 	case NumericRange:
 		return true
+	case RateOfChange:
+		return true
 	case StatusBased:
 		return true
+	case SustainedRange:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for AlertRuleState.
+const (
+	Firing   AlertRuleState = "firing"
+	Ok       AlertRuleState = "ok"
+	Resolved AlertRuleState = "resolved"
+)
+
+// Valid indicates whether the value is a known member of the AlertRuleState enum.
+func (e AlertRuleState) Valid() bool {
+	switch e {
+	case Firing:
+		return true
+	case Ok:
+		return true
+	case Resolved:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for AutomationRuleComparison.
+const (
+	AutomationComparisonEqual              AutomationRuleComparison = "=="
+	AutomationComparisonGreaterThan        AutomationRuleComparison = ">"
+	AutomationComparisonGreaterThanOrEqual AutomationRuleComparison = ">="
+	AutomationComparisonLessThan           AutomationRuleComparison = "<"
+	AutomationComparisonLessThanOrEqual    AutomationRuleComparison = "<="
+	AutomationComparisonNotEqual           AutomationRuleComparison = "!="
+)
+
+// Valid indicates whether the value is a known member of the AutomationRuleComparison enum.
+func (e AutomationRuleComparison) Valid() bool {
+	switch e {
+	case AutomationComparisonEqual:
+		return true
+	case AutomationComparisonGreaterThan:
+		return true
+	case AutomationComparisonGreaterThanOrEqual:
+		return true
+	case AutomationComparisonLessThan:
+		return true
+	case AutomationComparisonLessThanOrEqual:
+		return true
+	case AutomationComparisonNotEqual:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for AutomationRuleScheduleDays.
+const (
+	AutomationRuleScheduleDaysFri AutomationRuleScheduleDays = "fri"
+	AutomationRuleScheduleDaysMon AutomationRuleScheduleDays = "mon"
+	AutomationRuleScheduleDaysSat AutomationRuleScheduleDays = "sat"
+	AutomationRuleScheduleDaysSun AutomationRuleScheduleDays = "sun"
+	AutomationRuleScheduleDaysThu AutomationRuleScheduleDays = "thu"
+	AutomationRuleScheduleDaysTue AutomationRuleScheduleDays = "tue"
+	AutomationRuleScheduleDaysWed AutomationRuleScheduleDays = "wed"
+)
+
+// Valid indicates whether the value is a known member of the AutomationRuleScheduleDays enum.
+func (e AutomationRuleScheduleDays) Valid() bool {
+	switch e {
+	case AutomationRuleScheduleDaysFri:
+		return true
+	case AutomationRuleScheduleDaysMon:
+		return true
+	case AutomationRuleScheduleDaysSat:
+		return true
+	case AutomationRuleScheduleDaysSun:
+		return true
+	case AutomationRuleScheduleDaysThu:
+		return true
+	case AutomationRuleScheduleDaysTue:
+		return true
+	case AutomationRuleScheduleDaysWed:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for AutomationRuleScheduleHoliday.
+const (
+	AutomationScheduleHolidayOnly AutomationRuleScheduleHoliday = "only"
+	AutomationScheduleHolidayRun  AutomationRuleScheduleHoliday = "run"
+	AutomationScheduleHolidaySkip AutomationRuleScheduleHoliday = "skip"
+)
+
+// Valid indicates whether the value is a known member of the AutomationRuleScheduleHoliday enum.
+func (e AutomationRuleScheduleHoliday) Valid() bool {
+	switch e {
+	case AutomationScheduleHolidayOnly:
+		return true
+	case AutomationScheduleHolidayRun:
+		return true
+	case AutomationScheduleHolidaySkip:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for AutomationRuleTriggerType.
+const (
+	AutomationTriggerAlert    AutomationRuleTriggerType = "alert"
+	AutomationTriggerReading  AutomationRuleTriggerType = "reading"
+	AutomationTriggerSchedule AutomationRuleTriggerType = "schedule"
+)
+
+// Valid indicates whether the value is a known member of the AutomationRuleTriggerType enum.
+func (e AutomationRuleTriggerType) Valid() bool {
+	switch e {
+	case AutomationTriggerAlert:
+		return true
+	case AutomationTriggerReading:
+		return true
+	case AutomationTriggerSchedule:
+		return true
 	default:
 		return false
 	}
@@ -130,6 +307,27 @@ func (e ChannelPreferenceCategory) Valid() bool {
 	}
 }
 
+// Defines values for ChannelPreferenceEmailDigest.
+const (
+	Daily  ChannelPreferenceEmailDigest = "daily"
+	Hourly ChannelPreferenceEmailDigest = "hourly"
+	Off    ChannelPreferenceEmailDigest = "off"
+)
+
+// Valid indicates whether the value is a known member of the ChannelPreferenceEmailDigest enum.
+func (e ChannelPreferenceEmailDigest) Valid() bool {
+	switch e {
+	case Daily:
+		return true
+	case Hourly:
+		return true
+	case Off:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for CommandHistoryEntryStatus.
 const (
 	CommandHistoryEntryStatusAcknowledged CommandHistoryEntryStatus = "acknowledged"
@@ -154,6 +352,114 @@ func (e CommandHistoryEntryStatus) Valid() bool {
 	}
 }
 
+// Defines values for CommandScheduleHoliday.
+const (
+	ScheduleHolidayOnly CommandScheduleHoliday = "only"
+	ScheduleHolidayRun  CommandScheduleHoliday = "run"
+	ScheduleHolidaySkip CommandScheduleHoliday = "skip"
+)
+
+// Valid indicates whether the value is a known member of the CommandScheduleHoliday enum.
+func (e CommandScheduleHoliday) Valid() bool {
+	switch e {
+	case ScheduleHolidayOnly:
+		return true
+	case ScheduleHolidayRun:
+		return true
+	case ScheduleHolidaySkip:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for CompoundAlertRuleOperator.
+const (
+	And CompoundAlertRuleOperator = "and"
+	Or  CompoundAlertRuleOperator = "or"
+)
+
+// Valid indicates whether the value is a known member of the CompoundAlertRuleOperator enum.
+func (e CompoundAlertRuleOperator) Valid() bool {
+	switch e {
+	case And:
+		return true
+	case Or:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for CompoundAlertRuleState.
+const (
+	CompoundAlertFiring   CompoundAlertRuleState = "firing"
+	CompoundAlertOk       CompoundAlertRuleState = "ok"
+	CompoundAlertResolved CompoundAlertRuleState = "resolved"
+)
+
+// Valid indicates whether the value is a known member of the CompoundAlertRuleState enum.
+func (e CompoundAlertRuleState) Valid() bool {
+	switch e {
+	case CompoundAlertFiring:
+		return true
+	case CompoundAlertOk:
+		return true
+	case CompoundAlertResolved:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for DesiredStateStatus.
+const (
+	DesiredStateFailed   DesiredStateStatus = "failed"
+	DesiredStateInSync   DesiredStateStatus = "in_sync"
+	DesiredStatePending  DesiredStateStatus = "pending"
+	DesiredStateRetrying DesiredStateStatus = "retrying"
+	DesiredStateWaiting  DesiredStateStatus = "waiting"
+)
+
+// Valid indicates whether the value is a known member of the DesiredStateStatus enum.
+func (e DesiredStateStatus) Valid() bool {
+	switch e {
+	case DesiredStateFailed:
+		return true
+	case DesiredStateInSync:
+		return true
+	case DesiredStatePending:
+		return true
+	case DesiredStateRetrying:
+		return true
+	case DesiredStateWaiting:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for EscalationStepChannels.
+const (
+	EscalationChannelEmail   EscalationStepChannels = "email"
+	EscalationChannelPush    EscalationStepChannels = "push"
+	EscalationChannelWebhook EscalationStepChannels = "webhook"
+)
+
+// Valid indicates whether the value is a known member of the EscalationStepChannels enum.
+func (e EscalationStepChannels) Valid() bool {
+	switch e {
+	case EscalationChannelEmail:
+		return true
+	case EscalationChannelPush:
+		return true
+	case EscalationChannelWebhook:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for MeasurementTypeCategory.
 const (
 	MeasurementTypeCategoryBinary  MeasurementTypeCategory = "binary"
@@ -172,6 +478,33 @@ func (e MeasurementTypeCategory) Valid() bool {
 	}
 }
 
+// Defines values for MeasurementTypeDownsamplingInterval.
+const (
+	DownsampleP1D   MeasurementTypeDownsamplingInterval = "P1D"
+	DownsamplePT15M MeasurementTypeDownsamplingInterval = "PT15M"
+	DownsamplePT1H  MeasurementTypeDownsamplingInterval = "PT1H"
+	DownsamplePT1M  MeasurementTypeDownsamplingInterval = "PT1M"
+	DownsamplePT5M  MeasurementTypeDownsamplingInterval = "PT5M"
+)
+
+// Valid indicates whether the value is a known member of the MeasurementTypeDownsamplingInterval enum.
+func (e MeasurementTypeDownsamplingInterval) Valid() bool {
+	switch e {
+	case DownsampleP1D:
+		return true
+	case DownsamplePT15M:
+		return true
+	case DownsamplePT1H:
+		return true
+	case DownsamplePT1M:
+		return true
+	case DownsamplePT5M:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for NotificationCategory.
 const (
 	NotificationCategoryConfigChange   NotificationCategory = "config_change"
@@ -214,6 +547,72 @@ func (e NotificationSeverity) Valid() bool {
 	}
 }
 
+// Defines values for PushSubscriptionProvider.
+const (
+	Gotify PushSubscriptionProvider = "gotify"
+	Ntfy   PushSubscriptionProvider = "ntfy"
+)
+
+// Valid indicates whether the value is a known member of the PushSubscriptionProvider enum.
+func (e PushSubscriptionProvider) Valid() bool {
+	switch e {
+	case Gotify:
+		return true
+	case Ntfy:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for SceneActivationStatus.
+const (
+	SceneActivationFailed    SceneActivationStatus = "failed"
+	SceneActivationPartial   SceneActivationStatus = "partial"
+	SceneActivationPending   SceneActivationStatus = "pending"
+	SceneActivationSucceeded SceneActivationStatus = "succeeded"
+)
+
+// Valid indicates whether the value is a known member of the SceneActivationStatus enum.
+func (e SceneActivationStatus) Valid() bool {
+	switch e {
+	case SceneActivationFailed:
+		return true
+	case SceneActivationPartial:
+		return true
+	case SceneActivationPending:
+		return true
+	case SceneActivationSucceeded:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for SceneCommandOutcomeStatus.
+const (
+	SceneCommandAcknowledged SceneCommandOutcomeStatus = "acknowledged"
+	SceneCommandFailed       SceneCommandOutcomeStatus = "failed"
+	SceneCommandSent         SceneCommandOutcomeStatus = "sent"
+	SceneCommandTimedOut     SceneCommandOutcomeStatus = "timed_out"
+)
+
+// Valid indicates whether the value is a known member of the SceneCommandOutcomeStatus enum.
+func (e SceneCommandOutcomeStatus) Valid() bool {
+	switch e {
+	case SceneCommandAcknowledged:
+		return true
+	case SceneCommandFailed:
+		return true
+	case SceneCommandSent:
+		return true
+	case SceneCommandTimedOut:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for SensorStatus.
 const (
 	SensorStatusActive    SensorStatus = "active"
@@ -271,6 +670,78 @@ func (e SensorHealthStatus) Valid() bool {
 	}
 }
 
+// Defines values for SilenceWindowDays.
+const (
+	SilenceWindowDaysFri SilenceWindowDays = "fri"
+	SilenceWindowDaysMon SilenceWindowDays = "mon"
+	SilenceWindowDaysSat SilenceWindowDays = "sat"
+	SilenceWindowDaysSun SilenceWindowDays = "sun"
+	SilenceWindowDaysThu SilenceWindowDays = "thu"
+	SilenceWindowDaysTue SilenceWindowDays = "tue"
+	SilenceWindowDaysWed SilenceWindowDays = "wed"
+)
+
+// Valid indicates whether the value is a known member of the SilenceWindowDays enum.
+func (e SilenceWindowDays) Valid() bool {
+	switch e {
+	case SilenceWindowDaysFri:
+		return true
+	case SilenceWindowDaysMon:
+		return true
+	case SilenceWindowDaysSat:
+		return true
+	case SilenceWindowDaysSun:
+		return true
+	case SilenceWindowDaysThu:
+		return true
+	case SilenceWindowDaysTue:
+		return true
+	case SilenceWindowDaysWed:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for WebhookDeliveryStatus.
+const (
+	Delivered WebhookDeliveryStatus = "delivered"
+	Failed    WebhookDeliveryStatus = "failed"
+)
+
+// Valid indicates whether the value is a known member of the WebhookDeliveryStatus enum.
+func (e WebhookDeliveryStatus) Valid() bool {
+	switch e {
+	case Delivered:
+		return true
+	case Failed:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for WebhookTargetCategories.
+const (
+	ConfigChange   WebhookTargetCategories = "config_change"
+	ThresholdAlert WebhookTargetCategories = "threshold_alert"
+	UserManagement WebhookTargetCategories = "user_management"
+)
+
+// Valid indicates whether the value is a known member of the WebhookTargetCategories enum.
+func (e WebhookTargetCategories) Valid() bool {
+	switch e {
+	case ConfigChange:
+		return true
+	case ThresholdAlert:
+		return true
+	case UserManagement:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for ListDriversParamsType.
 const (
 	Pull ListDriversParamsType = "pull"
@@ -345,6 +816,11 @@ const (
 	GetReadingsBetweenDatesParamsAggregationFunctionAvg   GetReadingsBetweenDatesParamsAggregationFunction = "avg"
 	GetReadingsBetweenDatesParamsAggregationFunctionCount GetReadingsBetweenDatesParamsAggregationFunction = "count"
 	GetReadingsBetweenDatesParamsAggregationFunctionLast  GetReadingsBetweenDatesParamsAggregationFunction = "last"
+	GetReadingsBetweenDatesParamsAggregationFunctionMax   GetReadingsBetweenDatesParamsAggregationFunction = "max"
+	GetReadingsBetweenDatesParamsAggregationFunctionMin   GetReadingsBetweenDatesParamsAggregationFunction = "min"
+	GetReadingsBetweenDatesParamsAggregationFunctionP50   GetReadingsBetweenDatesParamsAggregationFunction = "p50"
+	GetReadingsBetweenDatesParamsAggregationFunctionP95   GetReadingsBetweenDatesParamsAggregationFunction = "p95"
+	GetReadingsBetweenDatesParamsAggregationFunctionSum   GetReadingsBetweenDatesParamsAggregationFunction = "sum"
 )
 
 // Valid indicates whether the value is a known member of the GetReadingsBetweenDatesParamsAggregationFunction enum.
@@ -356,6 +832,49 @@ func (e GetReadingsBetweenDatesParamsAggregationFunction) Valid() bool {
 		return true
 	case GetReadingsBetweenDatesParamsAggregationFunctionLast:
 		return true
+	case GetReadingsBetweenDatesParamsAggregationFunctionMax:
+		return true
+	case GetReadingsBetweenDatesParamsAggregationFunctionMin:
+		return true
+	case GetReadingsBetweenDatesParamsAggregationFunctionP50:
+		return true
+	case GetReadingsBetweenDatesParamsAggregationFunctionP95:
+		return true
+	case GetReadingsBetweenDatesParamsAggregationFunctionSum:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for GetReadingsBetweenDatesParamsStatistics.
+const (
+	StatisticAvg   GetReadingsBetweenDatesParamsStatistics = "avg"
+	StatisticCount GetReadingsBetweenDatesParamsStatistics = "count"
+	StatisticMax   GetReadingsBetweenDatesParamsStatistics = "max"
+	StatisticMin   GetReadingsBetweenDatesParamsStatistics = "min"
+	StatisticP50   GetReadingsBetweenDatesParamsStatistics = "p50"
+	StatisticP95   GetReadingsBetweenDatesParamsStatistics = "p95"
+	StatisticSum   GetReadingsBetweenDatesParamsStatistics = "sum"
+)
+
+// Valid indicates whether the value is a known member of the GetReadingsBetweenDatesParamsStatistics enum.
+func (e GetReadingsBetweenDatesParamsStatistics) Valid() bool {
+	switch e {
+	case StatisticAvg:
+		return true
+	case StatisticCount:
+		return true
+	case StatisticMax:
+		return true
+	case StatisticMin:
+		return true
+	case StatisticP50:
+		return true
+	case StatisticP95:
+		return true
+	case StatisticSum:
+		return true
 	default:
 		return false
 	}
@@ -392,6 +911,9 @@ type AggregatedReadingsResponse struct {
 
 	// Readings The readings, potentially aggregated.
 	Readings []Reading `json:"readings"`
+
+	// Statistics The extra statistics computed for each bucket, as requested. Absent when none were requested or readings are raw.
+	Statistics *[]string `json:"statistics,omitempty"`
 }
 
 // AggregatedReadingsResponseAggregationFunction The aggregate function applied within each bucket. `"none"` when readings are raw.
@@ -400,24 +922,73 @@ type AggregatedReadingsResponseAggregationFunction string
 // AggregatedReadingsResponseAggregationInterval The time-bucket size applied, as an ISO 8601 duration. `"raw"` means no aggregation was applied.
 type AggregatedReadingsResponseAggregationInterval string
 
+// AlertCondition One condition of a compound alert rule
+type AlertCondition struct {
+	// Comparison Comparison applied to the latest reading
+	Comparison        AlertConditionComparison `json:"Comparison"`
+	ID                *int                     `json:"ID,omitempty"`
+	MeasurementType   *string                  `json:"MeasurementType,omitempty"`
+	MeasurementTypeID int                      `json:"MeasurementTypeID"`
+	SensorID          int                      `json:"SensorID"`
+	SensorName        *string                  `json:"SensorName,omitempty"`
+
+	// Status Text state to compare against; when set only == and != are allowed
+	Status *string `json:"Status,omitempty"`
+
+	// Threshold Numeric value to compare against (numeric conditions)
+	Threshold *float64 `json:"Threshold,omitempty"`
+}
+
+// AlertConditionComparison Comparison applied to the latest reading
+type AlertConditionComparison string
+
 // AlertHistoryEntry Historical alert event
 type AlertHistoryEntry struct {
-	AlertType    string    `json:"alert_type"`
-	Id           int       `json:"id"`
-	ReadingValue string    `json:"reading_value"`
-	SensorId     int       `json:"sensor_id"`
-	SentAt       time.Time `json:"sent_at"`
+	AcknowledgedAt *time.Time `json:"acknowledged_at,omitempty"`
+
+	// AcknowledgedBy Username of the user who acknowledged the alert
+	AcknowledgedBy *string `json:"acknowledged_by,omitempty"`
+	AlertType      string  `json:"alert_type"`
+	Id             int     `json:"id"`
+
+	// PeakValue Most extreme reading seen while the alert was firing
+	PeakValue    *string `json:"peak_value,omitempty"`
+	ReadingValue string  `json:"reading_value"`
+
+	// ResolvedAt When the alert cleared; null while the alert is still firing
+	ResolvedAt *time.Time `json:"resolved_at,omitempty"`
+	SensorId   int        `json:"sensor_id"`
+	SentAt     time.Time  `json:"sent_at"`
+}
+
+// AlertRecipients Who an alert rule's notifications go to: the listed users, every user with one of the listed roles and every member of the listed contact groups. All empty sends them to every user with view_alerts.
+type AlertRecipients struct {
+	ContactGroupIDs *[]int    `json:"ContactGroupIDs,omitempty"`
+	Roles           *[]string `json:"Roles,omitempty"`
+	UserIDs         *[]int    `json:"UserIDs,omitempty"`
 }
 
 // AlertRule Alert rule configuration
 type AlertRule struct {
-	// AlertType Type of alert (threshold-based or status-based)
-	AlertType       AlertRuleAlertType `json:"AlertType"`
-	Enabled         bool               `json:"Enabled"`
-	HighThreshold   float64            `json:"HighThreshold"`
-	ID              int                `json:"ID"`
-	LastAlertSentAt *time.Time         `json:"LastAlertSentAt,omitempty"`
-	LowThreshold    float64            `json:"LowThreshold"`
+	// AlertType Type of alert. numeric_range fires on any reading outside the thresholds,
+	// sustained_range only once readings have stayed outside them for DurationSeconds,
+	// rate_of_change when the value moves by more than ChangeThreshold within
+	// DurationSeconds, and status_based on a matching status.
+	AlertType AlertRuleAlertType `json:"AlertType"`
+
+	// ChangeThreshold Largest allowed change within DurationSeconds (for rate_of_change type)
+	ChangeThreshold *float64 `json:"ChangeThreshold,omitempty"`
+
+	// DurationSeconds Look-back window in seconds (for sustained_range and rate_of_change types)
+	DurationSeconds *int    `json:"DurationSeconds,omitempty"`
+	Enabled         bool    `json:"Enabled"`
+	HighThreshold   float64 `json:"HighThreshold"`
+
+	// Hysteresis Margin a firing alert must clear before it resolves. Range rules resolve once the value is back inside the thresholds by this much; rate_of_change rules once the change falls below ChangeThreshold minus this margin.
+	Hysteresis      *float64   `json:"Hysteresis,omitempty"`
+	ID              int        `json:"ID"`
+	LastAlertSentAt *time.Time `json:"LastAlertSentAt,omitempty"`
+	LowThreshold    float64    `json:"LowThreshold"`
 
 	// MeasurementType Human-readable measurement type name (e.g. "temperature", "battery_low")
 	MeasurementType string `json:"MeasurementType"`
@@ -430,13 +1001,31 @@ type AlertRule struct {
 	SensorID         int    `json:"SensorID"`
 	SensorName       string `json:"SensorName"`
 
+	// SnoozedUntil Notifications are suppressed until this time
+	SnoozedUntil *time.Time `json:"SnoozedUntil,omitempty"`
+
+	// State Current alert state of the rule
+	State *AlertRuleState `json:"State,omitempty"`
+
 	// TriggerStatus Status that triggers alert (for status_based type)
 	TriggerStatus string `json:"TriggerStatus"`
 }
 
-// AlertRuleAlertType Type of alert (threshold-based or status-based)
+// AlertRuleAlertType Type of alert. numeric_range fires on any reading outside the thresholds,
+// sustained_range only once readings have stayed outside them for DurationSeconds,
+// rate_of_change when the value moves by more than ChangeThreshold within
+// DurationSeconds, and status_based on a matching status.
 type AlertRuleAlertType string
 
+// AlertRuleState Current alert state of the rule
+type AlertRuleState string
+
+// AlertSnoozeRequest defines model for AlertSnoozeRequest.
+type AlertSnoozeRequest struct {
+	// Until Time at which notifications resume
+	Until time.Time `json:"until"`
+}
+
 // ApiKey An API key belonging to a user. The full key value is never returned after creation.
 type ApiKey struct {
 	CreatedAt *time.Time `json:"created_at,omitempty"`
@@ -458,6 +1047,72 @@ type ApiKey struct {
 	UserId    *int       `json:"user_id,omitempty"`
 }
 
+// AutomationRule Sends value to the property capability of the controllable sensor target_sensor_id, or activates the scene scene_id, when the trigger fires. Exactly one of target_sensor_id and scene_id is set. Only the fields of the trigger type are used: reading rules fire when a reading of sensor_id/measurement_type_id starts meeting the comparison (so the command is sent once, not on every reading); alert rules fire when the single-sensor alert rule alert_rule_id starts firing; schedule rules fire at schedule_time in the hub's local time on schedule_days, following schedule_holiday while holiday mode is on.
+type AutomationRule struct {
+	// Active Whether the latest watched reading met the condition (reading triggers)
+	Active *bool `json:"active,omitempty"`
+
+	// AlertRuleId Alert rule whose firing triggers the rule (alert triggers)
+	AlertRuleId *int `json:"alert_rule_id,omitempty"`
+
+	// Comparison Comparison applied to each reading (reading triggers)
+	Comparison      *AutomationRuleComparison `json:"comparison,omitempty"`
+	Enabled         bool                      `json:"enabled"`
+	Id              *int                      `json:"id,omitempty"`
+	LastTriggeredAt *time.Time                `json:"last_triggered_at,omitempty"`
+	MeasurementType *string                   `json:"measurement_type,omitempty"`
+
+	// MeasurementTypeId Measurement type whose readings are watched (reading triggers)
+	MeasurementTypeId *int   `json:"measurement_type_id,omitempty"`
+	Name              string `json:"name"`
+
+	// Property Capability property to command, e.g. state (command targets)
+	Property *string `json:"property,omitempty"`
+
+	// SceneId Scene activated instead of sending a single command (scene targets)
+	SceneId   *int    `json:"scene_id,omitempty"`
+	SceneName *string `json:"scene_name,omitempty"`
+
+	// ScheduleDays Days the rule runs on; empty for every day (schedule triggers)
+	ScheduleDays *[]AutomationRuleScheduleDays `json:"schedule_days,omitempty"`
+
+	// ScheduleHoliday Whether the rule is skipped during holiday mode, runs regardless, or runs only then (schedule triggers). Defaults to skip.
+	ScheduleHoliday *AutomationRuleScheduleHoliday `json:"schedule_holiday,omitempty"`
+
+	// ScheduleTime Time of day as HH:MM in the hub's local time (schedule triggers)
+	ScheduleTime *string `json:"schedule_time,omitempty"`
+
+	// SensorId Sensor whose readings are watched (reading triggers)
+	SensorId   *int    `json:"sensor_id,omitempty"`
+	SensorName *string `json:"sensor_name,omitempty"`
+
+	// Status Text state to compare against; when set only == and != are allowed (reading triggers)
+	Status *string `json:"status,omitempty"`
+
+	// TargetSensorId Controllable sensor that receives the command (command targets)
+	TargetSensorId   *int    `json:"target_sensor_id,omitempty"`
+	TargetSensorName *string `json:"target_sensor_name,omitempty"`
+
+	// Threshold Numeric value to compare against (reading triggers)
+	Threshold   *float64                  `json:"threshold,omitempty"`
+	TriggerType AutomationRuleTriggerType `json:"trigger_type"`
+
+	// Value Value to send, e.g. ON (command targets)
+	Value *string `json:"value,omitempty"`
+}
+
+// AutomationRuleComparison Comparison applied to each reading (reading triggers)
+type AutomationRuleComparison string
+
+// AutomationRuleScheduleDays defines model for AutomationRule.ScheduleDays.
+type AutomationRuleScheduleDays string
+
+// AutomationRuleScheduleHoliday Whether the rule is skipped during holiday mode, runs regardless, or runs only then (schedule triggers). Defaults to skip.
+type AutomationRuleScheduleHoliday string
+
+// AutomationRuleTriggerType defines model for AutomationRule.TriggerType.
+type AutomationRuleTriggerType string
+
 // Capability A controllable property exposed by a driver. This is derived from driver metadata and is never user-configurable.
 type Capability struct {
 	// Max Maximum allowed value for numeric capabilities.
@@ -498,15 +1153,36 @@ type ChangePasswordRequest struct {
 
 // ChannelPreference Notification channel preference
 type ChannelPreference struct {
-	Category     ChannelPreferenceCategory `json:"category"`
-	EmailEnabled *bool                     `json:"email_enabled,omitempty"`
-	InappEnabled *bool                     `json:"inapp_enabled,omitempty"`
-	UserId       *int                      `json:"user_id,omitempty"`
+	Category ChannelPreferenceCategory `json:"category"`
+
+	// EmailDigest Whether emails in this category are sent as they happen (off) or batched into an hourly or daily digest; omitted keeps the current setting
+	EmailDigest  *ChannelPreferenceEmailDigest `json:"email_digest,omitempty"`
+	EmailEnabled *bool                         `json:"email_enabled,omitempty"`
+	InappEnabled *bool                         `json:"inapp_enabled,omitempty"`
+
+	// PushEnabled Whether the user's push subscription receives this category; omitted keeps the current setting
+	PushEnabled *bool `json:"push_enabled,omitempty"`
+	UserId      *int  `json:"user_id,omitempty"`
+
+	// WebhookEnabled Whether the user's webhook targets receive this category; omitted keeps the current setting
+	WebhookEnabled *bool `json:"webhook_enabled,omitempty"`
 }
 
 // ChannelPreferenceCategory defines model for ChannelPreference.Category.
 type ChannelPreferenceCategory string
 
+// ChannelPreferenceEmailDigest Whether emails in this category are sent as they happen (off) or batched into an hourly or daily digest; omitted keeps the current setting
+type ChannelPreferenceEmailDigest string
+
+// CommandHistoryAutomationRule Automation rule that issued a command.
+type CommandHistoryAutomationRule struct {
+	// Id Numeric database id of the automation rule.
+	Id int `json:"id"`
+
+	// Name Name of the rule at query time; empty once the rule has been deleted.
+	Name string `json:"name"`
+}
+
 // CommandHistoryEntry Durable audit record for a sensor command.
 type CommandHistoryEntry struct {
 	// AcknowledgedAt RFC3339 timestamp of the first echoed reading that acknowledged the command.
@@ -515,6 +1191,9 @@ type CommandHistoryEntry struct {
 	// AcknowledgedValue Actual value seen on the first echoed reading for the commanded property.
 	AcknowledgedValue *string `json:"acknowledged_value,omitempty"`
 
+	// AutomationRule Automation rule that issued the command, or null for commands not sent by a rule.
+	AutomationRule *CommandHistoryAutomationRule `json:"automation_rule,omitempty"`
+
 	// Id Internal identifier of the persisted command history row.
 	Id int `json:"id"`
 
@@ -527,6 +1206,9 @@ type CommandHistoryEntry struct {
 	// Property Driver-level capability property that was commanded.
 	Property string `json:"property"`
 
+	// Schedule Command schedule that issued the command, or null for commands not sent by a schedule.
+	Schedule *CommandHistorySchedule `json:"schedule,omitempty"`
+
 	// SentAt RFC3339 timestamp when the command was sent.
 	SentAt time.Time `json:"sent_at"`
 
@@ -546,6 +1228,15 @@ type CommandHistoryEntry struct {
 // CommandHistoryEntryStatus Current command status.
 type CommandHistoryEntryStatus string
 
+// CommandHistorySchedule Command schedule that issued a command.
+type CommandHistorySchedule struct {
+	// Id Numeric database id of the schedule.
+	Id int `json:"id"`
+
+	// Name Name of the schedule at query time; empty once the schedule has been deleted.
+	Name string `json:"name"`
+}
+
 // CommandHistoryUser Minimal user identity recorded against a sent command.
 type CommandHistoryUser struct {
 	// Id Numeric database id of the acting user.
@@ -555,6 +1246,69 @@ type CommandHistoryUser struct {
 	Username string `json:"username"`
 }
 
+// CommandSchedule Sends value to the property capability of the controllable sensor sensor_id at every minute matching cron, in the hub's local time. Commands are sent as the system rather than as a user. holiday decides what the schedule does while holiday mode is on. A run that fell due while the hub was down is sent at startup if it is at most 15 minutes late, and skipped otherwise.
+type CommandSchedule struct {
+	// Cron Five-field cron expression (minute hour day-of-month month day-of-week). Fields accept *, values, ranges, steps and lists; months and weekdays also accept names.
+	Cron    string `json:"cron"`
+	Enabled bool   `json:"enabled"`
+
+	// Holiday Whether the schedule is skipped during holiday mode, runs regardless, or runs only then. Defaults to skip.
+	Holiday *CommandScheduleHoliday `json:"holiday,omitempty"`
+	Id      *int                    `json:"id,omitempty"`
+
+	// LastCommandId Command History entry of the last command sent
+	LastCommandId *int `json:"last_command_id,omitempty"`
+
+	// LastError Why the last run sent no command; empty when it did
+	LastError *string    `json:"last_error,omitempty"`
+	LastRunAt *time.Time `json:"last_run_at,omitempty"`
+	Name      string     `json:"name"`
+
+	// NextRunAt Next time the schedule is due; null while it is disabled
+	NextRunAt *time.Time `json:"next_run_at,omitempty"`
+
+	// Property Capability property to command, e.g. state
+	Property string `json:"property"`
+
+	// SensorId Controllable sensor that receives the command
+	SensorId   int     `json:"sensor_id"`
+	SensorName *string `json:"sensor_name,omitempty"`
+
+	// Value Value to send, e.g. ON
+	Value string `json:"value"`
+}
+
+// CommandScheduleHoliday Whether the schedule is skipped during holiday mode, runs regardless, or runs only then. Defaults to skip.
+type CommandScheduleHoliday string
+
+// CompoundAlertRule Alert rule combining conditions across sensors with AND/OR
+type CompoundAlertRule struct {
+	Conditions []AlertCondition `json:"Conditions"`
+	Enabled    bool             `json:"Enabled"`
+
+	// Hysteresis Margin a firing alert must clear before it resolves. Each numeric condition using >, >=, < or <= must come back past its threshold by this much.
+	Hysteresis      *float64                  `json:"Hysteresis,omitempty"`
+	ID              *int                      `json:"ID,omitempty"`
+	LastAlertSentAt *time.Time                `json:"LastAlertSentAt,omitempty"`
+	Name            string                    `json:"Name"`
+	Operator        CompoundAlertRuleOperator `json:"Operator"`
+
+	// RateLimitSeconds Minimum seconds between alerts
+	RateLimitSeconds int `json:"RateLimitSeconds"`
+
+	// SnoozedUntil Notifications are suppressed until this time
+	SnoozedUntil *time.Time `json:"SnoozedUntil,omitempty"`
+
+	// State Current alert state of the rule
+	State *CompoundAlertRuleState `json:"State,omitempty"`
+}
+
+// CompoundAlertRuleOperator defines model for CompoundAlertRule.Operator.
+type CompoundAlertRuleOperator string
+
+// CompoundAlertRuleState Current alert state of the rule
+type CompoundAlertRuleState string
+
 // ConfigFieldSpec Describes a single configuration field that a driver expects.
 type ConfigFieldSpec struct {
 	// Default Default value if not specified.
@@ -576,6 +1330,15 @@ type ConfigFieldSpec struct {
 	Sensitive bool `json:"sensitive"`
 }
 
+// ContactGroup Named group of users that alert rules can notify together
+type ContactGroup struct {
+	ID   *int   `json:"ID,omitempty"`
+	Name string `json:"Name"`
+
+	// UserIDs Members of the group
+	UserIDs []int `json:"UserIDs"`
+}
+
 // CreateDashboardRequest Request body for creating a dashboard
 type CreateDashboardRequest struct {
 	// Config Widget layout and configuration stored as the dashboard config
@@ -627,6 +1390,37 @@ type DashboardWidget struct {
 	Type string `json:"type"`
 }
 
+// DesiredState The value the hub keeps the property capability of a controllable sensor at. status is pending while a command awaits its outcome, in_sync once the device reports value, retrying until next_retry_at when a command timed out, failed or was answered with another value, waiting while the device cannot be reached, and failed once 10 retries have not reached value. A command for the property sent by anyone else, such as a user, automation, schedule or scene, replaces value; a value reported otherwise is sent back to value.
+type DesiredState struct {
+	// Attempts Retries since the capability was last in sync
+	Attempts int `json:"attempts"`
+
+	// CommandId The command the hub last sent for value; its outcome settles status
+	CommandId *int `json:"command_id,omitempty"`
+
+	// LastError Why the desired value was last not reached; empty once in sync
+	LastError   string     `json:"last_error"`
+	NextRetryAt *time.Time `json:"next_retry_at,omitempty"`
+	Property    string     `json:"property"`
+
+	// ReportedValue The value the device last reported; empty until it reports
+	ReportedValue string             `json:"reported_value"`
+	SensorId      int                `json:"sensor_id"`
+	SensorName    string             `json:"sensor_name"`
+	Status        DesiredStateStatus `json:"status"`
+	UpdatedAt     time.Time          `json:"updated_at"`
+	Value         string             `json:"value"`
+}
+
+// DesiredStateStatus defines model for DesiredState.Status.
+type DesiredStateStatus string
+
+// DesiredStateRequest Request body for setting the desired value of a capability.
+type DesiredStateRequest struct {
+	// Value String form of the desired value, as for a sensor command.
+	Value string `json:"value"`
+}
+
 // DriverInfo Metadata and config schema for a sensor driver.
 type DriverInfo struct {
 	// ConfigFields Config fields the driver expects.
@@ -651,6 +1445,32 @@ type ErrorResponse struct {
 	Message string `json:"message"`
 }
 
+// EscalationPolicy Steps taken, in order, while an alert rule's firing notification goes unhandled
+type EscalationPolicy struct {
+	Steps []EscalationStep `json:"Steps"`
+}
+
+// EscalationStep One stage of an escalation policy. If nobody has read, dismissed or acknowledged the alert DelayMinutes after the previous notification, it is sent again as an error.
+type EscalationStep struct {
+	// Channels Send over these channels regardless of channel preferences; empty to follow them
+	Channels *[]EscalationStepChannels `json:"Channels,omitempty"`
+
+	// DelayMinutes Minutes after the previous notification
+	DelayMinutes int `json:"DelayMinutes"`
+
+	// Role Only notify users with this role; empty for everyone who received the alert
+	Role *string `json:"Role,omitempty"`
+}
+
+// EscalationStepChannels defines model for EscalationStep.Channels.
+type EscalationStepChannels string
+
+// HolidayMode While holiday mode is on, schedules with holiday "skip" do not run and schedules with holiday "only" do. until, when set, switches it off automatically.
+type HolidayMode struct {
+	Enabled bool       `json:"enabled"`
+	Until   *time.Time `json:"until,omitempty"`
+}
+
 // LoginRequest Login request body
 type LoginRequest struct {
 	// Password Password
@@ -777,6 +1597,9 @@ type MeasurementType struct {
 	// Name Machine-readable measurement type key.
 	Name string `json:"name"`
 
+	// RetentionDownsampleInterval Bucket interval that expired raw readings are downsampled to instead of being deleted. Absent when they are deleted.
+	RetentionDownsampleInterval *string `json:"retention_downsample_interval,omitempty"`
+
 	// SupportedAggregationFunctions List of aggregation functions supported by this measurement type. The `aggregation_function` query parameter on GET /readings/between must be one of these values (or omitted to use the default).
 	SupportedAggregationFunctions []string `json:"supported_aggregation_functions"`
 
@@ -787,6 +1610,15 @@ type MeasurementType struct {
 // MeasurementTypeCategory Whether this measurement type produces numeric or binary values.
 type MeasurementTypeCategory string
 
+// MeasurementTypeDownsampling Retention downsampling for a measurement type
+type MeasurementTypeDownsampling struct {
+	// Interval Bucket interval that expired raw readings are downsampled to
+	Interval MeasurementTypeDownsamplingInterval `json:"interval"`
+}
+
+// MeasurementTypeDownsamplingInterval Bucket interval that expired raw readings are downsampled to
+type MeasurementTypeDownsamplingInterval string
+
 // Notification Notification base object
 type Notification struct {
 	Category  NotificationCategory    `json:"category"`
@@ -841,6 +1673,30 @@ type PermissionInfo struct {
 // Sensitive values are masked ("*****") in responses.
 type PropertiesMap map[string]string
 
+// PushSubscription A user's push notification configuration. ntfy subscriptions publish to topic on server_url, with token as an optional access token; Gotify subscriptions post to server_url with token as the application token.
+type PushSubscription struct {
+	Enabled   bool                     `json:"enabled"`
+	HasToken  *bool                    `json:"has_token,omitempty"`
+	Provider  PushSubscriptionProvider `json:"provider"`
+	ServerUrl string                   `json:"server_url"`
+
+	// Token ntfy access token or Gotify application token
+	Token *string `json:"token,omitempty"`
+
+	// Topic ntfy topic; unused for Gotify
+	Topic *string `json:"topic,omitempty"`
+}
+
+// PushSubscriptionProvider defines model for PushSubscription.Provider.
+type PushSubscriptionProvider string
+
+// QuietHours Daily period during which the user's notification emails are held back and sent together when it ends. Error-severity notifications are still sent immediately. Times are HH:MM in the hub's local time; an end before the start runs past midnight.
+type QuietHours struct {
+	Enabled bool   `json:"enabled"`
+	End     string `json:"end"`
+	Start   string `json:"start"`
+}
+
 // RateLimitResponse Rate limit exceeded response
 type RateLimitResponse struct {
 	Exponent     *int    `json:"exponent,omitempty"`
@@ -873,6 +1729,9 @@ type Reading struct {
 	// SensorName Human-readable sensor name. This is the series key an MCP should use to group measurements.
 	SensorName string `json:"sensor_name"`
 
+	// Statistics The requested extra statistics of an aggregated bucket, keyed by function (e.g. `min`, `max`, `p95`). A statistic is left out when the bucket has no numeric values.
+	Statistics *map[string]float64 `json:"statistics,omitempty"`
+
 	// TextState Textual state value (null for numeric-only readings).
 	TextState *string `json:"text_state"`
 
@@ -889,6 +1748,62 @@ type RoleInfo struct {
 	Name string `json:"name"`
 }
 
+// Scene A named set of commands to controllable sensors that are sent together when the scene is activated, such as "Movie night": lamp off, TV plug on, dimmer to 20.
+type Scene struct {
+	Commands        []SceneCommand `json:"commands"`
+	Description     *string        `json:"description,omitempty"`
+	Id              *int           `json:"id,omitempty"`
+	LastActivatedAt *time.Time     `json:"last_activated_at,omitempty"`
+
+	// LastActivationStatus Aggregated outcome of the last activation whose commands all finished; empty before then
+	LastActivationStatus *string `json:"last_activation_status,omitempty"`
+	Name                 string  `json:"name"`
+}
+
+// SceneActivation The outcome of activating a scene. status aggregates the outcomes of its commands: pending while any command awaits its outcome, succeeded when all were acknowledged, failed when none were, and partial otherwise.
+type SceneActivation struct {
+	ActivatedAt time.Time             `json:"activated_at"`
+	Commands    []SceneCommandOutcome `json:"commands"`
+	SceneId     int                   `json:"scene_id"`
+	SceneName   string                `json:"scene_name"`
+	Status      SceneActivationStatus `json:"status"`
+}
+
+// SceneActivationStatus defines model for SceneActivation.Status.
+type SceneActivationStatus string
+
+// SceneCommand One command of a scene. A scene sets each capability of a sensor at most once.
+type SceneCommand struct {
+	// Property Capability property to command, e.g. state
+	Property string `json:"property"`
+
+	// SensorId Controllable sensor that receives the command
+	SensorId   int     `json:"sensor_id"`
+	SensorName *string `json:"sensor_name,omitempty"`
+
+	// Value Value to send, e.g. ON
+	Value string `json:"value"`
+}
+
+// SceneCommandOutcome The outcome of one command of a scene activation.
+type SceneCommandOutcome struct {
+	AcknowledgedValue *string `json:"acknowledged_value,omitempty"`
+
+	// CommandId Command History entry of the command; null when it could not be sent
+	CommandId *int `json:"command_id,omitempty"`
+
+	// Error Why the command could not be sent; empty when it was
+	Error      *string                   `json:"error,omitempty"`
+	Property   string                    `json:"property"`
+	SensorId   int                       `json:"sensor_id"`
+	SensorName string                    `json:"sensor_name"`
+	Status     SceneCommandOutcomeStatus `json:"status"`
+	Value      string                    `json:"value"`
+}
+
+// SceneCommandOutcomeStatus defines model for SceneCommandOutcome.Status.
+type SceneCommandOutcomeStatus string
+
 // Sensor Metadata for a sensor as returned by sensors endpoints and WebSocket snapshots.
 type Sensor struct {
 	// Capabilities Controllable properties for this sensor. Empty if the sensor is not controllable. Derived from driver metadata and ignored on create/update requests.
@@ -903,6 +1818,9 @@ type Sensor struct {
 	// Enabled Whether the sensor is enabled for collection.
 	Enabled bool `json:"enabled"`
 
+	// ExpectedIntervalSeconds Per-sensor override of how often the sensor is expected to report, in seconds. The stale-sensor watchdog marks the sensor unhealthy once its last reading is older than this. Set to null to fall back to the driver default or `sensor.stale.default.interval.seconds`.
+	ExpectedIntervalSeconds *int `json:"expected_interval_seconds,omitempty"`
+
 	// ExternalId Immutable device identifier for push-based sensors (e.g. MQTT). Set at auto-discovery and never changes, even if the sensor is renamed. Null for pull-based sensors.
 	ExternalId *string `json:"external_id,omitempty"`
 
@@ -998,6 +1916,28 @@ type ShareDashboardRequest struct {
 	TargetUserId int `json:"target_user_id"`
 }
 
+// SilenceWindow Recurring daily period during which alert notifications are suppressed, for one sensor or for every sensor when SensorID is null.
+type SilenceWindow struct {
+	// Days Days the window starts on; empty for every day
+	Days    *[]SilenceWindowDays `json:"Days,omitempty"`
+	Enabled bool                 `json:"Enabled"`
+
+	// EndTime End time as HH:MM; a window ending before it starts runs past midnight
+	EndTime string `json:"EndTime"`
+	ID      *int   `json:"ID,omitempty"`
+	Name    string `json:"Name"`
+
+	// SensorID Sensor the window applies to; null for every sensor
+	SensorID   *int    `json:"SensorID,omitempty"`
+	SensorName *string `json:"SensorName,omitempty"`
+
+	// StartTime Start time as HH:MM in the hub's local time
+	StartTime string `json:"StartTime"`
+}
+
+// SilenceWindowDays defines model for SilenceWindow.Days.
+type SilenceWindowDays string
+
 // SuccessMessage Generic success response
 type SuccessMessage struct {
 	Message string `json:"message"`
@@ -1040,6 +1980,51 @@ type UserNotification struct {
 	UserId         *int          `json:"user_id,omitempty"`
 }
 
+// WebhookDelivery Outcome of delivering one notification to a webhook target, after retries
+type WebhookDelivery struct {
+	Attempts  int       `json:"attempts"`
+	CreatedAt time.Time `json:"created_at"`
+	Error     string    `json:"error"`
+	Id        int       `json:"id"`
+
+	// NotificationId Delivered notification; null for test deliveries
+	NotificationId *int `json:"notification_id,omitempty"`
+
+	// ResponseCode HTTP status of the last attempt; null if no response was received
+	ResponseCode *int                  `json:"response_code,omitempty"`
+	Status       WebhookDeliveryStatus `json:"status"`
+	TargetId     int                   `json:"target_id"`
+}
+
+// WebhookDeliveryStatus defines model for WebhookDelivery.Status.
+type WebhookDeliveryStatus string
+
+// WebhookTarget Outbound webhook that notifications are POSTed to. A user's target receives their notifications in categories where their webhook channel is enabled; a global target receives every notification in its categories.
+type WebhookTarget struct {
+	// BodyTemplate Go text/template for the request body; empty sends the notification as JSON
+	BodyTemplate *string `json:"body_template,omitempty"`
+
+	// Categories Categories the target receives; empty for all
+	Categories *[]WebhookTargetCategories `json:"categories,omitempty"`
+	Enabled    bool                       `json:"enabled"`
+
+	// Global Whether the target is global rather than owned by a user; fixed at creation
+	Global    *bool `json:"global,omitempty"`
+	HasSecret *bool `json:"has_secret,omitempty"`
+
+	// Headers Extra request headers
+	Headers *map[string]string `json:"headers,omitempty"`
+	Id      *int               `json:"id,omitempty"`
+	Name    string             `json:"name"`
+
+	// Secret Key for the X-SensorHub-Signature-256 HMAC-SHA256 header; empty to send unsigned
+	Secret *string `json:"secret,omitempty"`
+	Url    string  `json:"url"`
+}
+
+// WebhookTargetCategories defines model for WebhookTarget.Categories.
+type WebhookTargetCategories string
+
 // GetAlertHistoryParams defines parameters for GetAlertHistory.
 type GetAlertHistoryParams struct {
 	// Limit Maximum number of records to return (default 50, max 100)
@@ -1097,6 +2082,12 @@ type ListNotificationsParams struct {
 // ListNotificationsParamsIncludeDismissed defines parameters for ListNotifications.
 type ListNotificationsParamsIncludeDismissed string
 
+// GetWebhookDeliveriesParams defines parameters for GetWebhookDeliveries.
+type GetWebhookDeliveriesParams struct {
+	// Limit Maximum number of records to return (default 50, max 100)
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
 // GetReadingsBetweenDatesParams defines parameters for GetReadingsBetweenDates.
 type GetReadingsBetweenDatesParams struct {
 	// Start Start of the range. Accepts either a date (YYYY-MM-DD, treated as start of day UTC) or a full ISO 8601 datetime (e.g. 2026-01-01T10:00:00Z).
@@ -1114,8 +2105,11 @@ type GetReadingsBetweenDatesParams struct {
 	// Aggregation Override the automatic aggregation interval. Use an ISO 8601 duration such as `PT5M` (5 minutes) or `PT1H` (1 hour). Pass `raw` to force unaggregated readings regardless of span.
 	Aggregation *GetReadingsBetweenDatesParamsAggregation `form:"aggregation,omitempty" json:"aggregation,omitempty"`
 
-	// AggregationFunction Override the aggregation function. Defaults are looked up per-measurement-type (e.g. `avg` for temperature, `last` for binary sensors). Only meaningful when aggregation is not `raw`.
+	// AggregationFunction Override the aggregation function. Defaults are looked up per-measurement-type (e.g. `avg` for temperature, `last` for binary sensors). `p50` and `p95` are nearest-rank percentiles. Only meaningful when aggregation is not `raw`.
 	AggregationFunction *GetReadingsBetweenDatesParamsAggregationFunction `form:"aggregation_function,omitempty" json:"aggregation_function,omitempty"`
+
+	// Statistics Extra statistics to compute for each bucket alongside the aggregation function, returned in each reading's `statistics` (e.g. `min,avg,max` for band charts). Each must be supported by the measurement type. Ignored when readings are raw.
+	Statistics *[]GetReadingsBetweenDatesParamsStatistics `form:"statistics,omitempty" json:"statistics,omitempty"`
 }
 
 // GetReadingsBetweenDatesParamsAggregation defines parameters for GetReadingsBetweenDates.
@@ -1124,11 +2118,20 @@ type GetReadingsBetweenDatesParamsAggregation string
 // GetReadingsBetweenDatesParamsAggregationFunction defines parameters for GetReadingsBetweenDates.
 type GetReadingsBetweenDatesParamsAggregationFunction string
 
+// GetReadingsBetweenDatesParamsStatistics defines parameters for GetReadingsBetweenDates.
+type GetReadingsBetweenDatesParamsStatistics string
+
 // AssignPermissionJSONBody defines parameters for AssignPermission.
 type AssignPermissionJSONBody struct {
 	PermissionId int `json:"permission_id"`
 }
 
+// ActivateSceneParams defines parameters for ActivateScene.
+type ActivateSceneParams struct {
+	// Wait Wait for the outcome of every command before responding
+	Wait *bool `form:"wait,omitempty" json:"wait,omitempty"`
+}
+
 // GetSensorsByStatusParamsStatus defines parameters for GetSensorsByStatus.
 type GetSensorsByStatusParamsStatus string
 
@@ -1145,9 +2148,45 @@ type SetUserRolesJSONBody struct {
 // CreateAlertRuleJSONRequestBody defines body for CreateAlertRule for application/json ContentType.
 type CreateAlertRuleJSONRequestBody = AlertRule
 
+// CreateCompoundAlertRuleJSONRequestBody defines body for CreateCompoundAlertRule for application/json ContentType.
+type CreateCompoundAlertRuleJSONRequestBody = CompoundAlertRule
+
+// UpdateCompoundAlertRuleJSONRequestBody defines body for UpdateCompoundAlertRule for application/json ContentType.
+type UpdateCompoundAlertRuleJSONRequestBody = CompoundAlertRule
+
+// SetCompoundAlertEscalationPolicyJSONRequestBody defines body for SetCompoundAlertEscalationPolicy for application/json ContentType.
+type SetCompoundAlertEscalationPolicyJSONRequestBody = EscalationPolicy
+
+// SetCompoundAlertRecipientsJSONRequestBody defines body for SetCompoundAlertRecipients for application/json ContentType.
+type SetCompoundAlertRecipientsJSONRequestBody = AlertRecipients
+
+// SnoozeCompoundAlertRuleJSONRequestBody defines body for SnoozeCompoundAlertRule for application/json ContentType.
+type SnoozeCompoundAlertRuleJSONRequestBody = AlertSnoozeRequest
+
+// CreateContactGroupJSONRequestBody defines body for CreateContactGroup for application/json ContentType.
+type CreateContactGroupJSONRequestBody = ContactGroup
+
+// UpdateContactGroupJSONRequestBody defines body for UpdateContactGroup for application/json ContentType.
+type UpdateContactGroupJSONRequestBody = ContactGroup
+
+// CreateSilenceWindowJSONRequestBody defines body for CreateSilenceWindow for application/json ContentType.
+type CreateSilenceWindowJSONRequestBody = SilenceWindow
+
+// UpdateSilenceWindowJSONRequestBody defines body for UpdateSilenceWindow for application/json ContentType.
+type UpdateSilenceWindowJSONRequestBody = SilenceWindow
+
 // UpdateAlertRuleJSONRequestBody defines body for UpdateAlertRule for application/json ContentType.
 type UpdateAlertRuleJSONRequestBody = AlertRule
 
+// SetAlertEscalationPolicyJSONRequestBody defines body for SetAlertEscalationPolicy for application/json ContentType.
+type SetAlertEscalationPolicyJSONRequestBody = EscalationPolicy
+
+// SetAlertRecipientsJSONRequestBody defines body for SetAlertRecipients for application/json ContentType.
+type SetAlertRecipientsJSONRequestBody = AlertRecipients
+
+// SnoozeAlertRuleJSONRequestBody defines body for SnoozeAlertRule for application/json ContentType.
+type SnoozeAlertRuleJSONRequestBody = AlertSnoozeRequest
+
 // CreateApiKeyJSONRequestBody defines body for CreateApiKey for application/json ContentType.
 type CreateApiKeyJSONRequestBody CreateApiKeyJSONBody
 
@@ -1157,6 +2196,12 @@ type UpdateApiKeyExpiryJSONRequestBody UpdateApiKeyExpiryJSONBody
 // LoginJSONRequestBody defines body for Login for application/json ContentType.
 type LoginJSONRequestBody = LoginRequest
 
+// CreateAutomationRuleJSONRequestBody defines body for CreateAutomationRule for application/json ContentType.
+type CreateAutomationRuleJSONRequestBody = AutomationRule
+
+// UpdateAutomationRuleJSONRequestBody defines body for UpdateAutomationRule for application/json ContentType.
+type UpdateAutomationRuleJSONRequestBody = AutomationRule
+
 // CreateDashboardJSONRequestBody defines body for CreateDashboard for application/json ContentType.
 type CreateDashboardJSONRequestBody = CreateDashboardRequest
 
@@ -1166,6 +2211,9 @@ type UpdateDashboardJSONRequestBody = UpdateDashboardRequest
 // ShareDashboardJSONRequestBody defines body for ShareDashboard for application/json ContentType.
 type ShareDashboardJSONRequestBody = ShareDashboardRequest
 
+// SetMeasurementTypeDownsamplingJSONRequestBody defines body for SetMeasurementTypeDownsampling for application/json ContentType.
+type SetMeasurementTypeDownsamplingJSONRequestBody = MeasurementTypeDownsampling
+
 // CreateMqttBrokerJSONRequestBody defines body for CreateMqttBroker for application/json ContentType.
 type CreateMqttBrokerJSONRequestBody = MQTTBroker
 
@@ -1181,6 +2229,18 @@ type UpdateMqttSubscriptionJSONRequestBody = MQTTSubscription
 // SetChannelPreferenceJSONRequestBody defines body for SetChannelPreference for application/json ContentType.
 type SetChannelPreferenceJSONRequestBody = ChannelPreference
 
+// SetPushSubscriptionJSONRequestBody defines body for SetPushSubscription for application/json ContentType.
+type SetPushSubscriptionJSONRequestBody = PushSubscription
+
+// SetQuietHoursJSONRequestBody defines body for SetQuietHours for application/json ContentType.
+type SetQuietHoursJSONRequestBody = QuietHours
+
+// CreateWebhookTargetJSONRequestBody defines body for CreateWebhookTarget for application/json ContentType.
+type CreateWebhookTargetJSONRequestBody = WebhookTarget
+
+// UpdateWebhookTargetJSONRequestBody defines body for UpdateWebhookTarget for application/json ContentType.
+type UpdateWebhookTargetJSONRequestBody = WebhookTarget
+
 // SubmitOAuthCodeJSONRequestBody defines body for SubmitOAuthCode for application/json ContentType.
 type SubmitOAuthCodeJSONRequestBody = OAuthSubmitCodeRequest
 
@@ -1190,9 +2250,27 @@ type UpdatePropertiesJSONRequestBody = UpdatePropertiesRequest
 // AssignPermissionJSONRequestBody defines body for AssignPermission for application/json ContentType.
 type AssignPermissionJSONRequestBody AssignPermissionJSONBody
 
+// CreateSceneJSONRequestBody defines body for CreateScene for application/json ContentType.
+type CreateSceneJSONRequestBody = Scene
+
+// UpdateSceneJSONRequestBody defines body for UpdateScene for application/json ContentType.
+type UpdateSceneJSONRequestBody = Scene
+
+// CreateScheduleJSONRequestBody defines body for CreateSchedule for application/json ContentType.
+type CreateScheduleJSONRequestBody = CommandSchedule
+
+// SetHolidayModeJSONRequestBody defines body for SetHolidayMode for application/json ContentType.
+type SetHolidayModeJSONRequestBody = HolidayMode
+
+// UpdateScheduleJSONRequestBody defines body for UpdateSchedule for application/json ContentType.
+type UpdateScheduleJSONRequestBody = CommandSchedule
+
 // AddSensorJSONRequestBody defines body for AddSensor for application/json ContentType.
 type AddSensorJSONRequestBody = Sensor
 
+// SetSensorDesiredStateJSONRequestBody defines body for SetSensorDesiredState for application/json ContentType.
+type SetSensorDesiredStateJSONRequestBody = DesiredStateRequest
+
 // UpdateSensorByIdJSONRequestBody defines body for UpdateSensorById for application/json ContentType.
 type UpdateSensorByIdJSONRequestBody = Sensor
 
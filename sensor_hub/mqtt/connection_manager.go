@@ -568,6 +568,16 @@ func (cm *ConnectionManager) OnSubscriptionRemoved(sub gen.MQTTSubscription) {
 }
 
 func (cm *ConnectionManager) Publish(brokerID int, topic string, payload []byte, qos byte) error {
+	return cm.publish(brokerID, topic, payload, qos, false)
+}
+
+// PublishRetained publishes a message that the broker keeps and delivers to
+// future subscribers, such as discovery configs and last-known states.
+func (cm *ConnectionManager) PublishRetained(brokerID int, topic string, payload []byte, qos byte) error {
+	return cm.publish(brokerID, topic, payload, qos, true)
+}
+
+func (cm *ConnectionManager) publish(brokerID int, topic string, payload []byte, qos byte, retained bool) error {
 	cm.mu.RLock()
 	conn, ok := cm.connections[brokerID]
 	cm.mu.RUnlock()
@@ -575,7 +585,7 @@ func (cm *ConnectionManager) Publish(brokerID int, topic string, payload []byte,
 		return fmt.Errorf("broker %d is not connected", brokerID)
 	}
 
-	token := conn.Client.Publish(topic, qos, false, payload)
+	token := conn.Client.Publish(topic, qos, retained, payload)
 	if token.WaitTimeout(5*time.Second) && token.Error() != nil {
 		return fmt.Errorf("publish to broker %d failed: %w", brokerID, token.Error())
 	}
@@ -75,6 +75,12 @@ func (m *MockSensorService) ServiceCollectReadingToValidateSensor(ctx context.Co
 func (m *MockSensorService) ServiceStartPeriodicSensorCollection(ctx context.Context) {
 	m.Called(ctx)
 }
+func (m *MockSensorService) ServiceStartStaleSensorWatchdog(ctx context.Context) {
+	m.Called(ctx)
+}
+func (m *MockSensorService) ServiceSetSensorExpectedInterval(ctx context.Context, sensorId int, expectedIntervalSeconds *int) error {
+	return m.Called(ctx, sensorId, expectedIntervalSeconds).Error(0)
+}
 func (m *MockSensorService) ServiceDiscoverSensors(ctx context.Context) error {
 	return m.Called(ctx).Error(0)
 }
@@ -142,6 +148,13 @@ func (m *MockSensorService) ServiceGetAllMeasurementTypesWithReadings(ctx contex
 	args := m.Called(ctx)
 	return args.Get(0).([]gen.MeasurementType), args.Error(1)
 }
+func (m *MockSensorService) ServiceSetMeasurementTypeDownsampling(ctx context.Context, name string, interval *string) (*gen.MeasurementType, error) {
+	args := m.Called(ctx, name, interval)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*gen.MeasurementType), args.Error(1)
+}
 
 type MockSubRepo struct {
 	mock.Mock
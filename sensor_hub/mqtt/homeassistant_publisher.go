@@ -0,0 +1,428 @@
+package mqtt
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"sync"
+	"time"
+
+	appProps "example/sensorHub/application_properties"
+	"example/sensorHub/drivers"
+	gen "example/sensorHub/gen"
+	"example/sensorHub/periodic"
+)
+
+const (
+	homeAssistantSyncInterval = 10 * time.Minute
+	homeAssistantPublishQOS   = 1
+	// homeAssistantStateQueueSize bounds the readings waiting to be mirrored. When a
+	// slow broker fills it, further readings are dropped rather than stalling ingestion.
+	homeAssistantStateQueueSize = 256
+
+	// homeAssistantIngestDriver is the driver that ingests Home Assistant
+	// discovery. Its sensors are not announced back, to avoid echo loops.
+	homeAssistantIngestDriver = "mqtt-homeassistant"
+)
+
+// homeAssistantEntityClass describes how a measurement type is announced to
+// Home Assistant.
+type homeAssistantEntityClass struct {
+	DeviceClass string
+	StateClass  string
+	Invert      bool // binary only: the hub's "true" is Home Assistant's "off"
+}
+
+// homeAssistantEntityClasses maps measurement types to Home Assistant device
+// classes. Measurement types not listed are announced without a device class.
+var homeAssistantEntityClasses = map[string]homeAssistantEntityClass{
+	"temperature":     {DeviceClass: "temperature", StateClass: "measurement"},
+	"humidity":        {DeviceClass: "humidity", StateClass: "measurement"},
+	"pressure":        {DeviceClass: "pressure", StateClass: "measurement"},
+	"battery":         {DeviceClass: "battery", StateClass: "measurement"},
+	"voltage":         {DeviceClass: "voltage", StateClass: "measurement"},
+	"current":         {DeviceClass: "current", StateClass: "measurement"},
+	"power":           {DeviceClass: "power", StateClass: "measurement"},
+	"energy":          {DeviceClass: "energy", StateClass: "total_increasing"},
+	"illuminance":     {DeviceClass: "illuminance", StateClass: "measurement"},
+	"co2":             {DeviceClass: "carbon_dioxide", StateClass: "measurement"},
+	"voc":             {DeviceClass: "volatile_organic_compounds_parts", StateClass: "measurement"},
+	"pm25":            {DeviceClass: "pm25", StateClass: "measurement"},
+	"soil_moisture":   {DeviceClass: "moisture", StateClass: "measurement"},
+	"wind_speed":      {DeviceClass: "wind_speed", StateClass: "measurement"},
+	"rain":            {DeviceClass: "precipitation", StateClass: "total_increasing"},
+	"occupancy":       {DeviceClass: "occupancy"},
+	"contact":         {DeviceClass: "opening", Invert: true},
+	"water_leak":      {DeviceClass: "moisture"},
+	"smoke":           {DeviceClass: "smoke"},
+	"carbon_monoxide": {DeviceClass: "carbon_monoxide"},
+	"tamper":          {DeviceClass: "tamper"},
+	"battery_low":     {DeviceClass: "battery"},
+	"vibration":       {DeviceClass: "vibration"},
+}
+
+// RetainedPublisher publishes retained MQTT messages to a broker.
+type RetainedPublisher interface {
+	PublishRetained(brokerID int, topic string, payload []byte, qos byte) error
+}
+
+// HomeAssistantSensorSource provides the sensors and measurement types to announce.
+type HomeAssistantSensorSource interface {
+	ServiceGetAllSensors(ctx context.Context) ([]gen.Sensor, error)
+	ServiceGetMeasurementTypesForSensor(ctx context.Context, sensorId int) ([]gen.MeasurementType, error)
+}
+
+// LatestReadingsSource provides the most recent reading of every sensor and
+// measurement type.
+type LatestReadingsSource interface {
+	ServiceGetLatest(ctx context.Context) ([]gen.Reading, error)
+}
+
+type homeAssistantDevice struct {
+	Identifiers  []string `json:"identifiers"`
+	Name         string   `json:"name"`
+	Manufacturer string   `json:"manufacturer"`
+	Model        string   `json:"model,omitempty"`
+}
+
+type homeAssistantDiscoveryConfig struct {
+	Name              string              `json:"name"`
+	UniqueID          string              `json:"unique_id"`
+	ObjectID          string              `json:"object_id"`
+	StateTopic        string              `json:"state_topic"`
+	DeviceClass       string              `json:"device_class,omitempty"`
+	StateClass        string              `json:"state_class,omitempty"`
+	UnitOfMeasurement string              `json:"unit_of_measurement,omitempty"`
+	PayloadOn         string              `json:"payload_on,omitempty"`
+	PayloadOff        string              `json:"payload_off,omitempty"`
+	Device            homeAssistantDevice `json:"device"`
+}
+
+// HomeAssistantPublisher announces the hub's sensors to Home Assistant using
+// MQTT discovery and mirrors every stored reading to a retained state topic,
+// so the hub stays the source of truth while its sensors appear in Home
+// Assistant.
+//
+// Discovery configs are republished periodically and whenever sensors change;
+// configs for sensors that have since been deleted, disabled or dismissed are
+// cleared. Readings are mirrored by a background worker so a slow broker never
+// holds up ingestion. Publishing is controlled by the homeassistant.publish.*
+// application properties and can be switched on or off at runtime.
+type HomeAssistantPublisher struct {
+	sensors   HomeAssistantSensorSource
+	readings  LatestReadingsSource
+	publisher RetainedPublisher
+	logger    *slog.Logger
+
+	states chan homeAssistantStates
+	resync chan struct{}
+
+	mu        sync.Mutex
+	announced map[string]bool // discovery topics currently announced
+	sensorIDs map[int]bool    // sensors whose readings are mirrored
+}
+
+// homeAssistantStates is one batch of readings waiting to be mirrored.
+type homeAssistantStates struct {
+	sensorID int
+	readings []gen.Reading
+}
+
+// NewHomeAssistantPublisher creates a publisher. Call Start to begin
+// announcing sensors, register it as a readings observer to mirror states, and
+// as a sensors observer to update discovery as soon as sensors change.
+func NewHomeAssistantPublisher(sensors HomeAssistantSensorSource, readings LatestReadingsSource, publisher RetainedPublisher, logger *slog.Logger) *HomeAssistantPublisher {
+	return &HomeAssistantPublisher{
+		sensors:   sensors,
+		readings:  readings,
+		publisher: publisher,
+		logger:    logger.With("component", "homeassistant_publisher"),
+		states:    make(chan homeAssistantStates, homeAssistantStateQueueSize),
+		resync:    make(chan struct{}, 1),
+		announced: make(map[string]bool),
+		sensorIDs: make(map[int]bool),
+	}
+}
+
+// Start recovers the discovery configs announced before the last restart,
+// starts the publishing worker and launches the periodic discovery sync.
+func (p *HomeAssistantPublisher) Start(ctx context.Context) {
+	if err := p.loadAnnounced(ctx); err != nil {
+		p.logger.Warn("failed to recover announced Home Assistant entities", "error", err)
+	}
+	go p.run(ctx)
+	periodic.RunTask(ctx, periodic.TaskConfig{
+		Name:           "homeassistant-discovery",
+		Interval:       homeAssistantSyncInterval,
+		Logger:         p.logger,
+		RunImmediately: true,
+	}, p.Sync)
+}
+
+// loadAnnounced marks the discovery topics of every sensor in the database as
+// announced. Retained configs outlive a restart, so without this the first sync
+// would never clear the entities of sensors disabled or dismissed while the hub
+// was down.
+func (p *HomeAssistantPublisher) loadAnnounced(ctx context.Context) error {
+	cfg := appProps.AppConfig
+	if cfg == nil || !cfg.HomeAssistantPublishEnabled {
+		return nil
+	}
+
+	sensors, err := p.sensors.ServiceGetAllSensors(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load sensors: %w", err)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	var errs []error
+	for _, sensor := range sensors {
+		if sensor.SensorDriver == homeAssistantIngestDriver {
+			continue
+		}
+		measurementTypes, err := p.sensors.ServiceGetMeasurementTypesForSensor(ctx, sensor.Id)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to load measurement types for sensor %s: %w", sensor.Name, err))
+			continue
+		}
+		for _, mt := range measurementTypes {
+			p.announced[homeAssistantDiscoveryTopic(cfg, sensor, mt)] = true
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// run mirrors queued readings and resyncs discovery after sensor changes until ctx
+// is cancelled.
+func (p *HomeAssistantPublisher) run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case batch := <-p.states:
+			p.publishStates(batch)
+		case <-p.resync:
+			if err := p.Sync(ctx); err != nil {
+				p.logger.Warn("failed to sync Home Assistant discovery", "error", err)
+			}
+		}
+	}
+}
+
+// SensorsChanged schedules a discovery sync, so added, deleted, disabled and
+// dismissed sensors are reflected in Home Assistant without waiting for the next
+// periodic sync.
+func (p *HomeAssistantPublisher) SensorsChanged(_ context.Context) {
+	select {
+	case p.resync <- struct{}{}:
+	default: // a sync is already pending
+	}
+}
+
+// Sync publishes discovery configs for every active sensor, clears configs
+// that are no longer wanted, and republishes the latest stored readings.
+func (p *HomeAssistantPublisher) Sync(ctx context.Context) error {
+	cfg := appProps.AppConfig
+	if cfg == nil {
+		return nil
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if !cfg.HomeAssistantPublishEnabled {
+		clear(p.sensorIDs)
+		return p.clearAnnounced(cfg, map[string]bool{})
+	}
+
+	sensors, err := p.sensors.ServiceGetAllSensors(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load sensors: %w", err)
+	}
+
+	var errs []error
+	wanted := make(map[string]bool)
+	sensorIDs := make(map[string]int)
+	for _, sensor := range sensors {
+		if !announceToHomeAssistant(sensor) {
+			continue
+		}
+		sensorIDs[sensor.Name] = sensor.Id
+
+		measurementTypes, err := p.sensors.ServiceGetMeasurementTypesForSensor(ctx, sensor.Id)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to load measurement types for sensor %s: %w", sensor.Name, err))
+			continue
+		}
+
+		for _, mt := range measurementTypes {
+			topic, payload, err := homeAssistantDiscoveryMessage(cfg, sensor, mt)
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			wanted[topic] = true
+			if err := p.publisher.PublishRetained(cfg.HomeAssistantPublishBrokerID, topic, payload, homeAssistantPublishQOS); err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			p.announced[topic] = true
+		}
+	}
+
+	clear(p.sensorIDs)
+	for _, id := range sensorIDs {
+		p.sensorIDs[id] = true
+	}
+
+	if err := p.clearAnnounced(cfg, wanted); err != nil {
+		errs = append(errs, err)
+	}
+
+	latest, err := p.readings.ServiceGetLatest(ctx)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("failed to load latest readings: %w", err))
+	}
+	for _, reading := range latest {
+		sensorID, ok := sensorIDs[reading.SensorName]
+		if !ok {
+			continue
+		}
+		if err := p.publishState(cfg, sensorID, reading); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	p.logger.Debug("synced Home Assistant discovery", "entities", len(wanted))
+	return errors.Join(errs...)
+}
+
+// ObserveReadings queues newly stored readings for the worker to mirror to their
+// state topics. It never blocks: when the queue is full the readings are dropped,
+// and the next sync republishes the latest ones.
+func (p *HomeAssistantPublisher) ObserveReadings(_ context.Context, sensorID int, readings []gen.Reading) {
+	cfg := appProps.AppConfig
+	if cfg == nil || !cfg.HomeAssistantPublishEnabled {
+		return
+	}
+
+	select {
+	case p.states <- homeAssistantStates{sensorID: sensorID, readings: readings}:
+	default:
+		p.logger.Debug("Home Assistant state queue full, dropping readings", "sensor_id", sensorID)
+	}
+}
+
+// publishStates mirrors a batch of readings if its sensor is announced. Sensors
+// approved since the last sync are picked up by the next.
+func (p *HomeAssistantPublisher) publishStates(batch homeAssistantStates) {
+	cfg := appProps.AppConfig
+	if cfg == nil || !cfg.HomeAssistantPublishEnabled {
+		return
+	}
+
+	p.mu.Lock()
+	announced := p.sensorIDs[batch.sensorID]
+	p.mu.Unlock()
+	if !announced {
+		return
+	}
+	for _, reading := range batch.readings {
+		if err := p.publishState(cfg, batch.sensorID, reading); err != nil {
+			p.logger.Debug("failed to publish Home Assistant state", "sensor_id", batch.sensorID, "measurement_type", reading.MeasurementType, "error", err)
+		}
+	}
+}
+
+// clearAnnounced removes previously announced entities that are not wanted.
+// An empty retained payload deletes the entity in Home Assistant.
+func (p *HomeAssistantPublisher) clearAnnounced(cfg *appProps.ApplicationConfiguration, wanted map[string]bool) error {
+	var errs []error
+	for topic := range p.announced {
+		if wanted[topic] {
+			continue
+		}
+		if err := p.publisher.PublishRetained(cfg.HomeAssistantPublishBrokerID, topic, []byte{}, homeAssistantPublishQOS); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		delete(p.announced, topic)
+	}
+	return errors.Join(errs...)
+}
+
+func (p *HomeAssistantPublisher) publishState(cfg *appProps.ApplicationConfiguration, sensorID int, reading gen.Reading) error {
+	var payload string
+	switch {
+	case reading.NumericValue != nil:
+		payload = strconv.FormatFloat(*reading.NumericValue, 'f', -1, 64)
+	case reading.TextState != nil:
+		payload = *reading.TextState
+	default:
+		return nil
+	}
+
+	topic := homeAssistantStateTopic(cfg, sensorID, reading.MeasurementType)
+	return p.publisher.PublishRetained(cfg.HomeAssistantPublishBrokerID, topic, []byte(payload), homeAssistantPublishQOS)
+}
+
+func announceToHomeAssistant(sensor gen.Sensor) bool {
+	return sensor.Enabled && sensor.Status == gen.SensorStatusActive && sensor.SensorDriver != homeAssistantIngestDriver
+}
+
+func homeAssistantStateTopic(cfg *appProps.ApplicationConfiguration, sensorID int, measurementType string) string {
+	return fmt.Sprintf("%s/%d/%s", cfg.HomeAssistantPublishTopicPrefix, sensorID, measurementType)
+}
+
+// homeAssistantDiscoveryMessage builds the discovery topic and config for one
+// measurement type of a sensor. Each hub sensor becomes a Home Assistant
+// device with one entity per measurement type.
+func homeAssistantDiscoveryMessage(cfg *appProps.ApplicationConfiguration, sensor gen.Sensor, mt gen.MeasurementType) (string, []byte, error) {
+	nodeID := fmt.Sprintf("sensor_hub_%d", sensor.Id)
+	class := homeAssistantEntityClasses[mt.Name]
+
+	config := homeAssistantDiscoveryConfig{
+		Name:        mt.DisplayName,
+		UniqueID:    fmt.Sprintf("%s_%s", nodeID, mt.Name),
+		ObjectID:    fmt.Sprintf("%s_%s", sensor.Name, mt.Name),
+		StateTopic:  homeAssistantStateTopic(cfg, sensor.Id, mt.Name),
+		DeviceClass: class.DeviceClass,
+		Device: homeAssistantDevice{
+			Identifiers:  []string{nodeID},
+			Name:         sensor.Name,
+			Manufacturer: "Sensor Hub",
+		},
+	}
+	if driver, ok := drivers.Get(sensor.SensorDriver); ok {
+		config.Device.Model = driver.DisplayName()
+	}
+
+	if mt.Category == gen.MeasurementTypeCategoryBinary {
+		config.PayloadOn, config.PayloadOff = "true", "false"
+		if class.Invert {
+			config.PayloadOn, config.PayloadOff = "false", "true"
+		}
+	} else {
+		config.StateClass = class.StateClass
+		config.UnitOfMeasurement = mt.Unit
+	}
+
+	payload, err := json.Marshal(config)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to marshal discovery config for sensor %s: %w", sensor.Name, err)
+	}
+
+	return homeAssistantDiscoveryTopic(cfg, sensor, mt), payload, nil
+}
+
+func homeAssistantDiscoveryTopic(cfg *appProps.ApplicationConfiguration, sensor gen.Sensor, mt gen.MeasurementType) string {
+	component := "sensor"
+	if mt.Category == gen.MeasurementTypeCategoryBinary {
+		component = "binary_sensor"
+	}
+	return fmt.Sprintf("%s/%s/sensor_hub_%d/%s/config", cfg.HomeAssistantPublishDiscoveryPrefix, component, sensor.Id, mt.Name)
+}
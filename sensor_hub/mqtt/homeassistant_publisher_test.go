@@ -0,0 +1,250 @@
+package mqtt
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"sync"
+	"testing"
+	"time"
+
+	appProps "example/sensorHub/application_properties"
+	gen "example/sensorHub/gen"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeLatestReadings struct {
+	readings []gen.Reading
+}
+
+func (f *fakeLatestReadings) ServiceGetLatest(_ context.Context) ([]gen.Reading, error) {
+	return f.readings, nil
+}
+
+type fakeRetainedPublisher struct {
+	mu       sync.Mutex
+	messages map[string]string
+	brokers  map[int]bool
+}
+
+func newFakeRetainedPublisher() *fakeRetainedPublisher {
+	return &fakeRetainedPublisher{messages: make(map[string]string), brokers: make(map[int]bool)}
+}
+
+func (f *fakeRetainedPublisher) PublishRetained(brokerID int, topic string, payload []byte, _ byte) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.brokers[brokerID] = true
+	f.messages[topic] = string(payload)
+	return nil
+}
+
+func (f *fakeRetainedPublisher) message(topic string) (string, bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	payload, ok := f.messages[topic]
+	return payload, ok
+}
+
+func setHomeAssistantPublishConfig(t *testing.T, enabled bool) {
+	t.Helper()
+	original := appProps.AppConfig
+	t.Cleanup(func() { appProps.AppConfig = original })
+	appProps.AppConfig = &appProps.ApplicationConfiguration{
+		HomeAssistantPublishEnabled:         enabled,
+		HomeAssistantPublishBrokerID:        2,
+		HomeAssistantPublishDiscoveryPrefix: "homeassistant",
+		HomeAssistantPublishTopicPrefix:     "sensor-hub",
+	}
+}
+
+func floatPtr(v float64) *float64 { return &v }
+func stringPtr(v string) *string  { return &v }
+
+func TestHomeAssistantPublisher_SyncAnnouncesActiveSensors(t *testing.T) {
+	setHomeAssistantPublishConfig(t, true)
+
+	sensorSvc := new(MockSensorService)
+	sensorSvc.On("ServiceGetAllSensors", mock.Anything).Return([]gen.Sensor{
+		{Id: 1, Name: "office", SensorDriver: "sensor-hub-http-temperature", Enabled: true, Status: gen.SensorStatusActive},
+		{Id: 2, Name: "front-door", SensorDriver: "mqtt-zigbee2mqtt", Enabled: true, Status: gen.SensorStatusActive},
+		{Id: 3, Name: "pending", SensorDriver: "mqtt-zigbee2mqtt", Enabled: false, Status: gen.SensorStatusPending},
+		{Id: 4, Name: "esp", SensorDriver: "mqtt-homeassistant", Enabled: true, Status: gen.SensorStatusActive},
+	}, nil)
+	sensorSvc.On("ServiceGetMeasurementTypesForSensor", mock.Anything, 1).Return([]gen.MeasurementType{
+		{Name: "temperature", DisplayName: "Temperature", Unit: "°C", Category: gen.MeasurementTypeCategoryNumeric},
+	}, nil)
+	sensorSvc.On("ServiceGetMeasurementTypesForSensor", mock.Anything, 2).Return([]gen.MeasurementType{
+		{Name: "contact", DisplayName: "Contact", Category: gen.MeasurementTypeCategoryBinary},
+	}, nil)
+
+	latest := &fakeLatestReadings{readings: []gen.Reading{
+		{SensorName: "office", MeasurementType: "temperature", NumericValue: floatPtr(21.5)},
+		{SensorName: "esp", MeasurementType: "temperature", NumericValue: floatPtr(19)},
+	}}
+	publisher := newFakeRetainedPublisher()
+
+	p := NewHomeAssistantPublisher(sensorSvc, latest, publisher, slog.Default())
+	require.NoError(t, p.Sync(context.Background()))
+
+	assert.Equal(t, map[int]bool{2: true}, publisher.brokers)
+	assert.Len(t, publisher.messages, 3)
+
+	var temperature homeAssistantDiscoveryConfig
+	require.NoError(t, json.Unmarshal([]byte(publisher.messages["homeassistant/sensor/sensor_hub_1/temperature/config"]), &temperature))
+	assert.Equal(t, "sensor_hub_1_temperature", temperature.UniqueID)
+	assert.Equal(t, "sensor-hub/1/temperature", temperature.StateTopic)
+	assert.Equal(t, "temperature", temperature.DeviceClass)
+	assert.Equal(t, "measurement", temperature.StateClass)
+	assert.Equal(t, "°C", temperature.UnitOfMeasurement)
+	assert.Equal(t, "office", temperature.Device.Name)
+	assert.Equal(t, []string{"sensor_hub_1"}, temperature.Device.Identifiers)
+
+	var contact homeAssistantDiscoveryConfig
+	require.NoError(t, json.Unmarshal([]byte(publisher.messages["homeassistant/binary_sensor/sensor_hub_2/contact/config"]), &contact))
+	assert.Equal(t, "opening", contact.DeviceClass)
+	assert.Equal(t, "false", contact.PayloadOn, "a closed contact is a closed opening")
+	assert.Equal(t, "true", contact.PayloadOff)
+	assert.Empty(t, contact.UnitOfMeasurement)
+
+	assert.Equal(t, "21.5", publisher.messages["sensor-hub/1/temperature"])
+	assert.NotContains(t, publisher.messages, "sensor-hub/4/temperature")
+}
+
+func TestHomeAssistantPublisher_SyncClearsRemovedSensors(t *testing.T) {
+	setHomeAssistantPublishConfig(t, true)
+
+	sensorSvc := new(MockSensorService)
+	sensorSvc.On("ServiceGetAllSensors", mock.Anything).Return([]gen.Sensor{
+		{Id: 1, Name: "office", Enabled: true, Status: gen.SensorStatusActive},
+	}, nil).Once()
+	sensorSvc.On("ServiceGetAllSensors", mock.Anything).Return([]gen.Sensor{}, nil).Once()
+	sensorSvc.On("ServiceGetMeasurementTypesForSensor", mock.Anything, 1).Return([]gen.MeasurementType{
+		{Name: "temperature", DisplayName: "Temperature", Unit: "°C", Category: gen.MeasurementTypeCategoryNumeric},
+	}, nil)
+	publisher := newFakeRetainedPublisher()
+
+	p := NewHomeAssistantPublisher(sensorSvc, &fakeLatestReadings{}, publisher, slog.Default())
+	require.NoError(t, p.Sync(context.Background()))
+	require.NotEmpty(t, publisher.messages["homeassistant/sensor/sensor_hub_1/temperature/config"])
+
+	require.NoError(t, p.Sync(context.Background()))
+	assert.Empty(t, publisher.messages["homeassistant/sensor/sensor_hub_1/temperature/config"])
+}
+
+func TestHomeAssistantPublisher_ObserveReadings(t *testing.T) {
+	setHomeAssistantPublishConfig(t, true)
+
+	sensorSvc := new(MockSensorService)
+	sensorSvc.On("ServiceGetAllSensors", mock.Anything).Return([]gen.Sensor{
+		{Id: 7, Name: "plug", Enabled: true, Status: gen.SensorStatusActive},
+	}, nil)
+	sensorSvc.On("ServiceGetMeasurementTypesForSensor", mock.Anything, 7).Return([]gen.MeasurementType{}, nil)
+	publisher := newFakeRetainedPublisher()
+
+	p := NewHomeAssistantPublisher(sensorSvc, &fakeLatestReadings{}, publisher, slog.Default())
+	require.NoError(t, p.Sync(context.Background()))
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go p.run(ctx)
+
+	p.ObserveReadings(context.Background(), 8, []gen.Reading{
+		{MeasurementType: "power", NumericValue: floatPtr(1)},
+	})
+	p.ObserveReadings(context.Background(), 7, []gen.Reading{
+		{MeasurementType: "power", NumericValue: floatPtr(12.25)},
+		{MeasurementType: "state", TextState: stringPtr("true")},
+	})
+
+	assert.Eventually(t, func() bool {
+		state, _ := publisher.message("sensor-hub/7/state")
+		return state == "true"
+	}, time.Second, 5*time.Millisecond)
+	power, _ := publisher.message("sensor-hub/7/power")
+	assert.Equal(t, "12.25", power)
+	_, mirrored := publisher.message("sensor-hub/8/power")
+	assert.False(t, mirrored, "unannounced sensors are not mirrored")
+}
+
+func TestHomeAssistantPublisher_ObserveReadingsDoesNotBlock(t *testing.T) {
+	setHomeAssistantPublishConfig(t, true)
+
+	p := NewHomeAssistantPublisher(new(MockSensorService), &fakeLatestReadings{}, newFakeRetainedPublisher(), slog.Default())
+
+	// nothing drains the queue, so every batch past its capacity is dropped
+	for i := 0; i < homeAssistantStateQueueSize+10; i++ {
+		p.ObserveReadings(context.Background(), 7, []gen.Reading{{MeasurementType: "power", NumericValue: floatPtr(1)}})
+	}
+
+	assert.Len(t, p.states, homeAssistantStateQueueSize)
+}
+
+func TestHomeAssistantPublisher_StartClearsSensorsRemovedWhileDown(t *testing.T) {
+	setHomeAssistantPublishConfig(t, true)
+
+	sensorSvc := new(MockSensorService)
+	sensorSvc.On("ServiceGetAllSensors", mock.Anything).Return([]gen.Sensor{
+		{Id: 1, Name: "office", Enabled: true, Status: gen.SensorStatusActive},
+		{Id: 2, Name: "garage", Enabled: false, Status: gen.SensorStatusActive},
+	}, nil)
+	sensorSvc.On("ServiceGetMeasurementTypesForSensor", mock.Anything, 1).Return([]gen.MeasurementType{
+		{Name: "temperature", DisplayName: "Temperature", Category: gen.MeasurementTypeCategoryNumeric},
+	}, nil)
+	sensorSvc.On("ServiceGetMeasurementTypesForSensor", mock.Anything, 2).Return([]gen.MeasurementType{
+		{Name: "contact", DisplayName: "Contact", Category: gen.MeasurementTypeCategoryBinary},
+	}, nil)
+	publisher := newFakeRetainedPublisher()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	NewHomeAssistantPublisher(sensorSvc, &fakeLatestReadings{}, publisher, slog.Default()).Start(ctx)
+
+	assert.Eventually(t, func() bool {
+		config, cleared := publisher.message("homeassistant/binary_sensor/sensor_hub_2/contact/config")
+		return cleared && config == ""
+	}, time.Second, 5*time.Millisecond, "the sensor disabled before the restart is cleared")
+	config, _ := publisher.message("homeassistant/sensor/sensor_hub_1/temperature/config")
+	assert.NotEmpty(t, config)
+}
+
+func TestHomeAssistantPublisher_SensorsChangedResyncs(t *testing.T) {
+	setHomeAssistantPublishConfig(t, true)
+
+	sensorSvc := new(MockSensorService)
+	sensorSvc.On("ServiceGetAllSensors", mock.Anything).Return([]gen.Sensor{
+		{Id: 1, Name: "office", Enabled: true, Status: gen.SensorStatusActive},
+	}, nil)
+	sensorSvc.On("ServiceGetMeasurementTypesForSensor", mock.Anything, 1).Return([]gen.MeasurementType{
+		{Name: "temperature", DisplayName: "Temperature", Category: gen.MeasurementTypeCategoryNumeric},
+	}, nil)
+	publisher := newFakeRetainedPublisher()
+	p := NewHomeAssistantPublisher(sensorSvc, &fakeLatestReadings{}, publisher, slog.Default())
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go p.run(ctx)
+
+	p.SensorsChanged(context.Background())
+	p.SensorsChanged(context.Background())
+
+	assert.Eventually(t, func() bool {
+		config, _ := publisher.message("homeassistant/sensor/sensor_hub_1/temperature/config")
+		return config != ""
+	}, time.Second, 5*time.Millisecond)
+}
+
+func TestHomeAssistantPublisher_Disabled(t *testing.T) {
+	setHomeAssistantPublishConfig(t, false)
+
+	sensorSvc := new(MockSensorService)
+	publisher := newFakeRetainedPublisher()
+
+	p := NewHomeAssistantPublisher(sensorSvc, &fakeLatestReadings{}, publisher, slog.Default())
+	require.NoError(t, p.Sync(context.Background()))
+	p.ObserveReadings(context.Background(), 1, []gen.Reading{{MeasurementType: "power", NumericValue: floatPtr(1)}})
+
+	assert.Empty(t, publisher.messages)
+	sensorSvc.AssertNotCalled(t, "ServiceGetAllSensors", mock.Anything)
+}
@@ -0,0 +1,201 @@
+package notifications
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+)
+
+// EmailSender sends notification emails to individual recipients.
+type EmailSender interface {
+	SendNotification(recipient, title, message, category string) error
+}
+
+// Deferrer applies a user's email digests and quiet hours. DeferEmail holds back an email
+// that belongs to a digest category or falls within the quiet hours, and reports whether
+// it did. InQuietHours reports whether notif reaches the user during their quiet hours;
+// error notifications never do.
+type Deferrer interface {
+	DeferEmail(ctx context.Context, notif Notification, userID int, pref *ChannelPreference) (bool, error)
+	InQuietHours(ctx context.Context, notif Notification, userID int) (bool, error)
+}
+
+// WebhookNotifier delivers a notification to the global webhook targets and to the
+// targets of the given users.
+type WebhookNotifier interface {
+	SendNotification(ctx context.Context, notif Notification, userIDs []int)
+}
+
+// PushNotifier publishes a notification to the ntfy or Gotify subscriptions of the given
+// users.
+type PushNotifier interface {
+	SendNotification(ctx context.Context, notif Notification, userIDs []int)
+}
+
+// PreferenceSource looks up a user's channel preferences for a category.
+type PreferenceSource interface {
+	GetChannelPreference(ctx context.Context, userID int, category NotificationCategory) (*ChannelPreference, error)
+}
+
+// Channel is a delivery channel besides the in-app notification list.
+type Channel string
+
+const (
+	ChannelEmail   Channel = "email"
+	ChannelWebhook Channel = "webhook"
+	ChannelPush    Channel = "push"
+)
+
+// Recipients selects who a Dispatcher delivers a notification to.
+type Recipients struct {
+	// UserIDs are the users the notification is for.
+	UserIDs []int
+	// Emails returns the email addresses of those users, by user ID. Users without an
+	// address get no email. It is only called when email may be sent.
+	Emails func(ctx context.Context) (map[int]string, error)
+	// Channels, when not empty, replaces the users' channel preferences, and emails are
+	// sent straight away instead of being deferred.
+	Channels []Channel
+}
+
+// uses reports whether channel is used for a user whose preference for it is preferred.
+func (r Recipients) uses(channel Channel, preferred bool) bool {
+	if len(r.Channels) == 0 {
+		return preferred
+	}
+	return slices.Contains(r.Channels, channel)
+}
+
+// allows reports whether channel can be used for any of the users.
+func (r Recipients) allows(channel Channel) bool {
+	return r.uses(channel, true)
+}
+
+// Dispatcher delivers persisted notifications over email, webhooks and push, following
+// each recipient's channel preferences. A channel without a sender is skipped.
+type Dispatcher struct {
+	prefs    PreferenceSource
+	email    EmailSender
+	deferrer Deferrer
+	webhooks WebhookNotifier
+	push     PushNotifier
+	logger   *slog.Logger
+}
+
+// NewDispatcher returns a Dispatcher with no channels. Errors are logged to logger.
+func NewDispatcher(prefs PreferenceSource, logger *slog.Logger) *Dispatcher {
+	return &Dispatcher{prefs: prefs, logger: logger}
+}
+
+// SetEmailSender enables email delivery. Call before the first Dispatch.
+func (d *Dispatcher) SetEmailSender(email EmailSender) {
+	d.email = email
+}
+
+// SetDeferrer enables digests and quiet hours. Call before the first Dispatch.
+func (d *Dispatcher) SetDeferrer(deferrer Deferrer) {
+	d.deferrer = deferrer
+}
+
+// SetWebhookNotifier enables webhook delivery. Call before the first Dispatch.
+func (d *Dispatcher) SetWebhookNotifier(webhooks WebhookNotifier) {
+	d.webhooks = webhooks
+}
+
+// SetPushNotifier enables ntfy/Gotify delivery. Call before the first Dispatch.
+func (d *Dispatcher) SetPushNotifier(push PushNotifier) {
+	d.push = push
+}
+
+// Dispatch delivers notif to the selected recipients. The webhook and push senders are
+// called once with the users who have the channel enabled; emails are sent last, one per
+// user, because they are the slowest. Failures are logged, not returned: the notification
+// has already been stored. Callers run Dispatch in its own goroutine.
+//
+// Quiet hours cover every channel that reaches a user: their emails are held until the
+// quiet hours end, while their push notifications and personal webhook targets are
+// skipped. Digests only batch emails. Global webhook targets belong to no user and are
+// always sent. Channels forced by the caller, such as an escalation step, ignore both.
+func (d *Dispatcher) Dispatch(ctx context.Context, notif Notification, r Recipients) {
+	sendEmail := d.email != nil && r.allows(ChannelEmail)
+	sendWebhooks := d.webhooks != nil && r.allows(ChannelWebhook)
+	sendPush := d.push != nil && r.allows(ChannelPush)
+	if !sendEmail && !sendWebhooks && !sendPush {
+		return
+	}
+
+	var emails map[int]string
+	if sendEmail {
+		var err error
+		if emails, err = r.Emails(ctx); err != nil {
+			d.logger.Error("failed to get users for email notification", "error", err)
+			sendEmail = false
+		}
+	}
+
+	type emailTo struct {
+		userID  int
+		address string
+		pref    *ChannelPreference
+	}
+	var webhookUsers, pushUsers []int
+	var emailUsers []emailTo
+	for _, userID := range r.UserIDs {
+		pref, err := d.prefs.GetChannelPreference(ctx, userID, notif.Category)
+		if err != nil {
+			d.logger.Error("failed to get channel preference", "user_id", userID, "error", err)
+			continue
+		}
+		if pref == nil {
+			continue
+		}
+		webhook := sendWebhooks && r.uses(ChannelWebhook, pref.WebhookEnabled)
+		push := sendPush && r.uses(ChannelPush, pref.PushEnabled)
+		if (webhook || push) && d.quiet(ctx, notif, userID, r) {
+			webhook, push = false, false
+		}
+		if webhook {
+			webhookUsers = append(webhookUsers, userID)
+		}
+		if push {
+			pushUsers = append(pushUsers, userID)
+		}
+		if address, ok := emails[userID]; ok && sendEmail && r.uses(ChannelEmail, pref.EmailEnabled) {
+			emailUsers = append(emailUsers, emailTo{userID: userID, address: address, pref: pref})
+		}
+	}
+
+	if sendWebhooks {
+		d.webhooks.SendNotification(ctx, notif, webhookUsers)
+	}
+	if sendPush {
+		d.push.SendNotification(ctx, notif, pushUsers)
+	}
+	for _, to := range emailUsers {
+		if d.deferrer != nil && len(r.Channels) == 0 {
+			deferred, err := d.deferrer.DeferEmail(ctx, notif, to.userID, to.pref)
+			if err != nil {
+				d.logger.Error("failed to check email digest and quiet hours, sending now", "user_id", to.userID, "error", err)
+			} else if deferred {
+				continue
+			}
+		}
+		if err := d.email.SendNotification(to.address, notif.Title, notif.Message, string(notif.Category)); err != nil {
+			d.logger.Error("failed to send email notification", "email", to.address, "error", err)
+		}
+	}
+}
+
+// quiet reports whether userID's push and webhook deliveries of notif are skipped for
+// their quiet hours. A failed check delivers them.
+func (d *Dispatcher) quiet(ctx context.Context, notif Notification, userID int, r Recipients) bool {
+	if d.deferrer == nil || len(r.Channels) > 0 {
+		return false
+	}
+	quiet, err := d.deferrer.InQuietHours(ctx, notif, userID)
+	if err != nil {
+		d.logger.Error("failed to check quiet hours, sending now", "user_id", userID, "error", err)
+		return false
+	}
+	return quiet
+}
@@ -0,0 +1,108 @@
+package notifications
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"reflect"
+	"testing"
+)
+
+type stubPreferences map[int]*ChannelPreference
+
+func (s stubPreferences) GetChannelPreference(_ context.Context, userID int, _ NotificationCategory) (*ChannelPreference, error) {
+	return s[userID], nil
+}
+
+type recordingEmails struct{ sent []string }
+
+func (r *recordingEmails) SendNotification(recipient, _, _, _ string) error {
+	r.sent = append(r.sent, recipient)
+	return nil
+}
+
+type recordingUsers struct{ userIDs []int }
+
+func (r *recordingUsers) SendNotification(_ context.Context, _ Notification, userIDs []int) {
+	r.userIDs = userIDs
+}
+
+// deferUsers treats its users as being in quiet hours.
+type deferUsers map[int]bool
+
+func (d deferUsers) DeferEmail(_ context.Context, _ Notification, userID int, _ *ChannelPreference) (bool, error) {
+	return d[userID], nil
+}
+
+func (d deferUsers) InQuietHours(_ context.Context, _ Notification, userID int) (bool, error) {
+	return d[userID], nil
+}
+
+func newTestDispatcher(prefs stubPreferences) (*Dispatcher, *recordingEmails, *recordingUsers, *recordingUsers) {
+	emails, webhooks, pushes := &recordingEmails{}, &recordingUsers{}, &recordingUsers{}
+	d := NewDispatcher(prefs, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	d.SetEmailSender(emails)
+	d.SetDeferrer(deferUsers{2: true})
+	d.SetWebhookNotifier(webhooks)
+	d.SetPushNotifier(pushes)
+	return d, emails, webhooks, pushes
+}
+
+func addresses(context.Context) (map[int]string, error) {
+	return map[int]string{1: "a@example.com", 2: "b@example.com"}, nil
+}
+
+func TestDispatcher_Dispatch_FollowsPreferences(t *testing.T) {
+	d, emails, webhooks, pushes := newTestDispatcher(stubPreferences{
+		1: {EmailEnabled: true, WebhookEnabled: true},
+		3: {PushEnabled: true},
+	})
+
+	d.Dispatch(context.Background(), Notification{Title: "Alert"}, Recipients{UserIDs: []int{1, 3, 4}, Emails: addresses})
+
+	if want := []string{"a@example.com"}; !reflect.DeepEqual(emails.sent, want) {
+		t.Errorf("emails sent to %v, want %v", emails.sent, want)
+	}
+	if want := []int{1}; !reflect.DeepEqual(webhooks.userIDs, want) {
+		t.Errorf("webhook users = %v, want %v", webhooks.userIDs, want)
+	}
+	if want := []int{3}; !reflect.DeepEqual(pushes.userIDs, want) {
+		t.Errorf("push users = %v, want %v", pushes.userIDs, want)
+	}
+}
+
+func TestDispatcher_Dispatch_QuietHoursCoverEveryChannel(t *testing.T) {
+	all := &ChannelPreference{EmailEnabled: true, WebhookEnabled: true, PushEnabled: true}
+	d, emails, webhooks, pushes := newTestDispatcher(stubPreferences{1: all, 2: all})
+
+	d.Dispatch(context.Background(), Notification{Title: "Alert"}, Recipients{UserIDs: []int{1, 2}, Emails: addresses})
+
+	if want := []string{"a@example.com"}; !reflect.DeepEqual(emails.sent, want) {
+		t.Errorf("emails sent to %v, want %v (user 2's email is deferred)", emails.sent, want)
+	}
+	if want := []int{1}; !reflect.DeepEqual(webhooks.userIDs, want) {
+		t.Errorf("webhook users = %v, want %v", webhooks.userIDs, want)
+	}
+	if want := []int{1}; !reflect.DeepEqual(pushes.userIDs, want) {
+		t.Errorf("push users = %v, want %v", pushes.userIDs, want)
+	}
+}
+
+func TestDispatcher_Dispatch_ForcedChannels(t *testing.T) {
+	d, emails, webhooks, pushes := newTestDispatcher(stubPreferences{
+		1: {WebhookEnabled: true},
+		2: {WebhookEnabled: true},
+	})
+
+	d.Dispatch(context.Background(), Notification{Title: "Alert"}, Recipients{UserIDs: []int{1, 2}, Emails: addresses, Channels: []Channel{ChannelEmail, ChannelPush}})
+
+	if want := []string{"a@example.com", "b@example.com"}; !reflect.DeepEqual(emails.sent, want) {
+		t.Errorf("emails sent to %v, want %v (forced emails are not deferred)", emails.sent, want)
+	}
+	if want := []int{1, 2}; !reflect.DeepEqual(pushes.userIDs, want) {
+		t.Errorf("push users = %v, want %v (forced channels ignore quiet hours)", pushes.userIDs, want)
+	}
+	if webhooks.userIDs != nil {
+		t.Errorf("webhooks were not forced but were sent to %v", webhooks.userIDs)
+	}
+}
@@ -0,0 +1,68 @@
+package notifications
+
+import (
+	"fmt"
+	"time"
+
+	"example/sensorHub/scheduling"
+)
+
+// QuietHours is a user's daily period during which notification emails are held back
+// and delivered together when it ends. Error-severity notifications are still sent
+// immediately. Start and End are "HH:MM" in the hub's local time; a period whose end is
+// not after its start runs past midnight.
+type QuietHours struct {
+	UserID  int    `json:"user_id"`
+	Start   string `json:"start"`
+	End     string `json:"end"`
+	Enabled bool   `json:"enabled"`
+}
+
+func (q *QuietHours) Validate() error {
+	start, err := scheduling.ParseClock(q.Start)
+	if err != nil {
+		return fmt.Errorf("invalid start time: %w", err)
+	}
+	end, err := scheduling.ParseClock(q.End)
+	if err != nil {
+		return fmt.Errorf("invalid end time: %w", err)
+	}
+	if start == end {
+		return fmt.Errorf("start and end time must differ")
+	}
+	return nil
+}
+
+// Covers reports whether t falls within the quiet hours. The caller passes t in the zone
+// the times are expressed in.
+func (q *QuietHours) Covers(t time.Time) bool {
+	if !q.Enabled {
+		return false
+	}
+	start, err := scheduling.ParseClock(q.Start)
+	if err != nil {
+		return false
+	}
+	end, err := scheduling.ParseClock(q.End)
+	if err != nil {
+		return false
+	}
+	minute := t.Hour()*60 + t.Minute()
+	if start < end {
+		return minute >= start && minute < end
+	}
+	return minute >= start || minute < end
+}
+
+// EndAfter returns the first time after t at which the quiet hours end, in t's zone.
+func (q *QuietHours) EndAfter(t time.Time) time.Time {
+	end, err := scheduling.ParseClock(q.End)
+	if err != nil {
+		return t
+	}
+	next := time.Date(t.Year(), t.Month(), t.Day(), end/60, end%60, 0, 0, t.Location())
+	if !next.After(t) {
+		next = time.Date(t.Year(), t.Month(), t.Day()+1, end/60, end%60, 0, 0, t.Location())
+	}
+	return next
+}
@@ -0,0 +1,83 @@
+package notifications
+
+import (
+	"testing"
+	"time"
+)
+
+func TestQuietHours_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		q       QuietHours
+		wantErr bool
+	}{
+		{"same day", QuietHours{Start: "12:00", End: "14:00"}, false},
+		{"overnight", QuietHours{Start: "22:00", End: "07:00"}, false},
+		{"bad start", QuietHours{Start: "25:00", End: "07:00"}, true},
+		{"bad end", QuietHours{Start: "22:00", End: "7am"}, true},
+		{"empty", QuietHours{Start: "22:00", End: "22:00"}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.q.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestQuietHours_Covers_Overnight(t *testing.T) {
+	q := QuietHours{Start: "22:00", End: "07:00", Enabled: true}
+	day := func(hour, minute int) time.Time {
+		return time.Date(2026, 3, 10, hour, minute, 0, 0, time.UTC)
+	}
+
+	for _, tc := range []struct {
+		at   time.Time
+		want bool
+	}{
+		{day(21, 59), false},
+		{day(22, 0), true},
+		{day(3, 30), true},
+		{day(6, 59), true},
+		{day(7, 0), false},
+		{day(12, 0), false},
+	} {
+		if got := q.Covers(tc.at); got != tc.want {
+			t.Errorf("Covers(%s) = %v, want %v", tc.at.Format("15:04"), got, tc.want)
+		}
+	}
+}
+
+func TestQuietHours_Covers_Disabled(t *testing.T) {
+	q := QuietHours{Start: "00:00", End: "23:59", Enabled: false}
+	if q.Covers(time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)) {
+		t.Error("disabled quiet hours should not cover any time")
+	}
+}
+
+func TestQuietHours_EndAfter(t *testing.T) {
+	q := QuietHours{Start: "22:00", End: "07:00", Enabled: true}
+
+	lateEvening := time.Date(2026, 3, 10, 23, 15, 0, 0, time.UTC)
+	if got, want := q.EndAfter(lateEvening), time.Date(2026, 3, 11, 7, 0, 0, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("EndAfter(late evening) = %v, want %v", got, want)
+	}
+
+	earlyMorning := time.Date(2026, 3, 11, 5, 0, 0, 0, time.UTC)
+	if got, want := q.EndAfter(earlyMorning), time.Date(2026, 3, 11, 7, 0, 0, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("EndAfter(early morning) = %v, want %v", got, want)
+	}
+}
+
+func TestIsValidDigestMode(t *testing.T) {
+	for _, mode := range []DigestMode{DigestOff, DigestHourly, DigestDaily} {
+		if !IsValidDigestMode(mode) {
+			t.Errorf("expected %q to be valid", mode)
+		}
+	}
+	if IsValidDigestMode("weekly") {
+		t.Error("expected weekly to be invalid")
+	}
+}
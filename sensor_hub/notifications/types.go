@@ -43,11 +43,24 @@ type UserNotification struct {
 	Notification   *Notification `json:"notification,omitempty"`
 }
 
+// DigestMode controls whether a category's emails are sent as they happen or batched
+// into a periodic summary.
+type DigestMode string
+
+const (
+	DigestOff    DigestMode = "off"
+	DigestHourly DigestMode = "hourly"
+	DigestDaily  DigestMode = "daily"
+)
+
 type ChannelPreference struct {
-	UserID       int                  `json:"user_id,omitempty"`
-	Category     NotificationCategory `json:"category"`
-	EmailEnabled bool                 `json:"email_enabled"`
-	InAppEnabled bool                 `json:"inapp_enabled"`
+	UserID         int                  `json:"user_id,omitempty"`
+	Category       NotificationCategory `json:"category"`
+	EmailEnabled   bool                 `json:"email_enabled"`
+	InAppEnabled   bool                 `json:"inapp_enabled"`
+	WebhookEnabled bool                 `json:"webhook_enabled"`
+	PushEnabled    bool                 `json:"push_enabled"`
+	EmailDigest    DigestMode           `json:"email_digest"`
 }
 
 var validCategories = map[NotificationCategory]bool{
@@ -62,6 +75,22 @@ var validSeverities = map[NotificationSeverity]bool{
 	SeverityError:   true,
 }
 
+var validDigestModes = map[DigestMode]bool{
+	DigestOff:    true,
+	DigestHourly: true,
+	DigestDaily:  true,
+}
+
+// IsValidCategory reports whether category is one of the known notification categories.
+func IsValidCategory(category NotificationCategory) bool {
+	return validCategories[category]
+}
+
+// IsValidDigestMode reports whether mode is one of the known email digest modes.
+func IsValidDigestMode(mode DigestMode) bool {
+	return validDigestModes[mode]
+}
+
 func (n *Notification) Validate() error {
 	if !validCategories[n.Category] {
 		return fmt.Errorf("invalid category: %s", n.Category)
@@ -0,0 +1,131 @@
+package push
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	appProps "example/sensorHub/application_properties"
+	"example/sensorHub/notifications"
+)
+
+const defaultTimeoutSeconds = 10
+
+// Repository is the subset of db.PushRepository needed by Notifier.
+// Defined locally to avoid an import cycle (db imports push).
+type Repository interface {
+	GetPushSubscriptionsForUsers(ctx context.Context, userIDs []int) ([]Subscription, error)
+}
+
+// Notifier publishes notifications to users' ntfy or Gotify servers.
+type Notifier struct {
+	repo   Repository
+	client *http.Client
+	logger *slog.Logger
+}
+
+func NewNotifier(repo Repository, logger *slog.Logger) *Notifier {
+	return &Notifier{
+		repo:   repo,
+		client: &http.Client{},
+		logger: logger.With("component", "push_notifier"),
+	}
+}
+
+// SendNotification publishes notif to the enabled subscriptions of userIDs. Errors are
+// logged per subscription and do not stop delivery to the others.
+func (n *Notifier) SendNotification(ctx context.Context, notif notifications.Notification, userIDs []int) {
+	if len(userIDs) == 0 {
+		return
+	}
+	subs, err := n.repo.GetPushSubscriptionsForUsers(ctx, userIDs)
+	if err != nil {
+		n.logger.Error("failed to get push subscriptions", "error", err)
+		return
+	}
+	for _, sub := range subs {
+		if err := n.Send(ctx, sub, notif); err != nil {
+			n.logger.Error("failed to send push notification", "user_id", sub.UserID, "provider", sub.Provider, "error", err)
+		}
+	}
+}
+
+// Send publishes notif to a single subscription.
+func (n *Notifier) Send(ctx context.Context, sub Subscription, notif notifications.Notification) error {
+	var (
+		endpoint string
+		body     any
+	)
+	title := notif.Title
+	message := MessageBody(notif)
+	priority := Priority(sub.Provider, notif.Severity)
+	switch sub.Provider {
+	case ProviderNtfy:
+		// JSON publishing to the server root, rather than headers on the topic URL, keeps
+		// non-ASCII titles intact.
+		endpoint = strings.TrimRight(sub.ServerURL, "/")
+		body = map[string]any{
+			"topic":    sub.Topic,
+			"title":    title,
+			"message":  message,
+			"priority": priority,
+			"tags":     []string{ntfyTags[notif.Severity], string(notif.Category)},
+		}
+	case ProviderGotify:
+		endpoint = strings.TrimRight(sub.ServerURL, "/") + "/message"
+		body = map[string]any{
+			"title":    title,
+			"message":  message,
+			"priority": priority,
+		}
+	default:
+		return fmt.Errorf("invalid provider: %s", sub.Provider)
+	}
+
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("failed to encode push message: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout())
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("failed to build push request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "sensor-hub-push")
+	if sub.Token != "" {
+		if sub.Provider == ProviderGotify {
+			req.Header.Set("X-Gotify-Key", sub.Token)
+		} else {
+			req.Header.Set("Authorization", "Bearer "+sub.Token)
+		}
+	}
+
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("push request failed: %w", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("%s server responded with status %d", sub.Provider, resp.StatusCode)
+	}
+
+	n.logger.Info("push notification sent", "user_id", sub.UserID, "provider", sub.Provider, "title", notif.Title)
+	return nil
+}
+
+func timeout() time.Duration {
+	if cfg := appProps.AppConfig; cfg != nil && cfg.PushTimeoutSeconds > 0 {
+		return time.Duration(cfg.PushTimeoutSeconds) * time.Second
+	}
+	return defaultTimeoutSeconds * time.Second
+}
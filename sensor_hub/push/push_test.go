@@ -0,0 +1,133 @@
+package push
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"example/sensorHub/notifications"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeRepository struct {
+	subs []Subscription
+}
+
+func (f *fakeRepository) GetPushSubscriptionsForUsers(_ context.Context, _ []int) ([]Subscription, error) {
+	return f.subs, nil
+}
+
+type receivedRequest struct {
+	path   string
+	header http.Header
+	body   map[string]any
+}
+
+// newServer starts a push server that answers every request with status and records
+// what it receives.
+func newServer(t *testing.T, status int) (*httptest.Server, func() []receivedRequest) {
+	t.Helper()
+	var mu sync.Mutex
+	var received []receivedRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		raw, _ := io.ReadAll(r.Body)
+		var body map[string]any
+		_ = json.Unmarshal(raw, &body)
+		mu.Lock()
+		received = append(received, receivedRequest{path: r.URL.Path, header: r.Header.Clone(), body: body})
+		mu.Unlock()
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(server.Close)
+	return server, func() []receivedRequest {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]receivedRequest(nil), received...)
+	}
+}
+
+func waterLeakAlert() notifications.Notification {
+	return notifications.Notification{
+		Category: notifications.CategoryThresholdAlert,
+		Severity: notifications.SeverityError,
+		Title:    "Alert: utility room",
+		Message:  "water leak detected",
+		Metadata: map[string]interface{}{
+			"sensor_name":   "utility room",
+			"sensor_type":   "water_level",
+			"numeric_value": 4.5,
+		},
+	}
+}
+
+func TestNotifier_Send_Ntfy(t *testing.T) {
+	server, received := newServer(t, http.StatusOK)
+	n := NewNotifier(&fakeRepository{}, slog.Default())
+
+	err := n.Send(context.Background(), Subscription{
+		Provider: ProviderNtfy, ServerURL: server.URL + "/", Topic: "leaks", Token: "tk_123",
+	}, waterLeakAlert())
+
+	require.NoError(t, err)
+	reqs := received()
+	require.Len(t, reqs, 1)
+	assert.Equal(t, "/", reqs[0].path)
+	assert.Equal(t, "Bearer tk_123", reqs[0].header.Get("Authorization"))
+	assert.Equal(t, "leaks", reqs[0].body["topic"])
+	assert.Equal(t, "Alert: utility room", reqs[0].body["title"])
+	assert.Equal(t, "water leak detected\nSensor: utility room\nValue: 4.50 (water_level)", reqs[0].body["message"])
+	assert.Equal(t, float64(5), reqs[0].body["priority"])
+	assert.Equal(t, []any{"rotating_light", "threshold_alert"}, reqs[0].body["tags"])
+}
+
+func TestNotifier_Send_Gotify(t *testing.T) {
+	server, received := newServer(t, http.StatusOK)
+	n := NewNotifier(&fakeRepository{}, slog.Default())
+
+	notif := waterLeakAlert()
+	notif.Severity = notifications.SeverityWarning
+	err := n.Send(context.Background(), Subscription{
+		Provider: ProviderGotify, ServerURL: server.URL, Token: "AbCdEf",
+	}, notif)
+
+	require.NoError(t, err)
+	reqs := received()
+	require.Len(t, reqs, 1)
+	assert.Equal(t, "/message", reqs[0].path)
+	assert.Equal(t, "AbCdEf", reqs[0].header.Get("X-Gotify-Key"))
+	assert.Empty(t, reqs[0].header.Get("Authorization"))
+	assert.Equal(t, float64(7), reqs[0].body["priority"])
+	assert.Contains(t, reqs[0].body["message"], "Sensor: utility room")
+}
+
+func TestNotifier_Send_ErrorStatus(t *testing.T) {
+	server, _ := newServer(t, http.StatusForbidden)
+	n := NewNotifier(&fakeRepository{}, slog.Default())
+
+	err := n.Send(context.Background(), Subscription{Provider: ProviderNtfy, ServerURL: server.URL, Topic: "leaks"}, waterLeakAlert())
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "status 403")
+}
+
+func TestNotifier_SendNotification_ContinuesAfterFailure(t *testing.T) {
+	failing, _ := newServer(t, http.StatusInternalServerError)
+	working, received := newServer(t, http.StatusOK)
+	n := NewNotifier(&fakeRepository{subs: []Subscription{
+		{UserID: 1, Provider: ProviderNtfy, ServerURL: failing.URL, Topic: "a"},
+		{UserID: 2, Provider: ProviderNtfy, ServerURL: working.URL, Topic: "b"},
+	}}, slog.Default())
+
+	n.SendNotification(context.Background(), waterLeakAlert(), []int{1, 2})
+
+	reqs := received()
+	require.Len(t, reqs, 1)
+	assert.Equal(t, "b", reqs[0].body["topic"])
+}
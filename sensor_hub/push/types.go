@@ -0,0 +1,123 @@
+package push
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"example/sensorHub/notifications"
+)
+
+// Provider is the kind of push server a subscription publishes to.
+type Provider string
+
+const (
+	ProviderNtfy   Provider = "ntfy"
+	ProviderGotify Provider = "gotify"
+)
+
+// Subscription is a user's push notification configuration. For ntfy, notifications are
+// published to Topic on ServerURL, authenticated with Token when it is set. For Gotify,
+// Token is the application token messages are posted with and Topic is unused.
+type Subscription struct {
+	UserID    int      `json:"user_id"`
+	Provider  Provider `json:"provider"`
+	ServerURL string   `json:"server_url"`
+	Topic     string   `json:"topic"`
+	Token     string   `json:"-"`
+	Enabled   bool     `json:"enabled"`
+}
+
+// ntfyTopicPattern matches the topic names ntfy accepts.
+var ntfyTopicPattern = regexp.MustCompile(`^[-_A-Za-z0-9]{1,64}$`)
+
+func (s *Subscription) Validate() error {
+	u, err := url.Parse(s.ServerURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("server_url must be an absolute http or https URL")
+	}
+	switch s.Provider {
+	case ProviderNtfy:
+		if !ntfyTopicPattern.MatchString(s.Topic) {
+			return fmt.Errorf("topic must be 1-64 letters, digits, '-' or '_'")
+		}
+	case ProviderGotify:
+		if s.Token == "" {
+			return fmt.Errorf("token is required for gotify")
+		}
+	default:
+		return fmt.Errorf("invalid provider: %s", s.Provider)
+	}
+	return nil
+}
+
+// ntfyPriorities and gotifyPriorities map notification severities to each server's
+// priority scale: ntfy uses 1-5 (3 is default), Gotify 0-10 (8 and above is high).
+var (
+	ntfyPriorities = map[notifications.NotificationSeverity]int{
+		notifications.SeverityInfo:    3,
+		notifications.SeverityWarning: 4,
+		notifications.SeverityError:   5,
+	}
+	gotifyPriorities = map[notifications.NotificationSeverity]int{
+		notifications.SeverityInfo:    4,
+		notifications.SeverityWarning: 7,
+		notifications.SeverityError:   9,
+	}
+)
+
+// ntfyTags are shown by ntfy clients as emoji in front of the title.
+var ntfyTags = map[notifications.NotificationSeverity]string{
+	notifications.SeverityInfo:    "information_source",
+	notifications.SeverityWarning: "warning",
+	notifications.SeverityError:   "rotating_light",
+}
+
+// Priority returns the priority notif is published with on provider.
+func Priority(provider Provider, severity notifications.NotificationSeverity) int {
+	if provider == ProviderGotify {
+		if p, ok := gotifyPriorities[severity]; ok {
+			return p
+		}
+		return gotifyPriorities[notifications.SeverityInfo]
+	}
+	if p, ok := ntfyPriorities[severity]; ok {
+		return p
+	}
+	return ntfyPriorities[notifications.SeverityInfo]
+}
+
+// MessageBody returns the notification message followed by the sensor name and value
+// from its metadata, when present, so they are visible on a lock screen.
+func MessageBody(notif notifications.Notification) string {
+	lines := []string{notif.Message}
+	if name, ok := notif.Metadata["sensor_name"].(string); ok && name != "" {
+		lines = append(lines, "Sensor: "+name)
+	}
+	if value, ok := numericValue(notif.Metadata["numeric_value"]); ok {
+		line := fmt.Sprintf("Value: %.2f", value)
+		if measurement, ok := notif.Metadata["sensor_type"].(string); ok && measurement != "" {
+			line += " (" + measurement + ")"
+		}
+		lines = append(lines, line)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// numericValue accepts the float64 set by the alert processor as well as other numeric
+// types, since metadata may have been round-tripped through JSON or built elsewhere.
+func numericValue(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case float64:
+		return n, true
+	case float32:
+		return float64(n), true
+	case int:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	default:
+		return 0, false
+	}
+}
@@ -0,0 +1,49 @@
+package push
+
+import (
+	"testing"
+
+	"example/sensorHub/notifications"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSubscription_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		sub     Subscription
+		wantErr string
+	}{
+		{"ntfy", Subscription{Provider: ProviderNtfy, ServerURL: "https://ntfy.sh", Topic: "garage_leaks-1"}, ""},
+		{"gotify", Subscription{Provider: ProviderGotify, ServerURL: "http://gotify.local:8080", Token: "AbCdEf"}, ""},
+		{"unknown provider", Subscription{Provider: "pushover", ServerURL: "https://api.pushover.net"}, "invalid provider"},
+		{"relative url", Subscription{Provider: ProviderNtfy, ServerURL: "ntfy.sh", Topic: "leaks"}, "server_url"},
+		{"ntfy without topic", Subscription{Provider: ProviderNtfy, ServerURL: "https://ntfy.sh"}, "topic"},
+		{"ntfy topic with slash", Subscription{Provider: ProviderNtfy, ServerURL: "https://ntfy.sh", Topic: "a/b"}, "topic"},
+		{"gotify without token", Subscription{Provider: ProviderGotify, ServerURL: "https://gotify.example.com"}, "token"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.sub.Validate()
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestPriority(t *testing.T) {
+	assert.Equal(t, 3, Priority(ProviderNtfy, notifications.SeverityInfo))
+	assert.Equal(t, 4, Priority(ProviderNtfy, notifications.SeverityWarning))
+	assert.Equal(t, 5, Priority(ProviderNtfy, notifications.SeverityError))
+	assert.Equal(t, 4, Priority(ProviderGotify, notifications.SeverityInfo))
+	assert.Equal(t, 9, Priority(ProviderGotify, notifications.SeverityError))
+	assert.Equal(t, 3, Priority(ProviderNtfy, "unknown"))
+}
+
+func TestMessageBody_WithoutSensorMetadata(t *testing.T) {
+	notif := notifications.Notification{Message: "User bob was created"}
+	assert.Equal(t, "User bob was created", MessageBody(notif))
+}
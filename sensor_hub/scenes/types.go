@@ -0,0 +1,93 @@
+package scenes
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ActivationStatus is the aggregated outcome of a scene activation.
+type ActivationStatus string
+
+const (
+	// ActivationPending is reported while any command awaits its outcome.
+	ActivationPending ActivationStatus = "pending"
+	// ActivationSucceeded is reported when every command was acknowledged.
+	ActivationSucceeded ActivationStatus = "succeeded"
+	// ActivationPartial is reported when some commands were acknowledged and some were not.
+	ActivationPartial ActivationStatus = "partial"
+	// ActivationFailed is reported when no command was acknowledged.
+	ActivationFailed ActivationStatus = "failed"
+)
+
+// Command sets the Property capability of the controllable sensor SensorID to Value.
+type Command struct {
+	SensorID   int    `json:"sensor_id"`
+	SensorName string `json:"sensor_name"`
+	Property   string `json:"property"`
+	Value      string `json:"value"`
+}
+
+// Scene is a named set of commands that are sent together, such as "Movie night": lamp
+// off, TV plug on, dimmer to 20.
+type Scene struct {
+	ID          int       `json:"id"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	Commands    []Command `json:"commands"`
+
+	LastActivatedAt      *time.Time       `json:"last_activated_at"`
+	LastActivationStatus ActivationStatus `json:"last_activation_status"`
+}
+
+func (s *Scene) Validate() error {
+	if strings.TrimSpace(s.Name) == "" {
+		return fmt.Errorf("name is required")
+	}
+	if len(s.Commands) == 0 {
+		return fmt.Errorf("a scene needs at least one command")
+	}
+	type capability struct {
+		sensorID int
+		property string
+	}
+	seen := make(map[capability]bool, len(s.Commands))
+	for i, c := range s.Commands {
+		if c.SensorID <= 0 {
+			return fmt.Errorf("command %d: sensor ID must be a positive integer", i+1)
+		}
+		if strings.TrimSpace(c.Property) == "" {
+			return fmt.Errorf("command %d: property is required", i+1)
+		}
+		if c.Value == "" {
+			return fmt.Errorf("command %d: value is required", i+1)
+		}
+		key := capability{c.SensorID, c.Property}
+		if seen[key] {
+			return fmt.Errorf("command %d: sensor %d property %q is already set by the scene", i+1, c.SensorID, c.Property)
+		}
+		seen[key] = true
+	}
+	return nil
+}
+
+// CommandOutcome is what happened to one command of an activation. Status is one of the
+// actuation command statuses: sent while the outcome is awaited, then acknowledged,
+// timed_out or failed. Error explains a command that could not be sent at all.
+type CommandOutcome struct {
+	Command
+	CommandID         *int    `json:"command_id"`
+	Status            string  `json:"status"`
+	AcknowledgedValue *string `json:"acknowledged_value"`
+	Error             string  `json:"error"`
+}
+
+// Activation is the outcome of activating a scene: one CommandOutcome per command, in the
+// scene's order, and their aggregated Status.
+type Activation struct {
+	SceneID     int              `json:"scene_id"`
+	SceneName   string           `json:"scene_name"`
+	ActivatedAt time.Time        `json:"activated_at"`
+	Status      ActivationStatus `json:"status"`
+	Commands    []CommandOutcome `json:"commands"`
+}
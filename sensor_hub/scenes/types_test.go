@@ -0,0 +1,46 @@
+package scenes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func validScene() Scene {
+	return Scene{
+		Name: "Movie night",
+		Commands: []Command{
+			{SensorID: 14, Property: "state", Value: "OFF"},
+			{SensorID: 15, Property: "state", Value: "ON"},
+			{SensorID: 15, Property: "brightness", Value: "50"},
+		},
+	}
+}
+
+func TestScene_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		modify  func(*Scene)
+		wantErr string
+	}{
+		{name: "valid", modify: func(*Scene) {}},
+		{name: "missing name", modify: func(s *Scene) { s.Name = " " }, wantErr: "name"},
+		{name: "no commands", modify: func(s *Scene) { s.Commands = nil }, wantErr: "at least one command"},
+		{name: "missing sensor", modify: func(s *Scene) { s.Commands[1].SensorID = 0 }, wantErr: "command 2: sensor ID"},
+		{name: "missing property", modify: func(s *Scene) { s.Commands[0].Property = "" }, wantErr: "command 1: property"},
+		{name: "missing value", modify: func(s *Scene) { s.Commands[2].Value = "" }, wantErr: "command 3: value"},
+		{name: "capability set twice", modify: func(s *Scene) { s.Commands[2].Property = "state" }, wantErr: "already set by the scene"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := validScene()
+			tt.modify(&s)
+			err := s.Validate()
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
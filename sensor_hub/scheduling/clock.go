@@ -0,0 +1,22 @@
+package scheduling
+
+import (
+	"fmt"
+	"time"
+)
+
+// ParseClock parses an "HH:MM" time of day into minutes after midnight.
+func ParseClock(clock string) (int, error) {
+	t, err := time.Parse("15:04", clock)
+	if err != nil {
+		return 0, fmt.Errorf("%q must be HH:MM", clock)
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+// ParseWeekday returns the weekday named by its three-letter lowercase abbreviation,
+// such as "mon", and whether the name is one.
+func ParseWeekday(name string) (time.Weekday, bool) {
+	day, ok := weekdayNames[name]
+	return time.Weekday(day), ok
+}
@@ -0,0 +1,33 @@
+package scheduling
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseClock(t *testing.T) {
+	minutes, err := ParseClock("07:30")
+	require.NoError(t, err)
+	assert.Equal(t, 450, minutes)
+
+	for _, clock := range []string{"", "7:30pm", "24:00", "12:60"} {
+		_, err := ParseClock(clock)
+		assert.Error(t, err, clock)
+	}
+}
+
+func TestParseWeekday(t *testing.T) {
+	day, ok := ParseWeekday("sun")
+	assert.True(t, ok)
+	assert.Equal(t, time.Sunday, day)
+
+	day, ok = ParseWeekday("sat")
+	assert.True(t, ok)
+	assert.Equal(t, time.Saturday, day)
+
+	_, ok = ParseWeekday("Monday")
+	assert.False(t, ok)
+}
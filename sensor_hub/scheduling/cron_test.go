@@ -0,0 +1,77 @@
+package scheduling
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCron_Invalid(t *testing.T) {
+	tests := []struct {
+		name string
+		expr string
+		want string
+	}{
+		{name: "too few fields", expr: "30 6 * *", want: "5 fields"},
+		{name: "minute out of range", expr: "60 6 * * *", want: "minute"},
+		{name: "hour out of range", expr: "0 24 * * *", want: "hour"},
+		{name: "zero day of month", expr: "0 6 0 * *", want: "day-of-month"},
+		{name: "unknown month name", expr: "0 6 1 foo *", want: "month"},
+		{name: "reversed range", expr: "0 6 * * fri-mon", want: "day-of-week"},
+		{name: "zero step", expr: "*/0 * * * *", want: "step"},
+		{name: "never matches", expr: "0 0 30 feb *", want: "never matches"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseCron(tt.expr)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.want)
+		})
+	}
+}
+
+func TestCron_Next(t *testing.T) {
+	// Monday 2 March 2026.
+	monday := func(hour, minute int) time.Time {
+		return time.Date(2026, 3, 2, hour, minute, 0, 0, time.UTC)
+	}
+	tests := []struct {
+		name string
+		expr string
+		from time.Time
+		want time.Time
+	}{
+		{name: "later the same day", expr: "30 6 * * mon-fri", from: monday(5, 0), want: monday(6, 30)},
+		{name: "exactly at a match moves on", expr: "30 6 * * mon-fri", from: monday(6, 30), want: monday(6, 30).AddDate(0, 0, 1)},
+		{name: "friday evening skips the weekend", expr: "30 6 * * mon-fri", from: monday(20, 0).AddDate(0, 0, 4), want: monday(6, 30).AddDate(0, 0, 7)},
+		{name: "seconds are ignored", expr: "* * * * *", from: monday(6, 30).Add(45 * time.Second), want: monday(6, 31)},
+		{name: "step", expr: "*/15 8-18/2 * * *", from: monday(8, 16), want: monday(8, 30)},
+		{name: "step from a value", expr: "5/20 9 * * *", from: monday(9, 6), want: monday(9, 25)},
+		{name: "list", expr: "0 6,22 * * *", from: monday(7, 0), want: monday(22, 0)},
+		{name: "7 is sunday", expr: "0 9 * * 7", from: monday(0, 0), want: monday(9, 0).AddDate(0, 0, 6)},
+		{name: "month name", expr: "0 0 1 jan *", from: monday(0, 0), want: time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{name: "leap day", expr: "0 12 29 2 *", from: monday(0, 0), want: time.Date(2028, 2, 29, 12, 0, 0, 0, time.UTC)},
+		// With both day fields restricted a day matching either runs, as in standard cron.
+		{name: "day of month or weekday", expr: "0 6 15 * sat", from: monday(0, 0), want: time.Date(2026, 3, 7, 6, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := ParseCron(tt.expr)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, c.Next(tt.from))
+		})
+	}
+}
+
+func TestCron_Next_KeepsLocation(t *testing.T) {
+	loc := time.FixedZone("UTC+2", 2*60*60)
+	c, err := ParseCron("30 6 * * *")
+	require.NoError(t, err)
+
+	next := c.Next(time.Date(2026, 3, 2, 5, 0, 0, 0, loc))
+
+	assert.Equal(t, time.Date(2026, 3, 2, 6, 30, 0, 0, loc), next)
+	assert.Equal(t, loc, next.Location())
+}
@@ -0,0 +1,110 @@
+package scheduling
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// HolidayBehaviour is what a schedule does while holiday mode is on.
+type HolidayBehaviour string
+
+const (
+	// HolidaySkip schedules do not run during holiday mode, e.g. the weekday heating.
+	HolidaySkip HolidayBehaviour = "skip"
+	// HolidayRun schedules run whether or not holiday mode is on.
+	HolidayRun HolidayBehaviour = "run"
+	// HolidayOnly schedules run only during holiday mode, e.g. lights that make the house
+	// look occupied.
+	HolidayOnly HolidayBehaviour = "only"
+)
+
+func (h HolidayBehaviour) Validate() error {
+	switch h {
+	case HolidaySkip, HolidayRun, HolidayOnly:
+		return nil
+	default:
+		return fmt.Errorf("invalid holiday behaviour %q: must be one of skip, run, only", h)
+	}
+}
+
+// RunsDuring reports whether something with this behaviour runs with holiday mode on or
+// off.
+func (h HolidayBehaviour) RunsDuring(holiday bool) bool {
+	switch h {
+	case HolidayRun:
+		return true
+	case HolidayOnly:
+		return holiday
+	default:
+		return !holiday
+	}
+}
+
+// Schedule sends Value to the Property capability of the controllable sensor SensorID at
+// every minute matching Cron, in the hub's local time.
+//
+// NextRunAt is persisted so that a run falling due while the hub is down is noticed at
+// startup; see service.ScheduleService.RecoverMissedRuns.
+type Schedule struct {
+	ID         int              `json:"id"`
+	Name       string           `json:"name"`
+	Enabled    bool             `json:"enabled"`
+	SensorID   int              `json:"sensor_id"`
+	SensorName string           `json:"sensor_name"`
+	Property   string           `json:"property"`
+	Value      string           `json:"value"`
+	Cron       string           `json:"cron"`
+	Holiday    HolidayBehaviour `json:"holiday"`
+
+	NextRunAt     *time.Time `json:"next_run_at"`
+	LastRunAt     *time.Time `json:"last_run_at"`
+	LastCommandID *int       `json:"last_command_id"`
+	LastError     string     `json:"last_error"`
+}
+
+func (s *Schedule) Validate() error {
+	if strings.TrimSpace(s.Name) == "" {
+		return fmt.Errorf("name is required")
+	}
+	if s.SensorID <= 0 {
+		return fmt.Errorf("sensor ID must be a positive integer")
+	}
+	if strings.TrimSpace(s.Property) == "" {
+		return fmt.Errorf("property is required")
+	}
+	if s.Value == "" {
+		return fmt.Errorf("value is required")
+	}
+	if _, err := ParseCron(s.Cron); err != nil {
+		return err
+	}
+	return s.Holiday.Validate()
+}
+
+// NextRun returns the first run of the schedule after t. It returns the zero time when
+// the cron expression is invalid.
+func (s *Schedule) NextRun(t time.Time) time.Time {
+	c, err := ParseCron(s.Cron)
+	if err != nil {
+		return time.Time{}
+	}
+	return c.Next(t)
+}
+
+// RunsDuring reports whether the schedule runs with holiday mode on or off.
+func (s *Schedule) RunsDuring(holiday bool) bool {
+	return s.Holiday.RunsDuring(holiday)
+}
+
+// HolidayMode pauses HolidaySkip schedules and enables HolidayOnly schedules. Until, when
+// set, ends it automatically.
+type HolidayMode struct {
+	Enabled bool       `json:"enabled"`
+	Until   *time.Time `json:"until"`
+}
+
+// ActiveAt reports whether holiday mode is on at t.
+func (h HolidayMode) ActiveAt(t time.Time) bool {
+	return h.Enabled && (h.Until == nil || t.Before(*h.Until))
+}
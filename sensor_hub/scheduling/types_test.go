@@ -0,0 +1,71 @@
+package scheduling
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func validSchedule() Schedule {
+	return Schedule{
+		Name: "Heater weekday mornings", Enabled: true, SensorID: 2,
+		Property: "state", Value: "ON", Cron: "30 6 * * mon-fri", Holiday: HolidaySkip,
+	}
+}
+
+func TestSchedule_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		modify  func(*Schedule)
+		wantErr string
+	}{
+		{name: "valid", modify: func(*Schedule) {}},
+		{name: "missing name", modify: func(s *Schedule) { s.Name = " " }, wantErr: "name"},
+		{name: "missing sensor", modify: func(s *Schedule) { s.SensorID = 0 }, wantErr: "sensor ID"},
+		{name: "missing property", modify: func(s *Schedule) { s.Property = "" }, wantErr: "property"},
+		{name: "missing value", modify: func(s *Schedule) { s.Value = "" }, wantErr: "value"},
+		{name: "invalid cron", modify: func(s *Schedule) { s.Cron = "every morning" }, wantErr: "5 fields"},
+		{name: "invalid holiday behaviour", modify: func(s *Schedule) { s.Holiday = "sometimes" }, wantErr: "holiday"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := validSchedule()
+			tt.modify(&s)
+			err := s.Validate()
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestSchedule_RunsDuring(t *testing.T) {
+	tests := []struct {
+		holiday           HolidayBehaviour
+		onHoliday, normal bool
+	}{
+		{holiday: HolidaySkip, onHoliday: false, normal: true},
+		{holiday: HolidayRun, onHoliday: true, normal: true},
+		{holiday: HolidayOnly, onHoliday: true, normal: false},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.holiday), func(t *testing.T) {
+			s := Schedule{Holiday: tt.holiday}
+			assert.Equal(t, tt.onHoliday, s.RunsDuring(true))
+			assert.Equal(t, tt.normal, s.RunsDuring(false))
+		})
+	}
+}
+
+func TestHolidayMode_ActiveAt(t *testing.T) {
+	now := time.Date(2026, 8, 10, 12, 0, 0, 0, time.UTC)
+	later := now.Add(time.Hour)
+
+	assert.False(t, HolidayMode{}.ActiveAt(now))
+	assert.True(t, HolidayMode{Enabled: true}.ActiveAt(now))
+	assert.True(t, HolidayMode{Enabled: true, Until: &later}.ActiveAt(now))
+	assert.False(t, HolidayMode{Enabled: true, Until: &now}.ActiveAt(now), "holiday mode ends at Until")
+}
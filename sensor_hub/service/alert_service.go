@@ -7,6 +7,7 @@ import (
 	database "example/sensorHub/db"
 	gen "example/sensorHub/gen"
 	"log/slog"
+	"time"
 )
 
 type AlertManagementService struct {
@@ -52,3 +53,163 @@ func (s *AlertManagementService) ServiceDeleteAlertRule(ctx context.Context, rul
 func (s *AlertManagementService) ServiceGetAlertHistory(ctx context.Context, sensorID int, limit int) ([]gen.AlertHistoryEntry, error) {
 	return s.alertRepo.GetAlertHistory(ctx, sensorID, limit)
 }
+
+func (s *AlertManagementService) ServiceGetAllCompoundAlertRules(ctx context.Context) ([]alerting.CompoundAlertRule, error) {
+	return s.alertRepo.GetAllCompoundAlertRules(ctx)
+}
+
+func (s *AlertManagementService) ServiceGetCompoundAlertRuleByID(ctx context.Context, ruleID int) (*alerting.CompoundAlertRule, error) {
+	return s.alertRepo.GetCompoundAlertRuleByID(ctx, ruleID)
+}
+
+func (s *AlertManagementService) ServiceCreateCompoundAlertRule(ctx context.Context, rule *alerting.CompoundAlertRule) error {
+	return s.alertRepo.CreateCompoundAlertRule(ctx, rule)
+}
+
+func (s *AlertManagementService) ServiceUpdateCompoundAlertRule(ctx context.Context, rule *alerting.CompoundAlertRule) error {
+	return s.alertRepo.UpdateCompoundAlertRule(ctx, rule)
+}
+
+func (s *AlertManagementService) ServiceDeleteCompoundAlertRule(ctx context.Context, ruleID int) error {
+	return s.alertRepo.DeleteCompoundAlertRule(ctx, ruleID)
+}
+
+func (s *AlertManagementService) ServiceAcknowledgeAlertRule(ctx context.Context, ruleID, userID int) (bool, error) {
+	acknowledged, err := s.alertRepo.AcknowledgeAlertRule(ctx, ruleID, userID)
+	if err == nil && acknowledged {
+		s.logger.Info("alert rule acknowledged", "rule_id", ruleID, "user_id", userID)
+	}
+	return acknowledged, err
+}
+
+func (s *AlertManagementService) ServiceAcknowledgeAlertHistoryEntry(ctx context.Context, entryID, userID int) (bool, error) {
+	acknowledged, err := s.alertRepo.AcknowledgeAlertHistoryEntry(ctx, entryID, userID)
+	if err == nil && acknowledged {
+		s.logger.Info("alert history entry acknowledged", "entry_id", entryID, "user_id", userID)
+	}
+	return acknowledged, err
+}
+
+// ServiceSnoozeAlertRule suppresses a rule's notifications until the given time; nil
+// cancels the snooze.
+func (s *AlertManagementService) ServiceSnoozeAlertRule(ctx context.Context, ruleID int, until *time.Time) error {
+	if err := s.alertRepo.SnoozeAlertRule(ctx, ruleID, until); err != nil {
+		return err
+	}
+	s.logger.Info("alert rule snooze updated", "rule_id", ruleID, "until", until)
+	return nil
+}
+
+func (s *AlertManagementService) ServiceAcknowledgeCompoundAlertRule(ctx context.Context, ruleID, userID int) (bool, error) {
+	acknowledged, err := s.alertRepo.AcknowledgeCompoundAlertRule(ctx, ruleID, userID)
+	if err == nil && acknowledged {
+		s.logger.Info("compound alert rule acknowledged", "rule_id", ruleID, "user_id", userID)
+	}
+	return acknowledged, err
+}
+
+// ServiceSnoozeCompoundAlertRule suppresses a compound rule's notifications until the
+// given time; nil cancels the snooze.
+func (s *AlertManagementService) ServiceSnoozeCompoundAlertRule(ctx context.Context, ruleID int, until *time.Time) error {
+	if err := s.alertRepo.SnoozeCompoundAlertRule(ctx, ruleID, until); err != nil {
+		return err
+	}
+	s.logger.Info("compound alert rule snooze updated", "rule_id", ruleID, "until", until)
+	return nil
+}
+
+func (s *AlertManagementService) ServiceGetAllSilenceWindows(ctx context.Context) ([]alerting.SilenceWindow, error) {
+	return s.alertRepo.GetAllSilenceWindows(ctx)
+}
+
+func (s *AlertManagementService) ServiceGetSilenceWindowByID(ctx context.Context, windowID int) (*alerting.SilenceWindow, error) {
+	return s.alertRepo.GetSilenceWindowByID(ctx, windowID)
+}
+
+func (s *AlertManagementService) ServiceCreateSilenceWindow(ctx context.Context, window *alerting.SilenceWindow) error {
+	return s.alertRepo.CreateSilenceWindow(ctx, window)
+}
+
+func (s *AlertManagementService) ServiceUpdateSilenceWindow(ctx context.Context, window *alerting.SilenceWindow) error {
+	return s.alertRepo.UpdateSilenceWindow(ctx, window)
+}
+
+func (s *AlertManagementService) ServiceDeleteSilenceWindow(ctx context.Context, windowID int) error {
+	return s.alertRepo.DeleteSilenceWindow(ctx, windowID)
+}
+
+func (s *AlertManagementService) ServiceGetEscalationSteps(ctx context.Context, ruleID int) ([]alerting.EscalationStep, error) {
+	return s.alertRepo.GetEscalationSteps(ctx, ruleID)
+}
+
+// ServiceSetEscalationSteps replaces a rule's escalation policy; no steps turns escalation
+// off.
+func (s *AlertManagementService) ServiceSetEscalationSteps(ctx context.Context, ruleID int, steps []alerting.EscalationStep) error {
+	if err := s.alertRepo.SetEscalationSteps(ctx, ruleID, steps); err != nil {
+		return err
+	}
+	s.logger.Info("alert escalation policy updated", "rule_id", ruleID, "steps", len(steps))
+	return nil
+}
+
+func (s *AlertManagementService) ServiceGetAlertRecipients(ctx context.Context, ruleID int) (alerting.AlertRecipients, error) {
+	return s.alertRepo.GetAlertRecipients(ctx, ruleID)
+}
+
+// ServiceSetAlertRecipients replaces who a rule notifies; no recipients notifies every user
+// with view_alerts.
+func (s *AlertManagementService) ServiceSetAlertRecipients(ctx context.Context, ruleID int, recipients alerting.AlertRecipients) error {
+	if err := s.alertRepo.SetAlertRecipients(ctx, ruleID, recipients); err != nil {
+		return err
+	}
+	s.logger.Info("alert recipients updated", "rule_id", ruleID, "users", recipients.UserIDs, "roles", recipients.Roles, "contact_groups", recipients.ContactGroupIDs)
+	return nil
+}
+
+func (s *AlertManagementService) ServiceGetCompoundEscalationSteps(ctx context.Context, ruleID int) ([]alerting.EscalationStep, error) {
+	return s.alertRepo.GetCompoundEscalationSteps(ctx, ruleID)
+}
+
+// ServiceSetCompoundEscalationSteps replaces a compound rule's escalation policy; no steps
+// turns escalation off.
+func (s *AlertManagementService) ServiceSetCompoundEscalationSteps(ctx context.Context, ruleID int, steps []alerting.EscalationStep) error {
+	if err := s.alertRepo.SetCompoundEscalationSteps(ctx, ruleID, steps); err != nil {
+		return err
+	}
+	s.logger.Info("compound alert escalation policy updated", "rule_id", ruleID, "steps", len(steps))
+	return nil
+}
+
+func (s *AlertManagementService) ServiceGetCompoundAlertRecipients(ctx context.Context, ruleID int) (alerting.AlertRecipients, error) {
+	return s.alertRepo.GetCompoundAlertRecipients(ctx, ruleID)
+}
+
+// ServiceSetCompoundAlertRecipients replaces who a compound rule notifies; no recipients
+// notifies every user with view_alerts.
+func (s *AlertManagementService) ServiceSetCompoundAlertRecipients(ctx context.Context, ruleID int, recipients alerting.AlertRecipients) error {
+	if err := s.alertRepo.SetCompoundAlertRecipients(ctx, ruleID, recipients); err != nil {
+		return err
+	}
+	s.logger.Info("compound alert recipients updated", "rule_id", ruleID, "users", recipients.UserIDs, "roles", recipients.Roles, "contact_groups", recipients.ContactGroupIDs)
+	return nil
+}
+
+func (s *AlertManagementService) ServiceGetAllContactGroups(ctx context.Context) ([]alerting.ContactGroup, error) {
+	return s.alertRepo.GetAllContactGroups(ctx)
+}
+
+func (s *AlertManagementService) ServiceGetContactGroupByID(ctx context.Context, groupID int) (*alerting.ContactGroup, error) {
+	return s.alertRepo.GetContactGroupByID(ctx, groupID)
+}
+
+func (s *AlertManagementService) ServiceCreateContactGroup(ctx context.Context, group *alerting.ContactGroup) error {
+	return s.alertRepo.CreateContactGroup(ctx, group)
+}
+
+func (s *AlertManagementService) ServiceUpdateContactGroup(ctx context.Context, group *alerting.ContactGroup) error {
+	return s.alertRepo.UpdateContactGroup(ctx, group)
+}
+
+func (s *AlertManagementService) ServiceDeleteContactGroup(ctx context.Context, groupID int) error {
+	return s.alertRepo.DeleteContactGroup(ctx, groupID)
+}
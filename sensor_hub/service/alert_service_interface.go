@@ -4,6 +4,7 @@ import (
 	"context"
 	"example/sensorHub/alerting"
 	gen "example/sensorHub/gen"
+	"time"
 )
 
 type AlertManagementServiceInterface interface {
@@ -15,4 +16,32 @@ type AlertManagementServiceInterface interface {
 	ServiceUpdateAlertRule(ctx context.Context, rule *alerting.AlertRule) error
 	ServiceDeleteAlertRule(ctx context.Context, ruleID int) error
 	ServiceGetAlertHistory(ctx context.Context, sensorID int, limit int) ([]gen.AlertHistoryEntry, error)
+	ServiceGetAllCompoundAlertRules(ctx context.Context) ([]alerting.CompoundAlertRule, error)
+	ServiceGetCompoundAlertRuleByID(ctx context.Context, ruleID int) (*alerting.CompoundAlertRule, error)
+	ServiceCreateCompoundAlertRule(ctx context.Context, rule *alerting.CompoundAlertRule) error
+	ServiceUpdateCompoundAlertRule(ctx context.Context, rule *alerting.CompoundAlertRule) error
+	ServiceDeleteCompoundAlertRule(ctx context.Context, ruleID int) error
+	ServiceAcknowledgeAlertRule(ctx context.Context, ruleID, userID int) (bool, error)
+	ServiceAcknowledgeAlertHistoryEntry(ctx context.Context, entryID, userID int) (bool, error)
+	ServiceSnoozeAlertRule(ctx context.Context, ruleID int, until *time.Time) error
+	ServiceAcknowledgeCompoundAlertRule(ctx context.Context, ruleID, userID int) (bool, error)
+	ServiceSnoozeCompoundAlertRule(ctx context.Context, ruleID int, until *time.Time) error
+	ServiceGetAllSilenceWindows(ctx context.Context) ([]alerting.SilenceWindow, error)
+	ServiceGetSilenceWindowByID(ctx context.Context, windowID int) (*alerting.SilenceWindow, error)
+	ServiceCreateSilenceWindow(ctx context.Context, window *alerting.SilenceWindow) error
+	ServiceUpdateSilenceWindow(ctx context.Context, window *alerting.SilenceWindow) error
+	ServiceDeleteSilenceWindow(ctx context.Context, windowID int) error
+	ServiceGetEscalationSteps(ctx context.Context, ruleID int) ([]alerting.EscalationStep, error)
+	ServiceSetEscalationSteps(ctx context.Context, ruleID int, steps []alerting.EscalationStep) error
+	ServiceGetAlertRecipients(ctx context.Context, ruleID int) (alerting.AlertRecipients, error)
+	ServiceSetAlertRecipients(ctx context.Context, ruleID int, recipients alerting.AlertRecipients) error
+	ServiceGetCompoundEscalationSteps(ctx context.Context, ruleID int) ([]alerting.EscalationStep, error)
+	ServiceSetCompoundEscalationSteps(ctx context.Context, ruleID int, steps []alerting.EscalationStep) error
+	ServiceGetCompoundAlertRecipients(ctx context.Context, ruleID int) (alerting.AlertRecipients, error)
+	ServiceSetCompoundAlertRecipients(ctx context.Context, ruleID int, recipients alerting.AlertRecipients) error
+	ServiceGetAllContactGroups(ctx context.Context) ([]alerting.ContactGroup, error)
+	ServiceGetContactGroupByID(ctx context.Context, groupID int) (*alerting.ContactGroup, error)
+	ServiceCreateContactGroup(ctx context.Context, group *alerting.ContactGroup) error
+	ServiceUpdateContactGroup(ctx context.Context, group *alerting.ContactGroup) error
+	ServiceDeleteContactGroup(ctx context.Context, groupID int) error
 }
@@ -37,12 +37,12 @@ func (m *mockAlertRepositoryForService) GetAlertRulesBySensorID(ctx context.Cont
 	return args.Get(0).([]alerting.AlertRule), args.Error(1)
 }
 
-func (m *mockAlertRepositoryForService) GetAlertRuleForReading(ctx context.Context, sensorID int, measurementTypeName string) (*alerting.AlertRule, error) {
+func (m *mockAlertRepositoryForService) GetAlertRulesForReading(ctx context.Context, sensorID int, measurementTypeName string) ([]alerting.AlertRule, error) {
 	args := m.Called(ctx, sensorID, measurementTypeName)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
-	return args.Get(0).(*alerting.AlertRule), args.Error(1)
+	return args.Get(0).([]alerting.AlertRule), args.Error(1)
 }
 
 func (m *mockAlertRepositoryForService) UpdateLastAlertSent(ctx context.Context, ruleID int) error {
@@ -55,6 +55,29 @@ func (m *mockAlertRepositoryForService) RecordAlertSent(ctx context.Context, rul
 	return args.Error(0)
 }
 
+func (m *mockAlertRepositoryForService) MarkAlertFiring(ctx context.Context, ruleID int) (bool, error) {
+	args := m.Called(ctx, ruleID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *mockAlertRepositoryForService) UpdateAlertPeak(ctx context.Context, ruleID int, value float64, higher bool) error {
+	args := m.Called(ctx, ruleID, value, higher)
+	return args.Error(0)
+}
+
+func (m *mockAlertRepositoryForService) MarkAlertRateLimited(ctx context.Context, ruleID int) error {
+	args := m.Called(ctx, ruleID)
+	return args.Error(0)
+}
+
+func (m *mockAlertRepositoryForService) ResolveAlert(ctx context.Context, ruleID int) (*alerting.AlertEpisode, error) {
+	args := m.Called(ctx, ruleID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*alerting.AlertEpisode), args.Error(1)
+}
+
 func (m *mockAlertRepositoryForService) GetAllAlertRules(ctx context.Context) ([]alerting.AlertRule, error) {
 	args := m.Called(ctx)
 	return args.Get(0).([]alerting.AlertRule), args.Error(1)
@@ -101,6 +124,247 @@ func (m *mockAlertRepositoryForService) GetAlertRule(ctx context.Context, sensor
 	return args.Get(0).(*alerting.AlertRule), args.Error(1)
 }
 
+func (m *mockAlertRepositoryForService) GetAllCompoundAlertRules(ctx context.Context) ([]alerting.CompoundAlertRule, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]alerting.CompoundAlertRule), args.Error(1)
+}
+
+func (m *mockAlertRepositoryForService) GetCompoundAlertRuleByID(ctx context.Context, ruleID int) (*alerting.CompoundAlertRule, error) {
+	args := m.Called(ctx, ruleID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*alerting.CompoundAlertRule), args.Error(1)
+}
+
+func (m *mockAlertRepositoryForService) GetCompoundAlertRulesForReading(ctx context.Context, sensorID int, measurementTypeName string) ([]alerting.CompoundAlertRule, error) {
+	args := m.Called(ctx, sensorID, measurementTypeName)
+	return args.Get(0).([]alerting.CompoundAlertRule), args.Error(1)
+}
+
+func (m *mockAlertRepositoryForService) CreateCompoundAlertRule(ctx context.Context, rule *alerting.CompoundAlertRule) error {
+	args := m.Called(ctx, rule)
+	return args.Error(0)
+}
+
+func (m *mockAlertRepositoryForService) UpdateCompoundAlertRule(ctx context.Context, rule *alerting.CompoundAlertRule) error {
+	args := m.Called(ctx, rule)
+	return args.Error(0)
+}
+
+func (m *mockAlertRepositoryForService) DeleteCompoundAlertRule(ctx context.Context, ruleID int) error {
+	args := m.Called(ctx, ruleID)
+	return args.Error(0)
+}
+
+func (m *mockAlertRepositoryForService) RecordCompoundAlertSent(ctx context.Context, ruleID, sensorID, measurementTypeId int, reason string, numericValue float64, statusValue string) error {
+	args := m.Called(ctx, ruleID, sensorID, measurementTypeId, reason, numericValue, statusValue)
+	return args.Error(0)
+}
+
+func (m *mockAlertRepositoryForService) MarkCompoundAlertFiring(ctx context.Context, ruleID int) (bool, error) {
+	args := m.Called(ctx, ruleID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *mockAlertRepositoryForService) MarkCompoundAlertRateLimited(ctx context.Context, ruleID int) error {
+	args := m.Called(ctx, ruleID)
+	return args.Error(0)
+}
+
+func (m *mockAlertRepositoryForService) ResolveCompoundAlert(ctx context.Context, ruleID int) (*alerting.AlertEpisode, error) {
+	args := m.Called(ctx, ruleID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*alerting.AlertEpisode), args.Error(1)
+}
+
+func (m *mockAlertRepositoryForService) AcknowledgeCompoundAlertRule(ctx context.Context, ruleID, userID int) (bool, error) {
+	args := m.Called(ctx, ruleID, userID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *mockAlertRepositoryForService) SnoozeCompoundAlertRule(ctx context.Context, ruleID int, until *time.Time) error {
+	args := m.Called(ctx, ruleID, until)
+	return args.Error(0)
+}
+
+func (m *mockAlertRepositoryForService) AcknowledgeAlertRule(ctx context.Context, ruleID, userID int) (bool, error) {
+	args := m.Called(ctx, ruleID, userID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *mockAlertRepositoryForService) AcknowledgeAlertHistoryEntry(ctx context.Context, entryID, userID int) (bool, error) {
+	args := m.Called(ctx, entryID, userID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *mockAlertRepositoryForService) SnoozeAlertRule(ctx context.Context, ruleID int, until *time.Time) error {
+	args := m.Called(ctx, ruleID, until)
+	return args.Error(0)
+}
+
+func (m *mockAlertRepositoryForService) GetAllSilenceWindows(ctx context.Context) ([]alerting.SilenceWindow, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]alerting.SilenceWindow), args.Error(1)
+}
+
+func (m *mockAlertRepositoryForService) GetSilenceWindowByID(ctx context.Context, windowID int) (*alerting.SilenceWindow, error) {
+	args := m.Called(ctx, windowID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*alerting.SilenceWindow), args.Error(1)
+}
+
+func (m *mockAlertRepositoryForService) GetSilenceWindowsForSensor(ctx context.Context, sensorID int) ([]alerting.SilenceWindow, error) {
+	args := m.Called(ctx, sensorID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]alerting.SilenceWindow), args.Error(1)
+}
+
+func (m *mockAlertRepositoryForService) CreateSilenceWindow(ctx context.Context, window *alerting.SilenceWindow) error {
+	args := m.Called(ctx, window)
+	return args.Error(0)
+}
+
+func (m *mockAlertRepositoryForService) UpdateSilenceWindow(ctx context.Context, window *alerting.SilenceWindow) error {
+	args := m.Called(ctx, window)
+	return args.Error(0)
+}
+
+func (m *mockAlertRepositoryForService) DeleteSilenceWindow(ctx context.Context, windowID int) error {
+	args := m.Called(ctx, windowID)
+	return args.Error(0)
+}
+
+func (m *mockAlertRepositoryForService) GetEscalationSteps(ctx context.Context, ruleID int) ([]alerting.EscalationStep, error) {
+	args := m.Called(ctx, ruleID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]alerting.EscalationStep), args.Error(1)
+}
+
+func (m *mockAlertRepositoryForService) SetEscalationSteps(ctx context.Context, ruleID int, steps []alerting.EscalationStep) error {
+	args := m.Called(ctx, ruleID, steps)
+	return args.Error(0)
+}
+
+func (m *mockAlertRepositoryForService) StartEscalation(ctx context.Context, ruleID, notificationID int, dueAt time.Time) error {
+	args := m.Called(ctx, ruleID, notificationID, dueAt)
+	return args.Error(0)
+}
+
+func (m *mockAlertRepositoryForService) GetDueEscalations(ctx context.Context, now time.Time) ([]alerting.Escalation, error) {
+	args := m.Called(ctx, now)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]alerting.Escalation), args.Error(1)
+}
+
+func (m *mockAlertRepositoryForService) AdvanceEscalation(ctx context.Context, escalationID, notificationID, nextStep int, dueAt time.Time) error {
+	args := m.Called(ctx, escalationID, notificationID, nextStep, dueAt)
+	return args.Error(0)
+}
+
+func (m *mockAlertRepositoryForService) EndEscalation(ctx context.Context, escalationID int) error {
+	args := m.Called(ctx, escalationID)
+	return args.Error(0)
+}
+
+func (m *mockAlertRepositoryForService) GetAlertRecipients(ctx context.Context, ruleID int) (alerting.AlertRecipients, error) {
+	args := m.Called(ctx, ruleID)
+	return args.Get(0).(alerting.AlertRecipients), args.Error(1)
+}
+
+func (m *mockAlertRepositoryForService) SetAlertRecipients(ctx context.Context, ruleID int, recipients alerting.AlertRecipients) error {
+	args := m.Called(ctx, ruleID, recipients)
+	return args.Error(0)
+}
+
+func (m *mockAlertRepositoryForService) GetAlertRecipientUserIDs(ctx context.Context, ruleID int) ([]int, bool, error) {
+	args := m.Called(ctx, ruleID)
+	if args.Get(0) == nil {
+		return nil, args.Bool(1), args.Error(2)
+	}
+	return args.Get(0).([]int), args.Bool(1), args.Error(2)
+}
+
+func (m *mockAlertRepositoryForService) GetCompoundEscalationSteps(ctx context.Context, ruleID int) ([]alerting.EscalationStep, error) {
+	args := m.Called(ctx, ruleID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]alerting.EscalationStep), args.Error(1)
+}
+
+func (m *mockAlertRepositoryForService) SetCompoundEscalationSteps(ctx context.Context, ruleID int, steps []alerting.EscalationStep) error {
+	args := m.Called(ctx, ruleID, steps)
+	return args.Error(0)
+}
+
+func (m *mockAlertRepositoryForService) StartCompoundEscalation(ctx context.Context, ruleID, notificationID int, dueAt time.Time) error {
+	args := m.Called(ctx, ruleID, notificationID, dueAt)
+	return args.Error(0)
+}
+
+func (m *mockAlertRepositoryForService) GetCompoundAlertRecipients(ctx context.Context, ruleID int) (alerting.AlertRecipients, error) {
+	args := m.Called(ctx, ruleID)
+	return args.Get(0).(alerting.AlertRecipients), args.Error(1)
+}
+
+func (m *mockAlertRepositoryForService) SetCompoundAlertRecipients(ctx context.Context, ruleID int, recipients alerting.AlertRecipients) error {
+	args := m.Called(ctx, ruleID, recipients)
+	return args.Error(0)
+}
+
+func (m *mockAlertRepositoryForService) GetCompoundAlertRecipientUserIDs(ctx context.Context, ruleID int) ([]int, bool, error) {
+	args := m.Called(ctx, ruleID)
+	if args.Get(0) == nil {
+		return nil, args.Bool(1), args.Error(2)
+	}
+	return args.Get(0).([]int), args.Bool(1), args.Error(2)
+}
+
+func (m *mockAlertRepositoryForService) GetAllContactGroups(ctx context.Context) ([]alerting.ContactGroup, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]alerting.ContactGroup), args.Error(1)
+}
+
+func (m *mockAlertRepositoryForService) GetContactGroupByID(ctx context.Context, groupID int) (*alerting.ContactGroup, error) {
+	args := m.Called(ctx, groupID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*alerting.ContactGroup), args.Error(1)
+}
+
+func (m *mockAlertRepositoryForService) CreateContactGroup(ctx context.Context, group *alerting.ContactGroup) error {
+	args := m.Called(ctx, group)
+	return args.Error(0)
+}
+
+func (m *mockAlertRepositoryForService) UpdateContactGroup(ctx context.Context, group *alerting.ContactGroup) error {
+	args := m.Called(ctx, group)
+	return args.Error(0)
+}
+
+func (m *mockAlertRepositoryForService) DeleteContactGroup(ctx context.Context, groupID int) error {
+	args := m.Called(ctx, groupID)
+	return args.Error(0)
+}
+
 func TestServiceGetAllAlertRules(t *testing.T) {
 	mockRepo := new(mockAlertRepositoryForService)
 	service := NewAlertManagementService(mockRepo, slog.Default())
@@ -211,3 +475,67 @@ func TestServiceGetAlertHistory(t *testing.T) {
 	assert.Equal(t, 1, history[0].SensorId)
 	mockRepo.AssertExpectations(t)
 }
+
+func TestServiceGetAllCompoundAlertRules(t *testing.T) {
+	mockRepo := new(mockAlertRepositoryForService)
+	service := NewAlertManagementService(mockRepo, slog.Default())
+
+	expectedRules := []alerting.CompoundAlertRule{
+		{ID: 1, Name: "Window open in the cold", Operator: alerting.CompoundOperatorAnd},
+	}
+
+	mockRepo.On("GetAllCompoundAlertRules", mock.Anything).Return(expectedRules, nil)
+
+	rules, err := service.ServiceGetAllCompoundAlertRules(context.Background())
+
+	assert.NoError(t, err)
+	assert.Equal(t, expectedRules, rules)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestServiceCreateCompoundAlertRule(t *testing.T) {
+	mockRepo := new(mockAlertRepositoryForService)
+	service := NewAlertManagementService(mockRepo, slog.Default())
+
+	newRule := &alerting.CompoundAlertRule{
+		Name:     "Bathroom humidity",
+		Operator: alerting.CompoundOperatorOr,
+		Enabled:  true,
+	}
+
+	mockRepo.On("CreateCompoundAlertRule", mock.Anything, newRule).Return(nil)
+
+	err := service.ServiceCreateCompoundAlertRule(context.Background(), newRule)
+
+	assert.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestServiceSetEscalationSteps(t *testing.T) {
+	mockRepo := new(mockAlertRepositoryForService)
+	service := NewAlertManagementService(mockRepo, slog.Default())
+
+	steps := []alerting.EscalationStep{
+		{DelayMinutes: 15},
+		{DelayMinutes: 30, Role: "admin", Channels: []alerting.EscalationChannel{alerting.EscalationChannelPush}},
+	}
+	mockRepo.On("SetEscalationSteps", mock.Anything, 4, steps).Return(nil)
+
+	err := service.ServiceSetEscalationSteps(context.Background(), 4, steps)
+
+	assert.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestServiceSetAlertRecipients(t *testing.T) {
+	mockRepo := new(mockAlertRepositoryForService)
+	service := NewAlertManagementService(mockRepo, slog.Default())
+
+	recipients := alerting.AlertRecipients{UserIDs: []int{2}, Roles: []string{}, ContactGroupIDs: []int{7}}
+	mockRepo.On("SetAlertRecipients", mock.Anything, 4, recipients).Return(nil)
+
+	err := service.ServiceSetAlertRecipients(context.Background(), 4, recipients)
+
+	assert.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+}
@@ -0,0 +1,153 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"example/sensorHub/alerting"
+	"example/sensorHub/automation"
+	database "example/sensorHub/db"
+	"example/sensorHub/drivers"
+	"example/sensorHub/scenes"
+)
+
+var (
+	ErrAutomationRuleNotFound = errors.New("automation rule not found")
+	// ErrInvalidAutomationRule is returned for rules whose sensors, alert rule or scene do
+	// not exist, or whose target cannot accept the rule's command.
+	ErrInvalidAutomationRule = errors.New("invalid automation rule")
+)
+
+// AutomationAlertRuleRepository is the subset of db.AlertRepository needed to check the
+// alert rule of alert-triggered automations.
+type AutomationAlertRuleRepository interface {
+	GetAlertRuleByID(ctx context.Context, ruleID int) (*alerting.AlertRule, error)
+}
+
+// AutomationSceneRepository is the subset of db.SceneRepository needed to check the scene
+// of rules that activate one.
+type AutomationSceneRepository interface {
+	GetSceneByID(ctx context.Context, sceneID int) (*scenes.Scene, error)
+}
+
+type AutomationService struct {
+	repo       database.AutomationRepository
+	sensorRepo CommandSensorRepository
+	alertRepo  AutomationAlertRuleRepository
+	sceneRepo  AutomationSceneRepository
+	logger     *slog.Logger
+}
+
+func NewAutomationService(repo database.AutomationRepository, sensorRepo CommandSensorRepository, alertRepo AutomationAlertRuleRepository, sceneRepo AutomationSceneRepository, logger *slog.Logger) *AutomationService {
+	return &AutomationService{
+		repo:       repo,
+		sensorRepo: sensorRepo,
+		alertRepo:  alertRepo,
+		sceneRepo:  sceneRepo,
+		logger:     logger.With("component", "automation_service"),
+	}
+}
+
+func (s *AutomationService) ServiceGetAllAutomationRules(ctx context.Context) ([]automation.Rule, error) {
+	rules, err := s.repo.GetAllAutomationRules(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error listing automation rules: %w", err)
+	}
+	return rules, nil
+}
+
+func (s *AutomationService) ServiceGetAutomationRuleByID(ctx context.Context, ruleID int) (*automation.Rule, error) {
+	rule, err := s.repo.GetAutomationRuleByID(ctx, ruleID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting automation rule: %w", err)
+	}
+	if rule == nil {
+		return nil, ErrAutomationRuleNotFound
+	}
+	return rule, nil
+}
+
+// ServiceCreateAutomationRule creates a rule after checking that its trigger and target
+// exist and that the target accepts the command.
+func (s *AutomationService) ServiceCreateAutomationRule(ctx context.Context, rule *automation.Rule) error {
+	if err := s.checkReferences(ctx, rule); err != nil {
+		return err
+	}
+	if err := s.repo.CreateAutomationRule(ctx, rule); err != nil {
+		return fmt.Errorf("error creating automation rule: %w", err)
+	}
+	s.logger.Info("automation rule created", "id", rule.ID, "name", rule.Name, "trigger", rule.Describe())
+	return nil
+}
+
+func (s *AutomationService) ServiceUpdateAutomationRule(ctx context.Context, rule *automation.Rule) error {
+	if _, err := s.ServiceGetAutomationRuleByID(ctx, rule.ID); err != nil {
+		return err
+	}
+	if err := s.checkReferences(ctx, rule); err != nil {
+		return err
+	}
+	if err := s.repo.UpdateAutomationRule(ctx, rule); err != nil {
+		return fmt.Errorf("error updating automation rule: %w", err)
+	}
+	s.logger.Info("automation rule updated", "id", rule.ID, "name", rule.Name)
+	return nil
+}
+
+func (s *AutomationService) ServiceDeleteAutomationRule(ctx context.Context, ruleID int) error {
+	if _, err := s.ServiceGetAutomationRuleByID(ctx, ruleID); err != nil {
+		return err
+	}
+	if err := s.repo.DeleteAutomationRule(ctx, ruleID); err != nil {
+		return fmt.Errorf("error deleting automation rule: %w", err)
+	}
+	s.logger.Info("automation rule deleted", "id", ruleID)
+	return nil
+}
+
+// checkReferences checks what Rule.Validate cannot: that the watched sensor or alert rule
+// exists, and that the target scene exists or the target is a controllable sensor able to
+// build the rule's command.
+func (s *AutomationService) checkReferences(ctx context.Context, rule *automation.Rule) error {
+	switch rule.TriggerType {
+	case automation.TriggerReading:
+		if sensor, err := s.sensorRepo.GetSensorById(ctx, *rule.SensorID); err != nil || sensor == nil {
+			return fmt.Errorf("%w: sensor %d not found", ErrInvalidAutomationRule, *rule.SensorID)
+		}
+	case automation.TriggerAlert:
+		alertRule, err := s.alertRepo.GetAlertRuleByID(ctx, *rule.AlertRuleID)
+		if err != nil {
+			return fmt.Errorf("error getting alert rule: %w", err)
+		}
+		if alertRule == nil {
+			return fmt.Errorf("%w: alert rule %d not found", ErrInvalidAutomationRule, *rule.AlertRuleID)
+		}
+	}
+
+	if rule.SceneID != nil {
+		scene, err := s.sceneRepo.GetSceneByID(ctx, *rule.SceneID)
+		if err != nil {
+			return fmt.Errorf("error getting scene: %w", err)
+		}
+		if scene == nil {
+			return fmt.Errorf("%w: scene %d not found", ErrInvalidAutomationRule, *rule.SceneID)
+		}
+		return nil
+	}
+
+	targetID := *rule.TargetSensorID
+	target, err := s.sensorRepo.GetSensorById(ctx, targetID)
+	if err != nil || target == nil {
+		return fmt.Errorf("%w: target sensor %d not found", ErrInvalidAutomationRule, targetID)
+	}
+	commandDriver, ok := drivers.GetCommandDriver(target.SensorDriver)
+	if !ok {
+		return fmt.Errorf("%w: target sensor %d is not controllable", ErrInvalidAutomationRule, targetID)
+	}
+	if _, _, err := commandDriver.BuildCommand(*target, rule.Property, rule.Value); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidAutomationRule, err)
+	}
+	return nil
+}
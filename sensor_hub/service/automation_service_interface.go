@@ -0,0 +1,15 @@
+package service
+
+import (
+	"context"
+
+	"example/sensorHub/automation"
+)
+
+type AutomationServiceInterface interface {
+	ServiceGetAllAutomationRules(ctx context.Context) ([]automation.Rule, error)
+	ServiceGetAutomationRuleByID(ctx context.Context, ruleID int) (*automation.Rule, error)
+	ServiceCreateAutomationRule(ctx context.Context, rule *automation.Rule) error
+	ServiceUpdateAutomationRule(ctx context.Context, rule *automation.Rule) error
+	ServiceDeleteAutomationRule(ctx context.Context, ruleID int) error
+}
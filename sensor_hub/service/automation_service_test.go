@@ -0,0 +1,194 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"example/sensorHub/alerting"
+	"example/sensorHub/automation"
+	gen "example/sensorHub/gen"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type mockAutomationRepository struct {
+	mock.Mock
+}
+
+func (m *mockAutomationRepository) GetAllAutomationRules(ctx context.Context) ([]automation.Rule, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]automation.Rule), args.Error(1)
+}
+
+func (m *mockAutomationRepository) GetAutomationRuleByID(ctx context.Context, ruleID int) (*automation.Rule, error) {
+	args := m.Called(ctx, ruleID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*automation.Rule), args.Error(1)
+}
+
+func (m *mockAutomationRepository) CreateAutomationRule(ctx context.Context, rule *automation.Rule) error {
+	return m.Called(ctx, rule).Error(0)
+}
+
+func (m *mockAutomationRepository) UpdateAutomationRule(ctx context.Context, rule *automation.Rule) error {
+	return m.Called(ctx, rule).Error(0)
+}
+
+func (m *mockAutomationRepository) DeleteAutomationRule(ctx context.Context, ruleID int) error {
+	return m.Called(ctx, ruleID).Error(0)
+}
+
+func (m *mockAutomationRepository) GetReadingRulesForSensor(ctx context.Context, sensorID int) ([]automation.Rule, error) {
+	args := m.Called(ctx, sensorID)
+	return args.Get(0).([]automation.Rule), args.Error(1)
+}
+
+func (m *mockAutomationRepository) GetRulesForAlert(ctx context.Context, alertRuleID int) ([]automation.Rule, error) {
+	args := m.Called(ctx, alertRuleID)
+	return args.Get(0).([]automation.Rule), args.Error(1)
+}
+
+func (m *mockAutomationRepository) GetScheduleRules(ctx context.Context) ([]automation.Rule, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]automation.Rule), args.Error(1)
+}
+
+func (m *mockAutomationRepository) SetRuleActive(ctx context.Context, ruleID int, active bool) (bool, error) {
+	args := m.Called(ctx, ruleID, active)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *mockAutomationRepository) RecordRuleTriggered(ctx context.Context, ruleID int, at time.Time) error {
+	return m.Called(ctx, ruleID, at).Error(0)
+}
+
+func (m *mockAutomationRepository) ClaimScheduledRun(ctx context.Context, ruleID int, minute time.Time) (bool, error) {
+	args := m.Called(ctx, ruleID, minute)
+	return args.Bool(0), args.Error(1)
+}
+
+func newAutomationServiceForTest() (*AutomationService, *mockAutomationRepository, *mockCommandSensorRepository, *mockAlertRepositoryForService) {
+	repo := &mockAutomationRepository{}
+	sensorRepo := &mockCommandSensorRepository{}
+	alertRepo := &mockAlertRepositoryForService{}
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	return NewAutomationService(repo, sensorRepo, alertRepo, &mockSceneRepository{}, logger), repo, sensorRepo, alertRepo
+}
+
+func dehumidifierPlug() *gen.Sensor {
+	return &gen.Sensor{
+		Id:           2,
+		Name:         "dehumidifier-plug",
+		SensorDriver: "mqtt-zigbee2mqtt",
+		Status:       gen.SensorStatusActive,
+		Enabled:      true,
+		Metadata: &map[string]interface{}{
+			"exposes": []interface{}{
+				map[string]interface{}{"type": "binary", "property": "state", "access": float64(7), "value_on": "ON", "value_off": "OFF"},
+			},
+		},
+	}
+}
+
+func alertTriggeredRule(value string) *automation.Rule {
+	alertRuleID, targetSensorID := 8, 2
+	return &automation.Rule{
+		Name: "Fan on alert", Enabled: true, TriggerType: automation.TriggerAlert, AlertRuleID: &alertRuleID,
+		TargetSensorID: &targetSensorID, Property: "state", Value: value,
+	}
+}
+
+func TestAutomationService_Create_ChecksReferences(t *testing.T) {
+	svc, repo, sensorRepo, alertRepo := newAutomationServiceForTest()
+	rule := alertTriggeredRule("ON")
+	alertRepo.On("GetAlertRuleByID", mock.Anything, 8).Return(&alerting.AlertRule{ID: 8}, nil)
+	sensorRepo.On("GetSensorById", mock.Anything, 2).Return(dehumidifierPlug(), nil)
+	repo.On("CreateAutomationRule", mock.Anything, rule).Return(nil)
+
+	require.NoError(t, svc.ServiceCreateAutomationRule(context.Background(), rule))
+	repo.AssertExpectations(t)
+}
+
+func TestAutomationService_Create_RejectsMissingAlertRule(t *testing.T) {
+	svc, repo, _, alertRepo := newAutomationServiceForTest()
+	alertRepo.On("GetAlertRuleByID", mock.Anything, 8).Return(nil, nil)
+
+	err := svc.ServiceCreateAutomationRule(context.Background(), alertTriggeredRule("ON"))
+
+	assert.ErrorIs(t, err, ErrInvalidAutomationRule)
+	repo.AssertNotCalled(t, "CreateAutomationRule", mock.Anything, mock.Anything)
+}
+
+func TestAutomationService_Create_RejectsValueTargetCannotAccept(t *testing.T) {
+	svc, repo, sensorRepo, alertRepo := newAutomationServiceForTest()
+	alertRepo.On("GetAlertRuleByID", mock.Anything, 8).Return(&alerting.AlertRule{ID: 8}, nil)
+	sensorRepo.On("GetSensorById", mock.Anything, 2).Return(dehumidifierPlug(), nil)
+
+	err := svc.ServiceCreateAutomationRule(context.Background(), alertTriggeredRule("MAYBE"))
+
+	assert.ErrorIs(t, err, ErrInvalidAutomationRule)
+	repo.AssertNotCalled(t, "CreateAutomationRule", mock.Anything, mock.Anything)
+}
+
+func TestAutomationService_Create_RejectsUncontrollableTarget(t *testing.T) {
+	svc, _, sensorRepo, alertRepo := newAutomationServiceForTest()
+	alertRepo.On("GetAlertRuleByID", mock.Anything, 8).Return(&alerting.AlertRule{ID: 8}, nil)
+	sensorRepo.On("GetSensorById", mock.Anything, 2).Return(&gen.Sensor{Id: 2, SensorDriver: "sensor-hub-http-temperature"}, nil)
+
+	err := svc.ServiceCreateAutomationRule(context.Background(), alertTriggeredRule("ON"))
+
+	assert.ErrorIs(t, err, ErrInvalidAutomationRule)
+	assert.Contains(t, err.Error(), "not controllable")
+}
+
+func TestAutomationService_Create_ChecksScene(t *testing.T) {
+	svc, repo, _, alertRepo := newAutomationServiceForTest()
+	sceneRepo := svc.sceneRepo.(*mockSceneRepository)
+	alertRepo.On("GetAlertRuleByID", mock.Anything, 8).Return(&alerting.AlertRule{ID: 8}, nil)
+	sceneRepo.On("GetSceneByID", mock.Anything, 4).Return(nil, nil)
+	rule := alertTriggeredRule("")
+	sceneID := 4
+	rule.TargetSensorID, rule.Property, rule.SceneID = nil, "", &sceneID
+
+	err := svc.ServiceCreateAutomationRule(context.Background(), rule)
+
+	assert.ErrorIs(t, err, ErrInvalidAutomationRule)
+	assert.Contains(t, err.Error(), "scene 4 not found")
+
+	sceneRepo.ExpectedCalls = nil
+	sceneRepo.On("GetSceneByID", mock.Anything, 4).Return(movieNight(), nil)
+	repo.On("CreateAutomationRule", mock.Anything, rule).Return(nil)
+	require.NoError(t, svc.ServiceCreateAutomationRule(context.Background(), rule))
+}
+
+func TestAutomationService_Update_NotFound(t *testing.T) {
+	svc, repo, _, _ := newAutomationServiceForTest()
+	rule := alertTriggeredRule("ON")
+	rule.ID = 5
+	repo.On("GetAutomationRuleByID", mock.Anything, 5).Return(nil, nil)
+
+	err := svc.ServiceUpdateAutomationRule(context.Background(), rule)
+
+	assert.ErrorIs(t, err, ErrAutomationRuleNotFound)
+}
+
+func TestAutomationService_Delete_PropagatesRepositoryError(t *testing.T) {
+	svc, repo, _, _ := newAutomationServiceForTest()
+	repo.On("GetAutomationRuleByID", mock.Anything, 5).Return(alertTriggeredRule("ON"), nil)
+	repo.On("DeleteAutomationRule", mock.Anything, 5).Return(errors.New("db down"))
+
+	err := svc.ServiceDeleteAutomationRule(context.Background(), 5)
+
+	assert.ErrorContains(t, err, "db down")
+}
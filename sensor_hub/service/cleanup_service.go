@@ -72,6 +72,9 @@ func (cs *cleanupService) StartPeriodicCleanup(ctx context.Context) {
 	sensorDataRetentionDays := appProps.AppConfig.SensorDataRetentionDays
 	failedLoginRetentionDays := appProps.AppConfig.FailedLoginRetentionDays
 	alertHistoryRetentionDays := appProps.AppConfig.AlertHistoryRetentionDays
+	downsampledRetentionDays := appProps.AppConfig.DownsampledDataRetentionDays
+	rollup5mRetentionDays := appProps.AppConfig.ReadingsRollup5mRetentionDays
+	rollup1hRetentionDays := appProps.AppConfig.ReadingsRollup1hRetentionDays
 
 	periodic.RunTask(ctx, periodic.TaskConfig{
 		Name:           "data_cleanup",
@@ -79,6 +82,8 @@ func (cs *cleanupService) StartPeriodicCleanup(ctx context.Context) {
 		Logger:         cs.logger,
 		RunImmediately: true,
 	}, func(ctx context.Context) error {
+		cs.cleanupDownsampledReadings(ctx, downsampledRetentionDays)
+		cs.cleanupRollups(ctx, rollup5mRetentionDays, rollup1hRetentionDays)
 		return cs.performCleanup(ctx, healthHistoryRetentionDays, sensorDataRetentionDays, failedLoginRetentionDays, alertHistoryRetentionDays)
 	})
 }
@@ -168,6 +173,39 @@ func (cs *cleanupService) performCleanup(ctx context.Context, healthHistoryReten
 	return nil
 }
 
+// cleanupDownsampledReadings applies the retention of readings that raw readings
+// retention downsampled rather than deleted.
+func (cs *cleanupService) cleanupDownsampledReadings(ctx context.Context, downsampledRetentionDays int) {
+	if downsampledRetentionDays <= 0 {
+		return
+	}
+	cs.logger.Debug("cleaning up old downsampled readings", "retention_days", downsampledRetentionDays)
+	if err := cs.readingsRepo.DeleteDownsampledReadingsOlderThan(ctx, time.Now().AddDate(0, 0, -downsampledRetentionDays)); err != nil {
+		cs.logger.Warn("failed to cleanup old downsampled readings", "error", err)
+	}
+}
+
+// cleanupRollups applies the readings rollups' own retention, which is independent of
+// raw readings retention so long-range charts outlive the raw data.
+func (cs *cleanupService) cleanupRollups(ctx context.Context, rollup5mRetentionDays int, rollup1hRetentionDays int) {
+	retention := []struct {
+		rollup database.AggregationInterval
+		days   int
+	}{
+		{database.AggregationPT5M, rollup5mRetentionDays},
+		{database.AggregationPT1H, rollup1hRetentionDays},
+	}
+	for _, r := range retention {
+		if r.days <= 0 {
+			continue
+		}
+		cs.logger.Debug("cleaning up old readings rollups", "rollup", r.rollup, "retention_days", r.days)
+		if err := cs.readingsRepo.DeleteRollupsOlderThan(ctx, r.rollup, time.Now().AddDate(0, 0, -r.days)); err != nil {
+			cs.logger.Warn("failed to cleanup old readings rollups", "rollup", r.rollup, "error", err)
+		}
+	}
+}
+
 func (cs *cleanupService) performDatabaseMaintenance(ctx context.Context) error {
 	statsBefore, err := cs.maintenanceRepo.DatabaseStats(ctx)
 	if err != nil {
@@ -368,6 +368,58 @@ func TestCleanupService_PerformCleanup_AlertHistoryError_DoesNotFail(t *testing.
 	assert.NoError(t, err)
 }
 
+// ============================================================================
+// Downsampled readings retention tests
+// ============================================================================
+
+func TestCleanupService_CleanupDownsampledReadings(t *testing.T) {
+	service, _, readingsRepo, _, _, _ := setupCleanupService()
+
+	readingsRepo.On("DeleteDownsampledReadingsOlderThan", mock.Anything, mock.MatchedBy(func(t time.Time) bool {
+		return time.Since(t) > 729*24*time.Hour && time.Since(t) < 731*24*time.Hour
+	})).Return(nil)
+
+	service.cleanupDownsampledReadings(context.Background(), 730)
+
+	readingsRepo.AssertExpectations(t)
+}
+
+func TestCleanupService_CleanupDownsampledReadings_ZeroKeepsForever(t *testing.T) {
+	service, _, readingsRepo, _, _, _ := setupCleanupService()
+
+	service.cleanupDownsampledReadings(context.Background(), 0)
+
+	readingsRepo.AssertNotCalled(t, "DeleteDownsampledReadingsOlderThan", mock.Anything, mock.Anything)
+}
+
+// ============================================================================
+// Readings rollup retention tests
+// ============================================================================
+
+func TestCleanupService_CleanupRollups_ZeroRetentionKeepsRollup(t *testing.T) {
+	service, _, readingsRepo, _, _, _ := setupCleanupService()
+
+	readingsRepo.On("DeleteRollupsOlderThan", mock.Anything, database.AggregationPT5M, mock.MatchedBy(func(t time.Time) bool {
+		return time.Since(t) > 364*24*time.Hour && time.Since(t) < 366*24*time.Hour
+	})).Return(nil)
+
+	service.cleanupRollups(context.Background(), 365, 0)
+
+	readingsRepo.AssertExpectations(t)
+	readingsRepo.AssertNotCalled(t, "DeleteRollupsOlderThan", mock.Anything, database.AggregationPT1H, mock.Anything)
+}
+
+func TestCleanupService_CleanupRollups_ErrorDoesNotStopOtherRollups(t *testing.T) {
+	service, _, readingsRepo, _, _, _ := setupCleanupService()
+
+	readingsRepo.On("DeleteRollupsOlderThan", mock.Anything, database.AggregationPT5M, mock.AnythingOfType("time.Time")).Return(errors.New("database error"))
+	readingsRepo.On("DeleteRollupsOlderThan", mock.Anything, database.AggregationPT1H, mock.AnythingOfType("time.Time")).Return(nil)
+
+	service.cleanupRollups(context.Background(), 90, 1825)
+
+	readingsRepo.AssertExpectations(t)
+}
+
 // ============================================================================
 // NewCleanupService tests
 // ============================================================================
@@ -31,7 +31,7 @@ type CommandSubscriptionRepository interface {
 
 type CommandHistoryRepository interface {
 	HasPendingCommand(ctx context.Context, sensorID int, property string) (bool, error)
-	AddSentCommand(ctx context.Context, sensorID int, userID *int, property string, value string, mqttTopic string, mqttPayload string, timeoutSeconds int, sentAt time.Time) (int, error)
+	AddSentCommand(ctx context.Context, sensorID int, userID *int, automationRuleID *int, property string, value string, mqttTopic string, mqttPayload string, timeoutSeconds int, sentAt time.Time) (int, error)
 	ListBySensorID(ctx context.Context, sensorID int, limit int) ([]gen.CommandHistoryEntry, error)
 }
 
@@ -46,6 +46,12 @@ type SentCommandResult struct {
 	Value    string
 }
 
+// CommandSentObserver is told about every command CommandService sends, once it is
+// tracked and before it is published, so that the command's outcome can be matched to it.
+type CommandSentObserver interface {
+	CommandSent(ctx context.Context, sensorID int, command SentCommandResult)
+}
+
 type CommandError struct {
 	StatusCode int
 	Message    string
@@ -65,6 +71,7 @@ type CommandService struct {
 	historyRepo CommandHistoryRepository
 	publisher   CommandPublisher
 	lifecycle   actuation.LifecycleManager
+	observers   []CommandSentObserver
 	logger      *slog.Logger
 }
 
@@ -82,6 +89,12 @@ func NewCommandService(sensorRepo CommandSensorRepository, subRepo CommandSubscr
 	}
 }
 
+// AddSentObserver registers an observer of sent commands. It must be called before any
+// command is sent.
+func (s *CommandService) AddSentObserver(observer CommandSentObserver) {
+	s.observers = append(s.observers, observer)
+}
+
 func (s *CommandService) GetHistory(ctx context.Context, sensorID int) ([]gen.CommandHistoryEntry, error) {
 	sensor, err := s.sensorRepo.GetSensorById(ctx, sensorID)
 	if err != nil || sensor == nil {
@@ -101,6 +114,23 @@ func (s *CommandService) GetHistory(ctx context.Context, sensorID int) ([]gen.Co
 	return history, nil
 }
 
+// commandOrigin records who a command is sent on behalf of in Command History.
+type commandOrigin struct {
+	userID           *int
+	automationRuleID *int
+}
+
+// systemActorID is the user ID of actors that stand for Sensor Hub itself. It matches no
+// user account.
+const systemActorID = 0
+
+// NewSystemActor returns an actor for commands Sensor Hub sends on its own behalf, such as
+// scheduled commands. It has the control_sensors permission, and its commands are recorded
+// in Command History without a user.
+func NewSystemActor(name string) *gen.User {
+	return &gen.User{Id: systemActorID, Username: name, Permissions: []string{"control_sensors"}}
+}
+
 func (s *CommandService) Send(ctx context.Context, sensorID int, actor *gen.User, property string, value string) (SentCommandResult, error) {
 	sensor, err := s.sensorRepo.GetSensorById(ctx, sensorID)
 	if err != nil || sensor == nil {
@@ -114,6 +144,32 @@ func (s *CommandService) Send(ctx context.Context, sensorID int, actor *gen.User
 		return SentCommandResult{}, newCommandError(http.StatusForbidden, "missing control_sensors permission")
 	}
 
+	var origin commandOrigin
+	if actor.Id != systemActorID {
+		userID := actor.Id
+		origin.userID = &userID
+	}
+	return s.send(ctx, sensor, origin, property, value)
+}
+
+// SendForAutomation sends a command issued by an automation rule and returns the ID of
+// its Command History entry. No user permission is checked: the rule was authorised when
+// it was saved. The command is otherwise handled exactly like one sent by a user.
+func (s *CommandService) SendForAutomation(ctx context.Context, ruleID, sensorID int, property, value string) (int, error) {
+	sensor, err := s.sensorRepo.GetSensorById(ctx, sensorID)
+	if err != nil || sensor == nil {
+		return 0, newCommandError(http.StatusNotFound, fmt.Sprintf("sensor %d not found", sensorID))
+	}
+
+	result, err := s.send(ctx, sensor, commandOrigin{automationRuleID: &ruleID}, property, value)
+	if err != nil {
+		return 0, err
+	}
+	return result.ID, nil
+}
+
+func (s *CommandService) send(ctx context.Context, sensor *gen.Sensor, origin commandOrigin, property string, value string) (SentCommandResult, error) {
+	sensorID := sensor.Id
 	commandDriver, ok := drivers.GetCommandDriver(sensor.SensorDriver)
 	if !ok {
 		return SentCommandResult{}, newCommandError(http.StatusBadRequest, fmt.Sprintf("sensor %d is not controllable", sensorID))
@@ -146,8 +202,7 @@ func (s *CommandService) Send(ctx context.Context, sensorID int, actor *gen.User
 
 	timeoutSeconds := resolveCommandTimeoutSeconds()
 	sentAt := time.Now().UTC()
-	userID := actor.Id
-	commandID, err := s.historyRepo.AddSentCommand(ctx, sensor.Id, &userID, property, value, topic, string(payload), timeoutSeconds, sentAt)
+	commandID, err := s.historyRepo.AddSentCommand(ctx, sensor.Id, origin.userID, origin.automationRuleID, property, value, topic, string(payload), timeoutSeconds, sentAt)
 	if err != nil {
 		return SentCommandResult{}, fmt.Errorf("persist sent command: %w", err)
 	}
@@ -165,6 +220,15 @@ func (s *CommandService) Send(ctx context.Context, sensorID int, actor *gen.User
 	if s.lifecycle != nil {
 		s.lifecycle.Track(backgroundCtx, commandRecord)
 	}
+	result := SentCommandResult{
+		ID:       commandID,
+		Status:   actuation.CommandStatusSent,
+		Property: property,
+		Value:    value,
+	}
+	for _, observer := range s.observers {
+		observer.CommandSent(backgroundCtx, sensor.Id, result)
+	}
 
 	var lastDisconnectedErr error
 	for _, subscription := range subscriptions {
@@ -188,12 +252,7 @@ func (s *CommandService) Send(ctx context.Context, sensorID int, actor *gen.User
 		return SentCommandResult{}, newCommandError(http.StatusServiceUnavailable, lastDisconnectedErr.Error())
 	}
 
-	return SentCommandResult{
-		ID:       commandID,
-		Status:   actuation.CommandStatusSent,
-		Property: property,
-		Value:    value,
-	}, nil
+	return result, nil
 }
 
 func hasPermission(permissions []string, required string) bool {
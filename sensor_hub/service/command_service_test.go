@@ -43,8 +43,8 @@ func (m *mockCommandHistoryRepository) HasPendingCommand(ctx context.Context, se
 	return args.Bool(0), args.Error(1)
 }
 
-func (m *mockCommandHistoryRepository) AddSentCommand(ctx context.Context, sensorID int, userID *int, property string, value string, mqttTopic string, mqttPayload string, timeoutSeconds int, sentAt time.Time) (int, error) {
-	args := m.Called(ctx, sensorID, userID, property, value, mqttTopic, mqttPayload, timeoutSeconds, sentAt)
+func (m *mockCommandHistoryRepository) AddSentCommand(ctx context.Context, sensorID int, userID *int, automationRuleID *int, property string, value string, mqttTopic string, mqttPayload string, timeoutSeconds int, sentAt time.Time) (int, error) {
+	args := m.Called(ctx, sensorID, userID, automationRuleID, property, value, mqttTopic, mqttPayload, timeoutSeconds, sentAt)
 	return args.Int(0), args.Error(1)
 }
 
@@ -126,6 +126,14 @@ func (f *fakeCommandLifecycle) RecoverPending(context.Context) error {
 	return nil
 }
 
+type recordingSentObserver struct {
+	sent []SentCommandResult
+}
+
+func (r *recordingSentObserver) CommandSent(_ context.Context, _ int, command SentCommandResult) {
+	r.sent = append(r.sent, command)
+}
+
 func newCommandServiceForTest(sensorRepo CommandSensorRepository, subRepo CommandSubscriptionRepository, historyRepo CommandHistoryRepository, publisher CommandPublisher, lifecycle *fakeCommandLifecycle) (*CommandService, *fakeCommandLifecycle) {
 	if lifecycle == nil {
 		lifecycle = &fakeCommandLifecycle{}
@@ -139,6 +147,8 @@ func TestCommandService_Send_PublishesAndPersistsSentCommand(t *testing.T) {
 	historyRepo := &mockCommandHistoryRepository{}
 	publisher := &mockCommandPublisher{}
 	svc, lifecycle := newCommandServiceForTest(sensorRepo, subRepo, historyRepo, publisher, nil)
+	observer := &recordingSentObserver{}
+	svc.AddSentObserver(observer)
 
 	sensor := &gen.Sensor{
 		Id:           7,
@@ -170,6 +180,7 @@ func TestCommandService_Send_PublishesAndPersistsSentCommand(t *testing.T) {
 		mock.Anything,
 		7,
 		&userID,
+		(*int)(nil),
 		"state",
 		"ON",
 		"zigbee2mqtt/office-plug/set",
@@ -189,6 +200,95 @@ func TestCommandService_Send_PublishesAndPersistsSentCommand(t *testing.T) {
 	}, result)
 	require.Len(t, lifecycle.tracked, 1)
 	assert.Equal(t, 42, lifecycle.tracked[0].ID)
+	assert.Equal(t, []SentCommandResult{result}, observer.sent)
+}
+
+func TestCommandService_SendForAutomation_RecordsRuleWithoutUser(t *testing.T) {
+	sensorRepo := &mockCommandSensorRepository{}
+	subRepo := &mockCommandSubscriptionRepository{}
+	historyRepo := &mockCommandHistoryRepository{}
+	publisher := &mockCommandPublisher{}
+	svc, lifecycle := newCommandServiceForTest(sensorRepo, subRepo, historyRepo, publisher, nil)
+
+	sensor := &gen.Sensor{
+		Id:           7,
+		Name:         "office-plug",
+		SensorDriver: "mqtt-zigbee2mqtt",
+		Status:       gen.SensorStatusActive,
+		Enabled:      true,
+		Metadata: &map[string]interface{}{
+			"exposes": []interface{}{
+				map[string]interface{}{"type": "binary", "property": "state", "access": float64(7), "value_on": "ON", "value_off": "OFF"},
+			},
+		},
+	}
+	ruleID := 3
+	sensorRepo.On("GetSensorById", mock.Anything, 7).Return(sensor, nil)
+	subRepo.On("ListEnabledByDriverType", mock.Anything, "mqtt-zigbee2mqtt").Return([]gen.MQTTSubscription{{BrokerId: 12, DriverType: "mqtt-zigbee2mqtt", Enabled: true}}, nil)
+	historyRepo.On("HasPendingCommand", mock.Anything, 7, "state").Return(false, nil)
+	publisher.On("Publish", 12, "zigbee2mqtt/office-plug/set", []byte(`{"state":"OFF"}`), byte(1)).Return(nil)
+	historyRepo.On("AddSentCommand",
+		mock.Anything,
+		7,
+		(*int)(nil),
+		&ruleID,
+		"state",
+		"OFF",
+		"zigbee2mqtt/office-plug/set",
+		`{"state":"OFF"}`,
+		10,
+		mock.AnythingOfType("time.Time"),
+	).Return(43, nil)
+
+	commandID, err := svc.SendForAutomation(context.Background(), ruleID, 7, "state", "OFF")
+
+	require.NoError(t, err)
+	assert.Equal(t, 43, commandID)
+	require.Len(t, lifecycle.tracked, 1)
+	historyRepo.AssertExpectations(t)
+}
+
+func TestCommandService_Send_SystemActorRecordsNoUser(t *testing.T) {
+	sensorRepo := &mockCommandSensorRepository{}
+	subRepo := &mockCommandSubscriptionRepository{}
+	historyRepo := &mockCommandHistoryRepository{}
+	publisher := &mockCommandPublisher{}
+	svc, _ := newCommandServiceForTest(sensorRepo, subRepo, historyRepo, publisher, nil)
+
+	sensor := &gen.Sensor{
+		Id:           7,
+		Name:         "office-plug",
+		SensorDriver: "mqtt-zigbee2mqtt",
+		Status:       gen.SensorStatusActive,
+		Enabled:      true,
+		Metadata: &map[string]interface{}{
+			"exposes": []interface{}{
+				map[string]interface{}{"type": "binary", "property": "state", "access": float64(7), "value_on": "ON", "value_off": "OFF"},
+			},
+		},
+	}
+	sensorRepo.On("GetSensorById", mock.Anything, 7).Return(sensor, nil)
+	subRepo.On("ListEnabledByDriverType", mock.Anything, "mqtt-zigbee2mqtt").Return([]gen.MQTTSubscription{{BrokerId: 12, DriverType: "mqtt-zigbee2mqtt", Enabled: true}}, nil)
+	historyRepo.On("HasPendingCommand", mock.Anything, 7, "state").Return(false, nil)
+	publisher.On("Publish", 12, "zigbee2mqtt/office-plug/set", []byte(`{"state":"ON"}`), byte(1)).Return(nil)
+	historyRepo.On("AddSentCommand",
+		mock.Anything,
+		7,
+		(*int)(nil),
+		(*int)(nil),
+		"state",
+		"ON",
+		"zigbee2mqtt/office-plug/set",
+		`{"state":"ON"}`,
+		10,
+		mock.AnythingOfType("time.Time"),
+	).Return(44, nil)
+
+	result, err := svc.Send(context.Background(), 7, NewSystemActor("scheduler"), "state", "ON")
+
+	require.NoError(t, err)
+	assert.Equal(t, 44, result.ID)
+	historyRepo.AssertExpectations(t)
 }
 
 func TestCommandService_GetHistory_ReturnsLatestEntriesFromRepository(t *testing.T) {
@@ -415,6 +515,7 @@ func TestCommandService_Send_BrokerDisconnected(t *testing.T) {
 		mock.Anything,
 		7,
 		&userID,
+		(*int)(nil),
 		"state",
 		"ON",
 		"zigbee2mqtt/office-plug/set",
@@ -470,6 +571,7 @@ func TestCommandService_Send_SkipsDisconnectedSubscriptionAndPublishesToNext(t *
 		mock.Anything,
 		7,
 		&userID,
+		(*int)(nil),
 		"state",
 		"ON",
 		"zigbee2mqtt/office-plug/set",
@@ -521,6 +623,7 @@ func TestCommandService_Send_PublishFailureMarksCommandFailed(t *testing.T) {
 		mock.Anything,
 		7,
 		&userID,
+		(*int)(nil),
 		"state",
 		"ON",
 		"zigbee2mqtt/office-plug/set",
@@ -0,0 +1,409 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"example/sensorHub/actuation"
+	database "example/sensorHub/db"
+	"example/sensorHub/desiredstate"
+	"example/sensorHub/drivers"
+	gen "example/sensorHub/gen"
+	"example/sensorHub/periodic"
+)
+
+// desiredStateRetryInterval is how often desired states due a retry are looked for. It is
+// shorter than the first retry delay so that retries go out close to their time.
+const desiredStateRetryInterval = 5 * time.Second
+
+var (
+	ErrDesiredStateNotFound = errors.New("desired state not found")
+	// ErrInvalidDesiredState is returned for desired values the sensor cannot accept as a
+	// command.
+	ErrInvalidDesiredState = errors.New("invalid desired state")
+)
+
+// DesiredStateCommandSender sends commands on behalf of an actor. CommandService
+// implements it.
+type DesiredStateCommandSender interface {
+	Send(ctx context.Context, sensorID int, actor *gen.User, property string, value string) (SentCommandResult, error)
+}
+
+// DesiredStateService keeps capabilities at their desired values. Setting a desired value
+// sends it as a command on behalf of the user. From then on the service observes the
+// command tracker and the sensor's readings: commands that time out, fail or leave the
+// device at another value are retried with backoff as the system actor, and the value is
+// sent again whenever the device reconnects or reports a different one. While the device
+// cannot be reached, retries wait for it to report again, and once the retries run out
+// the state is failed. A command for the property sent by anyone else, such as a user,
+// an automation, a schedule or a scene, becomes the desired value instead of being undone.
+type DesiredStateService struct {
+	repo       database.DesiredStateRepository
+	sensorRepo CommandSensorRepository
+	sender     DesiredStateCommandSender
+	actor      *gen.User
+	logger     *slog.Logger
+	now        func() time.Time
+}
+
+func NewDesiredStateService(repo database.DesiredStateRepository, sensorRepo CommandSensorRepository, sender DesiredStateCommandSender, logger *slog.Logger) *DesiredStateService {
+	return &DesiredStateService{
+		repo:       repo,
+		sensorRepo: sensorRepo,
+		sender:     sender,
+		actor:      NewSystemActor("desired_state"),
+		logger:     logger.With("component", "desired_state_service"),
+		now:        time.Now,
+	}
+}
+
+func (s *DesiredStateService) ServiceGetDesiredStates(ctx context.Context, sensorID int) ([]desiredstate.DesiredState, error) {
+	if _, err := s.getSensor(ctx, sensorID); err != nil {
+		return nil, err
+	}
+	states, err := s.repo.GetDesiredStatesForSensor(ctx, sensorID)
+	if err != nil {
+		return nil, fmt.Errorf("error listing desired states: %w", err)
+	}
+	return states, nil
+}
+
+// ServiceSetDesiredState makes value the desired value of the sensor's property and sends
+// it on behalf of actor. A command that cannot be sent does not fail the request: the
+// returned state records why, and the value is retried or sent once the device reports.
+func (s *DesiredStateService) ServiceSetDesiredState(ctx context.Context, sensorID int, actor *gen.User, property, value string) (*desiredstate.DesiredState, error) {
+	if actor == nil || !hasPermission(actor.Permissions, "control_sensors") {
+		return nil, newCommandError(http.StatusForbidden, "missing control_sensors permission")
+	}
+	state := &desiredstate.DesiredState{SensorID: sensorID, Property: property, Value: value, Status: desiredstate.StatusPending}
+	if err := state.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidDesiredState, err)
+	}
+	sensor, err := s.getSensor(ctx, sensorID)
+	if err != nil {
+		return nil, err
+	}
+	commandDriver, ok := drivers.GetCommandDriver(sensor.SensorDriver)
+	if !ok {
+		return nil, fmt.Errorf("%w: sensor %d is not controllable", ErrInvalidDesiredState, sensorID)
+	}
+	if _, _, err := commandDriver.BuildCommand(*sensor, property, value); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidDesiredState, err)
+	}
+
+	if err := s.repo.SetDesiredState(ctx, state); err != nil {
+		return nil, fmt.Errorf("error setting desired state: %w", err)
+	}
+	s.logger.Info("desired state set", "sensor_id", sensorID, "property", property, "value", value)
+
+	s.send(ctx, state, actor)
+	updated, err := s.repo.GetDesiredState(ctx, sensorID, property)
+	if err != nil {
+		return nil, fmt.Errorf("error getting desired state: %w", err)
+	}
+	if updated == nil {
+		return nil, ErrDesiredStateNotFound
+	}
+	return updated, nil
+}
+
+// ServiceDeleteDesiredState stops keeping the sensor's property at a desired value. The
+// device is left at whatever value it has.
+func (s *DesiredStateService) ServiceDeleteDesiredState(ctx context.Context, sensorID int, property string) error {
+	state, err := s.repo.GetDesiredState(ctx, sensorID, property)
+	if err != nil {
+		return fmt.Errorf("error getting desired state: %w", err)
+	}
+	if state == nil {
+		return ErrDesiredStateNotFound
+	}
+	if err := s.repo.DeleteDesiredState(ctx, sensorID, property); err != nil {
+		return fmt.Errorf("error deleting desired state: %w", err)
+	}
+	s.logger.Info("desired state cleared", "sensor_id", sensorID, "property", property)
+	return nil
+}
+
+func (s *DesiredStateService) getSensor(ctx context.Context, sensorID int) (*gen.Sensor, error) {
+	sensor, err := s.sensorRepo.GetSensorById(ctx, sensorID)
+	if err != nil || sensor == nil {
+		return nil, newCommandError(http.StatusNotFound, fmt.Sprintf("sensor %d not found", sensorID))
+	}
+	return sensor, nil
+}
+
+// CommandSent records the command just sent for a property with a desired state, so that
+// only its outcome settles the state. A command for another value replaces the desired
+// value, so the hub keeps the property at the value it was last told to set.
+func (s *DesiredStateService) CommandSent(ctx context.Context, sensorID int, command SentCommandResult) {
+	state, err := s.repo.GetDesiredState(ctx, sensorID, command.Property)
+	if err != nil {
+		s.logger.Error("failed to get desired state", "sensor_id", sensorID, "property", command.Property, "error", err)
+		return
+	}
+	if state == nil {
+		return
+	}
+
+	commandID := command.ID
+	state.CommandID = &commandID
+	state.Status = desiredstate.StatusPending
+	state.NextRetryAt = nil
+	if state.Matches(command.Value) {
+		s.updateProgress(ctx, state)
+		return
+	}
+	s.logger.Info("desired state replaced by command", "sensor_id", sensorID, "property", command.Property, "value", command.Value, "previous_value", state.Value, "command_id", command.ID)
+	state.Value = command.Value
+	state.Attempts = 0
+	state.LastError = ""
+	if err := s.repo.SetDesiredState(ctx, state); err != nil {
+		s.logger.Error("failed to replace desired state", "sensor_id", sensorID, "property", command.Property, "error", err)
+	}
+}
+
+// CommandStatusChanged settles a pending desired state with the outcome of the command
+// it last recorded for its property; outcomes of earlier commands are ignored. An
+// acknowledgement of the desired value brings it in sync; any other outcome schedules a
+// retry.
+func (s *DesiredStateService) CommandStatusChanged(message actuation.CommandStatusMessage) {
+	if message.Status == actuation.CommandStatusSent {
+		return
+	}
+	ctx := context.Background()
+	state, err := s.repo.GetDesiredState(ctx, message.SensorID, message.Property)
+	if err != nil {
+		s.logger.Error("failed to get desired state", "sensor_id", message.SensorID, "property", message.Property, "error", err)
+		return
+	}
+	if state == nil || state.Status != desiredstate.StatusPending || state.CommandID == nil || *state.CommandID != message.ID {
+		return
+	}
+
+	switch {
+	case message.Status == actuation.CommandStatusAcknowledged && message.AcknowledgedValue != nil && state.Matches(*message.AcknowledgedValue):
+		state.ReportedValue = *message.AcknowledgedValue
+		s.markInSync(ctx, state)
+	case message.Status == actuation.CommandStatusAcknowledged && message.AcknowledgedValue != nil:
+		state.ReportedValue = *message.AcknowledgedValue
+		s.scheduleRetry(ctx, state, fmt.Sprintf("device reported %q", *message.AcknowledgedValue))
+	default:
+		s.scheduleRetry(ctx, state, fmt.Sprintf("command %d %s", message.ID, strings.ReplaceAll(message.Status, "_", " ")))
+	}
+}
+
+// ObserveReadings compares the sensor's readings with its desired states. A desired
+// value that was in sync, or waiting for the device, is sent again when the device reports
+// a different value; one awaiting a command or a retry is left to them, and a failed one
+// is only brought back in sync.
+func (s *DesiredStateService) ObserveReadings(ctx context.Context, sensorID int, readings []gen.Reading) {
+	states, err := s.repo.GetDesiredStatesForSensor(ctx, sensorID)
+	if err != nil {
+		s.logger.Error("failed to get desired states", "sensor_id", sensorID, "error", err)
+		return
+	}
+	if len(states) == 0 {
+		return
+	}
+
+	for i := range states {
+		state := &states[i]
+		reported, ok := latestReportedValue(readings, state.Property)
+		if !ok {
+			continue
+		}
+
+		switch {
+		case state.Matches(reported):
+			if state.Status == desiredstate.StatusInSync && state.ReportedValue == reported {
+				continue
+			}
+			state.ReportedValue = reported
+			s.markInSync(ctx, state)
+		case state.Status == desiredstate.StatusInSync || state.Status == desiredstate.StatusWaiting:
+			s.logger.Info("re-asserting desired state", "sensor_id", sensorID, "property", state.Property, "value", state.Value, "reported_value", reported)
+			state.ReportedValue = reported
+			state.Attempts = 0
+			s.resend(ctx, state)
+		case state.ReportedValue != reported:
+			state.ReportedValue = reported
+			s.updateProgress(ctx, state)
+		}
+	}
+}
+
+// latestReportedValue returns the last value among readings for property.
+func latestReportedValue(readings []gen.Reading, property string) (string, bool) {
+	reported, found := "", false
+	for _, reading := range readings {
+		if reading.MeasurementType != property {
+			continue
+		}
+		if value := actuation.ReadingValue(reading); value != "" {
+			reported, found = value, true
+		}
+	}
+	return reported, found
+}
+
+// StartReconciler periodically retries the desired states whose retry is due.
+func (s *DesiredStateService) StartReconciler(ctx context.Context) {
+	periodic.RunTask(ctx, periodic.TaskConfig{
+		Name:     "desired_states",
+		Interval: desiredStateRetryInterval,
+		Logger:   s.logger,
+	}, func(ctx context.Context) error {
+		return s.ProcessDueRetries(ctx, s.now())
+	})
+}
+
+// ProcessDueRetries sends again every desired value whose retry is at or before now. A
+// device that cannot be reached is not sent to; its desired state waits for the device to
+// report again instead, without counting an attempt.
+func (s *DesiredStateService) ProcessDueRetries(ctx context.Context, now time.Time) error {
+	due, err := s.repo.GetDueDesiredStates(ctx, now)
+	if err != nil {
+		return err
+	}
+	for i := range due {
+		state := &due[i]
+		sensor, err := s.sensorRepo.GetSensorById(ctx, state.SensorID)
+		if err != nil {
+			s.logger.Error("failed to get sensor for desired state", "sensor_id", state.SensorID, "error", err)
+			continue
+		}
+		if sensor == nil {
+			continue
+		}
+		if !reachable(sensor) {
+			s.logger.Info("desired state waiting for device", "sensor_id", state.SensorID, "property", state.Property)
+			s.markWaiting(ctx, state, fmt.Sprintf("sensor %d cannot be reached", state.SensorID))
+			continue
+		}
+		s.logger.Info("retrying desired state", "sensor_id", state.SensorID, "property", state.Property, "value", state.Value, "attempt", state.Attempts)
+		s.resend(ctx, state)
+	}
+	return nil
+}
+
+// reachable reports whether commands can be expected to reach the sensor: it is enabled
+// and active, and the stale sensor watchdog has not found it silent.
+func reachable(sensor *gen.Sensor) bool {
+	if !sensor.Enabled || sensor.Status != gen.SensorStatusActive {
+		return false
+	}
+	return sensor.HealthStatus != gen.Bad || !strings.HasPrefix(sensor.HealthReason, staleHealthReasonPrefix)
+}
+
+// resend marks the state pending and sends its value again as the system actor.
+func (s *DesiredStateService) resend(ctx context.Context, state *desiredstate.DesiredState) {
+	state.Status = desiredstate.StatusPending
+	state.NextRetryAt = nil
+	if !s.updateProgress(ctx, state) {
+		return
+	}
+	s.send(ctx, state, s.actor)
+}
+
+// send sends the pending state's value on behalf of actor. The command's outcome reaches
+// the service through the command tracker. A command that cannot be sent waits for the
+// device when it cannot be reached, stays pending when another command for the property
+// is awaiting its outcome, and is otherwise retried.
+func (s *DesiredStateService) send(ctx context.Context, state *desiredstate.DesiredState, actor *gen.User) {
+	_, err := s.sender.Send(ctx, state.SensorID, actor, state.Property, state.Value)
+	if err == nil {
+		return
+	}
+	s.logger.Warn("failed to send desired state", "sensor_id", state.SensorID, "property", state.Property, "value", state.Value, "error", err)
+
+	current, getErr := s.repo.GetDesiredState(ctx, state.SensorID, state.Property)
+	if getErr != nil {
+		s.logger.Error("failed to get desired state", "sensor_id", state.SensorID, "property", state.Property, "error", getErr)
+		return
+	}
+	if current == nil || current.Value != state.Value {
+		return
+	}
+
+	var commandErr *CommandError
+	unreachable := errors.As(err, &commandErr) &&
+		(commandErr.StatusCode == http.StatusConflict || commandErr.StatusCode == http.StatusServiceUnavailable)
+	if unreachable {
+		// A command published while the broker is disconnected is reported failed before
+		// Send returns, which schedules a retry that waiting replaces.
+		if current.Status == desiredstate.StatusRetrying {
+			current.Attempts--
+		}
+		s.markWaiting(ctx, current, err.Error())
+		return
+	}
+	// The tracker reports commands that fail to publish before Send returns, so the state
+	// may have moved on already.
+	if current.Status != desiredstate.StatusPending {
+		return
+	}
+	if commandErr != nil {
+		switch commandErr.StatusCode {
+		case http.StatusTooManyRequests:
+			current.LastError = err.Error()
+			s.updateProgress(ctx, current)
+			return
+		}
+	}
+	s.scheduleRetry(ctx, current, err.Error())
+}
+
+func (s *DesiredStateService) markInSync(ctx context.Context, state *desiredstate.DesiredState) {
+	state.Status = desiredstate.StatusInSync
+	state.Attempts = 0
+	state.NextRetryAt = nil
+	state.LastError = ""
+	if s.updateProgress(ctx, state) {
+		s.logger.Info("desired state in sync", "sensor_id", state.SensorID, "property", state.Property, "value", state.Value)
+	}
+}
+
+func (s *DesiredStateService) markWaiting(ctx context.Context, state *desiredstate.DesiredState, reason string) {
+	state.Status = desiredstate.StatusWaiting
+	state.NextRetryAt = nil
+	state.LastError = reason
+	s.updateProgress(ctx, state)
+}
+
+// scheduleRetry schedules the next attempt at the desired value, or fails the state once
+// desiredstate.MaxAttempts retries have not reached it.
+func (s *DesiredStateService) scheduleRetry(ctx context.Context, state *desiredstate.DesiredState, reason string) {
+	if state.Attempts >= desiredstate.MaxAttempts {
+		state.Status = desiredstate.StatusFailed
+		state.NextRetryAt = nil
+		state.LastError = reason
+		if s.updateProgress(ctx, state) {
+			s.logger.Warn("desired state failed", "sensor_id", state.SensorID, "property", state.Property, "value", state.Value, "attempts", state.Attempts, "reason", reason)
+		}
+		return
+	}
+	state.Status = desiredstate.StatusRetrying
+	state.Attempts++
+	nextRetryAt := s.now().Add(desiredstate.RetryDelay(state.Attempts))
+	state.NextRetryAt = &nextRetryAt
+	state.LastError = reason
+	if s.updateProgress(ctx, state) {
+		s.logger.Info("desired state retry scheduled", "sensor_id", state.SensorID, "property", state.Property, "attempt", state.Attempts, "next_retry_at", nextRetryAt, "reason", reason)
+	}
+}
+
+// updateProgress records the state's progress and reports whether it was recorded. It is
+// not when the desired value was changed or cleared meanwhile.
+func (s *DesiredStateService) updateProgress(ctx context.Context, state *desiredstate.DesiredState) bool {
+	updated, err := s.repo.UpdateDesiredStateProgress(ctx, state)
+	if err != nil {
+		s.logger.Error("failed to update desired state", "sensor_id", state.SensorID, "property", state.Property, "error", err)
+		return false
+	}
+	return updated
+}
@@ -0,0 +1,14 @@
+package service
+
+import (
+	"context"
+
+	"example/sensorHub/desiredstate"
+	gen "example/sensorHub/gen"
+)
+
+type DesiredStateServiceInterface interface {
+	ServiceGetDesiredStates(ctx context.Context, sensorID int) ([]desiredstate.DesiredState, error)
+	ServiceSetDesiredState(ctx context.Context, sensorID int, actor *gen.User, property, value string) (*desiredstate.DesiredState, error)
+	ServiceDeleteDesiredState(ctx context.Context, sensorID int, property string) error
+}
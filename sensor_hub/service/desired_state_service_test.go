@@ -0,0 +1,317 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"testing"
+	"time"
+
+	"example/sensorHub/actuation"
+	"example/sensorHub/desiredstate"
+	gen "example/sensorHub/gen"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeDesiredStateRepository keeps desired states in memory, keyed by property. All of
+// them belong to the dehumidifier plug.
+type fakeDesiredStateRepository struct {
+	states map[string]desiredstate.DesiredState
+}
+
+func (f *fakeDesiredStateRepository) GetDesiredStatesForSensor(_ context.Context, sensorID int) ([]desiredstate.DesiredState, error) {
+	var states []desiredstate.DesiredState
+	for _, state := range f.states {
+		if state.SensorID == sensorID {
+			states = append(states, state)
+		}
+	}
+	return states, nil
+}
+
+func (f *fakeDesiredStateRepository) GetDesiredState(_ context.Context, sensorID int, property string) (*desiredstate.DesiredState, error) {
+	state, ok := f.states[property]
+	if !ok || state.SensorID != sensorID {
+		return nil, nil
+	}
+	return &state, nil
+}
+
+func (f *fakeDesiredStateRepository) SetDesiredState(_ context.Context, state *desiredstate.DesiredState) error {
+	stored := *state
+	stored.ReportedValue = f.states[state.Property].ReportedValue
+	f.states[state.Property] = stored
+	return nil
+}
+
+func (f *fakeDesiredStateRepository) UpdateDesiredStateProgress(_ context.Context, state *desiredstate.DesiredState) (bool, error) {
+	current, ok := f.states[state.Property]
+	if !ok || current.Value != state.Value {
+		return false, nil
+	}
+	f.states[state.Property] = *state
+	return true, nil
+}
+
+func (f *fakeDesiredStateRepository) DeleteDesiredState(_ context.Context, _ int, property string) error {
+	delete(f.states, property)
+	return nil
+}
+
+func (f *fakeDesiredStateRepository) GetDueDesiredStates(_ context.Context, now time.Time) ([]desiredstate.DesiredState, error) {
+	var due []desiredstate.DesiredState
+	for _, state := range f.states {
+		if state.Status == desiredstate.StatusRetrying && state.NextRetryAt != nil && !state.NextRetryAt.After(now) {
+			due = append(due, state)
+		}
+	}
+	return due, nil
+}
+
+// desiredStateTestNow is when the tests' desired states are set.
+var desiredStateTestNow = time.Date(2026, 3, 2, 21, 0, 0, 0, time.UTC)
+
+func newDesiredStateServiceForTest(sensor *gen.Sensor) (*DesiredStateService, *fakeDesiredStateRepository, *fakeScheduleCommandSender) {
+	repo := &fakeDesiredStateRepository{states: map[string]desiredstate.DesiredState{}}
+	sensorRepo := &mockCommandSensorRepository{}
+	sensorRepo.On("GetSensorById", mock.Anything, 2).Return(sensor, nil)
+	sender := &fakeScheduleCommandSender{}
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	svc := NewDesiredStateService(repo, sensorRepo, sender, logger)
+	svc.now = func() time.Time { return desiredStateTestNow }
+	sender.observer = svc
+	return svc, repo, sender
+}
+
+func acknowledged(value string) actuation.CommandStatusMessage {
+	return actuation.CommandStatusMessage{
+		Type: "command_status", ID: 91, SensorID: 2, Property: "state", Value: "ON",
+		Status: actuation.CommandStatusAcknowledged, AcknowledgedValue: &value,
+	}
+}
+
+func stateReading(value string) []gen.Reading {
+	return []gen.Reading{{MeasurementType: "state", TextState: &value}}
+}
+
+func TestDesiredStateService_Set_SendsAsUserAndSyncsOnAcknowledgement(t *testing.T) {
+	svc, repo, sender := newDesiredStateServiceForTest(dehumidifierPlug())
+	actor := &gen.User{Id: 7, Permissions: []string{"control_sensors"}}
+
+	state, err := svc.ServiceSetDesiredState(context.Background(), 2, actor, "state", "ON")
+
+	require.NoError(t, err)
+	assert.Equal(t, desiredstate.StatusPending, state.Status)
+	require.Len(t, sender.sent, 1)
+	assert.Same(t, actor, sender.sent[0].actor)
+
+	svc.CommandStatusChanged(acknowledged("on"))
+
+	synced := repo.states["state"]
+	assert.Equal(t, desiredstate.StatusInSync, synced.Status)
+	assert.Equal(t, "on", synced.ReportedValue)
+}
+
+func TestDesiredStateService_Set_RejectsUncontrollableSensor(t *testing.T) {
+	svc, repo, sender := newDesiredStateServiceForTest(&gen.Sensor{Id: 2, SensorDriver: "sensor-hub-http-temperature"})
+	actor := &gen.User{Id: 7, Permissions: []string{"control_sensors"}}
+
+	_, err := svc.ServiceSetDesiredState(context.Background(), 2, actor, "state", "ON")
+
+	assert.ErrorIs(t, err, ErrInvalidDesiredState)
+	assert.Empty(t, repo.states)
+	assert.Empty(t, sender.sent)
+}
+
+func TestDesiredStateService_Set_WaitsWhenDeviceCannotBeReached(t *testing.T) {
+	svc, _, sender := newDesiredStateServiceForTest(dehumidifierPlug())
+	sender.err = newCommandError(http.StatusServiceUnavailable, "no enabled MQTT subscription")
+
+	state, err := svc.ServiceSetDesiredState(context.Background(), 2, NewSystemActor("test"), "state", "ON")
+
+	require.NoError(t, err)
+	assert.Equal(t, desiredstate.StatusWaiting, state.Status)
+	assert.Equal(t, "no enabled MQTT subscription", state.LastError)
+	assert.Zero(t, state.Attempts)
+}
+
+func TestDesiredStateService_TimeoutIsRetriedWithBackoff(t *testing.T) {
+	svc, repo, sender := newDesiredStateServiceForTest(dehumidifierPlug())
+	ctx := context.Background()
+	_, err := svc.ServiceSetDesiredState(ctx, 2, NewSystemActor("test"), "state", "ON")
+	require.NoError(t, err)
+
+	svc.CommandStatusChanged(actuation.CommandStatusMessage{ID: 91, SensorID: 2, Property: "state", Status: actuation.CommandStatusTimedOut})
+	retrying := repo.states["state"]
+	assert.Equal(t, desiredstate.StatusRetrying, retrying.Status)
+	assert.Equal(t, 1, retrying.Attempts)
+	assert.Equal(t, "command 91 timed out", retrying.LastError)
+	require.NotNil(t, retrying.NextRetryAt)
+	assert.Equal(t, desiredStateTestNow.Add(desiredstate.RetryBaseDelay), *retrying.NextRetryAt)
+
+	require.NoError(t, svc.ProcessDueRetries(ctx, desiredStateTestNow.Add(time.Second)))
+	assert.Len(t, sender.sent, 1, "the retry is not due yet")
+
+	require.NoError(t, svc.ProcessDueRetries(ctx, *retrying.NextRetryAt))
+	require.Len(t, sender.sent, 2)
+	assert.Same(t, svc.actor, sender.sent[1].actor)
+	assert.Equal(t, desiredstate.StatusPending, repo.states["state"].Status)
+
+	svc.CommandStatusChanged(acknowledged("OFF"))
+	assert.Equal(t, desiredstate.StatusPending, repo.states["state"].Status, "only the retry's own command settles it")
+
+	ack := acknowledged("OFF")
+	ack.ID = 92
+	svc.CommandStatusChanged(ack)
+	assert.Equal(t, 2, repo.states["state"].Attempts)
+	assert.Equal(t, desiredStateTestNow.Add(2*desiredstate.RetryBaseDelay), *repo.states["state"].NextRetryAt)
+}
+
+func TestDesiredStateService_GivesUpAfterMaxAttempts(t *testing.T) {
+	svc, repo, sender := newDesiredStateServiceForTest(dehumidifierPlug())
+	ctx := context.Background()
+	commandID := 91
+	repo.states["state"] = desiredstate.DesiredState{
+		SensorID: 2, Property: "state", Value: "ON", Status: desiredstate.StatusPending,
+		Attempts: desiredstate.MaxAttempts, CommandID: &commandID,
+	}
+
+	svc.CommandStatusChanged(actuation.CommandStatusMessage{ID: 91, SensorID: 2, Property: "state", Status: actuation.CommandStatusTimedOut})
+
+	failed := repo.states["state"]
+	assert.Equal(t, desiredstate.StatusFailed, failed.Status)
+	assert.Equal(t, desiredstate.MaxAttempts, failed.Attempts)
+	assert.Nil(t, failed.NextRetryAt)
+	assert.Equal(t, "command 91 timed out", failed.LastError)
+
+	svc.ObserveReadings(ctx, 2, stateReading("OFF"))
+	assert.Empty(t, sender.sent, "a failed state is not re-asserted")
+	assert.Equal(t, desiredstate.StatusFailed, repo.states["state"].Status)
+
+	svc.ObserveReadings(ctx, 2, stateReading("ON"))
+	assert.Equal(t, desiredstate.StatusInSync, repo.states["state"].Status)
+}
+
+func TestDesiredStateService_OtherCommandReplacesDesiredValue(t *testing.T) {
+	svc, repo, sender := newDesiredStateServiceForTest(dehumidifierPlug())
+	ctx := context.Background()
+	_, err := svc.ServiceSetDesiredState(ctx, 2, NewSystemActor("test"), "state", "ON")
+	require.NoError(t, err)
+	svc.CommandStatusChanged(acknowledged("ON"))
+	require.Equal(t, desiredstate.StatusInSync, repo.states["state"].Status)
+
+	// A schedule turns the plug off.
+	svc.CommandSent(ctx, 2, SentCommandResult{ID: 95, Status: "sent", Property: "state", Value: "OFF"})
+
+	replaced := repo.states["state"]
+	assert.Equal(t, "OFF", replaced.Value)
+	assert.Equal(t, desiredstate.StatusPending, replaced.Status)
+	require.NotNil(t, replaced.CommandID)
+	assert.Equal(t, 95, *replaced.CommandID)
+
+	ack := acknowledged("OFF")
+	ack.ID = 95
+	svc.CommandStatusChanged(ack)
+	svc.ObserveReadings(ctx, 2, stateReading("OFF"))
+	assert.Equal(t, desiredstate.StatusInSync, repo.states["state"].Status)
+	assert.Len(t, sender.sent, 1, "the schedule's value is not undone")
+}
+
+func TestDesiredStateService_PublishFailureCountsOneAttempt(t *testing.T) {
+	svc, repo, sender := newDesiredStateServiceForTest(dehumidifierPlug())
+	sender.err = errors.New("publish command: broker refused")
+	sender.beforeReturn = func() {
+		svc.CommandStatusChanged(actuation.CommandStatusMessage{ID: 91, SensorID: 2, Property: "state", Status: actuation.CommandStatusFailed})
+	}
+
+	_, err := svc.ServiceSetDesiredState(context.Background(), 2, NewSystemActor("test"), "state", "ON")
+
+	require.NoError(t, err)
+	assert.Equal(t, desiredstate.StatusRetrying, repo.states["state"].Status)
+	assert.Equal(t, 1, repo.states["state"].Attempts)
+	assert.Equal(t, "command 91 failed", repo.states["state"].LastError)
+}
+
+func TestDesiredStateService_DisconnectedBrokerWaitsForDevice(t *testing.T) {
+	svc, repo, sender := newDesiredStateServiceForTest(dehumidifierPlug())
+	sender.err = newCommandError(http.StatusServiceUnavailable, "mqtt client not connected")
+	sender.beforeReturn = func() {
+		svc.CommandStatusChanged(actuation.CommandStatusMessage{ID: 91, SensorID: 2, Property: "state", Status: actuation.CommandStatusFailed})
+	}
+
+	_, err := svc.ServiceSetDesiredState(context.Background(), 2, NewSystemActor("test"), "state", "ON")
+
+	require.NoError(t, err)
+	waiting := repo.states["state"]
+	assert.Equal(t, desiredstate.StatusWaiting, waiting.Status)
+	assert.Zero(t, waiting.Attempts)
+	assert.Nil(t, waiting.NextRetryAt)
+}
+
+func TestDesiredStateService_UnreachableRetryWaitsForDevice(t *testing.T) {
+	stale := dehumidifierPlug()
+	stale.HealthStatus = gen.Bad
+	stale.HealthReason = staleHealthReasonPrefix + "15m0s (expected every 5m0s)"
+	svc, repo, sender := newDesiredStateServiceForTest(stale)
+	ctx := context.Background()
+	retryAt := desiredStateTestNow
+	repo.states["state"] = desiredstate.DesiredState{
+		SensorID: 2, Property: "state", Value: "ON", Status: desiredstate.StatusRetrying, Attempts: 3, NextRetryAt: &retryAt,
+	}
+
+	require.NoError(t, svc.ProcessDueRetries(ctx, desiredStateTestNow))
+
+	waiting := repo.states["state"]
+	assert.Empty(t, sender.sent)
+	assert.Equal(t, desiredstate.StatusWaiting, waiting.Status)
+	assert.Equal(t, 3, waiting.Attempts, "waiting does not count an attempt")
+	assert.Nil(t, waiting.NextRetryAt)
+
+	svc.ObserveReadings(ctx, 2, stateReading("OFF"))
+
+	require.Len(t, sender.sent, 1, "the device reconnecting with another value gets the desired value again")
+	assert.Equal(t, "ON", sender.sent[0].value)
+	assert.Equal(t, desiredstate.StatusPending, repo.states["state"].Status)
+	assert.Zero(t, repo.states["state"].Attempts)
+}
+
+func TestDesiredStateService_ObserveReadings(t *testing.T) {
+	tests := []struct {
+		name       string
+		status     desiredstate.Status
+		reported   string
+		wantStatus desiredstate.Status
+		wantSent   bool
+	}{
+		{name: "in sync and unchanged", status: desiredstate.StatusInSync, reported: "ON", wantStatus: desiredstate.StatusInSync},
+		{name: "drift is re-asserted", status: desiredstate.StatusInSync, reported: "OFF", wantStatus: desiredstate.StatusPending, wantSent: true},
+		{name: "retry made redundant", status: desiredstate.StatusRetrying, reported: "ON", wantStatus: desiredstate.StatusInSync},
+		{name: "retry left to its timer", status: desiredstate.StatusRetrying, reported: "OFF", wantStatus: desiredstate.StatusRetrying},
+		{name: "command left to the tracker", status: desiredstate.StatusPending, reported: "OFF", wantStatus: desiredstate.StatusPending},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, repo, sender := newDesiredStateServiceForTest(dehumidifierPlug())
+			repo.states["state"] = desiredstate.DesiredState{SensorID: 2, Property: "state", Value: "ON", Status: tt.status}
+
+			svc.ObserveReadings(context.Background(), 2, stateReading(tt.reported))
+
+			assert.Equal(t, tt.wantStatus, repo.states["state"].Status)
+			assert.Equal(t, tt.reported, repo.states["state"].ReportedValue)
+			assert.Equal(t, tt.wantSent, len(sender.sent) == 1)
+		})
+	}
+}
+
+func TestDesiredStateService_Delete_NotFound(t *testing.T) {
+	svc, _, _ := newDesiredStateServiceForTest(dehumidifierPlug())
+
+	err := svc.ServiceDeleteDesiredState(context.Background(), 2, "state")
+
+	assert.ErrorIs(t, err, ErrDesiredStateNotFound)
+}
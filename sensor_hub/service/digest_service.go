@@ -0,0 +1,201 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	appProps "example/sensorHub/application_properties"
+	database "example/sensorHub/db"
+	"example/sensorHub/notifications"
+	"example/sensorHub/periodic"
+)
+
+var (
+	// ErrQuietHoursNotFound is returned when the user has not configured quiet hours.
+	ErrQuietHoursNotFound = errors.New("quiet hours not found")
+	// ErrInvalidQuietHours wraps validation failures.
+	ErrInvalidQuietHours = errors.New("invalid quiet hours")
+)
+
+// DigestService holds back notification emails that belong to a digest category or fall
+// within a user's quiet hours, and sends each user's held emails as one summary email
+// once they are due.
+type DigestService struct {
+	repo    database.DigestRepository
+	emailer EmailNotifier
+	logger  *slog.Logger
+	now     func() time.Time
+}
+
+func NewDigestService(repo database.DigestRepository, emailer EmailNotifier, logger *slog.Logger) *DigestService {
+	return &DigestService{
+		repo:    repo,
+		emailer: emailer,
+		logger:  logger.With("component", "digest_service"),
+		now:     time.Now,
+	}
+}
+
+// DeferEmail queues the email for notif to userID when pref batches the category into a
+// digest, or when the user is in quiet hours and notif is not an error. It reports
+// whether the email was queued; if not, the caller sends it immediately.
+func (s *DigestService) DeferEmail(ctx context.Context, notif notifications.Notification, userID int, pref *notifications.ChannelPreference) (bool, error) {
+	quiet, err := s.repo.GetQuietHours(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+
+	now := s.now().Local()
+	var deliverAfter time.Time
+	switch pref.EmailDigest {
+	case notifications.DigestHourly:
+		deliverAfter = time.Date(now.Year(), now.Month(), now.Day(), now.Hour()+1, 0, 0, 0, now.Location())
+	case notifications.DigestDaily:
+		deliverAfter = nextDailyDigest(now)
+	default:
+		if quiet == nil || !quiet.Covers(now) || notif.Severity == notifications.SeverityError {
+			return false, nil
+		}
+		deliverAfter = quiet.EndAfter(now)
+	}
+	// A digest that falls due during quiet hours waits for them to end.
+	if quiet != nil && quiet.Covers(deliverAfter) {
+		deliverAfter = quiet.EndAfter(deliverAfter)
+	}
+
+	if err := s.repo.QueueEmail(ctx, userID, notif.ID, deliverAfter); err != nil {
+		return false, err
+	}
+	s.logger.Debug("email notification deferred", "user_id", userID, "notification_id", notif.ID, "deliver_after", deliverAfter)
+	return true, nil
+}
+
+// InQuietHours reports whether userID is in their quiet hours and notif is not an error,
+// in which case its push and webhook deliveries to the user are skipped.
+func (s *DigestService) InQuietHours(ctx context.Context, notif notifications.Notification, userID int) (bool, error) {
+	if notif.Severity == notifications.SeverityError {
+		return false, nil
+	}
+	quiet, err := s.repo.GetQuietHours(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+	return quiet != nil && quiet.Covers(s.now().Local()), nil
+}
+
+// nextDailyDigest returns the next occurrence of the configured daily digest hour after now.
+func nextDailyDigest(now time.Time) time.Time {
+	hour := 8
+	if appProps.AppConfig != nil {
+		hour = appProps.AppConfig.NotificationDigestDailyHour
+	}
+	next := time.Date(now.Year(), now.Month(), now.Day(), hour, 0, 0, 0, now.Location())
+	if !next.After(now) {
+		next = time.Date(now.Year(), now.Month(), now.Day()+1, hour, 0, 0, 0, now.Location())
+	}
+	return next
+}
+
+// ServiceStartDigestDelivery periodically sends the queued emails that have fallen due.
+func (s *DigestService) ServiceStartDigestDelivery(ctx context.Context) {
+	intervalSec := appProps.AppConfig.NotificationDigestCheckIntervalSeconds
+
+	periodic.RunTask(ctx, periodic.TaskConfig{
+		Name:     "email_digest_delivery",
+		Interval: time.Duration(intervalSec) * time.Second,
+		Logger:   s.logger,
+	}, func(ctx context.Context) error {
+		return s.deliverDueEmails(ctx, s.now())
+	})
+}
+
+// deliverDueEmails sends each user's due emails as one email and removes them from the
+// queue. Emails that fail to send stay queued and are retried on the next run.
+func (s *DigestService) deliverDueEmails(ctx context.Context, now time.Time) error {
+	due, err := s.repo.GetDueEmails(ctx, now)
+	if err != nil {
+		return fmt.Errorf("error fetching due emails: %w", err)
+	}
+
+	for start := 0; start < len(due); {
+		end := start + 1
+		for end < len(due) && due[end].UserID == due[start].UserID {
+			end++
+		}
+		batch := due[start:end]
+		start = end
+
+		title, message, category := digestContent(batch)
+		if err := s.emailer.SendNotification(batch[0].Email, title, message, category); err != nil {
+			s.logger.Error("failed to send digest email", "user_id", batch[0].UserID, "count", len(batch), "error", err)
+			continue
+		}
+		ids := make([]int, len(batch))
+		for i, e := range batch {
+			ids[i] = e.ID
+		}
+		if err := s.repo.DeleteQueuedEmails(ctx, ids); err != nil {
+			return fmt.Errorf("error removing sent emails from queue: %w", err)
+		}
+		s.logger.Info("digest email sent", "user_id", batch[0].UserID, "count", len(batch))
+	}
+	return nil
+}
+
+// digestContent returns the title, message and category of the email summarising batch.
+// A single held notification is sent as it would have been originally.
+func digestContent(batch []database.QueuedEmail) (title, message, category string) {
+	if len(batch) == 1 {
+		n := batch[0].Notification
+		return n.Title, n.Message, string(n.Category)
+	}
+
+	category = string(batch[0].Notification.Category)
+	entries := make([]string, len(batch))
+	for i, e := range batch {
+		n := e.Notification
+		if string(n.Category) != category {
+			category = "digest"
+		}
+		entries[i] = fmt.Sprintf("%s [%s] %s\n%s", n.CreatedAt.Local().Format("Jan 2 15:04"), n.Severity, n.Title, n.Message)
+	}
+	return fmt.Sprintf("%d notifications", len(batch)), strings.Join(entries, "\n\n"), category
+}
+
+func (s *DigestService) ServiceGetQuietHours(ctx context.Context, userID int) (*notifications.QuietHours, error) {
+	quiet, err := s.repo.GetQuietHours(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting quiet hours: %w", err)
+	}
+	if quiet == nil {
+		return nil, ErrQuietHoursNotFound
+	}
+	return quiet, nil
+}
+
+func (s *DigestService) ServiceSetQuietHours(ctx context.Context, userID int, quietHours *notifications.QuietHours) error {
+	quietHours.UserID = userID
+	if err := quietHours.Validate(); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidQuietHours, err)
+	}
+	if err := s.repo.SetQuietHours(ctx, quietHours); err != nil {
+		return fmt.Errorf("error setting quiet hours: %w", err)
+	}
+	s.logger.Info("quiet hours set", "user_id", userID, "start", quietHours.Start, "end", quietHours.End, "enabled", quietHours.Enabled)
+	return nil
+}
+
+func (s *DigestService) ServiceDeleteQuietHours(ctx context.Context, userID int) error {
+	if _, err := s.ServiceGetQuietHours(ctx, userID); err != nil {
+		return err
+	}
+	if err := s.repo.DeleteQuietHours(ctx, userID); err != nil {
+		return fmt.Errorf("error deleting quiet hours: %w", err)
+	}
+	s.logger.Info("quiet hours deleted", "user_id", userID)
+	return nil
+}
@@ -0,0 +1,13 @@
+package service
+
+import (
+	"context"
+
+	"example/sensorHub/notifications"
+)
+
+type DigestServiceInterface interface {
+	ServiceGetQuietHours(ctx context.Context, userID int) (*notifications.QuietHours, error)
+	ServiceSetQuietHours(ctx context.Context, userID int, quietHours *notifications.QuietHours) error
+	ServiceDeleteQuietHours(ctx context.Context, userID int) error
+}
@@ -0,0 +1,246 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	appProps "example/sensorHub/application_properties"
+	database "example/sensorHub/db"
+	"example/sensorHub/notifications"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type mockDigestRepository struct {
+	mock.Mock
+}
+
+func (m *mockDigestRepository) GetQuietHours(ctx context.Context, userID int) (*notifications.QuietHours, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*notifications.QuietHours), args.Error(1)
+}
+
+func (m *mockDigestRepository) SetQuietHours(ctx context.Context, quietHours *notifications.QuietHours) error {
+	return m.Called(ctx, quietHours).Error(0)
+}
+
+func (m *mockDigestRepository) DeleteQuietHours(ctx context.Context, userID int) error {
+	return m.Called(ctx, userID).Error(0)
+}
+
+func (m *mockDigestRepository) QueueEmail(ctx context.Context, userID, notificationID int, deliverAfter time.Time) error {
+	return m.Called(ctx, userID, notificationID, deliverAfter).Error(0)
+}
+
+func (m *mockDigestRepository) GetDueEmails(ctx context.Context, now time.Time) ([]database.QueuedEmail, error) {
+	args := m.Called(ctx, now)
+	return args.Get(0).([]database.QueuedEmail), args.Error(1)
+}
+
+func (m *mockDigestRepository) DeleteQueuedEmails(ctx context.Context, ids []int) error {
+	return m.Called(ctx, ids).Error(0)
+}
+
+type sentEmail struct {
+	recipient, title, message, category string
+}
+
+type recordingEmailer struct {
+	sent []sentEmail
+	fail map[string]error // recipient → error
+}
+
+func (r *recordingEmailer) SendNotification(recipient, title, message, category string) error {
+	if err := r.fail[recipient]; err != nil {
+		return err
+	}
+	r.sent = append(r.sent, sentEmail{recipient, title, message, category})
+	return nil
+}
+
+// newTestDigestService returns a DigestService whose clock reads at, with the daily
+// digest sent at 08:00.
+func newTestDigestService(t *testing.T, repo *mockDigestRepository, emailer EmailNotifier, at time.Time) *DigestService {
+	t.Helper()
+	original := appProps.AppConfig
+	appProps.AppConfig = &appProps.ApplicationConfiguration{NotificationDigestDailyHour: 8, NotificationDigestCheckIntervalSeconds: 60}
+	t.Cleanup(func() { appProps.AppConfig = original })
+
+	svc := NewDigestService(repo, emailer, slog.Default())
+	svc.now = func() time.Time { return at }
+	return svc
+}
+
+func localTime(day, hour, minute int) time.Time {
+	return time.Date(2026, 3, day, hour, minute, 0, 0, time.Local)
+}
+
+var overnightQuietHours = &notifications.QuietHours{UserID: 4, Start: "22:00", End: "07:00", Enabled: true}
+
+func warningNotification() notifications.Notification {
+	return notifications.Notification{ID: 99, Category: notifications.CategoryThresholdAlert, Severity: notifications.SeverityWarning, Title: "Garage cold", Message: "below 5"}
+}
+
+func TestDigestService_DeferEmail_ImmediateOutsideQuietHours(t *testing.T) {
+	repo := new(mockDigestRepository)
+	svc := newTestDigestService(t, repo, &recordingEmailer{}, localTime(10, 12, 0))
+	repo.On("GetQuietHours", mock.Anything, 4).Return(overnightQuietHours, nil)
+
+	deferred, err := svc.DeferEmail(context.Background(), warningNotification(), 4, &notifications.ChannelPreference{EmailEnabled: true, EmailDigest: notifications.DigestOff})
+
+	require.NoError(t, err)
+	assert.False(t, deferred)
+	repo.AssertNotCalled(t, "QueueEmail", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestDigestService_DeferEmail_QueuedDuringQuietHours(t *testing.T) {
+	repo := new(mockDigestRepository)
+	svc := newTestDigestService(t, repo, &recordingEmailer{}, localTime(10, 23, 15))
+	repo.On("GetQuietHours", mock.Anything, 4).Return(overnightQuietHours, nil)
+	repo.On("QueueEmail", mock.Anything, 4, 99, localTime(11, 7, 0)).Return(nil)
+
+	deferred, err := svc.DeferEmail(context.Background(), warningNotification(), 4, &notifications.ChannelPreference{EmailEnabled: true})
+
+	require.NoError(t, err)
+	assert.True(t, deferred)
+	repo.AssertExpectations(t)
+}
+
+func TestDigestService_DeferEmail_ErrorBypassesQuietHours(t *testing.T) {
+	repo := new(mockDigestRepository)
+	svc := newTestDigestService(t, repo, &recordingEmailer{}, localTime(10, 23, 15))
+	repo.On("GetQuietHours", mock.Anything, 4).Return(overnightQuietHours, nil)
+
+	notif := warningNotification()
+	notif.Severity = notifications.SeverityError
+	deferred, err := svc.DeferEmail(context.Background(), notif, 4, &notifications.ChannelPreference{EmailEnabled: true})
+
+	require.NoError(t, err)
+	assert.False(t, deferred)
+}
+
+func TestDigestService_InQuietHours(t *testing.T) {
+	repo := new(mockDigestRepository)
+	svc := newTestDigestService(t, repo, &recordingEmailer{}, localTime(10, 23, 15))
+	repo.On("GetQuietHours", mock.Anything, 4).Return(overnightQuietHours, nil)
+	repo.On("GetQuietHours", mock.Anything, 5).Return(nil, nil)
+
+	quiet, err := svc.InQuietHours(context.Background(), warningNotification(), 4)
+	require.NoError(t, err)
+	assert.True(t, quiet)
+
+	quiet, err = svc.InQuietHours(context.Background(), warningNotification(), 5)
+	require.NoError(t, err)
+	assert.False(t, quiet, "no quiet hours configured")
+
+	notif := warningNotification()
+	notif.Severity = notifications.SeverityError
+	quiet, err = svc.InQuietHours(context.Background(), notif, 4)
+	require.NoError(t, err)
+	assert.False(t, quiet, "errors are never held back")
+}
+
+func TestDigestService_DeferEmail_HourlyDigest(t *testing.T) {
+	repo := new(mockDigestRepository)
+	svc := newTestDigestService(t, repo, &recordingEmailer{}, localTime(10, 14, 20))
+	repo.On("GetQuietHours", mock.Anything, 4).Return(nil, nil)
+	repo.On("QueueEmail", mock.Anything, 4, 99, localTime(10, 15, 0)).Return(nil)
+
+	deferred, err := svc.DeferEmail(context.Background(), warningNotification(), 4, &notifications.ChannelPreference{EmailEnabled: true, EmailDigest: notifications.DigestHourly})
+
+	require.NoError(t, err)
+	assert.True(t, deferred)
+	repo.AssertExpectations(t)
+}
+
+func TestDigestService_DeferEmail_DailyDigestWaitsForQuietHoursToEnd(t *testing.T) {
+	repo := new(mockDigestRepository)
+	svc := newTestDigestService(t, repo, &recordingEmailer{}, localTime(10, 14, 20))
+	repo.On("GetQuietHours", mock.Anything, 4).Return(&notifications.QuietHours{Start: "22:00", End: "09:30", Enabled: true}, nil)
+	repo.On("QueueEmail", mock.Anything, 4, 99, localTime(11, 9, 30)).Return(nil)
+
+	deferred, err := svc.DeferEmail(context.Background(), warningNotification(), 4, &notifications.ChannelPreference{EmailEnabled: true, EmailDigest: notifications.DigestDaily})
+
+	require.NoError(t, err)
+	assert.True(t, deferred)
+	repo.AssertExpectations(t)
+}
+
+func TestDigestService_DeliverDueEmails(t *testing.T) {
+	repo := new(mockDigestRepository)
+	emailer := &recordingEmailer{fail: map[string]error{"carol@example.com": errors.New("connection refused")}}
+	now := localTime(11, 7, 0)
+	svc := newTestDigestService(t, repo, emailer, now)
+
+	due := []database.QueuedEmail{
+		{ID: 1, UserID: 4, Email: "alice@example.com", Notification: notifications.Notification{Category: notifications.CategoryThresholdAlert, Severity: notifications.SeverityWarning, Title: "Garage cold", Message: "below 5", CreatedAt: localTime(10, 23, 15)}},
+		{ID: 2, UserID: 4, Email: "alice@example.com", Notification: notifications.Notification{Category: notifications.CategoryThresholdAlert, Severity: notifications.SeverityInfo, Title: "Garage resolved", Message: "back above 5", CreatedAt: localTime(11, 1, 0)}},
+		{ID: 3, UserID: 5, Email: "bob@example.com", Notification: notifications.Notification{Category: notifications.CategoryConfigChange, Severity: notifications.SeverityInfo, Title: "Property changed", Message: "log.level", CreatedAt: localTime(10, 23, 30)}},
+		{ID: 4, UserID: 6, Email: "carol@example.com", Notification: notifications.Notification{Category: notifications.CategoryConfigChange, Severity: notifications.SeverityInfo, Title: "Property changed", Message: "log.level", CreatedAt: localTime(10, 23, 30)}},
+	}
+	repo.On("GetDueEmails", mock.Anything, now).Return(due, nil)
+	repo.On("DeleteQueuedEmails", mock.Anything, []int{1, 2}).Return(nil)
+	repo.On("DeleteQueuedEmails", mock.Anything, []int{3}).Return(nil)
+
+	require.NoError(t, svc.deliverDueEmails(context.Background(), now))
+
+	require.Len(t, emailer.sent, 2)
+	assert.Equal(t, sentEmail{
+		recipient: "alice@example.com",
+		title:     "2 notifications",
+		message:   "Mar 10 23:15 [warning] Garage cold\nbelow 5\n\nMar 11 01:00 [info] Garage resolved\nback above 5",
+		category:  "threshold_alert",
+	}, emailer.sent[0])
+	assert.Equal(t, sentEmail{"bob@example.com", "Property changed", "log.level", "config_change"}, emailer.sent[1])
+	// carol's email failed, so it stays queued for the next run
+	repo.AssertExpectations(t)
+	repo.AssertNumberOfCalls(t, "DeleteQueuedEmails", 2)
+}
+
+func TestDigestContent_MixedCategories(t *testing.T) {
+	_, _, category := digestContent([]database.QueuedEmail{
+		{Notification: notifications.Notification{Category: notifications.CategoryThresholdAlert}},
+		{Notification: notifications.Notification{Category: notifications.CategoryConfigChange}},
+	})
+	assert.Equal(t, "digest", category)
+}
+
+func TestDigestService_SetQuietHours_Invalid(t *testing.T) {
+	repo := new(mockDigestRepository)
+	svc := newTestDigestService(t, repo, &recordingEmailer{}, localTime(10, 12, 0))
+
+	err := svc.ServiceSetQuietHours(context.Background(), 4, &notifications.QuietHours{Start: "22:00", End: "22:00", Enabled: true})
+
+	assert.ErrorIs(t, err, ErrInvalidQuietHours)
+	repo.AssertNotCalled(t, "SetQuietHours", mock.Anything, mock.Anything)
+}
+
+func TestDigestService_SetQuietHours(t *testing.T) {
+	repo := new(mockDigestRepository)
+	svc := newTestDigestService(t, repo, &recordingEmailer{}, localTime(10, 12, 0))
+	repo.On("SetQuietHours", mock.Anything, &notifications.QuietHours{UserID: 4, Start: "22:00", End: "07:00", Enabled: true}).Return(nil)
+
+	err := svc.ServiceSetQuietHours(context.Background(), 4, &notifications.QuietHours{UserID: 99, Start: "22:00", End: "07:00", Enabled: true})
+
+	require.NoError(t, err)
+	repo.AssertExpectations(t)
+}
+
+func TestDigestService_DeleteQuietHours_NotFound(t *testing.T) {
+	repo := new(mockDigestRepository)
+	svc := newTestDigestService(t, repo, &recordingEmailer{}, localTime(10, 12, 0))
+	repo.On("GetQuietHours", mock.Anything, 4).Return(nil, nil)
+
+	err := svc.ServiceDeleteQuietHours(context.Background(), 4)
+
+	assert.ErrorIs(t, err, ErrQuietHoursNotFound)
+	repo.AssertNotCalled(t, "DeleteQuietHours", mock.Anything, mock.Anything)
+}
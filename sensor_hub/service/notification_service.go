@@ -18,18 +18,31 @@ type EmailNotifier interface {
 }
 
 type NotificationService struct {
-	repo    database.NotificationRepository
-	ws      WebSocketNotifier
-	emailer EmailNotifier
-	logger  *slog.Logger
+	repo     database.NotificationRepository
+	ws       WebSocketNotifier
+	channels *notifications.Dispatcher
+	logger   *slog.Logger
 }
 
 func NewNotificationService(repo database.NotificationRepository, ws WebSocketNotifier, logger *slog.Logger) *NotificationService {
-	return &NotificationService{repo: repo, ws: ws, logger: logger.With("component", "notification_service")}
+	logger = logger.With("component", "notification_service")
+	return &NotificationService{repo: repo, ws: ws, channels: notifications.NewDispatcher(repo, logger), logger: logger}
 }
 
 func (s *NotificationService) SetEmailNotifier(emailer EmailNotifier) {
-	s.emailer = emailer
+	s.channels.SetEmailSender(emailer)
+}
+
+func (s *NotificationService) SetDeferrer(deferrer notifications.Deferrer) {
+	s.channels.SetDeferrer(deferrer)
+}
+
+func (s *NotificationService) SetWebhookNotifier(webhooks notifications.WebhookNotifier) {
+	s.channels.SetWebhookNotifier(webhooks)
+}
+
+func (s *NotificationService) SetPushNotifier(push notifications.PushNotifier) {
+	s.channels.SetPushNotifier(push)
 }
 
 func (s *NotificationService) CreateNotification(ctx context.Context, notif notifications.Notification, targetPermission string) (int, error) {
@@ -42,52 +55,38 @@ func (s *NotificationService) CreateNotification(ctx context.Context, notif noti
 		return 0, fmt.Errorf("failed to assign notification: %w", err)
 	}
 
-	// Push via WebSocket to all assigned users
-	if s.ws != nil {
-		userIDs, err := s.repo.GetUserIDsWithPermission(ctx, targetPermission)
-		if err == nil {
-			notif.ID = id
-			for _, userID := range userIDs {
-				s.ws.BroadcastToUser(userID, notif)
-			}
-		}
-	}
-
-	// Send email notifications to users who have email enabled for this category
-	if s.emailer != nil {
-		go s.sendEmailNotifications(context.Background(), notif, targetPermission)
-	}
+	notif.ID = id
 
-	return id, nil
-}
-
-func (s *NotificationService) sendEmailNotifications(ctx context.Context, notif notifications.Notification, targetPermission string) {
-	users, err := s.repo.GetUsersWithPermissionAndEmail(ctx, targetPermission)
+	userIDs, err := s.repo.GetUserIDsWithPermission(ctx, targetPermission)
 	if err != nil {
-		s.logger.Error("failed to get users for email notification", "error", err)
-		return
+		s.logger.Warn("failed to get recipients for notification delivery", "title", notif.Title, "error", err)
+		return id, nil
 	}
 
-	s.logger.Debug("checking email preferences", "user_count", len(users), "category", notif.Category)
-
-	for _, user := range users {
-		pref, err := s.repo.GetChannelPreference(ctx, user.UserID, notif.Category)
-		if err != nil {
-			s.logger.Error("failed to get channel preference", "user_id", user.UserID, "error", err)
-			continue
+	// Push via WebSocket to all assigned users
+	if s.ws != nil {
+		for _, userID := range userIDs {
+			s.ws.BroadcastToUser(userID, notif)
 		}
+	}
 
-		s.logger.Debug("user email preference", "user_id", user.UserID, "email", user.Email, "email_enabled", pref.EmailEnabled, "category", notif.Category)
-
-		if !pref.EmailEnabled {
-			continue
-		}
+	// Deliver over email, webhooks and push to users who have the channel enabled for this category
+	go s.channels.Dispatch(context.Background(), notif, notifications.Recipients{
+		UserIDs: userIDs,
+		Emails: func(ctx context.Context) (map[int]string, error) {
+			users, err := s.repo.GetUsersWithPermissionAndEmail(ctx, targetPermission)
+			if err != nil {
+				return nil, err
+			}
+			emails := make(map[int]string, len(users))
+			for _, user := range users {
+				emails[user.UserID] = user.Email
+			}
+			return emails, nil
+		},
+	})
 
-		err = s.emailer.SendNotification(user.Email, notif.Title, notif.Message, string(notif.Category))
-		if err != nil {
-			s.logger.Error("failed to send email notification", "email", user.Email, "error", err)
-		}
-	}
+	return id, nil
 }
 
 func (s *NotificationService) GetNotificationsForUser(ctx context.Context, userID int, limit, offset int, includeDismissed bool) ([]notifications.UserNotification, error) {
@@ -133,6 +132,10 @@ func (s *NotificationService) ShouldNotifyChannel(ctx context.Context, userID in
 		return pref.EmailEnabled, nil
 	case "inapp":
 		return pref.InAppEnabled, nil
+	case "webhook":
+		return pref.WebhookEnabled, nil
+	case "push":
+		return pref.PushEnabled, nil
 	default:
 		return false, fmt.Errorf("unknown channel: %s", channel)
 	}
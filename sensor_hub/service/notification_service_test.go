@@ -94,10 +94,18 @@ func (m *mockNotificationRepo) GetUserIDsWithPermission(ctx context.Context, per
 	return []int{1, 2, 3}, nil
 }
 
+func (m *mockNotificationRepo) GetUserIDsWithRole(ctx context.Context, role string) ([]int, error) {
+	return []int{1}, nil
+}
+
 func (m *mockNotificationRepo) GetUsersWithPermissionAndEmail(ctx context.Context, permission string) ([]database.UserEmailInfo, error) {
 	return []database.UserEmailInfo{}, nil
 }
 
+func (m *mockNotificationRepo) GetUsersWithEmail(ctx context.Context, userIDs []int) ([]database.UserEmailInfo, error) {
+	return []database.UserEmailInfo{}, nil
+}
+
 func TestNotificationService_CreateNotification(t *testing.T) {
 	repo := &mockNotificationRepo{}
 	svc := NewNotificationService(repo, nil, slog.Default())
@@ -176,3 +184,128 @@ func TestNotificationService_SetChannelPreference(t *testing.T) {
 	require.NoError(t, err)
 	require.True(t, repo.setPreferenceCalled)
 }
+
+type recordingChannelNotifier struct {
+	sent chan []int
+}
+
+func (r *recordingChannelNotifier) SendNotification(ctx context.Context, notif notifications.Notification, userIDs []int) {
+	r.sent <- userIDs
+}
+
+func TestNotificationService_CreateNotification_Webhooks(t *testing.T) {
+	repo := &mockNotificationRepo{
+		channelPref: &notifications.ChannelPreference{WebhookEnabled: true},
+	}
+	webhooks := &recordingChannelNotifier{sent: make(chan []int, 1)}
+	svc := NewNotificationService(repo, nil, slog.Default())
+	svc.SetWebhookNotifier(webhooks)
+
+	notif := notifications.Notification{
+		Category: notifications.CategoryConfigChange,
+		Severity: notifications.SeverityInfo,
+		Title:    "Config changed",
+		Message:  "log.level was changed",
+	}
+
+	_, err := svc.CreateNotification(context.Background(), notif, "view_notifications_config")
+	require.NoError(t, err)
+
+	select {
+	case userIDs := <-webhooks.sent:
+		require.Equal(t, []int{1, 2, 3}, userIDs)
+	case <-time.After(time.Second):
+		t.Fatal("webhook notifier was not called")
+	}
+
+	shouldNotify, err := svc.ShouldNotifyChannel(context.Background(), 1, notifications.CategoryConfigChange, "webhook")
+	require.NoError(t, err)
+	require.True(t, shouldNotify)
+}
+
+func TestNotificationService_CreateNotification_Push(t *testing.T) {
+	repo := &mockNotificationRepo{
+		channelPref: &notifications.ChannelPreference{PushEnabled: true},
+	}
+	pushes := &recordingChannelNotifier{sent: make(chan []int, 1)}
+	svc := NewNotificationService(repo, nil, slog.Default())
+	svc.SetPushNotifier(pushes)
+
+	notif := notifications.Notification{
+		Category: notifications.CategoryUserManagement,
+		Severity: notifications.SeverityInfo,
+		Title:    "User created",
+		Message:  "bob was created",
+	}
+
+	_, err := svc.CreateNotification(context.Background(), notif, "manage_users")
+	require.NoError(t, err)
+
+	select {
+	case userIDs := <-pushes.sent:
+		require.Equal(t, []int{1, 2, 3}, userIDs)
+	case <-time.After(time.Second):
+		t.Fatal("push notifier was not called")
+	}
+
+	shouldNotify, err := svc.ShouldNotifyChannel(context.Background(), 1, notifications.CategoryUserManagement, "push")
+	require.NoError(t, err)
+	require.True(t, shouldNotify)
+}
+
+type stubDeferrer struct {
+	deferUsers map[int]bool
+}
+
+func (d *stubDeferrer) DeferEmail(ctx context.Context, notif notifications.Notification, userID int, pref *notifications.ChannelPreference) (bool, error) {
+	return d.deferUsers[userID], nil
+}
+
+func (d *stubDeferrer) InQuietHours(ctx context.Context, notif notifications.Notification, userID int) (bool, error) {
+	return false, nil
+}
+
+type emailRepo struct {
+	mockNotificationRepo
+}
+
+func (m *emailRepo) GetUsersWithPermissionAndEmail(ctx context.Context, permission string) ([]database.UserEmailInfo, error) {
+	return []database.UserEmailInfo{{UserID: 1, Email: "a@example.com"}, {UserID: 2, Email: "b@example.com"}}, nil
+}
+
+type chanEmailer struct {
+	sent chan string
+}
+
+func (c *chanEmailer) SendNotification(recipient, title, message, category string) error {
+	c.sent <- recipient
+	return nil
+}
+
+func TestNotificationService_CreateNotification_DeferredEmail(t *testing.T) {
+	repo := &emailRepo{}
+	emailer := &chanEmailer{sent: make(chan string, 2)}
+	svc := NewNotificationService(repo, nil, slog.Default())
+	svc.SetEmailNotifier(emailer)
+	svc.SetDeferrer(&stubDeferrer{deferUsers: map[int]bool{2: true}})
+
+	_, err := svc.CreateNotification(context.Background(), notifications.Notification{
+		Category: notifications.CategoryUserManagement,
+		Severity: notifications.SeverityInfo,
+		Title:    "User created",
+		Message:  "bob was created",
+	}, "manage_users")
+	require.NoError(t, err)
+
+	select {
+	case recipient := <-emailer.sent:
+		require.Equal(t, "a@example.com", recipient)
+	case <-time.After(time.Second):
+		t.Fatal("email was not sent")
+	}
+	select {
+	case recipient := <-emailer.sent:
+		t.Fatalf("deferred email was sent to %s", recipient)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
@@ -21,17 +21,30 @@ func setupPropertiesServiceTestConfig() func() {
 
 	// Set up minimal test config with actual field names
 	appProps.AppConfig = &appProps.ApplicationConfiguration{
-		SensorCollectionInterval:      30,
-		AuthSessionTTLMinutes:         60,
-		AuthBcryptCost:                4,
-		HealthHistoryRetentionDays:    30,
-		SensorDataRetentionDays:       90,
-		DataCleanupIntervalHours:      24,
-		FailedLoginRetentionDays:      2,
-		SMTPUser:                      "testuser",
-		DatabasePath:                  "data/sensor_hub.db",
-		MQTTBrokerPort:                1883,
-		ActuatorCommandTimeoutSeconds: 10,
+		SensorCollectionInterval:               30,
+		AuthSessionTTLMinutes:                  60,
+		AuthBcryptCost:                         4,
+		HealthHistoryRetentionDays:             30,
+		SensorDataRetentionDays:                90,
+		DataCleanupIntervalHours:               24,
+		FailedLoginRetentionDays:               2,
+		SMTPUser:                               "testuser",
+		SMTPHost:                               "smtp.example.com",
+		SMTPPort:                               587,
+		SMTPAuth:                               "plain",
+		SMTPTLS:                                "starttls",
+		SMTPPassword:                           "hunter2",
+		DatabasePath:                           "data/sensor_hub.db",
+		MQTTBrokerPort:                         1883,
+		ActuatorCommandTimeoutSeconds:          10,
+		SensorStaleCheckIntervalSeconds:        300,
+		WebhookTimeoutSeconds:                  10,
+		WebhookMaxAttempts:                     3,
+		PushTimeoutSeconds:                     10,
+		NotificationDigestDailyHour:            8,
+		NotificationDigestCheckIntervalSeconds: 60,
+		AlertEscalationCheckIntervalSeconds:    60,
+		ReadingsRollupRefreshIntervalSeconds:   300,
 	}
 
 	return func() {
@@ -131,7 +144,7 @@ func TestPropertiesService_ServiceUpdateProperties_SkipsMaskedSensitive(t *testi
 
 	service := NewPropertiesService(slog.Default())
 
-	// No sensitive properties exist, so "*****" for a non-sensitive key will update it
+	// "*****" for a non-sensitive key is a literal value and updates it
 	properties := map[string]string{
 		"database.path": "*****",
 	}
@@ -146,6 +159,24 @@ func TestPropertiesService_ServiceUpdateProperties_SkipsMaskedSensitive(t *testi
 	time.Sleep(50 * time.Millisecond)
 }
 
+func TestPropertiesService_ServiceUpdateProperties_KeepsMaskedSMTPPassword(t *testing.T) {
+	cleanup := setupPropertiesServiceTestConfig()
+	defer cleanup()
+
+	service := NewPropertiesService(slog.Default())
+
+	err := service.ServiceUpdateProperties(context.Background(), map[string]string{
+		"smtp.password": "*****",
+		"smtp.host":     "relay.example.com",
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "hunter2", appProps.AppConfig.SMTPPassword)
+	assert.Equal(t, "relay.example.com", appProps.AppConfig.SMTPHost)
+
+	time.Sleep(50 * time.Millisecond)
+}
+
 func TestPropertiesService_ServiceUpdateProperties_UpdatesDatabasePath(t *testing.T) {
 	cleanup := setupPropertiesServiceTestConfig()
 	defer cleanup()
@@ -0,0 +1,102 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	database "example/sensorHub/db"
+	"example/sensorHub/notifications"
+	"example/sensorHub/push"
+)
+
+var (
+	// ErrPushSubscriptionNotFound is returned when the user has not configured push notifications.
+	ErrPushSubscriptionNotFound = errors.New("push subscription not found")
+	// ErrInvalidPushSubscription wraps validation failures. Subscriptions are validated here
+	// rather than in the handler because a kept token counts towards validity.
+	ErrInvalidPushSubscription = errors.New("invalid push subscription")
+)
+
+// PushSender publishes a single notification to a single subscription.
+type PushSender interface {
+	Send(ctx context.Context, sub push.Subscription, notif notifications.Notification) error
+}
+
+type PushService struct {
+	repo   database.PushRepository
+	sender PushSender
+	logger *slog.Logger
+}
+
+func NewPushService(repo database.PushRepository, sender PushSender, logger *slog.Logger) *PushService {
+	return &PushService{
+		repo:   repo,
+		sender: sender,
+		logger: logger.With("component", "push_service"),
+	}
+}
+
+func (s *PushService) ServiceGetPushSubscription(ctx context.Context, userID int) (*push.Subscription, error) {
+	sub, err := s.repo.GetPushSubscription(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting push subscription: %w", err)
+	}
+	if sub == nil {
+		return nil, ErrPushSubscriptionNotFound
+	}
+	return sub, nil
+}
+
+// ServiceSetPushSubscription creates or replaces the user's subscription. A nil token
+// keeps the existing one and an empty token removes it.
+func (s *PushService) ServiceSetPushSubscription(ctx context.Context, userID int, sub *push.Subscription, token *string) error {
+	sub.UserID = userID
+	if token != nil {
+		sub.Token = *token
+	} else {
+		existing, err := s.repo.GetPushSubscription(ctx, userID)
+		if err != nil {
+			return fmt.Errorf("error getting push subscription: %w", err)
+		}
+		if existing != nil {
+			sub.Token = existing.Token
+		}
+	}
+	if err := sub.Validate(); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidPushSubscription, err)
+	}
+
+	if err := s.repo.SetPushSubscription(ctx, sub); err != nil {
+		return fmt.Errorf("error setting push subscription: %w", err)
+	}
+	s.logger.Info("push subscription set", "user_id", userID, "provider", sub.Provider)
+	return nil
+}
+
+func (s *PushService) ServiceDeletePushSubscription(ctx context.Context, userID int) error {
+	if _, err := s.ServiceGetPushSubscription(ctx, userID); err != nil {
+		return err
+	}
+	if err := s.repo.DeletePushSubscription(ctx, userID); err != nil {
+		return fmt.Errorf("error deleting push subscription: %w", err)
+	}
+	s.logger.Info("push subscription deleted", "user_id", userID)
+	return nil
+}
+
+// ServiceTestPushSubscription sends a test notification to the user's subscription, even
+// when it is disabled, and returns the push server's error if it was rejected.
+func (s *PushService) ServiceTestPushSubscription(ctx context.Context, userID int) error {
+	sub, err := s.ServiceGetPushSubscription(ctx, userID)
+	if err != nil {
+		return err
+	}
+	return s.sender.Send(ctx, *sub, notifications.Notification{
+		Category: notifications.CategoryConfigChange,
+		Severity: notifications.SeverityInfo,
+		Title:    "Test notification",
+		Message:  fmt.Sprintf("Test push notification from Sensor Hub via %s", sub.Provider),
+	})
+}
@@ -0,0 +1,14 @@
+package service
+
+import (
+	"context"
+
+	"example/sensorHub/push"
+)
+
+type PushServiceInterface interface {
+	ServiceGetPushSubscription(ctx context.Context, userID int) (*push.Subscription, error)
+	ServiceSetPushSubscription(ctx context.Context, userID int, sub *push.Subscription, token *string) error
+	ServiceDeletePushSubscription(ctx context.Context, userID int) error
+	ServiceTestPushSubscription(ctx context.Context, userID int) error
+}
@@ -0,0 +1,100 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+
+	"example/sensorHub/notifications"
+	"example/sensorHub/push"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type mockPushRepository struct {
+	mock.Mock
+}
+
+func (m *mockPushRepository) GetPushSubscriptionsForUsers(ctx context.Context, userIDs []int) ([]push.Subscription, error) {
+	args := m.Called(ctx, userIDs)
+	return args.Get(0).([]push.Subscription), args.Error(1)
+}
+
+func (m *mockPushRepository) GetPushSubscription(ctx context.Context, userID int) (*push.Subscription, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*push.Subscription), args.Error(1)
+}
+
+func (m *mockPushRepository) SetPushSubscription(ctx context.Context, sub *push.Subscription) error {
+	return m.Called(ctx, sub).Error(0)
+}
+
+func (m *mockPushRepository) DeletePushSubscription(ctx context.Context, userID int) error {
+	return m.Called(ctx, userID).Error(0)
+}
+
+type stubPushSender struct {
+	sub   push.Subscription
+	notif notifications.Notification
+	err   error
+}
+
+func (s *stubPushSender) Send(_ context.Context, sub push.Subscription, notif notifications.Notification) error {
+	s.sub, s.notif = sub, notif
+	return s.err
+}
+
+func TestPushService_SetPushSubscription_KeepsToken(t *testing.T) {
+	repo := new(mockPushRepository)
+	svc := NewPushService(repo, &stubPushSender{}, slog.Default())
+	repo.On("GetPushSubscription", mock.Anything, 7).Return(&push.Subscription{UserID: 7, Provider: push.ProviderGotify, Token: "AbCdEf"}, nil)
+	repo.On("SetPushSubscription", mock.Anything, mock.Anything).Return(nil)
+
+	sub := push.Subscription{Provider: push.ProviderGotify, ServerURL: "https://gotify.example.com", Enabled: true}
+	require.NoError(t, svc.ServiceSetPushSubscription(context.Background(), 7, &sub, nil))
+
+	assert.Equal(t, 7, sub.UserID)
+	assert.Equal(t, "AbCdEf", sub.Token)
+}
+
+func TestPushService_SetPushSubscription_Invalid(t *testing.T) {
+	repo := new(mockPushRepository)
+	svc := NewPushService(repo, &stubPushSender{}, slog.Default())
+
+	cleared := ""
+	sub := push.Subscription{Provider: push.ProviderGotify, ServerURL: "https://gotify.example.com"}
+	err := svc.ServiceSetPushSubscription(context.Background(), 7, &sub, &cleared)
+
+	assert.ErrorIs(t, err, ErrInvalidPushSubscription)
+	repo.AssertNotCalled(t, "SetPushSubscription", mock.Anything, mock.Anything)
+}
+
+func TestPushService_DeletePushSubscription_NotFound(t *testing.T) {
+	repo := new(mockPushRepository)
+	svc := NewPushService(repo, &stubPushSender{}, slog.Default())
+	repo.On("GetPushSubscription", mock.Anything, 7).Return(nil, nil)
+
+	err := svc.ServiceDeletePushSubscription(context.Background(), 7)
+
+	assert.ErrorIs(t, err, ErrPushSubscriptionNotFound)
+	repo.AssertNotCalled(t, "DeletePushSubscription", mock.Anything, mock.Anything)
+}
+
+func TestPushService_TestPushSubscription_ReturnsSendError(t *testing.T) {
+	repo := new(mockPushRepository)
+	sender := &stubPushSender{err: errors.New("ntfy server responded with status 403")}
+	svc := NewPushService(repo, sender, slog.Default())
+	repo.On("GetPushSubscription", mock.Anything, 7).Return(&push.Subscription{UserID: 7, Provider: push.ProviderNtfy, Topic: "leaks"}, nil)
+
+	err := svc.ServiceTestPushSubscription(context.Background(), 7)
+
+	assert.ErrorContains(t, err, "status 403")
+	assert.Equal(t, "leaks", sender.sub.Topic)
+	assert.Equal(t, "Test notification", sender.notif.Title)
+}
@@ -2,10 +2,13 @@ package service
 
 import (
 	"context"
+	appProps "example/sensorHub/application_properties"
 	database "example/sensorHub/db"
 	gen "example/sensorHub/gen"
+	"example/sensorHub/periodic"
 	"fmt"
 	"log/slog"
+	"slices"
 	"time"
 )
 
@@ -27,7 +30,7 @@ func NewReadingsService(repo database.ReadingsRepository, mtRepo database.Measur
 	}
 }
 
-func (s *ReadingsService) ServiceGetBetweenDates(ctx context.Context, startDate, endDate, sensorName, measurementType string, overrideInterval, overrideFunction string) (*gen.AggregatedReadingsResponse, error) {
+func (s *ReadingsService) ServiceGetBetweenDates(ctx context.Context, startDate, endDate, sensorName, measurementType string, overrideInterval, overrideFunction string, statistics []string) (*gen.AggregatedReadingsResponse, error) {
 	var interval database.AggregationInterval
 	var aggFunc database.AggregationFunction
 	var err error
@@ -54,20 +57,36 @@ func (s *ReadingsService) ServiceGetBetweenDates(ctx context.Context, startDate,
 		}
 	}
 
+	var stats []database.AggregationFunction
 	if interval == database.AggregationRaw {
 		aggFunc = database.AggregationFunctionNone
+	} else if stats, err = s.resolveStatistics(ctx, measurementType, statistics); err != nil {
+		return nil, err
 	}
 
-	readings, err := s.repo.GetBetweenDates(ctx, startDate, endDate, sensorName, measurementType, interval, aggFunc)
+	var readings []gen.Reading
+	if rollup, ok := database.RollupFor(interval, append([]database.AggregationFunction{aggFunc}, stats...)...); ok {
+		readings, err = s.repo.GetRollupBetweenDates(ctx, startDate, endDate, sensorName, measurementType, rollup, interval, aggFunc, stats)
+	} else {
+		readings, err = s.repo.GetBetweenDates(ctx, startDate, endDate, sensorName, measurementType, interval, aggFunc, stats)
+	}
 	if err != nil {
 		return nil, err
 	}
 
-	return &gen.AggregatedReadingsResponse{
+	response := &gen.AggregatedReadingsResponse{
 		AggregationInterval: gen.AggregatedReadingsResponseAggregationInterval(interval),
 		AggregationFunction: gen.AggregatedReadingsResponseAggregationFunction(aggFunc),
 		Readings:            readings,
-	}, nil
+	}
+	if len(stats) > 0 {
+		applied := make([]string, len(stats))
+		for i, fn := range stats {
+			applied[i] = string(fn)
+		}
+		response.Statistics = &applied
+	}
+	return response, nil
 }
 
 func (s *ReadingsService) resolveFunction(ctx context.Context, measurementType, overrideFunction string) (database.AggregationFunction, error) {
@@ -101,6 +120,36 @@ func (s *ReadingsService) resolveFunction(ctx context.Context, measurementType,
 	return database.AggregationFunction(agg.DefaultFunction), nil
 }
 
+// resolveStatistics checks that the measurement type supports each requested extra
+// statistic, the same way resolveFunction checks an overridden function.
+func (s *ReadingsService) resolveStatistics(ctx context.Context, measurementType string, statistics []string) ([]database.AggregationFunction, error) {
+	if len(statistics) == 0 {
+		return nil, nil
+	}
+
+	var supported []string
+	agg, err := s.mtRepo.GetAggregationsForMeasurementType(ctx, measurementType)
+	if err != nil {
+		s.logger.Warn("failed to look up aggregation functions, not checking statistics",
+			"measurement_type", measurementType, "error", err)
+	} else {
+		supported = agg.SupportedFunctions
+	}
+
+	stats := make([]database.AggregationFunction, 0, len(statistics))
+	for _, fn := range statistics {
+		if supported != nil && !slices.Contains(supported, fn) {
+			return nil, &ErrUnsupportedAggregationFunction{
+				Function:        fn,
+				MeasurementType: measurementType,
+				Supported:       supported,
+			}
+		}
+		stats = append(stats, database.AggregationFunction(fn))
+	}
+	return stats, nil
+}
+
 func (s *ReadingsService) ServiceGetLatest(ctx context.Context) ([]gen.Reading, error) {
 	readings, err := s.repo.GetLatest(ctx)
 	if err != nil {
@@ -109,6 +158,26 @@ func (s *ReadingsService) ServiceGetLatest(ctx context.Context) ([]gen.Reading,
 	return readings, nil
 }
 
+// ServiceStartRollupRefresh periodically folds new readings into the rollups that
+// long-range queries read from.
+func (s *ReadingsService) ServiceStartRollupRefresh(ctx context.Context) {
+	intervalSec := appProps.AppConfig.ReadingsRollupRefreshIntervalSeconds
+
+	periodic.RunTask(ctx, periodic.TaskConfig{
+		Name:           "readings_rollup_refresh",
+		Interval:       time.Duration(intervalSec) * time.Second,
+		Logger:         s.logger,
+		RunImmediately: true,
+	}, func(ctx context.Context) error {
+		folded, err := s.repo.RefreshRollups(ctx)
+		if err != nil {
+			return err
+		}
+		s.logger.Debug("refreshed readings rollups", "readings", folded)
+		return nil
+	})
+}
+
 func computeSpan(startDate, endDate string) (time.Duration, error) {
 	const layout = "2006-01-02 15:04:05"
 	start, err := time.Parse(layout, startDate)
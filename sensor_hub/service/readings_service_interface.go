@@ -6,6 +6,6 @@ import (
 )
 
 type ReadingsServiceInterface interface {
-	ServiceGetBetweenDates(ctx context.Context, startDate, endDate, sensorName, measurementType string, overrideInterval, overrideFunction string) (*gen.AggregatedReadingsResponse, error)
+	ServiceGetBetweenDates(ctx context.Context, startDate, endDate, sensorName, measurementType string, overrideInterval, overrideFunction string, statistics []string) (*gen.AggregatedReadingsResponse, error)
 	ServiceGetLatest(ctx context.Context) ([]gen.Reading, error)
 }
@@ -37,9 +37,9 @@ func TestReadingsService_ServiceGetBetweenDates_RawForShortRange(t *testing.T) {
 		{Id: 1, SensorName: "LivingRoom", MeasurementType: "temperature", Unit: "°C", NumericValue: &val1, Time: "2025-01-15 10:00:00"},
 	}
 	// 10-minute span → raw (no aggregation)
-	repo.On("GetBetweenDates", mock.Anything, "2025-01-15 10:00:00", "2025-01-15 10:10:00", "", "", database.AggregationRaw, database.AggregationFunctionNone).Return(readings, nil)
+	repo.On("GetBetweenDates", mock.Anything, "2025-01-15 10:00:00", "2025-01-15 10:10:00", "", "", database.AggregationRaw, database.AggregationFunctionNone, []database.AggregationFunction(nil)).Return(readings, nil)
 
-	result, err := svc.ServiceGetBetweenDates(context.Background(), "2025-01-15 10:00:00", "2025-01-15 10:10:00", "", "", "", "")
+	result, err := svc.ServiceGetBetweenDates(context.Background(), "2025-01-15 10:00:00", "2025-01-15 10:10:00", "", "", "", "", nil)
 
 	assert.NoError(t, err)
 	assert.Equal(t, gen.AggregatedReadingsResponseAggregationIntervalRaw, result.AggregationInterval)
@@ -59,10 +59,10 @@ func TestReadingsService_ServiceGetBetweenDates_AggregatedFor3DayRange(t *testin
 		DefaultFunction:    "avg",
 		SupportedFunctions: []string{"avg"},
 	}, nil)
-	// 3-day span → PT15M interval
-	repo.On("GetBetweenDates", mock.Anything, "2025-01-15 00:00:00", "2025-01-18 00:00:00", "", "temperature", database.AggregationPT15M, database.AggregationFunctionAvg).Return(readings, nil)
+	// 3-day span → PT15M interval, read from the 5-minute rollup
+	repo.On("GetRollupBetweenDates", mock.Anything, "2025-01-15 00:00:00", "2025-01-18 00:00:00", "", "temperature", database.AggregationPT5M, database.AggregationPT15M, database.AggregationFunctionAvg, []database.AggregationFunction(nil)).Return(readings, nil)
 
-	result, err := svc.ServiceGetBetweenDates(context.Background(), "2025-01-15 00:00:00", "2025-01-18 00:00:00", "", "temperature", "", "")
+	result, err := svc.ServiceGetBetweenDates(context.Background(), "2025-01-15 00:00:00", "2025-01-18 00:00:00", "", "temperature", "", "", nil)
 
 	assert.NoError(t, err)
 	assert.Equal(t, gen.AggregatedReadingsResponseAggregationIntervalPT15M, result.AggregationInterval)
@@ -79,9 +79,9 @@ func TestReadingsService_ServiceGetBetweenDates_OverrideInterval(t *testing.T) {
 		DefaultFunction:    "avg",
 		SupportedFunctions: []string{"avg"},
 	}, nil)
-	repo.On("GetBetweenDates", mock.Anything, "2025-01-15 00:00:00", "2025-01-15 01:00:00", "", "temperature", database.AggregationInterval("PT1H"), database.AggregationFunctionAvg).Return(readings, nil)
+	repo.On("GetRollupBetweenDates", mock.Anything, "2025-01-15 00:00:00", "2025-01-15 01:00:00", "", "temperature", database.AggregationPT1H, database.AggregationInterval("PT1H"), database.AggregationFunctionAvg, []database.AggregationFunction(nil)).Return(readings, nil)
 
-	result, err := svc.ServiceGetBetweenDates(context.Background(), "2025-01-15 00:00:00", "2025-01-15 01:00:00", "", "temperature", "PT1H", "")
+	result, err := svc.ServiceGetBetweenDates(context.Background(), "2025-01-15 00:00:00", "2025-01-15 01:00:00", "", "temperature", "PT1H", "", nil)
 
 	assert.NoError(t, err)
 	assert.Equal(t, gen.AggregatedReadingsResponseAggregationInterval("PT1H"), result.AggregationInterval)
@@ -96,14 +96,94 @@ func TestReadingsService_ServiceGetBetweenDates_OverrideFunction(t *testing.T) {
 		DefaultFunction:    "avg",
 		SupportedFunctions: []string{"avg", "count", "last"},
 	}, nil)
-	repo.On("GetBetweenDates", mock.Anything, "2025-01-15 00:00:00", "2025-01-18 00:00:00", "", "temperature", database.AggregationPT15M, database.AggregationFunctionCount).Return(readings, nil)
+	repo.On("GetRollupBetweenDates", mock.Anything, "2025-01-15 00:00:00", "2025-01-18 00:00:00", "", "temperature", database.AggregationPT5M, database.AggregationPT15M, database.AggregationFunctionCount, []database.AggregationFunction(nil)).Return(readings, nil)
 
-	result, err := svc.ServiceGetBetweenDates(context.Background(), "2025-01-15 00:00:00", "2025-01-18 00:00:00", "", "temperature", "", "count")
+	result, err := svc.ServiceGetBetweenDates(context.Background(), "2025-01-15 00:00:00", "2025-01-18 00:00:00", "", "temperature", "", "count", nil)
 
 	assert.NoError(t, err)
 	assert.Equal(t, gen.AggregatedReadingsResponseAggregationFunctionCount, result.AggregationFunction)
 }
 
+func TestReadingsService_ServiceGetBetweenDates_IntervalFinerThanRollups(t *testing.T) {
+	svc, repo, mtRepo := setupReadingsService()
+
+	mtRepo.On("GetAggregationsForMeasurementType", mock.Anything, "temperature").Return(&database.MeasurementTypeAggregation{
+		MeasurementType:    "temperature",
+		DefaultFunction:    "avg",
+		SupportedFunctions: []string{"avg"},
+	}, nil)
+	// 6-hour span → PT1M interval, which no rollup can answer
+	repo.On("GetBetweenDates", mock.Anything, "2025-01-15 00:00:00", "2025-01-15 06:00:00", "", "temperature", database.AggregationPT1M, database.AggregationFunctionAvg, []database.AggregationFunction(nil)).Return([]gen.Reading{}, nil)
+
+	result, err := svc.ServiceGetBetweenDates(context.Background(), "2025-01-15 00:00:00", "2025-01-15 06:00:00", "", "temperature", "", "", nil)
+
+	assert.NoError(t, err)
+	assert.Equal(t, gen.AggregatedReadingsResponseAggregationIntervalPT1M, result.AggregationInterval)
+	repo.AssertNotCalled(t, "GetRollupBetweenDates", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestReadingsService_ServiceGetBetweenDates_Statistics(t *testing.T) {
+	svc, repo, mtRepo := setupReadingsService()
+
+	mtRepo.On("GetAggregationsForMeasurementType", mock.Anything, "temperature").Return(&database.MeasurementTypeAggregation{
+		MeasurementType:    "temperature",
+		DefaultFunction:    "avg",
+		SupportedFunctions: []string{"avg", "max", "min", "p50", "p95"},
+	}, nil)
+	stats := []database.AggregationFunction{database.AggregationFunctionMin, database.AggregationFunctionMax}
+	repo.On("GetRollupBetweenDates", mock.Anything, "2025-01-15 00:00:00", "2025-01-18 00:00:00", "", "temperature", database.AggregationPT5M, database.AggregationPT15M, database.AggregationFunctionAvg, stats).Return([]gen.Reading{}, nil)
+
+	result, err := svc.ServiceGetBetweenDates(context.Background(), "2025-01-15 00:00:00", "2025-01-18 00:00:00", "", "temperature", "", "", []string{"min", "max"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"min", "max"}, *result.Statistics)
+}
+
+func TestReadingsService_ServiceGetBetweenDates_PercentileStatisticReadsRaw(t *testing.T) {
+	svc, repo, mtRepo := setupReadingsService()
+
+	mtRepo.On("GetAggregationsForMeasurementType", mock.Anything, "temperature").Return(&database.MeasurementTypeAggregation{
+		MeasurementType:    "temperature",
+		DefaultFunction:    "avg",
+		SupportedFunctions: []string{"avg", "max", "min", "p50", "p95"},
+	}, nil)
+	stats := []database.AggregationFunction{database.AggregationFunctionP95}
+	repo.On("GetBetweenDates", mock.Anything, "2025-01-15 00:00:00", "2025-01-18 00:00:00", "", "temperature", database.AggregationPT15M, database.AggregationFunctionAvg, stats).Return([]gen.Reading{}, nil)
+
+	_, err := svc.ServiceGetBetweenDates(context.Background(), "2025-01-15 00:00:00", "2025-01-18 00:00:00", "", "temperature", "", "", []string{"p95"})
+
+	assert.NoError(t, err)
+	repo.AssertNotCalled(t, "GetRollupBetweenDates", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestReadingsService_ServiceGetBetweenDates_UnsupportedStatistic(t *testing.T) {
+	svc, _, mtRepo := setupReadingsService()
+
+	mtRepo.On("GetAggregationsForMeasurementType", mock.Anything, "motion").Return(&database.MeasurementTypeAggregation{
+		MeasurementType:    "motion",
+		DefaultFunction:    "count",
+		SupportedFunctions: []string{"count", "last"},
+	}, nil)
+
+	result, err := svc.ServiceGetBetweenDates(context.Background(), "2025-01-15 00:00:00", "2025-01-18 00:00:00", "", "motion", "", "", []string{"p95"})
+
+	assert.Nil(t, result)
+	var unsupported *ErrUnsupportedAggregationFunction
+	assert.True(t, errors.As(err, &unsupported))
+	assert.Equal(t, "p95", unsupported.Function)
+}
+
+func TestReadingsService_ServiceGetBetweenDates_StatisticsIgnoredForRaw(t *testing.T) {
+	svc, repo, _ := setupReadingsService()
+
+	repo.On("GetBetweenDates", mock.Anything, "2025-01-15 10:00:00", "2025-01-15 10:10:00", "", "", database.AggregationRaw, database.AggregationFunctionNone, []database.AggregationFunction(nil)).Return([]gen.Reading{}, nil)
+
+	result, err := svc.ServiceGetBetweenDates(context.Background(), "2025-01-15 10:00:00", "2025-01-15 10:10:00", "", "", "", "", []string{"min"})
+
+	assert.NoError(t, err)
+	assert.Nil(t, result.Statistics)
+}
+
 func TestReadingsService_ServiceGetBetweenDates_UnsupportedFunction(t *testing.T) {
 	svc, _, mtRepo := setupReadingsService()
 
@@ -113,7 +193,7 @@ func TestReadingsService_ServiceGetBetweenDates_UnsupportedFunction(t *testing.T
 		SupportedFunctions: []string{"count", "last"},
 	}, nil)
 
-	result, err := svc.ServiceGetBetweenDates(context.Background(), "2025-01-15 00:00:00", "2025-01-18 00:00:00", "", "motion", "", "avg")
+	result, err := svc.ServiceGetBetweenDates(context.Background(), "2025-01-15 00:00:00", "2025-01-18 00:00:00", "", "motion", "", "avg", nil)
 
 	assert.Error(t, err)
 	assert.Nil(t, result)
@@ -134,9 +214,9 @@ func TestReadingsService_ServiceGetBetweenDates_DisabledAggregation(t *testing.T
 		{Id: 1, SensorName: "LivingRoom", MeasurementType: "temperature", Unit: "°C", NumericValue: &val, Time: "2025-01-15 10:00:00"},
 	}
 	// Even for a 3-day range, disabled → raw
-	repo.On("GetBetweenDates", mock.Anything, "2025-01-15 00:00:00", "2025-01-18 00:00:00", "", "", database.AggregationRaw, database.AggregationFunctionNone).Return(readings, nil)
+	repo.On("GetBetweenDates", mock.Anything, "2025-01-15 00:00:00", "2025-01-18 00:00:00", "", "", database.AggregationRaw, database.AggregationFunctionNone, []database.AggregationFunction(nil)).Return(readings, nil)
 
-	result, err := svc.ServiceGetBetweenDates(context.Background(), "2025-01-15 00:00:00", "2025-01-18 00:00:00", "", "", "", "")
+	result, err := svc.ServiceGetBetweenDates(context.Background(), "2025-01-15 00:00:00", "2025-01-18 00:00:00", "", "", "", "", nil)
 
 	assert.NoError(t, err)
 	assert.Equal(t, gen.AggregatedReadingsResponseAggregationIntervalRaw, result.AggregationInterval)
@@ -146,9 +226,9 @@ func TestReadingsService_ServiceGetBetweenDates_DisabledAggregation(t *testing.T
 func TestReadingsService_ServiceGetBetweenDates_Error(t *testing.T) {
 	svc, repo, _ := setupReadingsService()
 
-	repo.On("GetBetweenDates", mock.Anything, "2025-01-15 10:00:00", "2025-01-15 10:10:00", "", "", database.AggregationRaw, database.AggregationFunctionNone).Return([]gen.Reading{}, errors.New("database error"))
+	repo.On("GetBetweenDates", mock.Anything, "2025-01-15 10:00:00", "2025-01-15 10:10:00", "", "", database.AggregationRaw, database.AggregationFunctionNone, []database.AggregationFunction(nil)).Return([]gen.Reading{}, errors.New("database error"))
 
-	result, err := svc.ServiceGetBetweenDates(context.Background(), "2025-01-15 10:00:00", "2025-01-15 10:10:00", "", "", "", "")
+	result, err := svc.ServiceGetBetweenDates(context.Background(), "2025-01-15 10:00:00", "2025-01-15 10:10:00", "", "", "", "", nil)
 
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "database error")
@@ -158,7 +238,7 @@ func TestReadingsService_ServiceGetBetweenDates_Error(t *testing.T) {
 func TestReadingsService_ServiceGetBetweenDates_InvalidDateFormat(t *testing.T) {
 	svc, _, _ := setupReadingsService()
 
-	result, err := svc.ServiceGetBetweenDates(context.Background(), "not-a-date", "2025-01-15 10:00:00", "", "", "", "")
+	result, err := svc.ServiceGetBetweenDates(context.Background(), "not-a-date", "2025-01-15 10:00:00", "", "", "", "", nil)
 
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "parse start date")
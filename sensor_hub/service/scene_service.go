@@ -0,0 +1,359 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"example/sensorHub/actuation"
+	database "example/sensorHub/db"
+	"example/sensorHub/drivers"
+	gen "example/sensorHub/gen"
+	"example/sensorHub/scenes"
+)
+
+// sceneWaitGrace is added to the command timeout when waiting for an activation's outcome,
+// so that the tracker has time to report the commands that time out.
+const sceneWaitGrace = 2 * time.Second
+
+var (
+	ErrSceneNotFound = errors.New("scene not found")
+	// ErrInvalidScene is returned for scenes with a command whose sensor does not exist or
+	// cannot accept the command.
+	ErrInvalidScene = errors.New("invalid scene")
+)
+
+// SceneCommandSender sends the commands of a scene. CommandService implements it.
+type SceneCommandSender interface {
+	Send(ctx context.Context, sensorID int, actor *gen.User, property string, value string) (SentCommandResult, error)
+	SendForAutomation(ctx context.Context, ruleID, sensorID int, property, value string) (int, error)
+}
+
+// SceneStatusBroadcaster is told the final outcome of every scene activation.
+type SceneStatusBroadcaster interface {
+	BroadcastSceneStatus(activation scenes.Activation)
+}
+
+// SceneService manages scenes and activates them. An activation sends all of a scene's
+// commands concurrently through CommandService, so each is validated, tracked and
+// recorded in Command History like any other command. The service observes the command
+// tracker to learn each command's outcome and aggregates them into the activation's
+// status.
+type SceneService struct {
+	repo        database.SceneRepository
+	sensorRepo  CommandSensorRepository
+	sender      SceneCommandSender
+	broadcaster SceneStatusBroadcaster
+	logger      *slog.Logger
+	now         func() time.Time
+
+	mu sync.Mutex
+	// waiting holds the commands of unfinished activations by command ID.
+	waiting map[int]sceneCommandRef
+	// early holds outcomes reported while a send was still returning its command ID. It is
+	// emptied once no activation is sending.
+	early   map[int]actuation.CommandStatusMessage
+	sending int
+}
+
+type sceneRun struct {
+	activation scenes.Activation
+	unsettled  int
+	done       chan struct{}
+}
+
+type sceneCommandRef struct {
+	run   *sceneRun
+	index int
+}
+
+func NewSceneService(repo database.SceneRepository, sensorRepo CommandSensorRepository, sender SceneCommandSender, broadcaster SceneStatusBroadcaster, logger *slog.Logger) *SceneService {
+	return &SceneService{
+		repo:        repo,
+		sensorRepo:  sensorRepo,
+		sender:      sender,
+		broadcaster: broadcaster,
+		logger:      logger.With("component", "scene_service"),
+		now:         time.Now,
+		waiting:     make(map[int]sceneCommandRef),
+		early:       make(map[int]actuation.CommandStatusMessage),
+	}
+}
+
+func (s *SceneService) ServiceGetAllScenes(ctx context.Context) ([]scenes.Scene, error) {
+	all, err := s.repo.GetAllScenes(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error listing scenes: %w", err)
+	}
+	return all, nil
+}
+
+func (s *SceneService) ServiceGetSceneByID(ctx context.Context, sceneID int) (*scenes.Scene, error) {
+	scene, err := s.repo.GetSceneByID(ctx, sceneID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting scene: %w", err)
+	}
+	if scene == nil {
+		return nil, ErrSceneNotFound
+	}
+	return scene, nil
+}
+
+// ServiceCreateScene creates a scene after checking that every command's sensor accepts
+// the command.
+func (s *SceneService) ServiceCreateScene(ctx context.Context, scene *scenes.Scene) error {
+	if err := s.checkCommands(ctx, scene); err != nil {
+		return err
+	}
+	if err := s.repo.CreateScene(ctx, scene); err != nil {
+		return fmt.Errorf("error creating scene: %w", err)
+	}
+	s.logger.Info("scene created", "id", scene.ID, "name", scene.Name, "commands", len(scene.Commands))
+	return nil
+}
+
+func (s *SceneService) ServiceUpdateScene(ctx context.Context, scene *scenes.Scene) error {
+	if _, err := s.ServiceGetSceneByID(ctx, scene.ID); err != nil {
+		return err
+	}
+	if err := s.checkCommands(ctx, scene); err != nil {
+		return err
+	}
+	if err := s.repo.UpdateScene(ctx, scene); err != nil {
+		return fmt.Errorf("error updating scene: %w", err)
+	}
+	s.logger.Info("scene updated", "id", scene.ID, "name", scene.Name, "commands", len(scene.Commands))
+	return nil
+}
+
+func (s *SceneService) ServiceDeleteScene(ctx context.Context, sceneID int) error {
+	if _, err := s.ServiceGetSceneByID(ctx, sceneID); err != nil {
+		return err
+	}
+	if err := s.repo.DeleteScene(ctx, sceneID); err != nil {
+		return fmt.Errorf("error deleting scene: %w", err)
+	}
+	s.logger.Info("scene deleted", "id", sceneID)
+	return nil
+}
+
+// checkCommands checks what Scene.Validate cannot: that each command's sensor exists and
+// is a controllable sensor able to build the command.
+func (s *SceneService) checkCommands(ctx context.Context, scene *scenes.Scene) error {
+	for _, command := range scene.Commands {
+		sensor, err := s.sensorRepo.GetSensorById(ctx, command.SensorID)
+		if err != nil || sensor == nil {
+			return fmt.Errorf("%w: sensor %d not found", ErrInvalidScene, command.SensorID)
+		}
+		commandDriver, ok := drivers.GetCommandDriver(sensor.SensorDriver)
+		if !ok {
+			return fmt.Errorf("%w: sensor %d is not controllable", ErrInvalidScene, command.SensorID)
+		}
+		if _, _, err := commandDriver.BuildCommand(*sensor, command.Property, command.Value); err != nil {
+			return fmt.Errorf("%w: %v", ErrInvalidScene, err)
+		}
+	}
+	return nil
+}
+
+// ServiceActivateScene sends all of the scene's commands on behalf of actor. Without wait
+// it returns once every command has been sent, with the commands still awaiting their
+// outcome reported as sent. With wait it returns once every outcome is known, or the
+// command timeout has passed.
+func (s *SceneService) ServiceActivateScene(ctx context.Context, sceneID int, actor *gen.User, wait bool) (*scenes.Activation, error) {
+	scene, err := s.ServiceGetSceneByID(ctx, sceneID)
+	if err != nil {
+		return nil, err
+	}
+	s.logger.Info("activating scene", "id", scene.ID, "name", scene.Name)
+
+	run := s.activate(scene, func(command scenes.Command) (int, error) {
+		result, err := s.sender.Send(ctx, command.SensorID, actor, command.Property, command.Value)
+		return result.ID, err
+	})
+
+	if wait {
+		timer := time.NewTimer(time.Duration(resolveCommandTimeoutSeconds())*time.Second + sceneWaitGrace)
+		defer timer.Stop()
+		select {
+		case <-run.done:
+		case <-timer.C:
+		case <-ctx.Done():
+		}
+	}
+	activation := s.snapshot(run)
+	return &activation, nil
+}
+
+// ActivateSceneForAutomation activates a scene on behalf of an automation rule. Its
+// commands are recorded in Command History against the rule. It returns an error only
+// when none of the commands could be sent.
+func (s *SceneService) ActivateSceneForAutomation(ctx context.Context, ruleID, sceneID int) error {
+	scene, err := s.ServiceGetSceneByID(ctx, sceneID)
+	if err != nil {
+		return err
+	}
+
+	run := s.activate(scene, func(command scenes.Command) (int, error) {
+		return s.sender.SendForAutomation(ctx, ruleID, command.SensorID, command.Property, command.Value)
+	})
+
+	for _, outcome := range s.snapshot(run).Commands {
+		if outcome.CommandID != nil {
+			return nil
+		}
+	}
+	return fmt.Errorf("scene %d: no command could be sent", sceneID)
+}
+
+// activate sends every command of the scene concurrently and returns once all sends have
+// returned. Commands that were sent are then settled by CommandStatusChanged.
+func (s *SceneService) activate(scene *scenes.Scene, send func(command scenes.Command) (int, error)) *sceneRun {
+	run := &sceneRun{
+		activation: scenes.Activation{
+			SceneID:     scene.ID,
+			SceneName:   scene.Name,
+			ActivatedAt: s.now().UTC(),
+			Status:      scenes.ActivationPending,
+			Commands:    make([]scenes.CommandOutcome, len(scene.Commands)),
+		},
+		unsettled: len(scene.Commands),
+		done:      make(chan struct{}),
+	}
+	for i, command := range scene.Commands {
+		run.activation.Commands[i] = scenes.CommandOutcome{Command: command, Status: actuation.CommandStatusSent}
+	}
+
+	s.mu.Lock()
+	s.sending++
+	s.mu.Unlock()
+
+	var wg sync.WaitGroup
+	for i, command := range scene.Commands {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			commandID, err := send(command)
+			s.sent(run, i, commandID, err)
+		}()
+	}
+	wg.Wait()
+
+	s.mu.Lock()
+	s.sending--
+	if s.sending == 0 {
+		clear(s.early)
+	}
+	s.mu.Unlock()
+	return run
+}
+
+// sent records the result of sending the index'th command of run.
+func (s *SceneService) sent(run *sceneRun, index int, commandID int, sendErr error) {
+	s.mu.Lock()
+	outcome := &run.activation.Commands[index]
+	if sendErr != nil {
+		outcome.Status = actuation.CommandStatusFailed
+		outcome.Error = sendErr.Error()
+	} else {
+		outcome.CommandID = &commandID
+		message, ok := s.early[commandID]
+		if !ok {
+			s.waiting[commandID] = sceneCommandRef{run: run, index: index}
+			s.mu.Unlock()
+			return
+		}
+		delete(s.early, commandID)
+		applyCommandStatus(outcome, message)
+	}
+	finished := s.settle(run)
+	s.mu.Unlock()
+
+	if finished {
+		s.finish(run)
+	}
+}
+
+// CommandStatusChanged settles the scene command with the message's command ID, if any.
+// It implements actuation.CommandStatusObserver.
+func (s *SceneService) CommandStatusChanged(message actuation.CommandStatusMessage) {
+	s.mu.Lock()
+	ref, ok := s.waiting[message.ID]
+	if !ok {
+		if s.sending > 0 {
+			s.early[message.ID] = message
+		}
+		s.mu.Unlock()
+		return
+	}
+	delete(s.waiting, message.ID)
+	applyCommandStatus(&ref.run.activation.Commands[ref.index], message)
+	finished := s.settle(ref.run)
+	s.mu.Unlock()
+
+	if finished {
+		s.finish(ref.run)
+	}
+}
+
+// settle counts one more settled command of run and reports whether it was the last.
+// s.mu must be held.
+func (s *SceneService) settle(run *sceneRun) bool {
+	run.unsettled--
+	return run.unsettled == 0
+}
+
+// finish records and broadcasts the final outcome of run.
+func (s *SceneService) finish(run *sceneRun) {
+	close(run.done)
+	activation := s.snapshot(run)
+
+	if err := s.repo.RecordSceneActivation(context.Background(), activation.SceneID, activation.ActivatedAt, activation.Status); err != nil {
+		s.logger.Error("failed to record scene activation", "id", activation.SceneID, "error", err)
+	}
+	if s.broadcaster != nil {
+		s.broadcaster.BroadcastSceneStatus(activation)
+	}
+	s.logger.Info("scene activation finished", "id", activation.SceneID, "name", activation.SceneName, "status", activation.Status)
+}
+
+// snapshot copies the current state of run's activation.
+func (s *SceneService) snapshot(run *sceneRun) scenes.Activation {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	activation := run.activation
+	activation.Commands = append([]scenes.CommandOutcome(nil), run.activation.Commands...)
+	activation.Status = aggregateSceneStatus(activation.Commands)
+	return activation
+}
+
+func applyCommandStatus(outcome *scenes.CommandOutcome, message actuation.CommandStatusMessage) {
+	outcome.Status = message.Status
+	outcome.AcknowledgedValue = message.AcknowledgedValue
+}
+
+// aggregateSceneStatus combines command outcomes: pending while any command awaits its
+// outcome, then succeeded when all were acknowledged, failed when none were and partial
+// otherwise.
+func aggregateSceneStatus(outcomes []scenes.CommandOutcome) scenes.ActivationStatus {
+	acknowledged := 0
+	for _, outcome := range outcomes {
+		switch outcome.Status {
+		case actuation.CommandStatusSent:
+			return scenes.ActivationPending
+		case actuation.CommandStatusAcknowledged:
+			acknowledged++
+		}
+	}
+	switch acknowledged {
+	case len(outcomes):
+		return scenes.ActivationSucceeded
+	case 0:
+		return scenes.ActivationFailed
+	default:
+		return scenes.ActivationPartial
+	}
+}
@@ -0,0 +1,17 @@
+package service
+
+import (
+	"context"
+
+	gen "example/sensorHub/gen"
+	"example/sensorHub/scenes"
+)
+
+type SceneServiceInterface interface {
+	ServiceGetAllScenes(ctx context.Context) ([]scenes.Scene, error)
+	ServiceGetSceneByID(ctx context.Context, sceneID int) (*scenes.Scene, error)
+	ServiceCreateScene(ctx context.Context, scene *scenes.Scene) error
+	ServiceUpdateScene(ctx context.Context, scene *scenes.Scene) error
+	ServiceDeleteScene(ctx context.Context, sceneID int) error
+	ServiceActivateScene(ctx context.Context, sceneID int, actor *gen.User, wait bool) (*scenes.Activation, error)
+}
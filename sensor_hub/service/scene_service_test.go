@@ -0,0 +1,249 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"sync"
+	"testing"
+	"time"
+
+	"example/sensorHub/actuation"
+	gen "example/sensorHub/gen"
+	"example/sensorHub/scenes"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type mockSceneRepository struct {
+	mock.Mock
+}
+
+func (m *mockSceneRepository) GetAllScenes(ctx context.Context) ([]scenes.Scene, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]scenes.Scene), args.Error(1)
+}
+
+func (m *mockSceneRepository) GetSceneByID(ctx context.Context, sceneID int) (*scenes.Scene, error) {
+	args := m.Called(ctx, sceneID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*scenes.Scene), args.Error(1)
+}
+
+func (m *mockSceneRepository) CreateScene(ctx context.Context, scene *scenes.Scene) error {
+	return m.Called(ctx, scene).Error(0)
+}
+
+func (m *mockSceneRepository) UpdateScene(ctx context.Context, scene *scenes.Scene) error {
+	return m.Called(ctx, scene).Error(0)
+}
+
+func (m *mockSceneRepository) DeleteScene(ctx context.Context, sceneID int) error {
+	return m.Called(ctx, sceneID).Error(0)
+}
+
+func (m *mockSceneRepository) RecordSceneActivation(ctx context.Context, sceneID int, activatedAt time.Time, status scenes.ActivationStatus) error {
+	return m.Called(ctx, sceneID, activatedAt, status).Error(0)
+}
+
+// fakeSceneCommandSender gives each command the ID 100 + its sensor ID. Commands to the
+// sensors in errs fail to send, and beforeReturn lets a test report a command's outcome
+// before its send returns.
+type fakeSceneCommandSender struct {
+	mu           sync.Mutex
+	sent         []sentScheduleCommand
+	ruleIDs      []int
+	errs         map[int]error
+	beforeReturn func(commandID int)
+}
+
+func (f *fakeSceneCommandSender) Send(_ context.Context, sensorID int, actor *gen.User, property string, value string) (SentCommandResult, error) {
+	id, err := f.send(sentScheduleCommand{sensorID: sensorID, actor: actor, property: property, value: value})
+	return SentCommandResult{ID: id, Status: actuation.CommandStatusSent, Property: property, Value: value}, err
+}
+
+func (f *fakeSceneCommandSender) SendForAutomation(_ context.Context, ruleID, sensorID int, property, value string) (int, error) {
+	f.mu.Lock()
+	f.ruleIDs = append(f.ruleIDs, ruleID)
+	f.mu.Unlock()
+	return f.send(sentScheduleCommand{sensorID: sensorID, property: property, value: value})
+}
+
+func (f *fakeSceneCommandSender) send(command sentScheduleCommand) (int, error) {
+	f.mu.Lock()
+	f.sent = append(f.sent, command)
+	err := f.errs[command.sensorID]
+	f.mu.Unlock()
+	if err != nil {
+		return 0, err
+	}
+	id := 100 + command.sensorID
+	if f.beforeReturn != nil {
+		f.beforeReturn(id)
+	}
+	return id, nil
+}
+
+type fakeSceneStatusBroadcaster struct {
+	mu          sync.Mutex
+	activations []scenes.Activation
+}
+
+func (f *fakeSceneStatusBroadcaster) BroadcastSceneStatus(activation scenes.Activation) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.activations = append(f.activations, activation)
+}
+
+func newSceneServiceForTest() (*SceneService, *mockSceneRepository, *mockCommandSensorRepository, *fakeSceneCommandSender, *fakeSceneStatusBroadcaster) {
+	repo := &mockSceneRepository{}
+	sensorRepo := &mockCommandSensorRepository{}
+	sender := &fakeSceneCommandSender{}
+	broadcaster := &fakeSceneStatusBroadcaster{}
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	return NewSceneService(repo, sensorRepo, sender, broadcaster, logger), repo, sensorRepo, sender, broadcaster
+}
+
+func movieNight() *scenes.Scene {
+	return &scenes.Scene{
+		ID: 4, Name: "Movie night",
+		Commands: []scenes.Command{
+			{SensorID: 2, Property: "state", Value: "OFF"},
+			{SensorID: 3, Property: "state", Value: "ON"},
+		},
+	}
+}
+
+func commandStatus(id int, status string) actuation.CommandStatusMessage {
+	return actuation.CommandStatusMessage{Type: "command_status", ID: id, Status: status}
+}
+
+func TestSceneService_Activate_ReportsPendingThenAggregatedOutcome(t *testing.T) {
+	svc, repo, _, sender, broadcaster := newSceneServiceForTest()
+	repo.On("GetSceneByID", mock.Anything, 4).Return(movieNight(), nil)
+	repo.On("RecordSceneActivation", mock.Anything, 4, mock.AnythingOfType("time.Time"), scenes.ActivationPartial).Return(nil)
+	actor := &gen.User{Id: 7, Permissions: []string{"control_sensors"}}
+
+	activation, err := svc.ServiceActivateScene(context.Background(), 4, actor, false)
+
+	require.NoError(t, err)
+	assert.Equal(t, scenes.ActivationPending, activation.Status)
+	require.Len(t, activation.Commands, 2)
+	assert.Equal(t, 102, *activation.Commands[0].CommandID)
+	assert.Equal(t, actuation.CommandStatusSent, activation.Commands[1].Status)
+	assert.Len(t, sender.sent, 2)
+	assert.Same(t, actor, sender.sent[0].actor)
+
+	svc.CommandStatusChanged(commandStatus(102, actuation.CommandStatusAcknowledged))
+	assert.Empty(t, broadcaster.activations, "the activation finishes with its last command")
+	svc.CommandStatusChanged(commandStatus(103, actuation.CommandStatusTimedOut))
+
+	require.Len(t, broadcaster.activations, 1)
+	finished := broadcaster.activations[0]
+	assert.Equal(t, scenes.ActivationPartial, finished.Status)
+	assert.Equal(t, actuation.CommandStatusAcknowledged, finished.Commands[0].Status)
+	assert.Equal(t, actuation.CommandStatusTimedOut, finished.Commands[1].Status)
+	repo.AssertExpectations(t)
+}
+
+func TestSceneService_Activate_WaitsForOutcomesReportedDuringSend(t *testing.T) {
+	svc, repo, _, sender, broadcaster := newSceneServiceForTest()
+	repo.On("GetSceneByID", mock.Anything, 4).Return(movieNight(), nil)
+	repo.On("RecordSceneActivation", mock.Anything, 4, mock.AnythingOfType("time.Time"), scenes.ActivationSucceeded).Return(nil)
+	sender.beforeReturn = func(commandID int) {
+		svc.CommandStatusChanged(commandStatus(commandID, actuation.CommandStatusAcknowledged))
+	}
+
+	activation, err := svc.ServiceActivateScene(context.Background(), 4, NewSystemActor("test"), true)
+
+	require.NoError(t, err)
+	assert.Equal(t, scenes.ActivationSucceeded, activation.Status)
+	assert.Len(t, broadcaster.activations, 1)
+	assert.Empty(t, svc.early, "outcomes held during sending are dropped afterwards")
+	assert.Empty(t, svc.waiting)
+}
+
+func TestSceneService_Activate_SendErrorsFailTheirCommand(t *testing.T) {
+	svc, repo, _, sender, broadcaster := newSceneServiceForTest()
+	repo.On("GetSceneByID", mock.Anything, 4).Return(movieNight(), nil)
+	repo.On("RecordSceneActivation", mock.Anything, 4, mock.AnythingOfType("time.Time"), scenes.ActivationFailed).Return(nil)
+	sender.errs = map[int]error{
+		2: errors.New("sensor 2 is not in a controllable state"),
+		3: errors.New("sensor 3 already has a pending command"),
+	}
+
+	activation, err := svc.ServiceActivateScene(context.Background(), 4, NewSystemActor("test"), true)
+
+	require.NoError(t, err)
+	assert.Equal(t, scenes.ActivationFailed, activation.Status)
+	assert.Nil(t, activation.Commands[0].CommandID)
+	assert.Equal(t, "sensor 2 is not in a controllable state", activation.Commands[0].Error)
+	assert.Len(t, broadcaster.activations, 1)
+}
+
+func TestSceneService_ActivateForAutomation(t *testing.T) {
+	svc, repo, _, sender, _ := newSceneServiceForTest()
+	repo.On("GetSceneByID", mock.Anything, 4).Return(movieNight(), nil)
+	sender.errs = map[int]error{3: errors.New("no enabled MQTT subscription")}
+
+	require.NoError(t, svc.ActivateSceneForAutomation(context.Background(), 9, 4))
+	assert.Equal(t, []int{9, 9}, sender.ruleIDs)
+
+	sender.errs[2] = errors.New("sensor 2 is not in a controllable state")
+	repo.On("RecordSceneActivation", mock.Anything, 4, mock.AnythingOfType("time.Time"), scenes.ActivationFailed).Return(nil)
+	assert.ErrorContains(t, svc.ActivateSceneForAutomation(context.Background(), 9, 4), "no command could be sent")
+}
+
+func TestSceneService_Activate_NotFound(t *testing.T) {
+	svc, repo, _, _, _ := newSceneServiceForTest()
+	repo.On("GetSceneByID", mock.Anything, 4).Return(nil, nil)
+
+	_, err := svc.ServiceActivateScene(context.Background(), 4, NewSystemActor("test"), false)
+
+	assert.ErrorIs(t, err, ErrSceneNotFound)
+}
+
+func TestSceneService_Create_RejectsUncontrollableSensor(t *testing.T) {
+	svc, repo, sensorRepo, _, _ := newSceneServiceForTest()
+	sensorRepo.On("GetSensorById", mock.Anything, 2).Return(dehumidifierPlug(), nil)
+	sensorRepo.On("GetSensorById", mock.Anything, 3).Return(&gen.Sensor{Id: 3, SensorDriver: "sensor-hub-http-temperature"}, nil)
+
+	err := svc.ServiceCreateScene(context.Background(), movieNight())
+
+	assert.ErrorIs(t, err, ErrInvalidScene)
+	assert.Contains(t, err.Error(), "not controllable")
+	repo.AssertNotCalled(t, "CreateScene", mock.Anything, mock.Anything)
+}
+
+func TestAggregateSceneStatus(t *testing.T) {
+	outcomes := func(statuses ...string) []scenes.CommandOutcome {
+		result := make([]scenes.CommandOutcome, len(statuses))
+		for i, status := range statuses {
+			result[i].Status = status
+		}
+		return result
+	}
+	tests := []struct {
+		name     string
+		outcomes []scenes.CommandOutcome
+		want     scenes.ActivationStatus
+	}{
+		{name: "awaiting outcome", outcomes: outcomes("acknowledged", "sent"), want: scenes.ActivationPending},
+		{name: "all acknowledged", outcomes: outcomes("acknowledged", "acknowledged"), want: scenes.ActivationSucceeded},
+		{name: "some acknowledged", outcomes: outcomes("acknowledged", "timed_out"), want: scenes.ActivationPartial},
+		{name: "none acknowledged", outcomes: outcomes("failed", "timed_out"), want: scenes.ActivationFailed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, aggregateSceneStatus(tt.outcomes))
+		})
+	}
+}
@@ -0,0 +1,241 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	database "example/sensorHub/db"
+	"example/sensorHub/drivers"
+	gen "example/sensorHub/gen"
+	"example/sensorHub/periodic"
+	"example/sensorHub/scheduling"
+)
+
+const (
+	// scheduleCheckInterval is how often due schedules are looked for. It is shorter than
+	// a minute so that scheduled commands go out close to their minute.
+	scheduleCheckInterval = 20 * time.Second
+	// scheduleCatchUpWindow is how late a run may be and still be sent, for runs that fell
+	// due while the hub was down. Later runs are skipped rather than, say, switching the
+	// heating on in the middle of the afternoon.
+	scheduleCatchUpWindow = 15 * time.Minute
+)
+
+var (
+	ErrScheduleNotFound = errors.New("command schedule not found")
+	// ErrInvalidSchedule is returned for schedules whose sensor does not exist or cannot
+	// accept the schedule's command.
+	ErrInvalidSchedule = errors.New("invalid command schedule")
+)
+
+// ScheduleCommandSender sends commands on behalf of an actor. CommandService implements it.
+type ScheduleCommandSender interface {
+	Send(ctx context.Context, sensorID int, actor *gen.User, property string, value string) (SentCommandResult, error)
+}
+
+// ScheduleService manages command schedules and runs them. Scheduled commands are sent
+// through CommandService.Send as the system actor, so they are validated, tracked and
+// recorded in Command History like any other command.
+type ScheduleService struct {
+	repo       database.ScheduleRepository
+	sensorRepo CommandSensorRepository
+	sender     ScheduleCommandSender
+	actor      *gen.User
+	logger     *slog.Logger
+	now        func() time.Time
+}
+
+func NewScheduleService(repo database.ScheduleRepository, sensorRepo CommandSensorRepository, sender ScheduleCommandSender, logger *slog.Logger) *ScheduleService {
+	return &ScheduleService{
+		repo:       repo,
+		sensorRepo: sensorRepo,
+		sender:     sender,
+		actor:      NewSystemActor("scheduler"),
+		logger:     logger.With("component", "schedule_service"),
+		now:        time.Now,
+	}
+}
+
+func (s *ScheduleService) ServiceGetAllSchedules(ctx context.Context) ([]scheduling.Schedule, error) {
+	schedules, err := s.repo.GetAllSchedules(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error listing command schedules: %w", err)
+	}
+	return schedules, nil
+}
+
+func (s *ScheduleService) ServiceGetScheduleByID(ctx context.Context, scheduleID int) (*scheduling.Schedule, error) {
+	schedule, err := s.repo.GetScheduleByID(ctx, scheduleID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting command schedule: %w", err)
+	}
+	if schedule == nil {
+		return nil, ErrScheduleNotFound
+	}
+	return schedule, nil
+}
+
+// ServiceCreateSchedule creates a schedule after checking that its sensor accepts the
+// command, and works out its first run.
+func (s *ScheduleService) ServiceCreateSchedule(ctx context.Context, schedule *scheduling.Schedule) error {
+	if err := s.prepare(ctx, schedule); err != nil {
+		return err
+	}
+	if err := s.repo.CreateSchedule(ctx, schedule); err != nil {
+		return fmt.Errorf("error creating command schedule: %w", err)
+	}
+	s.logger.Info("command schedule created", "id", schedule.ID, "name", schedule.Name, "cron", schedule.Cron)
+	return nil
+}
+
+// ServiceUpdateSchedule replaces a schedule's settings. Its next run is worked out again
+// from the current time.
+func (s *ScheduleService) ServiceUpdateSchedule(ctx context.Context, schedule *scheduling.Schedule) error {
+	if _, err := s.ServiceGetScheduleByID(ctx, schedule.ID); err != nil {
+		return err
+	}
+	if err := s.prepare(ctx, schedule); err != nil {
+		return err
+	}
+	if err := s.repo.UpdateSchedule(ctx, schedule); err != nil {
+		return fmt.Errorf("error updating command schedule: %w", err)
+	}
+	s.logger.Info("command schedule updated", "id", schedule.ID, "name", schedule.Name, "cron", schedule.Cron)
+	return nil
+}
+
+func (s *ScheduleService) ServiceDeleteSchedule(ctx context.Context, scheduleID int) error {
+	if _, err := s.ServiceGetScheduleByID(ctx, scheduleID); err != nil {
+		return err
+	}
+	if err := s.repo.DeleteSchedule(ctx, scheduleID); err != nil {
+		return fmt.Errorf("error deleting command schedule: %w", err)
+	}
+	s.logger.Info("command schedule deleted", "id", scheduleID)
+	return nil
+}
+
+func (s *ScheduleService) ServiceGetHolidayMode(ctx context.Context) (scheduling.HolidayMode, error) {
+	mode, err := s.repo.GetHolidayMode(ctx)
+	if err != nil {
+		return mode, fmt.Errorf("error getting holiday mode: %w", err)
+	}
+	return mode, nil
+}
+
+func (s *ScheduleService) ServiceSetHolidayMode(ctx context.Context, mode scheduling.HolidayMode) error {
+	if !mode.Enabled {
+		mode.Until = nil
+	}
+	if mode.Until != nil && !mode.Until.After(s.now()) {
+		return fmt.Errorf("%w: holiday mode end must be in the future", ErrInvalidSchedule)
+	}
+	if err := s.repo.SetHolidayMode(ctx, mode); err != nil {
+		return fmt.Errorf("error setting holiday mode: %w", err)
+	}
+	s.logger.Info("holiday mode set", "enabled", mode.Enabled, "until", mode.Until)
+	return nil
+}
+
+// prepare checks that the schedule's sensor exists and can build its command, and sets
+// its next run. A disabled schedule has none.
+func (s *ScheduleService) prepare(ctx context.Context, schedule *scheduling.Schedule) error {
+	sensor, err := s.sensorRepo.GetSensorById(ctx, schedule.SensorID)
+	if err != nil || sensor == nil {
+		return fmt.Errorf("%w: sensor %d not found", ErrInvalidSchedule, schedule.SensorID)
+	}
+	commandDriver, ok := drivers.GetCommandDriver(sensor.SensorDriver)
+	if !ok {
+		return fmt.Errorf("%w: sensor %d is not controllable", ErrInvalidSchedule, schedule.SensorID)
+	}
+	if _, _, err := commandDriver.BuildCommand(*sensor, schedule.Property, schedule.Value); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidSchedule, err)
+	}
+
+	schedule.NextRunAt = nil
+	if schedule.Enabled {
+		next := schedule.NextRun(s.now().Local())
+		schedule.NextRunAt = &next
+	}
+	return nil
+}
+
+// RecoverMissedRuns handles the runs that fell due while the hub was down. Runs late by
+// no more than scheduleCatchUpWindow are sent; older ones are recorded as missed. Either
+// way each schedule moves on to its next run. Call once at startup, before
+// StartScheduler.
+func (s *ScheduleService) RecoverMissedRuns(ctx context.Context) error {
+	if err := s.ProcessDueSchedules(ctx, s.now()); err != nil {
+		return fmt.Errorf("failed to recover missed schedule runs: %w", err)
+	}
+	return nil
+}
+
+// StartScheduler periodically runs the schedules that have fallen due.
+func (s *ScheduleService) StartScheduler(ctx context.Context) {
+	periodic.RunTask(ctx, periodic.TaskConfig{
+		Name:     "command_schedules",
+		Interval: scheduleCheckInterval,
+		Logger:   s.logger,
+	}, func(ctx context.Context) error {
+		return s.ProcessDueSchedules(ctx, s.now())
+	})
+}
+
+// ProcessDueSchedules runs every enabled schedule whose next run is at or before now, once
+// each, and moves it on to its next run after now.
+func (s *ScheduleService) ProcessDueSchedules(ctx context.Context, now time.Time) error {
+	due, err := s.repo.GetDueSchedules(ctx, now)
+	if err != nil {
+		return err
+	}
+	if len(due) == 0 {
+		return nil
+	}
+	holidayMode, err := s.repo.GetHolidayMode(ctx)
+	if err != nil {
+		return err
+	}
+	holiday := holidayMode.ActiveAt(now)
+
+	var errs []error
+	for i := range due {
+		if err := s.runSchedule(ctx, &due[i], now.Local(), holiday); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (s *ScheduleService) runSchedule(ctx context.Context, schedule *scheduling.Schedule, now time.Time, holiday bool) error {
+	dueAt := *schedule.NextRunAt
+	claimed, err := s.repo.ClaimScheduleRun(ctx, schedule.ID, dueAt, schedule.NextRun(now))
+	if err != nil {
+		return err
+	}
+	if !claimed {
+		return nil
+	}
+
+	if late := now.Sub(dueAt); late > scheduleCatchUpWindow {
+		s.logger.Warn("skipping missed schedule run", "schedule_id", schedule.ID, "name", schedule.Name, "due_at", dueAt, "late", late)
+		runErr := fmt.Sprintf("missed run due at %s", dueAt.Local().Format("2006-01-02 15:04"))
+		return s.repo.RecordScheduleRun(ctx, schedule.ID, now, nil, runErr)
+	}
+	if !schedule.RunsDuring(holiday) {
+		s.logger.Debug("schedule not run in current holiday mode", "schedule_id", schedule.ID, "name", schedule.Name, "holiday", holiday)
+		return nil
+	}
+
+	s.logger.Info("running command schedule", "schedule_id", schedule.ID, "name", schedule.Name,
+		"sensor_id", schedule.SensorID, "property", schedule.Property, "value", schedule.Value)
+	result, err := s.sender.Send(ctx, schedule.SensorID, s.actor, schedule.Property, schedule.Value)
+	if err != nil {
+		s.logger.Error("scheduled command failed", "schedule_id", schedule.ID, "name", schedule.Name, "error", err)
+		return s.repo.RecordScheduleRun(ctx, schedule.ID, now, nil, err.Error())
+	}
+	return s.repo.RecordScheduleRun(ctx, schedule.ID, now, &result.ID, "")
+}
@@ -0,0 +1,17 @@
+package service
+
+import (
+	"context"
+
+	"example/sensorHub/scheduling"
+)
+
+type ScheduleServiceInterface interface {
+	ServiceGetAllSchedules(ctx context.Context) ([]scheduling.Schedule, error)
+	ServiceGetScheduleByID(ctx context.Context, scheduleID int) (*scheduling.Schedule, error)
+	ServiceCreateSchedule(ctx context.Context, schedule *scheduling.Schedule) error
+	ServiceUpdateSchedule(ctx context.Context, schedule *scheduling.Schedule) error
+	ServiceDeleteSchedule(ctx context.Context, scheduleID int) error
+	ServiceGetHolidayMode(ctx context.Context) (scheduling.HolidayMode, error)
+	ServiceSetHolidayMode(ctx context.Context, mode scheduling.HolidayMode) error
+}
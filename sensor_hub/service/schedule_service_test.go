@@ -0,0 +1,334 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"testing"
+	"time"
+
+	gen "example/sensorHub/gen"
+	"example/sensorHub/scheduling"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type mockScheduleRepository struct {
+	mock.Mock
+}
+
+func (m *mockScheduleRepository) GetAllSchedules(ctx context.Context) ([]scheduling.Schedule, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]scheduling.Schedule), args.Error(1)
+}
+
+func (m *mockScheduleRepository) GetScheduleByID(ctx context.Context, scheduleID int) (*scheduling.Schedule, error) {
+	args := m.Called(ctx, scheduleID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*scheduling.Schedule), args.Error(1)
+}
+
+func (m *mockScheduleRepository) CreateSchedule(ctx context.Context, schedule *scheduling.Schedule) error {
+	args := m.Called(ctx, schedule)
+	return args.Error(0)
+}
+
+func (m *mockScheduleRepository) UpdateSchedule(ctx context.Context, schedule *scheduling.Schedule) error {
+	args := m.Called(ctx, schedule)
+	return args.Error(0)
+}
+
+func (m *mockScheduleRepository) DeleteSchedule(ctx context.Context, scheduleID int) error {
+	args := m.Called(ctx, scheduleID)
+	return args.Error(0)
+}
+
+func (m *mockScheduleRepository) GetDueSchedules(ctx context.Context, now time.Time) ([]scheduling.Schedule, error) {
+	args := m.Called(ctx, now)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]scheduling.Schedule), args.Error(1)
+}
+
+func (m *mockScheduleRepository) ClaimScheduleRun(ctx context.Context, scheduleID int, dueAt, nextRunAt time.Time) (bool, error) {
+	args := m.Called(ctx, scheduleID, dueAt, nextRunAt)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *mockScheduleRepository) RecordScheduleRun(ctx context.Context, scheduleID int, ranAt time.Time, commandID *int, runErr string) error {
+	args := m.Called(ctx, scheduleID, ranAt, commandID, runErr)
+	return args.Error(0)
+}
+
+func (m *mockScheduleRepository) GetHolidayMode(ctx context.Context) (scheduling.HolidayMode, error) {
+	args := m.Called(ctx)
+	return args.Get(0).(scheduling.HolidayMode), args.Error(1)
+}
+
+func (m *mockScheduleRepository) SetHolidayMode(ctx context.Context, mode scheduling.HolidayMode) error {
+	args := m.Called(ctx, mode)
+	return args.Error(0)
+}
+
+type sentScheduleCommand struct {
+	sensorID        int
+	actor           *gen.User
+	property, value string
+}
+
+// fakeScheduleCommandSender records the commands it is asked to send. Like CommandService
+// it tells observer about each command before publishing it. beforeReturn lets a test
+// report a command's outcome before its send returns.
+type fakeScheduleCommandSender struct {
+	sent         []sentScheduleCommand
+	err          error
+	beforeReturn func()
+	observer     CommandSentObserver
+}
+
+func (f *fakeScheduleCommandSender) Send(ctx context.Context, sensorID int, actor *gen.User, property string, value string) (SentCommandResult, error) {
+	f.sent = append(f.sent, sentScheduleCommand{sensorID: sensorID, actor: actor, property: property, value: value})
+	result := SentCommandResult{ID: 90 + len(f.sent), Status: "sent", Property: property, Value: value}
+	if f.observer != nil {
+		f.observer.CommandSent(ctx, sensorID, result)
+	}
+	if f.beforeReturn != nil {
+		f.beforeReturn()
+	}
+	if f.err != nil {
+		return SentCommandResult{}, f.err
+	}
+	return result, nil
+}
+
+// scheduleTestNow is Monday 2 March 2026, 06:31 UTC, one minute after the heater schedule
+// below fell due.
+var scheduleTestNow = time.Date(2026, 3, 2, 6, 31, 0, 0, time.UTC)
+
+func newScheduleServiceForTest() (*ScheduleService, *mockScheduleRepository, *mockCommandSensorRepository, *fakeScheduleCommandSender) {
+	repo := &mockScheduleRepository{}
+	sensorRepo := &mockCommandSensorRepository{}
+	sender := &fakeScheduleCommandSender{}
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	svc := NewScheduleService(repo, sensorRepo, sender, logger)
+	svc.now = func() time.Time { return scheduleTestNow }
+	return svc, repo, sensorRepo, sender
+}
+
+func heaterSchedule(dueAt time.Time, holiday scheduling.HolidayBehaviour) scheduling.Schedule {
+	return scheduling.Schedule{
+		ID: 3, Name: "Heater weekday mornings", Enabled: true, SensorID: 2,
+		Property: "state", Value: "ON", Cron: "30 6 * * mon-fri", Holiday: holiday, NextRunAt: &dueAt,
+	}
+}
+
+// scheduleTestNext is the run after scheduleTestNow: Tuesday 06:30 in the local time zone.
+func scheduleTestNext() time.Time {
+	s := heaterSchedule(time.Time{}, scheduling.HolidaySkip)
+	return s.NextRun(scheduleTestNow.Local())
+}
+
+func TestScheduleService_ProcessDue_SendsAsSystemActor(t *testing.T) {
+	svc, repo, _, sender := newScheduleServiceForTest()
+	dueAt := scheduleTestNow.Add(-time.Minute)
+	repo.On("GetDueSchedules", mock.Anything, scheduleTestNow).Return([]scheduling.Schedule{heaterSchedule(dueAt, scheduling.HolidaySkip)}, nil)
+	repo.On("GetHolidayMode", mock.Anything).Return(scheduling.HolidayMode{}, nil)
+	repo.On("ClaimScheduleRun", mock.Anything, 3, dueAt, scheduleTestNext()).Return(true, nil)
+	commandID := 91
+	repo.On("RecordScheduleRun", mock.Anything, 3, mock.AnythingOfType("time.Time"), &commandID, "").Return(nil)
+
+	require.NoError(t, svc.ProcessDueSchedules(context.Background(), scheduleTestNow))
+
+	require.Len(t, sender.sent, 1)
+	assert.Equal(t, 2, sender.sent[0].sensorID)
+	assert.Equal(t, "ON", sender.sent[0].value)
+	assert.Equal(t, systemActorID, sender.sent[0].actor.Id)
+	assert.Equal(t, "scheduler", sender.sent[0].actor.Username)
+	repo.AssertExpectations(t)
+}
+
+func TestScheduleService_ProcessDue_ClaimLostSendsNothing(t *testing.T) {
+	svc, repo, _, sender := newScheduleServiceForTest()
+	dueAt := scheduleTestNow.Add(-time.Minute)
+	repo.On("GetDueSchedules", mock.Anything, scheduleTestNow).Return([]scheduling.Schedule{heaterSchedule(dueAt, scheduling.HolidaySkip)}, nil)
+	repo.On("GetHolidayMode", mock.Anything).Return(scheduling.HolidayMode{}, nil)
+	repo.On("ClaimScheduleRun", mock.Anything, 3, dueAt, mock.Anything).Return(false, nil)
+
+	require.NoError(t, svc.ProcessDueSchedules(context.Background(), scheduleTestNow))
+
+	assert.Empty(t, sender.sent)
+	repo.AssertNotCalled(t, "RecordScheduleRun", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestScheduleService_ProcessDue_RecordsSendFailure(t *testing.T) {
+	svc, repo, _, sender := newScheduleServiceForTest()
+	sender.err = newCommandError(http.StatusConflict, "sensor 2 is not in a controllable state")
+	dueAt := scheduleTestNow.Add(-time.Minute)
+	repo.On("GetDueSchedules", mock.Anything, scheduleTestNow).Return([]scheduling.Schedule{heaterSchedule(dueAt, scheduling.HolidaySkip)}, nil)
+	repo.On("GetHolidayMode", mock.Anything).Return(scheduling.HolidayMode{}, nil)
+	repo.On("ClaimScheduleRun", mock.Anything, 3, dueAt, mock.Anything).Return(true, nil)
+	repo.On("RecordScheduleRun", mock.Anything, 3, mock.Anything, (*int)(nil), "sensor 2 is not in a controllable state").Return(nil)
+
+	require.NoError(t, svc.ProcessDueSchedules(context.Background(), scheduleTestNow))
+
+	repo.AssertExpectations(t)
+}
+
+func TestScheduleService_RecoverMissedRuns(t *testing.T) {
+	tests := []struct {
+		name     string
+		late     time.Duration
+		wantSent bool
+	}{
+		{name: "within catch-up window is sent", late: 10 * time.Minute, wantSent: true},
+		{name: "beyond catch-up window is skipped", late: 3 * time.Hour, wantSent: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, repo, _, sender := newScheduleServiceForTest()
+			dueAt := scheduleTestNow.Add(-tt.late)
+			repo.On("GetDueSchedules", mock.Anything, scheduleTestNow).Return([]scheduling.Schedule{heaterSchedule(dueAt, scheduling.HolidaySkip)}, nil)
+			repo.On("GetHolidayMode", mock.Anything).Return(scheduling.HolidayMode{}, nil)
+			repo.On("ClaimScheduleRun", mock.Anything, 3, dueAt, scheduleTestNext()).Return(true, nil)
+			if tt.wantSent {
+				repo.On("RecordScheduleRun", mock.Anything, 3, mock.Anything, mock.AnythingOfType("*int"), "").Return(nil)
+			} else {
+				repo.On("RecordScheduleRun", mock.Anything, 3, mock.Anything, (*int)(nil),
+					mock.MatchedBy(func(runErr string) bool { return len(runErr) > 0 })).Return(nil)
+			}
+
+			require.NoError(t, svc.RecoverMissedRuns(context.Background()))
+
+			assert.Equal(t, tt.wantSent, len(sender.sent) == 1)
+			repo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestScheduleService_ProcessDue_HolidayMode(t *testing.T) {
+	until := scheduleTestNow.Add(48 * time.Hour)
+	tests := []struct {
+		name     string
+		holiday  scheduling.HolidayBehaviour
+		mode     scheduling.HolidayMode
+		wantSent bool
+	}{
+		{name: "skip schedule paused during holiday", holiday: scheduling.HolidaySkip, mode: scheduling.HolidayMode{Enabled: true, Until: &until}, wantSent: false},
+		{name: "skip schedule runs after holiday ends", holiday: scheduling.HolidaySkip, mode: scheduling.HolidayMode{Enabled: true, Until: &scheduleTestNow}, wantSent: true},
+		{name: "only schedule runs during holiday", holiday: scheduling.HolidayOnly, mode: scheduling.HolidayMode{Enabled: true}, wantSent: true},
+		{name: "only schedule idle outside holiday", holiday: scheduling.HolidayOnly, mode: scheduling.HolidayMode{}, wantSent: false},
+		{name: "run schedule ignores holiday", holiday: scheduling.HolidayRun, mode: scheduling.HolidayMode{Enabled: true}, wantSent: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, repo, _, sender := newScheduleServiceForTest()
+			dueAt := scheduleTestNow.Add(-time.Minute)
+			repo.On("GetDueSchedules", mock.Anything, scheduleTestNow).Return([]scheduling.Schedule{heaterSchedule(dueAt, tt.holiday)}, nil)
+			repo.On("GetHolidayMode", mock.Anything).Return(tt.mode, nil)
+			repo.On("ClaimScheduleRun", mock.Anything, 3, dueAt, mock.Anything).Return(true, nil)
+			repo.On("RecordScheduleRun", mock.Anything, 3, mock.Anything, mock.Anything, "").Return(nil).Maybe()
+
+			require.NoError(t, svc.ProcessDueSchedules(context.Background(), scheduleTestNow))
+
+			assert.Equal(t, tt.wantSent, len(sender.sent) == 1)
+			if !tt.wantSent {
+				repo.AssertNotCalled(t, "RecordScheduleRun", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+			}
+		})
+	}
+}
+
+func TestScheduleService_Create_SetsNextRun(t *testing.T) {
+	svc, repo, sensorRepo, _ := newScheduleServiceForTest()
+	schedule := heaterSchedule(time.Time{}, scheduling.HolidaySkip)
+	schedule.NextRunAt = nil
+	sensorRepo.On("GetSensorById", mock.Anything, 2).Return(dehumidifierPlug(), nil)
+	repo.On("CreateSchedule", mock.Anything, &schedule).Return(nil)
+
+	require.NoError(t, svc.ServiceCreateSchedule(context.Background(), &schedule))
+
+	require.NotNil(t, schedule.NextRunAt)
+	assert.True(t, schedule.NextRunAt.Equal(scheduleTestNext()))
+	repo.AssertExpectations(t)
+}
+
+func TestScheduleService_Create_DisabledHasNoNextRun(t *testing.T) {
+	svc, repo, sensorRepo, _ := newScheduleServiceForTest()
+	schedule := heaterSchedule(scheduleTestNow, scheduling.HolidaySkip)
+	schedule.Enabled = false
+	sensorRepo.On("GetSensorById", mock.Anything, 2).Return(dehumidifierPlug(), nil)
+	repo.On("CreateSchedule", mock.Anything, &schedule).Return(nil)
+
+	require.NoError(t, svc.ServiceCreateSchedule(context.Background(), &schedule))
+
+	assert.Nil(t, schedule.NextRunAt)
+}
+
+func TestScheduleService_Create_RejectsUncontrollableSensor(t *testing.T) {
+	svc, repo, sensorRepo, _ := newScheduleServiceForTest()
+	schedule := heaterSchedule(time.Time{}, scheduling.HolidaySkip)
+	sensorRepo.On("GetSensorById", mock.Anything, 2).Return(&gen.Sensor{Id: 2, SensorDriver: "sensor-hub-http-temperature"}, nil)
+
+	err := svc.ServiceCreateSchedule(context.Background(), &schedule)
+
+	assert.ErrorIs(t, err, ErrInvalidSchedule)
+	assert.Contains(t, err.Error(), "not controllable")
+	repo.AssertNotCalled(t, "CreateSchedule", mock.Anything, mock.Anything)
+}
+
+func TestScheduleService_Create_RejectsValueSensorCannotAccept(t *testing.T) {
+	svc, repo, sensorRepo, _ := newScheduleServiceForTest()
+	schedule := heaterSchedule(time.Time{}, scheduling.HolidaySkip)
+	schedule.Value = "MAYBE"
+	sensorRepo.On("GetSensorById", mock.Anything, 2).Return(dehumidifierPlug(), nil)
+
+	err := svc.ServiceCreateSchedule(context.Background(), &schedule)
+
+	assert.ErrorIs(t, err, ErrInvalidSchedule)
+	repo.AssertNotCalled(t, "CreateSchedule", mock.Anything, mock.Anything)
+}
+
+func TestScheduleService_Delete_NotFound(t *testing.T) {
+	svc, repo, _, _ := newScheduleServiceForTest()
+	repo.On("GetScheduleByID", mock.Anything, 5).Return(nil, nil)
+
+	err := svc.ServiceDeleteSchedule(context.Background(), 5)
+
+	assert.ErrorIs(t, err, ErrScheduleNotFound)
+}
+
+func TestScheduleService_SetHolidayMode_RejectsPastEnd(t *testing.T) {
+	svc, repo, _, _ := newScheduleServiceForTest()
+	past := scheduleTestNow.Add(-time.Hour)
+
+	err := svc.ServiceSetHolidayMode(context.Background(), scheduling.HolidayMode{Enabled: true, Until: &past})
+
+	assert.ErrorIs(t, err, ErrInvalidSchedule)
+	repo.AssertNotCalled(t, "SetHolidayMode", mock.Anything, mock.Anything)
+}
+
+func TestScheduleService_SetHolidayMode_OffClearsEnd(t *testing.T) {
+	svc, repo, _, _ := newScheduleServiceForTest()
+	until := scheduleTestNow.Add(time.Hour)
+	repo.On("SetHolidayMode", mock.Anything, scheduling.HolidayMode{Enabled: false}).Return(nil)
+
+	require.NoError(t, svc.ServiceSetHolidayMode(context.Background(), scheduling.HolidayMode{Enabled: false, Until: &until}))
+	repo.AssertExpectations(t)
+}
+
+func TestScheduleService_ProcessDue_PropagatesRepositoryError(t *testing.T) {
+	svc, repo, _, _ := newScheduleServiceForTest()
+	repo.On("GetDueSchedules", mock.Anything, scheduleTestNow).Return(nil, errors.New("db down"))
+
+	assert.ErrorContains(t, svc.ProcessDueSchedules(context.Background(), scheduleTestNow), "db down")
+}
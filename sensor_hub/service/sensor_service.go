@@ -43,10 +43,17 @@ type SensorService struct {
 	mtRepo             database.MeasurementTypeRepository
 	thresholdProcessor *alerting.ThresholdAlertProcessor
 	notifSvc           NotificationServiceInterface
-	readingsObserver   actuation.ReadingsObserver
+	readingsObservers  []actuation.ReadingsObserver
+	sensorsObservers   []SensorsObserver
 	logger             *slog.Logger
 }
 
+// SensorsObserver is told when a sensor is added, updated, deleted, enabled or
+// disabled, approved or dismissed. It is called on the request path and must not block.
+type SensorsObserver interface {
+	SensorsChanged(ctx context.Context)
+}
+
 func NewSensorService(sensorRepo database.SensorRepositoryInterface[gen.Sensor], readingsRepo database.ReadingsRepository, mtRepo database.MeasurementTypeRepository, processor *alerting.ThresholdAlertProcessor, notifSvc NotificationServiceInterface, logger *slog.Logger) *SensorService {
 	return &SensorService{
 		sensorRepo:         sensorRepo,
@@ -72,8 +79,28 @@ func (s *SensorService) notifyConfigEvent(action, sensorName string, metadata ma
 	go s.notifSvc.CreateNotification(context.Background(), notif, "view_notifications_config")
 }
 
-func (s *SensorService) SetReadingsObserver(observer actuation.ReadingsObserver) {
-	s.readingsObserver = observer
+// AddReadingsObserver registers an observer that is told about every batch of
+// stored readings, pull and push alike.
+func (s *SensorService) AddReadingsObserver(observer actuation.ReadingsObserver) {
+	s.readingsObservers = append(s.readingsObservers, observer)
+}
+
+// AddSensorsObserver registers an observer that is told about every change to the
+// set of sensors or their configuration.
+func (s *SensorService) AddSensorsObserver(observer SensorsObserver) {
+	s.sensorsObservers = append(s.sensorsObservers, observer)
+}
+
+func (s *SensorService) sensorsChanged(ctx context.Context) {
+	for _, observer := range s.sensorsObservers {
+		observer.SensorsChanged(ctx)
+	}
+}
+
+func (s *SensorService) observeReadings(ctx context.Context, sensorID int, readings []gen.Reading) {
+	for _, observer := range s.readingsObservers {
+		observer.ObserveReadings(ctx, sensorID, readings)
+	}
 }
 
 func (s *SensorService) ServiceAddSensor(ctx context.Context, sensor gen.Sensor) error {
@@ -103,6 +130,7 @@ func (s *SensorService) ServiceAddSensor(ctx context.Context, sensor gen.Sensor)
 		return fmt.Errorf("error adding sensor: %w", err)
 	}
 	s.logger.Info("sensor added", "name", sensor.Name)
+	s.sensorsChanged(ctx)
 	go s.broadcastSensors(context.Background())
 	s.notifyConfigEvent("added", sensor.Name, map[string]interface{}{"sensor_name": sensor.Name})
 	return nil
@@ -118,6 +146,7 @@ func (s *SensorService) ServiceUpdateSensorById(ctx context.Context, sensor gen.
 		return fmt.Errorf("error updating sensor: %w", err)
 	}
 	s.logger.Info("sensor updated", "id", sensor.Id, "name", sensor.Name)
+	s.sensorsChanged(ctx)
 	go s.broadcastSensors(context.Background())
 	s.notifyConfigEvent("updated", sensor.Name, map[string]interface{}{"sensor_name": sensor.Name})
 	return nil
@@ -136,6 +165,7 @@ func (s *SensorService) ServiceDeleteSensorByName(ctx context.Context, name stri
 		return fmt.Errorf("error deleting sensor: %w", err)
 	}
 	s.logger.Info("sensor deleted", "name", name)
+	s.sensorsChanged(ctx)
 	go s.broadcastSensors(context.Background())
 	s.notifyConfigEvent("removed", name, map[string]interface{}{"sensor_name": name})
 	return nil
@@ -277,6 +307,7 @@ func (s *SensorService) ServiceCollectAndStoreAllSensorReadings(ctx context.Cont
 		sensorSpan.End()
 
 		s.ServiceUpdateSensorHealthById(ctx, sensor.Id, gen.Good, "successful reading")
+		s.observeReadings(ctx, sensor.Id, readings)
 		allReadings = append(allReadings, readings...)
 		s.logger.Debug("collected readings", "sensor", sensor.Name, "count", len(readings))
 
@@ -354,6 +385,7 @@ func (s *SensorService) ServiceCollectFromSensorByName(ctx context.Context, sens
 		}
 		span.SetAttributes(attribute.Int("readings.count", len(readings)))
 		s.ServiceUpdateSensorHealthById(ctx, sensor.Id, gen.Good, "successful reading")
+		s.observeReadings(ctx, sensor.Id, readings)
 		s.logger.Debug("collected readings", "sensor", sensorName, "count", len(readings))
 		ws.BroadcastToTopic("current-readings", readings)
 
@@ -467,6 +499,7 @@ func (s *SensorService) ServiceSetEnabledSensorByName(ctx context.Context, name
 		return fmt.Errorf("error setting enabled status for sensor: %w", err)
 	}
 	s.logger.Info("sensor enabled status changed", "name", name, "enabled", enabled)
+	s.sensorsChanged(ctx)
 	go s.broadcastSensors(context.Background())
 	if enabled {
 		go func() {
@@ -538,12 +571,15 @@ func (s *SensorService) ServiceValidateSensorConfig(ctx context.Context, sensor
 		}
 	}
 
-	// Only pull drivers can be validated by trial collection
+	// Only pull drivers can be validated by trial collection; the others check their
+	// config locally
 	if _, isPull := driver.(drivers.PullDriver); isPull {
 		err := s.ServiceCollectReadingToValidateSensor(ctx, sensor)
 		if err != nil {
 			return fmt.Errorf("invalid sensor, failed to collect a reading: %w", err)
 		}
+	} else if err := driver.ValidateSensor(ctx, sensor); err != nil {
+		return fmt.Errorf("invalid sensor config: %w", err)
 	}
 	return nil
 }
@@ -585,17 +621,38 @@ func (s *SensorService) ServiceGetSensorsByStatus(ctx context.Context, status st
 }
 
 func (s *SensorService) ServiceApproveSensor(ctx context.Context, sensorId int) error {
-	return s.sensorRepo.UpdateSensorStatus(ctx, sensorId, string(gen.SensorStatusActive))
+	if err := s.sensorRepo.UpdateSensorStatus(ctx, sensorId, string(gen.SensorStatusActive)); err != nil {
+		return err
+	}
+	s.sensorsChanged(ctx)
+	return nil
 }
 
 func (s *SensorService) ServiceDismissSensor(ctx context.Context, sensorId int) error {
-	return s.sensorRepo.UpdateSensorStatus(ctx, sensorId, string(gen.SensorStatusDismissed))
+	if err := s.sensorRepo.UpdateSensorStatus(ctx, sensorId, string(gen.SensorStatusDismissed)); err != nil {
+		return err
+	}
+	s.sensorsChanged(ctx)
+	return nil
 }
 
 // ServiceProcessPushReadings stores readings from push-based (MQTT) sensors,
 // processes alerts, updates health, and broadcasts via WebSocket.
 // This provides the same pipeline as the pull-based collector.
 func (s *SensorService) ServiceProcessPushReadings(ctx context.Context, sensor gen.Sensor, readings []gen.Reading) error {
+	return s.processReadings(ctx, sensor, readings, "MQTT reading received")
+}
+
+// ServiceProcessComputedReadings stores readings calculated by a computed sensor
+// through the same pipeline as push readings, so alerts, observers and dashboards
+// treat them like any other reading.
+func (s *SensorService) ServiceProcessComputedReadings(ctx context.Context, sensor gen.Sensor, readings []gen.Reading) error {
+	return s.processReadings(ctx, sensor, readings, "computed reading stored")
+}
+
+// processReadings stores a sensor's readings, marks the sensor healthy with the given
+// reason, processes alerts, notifies observers and broadcasts the readings.
+func (s *SensorService) processReadings(ctx context.Context, sensor gen.Sensor, readings []gen.Reading, healthReason string) error {
 	if len(readings) == 0 {
 		return nil
 	}
@@ -607,10 +664,10 @@ func (s *SensorService) ServiceProcessPushReadings(ctx context.Context, sensor g
 
 	if err := s.readingsRepo.Add(ctx, readings); err != nil {
 		s.ServiceUpdateSensorHealthById(ctx, sensor.Id, gen.Bad, fmt.Sprintf("storage error: %v", err))
-		return fmt.Errorf("failed to store push readings: %w", err)
+		return fmt.Errorf("failed to store readings: %w", err)
 	}
 
-	s.ServiceUpdateSensorHealthById(ctx, sensor.Id, gen.Good, "MQTT reading received")
+	s.ServiceUpdateSensorHealthById(ctx, sensor.Id, gen.Good, healthReason)
 
 	// Process alerts
 	for _, reading := range readings {
@@ -623,13 +680,11 @@ func (s *SensorService) ServiceProcessPushReadings(ctx context.Context, sensor g
 			textVal = *reading.TextState
 		}
 		if err := s.thresholdProcessor.ProcessReading(ctx, alerting.ReadingAlert{SensorID: sensor.Id, SensorName: sensor.Name, MeasurementType: reading.MeasurementType, NumericValue: numVal, StatusValue: textVal}); err != nil {
-			s.logger.Error("failed to process alert for MQTT reading", "sensor", sensor.Name, "error", err)
+			s.logger.Error("failed to process alert for reading", "sensor", sensor.Name, "error", err)
 		}
 	}
 
-	if s.readingsObserver != nil {
-		s.readingsObserver.ObserveReadings(ctx, sensor.Id, readings)
-	}
+	s.observeReadings(ctx, sensor.Id, readings)
 
 	// Broadcast
 	ws.BroadcastToTopic("current-readings", readings)
@@ -649,6 +704,22 @@ func (s *SensorService) ServiceGetAllMeasurementTypesWithReadings(ctx context.Co
 	return s.mtRepo.GetAllWithReadings(ctx)
 }
 
+// ServiceSetMeasurementTypeDownsampling sets the bucket interval the type's expired raw
+// readings are downsampled to, or deletes them again when interval is nil. It returns nil
+// when the measurement type does not exist.
+func (s *SensorService) ServiceSetMeasurementTypeDownsampling(ctx context.Context, name string, interval *string) (*gen.MeasurementType, error) {
+	mt, err := s.mtRepo.GetByName(ctx, name)
+	if err != nil || mt == nil {
+		return nil, err
+	}
+	if err := s.mtRepo.SetRetentionDownsampleInterval(ctx, mt.Name, interval); err != nil {
+		return nil, err
+	}
+	mt.RetentionDownsampleInterval = interval
+	s.logger.Info("measurement type retention downsampling updated", "measurement_type", mt.Name, "interval", interval)
+	return mt, nil
+}
+
 func (s *SensorService) enrichSensor(sensor *gen.Sensor) *gen.Sensor {
 	enriched := *sensor
 	capabilities := s.resolveSensorCapabilities(enriched)
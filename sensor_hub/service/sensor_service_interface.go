@@ -22,6 +22,8 @@ type SensorServiceInterface interface {
 	ServiceCollectFromSensorByName(ctx context.Context, sensorName string) error
 	ServiceCollectReadingToValidateSensor(ctx context.Context, sensor gen.Sensor) error
 	ServiceStartPeriodicSensorCollection(ctx context.Context)
+	ServiceStartStaleSensorWatchdog(ctx context.Context)
+	ServiceSetSensorExpectedInterval(ctx context.Context, sensorId int, expectedIntervalSeconds *int) error
 	ServiceDiscoverSensors(ctx context.Context) error
 	ServiceValidateSensorConfig(ctx context.Context, sensor gen.Sensor) error
 	ServiceUpdateSensorHealthById(ctx context.Context, sensorId int, healthStatus gen.SensorHealthStatus, healthReason string)
@@ -35,4 +37,5 @@ type SensorServiceInterface interface {
 	ServiceGetMeasurementTypesForSensor(ctx context.Context, sensorId int) ([]gen.MeasurementType, error)
 	ServiceGetAllMeasurementTypes(ctx context.Context) ([]gen.MeasurementType, error)
 	ServiceGetAllMeasurementTypesWithReadings(ctx context.Context) ([]gen.MeasurementType, error)
+	ServiceSetMeasurementTypeDownsampling(ctx context.Context, name string, interval *string) (*gen.MeasurementType, error)
 }
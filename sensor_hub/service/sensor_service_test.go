@@ -49,12 +49,12 @@ func (m *MockAlertRepository) GetAlertRulesBySensorID(ctx context.Context, senso
 	return args.Get(0).([]alerting.AlertRule), args.Error(1)
 }
 
-func (m *MockAlertRepository) GetAlertRuleForReading(ctx context.Context, sensorID int, measurementTypeName string) (*alerting.AlertRule, error) {
+func (m *MockAlertRepository) GetAlertRulesForReading(ctx context.Context, sensorID int, measurementTypeName string) ([]alerting.AlertRule, error) {
 	args := m.Called(ctx, sensorID, measurementTypeName)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
-	return args.Get(0).(*alerting.AlertRule), args.Error(1)
+	return args.Get(0).([]alerting.AlertRule), args.Error(1)
 }
 
 func (m *MockAlertRepository) RecordAlertSent(ctx context.Context, ruleID, sensorID, measurementTypeId int, reason string, numericValue float64, statusValue string) error {
@@ -62,6 +62,29 @@ func (m *MockAlertRepository) RecordAlertSent(ctx context.Context, ruleID, senso
 	return args.Error(0)
 }
 
+func (m *MockAlertRepository) MarkAlertFiring(ctx context.Context, ruleID int) (bool, error) {
+	args := m.Called(ctx, ruleID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockAlertRepository) UpdateAlertPeak(ctx context.Context, ruleID int, value float64, higher bool) error {
+	args := m.Called(ctx, ruleID, value, higher)
+	return args.Error(0)
+}
+
+func (m *MockAlertRepository) MarkAlertRateLimited(ctx context.Context, ruleID int) error {
+	args := m.Called(ctx, ruleID)
+	return args.Error(0)
+}
+
+func (m *MockAlertRepository) ResolveAlert(ctx context.Context, ruleID int) (*alerting.AlertEpisode, error) {
+	args := m.Called(ctx, ruleID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*alerting.AlertEpisode), args.Error(1)
+}
+
 func (m *MockAlertRepository) GetAllAlertRules(ctx context.Context) ([]alerting.AlertRule, error) {
 	args := m.Called(ctx)
 	return args.Get(0).([]alerting.AlertRule), args.Error(1)
@@ -108,6 +131,247 @@ func (m *MockAlertRepository) GetAlertRule(ctx context.Context, sensorID, measur
 	return args.Get(0).(*alerting.AlertRule), args.Error(1)
 }
 
+func (m *MockAlertRepository) GetAllCompoundAlertRules(ctx context.Context) ([]alerting.CompoundAlertRule, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]alerting.CompoundAlertRule), args.Error(1)
+}
+
+func (m *MockAlertRepository) GetCompoundAlertRuleByID(ctx context.Context, ruleID int) (*alerting.CompoundAlertRule, error) {
+	args := m.Called(ctx, ruleID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*alerting.CompoundAlertRule), args.Error(1)
+}
+
+func (m *MockAlertRepository) GetCompoundAlertRulesForReading(ctx context.Context, sensorID int, measurementTypeName string) ([]alerting.CompoundAlertRule, error) {
+	args := m.Called(ctx, sensorID, measurementTypeName)
+	return args.Get(0).([]alerting.CompoundAlertRule), args.Error(1)
+}
+
+func (m *MockAlertRepository) CreateCompoundAlertRule(ctx context.Context, rule *alerting.CompoundAlertRule) error {
+	args := m.Called(ctx, rule)
+	return args.Error(0)
+}
+
+func (m *MockAlertRepository) UpdateCompoundAlertRule(ctx context.Context, rule *alerting.CompoundAlertRule) error {
+	args := m.Called(ctx, rule)
+	return args.Error(0)
+}
+
+func (m *MockAlertRepository) DeleteCompoundAlertRule(ctx context.Context, ruleID int) error {
+	args := m.Called(ctx, ruleID)
+	return args.Error(0)
+}
+
+func (m *MockAlertRepository) RecordCompoundAlertSent(ctx context.Context, ruleID, sensorID, measurementTypeId int, reason string, numericValue float64, statusValue string) error {
+	args := m.Called(ctx, ruleID, sensorID, measurementTypeId, reason, numericValue, statusValue)
+	return args.Error(0)
+}
+
+func (m *MockAlertRepository) MarkCompoundAlertFiring(ctx context.Context, ruleID int) (bool, error) {
+	args := m.Called(ctx, ruleID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockAlertRepository) MarkCompoundAlertRateLimited(ctx context.Context, ruleID int) error {
+	args := m.Called(ctx, ruleID)
+	return args.Error(0)
+}
+
+func (m *MockAlertRepository) ResolveCompoundAlert(ctx context.Context, ruleID int) (*alerting.AlertEpisode, error) {
+	args := m.Called(ctx, ruleID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*alerting.AlertEpisode), args.Error(1)
+}
+
+func (m *MockAlertRepository) AcknowledgeCompoundAlertRule(ctx context.Context, ruleID, userID int) (bool, error) {
+	args := m.Called(ctx, ruleID, userID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockAlertRepository) SnoozeCompoundAlertRule(ctx context.Context, ruleID int, until *time.Time) error {
+	args := m.Called(ctx, ruleID, until)
+	return args.Error(0)
+}
+
+func (m *MockAlertRepository) AcknowledgeAlertRule(ctx context.Context, ruleID, userID int) (bool, error) {
+	args := m.Called(ctx, ruleID, userID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockAlertRepository) AcknowledgeAlertHistoryEntry(ctx context.Context, entryID, userID int) (bool, error) {
+	args := m.Called(ctx, entryID, userID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockAlertRepository) SnoozeAlertRule(ctx context.Context, ruleID int, until *time.Time) error {
+	args := m.Called(ctx, ruleID, until)
+	return args.Error(0)
+}
+
+func (m *MockAlertRepository) GetAllSilenceWindows(ctx context.Context) ([]alerting.SilenceWindow, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]alerting.SilenceWindow), args.Error(1)
+}
+
+func (m *MockAlertRepository) GetSilenceWindowByID(ctx context.Context, windowID int) (*alerting.SilenceWindow, error) {
+	args := m.Called(ctx, windowID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*alerting.SilenceWindow), args.Error(1)
+}
+
+func (m *MockAlertRepository) GetSilenceWindowsForSensor(ctx context.Context, sensorID int) ([]alerting.SilenceWindow, error) {
+	args := m.Called(ctx, sensorID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]alerting.SilenceWindow), args.Error(1)
+}
+
+func (m *MockAlertRepository) CreateSilenceWindow(ctx context.Context, window *alerting.SilenceWindow) error {
+	args := m.Called(ctx, window)
+	return args.Error(0)
+}
+
+func (m *MockAlertRepository) UpdateSilenceWindow(ctx context.Context, window *alerting.SilenceWindow) error {
+	args := m.Called(ctx, window)
+	return args.Error(0)
+}
+
+func (m *MockAlertRepository) DeleteSilenceWindow(ctx context.Context, windowID int) error {
+	args := m.Called(ctx, windowID)
+	return args.Error(0)
+}
+
+func (m *MockAlertRepository) GetEscalationSteps(ctx context.Context, ruleID int) ([]alerting.EscalationStep, error) {
+	args := m.Called(ctx, ruleID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]alerting.EscalationStep), args.Error(1)
+}
+
+func (m *MockAlertRepository) SetEscalationSteps(ctx context.Context, ruleID int, steps []alerting.EscalationStep) error {
+	args := m.Called(ctx, ruleID, steps)
+	return args.Error(0)
+}
+
+func (m *MockAlertRepository) StartEscalation(ctx context.Context, ruleID, notificationID int, dueAt time.Time) error {
+	args := m.Called(ctx, ruleID, notificationID, dueAt)
+	return args.Error(0)
+}
+
+func (m *MockAlertRepository) GetDueEscalations(ctx context.Context, now time.Time) ([]alerting.Escalation, error) {
+	args := m.Called(ctx, now)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]alerting.Escalation), args.Error(1)
+}
+
+func (m *MockAlertRepository) AdvanceEscalation(ctx context.Context, escalationID, notificationID, nextStep int, dueAt time.Time) error {
+	args := m.Called(ctx, escalationID, notificationID, nextStep, dueAt)
+	return args.Error(0)
+}
+
+func (m *MockAlertRepository) EndEscalation(ctx context.Context, escalationID int) error {
+	args := m.Called(ctx, escalationID)
+	return args.Error(0)
+}
+
+func (m *MockAlertRepository) GetAlertRecipients(ctx context.Context, ruleID int) (alerting.AlertRecipients, error) {
+	args := m.Called(ctx, ruleID)
+	return args.Get(0).(alerting.AlertRecipients), args.Error(1)
+}
+
+func (m *MockAlertRepository) SetAlertRecipients(ctx context.Context, ruleID int, recipients alerting.AlertRecipients) error {
+	args := m.Called(ctx, ruleID, recipients)
+	return args.Error(0)
+}
+
+func (m *MockAlertRepository) GetAlertRecipientUserIDs(ctx context.Context, ruleID int) ([]int, bool, error) {
+	args := m.Called(ctx, ruleID)
+	if args.Get(0) == nil {
+		return nil, args.Bool(1), args.Error(2)
+	}
+	return args.Get(0).([]int), args.Bool(1), args.Error(2)
+}
+
+func (m *MockAlertRepository) GetCompoundEscalationSteps(ctx context.Context, ruleID int) ([]alerting.EscalationStep, error) {
+	args := m.Called(ctx, ruleID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]alerting.EscalationStep), args.Error(1)
+}
+
+func (m *MockAlertRepository) SetCompoundEscalationSteps(ctx context.Context, ruleID int, steps []alerting.EscalationStep) error {
+	args := m.Called(ctx, ruleID, steps)
+	return args.Error(0)
+}
+
+func (m *MockAlertRepository) StartCompoundEscalation(ctx context.Context, ruleID, notificationID int, dueAt time.Time) error {
+	args := m.Called(ctx, ruleID, notificationID, dueAt)
+	return args.Error(0)
+}
+
+func (m *MockAlertRepository) GetCompoundAlertRecipients(ctx context.Context, ruleID int) (alerting.AlertRecipients, error) {
+	args := m.Called(ctx, ruleID)
+	return args.Get(0).(alerting.AlertRecipients), args.Error(1)
+}
+
+func (m *MockAlertRepository) SetCompoundAlertRecipients(ctx context.Context, ruleID int, recipients alerting.AlertRecipients) error {
+	args := m.Called(ctx, ruleID, recipients)
+	return args.Error(0)
+}
+
+func (m *MockAlertRepository) GetCompoundAlertRecipientUserIDs(ctx context.Context, ruleID int) ([]int, bool, error) {
+	args := m.Called(ctx, ruleID)
+	if args.Get(0) == nil {
+		return nil, args.Bool(1), args.Error(2)
+	}
+	return args.Get(0).([]int), args.Bool(1), args.Error(2)
+}
+
+func (m *MockAlertRepository) GetAllContactGroups(ctx context.Context) ([]alerting.ContactGroup, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]alerting.ContactGroup), args.Error(1)
+}
+
+func (m *MockAlertRepository) GetContactGroupByID(ctx context.Context, groupID int) (*alerting.ContactGroup, error) {
+	args := m.Called(ctx, groupID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*alerting.ContactGroup), args.Error(1)
+}
+
+func (m *MockAlertRepository) CreateContactGroup(ctx context.Context, group *alerting.ContactGroup) error {
+	args := m.Called(ctx, group)
+	return args.Error(0)
+}
+
+func (m *MockAlertRepository) UpdateContactGroup(ctx context.Context, group *alerting.ContactGroup) error {
+	args := m.Called(ctx, group)
+	return args.Error(0)
+}
+
+func (m *MockAlertRepository) DeleteContactGroup(ctx context.Context, groupID int) error {
+	args := m.Called(ctx, groupID)
+	return args.Error(0)
+}
+
 // ============================================================================
 // Mock MeasurementTypeRepository for SensorService
 // ============================================================================
@@ -159,6 +423,11 @@ func (m *MockMeasurementTypeRepository) GetAllWithReadings(ctx context.Context)
 	return args.Get(0).([]gen.MeasurementType), args.Error(1)
 }
 
+func (m *MockMeasurementTypeRepository) SetRetentionDownsampleInterval(ctx context.Context, name string, interval *string) error {
+	args := m.Called(ctx, name, interval)
+	return args.Error(0)
+}
+
 func (m *MockMeasurementTypeRepository) GetAggregationsForMeasurementType(ctx context.Context, name string) (*database.MeasurementTypeAggregation, error) {
 	args := m.Called(ctx, name)
 	if args.Get(0) == nil {
@@ -176,7 +445,7 @@ func setupSensorService() (*SensorService, *MockSensorRepository, *MockReadingsR
 	readingsRepo := new(MockReadingsRepository)
 	mtRepo := new(MockMeasurementTypeRepository)
 	alertRepo := new(MockAlertRepository)
-	processor := alerting.NewThresholdAlertProcessor(alertRepo, nil, nil, nil, slog.Default())
+	processor := alerting.NewThresholdAlertProcessor(alertRepo, readingsRepo, nil, nil, nil, slog.Default())
 	service := NewSensorService(sensorRepo, readingsRepo, mtRepo, processor, nil, slog.Default())
 	return service, sensorRepo, readingsRepo, mtRepo, alertRepo
 }
@@ -191,6 +460,14 @@ func (f *fakeReadingsObserver) ObserveReadings(_ context.Context, sensorID int,
 	f.readings = append([]gen.Reading(nil), readings...)
 }
 
+type fakeSensorsObserver struct {
+	changes int
+}
+
+func (f *fakeSensorsObserver) SensorsChanged(_ context.Context) {
+	f.changes++
+}
+
 // ============================================================================
 // ServiceAddSensor tests
 // ============================================================================
@@ -221,7 +498,7 @@ func TestSensorService_ServiceAddSensor_Success(t *testing.T) {
 func TestSensorService_ServiceProcessPushReadings_NotifiesReadingsObserver(t *testing.T) {
 	service, sensorRepo, readingsRepo, _, alertRepo := setupSensorService()
 	observer := &fakeReadingsObserver{}
-	service.SetReadingsObserver(observer)
+	service.AddReadingsObserver(observer)
 
 	sensor := gen.Sensor{Id: 7, Name: "office-plug"}
 	readings := []gen.Reading{{
@@ -237,7 +514,8 @@ func TestSensorService_ServiceProcessPushReadings_NotifiesReadingsObserver(t *te
 	})).Return(nil)
 	sensorRepo.On("UpdateSensorHealthById", mock.Anything, 7, gen.Good, "MQTT reading received").Return(nil)
 	sensorRepo.On("GetAllSensors", mock.Anything).Return([]gen.Sensor{sensor}, nil).Maybe()
-	alertRepo.On("GetAlertRuleForReading", mock.Anything, 7, "state").Return(nil, nil)
+	alertRepo.On("GetAlertRulesForReading", mock.Anything, 7, "state").Return([]alerting.AlertRule{}, nil)
+	alertRepo.On("GetCompoundAlertRulesForReading", mock.Anything, 7, "state").Return([]alerting.CompoundAlertRule{}, nil)
 
 	err := service.ServiceProcessPushReadings(context.Background(), sensor, readings)
 
@@ -247,6 +525,30 @@ func TestSensorService_ServiceProcessPushReadings_NotifiesReadingsObserver(t *te
 	assert.Equal(t, "office-plug", observer.readings[0].SensorName)
 }
 
+func TestSensorService_ServiceProcessComputedReadings(t *testing.T) {
+	service, sensorRepo, readingsRepo, _, alertRepo := setupSensorService()
+	observer := &fakeReadingsObserver{}
+	service.AddReadingsObserver(observer)
+
+	sensor := gen.Sensor{Id: 12, Name: "office-dew-point", SensorDriver: "computed"}
+	value := 9.3
+	readings := []gen.Reading{{MeasurementType: "dew_point", NumericValue: &value}}
+
+	readingsRepo.On("Add", mock.Anything, mock.MatchedBy(func(actual []gen.Reading) bool {
+		return len(actual) == 1 && actual[0].SensorName == "office-dew-point"
+	})).Return(nil)
+	sensorRepo.On("UpdateSensorHealthById", mock.Anything, 12, gen.Good, "computed reading stored").Return(nil)
+	sensorRepo.On("GetAllSensors", mock.Anything).Return([]gen.Sensor{sensor}, nil).Maybe()
+	alertRepo.On("GetAlertRulesForReading", mock.Anything, 12, "dew_point").Return([]alerting.AlertRule{}, nil)
+	alertRepo.On("GetCompoundAlertRulesForReading", mock.Anything, 12, "dew_point").Return([]alerting.CompoundAlertRule{}, nil)
+
+	err := service.ServiceProcessComputedReadings(context.Background(), sensor, readings)
+
+	assert.NoError(t, err)
+	alertRepo.AssertExpectations(t)
+	assert.Equal(t, 12, observer.sensorID)
+}
+
 func TestSensorService_ServiceAddSensor_AlreadyExists(t *testing.T) {
 	service, sensorRepo, _, _, _ := setupSensorService()
 
@@ -356,6 +658,25 @@ func TestSensorService_ServiceDeleteSensorByName_Success(t *testing.T) {
 	time.Sleep(50 * time.Millisecond)
 }
 
+func TestSensorService_SensorChanges_NotifySensorsObservers(t *testing.T) {
+	service, sensorRepo, _, _, _ := setupSensorService()
+	observer := &fakeSensorsObserver{}
+	service.AddSensorsObserver(observer)
+
+	sensorRepo.On("SensorExists", mock.Anything, "TestSensor").Return(true, nil)
+	sensorRepo.On("DeleteSensorByName", mock.Anything, "TestSensor").Return(nil)
+	sensorRepo.On("UpdateSensorStatus", mock.Anything, 3, string(gen.SensorStatusActive)).Return(nil)
+	sensorRepo.On("UpdateSensorStatus", mock.Anything, 4, string(gen.SensorStatusDismissed)).Return(errors.New("database error"))
+	sensorRepo.On("GetAllSensors", mock.Anything).Return([]gen.Sensor{}, nil).Maybe()
+
+	assert.NoError(t, service.ServiceDeleteSensorByName(context.Background(), "TestSensor"))
+	assert.NoError(t, service.ServiceApproveSensor(context.Background(), 3))
+	assert.Error(t, service.ServiceDismissSensor(context.Background(), 4))
+
+	assert.Equal(t, 2, observer.changes, "a failed change is not reported")
+	time.Sleep(50 * time.Millisecond)
+}
+
 func TestSensorService_ServiceDeleteSensorByName_NotExists(t *testing.T) {
 	service, sensorRepo, _, _, _ := setupSensorService()
 
@@ -612,7 +933,8 @@ func TestSensorService_ServiceSetEnabledSensorByName_Enable(t *testing.T) {
 	sensorRepo.On("UpdateSensorHealthById", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
 	readingsRepo.On("Add", mock.Anything, mock.Anything).Return(nil).Maybe()
 	// The async collection triggers alert processing
-	alertRepo.On("GetAlertRuleForReading", mock.Anything, mock.Anything, mock.Anything).Return(nil, nil).Maybe()
+	alertRepo.On("GetAlertRulesForReading", mock.Anything, mock.Anything, mock.Anything).Return([]alerting.AlertRule{}, nil).Maybe()
+	alertRepo.On("GetCompoundAlertRulesForReading", mock.Anything, mock.Anything, mock.Anything).Return([]alerting.CompoundAlertRule{}, nil).Maybe()
 
 	err := service.ServiceSetEnabledSensorByName(context.Background(), "TestSensor", true)
 
@@ -762,6 +1084,22 @@ func TestSensorService_ServiceValidateSensorConfig_FetchFails(t *testing.T) {
 	assert.Contains(t, err.Error(), "failed to collect a reading")
 }
 
+func TestSensorService_ServiceValidateSensorConfig_ComputedSelfReference(t *testing.T) {
+	service, _, _, _, _ := setupSensorService()
+
+	sensor := gen.Sensor{Name: "office-dew-point", SensorDriver: "computed", Config: map[string]string{
+		"expression":       "dp + 1",
+		"inputs":           `{"dp": {"sensor": "Office-Dew-Point", "measurement_type": "dew_point"}}`,
+		"measurement_type": "dew_point",
+	}}
+
+	err := service.ServiceValidateSensorConfig(context.Background(), sensor)
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid sensor config")
+	assert.Contains(t, err.Error(), "reads sensor office-dew-point itself")
+}
+
 // ============================================================================
 // AlreadyExistsError tests
 // ============================================================================
@@ -789,7 +1127,8 @@ func TestSensorService_ServiceCollectFromSensorByName_Success(t *testing.T) {
 	readingsRepo.On("Add", mock.Anything, mock.Anything).Return(nil)
 	sensorRepo.On("UpdateSensorHealthById", mock.Anything, 1, gen.Good, mock.Anything).Return(nil).Maybe()
 	sensorRepo.On("GetAllSensors", mock.Anything).Return([]gen.Sensor{*sensor}, nil).Maybe()
-	alertRepo.On("GetAlertRuleForReading", mock.Anything, 1, mock.Anything).Return(nil, nil).Maybe()
+	alertRepo.On("GetAlertRulesForReading", mock.Anything, 1, mock.Anything).Return([]alerting.AlertRule{}, nil).Maybe()
+	alertRepo.On("GetCompoundAlertRulesForReading", mock.Anything, 1, mock.Anything).Return([]alerting.CompoundAlertRule{}, nil).Maybe()
 
 	err := service.ServiceCollectFromSensorByName(context.Background(), "test-sensor")
 
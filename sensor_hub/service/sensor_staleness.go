@@ -0,0 +1,117 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	appProps "example/sensorHub/application_properties"
+	"example/sensorHub/drivers"
+	gen "example/sensorHub/gen"
+	"example/sensorHub/notifications"
+	"example/sensorHub/periodic"
+)
+
+// staleHealthReasonPrefix marks health reasons set by the stale sensor watchdog, so a
+// sensor is only flagged (and notified about) once per silent period.
+const staleHealthReasonPrefix = "no readings for "
+
+func (s *SensorService) ServiceStartStaleSensorWatchdog(ctx context.Context) {
+	intervalSec := appProps.AppConfig.SensorStaleCheckIntervalSeconds
+
+	periodic.RunTask(ctx, periodic.TaskConfig{
+		Name:     "stale_sensor_watchdog",
+		Interval: time.Duration(intervalSec) * time.Second,
+		Logger:   s.logger,
+	}, func(ctx context.Context) error {
+		return s.checkStaleSensors(ctx, time.Now())
+	})
+}
+
+func (s *SensorService) ServiceSetSensorExpectedInterval(ctx context.Context, sensorId int, expectedIntervalSeconds *int) error {
+	if expectedIntervalSeconds != nil && *expectedIntervalSeconds <= 0 {
+		return fmt.Errorf("expected interval must be a positive number of seconds")
+	}
+	if err := s.sensorRepo.UpdateSensorExpectedInterval(ctx, sensorId, expectedIntervalSeconds); err != nil {
+		return fmt.Errorf("error updating sensor expected interval: %w", err)
+	}
+	s.logger.Info("sensor expected interval updated", "id", sensorId, "expected_interval_seconds", expectedIntervalSeconds)
+	go s.broadcastSensors(context.Background())
+	return nil
+}
+
+// checkStaleSensors marks active sensors whose latest reading is older than their expected
+// interval as bad and notifies users who can view alerts. Sensors that have never reported
+// are skipped; health recovers through the normal reading path once readings resume.
+func (s *SensorService) checkStaleSensors(ctx context.Context, now time.Time) error {
+	sensors, err := s.sensorRepo.GetAllSensors(ctx)
+	if err != nil {
+		return fmt.Errorf("error fetching sensors: %w", err)
+	}
+	latest, err := s.readingsRepo.GetLatestReadingTimes(ctx)
+	if err != nil {
+		return fmt.Errorf("error fetching latest reading times: %w", err)
+	}
+
+	for _, sensor := range sensors {
+		if !sensor.Enabled || sensor.Status != gen.SensorStatusActive {
+			continue
+		}
+		expected := expectedReadingInterval(sensor)
+		if expected <= 0 {
+			continue
+		}
+		lastReading, ok := latest[sensor.Id]
+		if !ok {
+			continue
+		}
+		silence := now.Sub(lastReading)
+		if silence <= expected {
+			continue
+		}
+		if sensor.HealthStatus == gen.Bad && strings.HasPrefix(sensor.HealthReason, staleHealthReasonPrefix) {
+			continue
+		}
+
+		reason := fmt.Sprintf("%s%s (expected every %s)", staleHealthReasonPrefix, silence.Truncate(time.Second), expected)
+		s.logger.Warn("sensor has stopped reporting", "sensor", sensor.Name, "last_reading", lastReading, "expected_interval", expected)
+		s.ServiceUpdateSensorHealthById(ctx, sensor.Id, gen.Bad, reason)
+		s.notifyStaleSensor(ctx, sensor, lastReading, reason)
+	}
+	return nil
+}
+
+// expectedReadingInterval resolves how often a sensor should report: its own setting,
+// then its driver's default, then the global default. Zero disables the check.
+func expectedReadingInterval(sensor gen.Sensor) time.Duration {
+	if sensor.ExpectedIntervalSeconds != nil {
+		return time.Duration(*sensor.ExpectedIntervalSeconds) * time.Second
+	}
+	if driver, ok := drivers.Get(sensor.SensorDriver); ok {
+		if reporter, ok := driver.(drivers.ExpectedIntervalReporter); ok {
+			return reporter.ExpectedInterval()
+		}
+	}
+	return time.Duration(appProps.AppConfig.SensorStaleDefaultIntervalSeconds) * time.Second
+}
+
+func (s *SensorService) notifyStaleSensor(ctx context.Context, sensor gen.Sensor, lastReading time.Time, reason string) {
+	if s.notifSvc == nil {
+		return
+	}
+	notif := notifications.Notification{
+		Category: notifications.CategoryThresholdAlert,
+		Severity: notifications.SeverityWarning,
+		Title:    fmt.Sprintf("Sensor %s stopped reporting", sensor.Name),
+		Message:  fmt.Sprintf("Sensor '%s' has sent %s", sensor.Name, reason),
+		Metadata: map[string]interface{}{
+			"sensor_id":    sensor.Id,
+			"sensor_name":  sensor.Name,
+			"last_reading": lastReading.UTC().Format(time.RFC3339),
+		},
+	}
+	if _, err := s.notifSvc.CreateNotification(ctx, notif, "view_alerts"); err != nil {
+		s.logger.Error("failed to send stale sensor notification", "sensor", sensor.Name, "error", err)
+	}
+}
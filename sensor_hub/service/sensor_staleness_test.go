@@ -0,0 +1,120 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"example/sensorHub/alerting"
+	appProps "example/sensorHub/application_properties"
+	gen "example/sensorHub/gen"
+	"example/sensorHub/notifications"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type recordingNotificationService struct {
+	NotificationServiceInterface
+	notifs      []notifications.Notification
+	permissions []string
+}
+
+func (r *recordingNotificationService) CreateNotification(_ context.Context, notif notifications.Notification, targetPermission string) (int, error) {
+	r.notifs = append(r.notifs, notif)
+	r.permissions = append(r.permissions, targetPermission)
+	return len(r.notifs), nil
+}
+
+func setupStaleSensorService(t *testing.T, defaultIntervalSeconds int) (*SensorService, *MockSensorRepository, *MockReadingsRepository, *recordingNotificationService) {
+	t.Helper()
+	originalConfig := appProps.AppConfig
+	appProps.AppConfig = &appProps.ApplicationConfiguration{SensorStaleDefaultIntervalSeconds: defaultIntervalSeconds}
+	t.Cleanup(func() { appProps.AppConfig = originalConfig })
+
+	sensorRepo := new(MockSensorRepository)
+	readingsRepo := new(MockReadingsRepository)
+	notifSvc := &recordingNotificationService{}
+	processor := alerting.NewThresholdAlertProcessor(new(MockAlertRepository), readingsRepo, nil, nil, nil, slog.Default())
+	svc := NewSensorService(sensorRepo, readingsRepo, new(MockMeasurementTypeRepository), processor, notifSvc, slog.Default())
+	return svc, sensorRepo, readingsRepo, notifSvc
+}
+
+func TestSensorService_checkStaleSensors_FlagsSilentSensors(t *testing.T) {
+	svc, sensorRepo, readingsRepo, notifSvc := setupStaleSensorService(t, 0)
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	custom := 600
+
+	sensors := []gen.Sensor{
+		{Id: 1, Name: "door", SensorDriver: "mqtt-zigbee2mqtt", Enabled: true, Status: gen.SensorStatusActive, HealthStatus: gen.Good},
+		{Id: 2, Name: "weather", SensorDriver: "mqtt-rtl433", Enabled: true, Status: gen.SensorStatusActive, HealthStatus: gen.Good},
+		{Id: 3, Name: "freezer", SensorDriver: "mqtt-zigbee2mqtt", Enabled: true, Status: gen.SensorStatusActive, HealthStatus: gen.Good, ExpectedIntervalSeconds: &custom},
+		{Id: 4, Name: "office", SensorDriver: "sensor-hub-http-temperature", Enabled: true, Status: gen.SensorStatusActive, HealthStatus: gen.Good},
+		{Id: 5, Name: "disabled", SensorDriver: "mqtt-rtl433", Enabled: false, Status: gen.SensorStatusActive, HealthStatus: gen.Good},
+		{Id: 6, Name: "never-reported", SensorDriver: "mqtt-rtl433", Enabled: true, Status: gen.SensorStatusActive, HealthStatus: gen.Unknown},
+	}
+	sensorRepo.On("GetAllSensors", mock.Anything).Return(sensors, nil)
+	readingsRepo.On("GetLatestReadingTimes", mock.Anything).Return(map[int]time.Time{
+		1: now.Add(-2 * time.Hour),
+		2: now.Add(-20 * time.Minute),
+		3: now.Add(-11 * time.Minute),
+		4: now.Add(-48 * time.Hour),
+		5: now.Add(-48 * time.Hour),
+	}, nil)
+	sensorRepo.On("UpdateSensorHealthById", mock.Anything, mock.Anything, gen.Bad, mock.Anything).Return(nil)
+
+	require.NoError(t, svc.checkStaleSensors(context.Background(), now))
+
+	sensorRepo.AssertCalled(t, "UpdateSensorHealthById", mock.Anything, 2, gen.Bad, "no readings for 20m0s (expected every 15m0s)")
+	sensorRepo.AssertCalled(t, "UpdateSensorHealthById", mock.Anything, 3, gen.Bad, "no readings for 11m0s (expected every 10m0s)")
+	sensorRepo.AssertNumberOfCalls(t, "UpdateSensorHealthById", 2)
+
+	require.Len(t, notifSvc.notifs, 2)
+	assert.Equal(t, notifications.CategoryThresholdAlert, notifSvc.notifs[0].Category)
+	assert.Equal(t, "Sensor weather stopped reporting", notifSvc.notifs[0].Title)
+	assert.Equal(t, []string{"view_alerts", "view_alerts"}, notifSvc.permissions)
+}
+
+func TestSensorService_checkStaleSensors_UsesGlobalDefault(t *testing.T) {
+	svc, sensorRepo, readingsRepo, notifSvc := setupStaleSensorService(t, 3600)
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	sensorRepo.On("GetAllSensors", mock.Anything).Return([]gen.Sensor{
+		{Id: 4, Name: "office", SensorDriver: "sensor-hub-http-temperature", Enabled: true, Status: gen.SensorStatusActive, HealthStatus: gen.Good},
+	}, nil)
+	readingsRepo.On("GetLatestReadingTimes", mock.Anything).Return(map[int]time.Time{4: now.Add(-2 * time.Hour)}, nil)
+	sensorRepo.On("UpdateSensorHealthById", mock.Anything, 4, gen.Bad, "no readings for 2h0m0s (expected every 1h0m0s)").Return(nil)
+
+	require.NoError(t, svc.checkStaleSensors(context.Background(), now))
+
+	sensorRepo.AssertExpectations(t)
+	assert.Len(t, notifSvc.notifs, 1)
+}
+
+func TestSensorService_checkStaleSensors_NotifiesOncePerSilence(t *testing.T) {
+	svc, sensorRepo, readingsRepo, notifSvc := setupStaleSensorService(t, 0)
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	sensorRepo.On("GetAllSensors", mock.Anything).Return([]gen.Sensor{
+		{Id: 2, Name: "weather", SensorDriver: "mqtt-rtl433", Enabled: true, Status: gen.SensorStatusActive,
+			HealthStatus: gen.Bad, HealthReason: "no readings for 20m0s (expected every 15m0s)"},
+	}, nil)
+	readingsRepo.On("GetLatestReadingTimes", mock.Anything).Return(map[int]time.Time{2: now.Add(-time.Hour)}, nil)
+
+	require.NoError(t, svc.checkStaleSensors(context.Background(), now))
+
+	sensorRepo.AssertNotCalled(t, "UpdateSensorHealthById", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	assert.Empty(t, notifSvc.notifs)
+}
+
+func TestSensorService_ServiceSetSensorExpectedInterval_RejectsNonPositive(t *testing.T) {
+	svc, sensorRepo, _, _ := setupStaleSensorService(t, 0)
+	zero := 0
+
+	err := svc.ServiceSetSensorExpectedInterval(context.Background(), 1, &zero)
+
+	assert.Error(t, err)
+	sensorRepo.AssertNotCalled(t, "UpdateSensorExpectedInterval", mock.Anything, mock.Anything, mock.Anything)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"example/sensorHub/alerting"
 	database "example/sensorHub/db"
 	gen "example/sensorHub/gen"
 
@@ -317,6 +318,10 @@ func (m *MockSensorRepository) GetSensorsWithRetention(ctx context.Context) ([]g
 	return args.Get(0).([]gen.Sensor), args.Error(1)
 }
 
+func (m *MockSensorRepository) UpdateSensorExpectedInterval(ctx context.Context, sensorId int, expectedIntervalSeconds *int) error {
+	return m.Called(ctx, sensorId, expectedIntervalSeconds).Error(0)
+}
+
 func (m *MockSensorRepository) GetSensorByExternalId(ctx context.Context, externalId string) (*gen.Sensor, error) {
 	args := m.Called(ctx, externalId)
 	if args.Get(0) == nil {
@@ -343,8 +348,8 @@ func (m *MockReadingsRepository) Add(ctx context.Context, readings []gen.Reading
 	return args.Error(0)
 }
 
-func (m *MockReadingsRepository) GetBetweenDates(ctx context.Context, startDate, endDate, sensorName, measurementType string, interval database.AggregationInterval, aggFunc database.AggregationFunction) ([]gen.Reading, error) {
-	args := m.Called(ctx, startDate, endDate, sensorName, measurementType, interval, aggFunc)
+func (m *MockReadingsRepository) GetBetweenDates(ctx context.Context, startDate, endDate, sensorName, measurementType string, interval database.AggregationInterval, aggFunc database.AggregationFunction, statistics []database.AggregationFunction) ([]gen.Reading, error) {
+	args := m.Called(ctx, startDate, endDate, sensorName, measurementType, interval, aggFunc, statistics)
 	return args.Get(0).([]gen.Reading), args.Error(1)
 }
 
@@ -353,6 +358,22 @@ func (m *MockReadingsRepository) GetLatest(ctx context.Context) ([]gen.Reading,
 	return args.Get(0).([]gen.Reading), args.Error(1)
 }
 
+func (m *MockReadingsRepository) GetRecentNumericReadings(ctx context.Context, sensorID int, measurementTypeName string, since time.Time) ([]alerting.ReadingSample, error) {
+	args := m.Called(ctx, sensorID, measurementTypeName, since)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]alerting.ReadingSample), args.Error(1)
+}
+
+func (m *MockReadingsRepository) GetLatestReadingTimes(ctx context.Context) (map[int]time.Time, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[int]time.Time), args.Error(1)
+}
+
 func (m *MockReadingsRepository) GetTotalReadingsBySensorId(ctx context.Context, sensorId int) (int, error) {
 	args := m.Called(ctx, sensorId)
 	return args.Int(0), args.Error(1)
@@ -373,6 +394,26 @@ func (m *MockReadingsRepository) DeleteReadingsOlderThanExcludingSensors(ctx con
 	return args.Error(0)
 }
 
+func (m *MockReadingsRepository) DeleteDownsampledReadingsOlderThan(ctx context.Context, cutoffDate time.Time) error {
+	args := m.Called(ctx, cutoffDate)
+	return args.Error(0)
+}
+
+func (m *MockReadingsRepository) RefreshRollups(ctx context.Context) (int, error) {
+	args := m.Called(ctx)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockReadingsRepository) GetRollupBetweenDates(ctx context.Context, startDate, endDate, sensorName, measurementType string, rollup, interval database.AggregationInterval, aggFunc database.AggregationFunction, statistics []database.AggregationFunction) ([]gen.Reading, error) {
+	args := m.Called(ctx, startDate, endDate, sensorName, measurementType, rollup, interval, aggFunc, statistics)
+	return args.Get(0).([]gen.Reading), args.Error(1)
+}
+
+func (m *MockReadingsRepository) DeleteRollupsOlderThan(ctx context.Context, rollup database.AggregationInterval, cutoff time.Time) error {
+	args := m.Called(ctx, rollup, cutoff)
+	return args.Error(0)
+}
+
 // ============================================================================
 // MockApiKeyRepository
 // ============================================================================
@@ -0,0 +1,148 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	database "example/sensorHub/db"
+	gen "example/sensorHub/gen"
+	"example/sensorHub/notifications"
+	"example/sensorHub/webhook"
+)
+
+const manageWebhooksPermission = "manage_webhooks"
+
+var (
+	// ErrWebhookTargetNotFound is returned for targets that do not exist or that the
+	// actor may not see: another user's targets, or global targets without manage_webhooks.
+	ErrWebhookTargetNotFound = errors.New("webhook target not found")
+	// ErrGlobalWebhookForbidden is returned when creating a global target without manage_webhooks.
+	ErrGlobalWebhookForbidden = errors.New("global webhooks require the manage_webhooks permission")
+)
+
+// WebhookDeliverer sends a single notification to a single target.
+type WebhookDeliverer interface {
+	Deliver(ctx context.Context, target webhook.Target, notif notifications.Notification) webhook.Delivery
+}
+
+type WebhookService struct {
+	repo      database.WebhookRepository
+	deliverer WebhookDeliverer
+	logger    *slog.Logger
+}
+
+func NewWebhookService(repo database.WebhookRepository, deliverer WebhookDeliverer, logger *slog.Logger) *WebhookService {
+	return &WebhookService{
+		repo:      repo,
+		deliverer: deliverer,
+		logger:    logger.With("component", "webhook_service"),
+	}
+}
+
+// ServiceListWebhookTargets returns the actor's own targets, and the global targets when
+// the actor can manage them.
+func (s *WebhookService) ServiceListWebhookTargets(ctx context.Context, actor *gen.User) ([]webhook.Target, error) {
+	targets, err := s.repo.GetWebhookTargetsVisibleTo(ctx, actor.Id, hasPermission(actor.Permissions, manageWebhooksPermission))
+	if err != nil {
+		return nil, fmt.Errorf("error listing webhook targets: %w", err)
+	}
+	return targets, nil
+}
+
+func (s *WebhookService) ServiceGetWebhookTarget(ctx context.Context, actor *gen.User, targetID int) (*webhook.Target, error) {
+	target, err := s.repo.GetWebhookTargetByID(ctx, targetID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting webhook target: %w", err)
+	}
+	if target == nil || !canAccessWebhookTarget(actor, target) {
+		return nil, ErrWebhookTargetNotFound
+	}
+	return target, nil
+}
+
+// ServiceCreateWebhookTarget creates a target owned by the actor, or a global target when
+// global is set.
+func (s *WebhookService) ServiceCreateWebhookTarget(ctx context.Context, actor *gen.User, target *webhook.Target, global bool) error {
+	if global {
+		if !hasPermission(actor.Permissions, manageWebhooksPermission) {
+			return ErrGlobalWebhookForbidden
+		}
+		target.UserID = nil
+	} else {
+		userID := actor.Id
+		target.UserID = &userID
+	}
+
+	if err := s.repo.CreateWebhookTarget(ctx, target); err != nil {
+		return fmt.Errorf("error creating webhook target: %w", err)
+	}
+	s.logger.Info("webhook target created", "id", target.ID, "name", target.Name, "global", global, "user_id", actor.Id)
+	return nil
+}
+
+// ServiceUpdateWebhookTarget replaces a target's settings. The owner cannot change; a nil
+// secret keeps the existing one and an empty secret removes it.
+func (s *WebhookService) ServiceUpdateWebhookTarget(ctx context.Context, actor *gen.User, target *webhook.Target, secret *string) error {
+	existing, err := s.ServiceGetWebhookTarget(ctx, actor, target.ID)
+	if err != nil {
+		return err
+	}
+	target.UserID = existing.UserID
+	target.Secret = existing.Secret
+	if secret != nil {
+		target.Secret = *secret
+	}
+
+	if err := s.repo.UpdateWebhookTarget(ctx, target); err != nil {
+		return fmt.Errorf("error updating webhook target: %w", err)
+	}
+	s.logger.Info("webhook target updated", "id", target.ID, "user_id", actor.Id)
+	return nil
+}
+
+func (s *WebhookService) ServiceDeleteWebhookTarget(ctx context.Context, actor *gen.User, targetID int) error {
+	if _, err := s.ServiceGetWebhookTarget(ctx, actor, targetID); err != nil {
+		return err
+	}
+	if err := s.repo.DeleteWebhookTarget(ctx, targetID); err != nil {
+		return fmt.Errorf("error deleting webhook target: %w", err)
+	}
+	s.logger.Info("webhook target deleted", "id", targetID, "user_id", actor.Id)
+	return nil
+}
+
+func (s *WebhookService) ServiceGetWebhookDeliveries(ctx context.Context, actor *gen.User, targetID, limit int) ([]webhook.Delivery, error) {
+	if _, err := s.ServiceGetWebhookTarget(ctx, actor, targetID); err != nil {
+		return nil, err
+	}
+	deliveries, err := s.repo.GetWebhookDeliveries(ctx, targetID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("error getting webhook deliveries: %w", err)
+	}
+	return deliveries, nil
+}
+
+// ServiceTestWebhookTarget sends a test notification to the target, even when it is
+// disabled, and returns the logged delivery.
+func (s *WebhookService) ServiceTestWebhookTarget(ctx context.Context, actor *gen.User, targetID int) (*webhook.Delivery, error) {
+	target, err := s.ServiceGetWebhookTarget(ctx, actor, targetID)
+	if err != nil {
+		return nil, err
+	}
+	delivery := s.deliverer.Deliver(ctx, *target, notifications.Notification{
+		Category: notifications.CategoryConfigChange,
+		Severity: notifications.SeverityInfo,
+		Title:    "Test notification",
+		Message:  fmt.Sprintf("Test delivery to webhook '%s' from Sensor Hub", target.Name),
+	})
+	return &delivery, nil
+}
+
+func canAccessWebhookTarget(actor *gen.User, target *webhook.Target) bool {
+	if target.UserID == nil {
+		return hasPermission(actor.Permissions, manageWebhooksPermission)
+	}
+	return *target.UserID == actor.Id
+}
@@ -0,0 +1,18 @@
+package service
+
+import (
+	"context"
+
+	gen "example/sensorHub/gen"
+	"example/sensorHub/webhook"
+)
+
+type WebhookServiceInterface interface {
+	ServiceListWebhookTargets(ctx context.Context, actor *gen.User) ([]webhook.Target, error)
+	ServiceGetWebhookTarget(ctx context.Context, actor *gen.User, targetID int) (*webhook.Target, error)
+	ServiceCreateWebhookTarget(ctx context.Context, actor *gen.User, target *webhook.Target, global bool) error
+	ServiceUpdateWebhookTarget(ctx context.Context, actor *gen.User, target *webhook.Target, secret *string) error
+	ServiceDeleteWebhookTarget(ctx context.Context, actor *gen.User, targetID int) error
+	ServiceGetWebhookDeliveries(ctx context.Context, actor *gen.User, targetID, limit int) ([]webhook.Delivery, error)
+	ServiceTestWebhookTarget(ctx context.Context, actor *gen.User, targetID int) (*webhook.Delivery, error)
+}
@@ -0,0 +1,154 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+
+	gen "example/sensorHub/gen"
+	"example/sensorHub/notifications"
+	"example/sensorHub/webhook"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type mockWebhookRepository struct {
+	mock.Mock
+}
+
+func (m *mockWebhookRepository) GetWebhookTargetsForUsers(ctx context.Context, userIDs []int) ([]webhook.Target, error) {
+	args := m.Called(ctx, userIDs)
+	return args.Get(0).([]webhook.Target), args.Error(1)
+}
+
+func (m *mockWebhookRepository) GetWebhookTargetsVisibleTo(ctx context.Context, userID int, includeGlobal bool) ([]webhook.Target, error) {
+	args := m.Called(ctx, userID, includeGlobal)
+	return args.Get(0).([]webhook.Target), args.Error(1)
+}
+
+func (m *mockWebhookRepository) GetWebhookTargetByID(ctx context.Context, targetID int) (*webhook.Target, error) {
+	args := m.Called(ctx, targetID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*webhook.Target), args.Error(1)
+}
+
+func (m *mockWebhookRepository) CreateWebhookTarget(ctx context.Context, target *webhook.Target) error {
+	return m.Called(ctx, target).Error(0)
+}
+
+func (m *mockWebhookRepository) UpdateWebhookTarget(ctx context.Context, target *webhook.Target) error {
+	return m.Called(ctx, target).Error(0)
+}
+
+func (m *mockWebhookRepository) DeleteWebhookTarget(ctx context.Context, targetID int) error {
+	return m.Called(ctx, targetID).Error(0)
+}
+
+func (m *mockWebhookRepository) RecordWebhookDelivery(ctx context.Context, delivery *webhook.Delivery) error {
+	return m.Called(ctx, delivery).Error(0)
+}
+
+func (m *mockWebhookRepository) GetWebhookDeliveries(ctx context.Context, targetID, limit int) ([]webhook.Delivery, error) {
+	args := m.Called(ctx, targetID, limit)
+	return args.Get(0).([]webhook.Delivery), args.Error(1)
+}
+
+type stubWebhookDeliverer struct {
+	target webhook.Target
+	notif  notifications.Notification
+}
+
+func (s *stubWebhookDeliverer) Deliver(_ context.Context, target webhook.Target, notif notifications.Notification) webhook.Delivery {
+	s.target, s.notif = target, notif
+	return webhook.Delivery{TargetID: target.ID, Status: webhook.DeliveryStatusDelivered, Attempts: 1}
+}
+
+var (
+	webhookUser  = &gen.User{Id: 7, Permissions: []string{"manage_notifications"}}
+	webhookAdmin = &gen.User{Id: 1, Permissions: []string{"manage_notifications", "manage_webhooks"}}
+)
+
+func TestWebhookService_ListWebhookTargets_IncludesGlobalForManagers(t *testing.T) {
+	repo := new(mockWebhookRepository)
+	svc := NewWebhookService(repo, &stubWebhookDeliverer{}, slog.Default())
+	repo.On("GetWebhookTargetsVisibleTo", mock.Anything, 7, false).Return([]webhook.Target{}, nil)
+	repo.On("GetWebhookTargetsVisibleTo", mock.Anything, 1, true).Return([]webhook.Target{}, nil)
+
+	_, err := svc.ServiceListWebhookTargets(context.Background(), webhookUser)
+	require.NoError(t, err)
+	_, err = svc.ServiceListWebhookTargets(context.Background(), webhookAdmin)
+	require.NoError(t, err)
+
+	repo.AssertExpectations(t)
+}
+
+func TestWebhookService_CreateWebhookTarget_OwnedByActor(t *testing.T) {
+	repo := new(mockWebhookRepository)
+	svc := NewWebhookService(repo, &stubWebhookDeliverer{}, slog.Default())
+	repo.On("CreateWebhookTarget", mock.Anything, mock.Anything).Return(nil)
+
+	target := webhook.Target{Name: "phone", URL: "https://ntfy.example.com/me"}
+	require.NoError(t, svc.ServiceCreateWebhookTarget(context.Background(), webhookUser, &target, false))
+
+	require.NotNil(t, target.UserID)
+	assert.Equal(t, 7, *target.UserID)
+}
+
+func TestWebhookService_CreateWebhookTarget_GlobalRequiresPermission(t *testing.T) {
+	repo := new(mockWebhookRepository)
+	svc := NewWebhookService(repo, &stubWebhookDeliverer{}, slog.Default())
+
+	target := webhook.Target{Name: "ops", URL: "https://ops.example.com"}
+	err := svc.ServiceCreateWebhookTarget(context.Background(), webhookUser, &target, true)
+
+	assert.ErrorIs(t, err, ErrGlobalWebhookForbidden)
+	repo.AssertNotCalled(t, "CreateWebhookTarget", mock.Anything, mock.Anything)
+}
+
+func TestWebhookService_UpdateWebhookTarget_KeepsOwnerAndSecret(t *testing.T) {
+	repo := new(mockWebhookRepository)
+	svc := NewWebhookService(repo, &stubWebhookDeliverer{}, slog.Default())
+	owner := 7
+	repo.On("GetWebhookTargetByID", mock.Anything, 3).Return(&webhook.Target{ID: 3, UserID: &owner, Name: "phone", Secret: "s3cret"}, nil)
+	repo.On("UpdateWebhookTarget", mock.Anything, mock.Anything).Return(nil)
+
+	target := webhook.Target{ID: 3, Name: "phone (muted)", URL: "https://ntfy.example.com/me"}
+	require.NoError(t, svc.ServiceUpdateWebhookTarget(context.Background(), webhookUser, &target, nil))
+
+	assert.Equal(t, &owner, target.UserID)
+	assert.Equal(t, "s3cret", target.Secret)
+
+	cleared := ""
+	require.NoError(t, svc.ServiceUpdateWebhookTarget(context.Background(), webhookUser, &target, &cleared))
+	assert.Empty(t, target.Secret)
+}
+
+func TestWebhookService_OtherUsersTargetsAreNotFound(t *testing.T) {
+	repo := new(mockWebhookRepository)
+	svc := NewWebhookService(repo, &stubWebhookDeliverer{}, slog.Default())
+	other := 8
+	repo.On("GetWebhookTargetByID", mock.Anything, 3).Return(&webhook.Target{ID: 3, UserID: &other}, nil)
+	repo.On("GetWebhookTargetByID", mock.Anything, 4).Return(&webhook.Target{ID: 4}, nil)
+
+	assert.ErrorIs(t, svc.ServiceDeleteWebhookTarget(context.Background(), webhookUser, 3), ErrWebhookTargetNotFound)
+	assert.ErrorIs(t, svc.ServiceDeleteWebhookTarget(context.Background(), webhookUser, 4), ErrWebhookTargetNotFound)
+	repo.AssertNotCalled(t, "DeleteWebhookTarget", mock.Anything, mock.Anything)
+}
+
+func TestWebhookService_TestWebhookTarget_DeliversTestNotification(t *testing.T) {
+	repo := new(mockWebhookRepository)
+	deliverer := &stubWebhookDeliverer{}
+	svc := NewWebhookService(repo, deliverer, slog.Default())
+	repo.On("GetWebhookTargetByID", mock.Anything, 4).Return(&webhook.Target{ID: 4, Name: "ops"}, nil)
+
+	delivery, err := svc.ServiceTestWebhookTarget(context.Background(), webhookAdmin, 4)
+
+	require.NoError(t, err)
+	assert.Equal(t, webhook.DeliveryStatusDelivered, delivery.Status)
+	assert.Equal(t, 4, deliverer.target.ID)
+	assert.Equal(t, "Test notification", deliverer.notif.Title)
+}
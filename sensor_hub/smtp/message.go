@@ -0,0 +1,113 @@
+package smtp
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"html/template"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/textproto"
+	"strings"
+	"time"
+)
+
+var htmlBody = template.Must(template.New("email").Parse(`<!DOCTYPE html>
+<html>
+<body style="font-family: sans-serif; color: #222;">
+<h2 style="margin-bottom: 0.5em;">{{.Title}}</h2>
+{{range .Paragraphs}}<p>{{.}}</p>
+{{end}}<p style="color: #777; font-size: small;">Sensor Hub &middot; {{.Category}}</p>
+</body>
+</html>
+`))
+
+// buildMessage returns an RFC 5322 message with text and HTML alternatives of message,
+// with the subject "[category] title".
+func buildMessage(from, to, title, message, category string, date time.Time) ([]byte, error) {
+	var body bytes.Buffer
+	mw := multipart.NewWriter(&body)
+
+	text := message + "\n\n-- \nSensor Hub · " + category + "\n"
+	if err := writePart(mw, "text/plain; charset=utf-8", []byte(text)); err != nil {
+		return nil, err
+	}
+
+	var html bytes.Buffer
+	err := htmlBody.Execute(&html, struct {
+		Title      string
+		Paragraphs []string
+		Category   string
+	}{
+		Title:      title,
+		Paragraphs: paragraphs(message),
+		Category:   category,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := writePart(mw, "text/html; charset=utf-8", html.Bytes()); err != nil {
+		return nil, err
+	}
+	if err := mw.Close(); err != nil {
+		return nil, err
+	}
+
+	var msg bytes.Buffer
+	header := func(name, value string) {
+		fmt.Fprintf(&msg, "%s: %s\r\n", name, value)
+	}
+	header("From", stripLineBreaks(from))
+	header("To", stripLineBreaks(to))
+	header("Subject", mime.QEncoding.Encode("utf-8", fmt.Sprintf("[%s] %s", category, title)))
+	header("Date", date.Format(time.RFC1123Z))
+	header("Message-ID", messageID(from))
+	header("MIME-Version", "1.0")
+	header("Content-Type", "multipart/alternative; boundary="+mw.Boundary())
+	msg.WriteString("\r\n")
+	msg.Write(body.Bytes())
+	return msg.Bytes(), nil
+}
+
+// paragraphs splits message into its non-blank lines.
+func paragraphs(message string) []string {
+	var result []string
+	for _, line := range strings.Split(message, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			result = append(result, line)
+		}
+	}
+	return result
+}
+
+func writePart(mw *multipart.Writer, contentType string, content []byte) error {
+	part, err := mw.CreatePart(textproto.MIMEHeader{
+		"Content-Type":              {contentType},
+		"Content-Transfer-Encoding": {"quoted-printable"},
+	})
+	if err != nil {
+		return err
+	}
+	qp := quotedprintable.NewWriter(part)
+	if _, err := qp.Write(content); err != nil {
+		return err
+	}
+	return qp.Close()
+}
+
+// messageID returns a unique Message-ID in the sender's domain.
+func messageID(from string) string {
+	domain := "sensor-hub.local"
+	if at := strings.LastIndex(from, "@"); at >= 0 && at < len(from)-1 {
+		domain = strings.Trim(from[at+1:], "> \r\n")
+	}
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return fmt.Sprintf("<%s@%s>", hex.EncodeToString(b), domain)
+}
+
+func stripLineBreaks(s string) string {
+	return strings.NewReplacer("\r", "", "\n", "").Replace(s)
+}
@@ -0,0 +1,60 @@
+package smtp
+
+import (
+	"bytes"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/mail"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildMessage_MultipartAlternative(t *testing.T) {
+	date := time.Date(2026, 1, 5, 7, 12, 0, 0, time.UTC)
+	raw, err := buildMessage("hub@example.com", "alice@example.com", "Alert: <Kühlschrank>",
+		"temperature is above 5\nvalue: 7.20", "threshold_alert", date)
+	require.NoError(t, err)
+
+	msg, err := mail.ReadMessage(bytes.NewReader(raw))
+	require.NoError(t, err)
+	subject, err := new(mime.WordDecoder).DecodeHeader(msg.Header.Get("Subject"))
+	require.NoError(t, err)
+	assert.Equal(t, "[threshold_alert] Alert: <Kühlschrank>", subject)
+	assert.Equal(t, "Mon, 05 Jan 2026 07:12:00 +0000", msg.Header.Get("Date"))
+	assert.Regexp(t, `^<[0-9a-f]{32}@example\.com>$`, msg.Header.Get("Message-ID"))
+
+	mediaType, params, err := mime.ParseMediaType(msg.Header.Get("Content-Type"))
+	require.NoError(t, err)
+	assert.Equal(t, "multipart/alternative", mediaType)
+
+	parts := map[string]string{}
+	mr := multipart.NewReader(msg.Body, params["boundary"])
+	for {
+		part, err := mr.NextPart()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		body, err := io.ReadAll(part) // NextPart decodes quoted-printable
+		require.NoError(t, err)
+		contentType, _, _ := mime.ParseMediaType(part.Header.Get("Content-Type"))
+		parts[contentType] = string(body)
+	}
+	require.Len(t, parts, 2)
+	assert.Contains(t, parts["text/plain"], "temperature is above 5\r\nvalue: 7.20")
+	assert.Contains(t, parts["text/html"], "<h2 style=\"margin-bottom: 0.5em;\">Alert: &lt;Kühlschrank&gt;</h2>")
+	assert.Contains(t, parts["text/html"], "<p>value: 7.20</p>")
+}
+
+func TestBuildMessage_StripsHeaderLineBreaks(t *testing.T) {
+	raw, err := buildMessage("hub@example.com", "alice@example.com\r\nBcc: mallory@example.com", "Alert", "hi", "threshold_alert", time.Now())
+	require.NoError(t, err)
+
+	msg, err := mail.ReadMessage(bytes.NewReader(raw))
+	require.NoError(t, err)
+	assert.Empty(t, msg.Header.Get("Bcc"))
+}
@@ -1,51 +1,215 @@
 package smtp
 
 import (
+	"crypto/tls"
+	"crypto/x509"
 	"fmt"
 	"log/slog"
+	"net"
+	"net/mail"
 	"net/smtp"
+	"os"
+	"strconv"
+	"strings"
+	"time"
 
 	appProps "example/sensorHub/application_properties"
 	"example/sensorHub/oauth"
 )
 
+// Authentication mechanisms, selected with smtp.auth.
+const (
+	AuthOAuth = "oauth"
+	AuthPlain = "plain"
+	AuthLogin = "login"
+	AuthNone  = "none"
+)
+
+// Transport security modes, selected with smtp.tls.
+const (
+	TLSStartTLS = "starttls"
+	TLSImplicit = "implicit"
+	TLSNone     = "none"
+)
+
+const (
+	defaultHost = "smtp.gmail.com"
+	// sendTimeout bounds the whole SMTP conversation for one message.
+	sendTimeout = 30 * time.Second
+)
+
 type SMTPNotifier struct {
 	logger *slog.Logger
+	// rootCAs verifies the server certificate; nil uses the system pool. Set in tests.
+	rootCAs *x509.CertPool
+	// helloName is the host name announced in EHLO/HELO.
+	helloName string
+	now       func() time.Time
 }
 
 func NewSMTPNotifier(logger *slog.Logger) *SMTPNotifier {
-	return &SMTPNotifier{logger: logger.With("component", "smtp_notifier")}
+	return &SMTPNotifier{logger: logger.With("component", "smtp_notifier"), helloName: localHostname(), now: time.Now}
 }
 
-func (n *SMTPNotifier) SendNotification(recipient, title, message, category string) error {
-	if !oauth.OauthSet {
-		n.logger.Warn("OAuth not configured, skipping notification email", "recipient", recipient)
-		return nil
+// localHostname returns this machine's host name for EHLO, falling back to "localhost"
+// when it is unknown or could not be sent on the command line.
+func localHostname() string {
+	name, err := os.Hostname()
+	if err != nil || name == "" || strings.ContainsAny(name, " \r\n") {
+		return "localhost"
 	}
+	return name
+}
 
-	// Get current token from OAuth service (may have been refreshed)
-	token := oauth.OauthToken
-	if token == nil {
-		n.logger.Warn("OAuth token is nil, skipping notification email", "recipient", recipient)
-		return nil
+// settings is the SMTP configuration in effect for one message.
+type settings struct {
+	host     string
+	port     int
+	auth     string
+	tls      string
+	user     string
+	password string
+	from     string
+}
+
+func currentSettings() settings {
+	s := settings{host: defaultHost, auth: AuthOAuth, tls: TLSStartTLS}
+	if cfg := appProps.AppConfig; cfg != nil {
+		if cfg.SMTPHost != "" {
+			s.host = cfg.SMTPHost
+		}
+		if cfg.SMTPAuth != "" {
+			s.auth = cfg.SMTPAuth
+		}
+		if cfg.SMTPTLS != "" {
+			s.tls = cfg.SMTPTLS
+		}
+		s.port = cfg.SMTPPort
+		s.user = cfg.SMTPUser
+		s.password = cfg.SMTPPassword
+		s.from = cfg.SMTPFrom
+	}
+	if s.port <= 0 {
+		s.port = 587
+		if s.tls == TLSImplicit {
+			s.port = 465
+		}
+	}
+	if s.from == "" {
+		s.from = s.user
 	}
+	return s
+}
 
-	auth := &oauth.XOauth2Auth{
-		Username:    appProps.AppConfig.SMTPUser,
-		AccessToken: token.AccessToken,
+func (n *SMTPNotifier) SendNotification(recipient, title, message, category string) error {
+	s := currentSettings()
+	if s.from == "" {
+		n.logger.Warn("no sender address configured, skipping notification email", "recipient", recipient)
+		return nil
+	}
+	// smtp.from may include a display name ("Sensor Hub <hub@example.com>"); only the
+	// address goes in MAIL FROM
+	sender, err := mail.ParseAddress(s.from)
+	if err != nil {
+		return fmt.Errorf("invalid sender address %q: %w", s.from, err)
 	}
 
-	subject := fmt.Sprintf("[%s] %s", category, title)
-	msg := "From: " + appProps.AppConfig.SMTPUser + "\n" +
-		"To: " + recipient + "\n" +
-		"Subject: " + subject + "\n\n" +
-		message
+	var auth smtp.Auth
+	switch s.auth {
+	case AuthOAuth:
+		if !oauth.OauthSet {
+			n.logger.Warn("OAuth not configured, skipping notification email", "recipient", recipient)
+			return nil
+		}
+		// Get current token from OAuth service (may have been refreshed)
+		token := oauth.OauthToken
+		if token == nil {
+			n.logger.Warn("OAuth token is nil, skipping notification email", "recipient", recipient)
+			return nil
+		}
+		auth = &oauth.XOauth2Auth{Username: s.user, AccessToken: token.AccessToken}
+	case AuthPlain:
+		auth = smtp.PlainAuth("", s.user, s.password, s.host)
+	case AuthLogin:
+		auth = &loginAuth{username: s.user, password: s.password, host: s.host}
+	case AuthNone:
+	default:
+		return fmt.Errorf("unsupported smtp.auth %q", s.auth)
+	}
 
-	err := smtp.SendMail("smtp.gmail.com:587", auth, appProps.AppConfig.SMTPUser, []string{recipient}, []byte(msg))
+	msg, err := buildMessage(sender.String(), recipient, title, message, category, n.now())
 	if err != nil {
+		return fmt.Errorf("failed to build notification email: %w", err)
+	}
+	if err := n.send(s, auth, sender.Address, recipient, msg); err != nil {
 		return fmt.Errorf("failed to send notification email via SMTP: %w", err)
 	}
 
-	n.logger.Info("notification email sent", "recipient", recipient, "title", title)
+	n.logger.Info("notification email sent", "recipient", recipient, "title", title, "host", s.host)
 	return nil
 }
+
+// send delivers msg from the sender address over one SMTP connection, negotiating TLS as
+// configured.
+func (n *SMTPNotifier) send(s settings, auth smtp.Auth, sender, recipient string, msg []byte) error {
+	addr := net.JoinHostPort(s.host, strconv.Itoa(s.port))
+	tlsConfig := &tls.Config{ServerName: s.host, RootCAs: n.rootCAs}
+	dialer := &net.Dialer{Timeout: sendTimeout}
+
+	var conn net.Conn
+	var err error
+	if s.tls == TLSImplicit {
+		conn, err = tls.DialWithDialer(dialer, "tcp", addr, tlsConfig)
+	} else {
+		conn, err = dialer.Dial("tcp", addr)
+	}
+	if err != nil {
+		return err
+	}
+	_ = conn.SetDeadline(time.Now().Add(sendTimeout))
+
+	c, err := smtp.NewClient(conn, s.host)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	defer c.Close()
+
+	if err := c.Hello(n.helloName); err != nil {
+		return err
+	}
+	if s.tls == TLSStartTLS {
+		if ok, _ := c.Extension("STARTTLS"); !ok {
+			return fmt.Errorf("server %s does not support STARTTLS", s.host)
+		}
+		if err := c.StartTLS(tlsConfig); err != nil {
+			return err
+		}
+	}
+	if auth != nil {
+		if ok, _ := c.Extension("AUTH"); !ok {
+			return fmt.Errorf("server %s does not support authentication", s.host)
+		}
+		if err := c.Auth(auth); err != nil {
+			return err
+		}
+	}
+
+	if err := c.Mail(sender); err != nil {
+		return err
+	}
+	if err := c.Rcpt(recipient); err != nil {
+		return err
+	}
+	w, err := c.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(msg); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	return c.Quit()
+}
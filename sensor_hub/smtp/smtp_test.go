@@ -1,12 +1,21 @@
 package smtp
 
 import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
 	"log/slog"
+	"net"
+	"net/http/httptest"
+	"net/textproto"
+	"strings"
+	"sync"
 	"testing"
 
 	appProps "example/sensorHub/application_properties"
 	"example/sensorHub/oauth"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"golang.org/x/oauth2"
 )
 
@@ -51,3 +60,257 @@ func TestSMTPNotifier_SendNotification_WithOAuth(t *testing.T) {
 	// Will fail because we're not actually connected to SMTP
 	assert.Error(t, err)
 }
+
+type receivedMail struct {
+	from, to string
+	data     []byte
+}
+
+// fakeSMTPServer is a minimal local SMTP stand-in. It offers STARTTLS when it has a
+// certificate and the connection is not yet encrypted, accepts AUTH PLAIN and LOGIN, and
+// records the credentials and messages it receives.
+type fakeSMTPServer struct {
+	port      int
+	tlsConfig *tls.Config
+	mu        sync.Mutex
+	creds     []string
+	tlsUsed   bool
+	helloName string
+	mail      []receivedMail
+}
+
+// startFakeSMTPServer starts a server; mode is "plain", "starttls" or "implicit". The
+// returned pool trusts the server's certificate.
+func startFakeSMTPServer(t *testing.T, mode string) (*fakeSMTPServer, *x509.CertPool) {
+	t.Helper()
+	certServer := httptest.NewUnstartedServer(nil)
+	certServer.StartTLS()
+	cert := certServer.TLS.Certificates[0]
+	pool := x509.NewCertPool()
+	pool.AddCert(certServer.Certificate())
+	certServer.Close()
+
+	s := &fakeSMTPServer{}
+	if mode != "plain" {
+		s.tlsConfig = &tls.Config{Certificates: []tls.Certificate{cert}}
+	}
+	var ln net.Listener
+	var err error
+	if mode == "implicit" {
+		ln, err = tls.Listen("tcp", "127.0.0.1:0", s.tlsConfig)
+	} else {
+		ln, err = net.Listen("tcp", "127.0.0.1:0")
+	}
+	require.NoError(t, err)
+	t.Cleanup(func() { ln.Close() })
+	s.port = ln.Addr().(*net.TCPAddr).Port
+
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go s.handle(conn, mode == "implicit")
+		}
+	}()
+	return s, pool
+}
+
+func (s *fakeSMTPServer) handle(conn net.Conn, encrypted bool) {
+	defer func() { conn.Close() }()
+	tp := textproto.NewConn(conn)
+	_ = tp.PrintfLine("220 localhost ESMTP test")
+	var from, to string
+	for {
+		line, err := tp.ReadLine()
+		if err != nil {
+			return
+		}
+		verb, arg, _ := strings.Cut(line, " ")
+		switch strings.ToUpper(verb) {
+		case "EHLO", "HELO":
+			s.mu.Lock()
+			s.helloName = arg
+			s.mu.Unlock()
+			_ = tp.PrintfLine("250-localhost")
+			if s.tlsConfig != nil && !encrypted {
+				_ = tp.PrintfLine("250-STARTTLS")
+			}
+			_ = tp.PrintfLine("250 AUTH PLAIN LOGIN")
+		case "STARTTLS":
+			_ = tp.PrintfLine("220 Ready to start TLS")
+			tlsConn := tls.Server(conn, s.tlsConfig)
+			if err := tlsConn.Handshake(); err != nil {
+				return
+			}
+			conn, encrypted = tlsConn, true
+			tp = textproto.NewConn(conn)
+		case "AUTH":
+			mech, initial, _ := strings.Cut(arg, " ")
+			var cred string
+			if mech == "PLAIN" {
+				decoded, _ := base64.StdEncoding.DecodeString(initial)
+				cred = "PLAIN " + string(decoded)
+			} else {
+				_ = tp.PrintfLine("334 %s", base64.StdEncoding.EncodeToString([]byte("Username:")))
+				user, _ := tp.ReadLine()
+				_ = tp.PrintfLine("334 %s", base64.StdEncoding.EncodeToString([]byte("Password:")))
+				pass, _ := tp.ReadLine()
+				u, _ := base64.StdEncoding.DecodeString(user)
+				p, _ := base64.StdEncoding.DecodeString(pass)
+				cred = "LOGIN " + string(u) + ":" + string(p)
+			}
+			s.mu.Lock()
+			s.creds = append(s.creds, cred)
+			s.mu.Unlock()
+			_ = tp.PrintfLine("235 Authentication successful")
+		case "MAIL":
+			from = strings.Trim(strings.TrimPrefix(arg, "FROM:"), "<>")
+			_ = tp.PrintfLine("250 OK")
+		case "RCPT":
+			to = strings.Trim(strings.TrimPrefix(arg, "TO:"), "<>")
+			_ = tp.PrintfLine("250 OK")
+		case "DATA":
+			_ = tp.PrintfLine("354 End data with <CR><LF>.<CR><LF>")
+			data, err := tp.ReadDotBytes()
+			if err != nil {
+				return
+			}
+			s.mu.Lock()
+			s.mail = append(s.mail, receivedMail{from: from, to: to, data: data})
+			s.tlsUsed = encrypted
+			s.mu.Unlock()
+			_ = tp.PrintfLine("250 OK: queued")
+		case "QUIT":
+			_ = tp.PrintfLine("221 Bye")
+			return
+		default:
+			_ = tp.PrintfLine("502 Command not implemented")
+		}
+	}
+}
+
+func (s *fakeSMTPServer) received() ([]receivedMail, []string, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]receivedMail(nil), s.mail...), append([]string(nil), s.creds...), s.tlsUsed
+}
+
+func useSMTPConfig(t *testing.T, cfg *appProps.ApplicationConfiguration) {
+	t.Helper()
+	original := appProps.AppConfig
+	appProps.AppConfig = cfg
+	t.Cleanup(func() { appProps.AppConfig = original })
+}
+
+func TestSMTPNotifier_SendNotification_StartTLSPlainAuth(t *testing.T) {
+	server, pool := startFakeSMTPServer(t, "starttls")
+	useSMTPConfig(t, &appProps.ApplicationConfiguration{
+		SMTPHost: "127.0.0.1", SMTPPort: server.port, SMTPAuth: AuthPlain, SMTPTLS: TLSStartTLS,
+		SMTPUser: "relay-user", SMTPPassword: "s3cret", SMTPFrom: "sensor-hub@example.com",
+	})
+	notifier := NewSMTPNotifier(slog.Default())
+	notifier.rootCAs = pool
+
+	err := notifier.SendNotification("alice@example.com", "Alert: garage", "temperature is above 30 (value: 35.00)", "threshold_alert")
+
+	require.NoError(t, err)
+	mail, creds, tlsUsed := server.received()
+	require.Len(t, mail, 1)
+	assert.True(t, tlsUsed)
+	assert.Equal(t, []string{"PLAIN \x00relay-user\x00s3cret"}, creds)
+	assert.Equal(t, "sensor-hub@example.com", mail[0].from)
+	assert.Equal(t, "alice@example.com", mail[0].to)
+	assert.Contains(t, string(mail[0].data), "Subject: [threshold_alert] Alert: garage")
+}
+
+func TestSMTPNotifier_SendNotification_ImplicitTLSLoginAuth(t *testing.T) {
+	server, pool := startFakeSMTPServer(t, "implicit")
+	useSMTPConfig(t, &appProps.ApplicationConfiguration{
+		SMTPHost: "127.0.0.1", SMTPPort: server.port, SMTPAuth: AuthLogin, SMTPTLS: TLSImplicit,
+		SMTPUser: "hub@example.com", SMTPPassword: "s3cret",
+	})
+	notifier := NewSMTPNotifier(slog.Default())
+	notifier.rootCAs = pool
+
+	err := notifier.SendNotification("alice@example.com", "Alert: garage", "too hot", "threshold_alert")
+
+	require.NoError(t, err)
+	mail, creds, tlsUsed := server.received()
+	require.Len(t, mail, 1)
+	assert.True(t, tlsUsed)
+	assert.Equal(t, []string{"LOGIN hub@example.com:s3cret"}, creds)
+	assert.Equal(t, "hub@example.com", mail[0].from, "smtp.user is the sender when smtp.from is empty")
+}
+
+func TestSMTPNotifier_SendNotification_UnauthenticatedRelay(t *testing.T) {
+	server, _ := startFakeSMTPServer(t, "plain")
+	useSMTPConfig(t, &appProps.ApplicationConfiguration{
+		SMTPHost: "127.0.0.1", SMTPPort: server.port, SMTPAuth: AuthNone, SMTPTLS: TLSNone,
+		SMTPFrom: "sensor-hub@example.com",
+	})
+
+	err := NewSMTPNotifier(slog.Default()).SendNotification("alice@example.com", "Alert: garage", "too hot", "threshold_alert")
+
+	require.NoError(t, err)
+	mail, creds, tlsUsed := server.received()
+	require.Len(t, mail, 1)
+	assert.False(t, tlsUsed)
+	assert.Empty(t, creds)
+}
+
+func TestSMTPNotifier_SendNotification_StartTLSNotOffered(t *testing.T) {
+	server, _ := startFakeSMTPServer(t, "plain")
+	useSMTPConfig(t, &appProps.ApplicationConfiguration{
+		SMTPHost: "127.0.0.1", SMTPPort: server.port, SMTPAuth: AuthPlain, SMTPTLS: TLSStartTLS,
+		SMTPUser: "relay@example.com", SMTPPassword: "s3cret",
+	})
+
+	err := NewSMTPNotifier(slog.Default()).SendNotification("alice@example.com", "Alert: garage", "too hot", "threshold_alert")
+
+	assert.ErrorContains(t, err, "does not support STARTTLS")
+	mail, creds, _ := server.received()
+	assert.Empty(t, mail)
+	assert.Empty(t, creds)
+}
+
+func TestSMTPNotifier_SendNotification_SenderWithDisplayName(t *testing.T) {
+	server, _ := startFakeSMTPServer(t, "plain")
+	useSMTPConfig(t, &appProps.ApplicationConfiguration{
+		SMTPHost: "127.0.0.1", SMTPPort: server.port, SMTPAuth: AuthNone, SMTPTLS: TLSNone,
+		SMTPFrom: "Sensor Hub <sensor-hub@example.com>",
+	})
+	notifier := NewSMTPNotifier(slog.Default())
+	notifier.helloName = "hub.example.com"
+
+	err := notifier.SendNotification("alice@example.com", "Alert: garage", "too hot", "threshold_alert")
+
+	require.NoError(t, err)
+	mail, _, _ := server.received()
+	require.Len(t, mail, 1)
+	assert.Equal(t, "sensor-hub@example.com", mail[0].from)
+	assert.Contains(t, string(mail[0].data), `From: "Sensor Hub" <sensor-hub@example.com>`)
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	assert.Equal(t, "hub.example.com", server.helloName)
+}
+
+func TestSMTPNotifier_SendNotification_InvalidSender(t *testing.T) {
+	useSMTPConfig(t, &appProps.ApplicationConfiguration{
+		SMTPHost: "127.0.0.1", SMTPPort: 1, SMTPAuth: AuthNone, SMTPTLS: TLSNone,
+		SMTPFrom: "Sensor Hub <sensor-hub",
+	})
+
+	err := NewSMTPNotifier(slog.Default()).SendNotification("alice@example.com", "Alert", "too hot", "threshold_alert")
+
+	assert.ErrorContains(t, err, "invalid sender address")
+}
+
+func TestSMTPNotifier_SendNotification_NoSender(t *testing.T) {
+	useSMTPConfig(t, &appProps.ApplicationConfiguration{SMTPAuth: AuthNone})
+
+	err := NewSMTPNotifier(slog.Default()).SendNotification("alice@example.com", "Alert", "too hot", "threshold_alert")
+
+	assert.NoError(t, err)
+}
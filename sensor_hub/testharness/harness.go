@@ -19,13 +19,17 @@ import (
 	"example/sensorHub/api"
 	"example/sensorHub/api/middleware"
 	appProps "example/sensorHub/application_properties"
+	"example/sensorHub/automation"
+	"example/sensorHub/computed"
 	database "example/sensorHub/db"
 	_ "example/sensorHub/drivers" // register sensor drivers
 	gen "example/sensorHub/gen"
 	mqttpkg "example/sensorHub/mqtt"
 	"example/sensorHub/notifications"
+	"example/sensorHub/push"
 	"example/sensorHub/service"
 	"example/sensorHub/smtp"
+	"example/sensorHub/webhook"
 	"example/sensorHub/ws"
 
 	"github.com/gin-gonic/gin"
@@ -123,10 +127,22 @@ func startServer(sensorURLs []string) (*Env, func(), error) {
 	wsBroadcaster := ws.NewNotificationBroadcaster(logger)
 	notificationService := service.NewNotificationService(notificationRepo, wsBroadcaster, logger)
 	notificationService.SetEmailNotifier(smtpNotifier)
+	webhookRepo := database.NewWebhookRepository(db, logger)
+	webhookNotifier := webhook.NewNotifier(webhookRepo, logger)
+	notificationService.SetWebhookNotifier(webhookNotifier)
+	pushRepo := database.NewPushRepository(db, logger)
+	pushNotifier := push.NewNotifier(pushRepo, logger)
+	notificationService.SetPushNotifier(pushNotifier)
+	digestRepo := database.NewDigestRepository(db, logger)
+	digestService := service.NewDigestService(digestRepo, smtpNotifier, logger)
+	notificationService.SetDeferrer(digestService)
 
 	wsCapture := &RecordingWSNotifier{}
 	emailCapture := &RecordingEmailNotifier{}
-	thresholdProcessor := alerting.NewThresholdAlertProcessor(alertRepo, &harnessNotifRepoAdapter{notificationRepo}, wsCapture, emailCapture, logger)
+	thresholdProcessor := alerting.NewThresholdAlertProcessor(alertRepo, readingsRepo, &harnessNotifRepoAdapter{notificationRepo}, wsCapture, emailCapture, logger)
+	thresholdProcessor.SetWebhookNotifier(webhookNotifier)
+	thresholdProcessor.SetPushNotifier(pushNotifier)
+	thresholdProcessor.SetDeferrer(digestService)
 	sensorService := service.NewSensorService(sensorRepo, readingsRepo, mtRepo, thresholdProcessor, notificationService, logger)
 
 	tiers := service.DefaultAggregationTiers
@@ -139,6 +155,8 @@ func startServer(sensorURLs []string) (*Env, func(), error) {
 	authService := service.NewAuthService(userRepo, sessionRepo, failedRepo, roleRepo, logger)
 	roleService := service.NewRoleService(roleRepo, logger)
 	alertManagementService := service.NewAlertManagementService(alertRepo, logger)
+	webhookService := service.NewWebhookService(webhookRepo, webhookNotifier, logger)
+	pushService := service.NewPushService(pushRepo, pushNotifier, logger)
 	apiKeyService := service.NewApiKeyService(apiKeyRepo, userRepo, roleRepo, logger)
 
 	// Init middleware
@@ -157,7 +175,24 @@ func startServer(sensorURLs []string) (*Env, func(), error) {
 	mqttService.SetSubscriptionNotifier(connManager)
 	commandTracker := actuation.NewCommandTracker(commandHistoryRepo, ws.NewCommandStatusBroadcaster(logger), logger)
 	commandService := service.NewCommandService(sensorRepo, mqttSubRepo, commandHistoryRepo, connManager, commandTracker, logger)
-	sensorService.SetReadingsObserver(commandTracker)
+	sensorService.AddReadingsObserver(commandTracker)
+	sceneRepo := database.NewSceneRepository(db, logger)
+	sceneService := service.NewSceneService(sceneRepo, sensorRepo, commandService, ws.NewSceneStatusBroadcaster(logger), logger)
+	commandTracker.AddStatusObserver(sceneService)
+	desiredStateService := service.NewDesiredStateService(database.NewDesiredStateRepository(db, logger), sensorRepo, commandService, logger)
+	commandService.AddSentObserver(desiredStateService)
+	commandTracker.AddStatusObserver(desiredStateService)
+	sensorService.AddReadingsObserver(desiredStateService)
+	automationRepo := database.NewAutomationRepository(db, logger)
+	scheduleRepo := database.NewScheduleRepository(db, logger)
+	automationEngine := automation.NewEngine(automationRepo, scheduleRepo, commandService, sceneService, logger)
+	sensorService.AddReadingsObserver(automationEngine)
+	thresholdProcessor.SetAlertListener(automationEngine)
+	computedEngine := computed.NewEngine(sensorRepo, readingsRepo, sensorService, logger)
+	sensorService.AddReadingsObserver(computedEngine)
+	sensorService.AddSensorsObserver(computedEngine)
+	automationService := service.NewAutomationService(automationRepo, sensorRepo, alertRepo, sceneRepo, logger)
+	scheduleService := service.NewScheduleService(scheduleRepo, sensorRepo, commandService, logger)
 	if err := commandTracker.RecoverPending(context.Background()); err != nil {
 		db.Close()
 		cleanupDir()
@@ -167,12 +202,19 @@ func startServer(sensorURLs []string) (*Env, func(), error) {
 	server := api.NewServer(
 		sensorService,
 		commandService,
+		automationService,
+		scheduleService,
+		sceneService,
+		desiredStateService,
 		readingsService,
 		authService,
 		userService,
 		roleService,
 		alertManagementService,
 		notificationService,
+		webhookService,
+		pushService,
+		digestService,
 		apiKeyService,
 		dashboardService,
 		propertiesService,
@@ -308,6 +350,14 @@ func (a *harnessNotifRepoAdapter) AssignNotificationToUsersWithPermission(ctx co
 	return a.repo.AssignNotificationToUsersWithPermission(ctx, notifID, permission)
 }
 
+func (a *harnessNotifRepoAdapter) AssignNotificationToUser(ctx context.Context, userID, notifID int) error {
+	return a.repo.AssignNotificationToUser(ctx, userID, notifID)
+}
+
+func (a *harnessNotifRepoAdapter) GetUserIDsWithRole(ctx context.Context, role string) ([]int, error) {
+	return a.repo.GetUserIDsWithRole(ctx, role)
+}
+
 func (a *harnessNotifRepoAdapter) GetUserIDsWithPermission(ctx context.Context, permission string) ([]int, error) {
 	return a.repo.GetUserIDsWithPermission(ctx, permission)
 }
@@ -324,6 +374,18 @@ func (a *harnessNotifRepoAdapter) GetUsersWithPermissionAndEmail(ctx context.Con
 	return result, nil
 }
 
+func (a *harnessNotifRepoAdapter) GetUsersWithEmail(ctx context.Context, userIDs []int) ([]alerting.UserEmailInfo, error) {
+	users, err := a.repo.GetUsersWithEmail(ctx, userIDs)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]alerting.UserEmailInfo, len(users))
+	for i, u := range users {
+		result[i] = alerting.UserEmailInfo{UserID: u.UserID, Email: u.Email}
+	}
+	return result, nil
+}
+
 func (a *harnessNotifRepoAdapter) GetChannelPreference(ctx context.Context, userID int, category notifications.NotificationCategory) (*notifications.ChannelPreference, error) {
 	return a.repo.GetChannelPreference(ctx, userID, category)
 }
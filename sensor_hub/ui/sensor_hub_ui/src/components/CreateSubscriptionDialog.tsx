@@ -15,6 +15,8 @@ interface Props {
 
 const KNOWN_DRIVERS = [
   { value: 'mqtt-zigbee2mqtt', label: 'Zigbee2MQTT' },
+  { value: 'mqtt-rtl433', label: 'rtl_433' },
+  { value: 'mqtt-homeassistant', label: 'Home Assistant MQTT discovery' },
 ];
 
 export default function CreateSubscriptionDialog({ open, onClose, onCreated }: Props) {
@@ -0,0 +1,155 @@
+package webhook
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"text/template"
+	"time"
+
+	"example/sensorHub/notifications"
+)
+
+// Target is an outbound webhook that notifications are POSTed to. A target with a UserID
+// belongs to that user and receives their notifications when their webhook channel is
+// enabled for the category; a target without one is global and receives every
+// notification in Categories (all categories when empty).
+type Target struct {
+	ID           int                                  `json:"id"`
+	UserID       *int                                 `json:"user_id"`
+	Name         string                               `json:"name"`
+	URL          string                               `json:"url"`
+	Secret       string                               `json:"-"`
+	Headers      map[string]string                    `json:"headers"`
+	BodyTemplate string                               `json:"body_template"`
+	Categories   []notifications.NotificationCategory `json:"categories"`
+	Enabled      bool                                 `json:"enabled"`
+}
+
+// DeliveryStatus is the final outcome of delivering one notification to a target.
+type DeliveryStatus string
+
+const (
+	DeliveryStatusDelivered DeliveryStatus = "delivered"
+	DeliveryStatusFailed    DeliveryStatus = "failed"
+)
+
+// Delivery is a delivery log entry: the outcome of sending one notification to one
+// target, after any retries. ResponseCode is nil when no response was received.
+type Delivery struct {
+	ID             int            `json:"id"`
+	TargetID       int            `json:"target_id"`
+	NotificationID *int           `json:"notification_id"`
+	Status         DeliveryStatus `json:"status"`
+	Attempts       int            `json:"attempts"`
+	ResponseCode   *int           `json:"response_code"`
+	Error          string         `json:"error"`
+	CreatedAt      time.Time      `json:"created_at"`
+}
+
+// Payload is the data a body template is executed against.
+type Payload struct {
+	ID        int                    `json:"id"`
+	Category  string                 `json:"category"`
+	Severity  string                 `json:"severity"`
+	Title     string                 `json:"title"`
+	Message   string                 `json:"message"`
+	Metadata  map[string]interface{} `json:"metadata,omitempty"`
+	CreatedAt time.Time              `json:"created_at"`
+	Target    string                 `json:"target"`
+}
+
+// templateFuncs are available to body templates. json encodes a value as JSON so that
+// strings can be embedded in a JSON body safely.
+var templateFuncs = template.FuncMap{
+	"json": func(v interface{}) (string, error) {
+		b, err := json.Marshal(v)
+		return string(b), err
+	},
+}
+
+func (t *Target) Validate() error {
+	if strings.TrimSpace(t.Name) == "" {
+		return fmt.Errorf("name is required")
+	}
+	u, err := url.Parse(t.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("url must be an absolute http or https URL")
+	}
+	for name := range t.Headers {
+		if !validHeaderName(name) {
+			return fmt.Errorf("invalid header name %q", name)
+		}
+	}
+	for _, category := range t.Categories {
+		if !notifications.IsValidCategory(category) {
+			return fmt.Errorf("invalid category: %s", category)
+		}
+	}
+	if _, err := parseBodyTemplate(t.BodyTemplate); err != nil {
+		return fmt.Errorf("invalid body template: %w", err)
+	}
+	return nil
+}
+
+// ReceivesCategory reports whether the target takes notifications of category.
+func (t *Target) ReceivesCategory(category notifications.NotificationCategory) bool {
+	if len(t.Categories) == 0 {
+		return true
+	}
+	for _, c := range t.Categories {
+		if c == category {
+			return true
+		}
+	}
+	return false
+}
+
+// RenderBody builds the request body for notif. An empty body template sends the payload
+// as JSON.
+func (t *Target) RenderBody(notif notifications.Notification) ([]byte, error) {
+	if notif.CreatedAt.IsZero() {
+		notif.CreatedAt = time.Now().UTC()
+	}
+	payload := Payload{
+		ID:        notif.ID,
+		Category:  string(notif.Category),
+		Severity:  string(notif.Severity),
+		Title:     notif.Title,
+		Message:   notif.Message,
+		Metadata:  notif.Metadata,
+		CreatedAt: notif.CreatedAt,
+		Target:    t.Name,
+	}
+	if t.BodyTemplate == "" {
+		return json.Marshal(payload)
+	}
+	tmpl, err := parseBodyTemplate(t.BodyTemplate)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, payload); err != nil {
+		return nil, fmt.Errorf("failed to render body template: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+func parseBodyTemplate(body string) (*template.Template, error) {
+	return template.New("body").Funcs(templateFuncs).Option("missingkey=zero").Parse(body)
+}
+
+func validHeaderName(name string) bool {
+	if name == "" || http.CanonicalHeaderKey(name) == "Content-Length" || http.CanonicalHeaderKey(name) == "Host" {
+		return false
+	}
+	for _, r := range name {
+		if r <= ' ' || r >= 0x7f || strings.ContainsRune("()<>@,;:\\\"/[]?={}", r) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,51 @@
+package webhook
+
+import (
+	"testing"
+
+	"example/sensorHub/notifications"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTarget_Validate(t *testing.T) {
+	valid := func() Target {
+		return Target{Name: "ops", URL: "https://hooks.example.com/sensor-hub", Headers: map[string]string{"Authorization": "Bearer x"}}
+	}
+
+	tests := []struct {
+		name    string
+		mutate  func(*Target)
+		wantErr string
+	}{
+		{"valid", func(*Target) {}, ""},
+		{"missing name", func(tg *Target) { tg.Name = " " }, "name is required"},
+		{"relative url", func(tg *Target) { tg.URL = "/hook" }, "url must be"},
+		{"unsupported scheme", func(tg *Target) { tg.URL = "ftp://example.com/hook" }, "url must be"},
+		{"bad header name", func(tg *Target) { tg.Headers = map[string]string{"Bad Header": "x"} }, "invalid header name"},
+		{"reserved header", func(tg *Target) { tg.Headers = map[string]string{"content-length": "1"} }, "invalid header name"},
+		{"bad category", func(tg *Target) { tg.Categories = []notifications.NotificationCategory{"nope"} }, "invalid category"},
+		{"bad template", func(tg *Target) { tg.BodyTemplate = "{{.Title" }, "invalid body template"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := valid()
+			tt.mutate(&target)
+			err := target.Validate()
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestTarget_ReceivesCategory(t *testing.T) {
+	all := Target{}
+	alerts := Target{Categories: []notifications.NotificationCategory{notifications.CategoryThresholdAlert}}
+
+	assert.True(t, all.ReceivesCategory(notifications.CategoryConfigChange))
+	assert.True(t, alerts.ReceivesCategory(notifications.CategoryThresholdAlert))
+	assert.False(t, alerts.ReceivesCategory(notifications.CategoryConfigChange))
+}
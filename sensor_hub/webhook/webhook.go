@@ -0,0 +1,192 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"sync"
+	"time"
+
+	appProps "example/sensorHub/application_properties"
+	"example/sensorHub/notifications"
+)
+
+// SignatureHeader carries the hex HMAC-SHA256 of the request body, keyed with the
+// target's secret and prefixed with "sha256=". It is only sent when the target has a
+// secret.
+const SignatureHeader = "X-SensorHub-Signature-256"
+
+const (
+	defaultTimeoutSeconds = 10
+	defaultMaxAttempts    = 3
+)
+
+// Repository is the subset of db.WebhookRepository needed by Notifier.
+// Defined locally to avoid an import cycle (db imports webhook).
+type Repository interface {
+	GetWebhookTargetsForUsers(ctx context.Context, userIDs []int) ([]Target, error)
+	RecordWebhookDelivery(ctx context.Context, delivery *Delivery) error
+}
+
+// Notifier delivers notifications to webhook targets and records each delivery in the
+// delivery log.
+type Notifier struct {
+	repo   Repository
+	client *http.Client
+	logger *slog.Logger
+	// sleep waits between attempts; replaced in tests.
+	sleep func(ctx context.Context, d time.Duration) error
+}
+
+func NewNotifier(repo Repository, logger *slog.Logger) *Notifier {
+	return &Notifier{
+		repo:   repo,
+		client: &http.Client{},
+		logger: logger.With("component", "webhook_notifier"),
+		sleep:  sleepContext,
+	}
+}
+
+// SendNotification delivers notif to every enabled global target and every enabled
+// target belonging to userIDs that takes its category. Targets are delivered to in
+// parallel; it returns once all deliveries have finished.
+func (n *Notifier) SendNotification(ctx context.Context, notif notifications.Notification, userIDs []int) {
+	targets, err := n.repo.GetWebhookTargetsForUsers(ctx, userIDs)
+	if err != nil {
+		n.logger.Error("failed to get webhook targets", "error", err)
+		return
+	}
+
+	var wg sync.WaitGroup
+	for _, target := range targets {
+		if !target.ReceivesCategory(notif.Category) {
+			continue
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			n.Deliver(ctx, target, notif)
+		}()
+	}
+	wg.Wait()
+}
+
+// Deliver sends notif to target, retrying with exponential backoff on network errors,
+// 429 and 5xx responses, and records the outcome in the delivery log.
+func (n *Notifier) Deliver(ctx context.Context, target Target, notif notifications.Notification) Delivery {
+	delivery := Delivery{TargetID: target.ID, Status: DeliveryStatusFailed}
+	if notif.ID > 0 {
+		id := notif.ID
+		delivery.NotificationID = &id
+	}
+
+	timeout, maxAttempts, backoff := deliverySettings()
+	body, err := target.RenderBody(notif)
+	if err != nil {
+		delivery.Error = err.Error()
+	}
+	for attempt := 1; err == nil && attempt <= maxAttempts; attempt++ {
+		delivery.Attempts = attempt
+		code, postErr := n.post(ctx, target, body, timeout)
+		if postErr == nil {
+			delivery.ResponseCode = &code
+			if code >= 200 && code < 300 {
+				delivery.Status = DeliveryStatusDelivered
+				delivery.Error = ""
+				break
+			}
+			delivery.Error = fmt.Sprintf("unexpected status %d", code)
+			if code != http.StatusTooManyRequests && code < 500 {
+				break
+			}
+		} else {
+			delivery.ResponseCode = nil
+			delivery.Error = postErr.Error()
+		}
+		if attempt < maxAttempts {
+			if err = n.sleep(ctx, backoff<<(attempt-1)); err != nil {
+				delivery.Error = err.Error()
+			}
+		}
+	}
+
+	if err := n.repo.RecordWebhookDelivery(context.WithoutCancel(ctx), &delivery); err != nil {
+		n.logger.Error("failed to record webhook delivery", "target", target.Name, "error", err)
+	}
+	if delivery.Status == DeliveryStatusDelivered {
+		n.logger.Info("webhook delivered", "target", target.Name, "title", notif.Title, "attempts", delivery.Attempts)
+	} else {
+		n.logger.Warn("webhook delivery failed", "target", target.Name, "title", notif.Title, "attempts", delivery.Attempts, "error", delivery.Error)
+	}
+	return delivery
+}
+
+// post makes one delivery attempt and returns the response status code.
+func (n *Notifier) post(ctx context.Context, target Target, body []byte, timeout time.Duration) (int, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, target.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, fmt.Errorf("failed to build webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "sensor-hub-webhook")
+	for name, value := range target.Headers {
+		req.Header.Set(name, value)
+	}
+	if target.Secret != "" {
+		req.Header.Set(SignatureHeader, Sign(target.Secret, body))
+	}
+
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("webhook request failed: %w", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+	return resp.StatusCode, nil
+}
+
+// Sign returns the SignatureHeader value for body signed with secret. Receivers verify a
+// delivery by computing the same value over the raw request body.
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func deliverySettings() (timeout time.Duration, maxAttempts int, backoff time.Duration) {
+	timeout = defaultTimeoutSeconds * time.Second
+	maxAttempts = defaultMaxAttempts
+	backoff = 2 * time.Second
+	if cfg := appProps.AppConfig; cfg != nil {
+		if cfg.WebhookTimeoutSeconds > 0 {
+			timeout = time.Duration(cfg.WebhookTimeoutSeconds) * time.Second
+		}
+		if cfg.WebhookMaxAttempts > 0 {
+			maxAttempts = cfg.WebhookMaxAttempts
+		}
+		if cfg.WebhookRetryBackoffSeconds >= 0 {
+			backoff = time.Duration(cfg.WebhookRetryBackoffSeconds) * time.Second
+		}
+	}
+	return timeout, maxAttempts, backoff
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
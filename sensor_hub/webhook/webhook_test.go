@@ -0,0 +1,200 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	appProps "example/sensorHub/application_properties"
+	"example/sensorHub/notifications"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeRepository struct {
+	mu         sync.Mutex
+	targets    []Target
+	deliveries []Delivery
+}
+
+func (f *fakeRepository) GetWebhookTargetsForUsers(_ context.Context, _ []int) ([]Target, error) {
+	return f.targets, nil
+}
+
+func (f *fakeRepository) RecordWebhookDelivery(_ context.Context, delivery *Delivery) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.deliveries = append(f.deliveries, *delivery)
+	return nil
+}
+
+type receivedRequest struct {
+	header http.Header
+	body   []byte
+}
+
+// newReceiver starts a webhook receiver that answers with statuses in turn, repeating
+// the last one, and records every request it gets.
+func newReceiver(t *testing.T, statuses ...int) (*httptest.Server, func() []receivedRequest) {
+	t.Helper()
+	var mu sync.Mutex
+	var received []receivedRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		mu.Lock()
+		received = append(received, receivedRequest{header: r.Header.Clone(), body: body})
+		status := statuses[min(len(received), len(statuses))-1]
+		mu.Unlock()
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(server.Close)
+	return server, func() []receivedRequest {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]receivedRequest(nil), received...)
+	}
+}
+
+func newTestNotifier(t *testing.T, repo *fakeRepository) (*Notifier, *[]time.Duration) {
+	t.Helper()
+	originalConfig := appProps.AppConfig
+	appProps.AppConfig = &appProps.ApplicationConfiguration{WebhookTimeoutSeconds: 5, WebhookMaxAttempts: 3, WebhookRetryBackoffSeconds: 2}
+	t.Cleanup(func() { appProps.AppConfig = originalConfig })
+
+	n := NewNotifier(repo, slog.Default())
+	var waits []time.Duration
+	n.sleep = func(_ context.Context, d time.Duration) error {
+		waits = append(waits, d)
+		return nil
+	}
+	return n, &waits
+}
+
+func testNotification() notifications.Notification {
+	return notifications.Notification{
+		ID:       42,
+		Category: notifications.CategoryThresholdAlert,
+		Severity: notifications.SeverityWarning,
+		Title:    "Alert: garage",
+		Message:  `value -2.00 is below "low"`,
+	}
+}
+
+func TestNotifier_Deliver_SendsSignedJSON(t *testing.T) {
+	server, received := newReceiver(t, http.StatusOK)
+	repo := &fakeRepository{}
+	n, _ := newTestNotifier(t, repo)
+	target := Target{ID: 1, Name: "ops", URL: server.URL, Secret: "s3cret", Headers: map[string]string{"X-Api-Key": "abc"}, Enabled: true}
+
+	delivery := n.Deliver(context.Background(), target, testNotification())
+
+	assert.Equal(t, DeliveryStatusDelivered, delivery.Status)
+	assert.Equal(t, 1, delivery.Attempts)
+	require.NotNil(t, delivery.ResponseCode)
+	assert.Equal(t, http.StatusOK, *delivery.ResponseCode)
+
+	reqs := received()
+	require.Len(t, reqs, 1)
+	assert.Equal(t, "application/json", reqs[0].header.Get("Content-Type"))
+	assert.Equal(t, "abc", reqs[0].header.Get("X-Api-Key"))
+	assert.Equal(t, Sign("s3cret", reqs[0].body), reqs[0].header.Get(SignatureHeader))
+
+	var payload Payload
+	require.NoError(t, json.Unmarshal(reqs[0].body, &payload))
+	assert.Equal(t, 42, payload.ID)
+	assert.Equal(t, "Alert: garage", payload.Title)
+	assert.Equal(t, "ops", payload.Target)
+
+	require.Len(t, repo.deliveries, 1)
+	require.NotNil(t, repo.deliveries[0].NotificationID)
+	assert.Equal(t, 42, *repo.deliveries[0].NotificationID)
+}
+
+func TestNotifier_Deliver_RendersBodyTemplate(t *testing.T) {
+	server, received := newReceiver(t, http.StatusNoContent)
+	n, _ := newTestNotifier(t, &fakeRepository{})
+	target := Target{ID: 1, Name: "chat", URL: server.URL, BodyTemplate: `{"text": {{json (printf "%s: %s" .Title .Message)}}}`}
+
+	delivery := n.Deliver(context.Background(), target, testNotification())
+
+	assert.Equal(t, DeliveryStatusDelivered, delivery.Status)
+	reqs := received()
+	require.Len(t, reqs, 1)
+	assert.JSONEq(t, `{"text": "Alert: garage: value -2.00 is below \"low\""}`, string(reqs[0].body))
+	assert.Empty(t, reqs[0].header.Get(SignatureHeader))
+}
+
+func TestNotifier_Deliver_RetriesServerErrorsWithBackoff(t *testing.T) {
+	server, received := newReceiver(t, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusOK)
+	n, waits := newTestNotifier(t, &fakeRepository{})
+
+	delivery := n.Deliver(context.Background(), Target{ID: 1, Name: "ops", URL: server.URL}, testNotification())
+
+	assert.Equal(t, DeliveryStatusDelivered, delivery.Status)
+	assert.Equal(t, 3, delivery.Attempts)
+	assert.Len(t, received(), 3)
+	assert.Equal(t, []time.Duration{2 * time.Second, 4 * time.Second}, *waits)
+}
+
+func TestNotifier_Deliver_GivesUpAfterMaxAttempts(t *testing.T) {
+	server, received := newReceiver(t, http.StatusInternalServerError)
+	repo := &fakeRepository{}
+	n, _ := newTestNotifier(t, repo)
+
+	delivery := n.Deliver(context.Background(), Target{ID: 1, Name: "ops", URL: server.URL}, testNotification())
+
+	assert.Equal(t, DeliveryStatusFailed, delivery.Status)
+	assert.Equal(t, 3, delivery.Attempts)
+	assert.Equal(t, "unexpected status 500", delivery.Error)
+	assert.Len(t, received(), 3)
+	require.Len(t, repo.deliveries, 1)
+	assert.Equal(t, DeliveryStatusFailed, repo.deliveries[0].Status)
+}
+
+func TestNotifier_Deliver_DoesNotRetryClientErrors(t *testing.T) {
+	server, received := newReceiver(t, http.StatusUnauthorized)
+	n, waits := newTestNotifier(t, &fakeRepository{})
+
+	delivery := n.Deliver(context.Background(), Target{ID: 1, Name: "ops", URL: server.URL}, testNotification())
+
+	assert.Equal(t, DeliveryStatusFailed, delivery.Status)
+	assert.Equal(t, 1, delivery.Attempts)
+	assert.Len(t, received(), 1)
+	assert.Empty(t, *waits)
+}
+
+func TestNotifier_Deliver_RetriesUnreachableReceiver(t *testing.T) {
+	server, _ := newReceiver(t, http.StatusOK)
+	url := server.URL
+	server.Close()
+	n, _ := newTestNotifier(t, &fakeRepository{})
+
+	delivery := n.Deliver(context.Background(), Target{ID: 1, Name: "ops", URL: url}, testNotification())
+
+	assert.Equal(t, DeliveryStatusFailed, delivery.Status)
+	assert.Equal(t, 3, delivery.Attempts)
+	assert.Nil(t, delivery.ResponseCode)
+	assert.Contains(t, delivery.Error, "webhook request failed")
+}
+
+func TestNotifier_SendNotification_SkipsTargetsForOtherCategories(t *testing.T) {
+	server, received := newReceiver(t, http.StatusOK)
+	repo := &fakeRepository{targets: []Target{
+		{ID: 1, Name: "all", URL: server.URL + "/all", Enabled: true},
+		{ID: 2, Name: "alerts", URL: server.URL + "/alerts", Enabled: true, Categories: []notifications.NotificationCategory{notifications.CategoryThresholdAlert}},
+		{ID: 3, Name: "users", URL: server.URL + "/users", Enabled: true, Categories: []notifications.NotificationCategory{notifications.CategoryUserManagement}},
+	}}
+	n, _ := newTestNotifier(t, repo)
+
+	n.SendNotification(context.Background(), testNotification(), []int{1})
+
+	assert.Len(t, received(), 2)
+	assert.Len(t, repo.deliveries, 2)
+}
@@ -0,0 +1,28 @@
+package ws
+
+import (
+	"log/slog"
+
+	"example/sensorHub/scenes"
+)
+
+// SceneStatusMessage reports the final outcome of a scene activation.
+type SceneStatusMessage struct {
+	Type string `json:"type"`
+	scenes.Activation
+}
+
+type SceneStatusBroadcaster struct {
+	logger *slog.Logger
+}
+
+func NewSceneStatusBroadcaster(logger *slog.Logger) *SceneStatusBroadcaster {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &SceneStatusBroadcaster{logger: logger.With("component", "scene_status_broadcaster")}
+}
+
+func (b *SceneStatusBroadcaster) BroadcastSceneStatus(activation scenes.Activation) {
+	BroadcastToTopic("current-readings", SceneStatusMessage{Type: "scene_status", Activation: activation})
+}